@@ -0,0 +1,375 @@
+// Command bench genera carga contra un servidor Notebook en ejecución y emite
+// percentiles de latencia y tasas de error por RPC en formato JSON.
+//
+// Ejemplo:
+//
+//	go run ./cmd/bench -addr localhost:50051 -rps 200 -duration 1m -mix create=20,list=50,update=20,stream=10
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var operations = map[string]func(b *bench, ctx context.Context) error{
+	"create": (*bench).createIdea,
+	"list":   (*bench).listIdeas,
+	"update": (*bench).updateIdea,
+	"stream": (*bench).streamNotification,
+}
+
+type config struct {
+	addr          string
+	rps           int
+	duration      time.Duration
+	concurrency   int
+	mix           map[string]int
+	userID        string
+	token         string
+	seedIdeas     int
+	timeout       time.Duration
+	streamTimeout time.Duration
+	randSeed      int64
+	output        string
+}
+
+type bench struct {
+	config   config
+	client   pb.NotebookServiceClient
+	recorder *recorder
+	ideas    *ideaPool
+	dropped  int64
+}
+
+func main() {
+	cfg, err := parseFlags()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.Dial(cfg.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to %s: %v", cfg.addr, err)
+	}
+	defer conn.Close()
+
+	b := &bench{
+		config:   cfg,
+		client:   pb.NewNotebookServiceClient(conn),
+		recorder: newRecorder(),
+		ideas:    &ideaPool{},
+	}
+
+	// Las actualizaciones necesitan ideas existentes sobre las que operar
+	if err := b.seed(cfg.seedIdeas); err != nil {
+		log.Fatalf("failed to seed ideas: %v", err)
+	}
+
+	startedAt := time.Now()
+	b.run()
+	elapsed := time.Since(startedAt)
+
+	total, ops := b.recorder.summarize()
+	report := Report{
+		Target:      cfg.addr,
+		StartedAt:   startedAt,
+		Duration:    elapsed.Seconds(),
+		TargetRPS:   cfg.rps,
+		AchievedRPS: float64(total.Requests) / elapsed.Seconds(),
+		Concurrency: cfg.concurrency,
+		Mix:         cfg.mix,
+		Dropped:     atomic.LoadInt64(&b.dropped),
+		Total:       total,
+		Operations:  ops,
+	}
+
+	if err := writeReport(cfg.output, report); err != nil {
+		log.Fatalf("failed to write report: %v", err)
+	}
+}
+
+func parseFlags() (config, error) {
+	var cfg config
+	var mix string
+
+	flag.StringVar(&cfg.addr, "addr", "localhost:50051", "server address")
+	flag.IntVar(&cfg.rps, "rps", 50, "target requests per second")
+	flag.DurationVar(&cfg.duration, "duration", 30*time.Second, "test duration")
+	flag.IntVar(&cfg.concurrency, "concurrency", 32, "maximum in-flight requests; requests beyond this are dropped and counted")
+	flag.StringVar(&mix, "mix", "create=30,list=40,update=20,stream=10", "weighted RPC mix (create, list, update, stream)")
+	flag.StringVar(&cfg.userID, "user", uuid.New().String(), "user ID used for create/list/update")
+	flag.StringVar(&cfg.token, "token", "", "bearer token sent in the authorization metadata")
+	flag.IntVar(&cfg.seedIdeas, "seed-ideas", 20, "ideas created before the run for update operations")
+	flag.DurationVar(&cfg.timeout, "timeout", 5*time.Second, "per-request timeout")
+	flag.DurationVar(&cfg.streamTimeout, "stream-timeout", 5*time.Second, "maximum wait for a streamed notification")
+	flag.Int64Var(&cfg.randSeed, "rand-seed", 1, "seed for the operation mix, for reproducible runs")
+	flag.StringVar(&cfg.output, "out", "", "write the JSON report to this file instead of stdout")
+	flag.Parse()
+
+	if cfg.rps <= 0 {
+		return cfg, fmt.Errorf("-rps must be positive")
+	}
+	if cfg.concurrency <= 0 {
+		return cfg, fmt.Errorf("-concurrency must be positive")
+	}
+	if _, err := uuid.Parse(cfg.userID); err != nil {
+		return cfg, fmt.Errorf("-user must be a UUID: %w", err)
+	}
+
+	parsed, err := parseMix(mix)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.mix = parsed
+	return cfg, nil
+}
+
+// parseMix interpreta una lista "op=peso,op=peso"
+func parseMix(raw string) (map[string]int, error) {
+	mix := make(map[string]int)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weight, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid mix entry %q, expected op=weight", part)
+		}
+		if _, known := operations[name]; !known {
+			return nil, fmt.Errorf("unknown operation %q in mix", name)
+		}
+		w, err := strconv.Atoi(weight)
+		if err != nil || w < 0 {
+			return nil, fmt.Errorf("invalid weight for %q: %s", name, weight)
+		}
+		if w > 0 {
+			mix[name] = w
+		}
+	}
+	if len(mix) == 0 {
+		return nil, fmt.Errorf("mix must contain at least one operation with positive weight")
+	}
+	return mix, nil
+}
+
+// run lanza operaciones a ritmo constante (carga de bucle abierto) para que la
+// latencia medida no se oculte cuando el servidor se ralentiza
+func (b *bench) run() {
+	picker := newWeightedPicker(b.config.mix, b.config.randSeed)
+	slots := make(chan struct{}, b.config.concurrency)
+	ticker := time.NewTicker(time.Second / time.Duration(b.config.rps))
+	defer ticker.Stop()
+
+	deadline := time.After(b.config.duration)
+	var wg sync.WaitGroup
+
+	for {
+		select {
+		case <-deadline:
+			wg.Wait()
+			return
+		case <-ticker.C:
+			select {
+			case slots <- struct{}{}:
+			default:
+				atomic.AddInt64(&b.dropped, 1)
+				continue
+			}
+
+			op := picker.pick()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				b.execute(op)
+			}()
+		}
+	}
+}
+
+func (b *bench) execute(op string) {
+	timeout := b.config.timeout
+	if op == "stream" {
+		timeout = b.config.streamTimeout
+	}
+	ctx, cancel := context.WithTimeout(b.outgoingContext(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := operations[op](b, ctx)
+	b.recorder.record(op, time.Since(start), err)
+}
+
+func (b *bench) outgoingContext() context.Context {
+	ctx := context.Background()
+	if b.config.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+b.config.token)
+	}
+	return ctx
+}
+
+func (b *bench) seed(n int) error {
+	for i := 0; i < n; i++ {
+		ctx, cancel := context.WithTimeout(b.outgoingContext(), b.config.timeout)
+		err := b.createIdea(ctx)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *bench) createIdea(ctx context.Context) error {
+	resp, err := b.client.CreateIdea(ctx, newCreateIdeaRequest(b.config.userID))
+	if err != nil {
+		return err
+	}
+	b.ideas.add(resp.GetIdea().GetId())
+	return nil
+}
+
+func (b *bench) listIdeas(ctx context.Context) error {
+	_, err := b.client.ListIdeas(ctx, &pb.ListIdeasRequest{
+		UserId:   b.config.userID,
+		Page:     1,
+		PageSize: 20,
+	})
+	return err
+}
+
+func (b *bench) updateIdea(ctx context.Context) error {
+	id, ok := b.ideas.next()
+	if !ok {
+		return b.createIdea(ctx)
+	}
+	_, err := b.client.UpdateIdea(ctx, &pb.UpdateIdeaRequest{
+		Id:      id,
+		UserId:  b.config.userID,
+		Content: fmt.Sprintf("bench update at %s", time.Now().Format(time.RFC3339Nano)),
+	})
+	return err
+}
+
+// streamNotification mide la latencia extremo a extremo desde que se crea una
+// idea hasta que su notificación llega por el stream de un usuario aislado
+func (b *bench) streamNotification(ctx context.Context) error {
+	userID := uuid.New().String()
+
+	stream, err := b.client.SubscribeNotifications(ctx, &pb.NotificationSubscriptionRequest{UserId: userID})
+	if err != nil {
+		return err
+	}
+	if _, err := b.client.CreateIdea(ctx, newCreateIdeaRequest(userID)); err != nil {
+		return err
+	}
+	_, err = stream.Recv()
+	return err
+}
+
+func newCreateIdeaRequest(userID string) *pb.CreateIdeaRequest {
+	return &pb.CreateIdeaRequest{
+		Title:    "Bench idea",
+		Content:  "Generated by the load testing tool",
+		Tags:     []string{"bench"},
+		Category: pb.IdeaCategory_IDEA_CATEGORY_TECHNICAL,
+		Priority: 3,
+		UserId:   userID,
+	}
+}
+
+func writeReport(path string, report Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if path == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// ideaPool guarda las ideas creadas y las reparte en round-robin para las actualizaciones
+type ideaPool struct {
+	mu     sync.Mutex
+	ids    []string
+	cursor int
+}
+
+func (p *ideaPool) add(id string) {
+	if id == "" {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ids = append(p.ids, id)
+}
+
+func (p *ideaPool) next() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ids) == 0 {
+		return "", false
+	}
+	id := p.ids[p.cursor%len(p.ids)]
+	p.cursor++
+	return id, true
+}
+
+// weightedPicker elige operaciones según los pesos del mix con una semilla fija
+type weightedPicker struct {
+	rng     *rand.Rand
+	names   []string
+	weights []int
+	total   int
+}
+
+func newWeightedPicker(mix map[string]int, seed int64) *weightedPicker {
+	names := make([]string, 0, len(mix))
+	for name := range mix {
+		names = append(names, name)
+	}
+	// Orden estable para que la misma semilla produzca la misma secuencia
+	sort.Strings(names)
+
+	p := &weightedPicker{rng: rand.New(rand.NewSource(seed)), names: names}
+	for _, name := range names {
+		p.weights = append(p.weights, mix[name])
+		p.total += mix[name]
+	}
+	return p
+}
+
+func (p *weightedPicker) pick() string {
+	n := p.rng.Intn(p.total)
+	for i, w := range p.weights {
+		if n < w {
+			return p.names[i]
+		}
+		n -= w
+	}
+	return p.names[len(p.names)-1]
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// OperationReport resume los resultados de un tipo de operación
+type OperationReport struct {
+	Operation string         `json:"operation"`
+	Requests  int            `json:"requests"`
+	Errors    int            `json:"errors"`
+	ErrorRate float64        `json:"error_rate"`
+	ErrorsBy  map[string]int `json:"errors_by_code,omitempty"`
+	Latency   LatencySummary `json:"latency_ms"`
+}
+
+// LatencySummary contiene percentiles de latencia en milisegundos
+type LatencySummary struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// Report es el resultado completo de una ejecución del benchmark
+type Report struct {
+	Target      string            `json:"target"`
+	StartedAt   time.Time         `json:"started_at"`
+	Duration    float64           `json:"duration_seconds"`
+	TargetRPS   int               `json:"target_rps"`
+	AchievedRPS float64           `json:"achieved_rps"`
+	Concurrency int               `json:"concurrency"`
+	Mix         map[string]int    `json:"mix"`
+	Dropped     int64             `json:"dropped"`
+	Total       OperationReport   `json:"total"`
+	Operations  []OperationReport `json:"operations"`
+}
+
+// recorder acumula las muestras de latencia y errores de cada operación
+type recorder struct {
+	mu      sync.Mutex
+	samples map[string][]time.Duration
+	errors  map[string]map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		samples: make(map[string][]time.Duration),
+		errors:  make(map[string]map[string]int),
+	}
+}
+
+func (r *recorder) record(op string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.samples[op] = append(r.samples[op], latency)
+	if err != nil {
+		if r.errors[op] == nil {
+			r.errors[op] = make(map[string]int)
+		}
+		r.errors[op][status.Code(err).String()]++
+	}
+}
+
+// summarize construye el informe por operación y el total agregado
+func (r *recorder) summarize() (OperationReport, []OperationReport) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ops := make([]string, 0, len(r.samples))
+	for op := range r.samples {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	var all []time.Duration
+	allErrors := make(map[string]int)
+	reports := make([]OperationReport, 0, len(ops))
+	for _, op := range ops {
+		reports = append(reports, buildOperationReport(op, r.samples[op], r.errors[op]))
+		all = append(all, r.samples[op]...)
+		for code, n := range r.errors[op] {
+			allErrors[code] += n
+		}
+	}
+
+	return buildOperationReport("total", all, allErrors), reports
+}
+
+func buildOperationReport(op string, samples []time.Duration, errorsBy map[string]int) OperationReport {
+	report := OperationReport{
+		Operation: op,
+		Requests:  len(samples),
+		ErrorsBy:  errorsBy,
+		Latency:   summarizeLatencies(samples),
+	}
+	for _, n := range errorsBy {
+		report.Errors += n
+	}
+	if report.Requests > 0 {
+		report.ErrorRate = float64(report.Errors) / float64(report.Requests)
+	}
+	return report
+}
+
+func summarizeLatencies(samples []time.Duration) LatencySummary {
+	if len(samples) == 0 {
+		return LatencySummary{}
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	return LatencySummary{
+		Min:  toMillis(sorted[0]),
+		Mean: toMillis(sum / time.Duration(len(sorted))),
+		P50:  toMillis(percentile(sorted, 0.50)),
+		P90:  toMillis(percentile(sorted, 0.90)),
+		P95:  toMillis(percentile(sorted, 0.95)),
+		P99:  toMillis(percentile(sorted, 0.99)),
+		Max:  toMillis(sorted[len(sorted)-1]),
+	}
+}
+
+// percentile usa el método nearest-rank sobre muestras ya ordenadas
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+func toMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/spf13/cobra"
+)
+
+// withAdminClient abre la conexión al servicio de administración y ejecuta fn
+func withAdminClient(cmd *cobra.Command, fn func(client pb.AdminServiceClient) error) error {
+	conn, err := dial(opts.adminAddr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(pb.NewAdminServiceClient(conn))
+}
+
+func newDLQCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "dlq", Short: "Manage the dead letter queue"}
+
+	var topic string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List dead-lettered messages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{Topic: topic})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	list.Flags().StringVar(&topic, "topic", "", "only list messages for this topic")
+
+	var all bool
+	requeue := &cobra.Command{
+		Use:   "requeue [message-id...]",
+		Short: "Move dead-lettered messages back to the main queue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.RequeueDeadLetters(ctx, &pb.RequeueDeadLettersRequest{
+					MessageIds: args,
+					All:        all,
+					Topic:      topic,
+				})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	requeue.Flags().BoolVar(&all, "all", false, "requeue every message (optionally filtered by --topic)")
+	requeue.Flags().StringVar(&topic, "topic", "", "topic filter used with --all")
+
+	cmd.AddCommand(list, requeue)
+	return cmd
+}
+
+func newQuarantineCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "quarantine", Short: "Inspect and replay poison messages"}
+
+	var topic string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List quarantined messages with the panic and log of their handler",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListQuarantined(ctx, &pb.ListQuarantinedRequest{Topic: topic})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	list.Flags().StringVar(&topic, "topic", "", "only list messages for this topic")
+
+	replay := &cobra.Command{
+		Use:   "replay <message-id...>",
+		Short: "Move quarantined messages back to the queue once the handler is fixed",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ReplayQuarantined(ctx, &pb.ReplayQuarantinedRequest{MessageIds: args})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(list, replay)
+	return cmd
+}
+
+func newQueueCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "queue", Short: "Control message delivery"}
+
+	pause := &cobra.Command{
+		Use:   "pause <topic>",
+		Short: "Stop delivering a topic; publishing keeps working",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.PauseTopic(ctx, &pb.PauseTopicRequest{Topic: args[0]})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	resume := &cobra.Command{
+		Use:   "resume <topic>",
+		Short: "Deliver a paused topic again, including the messages held while paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ResumeTopic(ctx, &pb.ResumeTopicRequest{Topic: args[0]})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	var wait time.Duration
+	drain := &cobra.Command{
+		Use:   "drain",
+		Short: "Stop all delivery and wait for running handlers, before a deployment",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				// El servidor espera hasta --wait, así que la llamada necesita más que --timeout
+				ctx, cancel := context.WithTimeout(authContext(cmd.Context()), wait+opts.timeout)
+				defer cancel()
+				resp, err := client.DrainQueue(ctx, &pb.DrainQueueRequest{TimeoutSeconds: int32(wait / time.Second)})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	drain.Flags().DurationVar(&wait, "wait", 30*time.Second, "how long the server waits for running handlers")
+
+	cmd.AddCommand(pause, resume, drain)
+	return cmd
+}
+
+func newTokenCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "token", Short: "Manage access tokens"}
+
+	rotate := &cobra.Command{
+		Use:   "rotate <token>",
+		Short: "Issue a new token and revoke the given one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.RotateToken(ctx, &pb.RotateTokenRequest{Token: args[0]})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(rotate)
+	return cmd
+}
+
+func newJobsCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "jobs", Short: "Inspect and trigger background jobs"}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List background jobs and their last run status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListJobs(ctx, &pb.ListJobsRequest{})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	run := &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a background job now and wait for it to finish",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.RunJob(ctx, &pb.RunJobRequest{Name: args[0]})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(list, run)
+	return cmd
+}
+
+func newLogLevelCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "log-level", Short: "Change the server log level"}
+
+	set := &cobra.Command{
+		Use:       "set <level>",
+		Short:     "Set the log level (trace, debug, info, warn, error, fatal)",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"trace", "debug", "info", "warn", "error", "fatal"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.SetLogLevel(ctx, &pb.SetLogLevelRequest{Level: args[0]})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(set)
+	return cmd
+}
+
+func newMaintenanceCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "maintenance", Short: "Put the server in read-only maintenance mode"}
+
+	var (
+		message    string
+		retryAfter time.Duration
+	)
+	on := &cobra.Command{
+		Use:   "on",
+		Short: "Reject writes, pause scheduled jobs and notify connected clients",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.SetMaintenanceMode(ctx, &pb.SetMaintenanceModeRequest{
+					Enabled:           true,
+					Message:           message,
+					RetryAfterSeconds: int64(retryAfter / time.Second),
+				})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	on.Flags().StringVar(&message, "message", "", "notice shown to users")
+	on.Flags().DurationVar(&retryAfter, "retry-after", 0, "retry hint for rejected writes (server default if unset)")
+
+	off := &cobra.Command{
+		Use:   "off",
+		Short: "Accept writes again and resume scheduled jobs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.SetMaintenanceMode(ctx, &pb.SetMaintenanceModeRequest{Enabled: false})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show whether maintenance mode is enabled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.GetMaintenanceMode(ctx, &pb.GetMaintenanceModeRequest{})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(on, off, statusCmd)
+	return cmd
+}
+
+func newReplicationCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "replication", Short: "Inspect and promote a follower instance"}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the role of the instance and how far a follower lags behind the primary",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.GetReplicationStatus(ctx, &pb.GetReplicationStatusRequest{})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	promote := &cobra.Command{
+		Use:   "promote",
+		Short: "Make a follower the primary; the old primary must already be down",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.PromoteToPrimary(ctx, &pb.PromoteToPrimaryRequest{})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+
+	cmd.AddCommand(statusCmd, promote)
+	return cmd
+}
+
+func newModerationCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "moderation", Short: "Audit content rejected by the moderation policy"}
+
+	var organization string
+	var limit int32
+	rejections := &cobra.Command{
+		Use:   "rejections",
+		Short: "List the most recent rejected ideas and the terms that matched",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(cmd, func(client pb.AdminServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListModerationRejections(ctx, &pb.ListModerationRejectionsRequest{
+					Organization: organization,
+					Limit:        limit,
+				})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	rejections.Flags().StringVar(&organization, "org", "", "only list rejections for this organization")
+	rejections.Flags().Int32Var(&limit, "limit", 50, "maximum number of rejections")
+
+	cmd.AddCommand(rejections)
+	return cmd
+}
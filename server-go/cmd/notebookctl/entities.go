@@ -0,0 +1,203 @@
+package main
+
+import (
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/spf13/cobra"
+)
+
+// withNotebookClient abre la conexión al servicio principal y ejecuta fn con un contexto autenticado
+func withNotebookClient(cmd *cobra.Command, fn func(client pb.NotebookServiceClient) error) error {
+	if err := requireUser(); err != nil {
+		return err
+	}
+	conn, err := dial(opts.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(pb.NewNotebookServiceClient(conn))
+}
+
+func newIdeasCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "ideas", Short: "Inspect ideas"}
+
+	var page, pageSize int32
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List a user's ideas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListIdeas(ctx, &pb.ListIdeasRequest{UserId: opts.userID, Page: page, PageSize: pageSize})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	list.Flags().Int32Var(&page, "page", 1, "page number")
+	list.Flags().Int32Var(&pageSize, "page-size", 20, "page size")
+
+	get := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show an idea",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.GetIdea(ctx, &pb.GetIdeaRequest{Id: args[0], UserId: opts.userID})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp.Idea)
+			})
+		},
+	}
+
+	cmd.AddCommand(list, get)
+	return cmd
+}
+
+func newRemindersCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "reminders", Short: "Inspect reminders"}
+
+	var page, pageSize int32
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List a user's reminders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListReminders(ctx, &pb.ListRemindersRequest{UserId: opts.userID, Page: page, PageSize: pageSize})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	list.Flags().Int32Var(&page, "page", 1, "page number")
+	list.Flags().Int32Var(&pageSize, "page-size", 20, "page size")
+
+	get := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a reminder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.GetReminder(ctx, &pb.GetReminderRequest{Id: args[0], UserId: opts.userID})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp.Reminder)
+			})
+		},
+	}
+
+	cmd.AddCommand(list, get)
+	return cmd
+}
+
+func newFilesCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "files", Short: "Inspect files"}
+
+	var page, pageSize int32
+	var contentType, query string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List a user's files",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.ListFiles(ctx, &pb.ListFilesRequest{
+					UserId:            opts.userID,
+					ContentTypeFilter: contentType,
+					SearchQuery:       query,
+					Page:              page,
+					PageSize:          pageSize,
+				})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp)
+			})
+		},
+	}
+	list.Flags().Int32Var(&page, "page", 1, "page number")
+	list.Flags().Int32Var(&pageSize, "page-size", 20, "page size")
+	list.Flags().StringVar(&contentType, "content-type", "", "only list files with this content type")
+	list.Flags().StringVar(&query, "query", "", "only list files whose name or extracted text matches")
+
+	cmd.AddCommand(list)
+	return cmd
+}
+
+func newProgressCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "progress", Short: "Inspect progress records"}
+
+	get := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a progress record",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				ctx, cancel := requestContext(cmd)
+				defer cancel()
+				resp, err := client.GetProgress(ctx, &pb.GetProgressRequest{Id: args[0], UserId: opts.userID})
+				if err != nil {
+					return err
+				}
+				return printProto(cmd, resp.Progress)
+			})
+		},
+	}
+
+	cmd.AddCommand(get)
+	return cmd
+}
+
+func newNotificationsCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "notifications", Short: "Work with notifications"}
+
+	var channels []string
+	tail := &cobra.Command{
+		Use:   "tail",
+		Short: "Stream a user's notifications until interrupted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withNotebookClient(cmd, func(client pb.NotebookServiceClient) error {
+				// Sin timeout: el stream se mantiene abierto hasta Ctrl+C
+				stream, err := client.SubscribeNotifications(authContext(cmd.Context()), &pb.NotificationSubscriptionRequest{
+					UserId:   opts.userID,
+					Channels: channels,
+				})
+				if err != nil {
+					return err
+				}
+				for {
+					notification, err := stream.Recv()
+					if err != nil {
+						return err
+					}
+					if err := printProto(cmd, notification); err != nil {
+						return err
+					}
+				}
+			})
+		},
+	}
+	tail.Flags().StringSliceVar(&channels, "channel", nil, "only receive notifications for these channels")
+
+	cmd.AddCommand(tail)
+	return cmd
+}
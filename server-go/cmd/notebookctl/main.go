@@ -0,0 +1,22 @@
+// Command notebookctl es la CLI de administración del servidor Notebook.
+//
+// Habla con el servidor por gRPC: consulta entidades y notificaciones en el puerto
+// principal y usa la API de administración (puerto separado, rol admin) para la
+// cola de mensajes fallidos, la rotación de tokens, las tareas de mantenimiento y
+// el nivel de log.
+//
+// La autenticación se hace con una API key (--api-key / NOTEBOOKCTL_API_KEY) o con
+// un token (--token / NOTEBOOKCTL_TOKEN).
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
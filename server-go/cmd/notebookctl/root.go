@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// globalOptions contiene los flags compartidos por todos los subcomandos
+type globalOptions struct {
+	addr      string
+	adminAddr string
+	apiKey    string
+	token     string
+	userID    string
+	timeout   time.Duration
+}
+
+var opts globalOptions
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "notebookctl",
+		Short:         "Administration CLI for the Notebook server",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.addr, "addr", envOrDefault("NOTEBOOKCTL_ADDR", "localhost:50051"), "notebook service address")
+	flags.StringVar(&opts.adminAddr, "admin-addr", envOrDefault("NOTEBOOKCTL_ADMIN_ADDR", "localhost:50052"), "admin service address")
+	flags.StringVar(&opts.apiKey, "api-key", os.Getenv("NOTEBOOKCTL_API_KEY"), "admin API key (sent as x-api-key)")
+	flags.StringVar(&opts.token, "token", os.Getenv("NOTEBOOKCTL_TOKEN"), "bearer token (sent as authorization)")
+	flags.StringVar(&opts.userID, "user", os.Getenv("NOTEBOOKCTL_USER"), "user ID whose entities are inspected")
+	flags.DurationVar(&opts.timeout, "timeout", 10*time.Second, "timeout for unary requests")
+
+	root.AddCommand(
+		newIdeasCommand(),
+		newRemindersCommand(),
+		newFilesCommand(),
+		newProgressCommand(),
+		newNotificationsCommand(),
+		newDLQCommand(),
+		newQueueCommand(),
+		newQuarantineCommand(),
+		newTokenCommand(),
+		newJobsCommand(),
+		newLogLevelCommand(),
+		newMaintenanceCommand(),
+		newReplicationCommand(),
+		newModerationCommand(),
+	)
+	return root
+}
+
+// dial abre una conexión gRPC sin TLS; el tráfico de administración debe ir por una red de confianza
+func dial(addr string) (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	return conn, nil
+}
+
+// authContext adjunta las credenciales configuradas como metadata saliente
+func authContext(ctx context.Context) context.Context {
+	if opts.apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", opts.apiKey)
+	}
+	if opts.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+opts.token)
+	}
+	return ctx
+}
+
+// requestContext crea un contexto autenticado con el timeout de las llamadas unarias
+func requestContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(authContext(cmd.Context()), opts.timeout)
+}
+
+func requireUser() error {
+	if opts.userID == "" {
+		return fmt.Errorf("--user is required")
+	}
+	return nil
+}
+
+// printProto imprime un mensaje como JSON legible
+func printProto(cmd *cobra.Command, msg proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: false}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	return err
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,179 @@
+// Command perfdiff compara dos salidas de go test -bench -benchmem y termina con error si algún
+// benchmark empeora más de lo permitido: el p95 de ns/op entre las ejecuciones de -count, o la
+// mediana de allocs/op. Sirve como control de regresiones antes de integrar un cambio.
+//
+// Ejemplo:
+//
+//	go test -run '^$' -bench . -benchmem -count 10 ./internal/... > old.txt
+//	go test -run '^$' -bench . -benchmem -count 10 ./internal/... > new.txt
+//	go run ./cmd/perfdiff -max-p95 10 -max-allocs 5 old.txt new.txt
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"text/tabwriter"
+)
+
+type config struct {
+	maxP95     float64
+	maxAllocs  float64
+	minSamples int
+	oldPath    string
+	newPath    string
+}
+
+// comparison es el resultado de un benchmark presente en ambas salidas
+type comparison struct {
+	Name        string
+	OldP95      float64
+	NewP95      float64
+	P95Change   float64
+	OldAllocs   float64
+	NewAllocs   float64
+	AllocChange float64
+	Samples     int
+	Regressed   bool
+}
+
+func main() {
+	cfg, err := parseFlags()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	oldResults, err := readResults(cfg.oldPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	newResults, err := readResults(cfg.newPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	comparisons, missing := compare(cfg, oldResults, newResults)
+	if len(comparisons) == 0 {
+		fmt.Fprintln(os.Stderr, "no benchmarks in common between the two files")
+		os.Exit(2)
+	}
+
+	regressed := printComparisons(cfg, comparisons)
+	for _, name := range missing {
+		fmt.Printf("only in %s: %s\n", cfg.oldPath, name)
+	}
+	if regressed > 0 {
+		fmt.Printf("\n%d benchmark(s) regressed beyond the thresholds (p95 +%.1f%%, allocs +%.1f%%)\n", regressed, cfg.maxP95, cfg.maxAllocs)
+		os.Exit(1)
+	}
+}
+
+func parseFlags() (config, error) {
+	var cfg config
+
+	flag.Float64Var(&cfg.maxP95, "max-p95", 10, "maximum increase of the p95 ns/op, in percent")
+	flag.Float64Var(&cfg.maxAllocs, "max-allocs", 5, "maximum increase of the median allocs/op, in percent")
+	flag.IntVar(&cfg.minSamples, "min-samples", 5, "benchmarks with fewer runs (-count) in either file are reported but never fail")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: perfdiff [flags] old.txt new.txt\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		return cfg, fmt.Errorf("expected the old and new benchmark files")
+	}
+	if cfg.maxP95 < 0 || cfg.maxAllocs < 0 {
+		return cfg, fmt.Errorf("-max-p95 and -max-allocs must not be negative")
+	}
+	cfg.oldPath, cfg.newPath = flag.Arg(0), flag.Arg(1)
+	return cfg, nil
+}
+
+func readResults(path string) (results, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	parsed, err := parseResults(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return parsed, nil
+}
+
+// compare devuelve las comparaciones de los benchmarks comunes y los que solo están en la salida anterior
+func compare(cfg config, oldResults, newResults results) ([]comparison, []string) {
+	var comparisons []comparison
+	var missing []string
+
+	for _, name := range oldResults.names() {
+		oldSamples := oldResults[name]
+		newSamples, ok := newResults[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+
+		c := comparison{
+			Name:      name,
+			OldP95:    percentile(oldSamples.NsPerOp, 0.95),
+			NewP95:    percentile(newSamples.NsPerOp, 0.95),
+			OldAllocs: percentile(oldSamples.AllocsPerOp, 0.50),
+			NewAllocs: percentile(newSamples.AllocsPerOp, 0.50),
+			Samples:   min(len(oldSamples.NsPerOp), len(newSamples.NsPerOp)),
+		}
+		c.P95Change = change(c.OldP95, c.NewP95)
+		c.AllocChange = change(c.OldAllocs, c.NewAllocs)
+
+		// Sin -benchmem no hay allocs/op y solo se compara la latencia
+		allocsRegressed := !math.IsNaN(c.AllocChange) && c.AllocChange > cfg.maxAllocs
+		c.Regressed = c.Samples >= cfg.minSamples && (c.P95Change > cfg.maxP95 || allocsRegressed)
+		comparisons = append(comparisons, c)
+	}
+	return comparisons, missing
+}
+
+// printComparisons escribe la tabla de resultados y devuelve cuántos benchmarks empeoraron
+func printComparisons(cfg config, comparisons []comparison) int {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "benchmark\told p95 ns/op\tnew p95 ns/op\tdelta\told allocs\tnew allocs\tdelta\t")
+
+	regressed := 0
+	for _, c := range comparisons {
+		result := ""
+		switch {
+		case c.Regressed:
+			result = "REGRESSION"
+			regressed++
+		case c.Samples < cfg.minSamples:
+			result = fmt.Sprintf("(%d samples)", c.Samples)
+		}
+		fmt.Fprintf(w, "%s\t%.1f\t%.1f\t%s\t%s\t%s\t%s\t%s\n",
+			c.Name, c.OldP95, c.NewP95, formatChange(c.P95Change),
+			formatCount(c.OldAllocs), formatCount(c.NewAllocs), formatChange(c.AllocChange), result)
+	}
+	w.Flush()
+	return regressed
+}
+
+func formatChange(pct float64) string {
+	if math.IsNaN(pct) {
+		return "~"
+	}
+	return fmt.Sprintf("%+.1f%%", pct)
+}
+
+func formatCount(value float64) string {
+	if math.IsNaN(value) {
+		return "~"
+	}
+	return fmt.Sprintf("%.0f", value)
+}
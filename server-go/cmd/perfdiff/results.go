@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// samples son las mediciones de un benchmark en todas las ejecuciones de -count
+type samples struct {
+	NsPerOp     []float64
+	BytesPerOp  []float64
+	AllocsPerOp []float64
+}
+
+// results agrupa las muestras por paquete y nombre del benchmark
+type results map[string]*samples
+
+// parseResults lee la salida de go test -bench, el mismo formato que lee benchstat
+func parseResults(r io.Reader) (results, error) {
+	parsed := make(results)
+	pkg := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "pkg: ") {
+			pkg = strings.TrimPrefix(line, "pkg: ")
+			continue
+		}
+		if !strings.HasPrefix(line, "Benchmark") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		// Nombre, iteraciones y al menos un par valor-unidad
+		if len(fields) < 4 || len(fields)%2 != 0 {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		key := fields[0]
+		if pkg != "" {
+			key = pkg + "." + key
+		}
+		s := parsed[key]
+		if s == nil {
+			s = &samples{}
+			parsed[key] = s
+		}
+
+		for i := 2; i+1 < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q in %q", fields[i], line)
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				s.NsPerOp = append(s.NsPerOp, value)
+			case "B/op":
+				s.BytesPerOp = append(s.BytesPerOp, value)
+			case "allocs/op":
+				s.AllocsPerOp = append(s.AllocsPerOp, value)
+			}
+		}
+	}
+	return parsed, scanner.Err()
+}
+
+// names devuelve los benchmarks ordenados
+func (r results) names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// percentile usa el método nearest-rank, como cmd/bench; devuelve NaN sin muestras
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// change es la variación relativa en porcentaje de old a new
+func change(old, new float64) float64 {
+	if math.IsNaN(old) || math.IsNaN(new) {
+		return math.NaN()
+	}
+	if old == 0 {
+		if new == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (new - old) / old * 100
+}
@@ -0,0 +1,177 @@
+// Command replay reenvía las llamadas grabadas por el interceptor de grabación
+// (GRPC_RECORDING_DIR) a otro build del servidor y compara las respuestas con las
+// grabadas. Sirve para validar cambios de comportamiento, como la migración a la
+// v2 del proto, con tráfico real antes de desplegarlos.
+//
+// Los campos redactados se envían con el valor REDACTED, así que las llamadas que
+// dependen de credenciales o correos deben excluirse con -methods o se reportarán
+// como diferencias de código en ambos builds por igual.
+//
+// Ejemplo:
+//
+//	go run ./cmd/replay -target localhost:50061 -token $TOKEN -ignore id,created_at,updated_at ./recordings
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/recording"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	// Registran los tipos de la v1 y la v2 para resolver los mensajes grabados por nombre
+	_ https://github.com/federiconbaez/gogrpc-go-android/proto"
+	_ https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+)
+
+type config struct {
+	target  string
+	token   string
+	apiKey  string
+	timeout time.Duration
+	methods map[string]bool
+	ignore  map[string]bool
+	redact  map[string]bool
+	limit   int
+	verbose bool
+	paths   []string
+}
+
+func main() {
+	cfg, err := parseFlags()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := recordingFiles(cfg.paths)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(os.Stderr, "no recordings found")
+		os.Exit(2)
+	}
+
+	conn, err := grpc.Dial(cfg.target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to %s: %v\n", cfg.target, err)
+		os.Exit(2)
+	}
+	defer conn.Close()
+
+	replayer := newReplayer(conn, cfg)
+	var summary summary
+	for _, file := range files {
+		records, err := recording.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		for _, record := range records {
+			if cfg.limit > 0 && summary.total >= cfg.limit {
+				break
+			}
+			if len(cfg.methods) > 0 && !cfg.methods[record.Method] {
+				continue
+			}
+
+			ctx, cancel := context.WithTimeout(authContext(context.Background(), cfg), cfg.timeout)
+			result := replayer.replay(ctx, record)
+			cancel()
+			summary.add(result)
+			printResult(result, cfg.verbose)
+		}
+	}
+
+	summary.print()
+	if summary.failed() > 0 {
+		os.Exit(1)
+	}
+}
+
+func parseFlags() (config, error) {
+	var cfg config
+	var methods, ignore, redact string
+
+	flag.StringVar(&cfg.target, "target", "localhost:50051", "address of the server build the recordings are replayed against")
+	flag.StringVar(&cfg.token, "token", os.Getenv("REPLAY_TOKEN"), "bearer token sent as authorization with every call")
+	flag.StringVar(&cfg.apiKey, "api-key", os.Getenv("REPLAY_API_KEY"), "API key sent as x-api-key with every call")
+	flag.DurationVar(&cfg.timeout, "timeout", 10*time.Second, "timeout of each replayed call")
+	flag.StringVar(&methods, "methods", "", "comma separated full method names to replay; empty replays every recorded method")
+	flag.StringVar(&ignore, "ignore", "", "comma separated response field names left out of the comparison, such as generated IDs and timestamps")
+	flag.StringVar(&redact, "redact", strings.Join(recording.DefaultRedactFields, ","), "fields redacted in the replayed responses, must match the recorder")
+	flag.IntVar(&cfg.limit, "limit", 0, "maximum number of calls to replay; 0 replays them all")
+	flag.BoolVar(&cfg.verbose, "v", false, "print matching calls too")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: replay [flags] recording-dir-or-file...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		return cfg, fmt.Errorf("expected at least one recording file or directory")
+	}
+	if cfg.timeout <= 0 {
+		return cfg, fmt.Errorf("-timeout must be positive")
+	}
+	cfg.methods = listSet(methods)
+	cfg.ignore = listSet(ignore)
+	cfg.redact = listSet(redact)
+	cfg.paths = flag.Args()
+	return cfg, nil
+}
+
+// recordingFiles expande los directorios a sus archivos de grabación, en orden de escritura
+func recordingFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, recording.FilePattern))
+		if err != nil {
+			return nil, err
+		}
+		// El nombre lleva la fecha de creación, así que el orden alfabético es el cronológico
+		sort.Strings(matches)
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+// authContext adjunta las credenciales configuradas como metadata saliente
+func authContext(ctx context.Context, cfg config) context.Context {
+	if cfg.apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", cfg.apiKey)
+	}
+	if cfg.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+cfg.token)
+	}
+	return ctx
+}
+
+func listSet(value string) map[string]bool {
+	set := make(map[string]bool)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/recording"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// result es el desenlace de reenviar una llamada grabada
+type result struct {
+	Record recording.Record
+	Code   string
+	// Differences son las rutas de los campos cuya respuesta cambió, vacía si coinciden
+	Differences []string
+	// Err indica que la llamada no pudo reenviarse, no que el servidor devolviera un error
+	Err error
+}
+
+type replayer struct {
+	conn   *grpc.ClientConn
+	ignore map[string]bool
+	redact map[string]bool
+}
+
+func newReplayer(conn *grpc.ClientConn, cfg config) *replayer {
+	return &replayer{conn: conn, ignore: cfg.ignore, redact: cfg.redact}
+}
+
+func (r *replayer) replay(ctx context.Context, record recording.Record) result {
+	res := result{Record: record}
+
+	method, err := findMethod(record.Method)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	if string(method.Input().FullName()) != record.RequestType {
+		res.Err = fmt.Errorf("recorded request is %s but %s now takes %s", record.RequestType, record.Method, method.Input().FullName())
+		return res
+	}
+
+	req, err := newMessage(method.Input())
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	// Los campos desconocidos se descartan para poder reenviar grabaciones de un proto más nuevo
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(record.Request, req); err != nil {
+		res.Err = fmt.Errorf("failed to decode recorded request: %w", err)
+		return res
+	}
+	resp, err := newMessage(method.Output())
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	callErr := r.conn.Invoke(ctx, record.Method, req, resp)
+	st := status.Convert(callErr)
+	res.Code = st.Code().String()
+	if res.Code != record.Code {
+		res.Differences = []string{fmt.Sprintf("code: %s != %s (%s)", record.Code, res.Code, st.Message())}
+		return res
+	}
+	if callErr != nil || record.Response == nil {
+		return res
+	}
+
+	// La respuesta se sanea igual que en el servidor para que los campos redactados coincidan
+	recording.Sanitize(resp.ProtoReflect(), r.redact)
+	replayed, err := recording.MarshalOptions.Marshal(resp)
+	if err != nil {
+		res.Err = fmt.Errorf("failed to encode replayed response: %w", err)
+		return res
+	}
+	res.Differences, res.Err = recording.Diff(record.Response, replayed, r.ignore)
+	return res
+}
+
+// findMethod resuelve un nombre completo como /notebook.NotebookService/GetIdea en el registro de protos
+func findMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	slash := strings.LastIndex(name, "/")
+	if slash < 0 {
+		return nil, fmt.Errorf("invalid method name %q", fullMethod)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name[:slash]))
+	if err != nil {
+		return nil, fmt.Errorf("unknown service of %s: %w", fullMethod, err)
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", name[:slash])
+	}
+	method := service.Methods().ByName(protoreflect.Name(name[slash+1:]))
+	if method == nil {
+		return nil, fmt.Errorf("unknown method %s", fullMethod)
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil, fmt.Errorf("%s is a streaming method and cannot be replayed", fullMethod)
+	}
+	return method, nil
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unknown message %s: %w", desc.FullName(), err)
+	}
+	return messageType.New().Interface(), nil
+}
+
+// summary cuenta los resultados de toda la ejecución
+type summary struct {
+	total    int
+	matched  int
+	differed int
+	errors   int
+}
+
+func (s *summary) add(res result) {
+	s.total++
+	switch {
+	case res.Err != nil:
+		s.errors++
+	case len(res.Differences) > 0:
+		s.differed++
+	default:
+		s.matched++
+	}
+}
+
+func (s *summary) failed() int {
+	return s.differed + s.errors
+}
+
+func (s *summary) print() {
+	fmt.Printf("\n%d call(s) replayed: %d matched, %d differed, %d could not be replayed\n", s.total, s.matched, s.differed, s.errors)
+}
+
+func printResult(res result, verbose bool) {
+	id := res.Record.Method
+	if res.Record.RequestID != "" {
+		id += " (" + res.Record.RequestID + ")"
+	}
+
+	switch {
+	case res.Err != nil:
+		fmt.Fprintf(os.Stderr, "ERROR %s: %v\n", id, res.Err)
+	case len(res.Differences) > 0:
+		fmt.Printf("DIFF  %s\n", id)
+		for _, difference := range res.Differences {
+			fmt.Printf("      %s\n", difference)
+		}
+	case verbose:
+		fmt.Printf("OK    %s %s\n", id, res.Code)
+	}
+}
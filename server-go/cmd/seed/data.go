@@ -0,0 +1,80 @@
+package main
+
+var ideaVerbs = []string{
+	"Build", "Explore", "Prototype", "Research", "Automate", "Redesign", "Launch", "Document", "Measure", "Simplify",
+}
+
+var ideaTopics = []string{
+	"offline sync for the mobile app",
+	"a weekly newsletter",
+	"home energy dashboard",
+	"reading list tracker",
+	"team onboarding guide",
+	"expense categorization",
+	"garden watering schedule",
+	"podcast episode outline",
+	"customer feedback loop",
+	"meal planning assistant",
+	"open source contribution plan",
+	"marathon training plan",
+	"side project landing page",
+	"photo archive cleanup",
+	"language learning routine",
+}
+
+var sentencePool = []string{
+	"Start with the smallest version that is still useful.",
+	"Talk to three people who would actually use this.",
+	"The main risk is underestimating the data migration.",
+	"Reuse the existing components where possible.",
+	"Write down the assumptions before building anything.",
+	"A simple spreadsheet might be enough for the first month.",
+	"Check whether there is an existing tool that solves most of it.",
+	"Budget a weekend for the first prototype.",
+	"Measure the baseline before changing anything.",
+	"Keep a log of what worked and what did not.",
+	"Ask for feedback after the first week.",
+	"This depends on finishing the previous milestone.",
+	"Automate the repetitive parts once the process is stable.",
+	"Share a draft early to avoid wasted effort.",
+}
+
+var tagPool = []string{
+	"work", "personal", "urgent", "someday", "research", "health", "finance", "learning",
+	"writing", "mobile", "backend", "design", "family", "travel", "reading",
+}
+
+var reminderTitles = []string{
+	"Review pull requests",
+	"Call the dentist",
+	"Submit expense report",
+	"Team standup",
+	"Water the plants",
+	"Renew passport",
+	"Quarterly planning meeting",
+	"Pay electricity bill",
+	"Backup laptop",
+	"Book flights",
+	"Weekly review",
+	"Project deadline",
+}
+
+var notificationChannels = []string{"push", "email", "sms"}
+
+var fileNames = []string{
+	"meeting-notes", "draft", "requirements", "budget", "reading-notes", "journal", "checklist", "summary",
+}
+
+var projectNames = []string{
+	"Mobile app v2",
+	"Home renovation",
+	"Thesis",
+	"Fitness plan",
+	"Blog relaunch",
+	"Data platform migration",
+	"Book manuscript",
+}
+
+var milestoneNames = []string{
+	"scope defined", "first prototype", "user testing", "beta release", "documentation", "final review", "launch",
+}
@@ -0,0 +1,299 @@
+// Command seed puebla la base de datos con usuarios, ideas, recordatorios,
+// archivos y registros de progreso ficticios para demos y pruebas de rendimiento.
+//
+// La generación es determinista: la misma semilla produce los mismos datos.
+//
+// Ejemplo:
+//
+//	go run ./cmd/seed -users 50 -ideas 200 -reminders 40 -files 10 -progress 5 -seed 42
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/postgres"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/services"
+	"github.com/google/uuid"
+)
+
+type config struct {
+	users            int
+	ideasPerUser     int
+	remindersPerUser int
+	filesPerUser     int
+	progressPerUser  int
+	milestones       int
+	history          time.Duration
+	seed             int64
+	uploadDir        string
+}
+
+// seeder genera entidades con un reloj y un generador de IDs deterministas
+type seeder struct {
+	config    config
+	rng       *rand.Rand
+	clock     *entities.FakeClock
+	ids       entities.IDGenerator
+	now       time.Time
+	ideas     ports.IdeaRepository
+	reminders ports.ReminderRepository
+	files     ports.FileRepository
+	progress  ports.ProgressRepository
+	storage   ports.FileStorageService
+	counts    map[string]int
+}
+
+func main() {
+	var cfg config
+	flag.IntVar(&cfg.users, "users", 10, "number of fake users")
+	flag.IntVar(&cfg.ideasPerUser, "ideas", 50, "ideas per user")
+	flag.IntVar(&cfg.remindersPerUser, "reminders", 20, "reminders per user")
+	flag.IntVar(&cfg.filesPerUser, "files", 5, "files per user")
+	flag.IntVar(&cfg.progressPerUser, "progress", 3, "progress records per user")
+	flag.IntVar(&cfg.milestones, "milestones", 5, "milestones per progress record")
+	flag.DurationVar(&cfg.history, "history", 90*24*time.Hour, "how far back creation timestamps are spread")
+	flag.Int64Var(&cfg.seed, "seed", 42, "random seed; the same seed produces the same data")
+	flag.StringVar(&cfg.uploadDir, "upload-dir", "./uploads", "directory where seeded file contents are stored")
+	flag.Parse()
+
+	db, err := postgres.NewConnection(postgres.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnv("DB_PORT", "5432"),
+		User:     getEnv("DB_USER", "postgres"),
+		Password: getEnv("DB_PASSWORD", "postgres"),
+		DBName:   getEnv("DB_NAME", "notebook"),
+		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+	})
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	rng := rand.New(rand.NewSource(cfg.seed))
+	now := time.Now().Truncate(time.Hour)
+	s := &seeder{
+		config:    cfg,
+		rng:       rng,
+		clock:     entities.NewFakeClock(now),
+		ids:       &randomIDGenerator{rng: rng},
+		now:       now,
+		ideas:     postgres.NewIdeaRepository(db),
+		reminders: postgres.NewReminderRepository(db),
+		files:     postgres.NewFileRepository(db),
+		progress:  postgres.NewProgressRepository(db),
+		storage:   services.NewLocalFileStorageService(cfg.uploadDir),
+		counts:    make(map[string]int),
+	}
+
+	ctx := context.Background()
+	started := time.Now()
+	for i := 0; i < cfg.users; i++ {
+		userID := s.ids.NewID()
+		if err := s.seedUser(ctx, userID); err != nil {
+			log.Fatalf("failed to seed user %s: %v", userID, err)
+		}
+		log.Printf("seeded user %d/%d (%s)", i+1, cfg.users, userID)
+	}
+
+	log.Printf("done in %s: %d users, %d ideas, %d reminders, %d files, %d progress records",
+		time.Since(started).Round(time.Millisecond), cfg.users,
+		s.counts["ideas"], s.counts["reminders"], s.counts["files"], s.counts["progress"])
+}
+
+func (s *seeder) seedUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.seedIdeas(ctx, userID); err != nil {
+		return fmt.Errorf("ideas: %w", err)
+	}
+	if err := s.seedReminders(ctx, userID); err != nil {
+		return fmt.Errorf("reminders: %w", err)
+	}
+	if err := s.seedFiles(ctx, userID); err != nil {
+		return fmt.Errorf("files: %w", err)
+	}
+	if err := s.seedProgress(ctx, userID); err != nil {
+		return fmt.Errorf("progress: %w", err)
+	}
+	return nil
+}
+
+func (s *seeder) seedIdeas(ctx context.Context, userID uuid.UUID) error {
+	created := make([]uuid.UUID, 0, s.config.ideasPerUser)
+	for i := 0; i < s.config.ideasPerUser; i++ {
+		s.clock.Set(s.pastTime())
+
+		topic := pick(s.rng, ideaTopics)
+		idea := entities.NewIdea(
+			s.clock, s.ids,
+			fmt.Sprintf("%s %s", pick(s.rng, ideaVerbs), topic),
+			s.paragraph(2+s.rng.Intn(4)),
+			entities.IdeaCategory(1+s.rng.Intn(5)),
+			userID,
+			s.tags(),
+			int32(s.rng.Intn(11)),
+		)
+		idea.Status = entities.IdeaStatus(1 + s.rng.Intn(5))
+
+		// Relacionar con algunas ideas anteriores del mismo usuario
+		for r := s.rng.Intn(4); r > 0 && len(created) > 0; r-- {
+			idea.AddRelatedIdea(created[s.rng.Intn(len(created))], idea.CreatedAt)
+		}
+
+		if err := s.ideas.Create(ctx, idea); err != nil {
+			return err
+		}
+		created = append(created, idea.ID)
+		s.counts["ideas"]++
+	}
+	return nil
+}
+
+func (s *seeder) seedReminders(ctx context.Context, userID uuid.UUID) error {
+	for i := 0; i < s.config.remindersPerUser; i++ {
+		s.clock.Set(s.pastTime())
+
+		// Mezcla de recordatorios pasados y futuros para poblar vencidos y pendientes
+		scheduled := s.now.Add(time.Duration(s.rng.Int63n(int64(60*24*time.Hour))) - 30*24*time.Hour)
+		recurring := s.rng.Float64() < 0.3
+		pattern := entities.RecurrencePatternUnspecified
+		if recurring {
+			pattern = entities.RecurrencePattern(1 + s.rng.Intn(4))
+		}
+
+		reminder := entities.NewReminder(
+			s.clock, s.ids,
+			pick(s.rng, reminderTitles),
+			s.paragraph(1),
+			scheduled,
+			entities.ReminderType(1+s.rng.Intn(5)),
+			userID,
+			recurring,
+			pattern,
+			pickN(s.rng, notificationChannels, 1+s.rng.Intn(2)),
+		)
+		switch {
+		case scheduled.Before(s.now) && s.rng.Float64() < 0.6:
+			reminder.Complete(scheduled)
+		case scheduled.Before(s.now):
+			reminder.MarkAsOverdue(scheduled)
+		}
+
+		if err := s.reminders.Create(ctx, reminder); err != nil {
+			return err
+		}
+		s.counts["reminders"]++
+	}
+	return nil
+}
+
+func (s *seeder) seedFiles(ctx context.Context, userID uuid.UUID) error {
+	for i := 0; i < s.config.filesPerUser; i++ {
+		s.clock.Set(s.pastTime())
+
+		filename := fmt.Sprintf("%s-%d.txt", pick(s.rng, fileNames), i+1)
+		content := bytes.NewBufferString(s.paragraph(5 + s.rng.Intn(20)))
+		path, checksum, size, err := s.storage.StoreFile(ctx, filename, content, false, "")
+		if err != nil {
+			return err
+		}
+
+		fileInfo := entities.NewFileInfo(s.clock, s.ids, filename, "text/plain", checksum, path, size, userID, false, "")
+		if err := s.files.Create(ctx, fileInfo); err != nil {
+			return err
+		}
+		s.counts["files"]++
+	}
+	return nil
+}
+
+func (s *seeder) seedProgress(ctx context.Context, userID uuid.UUID) error {
+	for i := 0; i < s.config.progressPerUser; i++ {
+		createdAt := s.pastTime()
+		s.clock.Set(createdAt)
+
+		progress := entities.NewProgress(s.clock, s.ids, userID, pick(s.rng, projectNames), s.paragraph(1))
+		for m := 0; m < s.config.milestones; m++ {
+			due := createdAt.Add(time.Duration(m+1) * 7 * 24 * time.Hour)
+			milestone := entities.NewMilestone(s.ids, fmt.Sprintf("Milestone %d: %s", m+1, pick(s.rng, milestoneNames)), s.paragraph(1), due)
+			progress.AddMilestone(milestone, createdAt)
+			if due.Before(s.now) && s.rng.Float64() < 0.7 {
+				progress.CompleteMilestone(milestone.ID, due)
+			}
+		}
+
+		if err := s.progress.Create(ctx, progress); err != nil {
+			return err
+		}
+		s.counts["progress"]++
+	}
+	return nil
+}
+
+// pastTime devuelve un instante aleatorio dentro de la ventana de historia
+func (s *seeder) pastTime() time.Time {
+	if s.config.history <= 0 {
+		return s.now
+	}
+	return s.now.Add(-time.Duration(s.rng.Int63n(int64(s.config.history))))
+}
+
+func (s *seeder) tags() []string {
+	return pickN(s.rng, tagPool, s.rng.Intn(5))
+}
+
+func (s *seeder) paragraph(sentences int) string {
+	var buf bytes.Buffer
+	for i := 0; i < sentences; i++ {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(pick(s.rng, sentencePool))
+	}
+	return buf.String()
+}
+
+// randomIDGenerator genera UUID v4 a partir de la fuente aleatoria con semilla
+type randomIDGenerator struct {
+	rng *rand.Rand
+}
+
+func (g *randomIDGenerator) NewID() uuid.UUID {
+	id, err := uuid.NewRandomFromReader(g.rng)
+	if err != nil {
+		// rand.Rand nunca devuelve error al leer
+		panic(err)
+	}
+	return id
+}
+
+func pick(rng *rand.Rand, values []string) string {
+	return values[rng.Intn(len(values))]
+}
+
+// pickN elige n valores distintos
+func pickN(rng *rand.Rand, values []string, n int) []string {
+	if n > len(values) {
+		n = len(values)
+	}
+	result := make([]string, 0, n)
+	for _, idx := range rng.Perm(len(values))[:n] {
+		result = append(result, values[idx])
+	}
+	return result
+}
+
+// getEnv obtiene una variable de entorno con un valor por defecto
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -1,23 +1,75 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"log"
 	"net"
+	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
 	grpcAdapter https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/postgres"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/sqlite"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/web"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/appversion"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/buildinfo"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/cache"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/cdn"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/circuitbreaker"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/classification"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/compression"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/embeddings"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/extraction"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/jobs"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/limits"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/lock"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/maintenance"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/moderation"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/notifications"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/preview"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/queue"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/recording"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/reload"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/replication"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/reports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/requestid"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/services"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/shadow"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/startup"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/storage"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
 	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
+	standalone := flag.Bool("standalone", false, "run with SQLite, local file storage and in-memory infrastructure, without external dependencies")
+	flag.Parse()
+
 	// Configurar logger
 	logger, err := zap.NewProduction()
 	if err != nil {
@@ -25,39 +77,967 @@ func main() {
 	}
 	defer logger.Sync()
 
-	// Configuración de la base de datos
-	dbConfig := postgres.Config{
-		Host:     getEnv("DB_HOST", "localhost"),
-		Port:     getEnv("DB_PORT", "5432"),
-		User:     getEnv("DB_USER", "postgres"),
-		Password: getEnv("DB_PASSWORD", "postgres"),
-		DBName:   getEnv("DB_NAME", "notebook"),
-		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+	// Con ADMIN_HTTP_PORT se sirve en localhost un panel de operación; los últimos logs que muestra
+	// se guardan en memoria desde el arranque
+	adminHTTPPort := getEnv("ADMIN_HTTP_PORT", "")
+	var recentLogs *logging.RecentLogs
+	if adminHTTPPort != "" {
+		recentLogs = logging.NewRecentLogs(getEnvInt(logger, "ADMIN_DASHBOARD_LOG_LINES", 200), logging.INFO)
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, recentLogs.Core())
+		}))
 	}
 
-	// Inicializar repositorios
-	db, err := postgres.NewConnection(dbConfig)
+	// Métricas y logger estructurado de infraestructura
+	metricsCollector := metrics.NewMetricsCollector(metrics.WithExternalFlush())
+	defer metricsCollector.Stop()
+
+	logLevel, err := logging.ParseLogLevel(getEnv("LOG_LEVEL", "info"))
 	if err != nil {
-		logger.Fatal("Failed to connect to database", zap.Error(err))
+		logger.Fatal("Invalid LOG_LEVEL", zap.Error(err))
+	}
+	structuredLogger := logging.NewStructuredLogger(logging.LoggerConfig{
+		Level:       logLevel,
+		Format:      "json",
+		ServiceName: "notebook-server",
+	})
+	if recentLogs != nil {
+		structuredLogger.AddHook(recentLogs)
+	}
+
+	// Circuit breakers por dependencia externa, expuestos como métricas
+	breakers := circuitbreaker.NewRegistry()
+	metricsCollector.RegisterCollector(breakers.Metrics)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Las goroutines de fondo se cuentan por tarea; una tarea cuyo número no deja de crecer se
+	// avisa como posible fuga
+	metricsCollector.RegisterCollector(supervisor.Default.Metrics)
+	if interval := getEnvDuration(logger, "GOROUTINE_LEAK_CHECK_INTERVAL", time.Minute); interval > 0 {
+		supervisor.Go("supervisor.watch", func() {
+			supervisor.Default.Watch(ctx, supervisor.WatchConfig{
+				Interval: interval,
+				Samples:  getEnvInt(logger, "GOROUTINE_LEAK_SAMPLES", supervisor.DefaultLeakSamples),
+				OnLeak: func(leak supervisor.Leak) {
+					logger.Warn("Possible goroutine leak",
+						zap.String("task", leak.Name),
+						zap.Int("running", leak.Running),
+						zap.Int("growing_samples", leak.Samples))
+				},
+			})
+		})
+	}
+
+	var (
+		ideaRepo             ports.IdeaRepository
+		reminderRepo         ports.ReminderRepository
+		fileRepo             ports.FileRepository
+		progressRepo         ports.ProgressRepository
+		unitOfWork           ports.UnitOfWork
+		changeFeed           ports.ChangeFeed
+		locker               ports.DistributedLocker
+		inbox                ports.NotificationInbox
+		shareLinkRepo        ports.ShareLinkRepository
+		inboundAddressRepo   ports.InboundAddressRepository
+		chatBindingRepo      ports.ChatBindingRepository
+		localePreferenceRepo ports.LocalePreferenceRepository
+		fileTextRepo         ports.FileTextRepository
+		ideaEmbeddingRepo    ports.IdeaEmbeddingRepository
+		ideaReviewRepo       ports.IdeaReviewRepository
+		publicationRepo      ports.IdeaPublicationRepository
+		phoneNumberRepo      ports.PhoneNumberRepository
+		customFieldRepo      ports.CustomFieldRepository
+		statisticsRepo       ports.StatisticsRepository
+		ideaArchiveRepo      ports.IdeaArchiveRepository
+		clientMetricRepo     ports.ClientMetricRepository
+		moderationRepo       ports.ModerationRejectionRepository
+		emailPreferenceRepo  ports.EmailPreferenceRepository
+		weeklySummaryRepo    ports.WeeklySummaryRepository
+		timeEntryRepo        ports.TimeEntryRepository
+		progressTemplateRepo ports.ProgressTemplateRepository
+		serverOptions        []grpcAdapter.ServerOption
+	)
+
+	// Las dependencias externas se esperan en orden, con reintentos y un tiempo máximo, en lugar de
+	// fallar si todavía no están listas (por ejemplo, Postgres arrancando en docker-compose). Si el
+	// almacenamiento no responde a tiempo el servidor arranca degradado: las operaciones con archivos
+	// fallan por su circuit breaker hasta que vuelve y el resto funciona con normalidad
+	dependencies := startup.NewOrchestrator(startup.Config{
+		InitialBackoff: getEnvDuration(logger, "STARTUP_INITIAL_BACKOFF", 500*time.Millisecond),
+		MaxBackoff:     getEnvDuration(logger, "STARTUP_MAX_BACKOFF", 10*time.Second),
+		MaxWait:        getEnvDuration(logger, "STARTUP_MAX_WAIT", 2*time.Minute),
+		OnAttempt: func(name string, attempt int, err error, retryIn time.Duration) {
+			logger.Warn("Dependency not ready",
+				zap.String("dependency", name),
+				zap.Int("attempt", attempt),
+				zap.Duration("retry_in", retryIn),
+				zap.Error(err))
+		},
+	})
+	metricsCollector.RegisterCollector(dependencies.Metrics)
+
+	uploadsDir := getEnv("UPLOADS_DIR", "./uploads")
+	var (
+		db          *pgxpool.Pool
+		queryTracer *postgres.QueryTracer
+		startupDeps []startup.Dependency
+		// Con REPLICATION_ROLE=follower la instancia es una réplica de solo lectura que sigue al primario
+		replicationRole = replication.Role(getEnv("REPLICATION_ROLE", string(replication.RolePrimary)))
+		follower        *replication.Follower
+	)
+	if !*standalone {
+		slowQueryThreshold, err := time.ParseDuration(getEnv("DB_SLOW_QUERY_THRESHOLD", "200ms"))
+		if err != nil {
+			logger.Fatal("Invalid DB_SLOW_QUERY_THRESHOLD", zap.Error(err))
+		}
+		queryTracer = postgres.NewQueryTracer(slowQueryThreshold, metricsCollector, structuredLogger)
+
+		// Configuración de la base de datos
+		dbConfig := postgres.Config{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnv("DB_PORT", "5432"),
+			User:     getEnv("DB_USER", "postgres"),
+			Password: getEnv("DB_PASSWORD", "postgres"),
+			DBName:   getEnv("DB_NAME", "notebook"),
+			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+			Tracer:   queryTracer,
+		}
+		startupDeps = append(startupDeps, startup.Dependency{
+			Name: "postgres",
+			Probe: func(ctx context.Context) error {
+				conn, err := postgres.NewConnection(dbConfig)
+				if err != nil {
+					return err
+				}
+				db = conn
+				return nil
+			},
+		})
+	}
+	startupDeps = append(startupDeps, startup.Dependency{
+		Name:      "storage",
+		Optional:  true,
+		Probe:     startup.DirProbe(uploadsDir),
+		OnRecover: func() { logger.Info("Storage recovered", zap.String("dir", uploadsDir)) },
+	})
+	if coldDir := getEnv("STORAGE_COLD_DIR", ""); coldDir != "" {
+		startupDeps = append(startupDeps, startup.Dependency{
+			Name:      "cold_storage",
+			Optional:  true,
+			Probe:     startup.DirProbe(coldDir),
+			OnRecover: func() { logger.Info("Cold storage recovered", zap.String("dir", coldDir)) },
+		})
 	}
-	defer db.Close()
+	if redisAddr := getEnv("REDIS_ADDR", ""); redisAddr != "" {
+		startupDeps = append(startupDeps, startup.Dependency{
+			Name:     "redis",
+			Optional: true,
+			Probe:    startup.TCPProbe(redisAddr),
+		})
+	}
+	for _, dependency := range startupDeps {
+		if err := dependencies.Add(dependency); err != nil {
+			logger.Fatal("Failed to register startup dependency", zap.String("dependency", dependency.Name), zap.Error(err))
+		}
+	}
+	if err := dependencies.Run(ctx); err != nil {
+		logger.Fatal("Dependencies not ready", zap.Error(err))
+	}
+	if degraded := dependencies.Degraded(); len(degraded) > 0 {
+		logger.Warn("Starting in degraded mode", zap.Strings("dependencies", degraded))
+	}
+
+	if *standalone {
+		// Modo standalone: SQLite en un archivo local, sin dependencias externas
+		sqlitePath := getEnv("SQLITE_PATH", "./notebook.db")
+		db, err := sqlite.NewConnection(sqlitePath)
+		if err != nil {
+			logger.Fatal("Failed to open SQLite database", zap.Error(err))
+		}
+		defer db.Close()
+
+		ideaRepo = sqlite.NewIdeaRepository(db)
+		reminderRepo = sqlite.NewReminderRepository(db)
+		fileRepo = sqlite.NewFileRepository(db)
+		progressRepo = sqlite.NewProgressRepository(db)
+		unitOfWork = sqlite.NewUnitOfWork(db)
+		inbox = sqlite.NewNotificationInbox(db)
+		shareLinkRepo = sqlite.NewShareLinkRepository(db)
+		inboundAddressRepo = sqlite.NewInboundAddressRepository(db)
+		chatBindingRepo = sqlite.NewChatBindingRepository(db)
+		localePreferenceRepo = sqlite.NewLocalePreferenceRepository(db)
+		fileTextRepo = sqlite.NewFileTextRepository(db)
+		ideaEmbeddingRepo = sqlite.NewIdeaEmbeddingRepository(db)
+		ideaReviewRepo = sqlite.NewIdeaReviewRepository(db)
+		publicationRepo = sqlite.NewIdeaPublicationRepository(db)
+		phoneNumberRepo = sqlite.NewPhoneNumberRepository(db)
+		customFieldRepo = sqlite.NewCustomFieldRepository(db)
+		statisticsRepo = sqlite.NewStatisticsRepository(db)
+		ideaArchiveRepo = sqlite.NewIdeaArchiveRepository(db)
+		clientMetricRepo = sqlite.NewClientMetricRepository(db)
+		moderationRepo = sqlite.NewModerationRejectionRepository(db)
+		emailPreferenceRepo = sqlite.NewEmailPreferenceRepository(db)
+		weeklySummaryRepo = sqlite.NewWeeklySummaryRepository(db)
+		timeEntryRepo = sqlite.NewTimeEntryRepository(db)
+		progressTemplateRepo = sqlite.NewProgressTemplateRepository(db)
+		locker = lock.NewLocalLocker()
+
+		logger.Info("Running in standalone mode", zap.String("database", sqlitePath))
+	} else {
+		// Inicializar repositorios sobre la conexión abierta al esperar las dependencias
+		defer db.Close()
+
+		// Reintentos con backoff y corte rápido cuando la base de datos no responde
+		dbRetrier := postgres.NewRetrier(postgres.RetryConfig{}, breakers)
 
-	ideaRepo := postgres.NewIdeaRepository(db)
-	reminderRepo := postgres.NewReminderRepository(db)
-	fileRepo := postgres.NewFileRepository(db)
-	progressRepo := postgres.NewProgressRepository(db)
+		ideaRepo = postgres.NewRetryingIdeaRepository(postgres.NewIdeaRepository(db), dbRetrier)
+		reminderRepo = postgres.NewReminderRepository(db)
+		fileRepo = postgres.NewRetryingFileRepository(postgres.NewFileRepository(db), dbRetrier)
+		progressRepo = postgres.NewProgressRepository(db)
+		unitOfWork = postgres.NewUnitOfWork(db)
+		inbox = postgres.NewNotificationInbox(db)
+		shareLinkRepo = postgres.NewShareLinkRepository(db)
+		inboundAddressRepo = postgres.NewInboundAddressRepository(db)
+		chatBindingRepo = postgres.NewChatBindingRepository(db)
+		localePreferenceRepo = postgres.NewLocalePreferenceRepository(db)
+		fileTextRepo = postgres.NewFileTextRepository(db)
+		ideaEmbeddingRepo = postgres.NewIdeaEmbeddingRepository(db)
+		ideaReviewRepo = postgres.NewIdeaReviewRepository(db)
+		publicationRepo = postgres.NewIdeaPublicationRepository(db)
+		phoneNumberRepo = postgres.NewPhoneNumberRepository(db)
+		customFieldRepo = postgres.NewCustomFieldRepository(db)
+		statisticsRepo = postgres.NewStatisticsRepository(db)
+		ideaArchiveRepo = postgres.NewIdeaArchiveRepository(db)
+		clientMetricRepo = postgres.NewClientMetricRepository(db)
+		moderationRepo = postgres.NewModerationRejectionRepository(db)
+		emailPreferenceRepo = postgres.NewEmailPreferenceRepository(db)
+		weeklySummaryRepo = postgres.NewWeeklySummaryRepository(db)
+		timeEntryRepo = postgres.NewTimeEntryRepository(db)
+		progressTemplateRepo = postgres.NewProgressTemplateRepository(db)
+		locker = postgres.NewAdvisoryLocker(db)
+
+		if replicationRole == replication.RoleFollower {
+			// La base de datos de una réplica es un standby que no admite LISTEN: los cambios
+			// llegan del primario a través de su API de administración
+			follower = newReplicationFollower(logger)
+			follower.Start(ctx)
+			metricsCollector.RegisterCollector(follower.Metrics)
+			changeFeed = follower
+		} else {
+			// Flujo de cambios LISTEN/NOTIFY para sincronización entre dispositivos
+			pgChangeFeed := postgres.NewChangeFeed(db, structuredLogger)
+			supervisor.Go("postgres.change_feed", func() { pgChangeFeed.Start(ctx) })
+			metricsCollector.RegisterCollector(pgChangeFeed.Metrics)
+			changeFeed = pgChangeFeed
+		}
+
+		serverOptions = append(serverOptions, grpcAdapter.WithQueryDiagnostics(queryTracer))
+	}
 
 	// Inicializar servicios
-	fileStorageService := services.NewLocalFileStorageService("./uploads")
+	var storageBackend ports.FileStorageService = services.NewLocalFileStorageService(uploadsDir)
+	var storageInventory ports.StorageInventory = storage.NewLocalInventory(uploadsDir)
+	// Con STORAGE_COLD_DIR (fuera de UPLOADS_DIR) los archivos sin uso pasan a un almacenamiento más barato
+	var storageTiering ports.StorageTiering
+	if coldDir := getEnv("STORAGE_COLD_DIR", ""); coldDir != "" {
+		tiered := storage.NewTieredFileStorage(storageBackend, services.NewLocalFileStorageService(coldDir))
+		storageBackend, storageTiering = tiered, tiered
+		storageInventory = storage.NewTieredInventory(storageInventory, storage.NewLocalInventory(coldDir))
+	}
+	fileStorageService := circuitbreaker.NewFileStorageService(
+		storageBackend,
+		breakers.Get(circuitbreaker.BreakerConfig{Name: "file_storage"}),
+	)
 	compressionService := services.NewCompressionService()
 	eventBus := services.NewInMemoryEventBus()
-	notificationService := services.NewNotificationService(eventBus)
+	// Las respuestas y notificaciones se redactan en el idioma de cada usuario; DEFAULT_LOCALE es el
+	// de quienes no eligieron ninguno ni lo envían en la petición
+	translator, err := i18n.NewTranslator(getEnv("DEFAULT_LOCALE", i18n.DefaultLocale))
+	if err != nil {
+		logger.Fatal("Failed to load message catalogs", zap.Error(err))
+	}
+	// Los bots de Slack y de Telegram entregan las notificaciones en los chats que cada usuario vinculó
+	var telegramSender *notifications.TelegramSender
+	var slackSender *notifications.SlackSender
+	var chatSenders []notifications.ChatSender
+	if token := getEnv("TELEGRAM_BOT_TOKEN", ""); token != "" {
+		telegramSender = notifications.NewTelegramSender(notifications.TelegramConfig{BotToken: token})
+		chatSenders = append(chatSenders, telegramSender)
+	}
+	if token := getEnv("SLACK_BOT_TOKEN", ""); token != "" {
+		slackSender = notifications.NewSlackSender(notifications.SlackConfig{BotToken: token})
+		chatSenders = append(chatSenders, slackSender)
+	}
+	outbound := notifications.Fanout{circuitbreaker.NewNotificationService(
+		services.NewNotificationService(eventBus),
+		breakers.Get(circuitbreaker.BreakerConfig{Name: "notification_delivery"}),
+	)}
+	if len(chatSenders) > 0 {
+		chatNotifier, err := notifications.NewChatNotifier(chatBindingRepo, nil, translator, chatSenders...)
+		if err != nil {
+			logger.Fatal("Failed to create chat notifier", zap.Error(err))
+		}
+		outbound = append(outbound, circuitbreaker.NewNotificationService(
+			chatNotifier,
+			breakers.Get(circuitbreaker.BreakerConfig{Name: "chat_notification_delivery"}),
+		))
+	}
+	// Con las credenciales de Twilio, las notificaciones que piden el canal "sms" llegan al teléfono
+	// verificado del usuario, hasta SMS_MAX_PER_DAY por día
+	var smsSender ports.SMSSender
+	smsDailyLimit := getEnvInt(logger, "SMS_MAX_PER_DAY", 10)
+	if sid := getEnv("TWILIO_ACCOUNT_SID", ""); sid != "" {
+		smsSender = notifications.NewTwilioSender(notifications.TwilioConfig{
+			AccountSID: sid,
+			AuthToken:  getEnv("TWILIO_AUTH_TOKEN", ""),
+			From:       getEnv("TWILIO_FROM_NUMBER", ""),
+		})
+		smsNotifier := notifications.NewSMSNotifier(phoneNumberRepo, smsSender, notifications.SMSConfig{
+			DailyLimit:      smsDailyLimit,
+			DeepLinkBaseURL: getEnv("APP_DEEP_LINK_BASE_URL", "notebook://"),
+		}, translator, entities.SystemClock{})
+		outbound = append(outbound, circuitbreaker.NewNotificationService(
+			smsNotifier,
+			breakers.Get(circuitbreaker.BreakerConfig{Name: "sms_notification_delivery"}),
+		))
+	}
+	// Con un relay SMTP, las notificaciones que piden el canal "email" y el resumen semanal llegan a
+	// la dirección que registró el usuario
+	var emailSender ports.EmailSender
+	if smtpAddr := getEnv("SMTP_ADDR", ""); smtpAddr != "" {
+		emailSender = notifications.NewSMTPSender(notifications.SMTPConfig{
+			Addr:     smtpAddr,
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("EMAIL_FROM", "Notebook <no-reply@localhost>"),
+			Timeout:  getEnvDuration(logger, "SMTP_TIMEOUT", 30*time.Second),
+		})
+		outbound = append(outbound, circuitbreaker.NewNotificationService(
+			notifications.NewEmailNotifier(emailPreferenceRepo, emailSender),
+			breakers.Get(circuitbreaker.BreakerConfig{Name: "email_notification_delivery"}),
+		))
+	}
+	// Con MQTT_BROKER_URL las notificaciones se replican en el broker, bajo un tópico por usuario, para
+	// que la app Android las reciba en segundo plano sin mantener abierto un stream gRPC
+	if brokerURL := getEnv("MQTT_BROKER_URL", ""); brokerURL != "" {
+		mqttClient, err := notifications.NewMQTTClient(notifications.MQTTConfig{
+			BrokerURL: brokerURL,
+			ClientID:  getEnv("MQTT_CLIENT_ID", "notebook-server"),
+			Username:  getEnv("MQTT_USERNAME", ""),
+			Password:  getEnv("MQTT_PASSWORD", ""),
+			QoS:       byte(getEnvInt(logger, "MQTT_QOS", 1)),
+		})
+		if err != nil {
+			logger.Fatal("Failed to create MQTT client", zap.Error(err))
+		}
+		defer mqttClient.Close()
+		mqttBridge := notifications.NewMQTTBridge(mqttClient, notifications.MQTTBridgeConfig{
+			TopicPrefix: getEnv("MQTT_TOPIC_PREFIX", "notebook"),
+			// Por defecto solo los avisos de recordatorios, que son los que no pueden esperar a abrir la app
+			Types: getEnvList("MQTT_NOTIFICATION_TYPES", []string{"reminder_overdue", "reminder_escalated"}),
+		}, entities.SystemClock{})
+		outbound = append(outbound, circuitbreaker.NewNotificationService(
+			mqttBridge,
+			breakers.Get(circuitbreaker.BreakerConfig{Name: "mqtt_notification_delivery"}),
+		))
+	}
+
+	// El hub reparte las notificaciones a los streams abiertos; la entrega externa pasa por el circuit breaker
+	notificationService := notifications.NewHub(notifications.HubConfig{
+		BufferSize: getEnvInt(logger, "NOTIFICATION_BUFFER_SIZE", 64),
+		Inbox:      inbox,
+		Outbound:   outbound,
+		OnEvict: func(userID uuid.UUID) {
+			logger.Warn("Evicted slow notification subscriber", zap.String("user_id", userID.String()))
+		},
+	})
+	metricsCollector.RegisterCollector(notificationService.Metrics)
+	localizedNotifications := i18n.NewNotifications(notificationService, translator, localePreferenceRepo)
+	// Los clientes que reconectan reciben desde el buzón lo que se perdieron mientras estaban desconectados
+	notificationRetention := getEnvDuration(logger, "NOTIFICATION_RETENTION", 7*24*time.Hour)
+	serverOptions = append(serverOptions,
+		grpcAdapter.WithNotificationInbox(inbox),
+		grpcAdapter.WithNotificationHeartbeat(getEnvDuration(logger, "NOTIFICATION_HEARTBEAT_INTERVAL", 30*time.Second)),
+	)
+
+	// Reloj y generador de IDs compartidos por los casos de uso
+	clock := entities.SystemClock{}
+	idGenerator := entities.UUIDGenerator{}
+
+	// Cola de mensajes para trabajo asíncrono
+	messageQueue := queue.NewMessageQueue(queue.QueueConfig{ExternalDLQCleanup: true})
+	defer messageQueue.Stop()
+
+	// El texto de imágenes y documentos se extrae en segundo plano para la búsqueda de archivos
+	textExtractionUseCases := usecases.NewTextExtractionUseCases(fileRepo, fileTextRepo, fileStorageService, newTextExtractors(logger), eventBus, clock)
+	textExtractionQueue := queue.NewTextExtractionQueue(messageQueue, textExtractionUseCases.ExtractText)
+
+	// Ciclo de vida del almacenamiento: los archivos sin versiones nuevas en STORAGE_COLD_AFTER pasan
+	// al nivel frío y vuelven al principal cuando se descargan
+	var storageLifecycle *usecases.StorageLifecycleUseCases
+	if storageTiering != nil {
+		storageLifecycle = usecases.NewStorageLifecycleUseCases(fileRepo, fileStorageService, storageTiering, eventBus, clock,
+			getEnvDuration(logger, "STORAGE_COLD_AFTER", 30*24*time.Hour),
+		)
+	}
+
+	// Con EMBEDDINGS_PROVIDER los vectores de las ideas se calculan en segundo plano para la búsqueda semántica
+	var semanticSearch *usecases.SemanticSearchUseCases
+	ideaOptions := []usecases.IdeaOption{usecases.WithIdeaCustomFields(customFieldRepo)}
+	if embedder := newEmbeddingService(logger); embedder != nil {
+		embedder = circuitbreaker.NewEmbeddingService(embedder, breakers.Get(circuitbreaker.BreakerConfig{Name: "embeddings"}))
+		semanticSearch = usecases.NewSemanticSearchUseCases(ideaRepo, ideaEmbeddingRepo, embedder,
+			getEnvFloat(logger, "EMBEDDINGS_MIN_SIMILARITY", 0.25),
+			eventBus, clock, idGenerator,
+		)
+		ideaOptions = append(ideaOptions, usecases.WithIdeaEmbeddings(queue.NewIdeaEmbeddingQueue(messageQueue, semanticSearch.EmbedIdea)))
+		serverOptions = append(serverOptions, grpcAdapter.WithSemanticSearch(semanticSearch))
+	}
+
+	// Sugerencias de etiquetas y categoría: por palabras clave o con un modelo externo según CLASSIFIER_PROVIDER
+	var classificationUseCases *usecases.ClassificationUseCases
+	if classifier := newIdeaClassifier(logger, breakers); classifier != nil {
+		thresholds := usecases.DefaultClassificationThresholds
+		thresholds.Suggest = getEnvFloat(logger, "CLASSIFIER_SUGGEST_CONFIDENCE", thresholds.Suggest)
+		thresholds.Apply = getEnvFloat(logger, "CLASSIFIER_APPLY_CONFIDENCE", thresholds.Apply)
+		thresholds.MaxTags = getEnvInt(logger, "CLASSIFIER_MAX_TAGS", thresholds.MaxTags)
+		classificationUseCases = usecases.NewClassificationUseCases(ideaRepo, classifier, thresholds, eventBus, clock, idGenerator)
+		serverOptions = append(serverOptions, grpcAdapter.WithClassification(classificationUseCases))
+	}
+
+	// Con MODERATION_POLICY_FILE se revisa el texto de las ideas compartidas en una organización o
+	// publicadas; sus miembros son también las organizaciones con las que se comparten plantillas
+	moderationPolicies := loadModerationPolicies(logger)
+	moderationUseCases := newModeration(logger, moderationPolicies, breakers, moderationRepo, clock, idGenerator)
+	if moderationUseCases != nil {
+		ideaOptions = append(ideaOptions, usecases.WithIdeaModeration(moderationUseCases))
+	}
 
 	// Inicializar casos de uso
-	ideaUseCases := usecases.NewIdeaUseCases(ideaRepo, eventBus)
-	reminderUseCases := usecases.NewReminderUseCases(reminderRepo, notificationService, eventBus)
-	fileUseCases := usecases.NewFileUseCases(fileRepo, fileStorageService, eventBus)
-	progressUseCases := usecases.NewProgressUseCases(progressRepo, eventBus)
+	ideaUseCases := usecases.NewIdeaUseCases(ideaRepo, eventBus, clock, idGenerator, ideaOptions...)
+	reminderUseCases := usecases.NewReminderUseCases(reminderRepo, ideaRepo, localizedNotifications, eventBus, clock, idGenerator)
+	fileUseCases := usecases.NewFileUseCases(fileRepo, fileStorageService, eventBus, unitOfWork, clock, idGenerator,
+		usecases.WithMaxFileVersions(getEnvInt(logger, "FILE_MAX_VERSIONS", usecases.DefaultMaxFileVersions)),
+		usecases.WithTextExtraction(textExtractionQueue),
+		usecases.WithPreviewExtractor(preview.NewExtractor(preview.Config{
+			StripGPS: getEnvBool(logger, "FILE_STRIP_GPS", true),
+		})),
+		usecases.WithStorageLifecycle(storageLifecycle),
+		usecases.WithFileDeduplication(getEnvBool(logger, "FILE_DEDUP_ENABLED", false)),
+	)
+	progressUseCases := usecases.NewProgressUseCases(progressRepo, eventBus, clock, idGenerator,
+		usecases.WithProgressCustomFields(customFieldRepo),
+		usecases.WithProgressTimeTracking(timeEntryRepo),
+	)
+	shareLinkUseCases := usecases.NewShareLinkUseCases(shareLinkRepo, fileRepo, fileStorageService, eventBus, clock, idGenerator)
+
+	// Las descargas por enlace se sirven por HTTP para quienes no usan la aplicación
+	shareHTTPPort := getEnv("SHARE_HTTP_PORT", "8080")
+	serverOptions = append(serverOptions, grpcAdapter.WithShareLinks(
+		shareLinkUseCases,
+		getEnv("SHARE_BASE_URL", "http://localhost:"+shareHTTPPort+"/share"),
+	))
+
+	// Las direcciones de entrada crean ideas y recordatorios desde correos y otras aplicaciones;
+	// los correos llegan por los webhooks de SendGrid o de SES en el mismo servidor HTTP
+	inboundUseCases := usecases.NewInboundUseCases(inboundAddressRepo, ideaUseCases, reminderUseCases, fileUseCases, eventBus, clock, idGenerator)
+	inboundEmailDomain := getEnv("INBOUND_EMAIL_DOMAIN", "")
+	serverOptions = append(serverOptions, grpcAdapter.WithInbound(
+		inboundUseCases,
+		getEnv("INBOUND_BASE_URL", "http://localhost:"+shareHTTPPort+"/inbound"),
+		inboundEmailDomain,
+	))
+
+	// Vinculación de chats y botones de las notificaciones, atendidos por el mismo servidor HTTP
+	chatUseCases := usecases.NewChatUseCases(chatBindingRepo, reminderUseCases, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithChatBindings(chatUseCases, getEnv("TELEGRAM_BOT_USERNAME", "")))
+
+	localeUseCases := usecases.NewLocaleUseCases(localePreferenceRepo, translator.Locales(), eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithLocales(localeUseCases))
+
+	customFieldUseCases := usecases.NewCustomFieldUseCases(customFieldRepo, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithCustomFields(customFieldUseCases))
+	var organizationMembers usecases.OrganizationMembers
+	if moderationPolicies != nil {
+		organizationMembers = moderationPolicies.Members
+	}
+	progressTemplateUseCases := usecases.NewProgressTemplateUseCases(progressTemplateRepo, progressRepo, organizationMembers, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithProgressTemplates(progressTemplateUseCases))
+	reminderMilestoneUseCases := usecases.NewReminderMilestoneUseCases(reminderUseCases, progressUseCases, reminderRepo, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithReminderMilestoneLinks(reminderMilestoneUseCases))
+
+	if smsSender != nil {
+		phoneUseCases := usecases.NewPhoneUseCases(phoneNumberRepo, smsSender, smsDailyLimit, eventBus, clock, idGenerator)
+		serverOptions = append(serverOptions, grpcAdapter.WithPhoneNumbers(phoneUseCases))
+	}
+	if emailSender != nil {
+		serverOptions = append(serverOptions, grpcAdapter.WithEmail(usecases.NewEmailUseCases(emailPreferenceRepo, clock)))
+	}
+
+	// El tablero avisa de los movimientos por el canal "board" del hub de notificaciones
+	boardUseCases := usecases.NewBoardUseCases(ideaRepo, unitOfWork, notificationService, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithBoard(boardUseCases))
+
+	ideaWatchUseCases := usecases.NewIdeaWatchUseCases(ideaRepo, supervisor.Go)
+	if err := ideaWatchUseCases.Subscribe(eventBus); err != nil {
+		logger.Fatal("Failed to subscribe idea watch", zap.Error(err))
+	}
+	serverOptions = append(serverOptions, grpcAdapter.WithIdeaWatch(ideaWatchUseCases))
+
+	bulkTagUseCases := usecases.NewBulkTagUseCases(unitOfWork, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithBulkTags(bulkTagUseCases))
+
+	// Las estadísticas del panel se recalculan por secciones al publicarse los eventos que las afectan
+	statisticsUseCases := usecases.NewStatisticsUseCases(statisticsRepo, clock)
+	if err := statisticsUseCases.Subscribe(eventBus); err != nil {
+		logger.Fatal("Failed to subscribe statistics projection", zap.Error(err))
+	}
+	serverOptions = append(serverOptions, grpcAdapter.WithStatistics(statisticsUseCases))
+
+	// La telemetría de la app se guarda a través de la cola para no retrasar las respuestas
+	var telemetryUseCases *usecases.TelemetryUseCases
+	clientMetricQueue := queue.NewClientMetricQueue(messageQueue, func(ctx context.Context, metrics []*entities.ClientMetric) error {
+		return telemetryUseCases.StoreClientMetrics(ctx, metrics)
+	})
+	telemetryUseCases = usecases.NewTelemetryUseCases(clientMetricRepo, clientMetricQueue, clock, idGenerator,
+		getEnvDuration(logger, "TELEMETRY_RETENTION", 30*24*time.Hour),
+		usecases.WithClientMetricSampling(entities.ClientMetricSyncDuration, getEnvFloat(logger, "TELEMETRY_SYNC_SAMPLE_RATE", usecases.DefaultClientMetricSampling[entities.ClientMetricSyncDuration])),
+		usecases.WithClientMetricSampling(entities.ClientMetricFeatureUsage, getEnvFloat(logger, "TELEMETRY_FEATURE_SAMPLE_RATE", usecases.DefaultClientMetricSampling[entities.ClientMetricFeatureUsage])),
+	)
+	serverOptions = append(serverOptions, grpcAdapter.WithTelemetry(telemetryUseCases))
+
+	// Las lecturas de ideas y archivos repetidas por los clientes móviles se responden desde memoria
+	// durante unos segundos, incluidas las que no encuentran nada; cualquier cambio en los datos de
+	// un usuario invalida sus respuestas. GRPC_READ_CACHE_NEGATIVE_TTL=0 no guarda los NotFound
+	readCacheTTL := getEnvDuration(logger, "GRPC_READ_CACHE_TTL", 5*time.Second)
+	readCacheNegativeTTL := getEnvDuration(logger, "GRPC_READ_CACHE_NEGATIVE_TTL", 2*time.Second)
+	responseCache := cache.NewResponseCache(cache.ResponseCacheConfig{
+		Methods: map[string]time.Duration{
+			"/" + pb.NotebookService_ServiceDesc.ServiceName + "/GetIdea":     readCacheTTL,
+			"/" + pb.NotebookService_ServiceDesc.ServiceName + "/ListIdeas":   readCacheTTL,
+			"/" + pb.NotebookService_ServiceDesc.ServiceName + "/GetFileUrl":  readCacheTTL,
+			"/" + pbv2.NotebookService_ServiceDesc.ServiceName + "/GetIdea":   readCacheTTL,
+			"/" + pbv2.NotebookService_ServiceDesc.ServiceName + "/ListIdeas": readCacheTTL,
+		},
+		MaxEntries:    getEnvInt(logger, "GRPC_READ_CACHE_MAX_ENTRIES", 10000),
+		MaxMemory:     getEnvInt(logger, "GRPC_READ_CACHE_MAX_MEMORY", 64<<20),
+		AllowNegative: readCacheNegativeTTL > 0,
+		NegativeTTL:   readCacheNegativeTTL,
+		Clock:         clock,
+	})
+	if err := usecases.SubscribeReadCacheInvalidation(eventBus, responseCache); err != nil {
+		logger.Fatal("Failed to subscribe read cache invalidation", zap.Error(err))
+	}
+	metricsCollector.RegisterCollector(responseCache.Metrics)
+
+	reviewUseCases := usecases.NewReviewUseCases(ideaRepo, ideaReviewRepo, eventBus, clock, idGenerator)
+	serverOptions = append(serverOptions, grpcAdapter.WithReviews(reviewUseCases))
+
+	// Las visitas a las ideas publicadas se acumulan en memoria y se guardan por lotes; el diario en
+	// PUBLICATION_VIEWS_JOURNAL conserva las pendientes si el proceso se cae
+	publicationViews, err := cache.NewPublicationViewCounter(publicationRepo, getEnv("PUBLICATION_VIEWS_JOURNAL", "./publication_views.journal"))
+	if err != nil {
+		logger.Fatal("Failed to open publication views journal", zap.Error(err))
+	}
+	defer func() {
+		if err := publicationViews.Close(context.Background()); err != nil {
+			logger.Error("Failed to flush publication views", zap.Error(err))
+		}
+	}()
+	metricsCollector.RegisterCollector(publicationViews.Metrics)
+
+	publicationOptions := []usecases.PublicationOption{usecases.WithPublicationViewRecorder(publicationViews)}
+	if moderationUseCases != nil {
+		publicationOptions = append(publicationOptions, usecases.WithPublicationModeration(moderationUseCases))
+	}
+	publicationUseCases := usecases.NewPublicationUseCases(publicationRepo, ideaRepo, eventBus, clock, idGenerator, publicationOptions...)
+	serverOptions = append(serverOptions, grpcAdapter.WithPublishing(
+		publicationUseCases,
+		getEnv("PUBLIC_IDEA_BASE_URL", "http://localhost:"+shareHTTPPort+"/p"),
+	))
+
+	// Los tokens de la API de administración también autentican el endpoint HTTP de archivos
+	secretKey := authSecretKey(logger)
+	tokenManager := security.NewTokenManager(secretKey, "notebook-server", 24*time.Hour)
+	// Los tokens validados se recuerdan unos segundos para no verificar la firma en cada llamada;
+	// AUTH_TOKEN_CACHE_TTL=0 lo desactiva
+	if ttl := getEnvDuration(logger, "AUTH_TOKEN_CACHE_TTL", 30*time.Second); ttl > 0 {
+		tokenManager.EnableValidationCache(ttl, getEnvInt(logger, "AUTH_TOKEN_CACHE_MAX_ENTRIES", 10000))
+	}
+
+	// Los archivos se sirven por HTTP con Range y caché; GetFileUrl entrega URLs firmadas
+	// para reproductores multimedia y, si se configura, para la CDN
+	fileURLTTL := getEnvDuration(logger, "FILE_URL_TTL", cdn.DefaultFileURLTTL)
+	fileURLSigner := cdn.NewOriginSigner([]byte(getEnv("FILE_URL_SECRET", secretKey)))
+	serverOptions = append(serverOptions, grpcAdapter.WithFileURLs(cdn.NewFileURLs(cdn.FileURLConfig{
+		BaseURL: getEnv("FILE_BASE_URL", "http://localhost:"+shareHTTPPort+"/files"),
+		Origin:  fileURLSigner,
+		Edge:    newCDNSigner(logger, fileURLTTL),
+		TTL:     fileURLTTL,
+	})))
+
+	// Los fragmentos se pasan al almacenamiento a medida que llegan; el límite corta subidas desmedidas
+	serverOptions = append(serverOptions, grpcAdapter.WithMaxUploadSize(int64(getEnvInt(logger, "FILE_MAX_UPLOAD_SIZE", grpcAdapter.DefaultMaxUploadSize))))
+
+	// SQLite no tiene LISTEN/NOTIFY; en modo standalone no hay otras instancias que sincronizar
+	var changePublisher *replication.Publisher
+	if changeFeed != nil {
+		changeRelayUseCases := usecases.NewChangeRelayUseCases(changeFeed, notificationService)
+		supervisor.Go("change_relay", func() { changeRelayUseCases.Run(ctx) })
+
+		// Los cambios se reenvían a las réplicas que se conecten; se guardan los últimos en memoria
+		// para que una réplica que se reconecta no pierda los de un corte breve
+		changePublisher = replication.NewPublisher(changeFeed, replication.PublisherConfig{
+			BacklogSize: getEnvInt(logger, "REPLICATION_BACKLOG_SIZE", 10000),
+		})
+		supervisor.Go("replication.publisher", func() { changePublisher.Start(ctx) })
+		metricsCollector.RegisterCollector(changePublisher.Metrics)
+	}
+
+	// La reconciliación del almacenamiento solo informa salvo que se active la reparación
+	storageReconciliation := usecases.NewStorageReconciliationUseCases(
+		fileRepo,
+		storageInventory,
+		fileStorageService,
+		localizedNotifications,
+		clock,
+		getEnvDuration(logger, "STORAGE_ORPHAN_GRACE", usecases.DefaultOrphanGracePeriod),
+	)
+	storageReconcileRepair := getEnvBool(logger, "STORAGE_RECONCILE_REPAIR", false)
+
+	// Tareas en segundo plano; las singleton solo se ejecutan en la réplica que retiene el lock
+	// Los pasos de escalado pasan por la cola; el planificador solo detecta a qué recordatorios les toca
+	var reminderScheduler *usecases.ReminderSchedulerUseCases
+	reminderEscalationQueue := queue.NewReminderEscalationQueue(messageQueue, func(ctx context.Context, reminderID uuid.UUID) error {
+		return reminderScheduler.EscalateReminder(ctx, reminderID)
+	})
+	reminderScheduler = usecases.NewReminderSchedulerUseCases(reminderRepo, localizedNotifications, clock, usecases.WithReminderEscalationQueue(reminderEscalationQueue), usecases.WithReminderEventBus(eventBus, idGenerator))
+	ideaAging, err := usecases.NewIdeaAgingUseCases(ideaRepo, reminderRepo, notificationService, eventBus, clock, idGenerator, ideaPriorityRules(logger))
+	if err != nil {
+		logger.Fatal("Invalid idea priority rules", zap.Error(err))
+	}
+	// Las ideas archivadas sin cambios en este tiempo se compactan; 0 desactiva la compactación
+	ideaCompaction := usecases.NewIdeaCompactionUseCases(ideaArchiveRepo, eventBus, clock, idGenerator,
+		getEnvDuration(logger, "IDEA_COMPACTION_AFTER", 180*24*time.Hour))
+	jobRegistry := jobs.NewRegistry(jobs.RegistryConfig{Locker: locker, Clock: clock})
+	jobRegistry.OnRunComplete(func(status jobs.JobStatus, err error) {
+		if status.LastResult == jobs.ResultFailed {
+			logger.Error("Background job failed", zap.String("job", status.Name), zap.Error(err))
+		}
+	})
+	metricsCollector.RegisterCollector(jobRegistry.Metrics)
+
+	// En modo mantenimiento solo se aceptan lecturas, las tareas programadas se pausan y
+	// los streams de notificaciones avisan a los usuarios; se activa desde la API de administración
+	maintenanceMode := maintenance.NewMode(maintenance.Config{
+		DefaultRetryAfter: getEnvDuration(logger, "MAINTENANCE_RETRY_AFTER", 5*time.Minute),
+	})
+	replica, err := replication.NewNode(replication.Config{
+		Role:        replicationRole,
+		PrimaryAddr: getEnv("REPLICATION_PRIMARY_ADDR", ""),
+		IsRead:      maintenanceMode.IsRead,
+	})
+	if err != nil {
+		logger.Fatal("Invalid REPLICATION_ROLE", zap.Error(err))
+	}
+	metricsCollector.RegisterCollector(replica.Metrics)
+	maintenanceMode.OnChange(func(state maintenance.State) {
+		if state.Enabled {
+			jobRegistry.Pause()
+			logger.Warn("Maintenance mode enabled", zap.String("message", state.Message), zap.Duration("retry_after", state.RetryAfter))
+		} else {
+			// Una réplica mantiene las tareas en pausa hasta que se promueva
+			if !replica.IsFollower() {
+				jobRegistry.Resume()
+			}
+			logger.Info("Maintenance mode disabled")
+		}
+	})
+	if replica.IsFollower() {
+		// Las tareas escriben en la base de datos, así que en una réplica no se ejecutan
+		jobRegistry.Pause()
+		replica.OnPromote(func(context.Context) error {
+			if follower != nil {
+				// Los suscriptores del flujo de cambios pasan a recibir los de la base de datos local,
+				// que deja de ser un standby al promoverla
+				follower.Stop()
+				pgChangeFeed := postgres.NewChangeFeed(db, structuredLogger)
+				supervisor.Go("postgres.change_feed", func() { pgChangeFeed.Start(ctx) })
+				metricsCollector.RegisterCollector(pgChangeFeed.Metrics)
+				supervisor.Go("replication.relay", func() { follower.Relay(ctx, pgChangeFeed) })
+			}
+			if !maintenanceMode.Enabled() {
+				jobRegistry.Resume()
+			}
+			logger.Warn("Promoted to primary")
+			return nil
+		})
+		logger.Info("Running as a read-only follower", zap.String("primary", replica.PrimaryAddr()))
+	}
+	metricsCollector.RegisterCollector(maintenanceMode.Metrics)
+	serverOptions = append(serverOptions, grpcAdapter.WithMaintenanceBanner(maintenanceMode))
+
+	// Las apps anteriores a CLIENT_MIN_VERSION reciben UPGRADE_REQUIRED y deben actualizarse;
+	// GetServerInfo sigue respondiendo y publica la versión mínima para que la app avise antes
+	versionGate, err := appversion.NewGate(appversion.Config{
+		Header:        getEnv("CLIENT_VERSION_HEADER", "x-client-version"),
+		MinVersion:    getEnv("CLIENT_MIN_VERSION", ""),
+		RejectMissing: getEnvBool(logger, "CLIENT_VERSION_REQUIRED", false),
+		ExemptMethods: getEnvList("CLIENT_VERSION_EXEMPT_METHODS", []string{
+			"/" + pb.NotebookService_ServiceDesc.ServiceName + "/GetServerInfo",
+		}),
+		UpgradeURL:  getEnv("CLIENT_UPGRADE_URL", ""),
+		MaxVersions: getEnvInt(logger, "METRICS_MAX_LABEL_VALUES", 50),
+	})
+	if err != nil {
+		logger.Fatal("Invalid CLIENT_MIN_VERSION", zap.Error(err))
+	}
+	metricsCollector.RegisterCollector(versionGate.Metrics)
+	serverOptions = append(serverOptions, grpcAdapter.WithVersionGate(versionGate))
+
+	backgroundJobs := []jobs.JobConfig{
+		{
+			Name:     "metrics_flush",
+			Interval: 30 * time.Second,
+			Task: func(ctx context.Context) error {
+				metricsCollector.FlushOldMetrics()
+				return nil
+			},
+		},
+		{
+			// La cola es en memoria, así que cada réplica limpia su propia DLQ
+			Name:     "dlq_cleanup",
+			Interval: time.Hour,
+			Task: func(ctx context.Context) error {
+				messageQueue.CleanupExpiredDLQ()
+				return nil
+			},
+		},
+		{
+			// La caché de respuestas es en memoria, así que cada réplica limpia la suya
+			Name:     "response_cache_cleanup",
+			Interval: time.Minute,
+			Task: func(ctx context.Context) error {
+				responseCache.CleanupExpired()
+				return nil
+			},
+		},
+		{
+			// Cada réplica acumula sus propias visitas
+			Name:     "publication_views_flush",
+			Interval: getEnvDuration(logger, "PUBLICATION_VIEWS_FLUSH_INTERVAL", 10*time.Second),
+			Task: func(ctx context.Context) error {
+				_, err := publicationViews.Flush(ctx)
+				return err
+			},
+		},
+		{
+			Name:       "reminder_scheduler",
+			Interval:   time.Minute,
+			Timeout:    30 * time.Second,
+			RunOnStart: true,
+			Singleton:  true,
+			Task: func(ctx context.Context) error {
+				_, err := reminderScheduler.MarkOverdueReminders(ctx)
+				return err
+			},
+		},
+		{
+			Name:      "reminder_escalation",
+			Interval:  getEnvDuration(logger, "REMINDER_ESCALATION_INTERVAL", time.Minute),
+			Timeout:   30 * time.Second,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				_, err := reminderScheduler.EscalateReminders(ctx)
+				return err
+			},
+		},
+		{
+			Name:      "notification_inbox_cleanup",
+			Interval:  time.Hour,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				_, err := inbox.DeleteOlderThan(ctx, clock.Now().Add(-notificationRetention))
+				return err
+			},
+		},
+		{
+			Name:      "telemetry_cleanup",
+			Interval:  time.Hour,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				_, err := telemetryUseCases.PurgeClientMetrics(ctx)
+				return err
+			},
+		},
+		{
+			Name:      "idea_priority_aging",
+			Interval:  getEnvDuration(logger, "IDEA_PRIORITY_AGING_INTERVAL", time.Hour),
+			Timeout:   10 * time.Minute,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := ideaAging.ApplyRules(ctx)
+				if err != nil {
+					return err
+				}
+				if report.Changed > 0 {
+					logger.Info("Adjusted idea priorities",
+						zap.Int("evaluated", report.Evaluated),
+						zap.Int("changed", report.Changed),
+						zap.Int("conflicts", report.Conflicts),
+					)
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "idea_compaction",
+			Interval:  getEnvDuration(logger, "IDEA_COMPACTION_INTERVAL", 24*time.Hour),
+			Timeout:   30 * time.Minute,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := ideaCompaction.CompactArchivedIdeas(ctx)
+				if err != nil {
+					return err
+				}
+				if report.Compacted > 0 || report.Conflicts > 0 {
+					logger.Info("Compacted archived ideas",
+						zap.Int("compacted", report.Compacted),
+						zap.Int("conflicts", report.Conflicts),
+					)
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "storage_reconciliation",
+			Interval:  getEnvDuration(logger, "STORAGE_RECONCILE_INTERVAL", 6*time.Hour),
+			Timeout:   time.Hour,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := storageReconciliation.Reconcile(ctx, storageReconcileRepair)
+				if err != nil {
+					return err
+				}
+				metricsCollector.SetGauge("storage_orphaned_blobs", float64(len(report.OrphanedBlobs)), nil)
+				metricsCollector.SetGauge("storage_dangling_records", float64(len(report.DanglingRecords)), nil)
+				if len(report.OrphanedBlobs) > 0 || len(report.DanglingRecords) > 0 {
+					logger.Warn("Storage and file records are out of sync",
+						zap.Int("orphaned_blobs", len(report.OrphanedBlobs)),
+						zap.Int("dangling_records", len(report.DanglingRecords)),
+						zap.Int("removed_blobs", report.RemovedBlobs),
+						zap.Int("removed_records", report.RemovedRecords),
+					)
+				}
+				return nil
+			},
+		},
+	}
+	if classificationUseCases != nil {
+		// Sin IDEA_AUTO_TAG_INTERVAL solo se ejecuta a pedido desde la API de administración
+		backgroundJobs = append(backgroundJobs, jobs.JobConfig{
+			Name:      "auto_tag_ideas",
+			Interval:  getEnvDuration(logger, "IDEA_AUTO_TAG_INTERVAL", 0),
+			Timeout:   time.Hour,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := classificationUseCases.AutoTagIdeas(ctx)
+				if err != nil {
+					return err
+				}
+				if report.Tagged > 0 {
+					logger.Info("Auto-tagged ideas",
+						zap.Int("evaluated", report.Evaluated),
+						zap.Int("tagged", report.Tagged),
+						zap.Int("conflicts", report.Conflicts),
+					)
+				}
+				return nil
+			},
+		})
+	}
+	if semanticSearch != nil {
+		// Completa los vectores de las ideas anteriores a la búsqueda semántica o de un modelo anterior
+		backgroundJobs = append(backgroundJobs, jobs.JobConfig{
+			Name:       "idea_embeddings_backfill",
+			Interval:   getEnvDuration(logger, "EMBEDDINGS_BACKFILL_INTERVAL", 24*time.Hour),
+			Timeout:    time.Hour,
+			RunOnStart: true,
+			Singleton:  true,
+			Task: func(ctx context.Context) error {
+				_, err := semanticSearch.BackfillEmbeddings(ctx)
+				return err
+			},
+		})
+	}
+	if storageLifecycle != nil {
+		backgroundJobs = append(backgroundJobs, jobs.JobConfig{
+			Name:      "storage_lifecycle",
+			Interval:  getEnvDuration(logger, "STORAGE_LIFECYCLE_INTERVAL", time.Hour),
+			Timeout:   30 * time.Minute,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := storageLifecycle.ApplyLifecycle(ctx)
+				if err != nil {
+					return err
+				}
+				if report.Transitioned > 0 || report.Failed > 0 {
+					logger.Info("Moved files to cold storage",
+						zap.Int("transitioned", report.Transitioned),
+						zap.Int("failed", report.Failed),
+					)
+				}
+
+				tiers, err := storageLifecycle.GetTierUsage(ctx)
+				if err != nil {
+					return err
+				}
+				for _, tier := range tiers {
+					labels := map[string]string{"tier": string(tier.Tier)}
+					metricsCollector.SetGauge("storage_tier_files", float64(tier.Blobs), labels)
+					metricsCollector.SetGauge("storage_tier_bytes", float64(tier.Bytes), labels)
+				}
+				return nil
+			},
+		})
+	}
+	// El resumen de la semana anterior se envía en la primera ejecución después del lunes a las 00:00 UTC
+	if emailSender != nil {
+		renderer, err := reports.NewRenderer(translator)
+		if err != nil {
+			logger.Fatal("Failed to load report templates", zap.Error(err))
+		}
+		weeklySummaries := usecases.NewWeeklySummaryUseCases(emailPreferenceRepo, weeklySummaryRepo, progressRepo, localePreferenceRepo, renderer, notificationService, clock)
+		backgroundJobs = append(backgroundJobs, jobs.JobConfig{
+			Name:      "weekly_summary",
+			Interval:  getEnvDuration(logger, "WEEKLY_SUMMARY_INTERVAL", time.Hour),
+			Timeout:   30 * time.Minute,
+			Singleton: true,
+			Task: func(ctx context.Context) error {
+				report, err := weeklySummaries.SendWeeklySummaries(ctx)
+				if err != nil {
+					return err
+				}
+				if report.Sent > 0 || report.Failed > 0 {
+					logger.Info("Sent weekly summaries",
+						zap.Int("sent", report.Sent),
+						zap.Int("skipped", report.Skipped),
+						zap.Int("failed", report.Failed),
+					)
+				}
+				return nil
+			},
+		})
+	}
+	for _, job := range backgroundJobs {
+		if err := jobRegistry.Register(job); err != nil {
+			logger.Fatal("Failed to register background job", zap.String("job", job.Name), zap.Error(err))
+		}
+	}
+	jobRegistry.Start(ctx)
+	defer jobRegistry.Stop()
 
 	// Crear el servidor gRPC
 	notebookServer := grpcAdapter.NewNotebookServer(
@@ -66,6 +1046,7 @@ func main() {
 		fileUseCases,
 		progressUseCases,
 		notificationService,
+		serverOptions...,
 	)
 
 	// Configurar el servidor gRPC
@@ -75,13 +1056,305 @@ func main() {
 		logger.Fatal("Failed to listen", zap.Error(err))
 	}
 
-	s := grpc.NewServer()
+	// Cada llamada recibe un request ID (el del cliente o uno nuevo) que se devuelve en los trailers
+	requestIDs := requestid.NewInterceptor(idGenerator, requestid.WithRequestLogging(structuredLogger))
+
+	// Respuestas comprimidas con gzip para reducir el consumo de datos móviles; los
+	// fragmentos de archivos se envían tal cual porque suelen estar ya comprimidos
+	compressionEnabled := getEnvBool(logger, "GRPC_COMPRESSION_ENABLED", true)
+	responseCompression := compression.NewInterceptor(compression.Config{
+		Enabled: compressionEnabled,
+		Methods: map[string]bool{
+			"/" + pb.NotebookService_ServiceDesc.ServiceName + "/DownloadFile": false,
+		},
+	})
+
+	// Límite de streams concurrentes por usuario y en total para no agotar goroutines
+	streamLimiter := security.NewStreamLimiter(security.StreamLimiterConfig{
+		MaxStreamsPerUser: getEnvInt(logger, "GRPC_MAX_STREAMS_PER_USER", 5),
+		MaxTotalStreams:   getEnvInt(logger, "GRPC_MAX_TOTAL_STREAMS", 1000),
+	})
+	metricsCollector.RegisterCollector(streamLimiter.Metrics)
+
+	// El campo message de las respuestas se traduce al idioma de Accept-Language o al preferido del usuario
+	localization := i18n.NewInterceptor(translator, localeUseCases)
+
+	// Latencia y número de llamadas por método, código, rol, organización y versión mayor.menor
+	// de la app; cada etiqueta admite METRICS_MAX_LABEL_VALUES valores y el resto cuenta como "other"
+	rpcMetrics := metrics.NewRPCMetrics(metricsCollector, metrics.RPCConfig{
+		Labels: []metrics.LabelFunc{
+			security.ClaimLabels(getEnv("METRICS_ORG_CLAIM", "org")),
+			metrics.MetadataLabel(getEnv("CLIENT_VERSION_HEADER", "x-client-version"), "client_version", metrics.MajorMinorVersion),
+		},
+		MaxLabelValues: getEnvInt(logger, "METRICS_MAX_LABEL_VALUES", 50),
+	})
+
+	// Límites de tamaño comprobados antes de los handlers: el mensaje completo (ResourceExhausted) y
+	// los campos de texto y listas por nombre (InvalidArgument), para que una idea de 50MB no llegue a Postgres
+	stringLimits := make(map[string]int, len(limits.DefaultStringFields))
+	for field, limit := range limits.DefaultStringFields {
+		stringLimits[field] = limit
+	}
+	stringLimits["title"] = getEnvInt(logger, "REQUEST_MAX_TITLE_LENGTH", stringLimits["title"])
+	stringLimits["content"] = getEnvInt(logger, "REQUEST_MAX_CONTENT_LENGTH", stringLimits["content"])
+	stringLimits["filename"] = getEnvInt(logger, "REQUEST_MAX_FILENAME_LENGTH", stringLimits["filename"])
+	listLimits := make(map[string]int, len(limits.DefaultListFields))
+	for field, limit := range limits.DefaultListFields {
+		listLimits[field] = limit
+	}
+	listLimits["tags"] = getEnvInt(logger, "REQUEST_MAX_TAGS", listLimits["tags"])
+	listLimits["custom_fields"] = getEnvInt(logger, "REQUEST_MAX_CUSTOM_FIELDS", listLimits["custom_fields"])
+	requestLimits := limits.NewEnforcer(limits.Config{
+		MaxMessageBytes: getEnvInt(logger, "REQUEST_MAX_MESSAGE_BYTES", 4<<20),
+		StringFields:    stringLimits,
+		ListFields:      listLimits,
+	})
+	metricsCollector.RegisterCollector(requestLimits.Metrics)
+
+	// Todos los errores llevan un ErrorCode en ErrorInfo para que los clientes no dependan del texto;
+	// va justo después del request ID para cubrir también los rechazos de los interceptores siguientes
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		requestIDs.UnaryInterceptor(),
+		grpcAdapter.ErrorCodeUnaryInterceptor(),
+		rpcMetrics.UnaryInterceptor(),
+		requestLimits.UnaryInterceptor(),
+		versionGate.UnaryInterceptor(),
+	}
+
+	// Con SHADOW_TARGET un porcentaje de las lecturas se reenvía en segundo plano a una instancia
+	// canary y las diferencias de respuesta se registran en el log; el cliente solo recibe la del
+	// primario. Va antes de la traducción para comparar las respuestas ya traducidas en ambos lados
+	if shadowTarget := getEnv("SHADOW_TARGET", ""); shadowTarget != "" {
+		canaryConn, err := grpc.Dial(shadowTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("Failed to connect to the shadow target", zap.Error(err))
+		}
+		defer canaryConn.Close()
+		shadower := shadow.NewShadower(canaryConn, structuredLogger, shadow.Config{
+			Percent:      getEnvFloat(logger, "SHADOW_PERCENT", 1),
+			IsRead:       maintenanceMode.IsRead,
+			Methods:      getEnvList("SHADOW_METHODS", nil),
+			IgnoreFields: getEnvList("SHADOW_IGNORE_FIELDS", nil),
+			Timeout:      getEnvDuration(logger, "SHADOW_TIMEOUT", 5*time.Second),
+			MaxInFlight:  getEnvInt(logger, "SHADOW_MAX_IN_FLIGHT", 32),
+		})
+		metricsCollector.RegisterCollector(shadower.Metrics)
+		unaryInterceptors = append(unaryInterceptors, shadower.UnaryInterceptor())
+		logger.Warn("Shadowing read calls to canary", zap.String("target", shadowTarget))
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		localization.UnaryInterceptor(),
+		maintenanceMode.UnaryInterceptor(),
+		replica.UnaryInterceptor(),
+	)
+
+	// Con GRPC_RECORDING_DIR las llamadas unarias se graban saneadas para reenviarlas con cmd/replay
+	// contra otro build; va después de la traducción para grabar los mensajes sin traducir
+	if recordingDir := getEnv("GRPC_RECORDING_DIR", ""); recordingDir != "" {
+		recorder, err := recording.NewRecorder(recording.Config{
+			Dir:          recordingDir,
+			Methods:      getEnvList("GRPC_RECORDING_METHODS", nil),
+			SampleRate:   getEnvFloat(logger, "GRPC_RECORDING_SAMPLE_RATE", 1),
+			MaxFileSize:  int64(getEnvInt(logger, "GRPC_RECORDING_MAX_FILE_SIZE", 64<<20)),
+			RedactFields: getEnvList("GRPC_RECORDING_REDACT_FIELDS", recording.DefaultRedactFields),
+		})
+		if err != nil {
+			logger.Fatal("Failed to create RPC recorder", zap.Error(err))
+		}
+		defer recorder.Close()
+		metricsCollector.RegisterCollector(recorder.Metrics)
+		unaryInterceptors = append(unaryInterceptors, recorder.UnaryInterceptor())
+		logger.Warn("Recording RPC calls", zap.String("dir", recordingDir))
+	}
+	unaryInterceptors = append(unaryInterceptors, responseCompression.UnaryInterceptor(), responseCache.UnaryInterceptor())
+
+	grpcOptions := append(connectionOptions(logger),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(requestIDs.StreamInterceptor(), grpcAdapter.ErrorCodeStreamInterceptor(), rpcMetrics.StreamInterceptor(), requestLimits.StreamInterceptor(), versionGate.StreamInterceptor(), localization.StreamInterceptor(), maintenanceMode.StreamInterceptor(), replica.StreamInterceptor(), responseCompression.StreamInterceptor(), streamLimiter.StreamInterceptor()),
+	)
+
+	// Con GRPC_TLS_CERT_FILE y GRPC_TLS_KEY_FILE el servidor usa TLS; el certificado se vuelve a leer
+	// en cada recarga de configuración para renovarlo sin reiniciar
+	tlsFiles := reload.TLSSettings{
+		CertFile: getEnv("GRPC_TLS_CERT_FILE", ""),
+		KeyFile:  getEnv("GRPC_TLS_KEY_FILE", ""),
+	}
+	var certificate *reload.Certificate
+	if tlsFiles.CertFile != "" {
+		certificate, err = reload.LoadCertificate(tlsFiles.CertFile, tlsFiles.KeyFile)
+		if err != nil {
+			logger.Fatal("Failed to load TLS certificate", zap.Error(err))
+		}
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(certificate.TLSConfig())))
+	}
+	s := grpc.NewServer(grpcOptions...)
 	pb.RegisterNotebookServiceServer(s, notebookServer)
+	// La v2 se sirve junto a la v1 sobre los mismos casos de uso mientras los clientes migran
+	pbv2.RegisterNotebookServiceServer(s, grpcAdapter.NewNotebookServerV2(ideaUseCases))
 	
 	// Habilitar reflection para herramientas como grpcurl
 	reflection.Register(s)
 
-	logger.Info("Starting gRPC server", zap.String("port", port))
+	build := buildinfo.Get()
+	logger.Info("Starting gRPC server",
+		zap.String("port", port),
+		zap.String("version", build.Version),
+		zap.String("commit", build.Commit),
+		zap.String("schema_version", build.SchemaVersion))
+
+	// Servidor de administración en un puerto separado, restringido al rol admin
+	adminServer, adminListener := newAdminServer(logger, structuredLogger, messageQueue, jobRegistry, maintenanceMode, requestIDs, rpcMetrics, tokenManager,
+		grpcAdapter.WithReplication(replica, changePublisher, follower), grpcAdapter.WithModeration(moderationUseCases))
+	go func() {
+		if err := adminServer.Serve(adminListener); err != nil {
+			logger.Error("Admin gRPC server stopped", zap.Error(err))
+		}
+	}()
+
+	shareRateLimit := getEnvInt(logger, "SHARE_RATE_LIMIT_PER_MINUTE", 30)
+	publicIdeaRateLimit := getEnvInt(logger, "PUBLIC_IDEA_RATE_LIMIT_PER_MINUTE", 60)
+	inboundRateLimit := getEnvInt(logger, "INBOUND_RATE_LIMIT_PER_MINUTE", 20)
+	shareLimiter := security.NewRateLimiter(shareRateLimit, time.Minute)
+	publicIdeaLimiter := security.NewRateLimiter(publicIdeaRateLimit, time.Minute)
+	inboundLimiter := security.NewRateLimiter(inboundRateLimit, time.Minute)
+
+	shareMux := http.NewServeMux()
+	shareMux.Handle(web.SharePathPrefix, web.NewShareHandler(
+		shareLinkUseCases,
+		shareLimiter,
+		logger,
+	))
+	shareMux.Handle(web.PublicIdeaPathPrefix, web.NewPublicIdeaHandler(
+		publicationUseCases,
+		publicIdeaLimiter,
+		logger,
+	))
+	shareMux.Handle(web.FilePathPrefix, web.NewFileHandler(
+		fileUseCases,
+		tokenManager,
+		fileURLSigner,
+		getEnvDuration(logger, "FILE_CACHE_MAX_AGE", 24*time.Hour),
+		logger,
+	))
+	shareMux.Handle(web.ChatPathPrefix, web.NewChatHandler(
+		chatUseCases,
+		telegramSender,
+		slackSender,
+		web.ChatConfig{
+			TelegramSecret:     getEnv("TELEGRAM_WEBHOOK_SECRET", ""),
+			SlackSigningSecret: getEnv("SLACK_SIGNING_SECRET", ""),
+			Translator:         translator,
+			Preferences:        localeUseCases,
+		},
+		logger,
+	))
+	shareMux.Handle(web.InboundPathPrefix, web.NewInboundHandler(
+		inboundUseCases,
+		web.InboundConfig{
+			EmailDomain: inboundEmailDomain,
+			WebhookKey:  getEnv("INBOUND_EMAIL_WEBHOOK_KEY", ""),
+			MaxBodySize: int64(getEnvInt(logger, "INBOUND_MAX_BODY_SIZE", web.DefaultInboundMaxBodySize)),
+			SpamScore:   getEnvFloat(logger, "INBOUND_SPAM_SCORE", web.DefaultInboundSpamScore),
+			Limiter:     inboundLimiter,
+		},
+		logger,
+	))
+	shareServer := &http.Server{
+		Addr:              ":" + shareHTTPPort,
+		Handler:           shareMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		logger.Info("Starting share link HTTP server", zap.String("port", shareHTTPPort))
+		if err := shareServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Share link HTTP server stopped", zap.Error(err))
+		}
+	}()
+
+	// El panel no autentica: el servidor escucha en localhost y solo atiende a las IPs de
+	// ADMIN_HTTP_ALLOWED_IPS. pprof y expvar exigen además ADMIN_API_KEY o un token de admin
+	var adminHTTPServer *http.Server
+	var adminAllowList *security.IPAllowList
+	var adminAllowedIPs []netip.Prefix
+	var debugHandler *web.DebugHandler
+	debugEnabled := getEnvBool(logger, "ADMIN_DEBUG_ENABLED", true)
+	if adminHTTPPort != "" {
+		adminAllowedIPs, err = security.ParseIPAllowList(getEnvList("ADMIN_HTTP_ALLOWED_IPS", []string{"127.0.0.1", "::1"}))
+		if err != nil {
+			logger.Fatal("Invalid ADMIN_HTTP_ALLOWED_IPS", zap.Error(err))
+		}
+		adminAllowList = security.NewIPAllowList(adminAllowedIPs)
+		debugHandler = web.NewDebugHandler(web.DebugConfig{
+			Tokens:  tokenManager,
+			APIKey:  getEnv("ADMIN_API_KEY", ""),
+			Enabled: debugEnabled,
+		}, logger)
+
+		adminMux := http.NewServeMux()
+		adminMux.Handle(web.DebugPathPrefix, debugHandler)
+		adminMux.Handle(web.DashboardPath, web.NewDashboardHandler(web.DashboardConfig{
+			Metrics: metricsCollector,
+			Queue:   messageQueue,
+			Streams: notificationService,
+			Health:  dependencies,
+			Logs:    recentLogs,
+			Refresh: getEnvDuration(logger, "ADMIN_DASHBOARD_REFRESH", 10*time.Second),
+		}, logger))
+		adminHTTPServer = &http.Server{
+			Addr:              net.JoinHostPort(getEnv("ADMIN_HTTP_HOST", "127.0.0.1"), adminHTTPPort),
+			Handler:           web.AllowListHandler(adminAllowList, adminMux, logger),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			logger.Info("Starting admin HTTP server", zap.String("addr", adminHTTPServer.Addr))
+			if err := adminHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Admin HTTP server stopped", zap.Error(err))
+			}
+		}()
+	}
+
+	// Con CONFIG_FILE el nivel de log, los límites de peticiones, los flags y el certificado TLS se
+	// recargan con SIGHUP o al cambiar el archivo. Un archivo inválido se rechaza entero y lo que
+	// no define vuelve a los valores de las variables de entorno
+	if configFile := getEnv("CONFIG_FILE", ""); configFile != "" {
+		reloader := reload.NewReloader(reload.Config{
+			Path:         configFile,
+			PollInterval: getEnvDuration(logger, "CONFIG_POLL_INTERVAL", 10*time.Second),
+			OnReload: func(settings reload.Settings, err error) {
+				if err != nil {
+					logger.Error("Configuration reload rejected", zap.String("file", configFile), zap.Error(err))
+					return
+				}
+				logger.Info("Configuration reloaded", zap.String("file", configFile))
+			},
+		})
+		components := []reload.Component{
+			reload.LogLevel(structuredLogger, logLevel),
+			reload.RateLimit("share", shareLimiter, shareRateLimit),
+			reload.RateLimit("public_idea", publicIdeaLimiter, publicIdeaRateLimit),
+			reload.RateLimit("inbound", inboundLimiter, inboundRateLimit),
+			reload.Feature("grpc_compression", compressionEnabled, responseCompression.SetEnabled),
+		}
+		if certificate != nil {
+			components = append(components, certificate.Component(tlsFiles))
+		}
+		if adminHTTPServer != nil {
+			components = append(components,
+				reload.AllowList("admin_http", adminAllowList, adminAllowedIPs),
+				reload.Feature("admin_debug", debugEnabled, debugHandler.SetEnabled),
+			)
+		}
+		for _, component := range components {
+			if err := reloader.Register(component); err != nil {
+				logger.Fatal("Failed to register reloadable component", zap.String("component", component.Name), zap.Error(err))
+			}
+		}
+		if err := reloader.Reload(); err != nil {
+			logger.Fatal("Invalid configuration file", zap.String("file", configFile), zap.Error(err))
+		}
+		metricsCollector.RegisterCollector(reloader.Metrics)
+		supervisor.Go("reload.watch", func() { reloader.Watch(ctx) })
+	}
 
 	// Manejar señales para shutdown graceful
 	go func() {
@@ -90,6 +1363,18 @@ func main() {
 		<-sigChan
 		
 		logger.Info("Shutting down gRPC server...")
+		cancel()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer shutdownCancel()
+		shareServer.Shutdown(shutdownCtx)
+		if adminHTTPServer != nil {
+			adminHTTPServer.Shutdown(shutdownCtx)
+		}
+		adminServer.GracefulStop()
+		// Los handlers de la cola terminan antes de que el servidor se detenga
+		if err := messageQueue.Drain(shutdownCtx); err != nil {
+			logger.Warn("Message queue not drained before shutdown", zap.Error(err))
+		}
 		s.GracefulStop()
 	}()
 
@@ -99,10 +1384,435 @@ func main() {
 	}
 }
 
+// newAdminServer configura el servidor gRPC de administración usado por notebookctl
+func newAdminServer(logger *zap.Logger, structuredLogger *logging.StructuredLogger, messageQueue *queue.MessageQueue, jobRegistry *jobs.Registry, maintenanceMode *maintenance.Mode, requestIDs *requestid.Interceptor, rpcMetrics *metrics.RPCMetrics, tokenManager *security.TokenManager, options ...grpcAdapter.AdminOption) (*grpc.Server, net.Listener) {
+	authInterceptor := security.NewAuthInterceptor(tokenManager)
+	authInterceptor.SetServiceRole(&pb.AdminService_ServiceDesc, security.RoleAdmin)
+	if apiKey := getEnv("ADMIN_API_KEY", ""); apiKey != "" {
+		authInterceptor.AddAPIKey(apiKey, &security.AuthClaims{
+			UserID:  "admin",
+			Role:    security.RoleAdmin,
+			Subject: "api-key",
+		})
+	} else {
+		logger.Warn("ADMIN_API_KEY not set, the admin API only accepts bearer tokens")
+	}
+
+	adminService := grpcAdapter.NewAdminServer(append([]grpcAdapter.AdminOption{
+		grpcAdapter.WithMessageQueue(messageQueue),
+		grpcAdapter.WithTokenManager(tokenManager),
+		grpcAdapter.WithLogger(structuredLogger),
+		grpcAdapter.WithJobRegistry(jobRegistry),
+		grpcAdapter.WithMaintenanceMode(maintenanceMode),
+	}, options...)...)
+
+	port := getEnv("ADMIN_GRPC_PORT", "50052")
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		logger.Fatal("Failed to listen for admin server", zap.Error(err))
+	}
+
+	// Las métricas van después de la autenticación para etiquetar con el rol del token
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(requestIDs.UnaryInterceptor(), authInterceptor.UnaryInterceptor(), rpcMetrics.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(requestIDs.StreamInterceptor(), authInterceptor.StreamInterceptor(), rpcMetrics.StreamInterceptor()),
+	)
+	pb.RegisterAdminServiceServer(server, adminService)
+	reflection.Register(server)
+
+	logger.Info("Starting admin gRPC server", zap.String("port", port))
+	return server, listener
+}
+
+// newReplicationFollower conecta con la API de administración del primario para seguir su flujo de cambios
+func newReplicationFollower(logger *zap.Logger) *replication.Follower {
+	adminAddr := getEnv("REPLICATION_PRIMARY_ADMIN_ADDR", "")
+	if adminAddr == "" {
+		logger.Fatal("REPLICATION_PRIMARY_ADMIN_ADDR is required when REPLICATION_ROLE=follower")
+	}
+	conn, err := grpc.Dial(adminAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("Failed to connect to the primary", zap.Error(err))
+	}
+
+	return replication.NewFollower(
+		grpcAdapter.NewReplicationSource(conn, getEnv("REPLICATION_API_KEY", "")),
+		replication.FollowerConfig{
+			ReconnectDelay: getEnvDuration(logger, "REPLICATION_RECONNECT_DELAY", 2*time.Second),
+			OnDisconnect: func(err error) {
+				logger.Warn("Lost the change stream from the primary", zap.String("primary", adminAddr), zap.Error(err))
+			},
+		},
+	)
+}
+
+// authSecretKey devuelve AUTH_SECRET_KEY o, si no se configuró, una clave aleatoria
+func authSecretKey(logger *zap.Logger) string {
+	secretKey := getEnv("AUTH_SECRET_KEY", "")
+	if secretKey == "" {
+		generated, err := security.GenerateSecretKey()
+		if err != nil {
+			logger.Fatal("Failed to generate auth secret key", zap.Error(err))
+		}
+		secretKey = generated
+		logger.Warn("AUTH_SECRET_KEY not set, using a random key; issued tokens and file URLs will not survive a restart")
+	}
+	return secretKey
+}
+
+// newCDNSigner construye el firmador de URLs de la CDN según CDN_PROVIDER: "cloudfront" firma
+// con una política predefinida, "cloudflare" con el token que valida la regla WAF y vacío no firma
+func newCDNSigner(logger *zap.Logger, ttl time.Duration) cdn.URLSigner {
+	switch provider := getEnv("CDN_PROVIDER", ""); provider {
+	case "":
+		return nil
+	case "cloudfront":
+		privateKey, err := os.ReadFile(getEnv("CLOUDFRONT_PRIVATE_KEY_PATH", ""))
+		if err != nil {
+			logger.Fatal("Failed to read CloudFront private key", zap.Error(err))
+		}
+		signer, err := cdn.NewCloudFrontSigner(getEnv("CLOUDFRONT_KEY_PAIR_ID", ""), privateKey)
+		if err != nil {
+			logger.Fatal("Invalid CloudFront private key", zap.Error(err))
+		}
+		return signer
+	case "cloudflare":
+		secret := getEnv("CLOUDFLARE_SIGNING_SECRET", "")
+		if secret == "" {
+			logger.Fatal("CLOUDFLARE_SIGNING_SECRET is required when CDN_PROVIDER is cloudflare")
+		}
+		return cdn.NewCloudflareSigner([]byte(secret), getEnvDuration(logger, "CLOUDFLARE_TOKEN_LIFETIME", ttl))
+	default:
+		logger.Fatal("Invalid CDN_PROVIDER", zap.String("provider", provider))
+		return nil
+	}
+}
+
+// newTextExtractors construye los extractores de texto según OCR_PROVIDER: "tesseract" usa las
+// herramientas locales, "http" un servicio externo y vacío solo indexa los archivos de texto plano
+func newTextExtractors(logger *zap.Logger) []ports.TextExtractor {
+	extractors := []ports.TextExtractor{extraction.PlainTextExtractor{}}
+	timeout := getEnvDuration(logger, "OCR_TIMEOUT", 2*time.Minute)
+
+	switch provider := getEnv("OCR_PROVIDER", ""); provider {
+	case "":
+	case "tesseract":
+		extractors = append(extractors, extraction.NewTesseractExtractor(extraction.TesseractConfig{
+			TesseractPath: getEnv("OCR_TESSERACT_PATH", "tesseract"),
+			PdfToTextPath: getEnv("OCR_PDFTOTEXT_PATH", "pdftotext"),
+			Languages:     getEnv("OCR_LANGUAGES", "eng"),
+			Timeout:       timeout,
+		}))
+	case "http":
+		endpoint := getEnv("OCR_API_URL", "")
+		if endpoint == "" {
+			logger.Fatal("OCR_API_URL is required when OCR_PROVIDER is http")
+		}
+		extractors = append(extractors, extraction.NewHTTPExtractor(extraction.HTTPConfig{
+			Endpoint: endpoint,
+			APIKey:   getEnv("OCR_API_KEY", ""),
+			Timeout:  timeout,
+		}))
+	default:
+		logger.Fatal("Invalid OCR_PROVIDER", zap.String("provider", provider))
+	}
+
+	return extractors
+}
+
+// newEmbeddingService construye el servicio de vectores según EMBEDDINGS_PROVIDER: "openai" usa la
+// API de OpenAI, "http" un servidor local compatible (Ollama, llama.cpp, vLLM) y vacío deshabilita
+// la búsqueda semántica
+func newEmbeddingService(logger *zap.Logger) ports.EmbeddingService {
+	config := embeddings.HTTPConfig{
+		Endpoint:   getEnv("EMBEDDINGS_API_URL", ""),
+		APIKey:     getEnv("EMBEDDINGS_API_KEY", ""),
+		Model:      getEnv("EMBEDDINGS_MODEL", ""),
+		Dimensions: getEnvInt(logger, "EMBEDDINGS_DIMENSIONS", 0),
+		Timeout:    getEnvDuration(logger, "EMBEDDINGS_TIMEOUT", 30*time.Second),
+	}
+
+	switch provider := getEnv("EMBEDDINGS_PROVIDER", ""); provider {
+	case "":
+		return nil
+	case "openai":
+		if config.APIKey == "" {
+			logger.Fatal("EMBEDDINGS_API_KEY is required when EMBEDDINGS_PROVIDER is openai")
+		}
+		if config.Model == "" {
+			config.Model = "text-embedding-3-small"
+		}
+	case "http":
+		if config.Endpoint == "" || config.Model == "" {
+			logger.Fatal("EMBEDDINGS_API_URL and EMBEDDINGS_MODEL are required when EMBEDDINGS_PROVIDER is http")
+		}
+	default:
+		logger.Fatal("Invalid EMBEDDINGS_PROVIDER", zap.String("provider", provider))
+	}
+
+	return embeddings.NewHTTPEmbedder(config)
+}
+
+// newIdeaClassifier construye el clasificador de ideas según CLASSIFIER_PROVIDER: vacío o "keywords"
+// usa las palabras clave, "http" un modelo externo que recurre a las palabras clave cuando falla y
+// "none" deshabilita las sugerencias
+func newIdeaClassifier(logger *zap.Logger, breakers *circuitbreaker.Registry) ports.IdeaClassifier {
+	keywords := classification.NewKeywordClassifier(classification.KeywordConfig{})
+
+	switch provider := getEnv("CLASSIFIER_PROVIDER", "keywords"); provider {
+	case "", "keywords":
+		return keywords
+	case "http":
+		endpoint := getEnv("CLASSIFIER_API_URL", "")
+		if endpoint == "" {
+			logger.Fatal("CLASSIFIER_API_URL is required when CLASSIFIER_PROVIDER is http")
+		}
+		model := classification.NewHTTPClassifier(classification.HTTPConfig{
+			Endpoint: endpoint,
+			APIKey:   getEnv("CLASSIFIER_API_KEY", ""),
+			Timeout:  getEnvDuration(logger, "CLASSIFIER_TIMEOUT", 5*time.Second),
+		})
+		return classification.Fallback{
+			Primary:   circuitbreaker.NewIdeaClassifier(model, breakers.Get(circuitbreaker.BreakerConfig{Name: "classifier"})),
+			Secondary: keywords,
+		}
+	case "none":
+		return nil
+	default:
+		logger.Fatal("Invalid CLASSIFIER_PROVIDER", zap.String("provider", provider))
+		return nil
+	}
+}
+
+// loadModerationPolicies lee MODERATION_POLICY_FILE, un JSON con la política por defecto, la de
+// cada organización y sus miembros; sin archivo devuelve nil
+func loadModerationPolicies(logger *zap.Logger) *usecases.ModerationPolicies {
+	path := getEnv("MODERATION_POLICY_FILE", "")
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logger.Fatal("Failed to read moderation policies", zap.Error(err))
+	}
+	var policies usecases.ModerationPolicies
+	if err := json.Unmarshal(data, &policies); err != nil {
+		logger.Fatal("Invalid moderation policies", zap.String("path", path), zap.Error(err))
+	}
+	return &policies
+}
+
+// newModeration construye la moderación de contenidos con policies; sin políticas no se revisa nada.
+// MODERATION_WORDLIST_FILE reemplaza la lista de términos bloqueados (uno por línea) y con
+// MODERATION_PROVIDER "http" las organizaciones con use_external se revisan con un servicio externo
+// que recurre a la lista cuando falla
+func newModeration(logger *zap.Logger, policies *usecases.ModerationPolicies, breakers *circuitbreaker.Registry, rejectionRepo ports.ModerationRejectionRepository, clock entities.Clock, ids entities.IDGenerator) *usecases.ModerationUseCases {
+	if policies == nil {
+		return nil
+	}
+
+	var wordlist moderation.WordlistConfig
+	if wordlistPath := getEnv("MODERATION_WORDLIST_FILE", ""); wordlistPath != "" {
+		data, err := os.ReadFile(wordlistPath)
+		if err != nil {
+			logger.Fatal("Failed to read moderation wordlist", zap.Error(err))
+		}
+		wordlist.Terms = []string{}
+		for _, line := range strings.Split(string(data), "\n") {
+			if term := strings.TrimSpace(line); term != "" && !strings.HasPrefix(term, "#") {
+				wordlist.Terms = append(wordlist.Terms, term)
+			}
+		}
+	}
+
+	var options []usecases.ModerationOption
+	switch provider := getEnv("MODERATION_PROVIDER", "wordlist"); provider {
+	case "", "wordlist":
+	case "http":
+		endpoint := getEnv("MODERATION_API_URL", "")
+		if endpoint == "" {
+			logger.Fatal("MODERATION_API_URL is required when MODERATION_PROVIDER is http")
+		}
+		external := moderation.NewHTTPModerator(moderation.HTTPConfig{
+			Endpoint: endpoint,
+			APIKey:   getEnv("MODERATION_API_KEY", ""),
+			Timeout:  getEnvDuration(logger, "MODERATION_TIMEOUT", 5*time.Second),
+		})
+		options = append(options, usecases.WithExternalModerator(
+			circuitbreaker.NewContentModerator(external, breakers.Get(circuitbreaker.BreakerConfig{Name: "moderation"})),
+		))
+	default:
+		logger.Fatal("Invalid MODERATION_PROVIDER", zap.String("provider", provider))
+	}
+
+	logger.Info("Content moderation enabled", zap.Int("organizations", len(policies.Organizations)))
+	return usecases.NewModerationUseCases(moderation.NewWordlistModerator(wordlist), *policies, rejectionRepo, clock, ids, options...)
+}
+
+// priorityRuleConfig es una regla de prioridad en el archivo IDEA_PRIORITY_RULES_FILE, con las
+// duraciones en el formato de time.ParseDuration y los estados por nombre
+type priorityRuleConfig struct {
+	Name           string   `json:"name"`
+	Statuses       []string `json:"statuses"`
+	MinAge         string   `json:"min_age"`
+	IdleFor        string   `json:"idle_for"`
+	DeadlineWithin string   `json:"deadline_within"`
+	Delta          int32    `json:"delta"`
+	Limit          int32    `json:"limit"`
+}
+
+var priorityRuleStatuses = map[string]entities.IdeaStatus{
+	"draft":   entities.IdeaStatusDraft,
+	"active":  entities.IdeaStatusActive,
+	"on_hold": entities.IdeaStatusOnHold,
+}
+
+// ideaPriorityRules carga las reglas de envejecimiento de prioridades de IDEA_PRIORITY_RULES_FILE,
+// un arreglo JSON de reglas; sin archivo se usan las reglas por defecto y un arreglo vacío las desactiva
+func ideaPriorityRules(logger *zap.Logger) []entities.PriorityRule {
+	path := getEnv("IDEA_PRIORITY_RULES_FILE", "")
+	if path == "" {
+		return usecases.DefaultPriorityRules
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logger.Fatal("Failed to read idea priority rules", zap.Error(err))
+	}
+	var configs []priorityRuleConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		logger.Fatal("Invalid idea priority rules", zap.String("path", path), zap.Error(err))
+	}
+
+	parseDuration := func(rule, field, value string) time.Duration {
+		if value == "" {
+			return 0
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Fatal("Invalid duration in idea priority rule", zap.String("rule", rule), zap.String("field", field), zap.Error(err))
+		}
+		return duration
+	}
+
+	rules := make([]entities.PriorityRule, len(configs))
+	for i, config := range configs {
+		rules[i] = entities.PriorityRule{
+			Name:           config.Name,
+			MinAge:         parseDuration(config.Name, "min_age", config.MinAge),
+			IdleFor:        parseDuration(config.Name, "idle_for", config.IdleFor),
+			DeadlineWithin: parseDuration(config.Name, "deadline_within", config.DeadlineWithin),
+			Delta:          config.Delta,
+			Limit:          config.Limit,
+		}
+		for _, name := range config.Statuses {
+			status, ok := priorityRuleStatuses[name]
+			if !ok {
+				logger.Fatal("Invalid status in idea priority rule", zap.String("rule", config.Name), zap.String("status", name))
+			}
+			rules[i].Statuses = append(rules[i].Statuses, status)
+		}
+	}
+	return rules
+}
+
+// connectionOptions configura keepalive, antigüedad máxima de conexión y tamaño de mensajes.
+// Los pings del servidor mantienen vivas las conexiones de clientes móviles detrás de NAT,
+// y al superar la antigüedad máxima se envía GOAWAY dejando terminar los streams en curso.
+func connectionOptions(logger *zap.Logger) []grpc.ServerOption {
+	serverParameters := keepalive.ServerParameters{
+		MaxConnectionIdle:     getEnvDuration(logger, "GRPC_MAX_CONNECTION_IDLE", 15*time.Minute),
+		MaxConnectionAge:      getEnvDuration(logger, "GRPC_MAX_CONNECTION_AGE", 30*time.Minute),
+		MaxConnectionAgeGrace: getEnvDuration(logger, "GRPC_MAX_CONNECTION_AGE_GRACE", 5*time.Minute),
+		Time:                  getEnvDuration(logger, "GRPC_KEEPALIVE_TIME", 30*time.Second),
+		Timeout:               getEnvDuration(logger, "GRPC_KEEPALIVE_TIMEOUT", 10*time.Second),
+	}
+	enforcementPolicy := keepalive.EnforcementPolicy{
+		MinTime:             getEnvDuration(logger, "GRPC_KEEPALIVE_MIN_TIME", 10*time.Second),
+		PermitWithoutStream: getEnvBool(logger, "GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM", true),
+	}
+
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(serverParameters),
+		grpc.KeepaliveEnforcementPolicy(enforcementPolicy),
+		grpc.MaxRecvMsgSize(getEnvInt(logger, "GRPC_MAX_RECV_MSG_SIZE", 16<<20)),
+		grpc.MaxSendMsgSize(getEnvInt(logger, "GRPC_MAX_SEND_MSG_SIZE", 16<<20)),
+		grpc.MaxConcurrentStreams(uint32(getEnvInt(logger, "GRPC_MAX_CONCURRENT_STREAMS", 100))),
+	}
+}
+
 // getEnv obtiene una variable de entorno con un valor por defecto
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// getEnvDuration obtiene una duración (por ejemplo "30s") de una variable de entorno
+func getEnvDuration(logger *zap.Logger, key string, defaultValue time.Duration) time.Duration {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		logger.Fatal("Invalid duration in "+key, zap.Error(err))
+	}
+	return duration
+}
+
+// getEnvInt obtiene un entero no negativo de una variable de entorno
+func getEnvInt(logger *zap.Logger, key string, defaultValue int) int {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		logger.Fatal("Invalid integer in "+key, zap.String("value", value))
+	}
+	return number
+}
+
+// getEnvFloat obtiene un número decimal no negativo de una variable de entorno
+func getEnvFloat(logger *zap.Logger, key string, defaultValue float64) float64 {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		logger.Fatal("Invalid number in "+key, zap.String("value", value))
+	}
+	return number
+}
+
+// getEnvList obtiene una lista separada por comas de una variable de entorno; "*" la deja vacía
+func getEnvList(key string, defaultValue []string) []string {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" && item != "*" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvBool obtiene un booleano de una variable de entorno
+func getEnvBool(logger *zap.Logger, key string, defaultValue bool) bool {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.Fatal("Invalid boolean in "+key, zap.Error(err))
+	}
+	return enabled
+}
@@ -0,0 +1,262 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// BoardColumns son los estados que se muestran como columnas del tablero, en orden
+var BoardColumns = []entities.IdeaStatus{
+	entities.IdeaStatusDraft,
+	entities.IdeaStatusActive,
+	entities.IdeaStatusOnHold,
+	entities.IdeaStatusCompleted,
+	entities.IdeaStatusArchived,
+}
+
+// BoardColumn es una columna del tablero con sus ideas ordenadas por posición
+type BoardColumn struct {
+	Status     entities.IdeaStatus
+	Ideas      []*entities.Idea
+	TotalCount int
+}
+
+// BoardUseCases presenta las ideas de un usuario como un tablero Kanban con una columna por estado
+type BoardUseCases struct {
+	ideaRepo        ports.IdeaRepository
+	uow             ports.UnitOfWork
+	notificationSvc ports.NotificationService
+	eventBus        ports.EventBus
+	clock           entities.Clock
+	ids             entities.IDGenerator
+}
+
+// NewBoardUseCases crea una nueva instancia de BoardUseCases
+func NewBoardUseCases(ideaRepo ports.IdeaRepository, uow ports.UnitOfWork, notificationSvc ports.NotificationService, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *BoardUseCases {
+	return &BoardUseCases{
+		ideaRepo:        ideaRepo,
+		uow:             uow,
+		notificationSvc: notificationSvc,
+		eventBus:        eventBus,
+		clock:           clock,
+		ids:             ids,
+	}
+}
+
+// GetBoard devuelve las columnas del tablero con hasta pageSize ideas cada una (0 para todas)
+func (uc *BoardUseCases) GetBoard(ctx context.Context, userID uuid.UUID, pageSize int) ([]BoardColumn, error) {
+	columns := make([]BoardColumn, len(BoardColumns))
+	for i, status := range BoardColumns {
+		ideas, totalCount, err := uc.ideaRepo.GetByUserID(ctx, userID, ports.IdeaFilters{
+			Status:   status,
+			Page:     1,
+			PageSize: pageSize,
+			Sort:     []entities.SortField{{Field: "position"}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		sortByPosition(ideas)
+		columns[i] = BoardColumn{Status: status, Ideas: ideas, TotalCount: totalCount}
+	}
+	return columns, nil
+}
+
+// MoveIdea mueve una idea a la columna de status, justo después de afterID o justo antes de
+// beforeID (uuid.Nil en ambos la deja al final). El estado y la posición se guardan en la misma
+// transacción; si la columna no deja lugar entre los vecinos se renumera entera.
+func (uc *BoardUseCases) MoveIdea(ctx context.Context, id, userID uuid.UUID, status entities.IdeaStatus, afterID, beforeID uuid.UUID, expectedVersion int64) (*entities.Idea, error) {
+	if !isBoardColumn(status) {
+		return nil, entities.ErrInvalidBoardColumn
+	}
+	
+	var idea *entities.Idea
+	var oldStatus entities.IdeaStatus
+	var renumbered []*entities.Idea
+	err := runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		var err error
+		idea, err = tx.Ideas().GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !idea.IsOwnedBy(userID) {
+			return entities.ErrIdeaUnauthorized
+		}
+		if !idea.HasVersion(expectedVersion) {
+			return entities.ErrVersionConflict
+		}
+		oldStatus = idea.Status
+	
+		column, _, err := tx.Ideas().GetByUserID(ctx, userID, ports.IdeaFilters{Status: status, Sort: []entities.SortField{{Field: "position"}}})
+		if err != nil {
+			return err
+		}
+		sortByPosition(column)
+		column = removeIdea(column, id)
+	
+		index, err := insertIndex(column, afterID, beforeID)
+		if err != nil {
+			return err
+		}
+	
+		now := uc.clock.Now()
+		var prev, next string
+		if index > 0 {
+			prev = column[index-1].Position
+		}
+		if index < len(column) {
+			next = column[index].Position
+		}
+	
+		position, err := entities.PositionBetween(prev, next)
+		if err != nil || (index < len(column) && next == "") {
+			// Los vecinos no tienen posiciones válidas o distintas: renumerar toda la columna
+			positions := entities.SpreadPositions(len(column) + 1)
+			position = positions[index]
+			for i, other := range column {
+				if i >= index {
+					i++
+				}
+				if other.Position == positions[i] {
+					continue
+				}
+				other.MoveTo(other.Status, positions[i], now)
+				if err := tx.Ideas().Update(ctx, other); err != nil {
+					return err
+				}
+				renumbered = append(renumbered, other)
+			}
+		}
+	
+		idea.MoveTo(status, position, now)
+		return tx.Ideas().Update(ctx, idea)
+	})
+	if err != nil {
+		if err == entities.ErrVersionConflict && idea != nil {
+			// Devolver el estado actual para que el cliente pueda reintentar sobre él
+			if latest, getErr := uc.ideaRepo.GetByID(ctx, id); getErr == nil {
+				return latest, err
+			}
+		}
+		return nil, err
+	}
+	
+	// Publicar evento de idea movida
+	if uc.eventBus != nil {
+		event := &IdeaMovedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      idea.ID,
+			UserID:      userID,
+			OldStatus:   oldStatus,
+			NewStatus:   idea.Status,
+			Position:    idea.Position,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	// Avisar a los tableros abiertos de la idea movida y de las que cambiaron de posición
+	for _, other := range renumbered {
+		uc.notifyMove(ctx, other, other.Status)
+	}
+	uc.notifyMove(ctx, idea, oldStatus)
+	
+	return idea, nil
+}
+
+func (uc *BoardUseCases) notifyMove(ctx context.Context, idea *entities.Idea, previousStatus entities.IdeaStatus) {
+	if uc.notificationSvc == nil {
+		return
+	}
+	
+	uc.notificationSvc.SendNotification(
+		ctx,
+		idea.UserID,
+		idea.Title,
+		"",
+		"board_idea_moved",
+		[]string{"board"},
+		map[string]string{
+			"idea_id":         idea.ID.String(),
+			"status":          strconv.Itoa(int(idea.Status)),
+			"previous_status": strconv.Itoa(int(previousStatus)),
+			"position":        idea.Position,
+			"version":         strconv.FormatInt(idea.Version, 10),
+		},
+	)
+}
+
+func isBoardColumn(status entities.IdeaStatus) bool {
+	for _, column := range BoardColumns {
+		if column == status {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByPosition ordena una columna por posición; las ideas sin posición van primero, por fecha de creación
+func sortByPosition(ideas []*entities.Idea) {
+	sort.SliceStable(ideas, func(i, j int) bool {
+		if ideas[i].Position != ideas[j].Position {
+			return ideas[i].Position < ideas[j].Position
+		}
+		return ideas[i].CreatedAt.Before(ideas[j].CreatedAt)
+	})
+}
+
+func removeIdea(ideas []*entities.Idea, id uuid.UUID) []*entities.Idea {
+	for i, idea := range ideas {
+		if idea.ID == id {
+			return append(ideas[:i], ideas[i+1:]...)
+		}
+	}
+	return ideas
+}
+
+// insertIndex devuelve el índice de la columna donde insertar la idea. Los vecinos deben estar en
+// la columna y, si se indican los dos, ser contiguos.
+func insertIndex(column []*entities.Idea, afterID, beforeID uuid.UUID) (int, error) {
+	indexOf := func(id uuid.UUID) int {
+		for i, idea := range column {
+			if idea.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	
+	switch {
+	case afterID != uuid.Nil:
+		after := indexOf(afterID)
+		if after < 0 {
+			return 0, entities.ErrInvalidBoardPosition
+		}
+		if beforeID != uuid.Nil && indexOf(beforeID) != after+1 {
+			return 0, entities.ErrInvalidBoardPosition
+		}
+		return after + 1, nil
+	case beforeID != uuid.Nil:
+		before := indexOf(beforeID)
+		if before < 0 {
+			return 0, entities.ErrInvalidBoardPosition
+		}
+		return before, nil
+	default:
+		return len(column), nil
+	}
+}
+
+// Events
+type IdeaMovedEvent struct {
+	entities.EventHeader
+	IdeaID    uuid.UUID
+	UserID    uuid.UUID
+	OldStatus entities.IdeaStatus
+	NewStatus entities.IdeaStatus
+	Position  string
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// MaxBulkTagIdeas es el máximo de ideas que una operación masiva de etiquetas puede alcanzar;
+// por encima hay que acotar el filtro
+const MaxBulkTagIdeas = 1000
+
+// BulkTagUseCases añade o quita una etiqueta en todas las ideas de un usuario que cumplen un
+// filtro, en una sola transacción
+type BulkTagUseCases struct {
+	uow      ports.UnitOfWork
+	eventBus ports.EventBus
+	clock    entities.Clock
+	ids      entities.IDGenerator
+}
+
+// NewBulkTagUseCases crea una nueva instancia de BulkTagUseCases
+func NewBulkTagUseCases(uow ports.UnitOfWork, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *BulkTagUseCases {
+	return &BulkTagUseCases{
+		uow:      uow,
+		eventBus: eventBus,
+		clock:    clock,
+		ids:      ids,
+	}
+}
+
+// BulkTagIdeas añade tag a las ideas del usuario que cumplen filters y, si ideaIDs no está vacío,
+// están entre ellas. Devuelve cuántas ideas cambiaron; las que ya tenían la etiqueta no cuentan.
+func (uc *BulkTagUseCases) BulkTagIdeas(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters, ideaIDs []uuid.UUID, tag string) (int, error) {
+	return uc.apply(ctx, userID, filters, ideaIDs, tag, true)
+}
+
+// BulkUntagIdeas quita tag de las ideas del usuario que cumplen filters y, si ideaIDs no está
+// vacío, están entre ellas. Devuelve cuántas ideas cambiaron.
+func (uc *BulkTagUseCases) BulkUntagIdeas(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters, ideaIDs []uuid.UUID, tag string) (int, error) {
+	return uc.apply(ctx, userID, filters, ideaIDs, tag, false)
+}
+
+func (uc *BulkTagUseCases) apply(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters, ideaIDs []uuid.UUID, tag string, add bool) (int, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return 0, entities.ErrInvalidTag
+	}
+	
+	if err := validateCustomFieldFilters(filters.CustomFields); err != nil {
+		return 0, err
+	}
+	
+	// Se recorren todas las ideas que cumplen el filtro, no una página
+	filters.Page = 0
+	filters.PageSize = 0
+	
+	var changed []uuid.UUID
+	err := runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		ideas, _, err := tx.Ideas().GetByUserID(ctx, userID, filters)
+		if err != nil {
+			return err
+		}
+		ideas = selectIdeas(ideas, ideaIDs)
+		if len(ideas) > MaxBulkTagIdeas {
+			return entities.ErrTooManyIdeasForBulk
+		}
+	
+		now := uc.clock.Now()
+		for _, idea := range ideas {
+			var modified bool
+			if add {
+				modified = idea.AddTag(tag, now)
+			} else {
+				modified = idea.RemoveTag(tag, now)
+			}
+			if !modified {
+				continue
+			}
+			if err := tx.Ideas().Update(ctx, idea); err != nil {
+				return err
+			}
+			changed = append(changed, idea.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	
+	// Publicar evento de etiquetado masivo
+	if uc.eventBus != nil && len(changed) > 0 {
+		event := &IdeasBulkTaggedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			UserID:      userID,
+			Tag:         tag,
+			Added:       add,
+			IdeaIDs:     changed,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return len(changed), nil
+}
+
+// selectIdeas deja solo las ideas cuyo ID está en ids; sin ids las deja todas
+func selectIdeas(ideas []*entities.Idea, ids []uuid.UUID) []*entities.Idea {
+	if len(ids) == 0 {
+		return ideas
+	}
+	wanted := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	selected := ideas[:0]
+	for _, idea := range ideas {
+		if wanted[idea.ID] {
+			selected = append(selected, idea)
+		}
+	}
+	return selected
+}
+
+// Events
+type IdeasBulkTaggedEvent struct {
+	entities.EventHeader
+	UserID uuid.UUID
+	Tag    string
+	// Added es falso si la etiqueta se quitó
+	Added   bool
+	IdeaIDs []uuid.UUID
+}
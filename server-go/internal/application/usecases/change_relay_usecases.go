@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ChangeRelayUseCases reenvía los cambios de entidades a los dispositivos del usuario
+type ChangeRelayUseCases struct {
+	changeFeed      ports.ChangeFeed
+	notificationSvc ports.NotificationService
+}
+
+// NewChangeRelayUseCases crea una nueva instancia de ChangeRelayUseCases
+func NewChangeRelayUseCases(changeFeed ports.ChangeFeed, notificationSvc ports.NotificationService) *ChangeRelayUseCases {
+	return &ChangeRelayUseCases{
+		changeFeed:      changeFeed,
+		notificationSvc: notificationSvc,
+	}
+}
+
+// Run consume el flujo de cambios hasta que se cancele el contexto
+func (uc *ChangeRelayUseCases) Run(ctx context.Context) error {
+	changes, err := uc.changeFeed.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	
+	for change := range changes {
+		if change.UserID == uuid.Nil {
+			continue
+		}
+		
+		metadata := map[string]string{
+			"table":     change.Table,
+			"operation": change.Operation,
+			"entity_id": change.EntityID.String(),
+			"version":   strconv.FormatInt(change.Version, 10),
+		}
+		
+		// Los errores de entrega no detienen el relay; el dispositivo se resincroniza en la próxima conexión
+		uc.notificationSvc.SendNotification(
+			ctx,
+			change.UserID,
+			"Sync",
+			fmt.Sprintf("%s %s", change.Table, change.Operation),
+			"sync",
+			[]string{"sync"},
+			metadata,
+		)
+	}
+	
+	return ctx.Err()
+}
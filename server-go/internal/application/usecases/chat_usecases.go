@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// DefaultChatBindingCodeTTL es la vigencia del código que el usuario envía desde el chat para vincularlo
+const DefaultChatBindingCodeTTL = 15 * time.Minute
+
+// ChatActionType identifica una acción interactiva pulsada en un mensaje de Slack o de Telegram
+type ChatActionType string
+
+const (
+	// ChatActionCompleteReminder completa el recordatorio de la notificación
+	ChatActionCompleteReminder ChatActionType = "complete_reminder"
+)
+
+// ChatAction es una acción interactiva recibida desde un chat vinculado
+type ChatAction struct {
+	Type     ChatActionType
+	TargetID uuid.UUID
+}
+
+// ChatUseCases contiene los casos de uso para vincular chats de Slack y de Telegram y para
+// atender las acciones que los usuarios pulsan en las notificaciones que reciben en ellos
+type ChatUseCases struct {
+	bindingRepo ports.ChatBindingRepository
+	reminders   *ReminderUseCases
+	eventBus    ports.EventBus
+	clock       entities.Clock
+	ids         entities.IDGenerator
+}
+
+// NewChatUseCases crea una nueva instancia de ChatUseCases
+func NewChatUseCases(bindingRepo ports.ChatBindingRepository, reminders *ReminderUseCases, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ChatUseCases {
+	return &ChatUseCases{
+		bindingRepo: bindingRepo,
+		reminders:   reminders,
+		eventBus:    eventBus,
+		clock:       clock,
+		ids:         ids,
+	}
+}
+
+// StartBinding crea un vínculo pendiente y devuelve el código, que el usuario debe enviar desde el
+// chat antes de DefaultChatBindingCodeTTL
+func (uc *ChatUseCases) StartBinding(ctx context.Context, userID uuid.UUID, provider entities.ChatProvider) (*entities.ChatBinding, string, error) {
+	binding, code, err := entities.NewChatBinding(uc.clock, uc.ids, userID, provider, DefaultChatBindingCodeTTL)
+	if err != nil {
+		return nil, "", err
+	}
+	
+	if err := binding.Validate(); err != nil {
+		return nil, "", err
+	}
+	
+	if err := uc.bindingRepo.Create(ctx, binding); err != nil {
+		return nil, "", err
+	}
+	
+	return binding, code, nil
+}
+
+// CompleteBinding confirma el vínculo pendiente con el código recibido desde chatID. Si el chat ya
+// estaba vinculado al mismo usuario se descarta el pendiente y se devuelve el existente.
+func (uc *ChatUseCases) CompleteBinding(ctx context.Context, provider entities.ChatProvider, code, chatID string) (*entities.ChatBinding, error) {
+	binding, err := uc.bindingRepo.GetPendingByCodeHash(ctx, provider, entities.HashChatBindingCode(code))
+	if err != nil {
+		return nil, err
+	}
+	
+	now := uc.clock.Now()
+	if binding.IsCodeExpired(now) {
+		uc.bindingRepo.Delete(ctx, binding.ID)
+		return nil, entities.ErrChatBindingCodeExpired
+	}
+	
+	existing, err := uc.bindingRepo.GetByChat(ctx, provider, chatID)
+	switch {
+	case err == nil && existing.IsOwnedBy(binding.UserID):
+		uc.bindingRepo.Delete(ctx, binding.ID)
+		return existing, nil
+	case err == nil:
+		return nil, entities.ErrChatAlreadyBound
+	case !errors.Is(err, entities.ErrChatBindingNotFound):
+		return nil, err
+	}
+	
+	binding.Link(chatID, now)
+	if err := uc.bindingRepo.Link(ctx, binding); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de chat vinculado
+	if uc.eventBus != nil {
+		event := &ChatBindingLinkedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, binding.UserID),
+			BindingID:   binding.ID,
+			UserID:      binding.UserID,
+			Provider:    binding.Provider,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return binding, nil
+}
+
+// ListBindings obtiene los vínculos de un usuario, incluidos los pendientes
+func (uc *ChatUseCases) ListBindings(ctx context.Context, userID uuid.UUID) ([]*entities.ChatBinding, error) {
+	return uc.bindingRepo.ListByUserID(ctx, userID)
+}
+
+// GetBindingByChat obtiene el vínculo confirmado de un chat
+func (uc *ChatUseCases) GetBindingByChat(ctx context.Context, provider entities.ChatProvider, chatID string) (*entities.ChatBinding, error) {
+	return uc.bindingRepo.GetByChat(ctx, provider, chatID)
+}
+
+// DeleteBinding elimina un vínculo del usuario; el chat deja de recibir sus notificaciones
+func (uc *ChatUseCases) DeleteBinding(ctx context.Context, id, userID uuid.UUID) error {
+	binding, err := uc.bindingRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	
+	if !binding.IsOwnedBy(userID) {
+		return entities.ErrChatBindingUnauthorized
+	}
+	
+	return uc.delete(ctx, binding)
+}
+
+// Unbind elimina el vínculo de un chat a petición de quien escribe en él
+func (uc *ChatUseCases) Unbind(ctx context.Context, provider entities.ChatProvider, chatID string) error {
+	binding, err := uc.bindingRepo.GetByChat(ctx, provider, chatID)
+	if err != nil {
+		return err
+	}
+	
+	return uc.delete(ctx, binding)
+}
+
+// HandleAction ejecuta en nombre del dueño del chat la acción pulsada en una notificación
+func (uc *ChatUseCases) HandleAction(ctx context.Context, provider entities.ChatProvider, chatID string, action ChatAction) (*entities.Reminder, error) {
+	binding, err := uc.bindingRepo.GetByChat(ctx, provider, chatID)
+	if err != nil {
+		return nil, err
+	}
+	
+	ctx = entities.ContextWithEventActor(ctx, binding.UserID)
+	switch action.Type {
+	case ChatActionCompleteReminder:
+		return uc.reminders.CompleteReminder(ctx, action.TargetID, binding.UserID, 0)
+	default:
+		return nil, entities.ErrUnsupportedChatAction
+	}
+}
+
+func (uc *ChatUseCases) delete(ctx context.Context, binding *entities.ChatBinding) error {
+	if err := uc.bindingRepo.Delete(ctx, binding.ID); err != nil {
+		return err
+	}
+	
+	// Publicar evento de vínculo eliminado
+	if uc.eventBus != nil && binding.IsLinked() {
+		event := &ChatBindingDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, binding.UserID),
+			BindingID:   binding.ID,
+			UserID:      binding.UserID,
+			Provider:    binding.Provider,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// Events
+type ChatBindingLinkedEvent struct {
+	entities.EventHeader
+	BindingID uuid.UUID
+	UserID    uuid.UUID
+	Provider  entities.ChatProvider
+}
+
+type ChatBindingDeletedEvent struct {
+	entities.EventHeader
+	BindingID uuid.UUID
+	UserID    uuid.UUID
+	Provider  entities.ChatProvider
+}
@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testChatID = "C0123456"
+
+func newTestChatUseCases(t *testing.T) (*ChatUseCases, *mocks.ChatBindingRepository, *mocks.ReminderRepository) {
+	bindings := mocks.NewChatBindingRepository(t)
+	reminderRepo := mocks.NewReminderRepository(t)
+	reminders := newTestReminderUseCases(reminderRepo, nil, nil)
+	return NewChatUseCases(bindings, reminders, nil, entities.NewFakeClock(testNow), &entities.SequentialIDGenerator{}), bindings, reminderRepo
+}
+
+func linkedChatBindingFixture(userID uuid.UUID) *entities.ChatBinding {
+	linkedAt := testNow.Add(-time.Hour)
+	return &entities.ChatBinding{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Provider: entities.ChatProviderSlack,
+		ChatID:   testChatID,
+		LinkedAt: &linkedAt,
+	}
+}
+
+func TestHandleAction_CompletesReminderOfChatOwner(t *testing.T) {
+	// Arrange
+	useCase, bindings, reminderRepo := newTestChatUseCases(t)
+	userID := uuid.New()
+	reminder := pastDueReminderFixture(userID)
+
+	bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, testChatID).Return(linkedChatBindingFixture(userID), nil)
+	reminderRepo.On("GetByID", mock.Anything, reminder.ID).Return(reminder, nil)
+	reminderRepo.On("Update", mock.Anything, reminder).Return(nil)
+
+	// Act
+	completed, err := useCase.HandleAction(context.Background(), entities.ChatProviderSlack, testChatID, ChatAction{
+		Type:     ChatActionCompleteReminder,
+		TargetID: reminder.ID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReminderStatusCompleted, completed.Status)
+}
+
+func TestHandleAction_ReminderOfAnotherUser(t *testing.T) {
+	// Arrange
+	useCase, bindings, reminderRepo := newTestChatUseCases(t)
+	reminder := pastDueReminderFixture(uuid.New())
+
+	bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, testChatID).Return(linkedChatBindingFixture(uuid.New()), nil)
+	reminderRepo.On("GetByID", mock.Anything, reminder.ID).Return(reminder, nil)
+
+	// Act
+	_, err := useCase.HandleAction(context.Background(), entities.ChatProviderSlack, testChatID, ChatAction{
+		Type:     ChatActionCompleteReminder,
+		TargetID: reminder.ID,
+	})
+
+	// Assert
+	assert.Equal(t, entities.ErrReminderUnauthorized, err)
+	reminderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestHandleAction_UnsupportedAction(t *testing.T) {
+	// Arrange
+	useCase, bindings, reminderRepo := newTestChatUseCases(t)
+	bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, testChatID).Return(linkedChatBindingFixture(uuid.New()), nil)
+
+	// Act
+	_, err := useCase.HandleAction(context.Background(), entities.ChatProviderSlack, testChatID, ChatAction{
+		Type:     "delete_reminder",
+		TargetID: uuid.New(),
+	})
+
+	// Assert
+	assert.Equal(t, entities.ErrUnsupportedChatAction, err)
+	reminderRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestHandleAction_UnboundChat(t *testing.T) {
+	// Arrange
+	useCase, bindings, reminderRepo := newTestChatUseCases(t)
+	bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, testChatID).Return(nil, entities.ErrChatBindingNotFound)
+
+	// Act
+	_, err := useCase.HandleAction(context.Background(), entities.ChatProviderSlack, testChatID, ChatAction{
+		Type:     ChatActionCompleteReminder,
+		TargetID: uuid.New(),
+	})
+
+	// Assert
+	assert.Equal(t, entities.ErrChatBindingNotFound, err)
+	reminderRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestCompleteBinding_ExpiredCodeIsDiscarded(t *testing.T) {
+	// Arrange
+	useCase, bindings, _ := newTestChatUseCases(t)
+	pending := &entities.ChatBinding{ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderSlack, CodeExpiresAt: testNow}
+
+	bindings.On("GetPendingByCodeHash", mock.Anything, entities.ChatProviderSlack, entities.HashChatBindingCode("abcd")).Return(pending, nil)
+	bindings.On("Delete", mock.Anything, pending.ID).Return(nil)
+
+	// Act
+	binding, err := useCase.CompleteBinding(context.Background(), entities.ChatProviderSlack, "abcd", testChatID)
+
+	// Assert
+	assert.Equal(t, entities.ErrChatBindingCodeExpired, err)
+	assert.Nil(t, binding)
+	bindings.AssertNotCalled(t, "Link", mock.Anything, mock.Anything)
+}
+
+func TestCompleteBinding_ChatBoundToAnotherUser(t *testing.T) {
+	// Arrange
+	useCase, bindings, _ := newTestChatUseCases(t)
+	pending := &entities.ChatBinding{ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderSlack, CodeExpiresAt: testNow.Add(time.Minute)}
+
+	bindings.On("GetPendingByCodeHash", mock.Anything, entities.ChatProviderSlack, entities.HashChatBindingCode("ABCD")).Return(pending, nil)
+	bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, testChatID).Return(linkedChatBindingFixture(uuid.New()), nil)
+
+	// Act
+	binding, err := useCase.CompleteBinding(context.Background(), entities.ChatProviderSlack, "ABCD", testChatID)
+
+	// Assert
+	assert.Equal(t, entities.ErrChatAlreadyBound, err)
+	assert.Nil(t, binding)
+	bindings.AssertNotCalled(t, "Link", mock.Anything, mock.Anything)
+	bindings.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
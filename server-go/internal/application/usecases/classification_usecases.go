@@ -0,0 +1,150 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// autoTagBatchSize es el número de ideas recorridas por consulta al aplicar las sugerencias
+const autoTagBatchSize = 100
+
+// ClassificationThresholds define qué sugerencias se muestran y cuáles se aplican solas
+type ClassificationThresholds struct {
+	// Suggest es la confianza mínima de lo que se propone al crear o editar una idea
+	Suggest float64
+	// Apply es la confianza mínima de lo que AutoTagIdeas guarda sin preguntar
+	Apply float64
+	// MaxTags es el máximo de etiquetas propuestas o aplicadas por idea
+	MaxTags int
+}
+
+// DefaultClassificationThresholds solo aplican solas las sugerencias bastante seguras
+var DefaultClassificationThresholds = ClassificationThresholds{Suggest: 0.5, Apply: 0.8, MaxTags: 5}
+
+// AutoTagReport resume una ejecución de AutoTagIdeas
+type AutoTagReport struct {
+	Evaluated int
+	Tagged    int
+	// Conflicts cuenta las ideas que el usuario modificó durante la ejecución; se reevalúan en la siguiente
+	Conflicts int
+}
+
+// ClassificationUseCases contiene los casos de uso para sugerir y aplicar etiquetas y categorías
+type ClassificationUseCases struct {
+	ideaRepo   ports.IdeaRepository
+	classifier ports.IdeaClassifier
+	thresholds ClassificationThresholds
+	eventBus   ports.EventBus
+	clock      entities.Clock
+	ids        entities.IDGenerator
+}
+
+// NewClassificationUseCases crea una nueva instancia de ClassificationUseCases
+func NewClassificationUseCases(ideaRepo ports.IdeaRepository, classifier ports.IdeaClassifier, thresholds ClassificationThresholds, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ClassificationUseCases {
+	return &ClassificationUseCases{
+		ideaRepo:   ideaRepo,
+		classifier: classifier,
+		thresholds: thresholds,
+		eventBus:   eventBus,
+		clock:      clock,
+		ids:        ids,
+	}
+}
+
+// SuggestForIdea devuelve las etiquetas que la idea todavía no tiene y la categoría, si es otra,
+// que el clasificador propone con la confianza suficiente para mostrarlas
+func (uc *ClassificationUseCases) SuggestForIdea(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error) {
+	suggestion, err := uc.classifier.Classify(ctx, idea)
+	if err != nil {
+		return nil, err
+	}
+	return suggestion.For(idea, uc.thresholds.Suggest, uc.thresholds.MaxTags), nil
+}
+
+// AutoTagIdeas completa las etiquetas y la categoría de las ideas que no las tienen con las
+// sugerencias más seguras. Lo que el usuario ya eligió nunca se reemplaza, así que volver a
+// ejecutarlo no cambia las ideas ya etiquetadas.
+func (uc *ClassificationUseCases) AutoTagIdeas(ctx context.Context) (*AutoTagReport, error) {
+	report := &AutoTagReport{}
+	afterID := uuid.Nil
+	for {
+		ideas, err := uc.ideaRepo.ListByStatus(ctx, allIdeaStatuses, afterID, autoTagBatchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(ideas) == 0 {
+			return report, nil
+		}
+		afterID = ideas[len(ideas)-1].ID
+	
+		for _, idea := range ideas {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			if len(idea.Tags) > 0 && idea.Category != entities.IdeaCategoryUnspecified {
+				continue
+			}
+			report.Evaluated++
+	
+			tagged, err := uc.autoTag(ctx, idea)
+			if errors.Is(err, entities.ErrVersionConflict) || errors.Is(err, entities.ErrIdeaNotFound) {
+				report.Conflicts++
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			if tagged {
+				report.Tagged++
+			}
+		}
+	
+		if len(ideas) < autoTagBatchSize {
+			return report, nil
+		}
+	}
+}
+
+func (uc *ClassificationUseCases) autoTag(ctx context.Context, idea *entities.Idea) (bool, error) {
+	suggestion, err := uc.classifier.Classify(ctx, idea)
+	if err != nil {
+		return false, err
+	}
+	suggestion = suggestion.For(idea, uc.thresholds.Apply, uc.thresholds.MaxTags)
+	if !idea.ApplySuggestion(suggestion, uc.clock.Now()) {
+		return false, nil
+	}
+	
+	if err := uc.ideaRepo.Update(ctx, idea); err != nil {
+		return false, err
+	}
+	
+	// Publicar evento de idea etiquetada
+	if uc.eventBus != nil {
+		event := &IdeaAutoTaggedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+			IdeaID:      idea.ID,
+			UserID:      idea.UserID,
+			Tags:        idea.Tags,
+			Category:    idea.Category,
+			Classifier:  suggestion.Classifier,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return true, nil
+}
+
+// Events
+type IdeaAutoTaggedEvent struct {
+	entities.EventHeader
+	IdeaID     uuid.UUID
+	UserID     uuid.UUID
+	Tags       []string
+	Category   entities.IdeaCategory
+	Classifier string
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// CustomFieldUseCases contiene los casos de uso para los campos personalizados que cada usuario
+// define para sus ideas y su progreso. Los valores se asignan con SetIdeaCustomFields y
+// SetProgressCustomFields.
+type CustomFieldUseCases struct {
+	fieldRepo ports.CustomFieldRepository
+	eventBus  ports.EventBus
+	clock     entities.Clock
+	ids       entities.IDGenerator
+}
+
+// NewCustomFieldUseCases crea una nueva instancia de CustomFieldUseCases
+func NewCustomFieldUseCases(fieldRepo ports.CustomFieldRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *CustomFieldUseCases {
+	return &CustomFieldUseCases{
+		fieldRepo: fieldRepo,
+		eventBus:  eventBus,
+		clock:     clock,
+		ids:       ids,
+	}
+}
+
+// CreateField define un nuevo campo para las ideas o el progreso del usuario
+func (uc *CustomFieldUseCases) CreateField(ctx context.Context, userID uuid.UUID, entityType entities.CustomFieldEntity, key, name string, fieldType entities.CustomFieldType, options []string) (*entities.CustomFieldDefinition, error) {
+	definition := entities.NewCustomFieldDefinition(uc.clock, uc.ids, userID, entityType, key, name, fieldType, options)
+	
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+	
+	existing, err := uc.fieldRepo.ListByUserID(ctx, userID, entityType)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= entities.MaxCustomFieldsPerEntity {
+		return nil, entities.ErrTooManyCustomFields
+	}
+	
+	if err := uc.fieldRepo.Create(ctx, definition); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de campo creado
+	if uc.eventBus != nil {
+		event := &CustomFieldCreatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			FieldID:     definition.ID,
+			UserID:      userID,
+			EntityType:  entityType,
+			Key:         definition.Key,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return definition, nil
+}
+
+// ListFields obtiene los campos que el usuario definió para un tipo de registro
+func (uc *CustomFieldUseCases) ListFields(ctx context.Context, userID uuid.UUID, entityType entities.CustomFieldEntity) ([]*entities.CustomFieldDefinition, error) {
+	if !entityType.IsValid() {
+		return nil, entities.ErrInvalidCustomField
+	}
+	return uc.fieldRepo.ListByUserID(ctx, userID, entityType)
+}
+
+// DeleteField elimina un campo. Los registros conservan el valor que tenían, pero deja de
+// aceptarse al asignar valores, así que hay que quitarlo en la siguiente asignación.
+func (uc *CustomFieldUseCases) DeleteField(ctx context.Context, id, userID uuid.UUID) error {
+	definition, err := uc.fieldRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	
+	if !definition.IsOwnedBy(userID) {
+		return entities.ErrCustomFieldUnauthorized
+	}
+	
+	if err := uc.fieldRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	
+	// Publicar evento de campo eliminado
+	if uc.eventBus != nil {
+		event := &CustomFieldDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			FieldID:     id,
+			UserID:      userID,
+			EntityType:  definition.EntityType,
+			Key:         definition.Key,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// validateCustomFields verifica fields contra las definiciones del usuario; sin repositorio de
+// campos no hay ninguno definido
+func validateCustomFields(ctx context.Context, fieldRepo ports.CustomFieldRepository, userID uuid.UUID, entityType entities.CustomFieldEntity, fields entities.CustomFields) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	if fieldRepo == nil {
+		return entities.ErrUnknownCustomField
+	}
+	
+	definitions, err := fieldRepo.ListByUserID(ctx, userID, entityType)
+	if err != nil {
+		return err
+	}
+	return entities.ValidateCustomFields(fields, definitions)
+}
+
+// validateCustomFieldFilters valida los filtros por campo personalizado de los listados
+func validateCustomFieldFilters(filters []entities.CustomFieldFilter) error {
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Events
+type CustomFieldCreatedEvent struct {
+	entities.EventHeader
+	FieldID    uuid.UUID
+	UserID     uuid.UUID
+	EntityType entities.CustomFieldEntity
+	Key        string
+}
+
+type CustomFieldDeletedEvent struct {
+	entities.EventHeader
+	FieldID    uuid.UUID
+	UserID     uuid.UUID
+	EntityType entities.CustomFieldEntity
+	Key        string
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// EmailUseCases contiene los casos de uso para la dirección de email de cada usuario y los
+// informes que recibe en ella
+type EmailUseCases struct {
+	preferenceRepo ports.EmailPreferenceRepository
+	clock          entities.Clock
+}
+
+// NewEmailUseCases crea una nueva instancia de EmailUseCases
+func NewEmailUseCases(preferenceRepo ports.EmailPreferenceRepository, clock entities.Clock) *EmailUseCases {
+	return &EmailUseCases{preferenceRepo: preferenceRepo, clock: clock}
+}
+
+// GetPreference obtiene la dirección de email de un usuario; devuelve nil si no registró ninguna
+func (uc *EmailUseCases) GetPreference(ctx context.Context, userID uuid.UUID) (*entities.EmailPreference, error) {
+	preference, err := uc.preferenceRepo.Get(ctx, userID)
+	if errors.Is(err, entities.ErrEmailPreferenceNotFound) {
+		return nil, nil
+	}
+	return preference, err
+}
+
+// SetPreference guarda la dirección de email de un usuario; una dirección vacía la elimina y deja
+// de enviarle emails
+func (uc *EmailUseCases) SetPreference(ctx context.Context, userID uuid.UUID, address string, weeklySummary bool) (*entities.EmailPreference, error) {
+	if address == "" {
+		return nil, uc.preferenceRepo.Delete(ctx, userID)
+	}
+
+	preference, err := entities.NewEmailPreference(uc.clock, userID, address, weeklySummary)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.preferenceRepo.Upsert(ctx, preference); err != nil {
+		return nil, err
+	}
+	return preference, nil
+}
@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// newEventHeader crea la cabecera de un evento con los metadatos de la petición guardados en ctx.
+// actorID se usa cuando ctx no identifica al usuario autenticado (por ejemplo, al usar la
+// biblioteca sin el servidor gRPC); las tareas del sistema pasan uuid.Nil.
+func newEventHeader(ctx context.Context, clock entities.Clock, ids entities.IDGenerator, actorID uuid.UUID) entities.EventHeader {
+	if ids == nil {
+		ids = entities.UUIDGenerator{}
+	}
+
+	eventCtx := entities.EventContextFromContext(ctx)
+	if eventCtx.ActorID != uuid.Nil {
+		actorID = eventCtx.ActorID
+	}
+
+	return entities.EventHeader{
+		EventID:       ids.NewID(),
+		ActorID:       actorID,
+		RequestID:     eventCtx.RequestID,
+		CorrelationID: eventCtx.CorrelationID,
+		CausationID:   eventCtx.CausationID,
+		OccurredAt:    clock.Now(),
+		SchemaVersion: entities.EventSchemaVersion,
+	}
+}
+
+// eventType devuelve el nombre con el que el bus identifica un evento, su tipo Go (por ejemplo
+// "*usecases.IdeaCreatedEvent")
+func eventType(event any) string {
+	return fmt.Sprintf("%T", event)
+}
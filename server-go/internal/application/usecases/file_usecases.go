@@ -2,39 +2,125 @@ package usecases
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
+	"strings"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
 	"github.com/google/uuid"
 )
 
+// DefaultMaxFileVersions es el número de versiones que se conservan por archivo si no se indica otro
+const DefaultMaxFileVersions = 10
+
 // FileUseCases contiene los casos de uso para archivos
 type FileUseCases struct {
 	fileRepo        ports.FileRepository
 	storageService  ports.FileStorageService
 	eventBus        ports.EventBus
+	uow             ports.UnitOfWork
+	clock           entities.Clock
+	ids             entities.IDGenerator
+	maxVersions     int
+	textExtraction  ports.TextExtractionQueue
+	previews        ports.PreviewExtractor
+	lifecycle       *StorageLifecycleUseCases
+	deduplicate     bool
+}
+
+// FileOption configura parámetros opcionales de FileUseCases
+type FileOption func(*FileUseCases)
+
+// WithMaxFileVersions define cuántas versiones se conservan por archivo; las más antiguas se eliminan
+func WithMaxFileVersions(maxVersions int) FileOption {
+	return func(uc *FileUseCases) {
+		if maxVersions > 0 {
+			uc.maxVersions = maxVersions
+		}
+	}
+}
+
+// WithTextExtraction encola la extracción de texto de las imágenes y documentos subidos
+// para que su contenido aparezca en la búsqueda
+func WithTextExtraction(queue ports.TextExtractionQueue) FileOption {
+	return func(uc *FileUseCases) {
+		uc.textExtraction = queue
+	}
+}
+
+// WithPreviewExtractor extrae los metadatos de vista previa de los archivos mientras se suben
+func WithPreviewExtractor(extractor ports.PreviewExtractor) FileOption {
+	return func(uc *FileUseCases) {
+		uc.previews = extractor
+	}
+}
+
+// WithStorageLifecycle devuelve al nivel principal los archivos del nivel frío al descargarlos
+func WithStorageLifecycle(lifecycle *StorageLifecycleUseCases) FileOption {
+	return func(uc *FileUseCases) {
+		uc.lifecycle = lifecycle
+	}
+}
+
+// WithFileDeduplication permite registrar un archivo sin subirlo cuando el usuario ya tiene
+// almacenado el mismo contenido; la nueva versión comparte el archivo físico existente
+func WithFileDeduplication(enabled bool) FileOption {
+	return func(uc *FileUseCases) {
+		uc.deduplicate = enabled
+	}
 }
 
 // NewFileUseCases crea una nueva instancia de FileUseCases
-func NewFileUseCases(fileRepo ports.FileRepository, storageService ports.FileStorageService, eventBus ports.EventBus) *FileUseCases {
-	return &FileUseCases{
+func NewFileUseCases(fileRepo ports.FileRepository, storageService ports.FileStorageService, eventBus ports.EventBus, uow ports.UnitOfWork, clock entities.Clock, ids entities.IDGenerator, options ...FileOption) *FileUseCases {
+	uc := &FileUseCases{
 		fileRepo:       fileRepo,
 		storageService: storageService,
 		eventBus:       eventBus,
+		uow:            uow,
+		clock:          clock,
+		ids:            ids,
+		maxVersions:    DefaultMaxFileVersions,
+	}
+	for _, option := range options {
+		option(uc)
 	}
+	return uc
 }
 
-// UploadFile sube un archivo al sistema
-func (uc *FileUseCases) UploadFile(ctx context.Context, filename, contentType string, reader io.Reader, userID uuid.UUID, compress bool, compressionType string) (*entities.FileInfo, error) {
-	// Almacenar el archivo físicamente
-	path, checksum, size, err := uc.storageService.StoreFile(ctx, filename, reader, compress, compressionType)
+// UploadFile sube un archivo al sistema; si el usuario ya tiene un archivo con ese
+// nombre, se guarda como su nueva versión. El checksum SHA-256 se calcula sobre el
+// contenido recibido mientras se almacena; si expectedChecksum no está vacío y no
+// coincide, el archivo se descarta sin registrarlo. Si el extractor de vista previa
+// modifica el contenido (por ejemplo, al borrar la ubicación GPS), expectedChecksum se
+// compara con lo recibido y el checksum guardado corresponde a lo almacenado.
+func (uc *FileUseCases) UploadFile(ctx context.Context, filename, contentType string, reader io.Reader, userID uuid.UUID, compress bool, compressionType string, expectedChecksum string) (*entities.FileInfo, error) {
+	received := sha256.New()
+	stored := received
+	content := io.TeeReader(reader, received)
+	preview := func() *entities.FilePreview { return nil }
+	if uc.previews != nil {
+		stored = sha256.New()
+		content, preview = uc.previews.Wrap(contentType, content)
+		content = io.TeeReader(content, stored)
+	}
+	
+	// Almacenar el archivo físicamente calculando el checksum del contenido original
+	path, _, size, err := uc.storageService.StoreFile(ctx, filename, content, compress, compressionType)
 	if err != nil {
 		return nil, err
 	}
 	
 	// Crear la entidad de archivo
-	fileInfo := entities.NewFileInfo(filename, contentType, checksum, path, size, userID, compress, compressionType)
+	fileInfo := entities.NewFileInfo(uc.clock, uc.ids, filename, contentType, hex.EncodeToString(stored.Sum(nil)), path, size, userID, compress, compressionType)
+	fileInfo.ChecksumAlgorithm = entities.ChecksumAlgorithmSHA256
+	fileInfo.Preview = preview()
+	
+	if expectedChecksum != "" && !entities.ChecksumEquals(expectedChecksum, hex.EncodeToString(received.Sum(nil))) {
+		uc.storageService.DeleteFile(ctx, path)
+		return nil, entities.ErrFileChecksumMismatch
+	}
 	
 	if err := fileInfo.Validate(); err != nil {
 		// Si falla la validación, eliminar el archivo físico
@@ -43,23 +129,56 @@ func (uc *FileUseCases) UploadFile(ctx context.Context, filename, contentType st
 	}
 	
 	// Guardar la información en la base de datos
-	if err := uc.fileRepo.Create(ctx, fileInfo); err != nil {
+	var pruned []string
+	err = runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		pruned, err = uc.createVersion(ctx, tx, fileInfo)
+		return err
+	})
+	if err != nil {
 		// Si falla la creación en BD, eliminar el archivo físico
 		uc.storageService.DeleteFile(ctx, path)
 		return nil, err
 	}
+	uc.deleteStoredFiles(ctx, pruned)
 	
-	// Publicar evento de archivo subido
-	if uc.eventBus != nil {
-		event := &FileUploadedEvent{
-			FileID:   fileInfo.ID,
-			UserID:   userID,
-			Filename: filename,
-			Size:     size,
+	uc.afterUpload(ctx, fileInfo)
+	return fileInfo, nil
+}
+
+// CheckFileExists registra filename como una nueva versión sin recibir su contenido si el
+// usuario ya tiene almacenado un archivo con ese checksum SHA-256 y ese tamaño. Devuelve nil
+// si el contenido no existe o la deduplicación no está habilitada; el cliente debe subirlo.
+func (uc *FileUseCases) CheckFileExists(ctx context.Context, filename, contentType, checksum string, size int64, userID uuid.UUID) (*entities.FileInfo, error) {
+	if !uc.deduplicate || checksum == "" {
+		return nil, nil
+	}
+	checksum = strings.ToLower(strings.TrimSpace(checksum))
+	
+	var fileInfo *entities.FileInfo
+	var pruned []string
+	err := runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		// Se busca dentro de la transacción para no compartir un archivo físico que se está eliminando
+		existing, err := tx.Files().FindByChecksum(ctx, userID, checksum, size)
+		if err == entities.ErrFileNotFound {
+			return nil
 		}
-		uc.eventBus.Publish(ctx, event)
+		if err != nil {
+			return err
+		}
+		
+		fileInfo = entities.NewDeduplicatedFile(uc.clock, uc.ids, filename, contentType, userID, existing)
+		if err := fileInfo.Validate(); err != nil {
+			return err
+		}
+		pruned, err = uc.createVersion(ctx, tx, fileInfo)
+		return err
+	})
+	if err != nil || fileInfo == nil {
+		return nil, err
 	}
+	uc.deleteStoredFiles(ctx, pruned)
 	
+	uc.afterUpload(ctx, fileInfo)
 	return fileInfo, nil
 }
 
@@ -75,6 +194,13 @@ func (uc *FileUseCases) DownloadFile(ctx context.Context, fileID, userID uuid.UU
 		return nil, nil, entities.ErrFileUnauthorized
 	}
 	
+	// Restaurar al nivel principal los archivos con uso; si falla, se leen desde el nivel frío
+	if fileInfo.IsCold() && uc.lifecycle != nil {
+		if restored, err := uc.lifecycle.Restore(ctx, fileInfo); err == nil {
+			fileInfo = restored
+		}
+	}
+	
 	// Obtener el archivo físico
 	reader, err := uc.storageService.RetrieveFile(ctx, fileInfo.Path)
 	if err != nil {
@@ -84,9 +210,10 @@ func (uc *FileUseCases) DownloadFile(ctx context.Context, fileID, userID uuid.UU
 	// Publicar evento de archivo descargado
 	if uc.eventBus != nil {
 		event := &FileDownloadedEvent{
-			FileID:   fileInfo.ID,
-			UserID:   userID,
-			Filename: fileInfo.Filename,
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			FileID:      fileInfo.ID,
+			UserID:      userID,
+			Filename:    fileInfo.Filename,
 		}
 		uc.eventBus.Publish(ctx, event)
 	}
@@ -94,7 +221,23 @@ func (uc *FileUseCases) DownloadFile(ctx context.Context, fileID, userID uuid.UU
 	return fileInfo, reader, nil
 }
 
-// DeleteFile elimina un archivo del sistema
+// ReadFile devuelve el contenido de un archivo desde el nivel en el que esté, sin restaurarlo
+// ni publicar FileDownloadedEvent: sirve las lecturas por rangos, que no cuentan como descarga
+func (uc *FileUseCases) ReadFile(ctx context.Context, fileID, userID uuid.UUID) (*entities.FileInfo, io.ReadCloser, error) {
+	fileInfo, err := uc.GetFileInfo(ctx, fileID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	
+	reader, err := uc.storageService.RetrieveFile(ctx, fileInfo.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	
+	return fileInfo, reader, nil
+}
+
+// DeleteFile elimina un archivo del sistema junto con todas sus versiones
 func (uc *FileUseCases) DeleteFile(ctx context.Context, fileID, userID uuid.UUID) error {
 	// Obtener información del archivo
 	fileInfo, err := uc.fileRepo.GetByID(ctx, fileID)
@@ -106,23 +249,36 @@ func (uc *FileUseCases) DeleteFile(ctx context.Context, fileID, userID uuid.UUID
 		return entities.ErrFileUnauthorized
 	}
 	
-	// Eliminar de la base de datos
-	if err := uc.fileRepo.Delete(ctx, fileID); err != nil {
+	// Eliminar los registros y los archivos físicos de forma atómica: si el almacenamiento
+	// falla la transacción se revierte y el archivo sigue visible para reintentar
+	err = runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		versions, err := tx.Files().ListVersions(ctx, fileInfo.LogicalID)
+		if err != nil {
+			return err
+		}
+		
+		unreferenced, err := deleteVersions(ctx, tx, versions)
+		if err != nil {
+			return err
+		}
+		for _, path := range unreferenced {
+			if err := uc.storageService.DeleteFile(ctx, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 	
-	// Eliminar el archivo físico
-	if err := uc.storageService.DeleteFile(ctx, fileInfo.Path); err != nil {
-		// Log del error pero no fallar la operación
-		// ya que el registro ya fue eliminado de la BD
-	}
-	
 	// Publicar evento de archivo eliminado
 	if uc.eventBus != nil {
 		event := &FileDeletedEvent{
-			FileID:   fileID,
-			UserID:   userID,
-			Filename: fileInfo.Filename,
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			FileID:      fileID,
+			UserID:      userID,
+			Filename:    fileInfo.Filename,
 		}
 		uc.eventBus.Publish(ctx, event)
 	}
@@ -132,6 +288,12 @@ func (uc *FileUseCases) DeleteFile(ctx context.Context, fileID, userID uuid.UUID
 
 // ListFiles lista los archivos de un usuario
 func (uc *FileUseCases) ListFiles(ctx context.Context, userID uuid.UUID, filters ports.FileFilters) ([]*entities.FileInfo, int, error) {
+	if !filters.Count.IsValid() {
+		return nil, 0, entities.ErrInvalidPagination
+	}
+	if err := entities.ValidateSort(filters.Sort, entities.FileSortFields); err != nil {
+		return nil, 0, err
+	}
 	return uc.fileRepo.GetByUserID(ctx, userID, filters)
 }
 
@@ -149,8 +311,164 @@ func (uc *FileUseCases) GetFileInfo(ctx context.Context, fileID, userID uuid.UUI
 	return fileInfo, nil
 }
 
+// ListFileVersions lista las versiones del archivo al que pertenece fileID, de la más reciente a la más antigua
+func (uc *FileUseCases) ListFileVersions(ctx context.Context, fileID, userID uuid.UUID) ([]*entities.FileInfo, error) {
+	fileInfo, err := uc.GetFileInfo(ctx, fileID, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	return uc.fileRepo.ListVersions(ctx, fileInfo.LogicalID)
+}
+
+// RestoreVersion crea una nueva versión con el contenido de la versión versionID.
+// El historial no se reescribe: la versión restaurada pasa a ser la más reciente.
+func (uc *FileUseCases) RestoreVersion(ctx context.Context, versionID, userID uuid.UUID) (*entities.FileInfo, error) {
+	version, err := uc.GetFileInfo(ctx, versionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	var restored *entities.FileInfo
+	var pruned []string
+	err = runInTx(ctx, uc.uow, func(tx ports.Tx) error {
+		versions, err := tx.Files().ListVersions(ctx, version.LogicalID)
+		if err != nil {
+			return err
+		}
+		
+		restored = entities.NewRestoredVersion(uc.clock, uc.ids, version, versions[0])
+		if err := tx.Files().Create(ctx, restored); err != nil {
+			return err
+		}
+		
+		pruned, err = uc.pruneVersions(ctx, tx, version.LogicalID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.deleteStoredFiles(ctx, pruned)
+	uc.enqueueTextExtraction(ctx, restored)
+	
+	// Publicar evento de versión restaurada
+	if uc.eventBus != nil {
+		event := &FileVersionRestoredEvent{
+			EventHeader:     newEventHeader(ctx, uc.clock, uc.ids, userID),
+			FileID:          restored.ID,
+			LogicalID:       restored.LogicalID,
+			UserID:          userID,
+			RestoredVersion: version.Version,
+			NewVersion:      restored.Version,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return restored, nil
+}
+
+// GetStorageUsage obtiene el almacenamiento usado por un usuario contando todas las versiones
+func (uc *FileUseCases) GetStorageUsage(ctx context.Context, userID uuid.UUID) (*ports.StorageUsage, error) {
+	return uc.fileRepo.GetStorageUsage(ctx, userID)
+}
+
+// createVersion registra fileInfo como la versión siguiente del archivo del usuario con ese nombre,
+// elimina las versiones que exceden maxVersions y devuelve las rutas físicas que quedaron sin referencias
+func (uc *FileUseCases) createVersion(ctx context.Context, tx ports.Tx, fileInfo *entities.FileInfo) ([]string, error) {
+	latest, err := tx.Files().GetLatestVersion(ctx, fileInfo.UserID, fileInfo.Filename)
+	if err == nil {
+		fileInfo.FollowVersion(latest)
+	} else if err != entities.ErrFileNotFound {
+		return nil, err
+	}
+	
+	if err := tx.Files().Create(ctx, fileInfo); err != nil {
+		return nil, err
+	}
+	
+	return uc.pruneVersions(ctx, tx, fileInfo.LogicalID)
+}
+
+// afterUpload publica el evento de archivo subido y encola la extracción de texto
+func (uc *FileUseCases) afterUpload(ctx context.Context, fileInfo *entities.FileInfo) {
+	// Publicar evento de archivo subido
+	header := newEventHeader(ctx, uc.clock, uc.ids, fileInfo.UserID)
+	if uc.eventBus != nil {
+		event := &FileUploadedEvent{
+			EventHeader: header,
+			FileID:      fileInfo.ID,
+			UserID:      fileInfo.UserID,
+			Filename:    fileInfo.Filename,
+			Size:        fileInfo.Size,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	// La extracción de texto se registra como consecuencia de la subida
+	uc.enqueueTextExtraction(entities.ContextWithEventCause(ctx, header), fileInfo)
+}
+
+// pruneVersions elimina las versiones que exceden maxVersions y devuelve
+// las rutas físicas que ya no referencia ninguna versión
+func (uc *FileUseCases) pruneVersions(ctx context.Context, tx ports.Tx, logicalID uuid.UUID) ([]string, error) {
+	versions, err := tx.Files().ListVersions(ctx, logicalID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) <= uc.maxVersions {
+		return nil, nil
+	}
+	
+	return deleteVersions(ctx, tx, versions[uc.maxVersions:])
+}
+
+// deleteVersions elimina los registros de versions y devuelve las rutas físicas que quedaron sin referencias
+func deleteVersions(ctx context.Context, tx ports.Tx, versions []*entities.FileInfo) ([]string, error) {
+	for _, version := range versions {
+		if err := tx.Files().Delete(ctx, version.ID); err != nil {
+			return nil, err
+		}
+	}
+	
+	var unreferenced []string
+	seen := make(map[string]bool)
+	for _, version := range versions {
+		if seen[version.Path] {
+			continue
+		}
+		seen[version.Path] = true
+		
+		count, err := tx.Files().CountByPath(ctx, version.Path)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			unreferenced = append(unreferenced, version.Path)
+		}
+	}
+	return unreferenced, nil
+}
+
+// deleteStoredFiles elimina archivos físicos de versiones ya eliminadas; un fallo
+// solo deja un archivo huérfano, así que no se propaga
+func (uc *FileUseCases) deleteStoredFiles(ctx context.Context, paths []string) {
+	for _, path := range paths {
+		uc.storageService.DeleteFile(ctx, path)
+	}
+}
+
+// enqueueTextExtraction encola la extracción de texto del archivo; si la cola falla,
+// el archivo solo queda fuera de la búsqueda por contenido, así que no se propaga
+func (uc *FileUseCases) enqueueTextExtraction(ctx context.Context, fileInfo *entities.FileInfo) {
+	if uc.textExtraction == nil || !fileInfo.SupportsTextExtraction() {
+		return
+	}
+	uc.textExtraction.EnqueueTextExtraction(ctx, fileInfo.ID)
+}
+
 // Events
 type FileUploadedEvent struct {
+	entities.EventHeader
 	FileID   uuid.UUID
 	UserID   uuid.UUID
 	Filename string
@@ -158,13 +476,24 @@ type FileUploadedEvent struct {
 }
 
 type FileDownloadedEvent struct {
+	entities.EventHeader
 	FileID   uuid.UUID
 	UserID   uuid.UUID
 	Filename string
 }
 
 type FileDeletedEvent struct {
+	entities.EventHeader
 	FileID   uuid.UUID
 	UserID   uuid.UUID
 	Filename string
+}
+
+type FileVersionRestoredEvent struct {
+	entities.EventHeader
+	FileID          uuid.UUID
+	LogicalID       uuid.UUID
+	UserID          uuid.UUID
+	RestoredVersion int64
+	NewVersion      int64
 }
\ No newline at end of file
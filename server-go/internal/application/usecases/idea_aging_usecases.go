@@ -0,0 +1,245 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// agingBatchSize es el número de ideas evaluadas por consulta al aplicar las reglas de prioridad
+const agingBatchSize = 100
+
+// openIdeaStatuses son los estados de las ideas a las que se aplican las reglas de prioridad
+var openIdeaStatuses = []entities.IdeaStatus{entities.IdeaStatusDraft, entities.IdeaStatusActive, entities.IdeaStatusOnHold}
+
+// DefaultPriorityRules suben las ideas con recordatorios próximos y las activas olvidadas, y bajan
+// los borradores y las ideas en pausa que nadie toca
+var DefaultPriorityRules = []entities.PriorityRule{
+	{
+		Name:           "deadline_approaching",
+		DeadlineWithin: 48 * time.Hour,
+		IdleFor:        24 * time.Hour,
+		Delta:          1,
+		Limit:          5,
+	},
+	{
+		Name:     "neglected_active",
+		Statuses: []entities.IdeaStatus{entities.IdeaStatusActive},
+		MinAge:   14 * 24 * time.Hour,
+		IdleFor:  7 * 24 * time.Hour,
+		Delta:    1,
+		Limit:    3,
+	},
+	{
+		Name:     "stale_draft",
+		Statuses: []entities.IdeaStatus{entities.IdeaStatusDraft},
+		IdleFor:  30 * 24 * time.Hour,
+		Delta:    -1,
+		Limit:    0,
+	},
+	{
+		Name:     "stale_on_hold",
+		Statuses: []entities.IdeaStatus{entities.IdeaStatusOnHold},
+		IdleFor:  14 * 24 * time.Hour,
+		Delta:    -1,
+		Limit:    0,
+	},
+}
+
+// IdeaAgingReport resume una ejecución de las reglas de prioridad
+type IdeaAgingReport struct {
+	Evaluated int
+	Changed   int
+	// Conflicts cuenta las ideas que el usuario modificó durante la ejecución; se reevalúan en la siguiente
+	Conflicts int
+}
+
+// IdeaAgingUseCases ajusta la prioridad de las ideas en curso según su antigüedad, su estado y los
+// vencimientos de sus recordatorios. Debe ejecutarse en una sola réplica a la vez.
+type IdeaAgingUseCases struct {
+	ideaRepo        ports.IdeaRepository
+	reminderRepo    ports.ReminderRepository
+	notificationSvc ports.NotificationService
+	eventBus        ports.EventBus
+	clock           entities.Clock
+	ids             entities.IDGenerator
+	rules           []entities.PriorityRule
+}
+
+// NewIdeaAgingUseCases crea una nueva instancia de IdeaAgingUseCases. Las reglas se evalúan en
+// orden y a cada idea se le aplica solo la primera que cumple; sin reglas no se modifica ninguna idea.
+func NewIdeaAgingUseCases(ideaRepo ports.IdeaRepository, reminderRepo ports.ReminderRepository, notificationSvc ports.NotificationService, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator, rules []entities.PriorityRule) (*IdeaAgingUseCases, error) {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	
+	return &IdeaAgingUseCases{
+		ideaRepo:        ideaRepo,
+		reminderRepo:    reminderRepo,
+		notificationSvc: notificationSvc,
+		eventBus:        eventBus,
+		clock:           clock,
+		ids:             ids,
+		rules:           rules,
+	}, nil
+}
+
+// ApplyRules evalúa las reglas sobre todas las ideas en curso y guarda las prioridades que cambian
+func (uc *IdeaAgingUseCases) ApplyRules(ctx context.Context) (*IdeaAgingReport, error) {
+	report := &IdeaAgingReport{}
+	if len(uc.rules) == 0 {
+		return report, nil
+	}
+	
+	var deadlineWindow time.Duration
+	for _, rule := range uc.rules {
+		deadlineWindow = max(deadlineWindow, rule.DeadlineWithin)
+	}
+	
+	now := uc.clock.Now()
+	afterID := uuid.Nil
+	for {
+		ideas, err := uc.ideaRepo.ListByStatus(ctx, openIdeaStatuses, afterID, agingBatchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(ideas) == 0 {
+			return report, nil
+		}
+		afterID = ideas[len(ideas)-1].ID
+		
+		var deadlines map[uuid.UUID]time.Time
+		if deadlineWindow > 0 {
+			if deadlines, err = uc.nextDeadlines(ctx, ideas, now.Add(deadlineWindow)); err != nil {
+				return report, err
+			}
+		}
+		
+		for _, idea := range ideas {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			report.Evaluated++
+			
+			var nextDeadline *time.Time
+			if deadline, ok := deadlines[idea.ID]; ok {
+				nextDeadline = &deadline
+			}
+			rule, ok := uc.firstMatch(idea, nextDeadline, now)
+			if !ok {
+				continue
+			}
+			
+			err := uc.apply(ctx, idea, rule, now)
+			if errors.Is(err, entities.ErrVersionConflict) || errors.Is(err, entities.ErrIdeaNotFound) {
+				report.Conflicts++
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			report.Changed++
+		}
+		
+		if len(ideas) < agingBatchSize {
+			return report, nil
+		}
+	}
+}
+
+func (uc *IdeaAgingUseCases) firstMatch(idea *entities.Idea, nextDeadline *time.Time, now time.Time) (entities.PriorityRule, bool) {
+	for _, rule := range uc.rules {
+		if rule.Matches(idea, nextDeadline, now) {
+			return rule, true
+		}
+	}
+	return entities.PriorityRule{}, false
+}
+
+// nextDeadlines devuelve, por idea, el vencimiento más próximo de sus recordatorios sin completar.
+// Se ignoran los recordatorios vinculados a ideas de otro usuario.
+func (uc *IdeaAgingUseCases) nextDeadlines(ctx context.Context, ideas []*entities.Idea, before time.Time) (map[uuid.UUID]time.Time, error) {
+	owners := make(map[uuid.UUID]uuid.UUID, len(ideas))
+	ideaIDs := make([]uuid.UUID, len(ideas))
+	for i, idea := range ideas {
+		owners[idea.ID] = idea.UserID
+		ideaIDs[i] = idea.ID
+	}
+	
+	reminders, err := uc.reminderRepo.GetUpcomingByIdeaIDs(ctx, ideaIDs, before)
+	if err != nil {
+		return nil, err
+	}
+	
+	deadlines := make(map[uuid.UUID]time.Time)
+	for _, reminder := range reminders {
+		if owners[reminder.IdeaID] != reminder.UserID {
+			continue
+		}
+		if current, ok := deadlines[reminder.IdeaID]; !ok || reminder.ScheduledTime.Before(current) {
+			deadlines[reminder.IdeaID] = reminder.ScheduledTime
+		}
+	}
+	return deadlines, nil
+}
+
+// apply guarda la prioridad que resulta de la regla y avisa a los dispositivos del usuario para que
+// reordenen sus listas
+func (uc *IdeaAgingUseCases) apply(ctx context.Context, idea *entities.Idea, rule entities.PriorityRule, now time.Time) error {
+	oldPriority := idea.Priority
+	idea.SetPriority(rule.Apply(oldPriority), now)
+	
+	if err := uc.ideaRepo.Update(ctx, idea); err != nil {
+		return err
+	}
+	
+	// Publicar evento de prioridad cambiada
+	if uc.eventBus != nil {
+		event := &IdeaPriorityChangedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+			IdeaID:      idea.ID,
+			UserID:      idea.UserID,
+			Rule:        rule.Name,
+			OldPriority: oldPriority,
+			NewPriority: idea.Priority,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	if uc.notificationSvc != nil {
+		uc.notificationSvc.SendNotification(
+			ctx,
+			idea.UserID,
+			idea.Title,
+			"",
+			"idea_priority_changed",
+			[]string{"sync"},
+			map[string]string{
+				"idea_id":           idea.ID.String(),
+				"priority":          strconv.FormatInt(int64(idea.Priority), 10),
+				"previous_priority": strconv.FormatInt(int64(oldPriority), 10),
+				"rule":              rule.Name,
+				"version":           strconv.FormatInt(idea.Version, 10),
+			},
+		)
+	}
+	
+	return nil
+}
+
+// Events
+type IdeaPriorityChangedEvent struct {
+	entities.EventHeader
+	IdeaID      uuid.UUID
+	UserID      uuid.UUID
+	Rule        string
+	OldPriority int32
+	NewPriority int32
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// compactionBatchSize es el número de ideas compactadas por consulta
+const compactionBatchSize = 100
+
+// IdeaCompactionReport resume una ejecución de la compactación de ideas archivadas
+type IdeaCompactionReport struct {
+	Compacted int
+	// Conflicts cuenta las ideas que el usuario modificó durante la ejecución; se reintentan en la siguiente
+	Conflicts int
+}
+
+// IdeaCompactionUseCases mueve el contenido de las ideas archivadas sin cambios recientes a un
+// archivo comprimido y deja en la fila un extracto para los listados y la búsqueda. El repositorio
+// de ideas restaura el contenido completo al obtener la idea.
+type IdeaCompactionUseCases struct {
+	archiveRepo  ports.IdeaArchiveRepository
+	eventBus     ports.EventBus
+	clock        entities.Clock
+	ids          entities.IDGenerator
+	compactAfter time.Duration
+}
+
+// NewIdeaCompactionUseCases crea una nueva instancia de IdeaCompactionUseCases; se compactan las
+// ideas archivadas sin modificar desde hace más de compactAfter (0 desactiva la compactación)
+func NewIdeaCompactionUseCases(archiveRepo ports.IdeaArchiveRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator, compactAfter time.Duration) *IdeaCompactionUseCases {
+	return &IdeaCompactionUseCases{
+		archiveRepo:  archiveRepo,
+		eventBus:     eventBus,
+		clock:        clock,
+		ids:          ids,
+		compactAfter: compactAfter,
+	}
+}
+
+// CompactArchivedIdeas compacta las ideas archivadas que cumplen el umbral. Debe ejecutarse en una
+// sola réplica a la vez.
+func (uc *IdeaCompactionUseCases) CompactArchivedIdeas(ctx context.Context) (*IdeaCompactionReport, error) {
+	report := &IdeaCompactionReport{}
+	if uc.compactAfter <= 0 {
+		return report, nil
+	}
+	
+	now := uc.clock.Now()
+	before := now.Add(-uc.compactAfter)
+	for {
+		ideas, err := uc.archiveRepo.ListCompactionCandidates(ctx, before, compactionBatchSize)
+		if err != nil {
+			return report, err
+		}
+	
+		compacted := 0
+		for _, idea := range ideas {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+	
+			err := uc.archiveRepo.Compact(ctx, idea, now)
+			if errors.Is(err, entities.ErrVersionConflict) {
+				report.Conflicts++
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			compacted++
+	
+			// Publicar evento de idea compactada
+			if uc.eventBus != nil {
+				event := &IdeaCompactedEvent{
+					EventHeader: newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+					IdeaID:      idea.ID,
+					UserID:      idea.UserID,
+				}
+				uc.eventBus.Publish(ctx, event)
+			}
+		}
+		report.Compacted += compacted
+	
+		// Si ninguna idea del lote se compactó, la siguiente consulta devolvería las mismas
+		if len(ideas) < compactionBatchSize || compacted == 0 {
+			return report, nil
+		}
+	}
+}
+
+// Events
+type IdeaCompactedEvent struct {
+	entities.EventHeader
+	IdeaID uuid.UUID
+	UserID uuid.UUID
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"strings"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
@@ -10,26 +11,67 @@ import (
 
 // IdeaUseCases contiene los casos de uso para ideas
 type IdeaUseCases struct {
-	ideaRepo ports.IdeaRepository
-	eventBus ports.EventBus
+	ideaRepo   ports.IdeaRepository
+	eventBus   ports.EventBus
+	clock      entities.Clock
+	ids        entities.IDGenerator
+	embeddings ports.IdeaEmbeddingQueue
+	fieldRepo  ports.CustomFieldRepository
+	moderation *ModerationUseCases
+}
+
+// IdeaOption configura parámetros opcionales de IdeaUseCases
+type IdeaOption func(*IdeaUseCases)
+
+// WithIdeaEmbeddings encola el cálculo del vector de las ideas creadas o cuyo texto cambia
+// para que aparezcan en la búsqueda semántica
+func WithIdeaEmbeddings(queue ports.IdeaEmbeddingQueue) IdeaOption {
+	return func(uc *IdeaUseCases) {
+		uc.embeddings = queue
+	}
+}
+
+// WithIdeaCustomFields permite asignar a las ideas los campos personalizados que define cada usuario
+func WithIdeaCustomFields(fieldRepo ports.CustomFieldRepository) IdeaOption {
+	return func(uc *IdeaUseCases) {
+		uc.fieldRepo = fieldRepo
+	}
+}
+
+// WithIdeaModeration revisa el texto de las ideas creadas o editadas por usuarios de una
+// organización con la política de moderación de esa organización
+func WithIdeaModeration(moderation *ModerationUseCases) IdeaOption {
+	return func(uc *IdeaUseCases) {
+		uc.moderation = moderation
+	}
 }
 
 // NewIdeaUseCases crea una nueva instancia de IdeaUseCases
-func NewIdeaUseCases(ideaRepo ports.IdeaRepository, eventBus ports.EventBus) *IdeaUseCases {
-	return &IdeaUseCases{
+func NewIdeaUseCases(ideaRepo ports.IdeaRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator, opts ...IdeaOption) *IdeaUseCases {
+	uc := &IdeaUseCases{
 		ideaRepo: ideaRepo,
 		eventBus: eventBus,
+		clock:    clock,
+		ids:      ids,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 // CreateIdea crea una nueva idea
 func (uc *IdeaUseCases) CreateIdea(ctx context.Context, title, content string, category entities.IdeaCategory, userID uuid.UUID, tags []string, priority int32) (*entities.Idea, error) {
-	idea := entities.NewIdea(title, content, category, userID, tags, priority)
+	idea := entities.NewIdea(uc.clock, uc.ids, title, content, category, userID, tags, priority)
 	
 	if err := idea.Validate(); err != nil {
 		return nil, err
 	}
 	
+	if err := uc.moderate(ctx, idea); err != nil {
+		return nil, err
+	}
+	
 	if err := uc.ideaRepo.Create(ctx, idea); err != nil {
 		return nil, err
 	}
@@ -37,12 +79,14 @@ func (uc *IdeaUseCases) CreateIdea(ctx context.Context, title, content string, c
 	// Publicar evento de idea creada
 	if uc.eventBus != nil {
 		event := &IdeaCreatedEvent{
-			IdeaID: idea.ID,
-			UserID: userID,
-			Title:  title,
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      idea.ID,
+			UserID:      userID,
+			Title:       title,
 		}
 		uc.eventBus.Publish(ctx, event)
 	}
+	uc.enqueueEmbedding(ctx, idea)
 	
 	return idea, nil
 }
@@ -63,11 +107,22 @@ func (uc *IdeaUseCases) GetIdea(ctx context.Context, id, userID uuid.UUID) (*ent
 
 // ListIdeas obtiene las ideas de un usuario con filtros
 func (uc *IdeaUseCases) ListIdeas(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
+	if err := validateCustomFieldFilters(filters.CustomFields); err != nil {
+		return nil, 0, err
+	}
+	if err := entities.ValidateSort(filters.Sort, entities.IdeaSortFields); err != nil {
+		return nil, 0, err
+	}
+	if !filters.Count.IsValid() {
+		return nil, 0, entities.ErrInvalidPagination
+	}
 	return uc.ideaRepo.GetByUserID(ctx, userID, filters)
 }
 
-// UpdateIdea actualiza una idea existente
-func (uc *IdeaUseCases) UpdateIdea(ctx context.Context, id, userID uuid.UUID, title, content string, tags []string, category entities.IdeaCategory, status entities.IdeaStatus, priority int32) (*entities.Idea, error) {
+// UpdateIdea actualiza una idea existente.
+// Si expectedVersion no coincide con la versión almacenada devuelve la idea más reciente junto con ErrVersionConflict.
+// Si updateMask no está vacío solo se modifican los campos indicados, incluso si su nuevo valor es vacío.
+func (uc *IdeaUseCases) UpdateIdea(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, title, content string, tags []string, category entities.IdeaCategory, status entities.IdeaStatus, priority int32, updateMask []string) (*entities.Idea, error) {
 	idea, err := uc.ideaRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -77,22 +132,94 @@ func (uc *IdeaUseCases) UpdateIdea(ctx context.Context, id, userID uuid.UUID, ti
 		return nil, entities.ErrIdeaUnauthorized
 	}
 	
-	idea.Update(title, content, tags, category, status, priority)
+	if !idea.HasVersion(expectedVersion) {
+		return idea, entities.ErrVersionConflict
+	}
+	previousText := entities.EmbeddingText(idea)
+	previousModerated := strings.Join(moderatedTexts(idea), "\n")
+	
+	if len(updateMask) > 0 {
+		if err := idea.UpdateFields(updateMask, title, content, tags, category, status, priority, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+	} else {
+		idea.Update(title, content, tags, category, status, priority, uc.clock.Now())
+	}
 	
 	if err := idea.Validate(); err != nil {
 		return nil, err
 	}
 	
+	if strings.Join(moderatedTexts(idea), "\n") != previousModerated {
+		if err := uc.moderate(ctx, idea); err != nil {
+			return nil, err
+		}
+	}
+	
+	if err := uc.ideaRepo.Update(ctx, idea); err != nil {
+		if err == entities.ErrVersionConflict {
+			// Otra escritura ganó la carrera: devolver el estado actual para que el cliente pueda fusionar
+			if latest, getErr := uc.ideaRepo.GetByID(ctx, id); getErr == nil {
+				return latest, err
+			}
+		}
+		return nil, err
+	}
+	
+	// Publicar evento de idea actualizada
+	if uc.eventBus != nil {
+		event := &IdeaUpdatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      idea.ID,
+			UserID:      userID,
+			Title:       idea.Title,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	if entities.EmbeddingText(idea) != previousText {
+		uc.enqueueEmbedding(ctx, idea)
+	}
+	
+	return idea, nil
+}
+
+// SetIdeaCustomFields reemplaza los valores de los campos personalizados de la idea; fields vacío
+// los quita todos. Si expectedVersion no coincide devuelve la idea más reciente junto con ErrVersionConflict.
+func (uc *IdeaUseCases) SetIdeaCustomFields(ctx context.Context, id, userID uuid.UUID, fields entities.CustomFields, expectedVersion int64) (*entities.Idea, error) {
+	idea, err := uc.ideaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !idea.IsOwnedBy(userID) {
+		return nil, entities.ErrIdeaUnauthorized
+	}
+	
+	if !idea.HasVersion(expectedVersion) {
+		return idea, entities.ErrVersionConflict
+	}
+	
+	if err := validateCustomFields(ctx, uc.fieldRepo, userID, entities.CustomFieldEntityIdea, fields); err != nil {
+		return nil, err
+	}
+	
+	idea.SetCustomFields(fields, uc.clock.Now())
 	if err := uc.ideaRepo.Update(ctx, idea); err != nil {
+		if err == entities.ErrVersionConflict {
+			if latest, getErr := uc.ideaRepo.GetByID(ctx, id); getErr == nil {
+				return latest, err
+			}
+		}
 		return nil, err
 	}
 	
 	// Publicar evento de idea actualizada
 	if uc.eventBus != nil {
 		event := &IdeaUpdatedEvent{
-			IdeaID: idea.ID,
-			UserID: userID,
-			Title:  idea.Title,
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      idea.ID,
+			UserID:      userID,
+			Title:       idea.Title,
 		}
 		uc.eventBus.Publish(ctx, event)
 	}
@@ -118,8 +245,9 @@ func (uc *IdeaUseCases) DeleteIdea(ctx context.Context, id, userID uuid.UUID) er
 	// Publicar evento de idea eliminada
 	if uc.eventBus != nil {
 		event := &IdeaDeletedEvent{
-			IdeaID: id,
-			UserID: userID,
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      id,
+			UserID:      userID,
 		}
 		uc.eventBus.Publish(ctx, event)
 	}
@@ -127,20 +255,46 @@ func (uc *IdeaUseCases) DeleteIdea(ctx context.Context, id, userID uuid.UUID) er
 	return nil
 }
 
+// enqueueEmbedding encola el cálculo del vector de la idea; si la cola falla, la idea solo
+// queda fuera de la búsqueda semántica hasta el siguiente recálculo, así que no se propaga
+func (uc *IdeaUseCases) enqueueEmbedding(ctx context.Context, idea *entities.Idea) {
+	if uc.embeddings == nil {
+		return
+	}
+	uc.embeddings.EnqueueIdeaEmbedding(ctx, idea.ID)
+}
+
 // Events
 type IdeaCreatedEvent struct {
+	entities.EventHeader
 	IdeaID uuid.UUID
 	UserID uuid.UUID
 	Title  string
 }
 
 type IdeaUpdatedEvent struct {
+	entities.EventHeader
 	IdeaID uuid.UUID
 	UserID uuid.UUID
 	Title  string
 }
 
 type IdeaDeletedEvent struct {
+	entities.EventHeader
 	IdeaID uuid.UUID
 	UserID uuid.UUID
-}
\ No newline at end of file
+}
+
+// moderate revisa el título, el contenido y las etiquetas de la idea si hay moderación configurada
+func (uc *IdeaUseCases) moderate(ctx context.Context, idea *entities.Idea) error {
+	if uc.moderation == nil {
+		return nil
+	}
+	target := ModerationTarget{UserID: idea.UserID, Scope: entities.ModerationScopeOrganization, EntityType: "idea", EntityID: idea.ID}
+	return uc.moderation.Check(ctx, target, moderatedTexts(idea)...)
+}
+
+// moderatedTexts son los campos de la idea que ven los demás usuarios de la organización
+func moderatedTexts(idea *entities.Idea) []string {
+	return append([]string{idea.Title, idea.Content}, idea.Tags...)
+}
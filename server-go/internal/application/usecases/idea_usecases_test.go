@@ -3,71 +3,28 @@ package usecases
 import (
 	"context"
 	"testing"
+	"time"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-// MockIdeaRepository es un mock del repositorio de ideas
-type MockIdeaRepository struct {
-	mock.Mock
-}
-
-func (m *MockIdeaRepository) Create(ctx context.Context, idea *entities.Idea) error {
-	args := m.Called(ctx, idea)
-	return args.Error(0)
-}
-
-func (m *MockIdeaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Idea, error) {
-	args := m.Called(ctx, id)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*entities.Idea), args.Error(1)
-}
-
-func (m *MockIdeaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
-	args := m.Called(ctx, userID, filters)
-	if args.Get(0) == nil {
-		return nil, args.Int(1), args.Error(2)
-	}
-	return args.Get(0).([]*entities.Idea), args.Int(1), args.Error(2)
-}
-
-func (m *MockIdeaRepository) Update(ctx context.Context, idea *entities.Idea) error {
-	args := m.Called(ctx, idea)
-	return args.Error(0)
-}
-
-func (m *MockIdeaRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	args := m.Called(ctx, id)
-	return args.Error(0)
-}
-
-// MockEventBus es un mock del bus de eventos
-type MockEventBus struct {
-	mock.Mock
-}
-
-func (m *MockEventBus) Publish(ctx context.Context, event interface{}) error {
-	args := m.Called(ctx, event)
-	return args.Error(0)
-}
+var testNow = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
 
-func (m *MockEventBus) Subscribe(eventType string, handler ports.EventHandler) error {
-	args := m.Called(eventType, handler)
-	return args.Error(0)
+func newTestIdeaUseCases(repo ports.IdeaRepository, eventBus ports.EventBus) *IdeaUseCases {
+	return NewIdeaUseCases(repo, eventBus, entities.NewFakeClock(testNow), &entities.SequentialIDGenerator{})
 }
 
 func TestCreateIdea_Success(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	title := "Test Idea"
@@ -91,6 +48,8 @@ func TestCreateIdea_Success(t *testing.T) {
 	assert.Equal(t, userID, idea.UserID)
 	assert.Equal(t, tags, idea.Tags)
 	assert.Equal(t, priority, idea.Priority)
+	assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), idea.ID)
+	assert.Equal(t, testNow, idea.CreatedAt)
 	
 	mockRepo.AssertExpectations(t)
 	mockEventBus.AssertExpectations(t)
@@ -98,9 +57,9 @@ func TestCreateIdea_Success(t *testing.T) {
 
 func TestCreateIdea_ValidationError(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	title := "" // Invalid title
@@ -122,9 +81,9 @@ func TestCreateIdea_ValidationError(t *testing.T) {
 
 func TestCreateIdea_RepositoryError(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	title := "Test Idea"
@@ -146,11 +105,59 @@ func TestCreateIdea_RepositoryError(t *testing.T) {
 	mockEventBus.AssertNotCalled(t, "Publish")
 }
 
+func TestCreateIdea_ModerationRejected(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockModerator := mocks.NewContentModerator(t)
+	mockRejections := mocks.NewModerationRejectionRepository(t)
+	clock := entities.NewFakeClock(testNow)
+	ids := &entities.SequentialIDGenerator{}
+	userID := uuid.New()
+	policies := ModerationPolicies{
+		Organizations: map[string]entities.ModerationPolicy{"acme": {Enabled: true}},
+		Members:       map[uuid.UUID]string{userID: "acme"},
+	}
+	moderation := NewModerationUseCases(mockModerator, policies, mockRejections, clock, ids)
+	useCase := NewIdeaUseCases(mockRepo, nil, clock, ids, WithIdeaModeration(moderation))
+
+	verdict := &entities.ModerationVerdict{Flagged: true, Reason: "profanity", Matches: []string{"darn"}, Moderator: "wordlist"}
+	mockModerator.On("Moderate", mock.Anything, "Darn idea\nContent\nwork", policies.Organizations["acme"]).Return(verdict, nil)
+	mockRejections.On("Create", mock.Anything, mock.MatchedBy(func(rejection *entities.ModerationRejection) bool {
+		return rejection.UserID == userID && rejection.Organization == "acme" && rejection.Scope == entities.ModerationScopeOrganization && rejection.Reason == "profanity"
+	})).Return(nil)
+
+	// Act
+	idea, err := useCase.CreateIdea(context.Background(), "Darn idea", "Content", entities.IdeaCategoryBusiness, userID, []string{"work"}, 1)
+
+	// Assert
+	assert.Nil(t, idea)
+	assert.Equal(t, entities.ErrContentRejected, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateIdea_ModerationSkippedWithoutOrganization(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockModerator := mocks.NewContentModerator(t)
+	clock := entities.NewFakeClock(testNow)
+	ids := &entities.SequentialIDGenerator{}
+	moderation := NewModerationUseCases(mockModerator, ModerationPolicies{Default: entities.ModerationPolicy{Enabled: true}}, mocks.NewModerationRejectionRepository(t), clock, ids)
+	useCase := NewIdeaUseCases(mockRepo, nil, clock, ids, WithIdeaModeration(moderation))
+
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entities.Idea")).Return(nil)
+
+	// Act
+	_, err := useCase.CreateIdea(context.Background(), "Darn idea", "Content", entities.IdeaCategoryBusiness, uuid.New(), nil, 1)
+
+	// Assert
+	require.NoError(t, err)
+}
+
 func TestGetIdea_Success(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
@@ -174,9 +181,9 @@ func TestGetIdea_Success(t *testing.T) {
 
 func TestGetIdea_NotFound(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
@@ -196,9 +203,9 @@ func TestGetIdea_NotFound(t *testing.T) {
 
 func TestGetIdea_Unauthorized(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
@@ -225,9 +232,9 @@ func TestGetIdea_Unauthorized(t *testing.T) {
 
 func TestListIdeas_Success(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	filters := ports.IdeaFilters{
@@ -257,16 +264,17 @@ func TestListIdeas_Success(t *testing.T) {
 
 func TestUpdateIdea_Success(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
 	existingIdea := &entities.Idea{
-		ID:     ideaID,
-		Title:  "Original Title",
-		UserID: userID,
+		ID:      ideaID,
+		Title:   "Original Title",
+		Content: "Original content",
+		UserID:  userID,
 	}
 
 	newTitle := "Updated Title"
@@ -276,7 +284,7 @@ func TestUpdateIdea_Success(t *testing.T) {
 	mockEventBus.On("Publish", mock.Anything, mock.AnythingOfType("*usecases.IdeaUpdatedEvent")).Return(nil)
 
 	// Act
-	updatedIdea, err := useCase.UpdateIdea(context.Background(), ideaID, userID, newTitle, "", []string{}, entities.IdeaCategoryUnspecified, entities.IdeaStatusUnspecified, 0)
+	updatedIdea, err := useCase.UpdateIdea(context.Background(), ideaID, userID, 0, newTitle, "", []string{}, entities.IdeaCategoryUnspecified, entities.IdeaStatusUnspecified, 0, nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -287,11 +295,42 @@ func TestUpdateIdea_Success(t *testing.T) {
 	mockEventBus.AssertExpectations(t)
 }
 
+func TestUpdateIdea_VersionConflict(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
+
+	ideaID := uuid.New()
+	userID := uuid.New()
+	existingIdea := &entities.Idea{
+		ID:      ideaID,
+		Title:   "Original Title",
+		UserID:  userID,
+		Version: 3,
+	}
+
+	mockRepo.On("GetByID", mock.Anything, ideaID).Return(existingIdea, nil)
+
+	// Act - client edited version 2, but the stored idea is already at version 3
+	latest, err := useCase.UpdateIdea(context.Background(), ideaID, userID, 2, "Stale Title", "", nil, entities.IdeaCategoryUnspecified, entities.IdeaStatusUnspecified, 0, nil)
+
+	// Assert
+	assert.Equal(t, entities.ErrVersionConflict, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, "Original Title", latest.Title)
+	assert.Equal(t, int64(3), latest.Version)
+	
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Update")
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
+
 func TestDeleteIdea_Success(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
@@ -317,9 +356,9 @@ func TestDeleteIdea_Success(t *testing.T) {
 
 func TestDeleteIdea_Unauthorized(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	ideaID := uuid.New()
 	userID := uuid.New()
@@ -348,9 +387,9 @@ func TestDeleteIdea_Unauthorized(t *testing.T) {
 // Integration-style test
 func TestIdeaUseCases_IntegrationFlow(t *testing.T) {
 	// Arrange
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	
@@ -378,9 +417,9 @@ func TestIdeaUseCases_IntegrationFlow(t *testing.T) {
 
 // Benchmark tests
 func BenchmarkCreateIdea(b *testing.B) {
-	mockRepo := new(MockIdeaRepository)
-	mockEventBus := new(MockEventBus)
-	useCase := NewIdeaUseCases(mockRepo, mockEventBus)
+	mockRepo := mocks.NewIdeaRepository(b)
+	mockEventBus := mocks.NewEventBus(b)
+	useCase := newTestIdeaUseCases(mockRepo, mockEventBus)
 
 	userID := uuid.New()
 	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entities.Idea")).Return(nil)
@@ -0,0 +1,252 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// IdeaChangeType es el tipo de cambio que se envía a quienes observan las ideas de un usuario
+type IdeaChangeType int
+
+const (
+	IdeaChangeCreated IdeaChangeType = iota + 1
+	IdeaChangeUpdated
+	IdeaChangeDeleted
+	// IdeaChangeRemoved indica que la idea cambió y ya no cumple el filtro del observador
+	IdeaChangeRemoved
+)
+
+// ideaWatchBuffer es el número de avisos pendientes por observador; si se llena, el observador se cierra
+const ideaWatchBuffer = 64
+
+// IdeaChange es un cambio en una idea del usuario; Idea es nil en las eliminaciones
+type IdeaChange struct {
+	Type       IdeaChangeType
+	IdeaID     uuid.UUID
+	Idea       *entities.Idea
+	OccurredAt time.Time
+}
+
+// IdeaWatchFilter limita los cambios enviados a las ideas que cumplen todos sus campos; los vacíos no filtran
+type IdeaWatchFilter struct {
+	Category entities.IdeaCategory
+	Status   entities.IdeaStatus
+	// Tags se cumple si la idea tiene alguna de ellas, igual que en ListIdeas
+	Tags []string
+}
+
+// Matches verifica si la idea cumple el filtro
+func (f IdeaWatchFilter) Matches(idea *entities.Idea) bool {
+	if f.Category != entities.IdeaCategoryUnspecified && idea.Category != f.Category {
+		return false
+	}
+	if f.Status != entities.IdeaStatusUnspecified && idea.Status != f.Status {
+		return false
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+	for _, tag := range f.Tags {
+		if idea.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// IdeaWatchUseCases reenvía a los clientes conectados los cambios de las ideas de cada usuario,
+// a partir de los eventos de dominio, para que no tengan que consultar ListIdeas periódicamente
+type IdeaWatchUseCases struct {
+	ideaRepo ports.IdeaRepository
+	spawn    func(name string, fn func())
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[*ideaWatcher]struct{}
+}
+
+type ideaWatcher struct {
+	notices chan ideaChangeNotice
+}
+
+// ideaChangeNotice es lo que se sabe de un cambio al recibir el evento; la idea se lee después,
+// fuera del camino de quien publica
+type ideaChangeNotice struct {
+	changeType IdeaChangeType
+	ideaIDs    []uuid.UUID
+	occurredAt time.Time
+}
+
+// NewIdeaWatchUseCases crea una nueva instancia de IdeaWatchUseCases. spawn arranca la goroutine
+// de cada observador (normalmente supervisor.Go); si es nil se usa una goroutine sin supervisar.
+func NewIdeaWatchUseCases(ideaRepo ports.IdeaRepository, spawn func(name string, fn func())) *IdeaWatchUseCases {
+	if spawn == nil {
+		spawn = func(_ string, fn func()) { go fn() }
+	}
+	return &IdeaWatchUseCases{
+		ideaRepo: ideaRepo,
+		spawn:    spawn,
+		watchers: make(map[uuid.UUID]map[*ideaWatcher]struct{}),
+	}
+}
+
+// Subscribe registra en eventBus los eventos de ideas que se reenvían a los observadores
+func (uc *IdeaWatchUseCases) Subscribe(eventBus ports.EventBus) error {
+	events := []any{
+		(*IdeaCreatedEvent)(nil), (*IdeaUpdatedEvent)(nil), (*IdeaDeletedEvent)(nil),
+		(*IdeaMovedEvent)(nil), (*IdeaAutoTaggedEvent)(nil), (*IdeasBulkTaggedEvent)(nil),
+		(*IdeaPriorityChangedEvent)(nil),
+	}
+	
+	for _, event := range events {
+		if err := eventBus.Subscribe(eventType(event), uc.handleEvent); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", eventType(event), err)
+		}
+	}
+	return nil
+}
+
+// Watch devuelve los cambios de las ideas del usuario que cumplen filter hasta que se cancele ctx.
+// El canal también se cierra si el cliente no consume los cambios a tiempo o si no se puede leer
+// una idea; en ese caso el cliente debe volver a listar las ideas antes de observar de nuevo.
+func (uc *IdeaWatchUseCases) Watch(ctx context.Context, userID uuid.UUID, filter IdeaWatchFilter) <-chan IdeaChange {
+	watcher := &ideaWatcher{notices: make(chan ideaChangeNotice, ideaWatchBuffer)}
+	
+	uc.mu.Lock()
+	if uc.watchers[userID] == nil {
+		uc.watchers[userID] = make(map[*ideaWatcher]struct{})
+	}
+	uc.watchers[userID][watcher] = struct{}{}
+	uc.mu.Unlock()
+	
+	changes := make(chan IdeaChange)
+	uc.spawn("usecases.idea_watch", func() {
+		defer close(changes)
+		defer uc.unregister(userID, watcher)
+	
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notice, ok := <-watcher.notices:
+				if !ok {
+					return
+				}
+				for _, ideaID := range notice.ideaIDs {
+					change, send, err := uc.resolve(ctx, userID, ideaID, notice, filter)
+					if err != nil {
+						return
+					}
+					if !send {
+						continue
+					}
+					select {
+					case changes <- change:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	})
+	
+	return changes
+}
+
+func (uc *IdeaWatchUseCases) handleEvent(ctx context.Context, event interface{}) error {
+	userID := ideaEventUser(event)
+	if userID == uuid.Nil {
+		return nil
+	}
+	
+	notice := ideaChangeNotice{changeType: IdeaChangeUpdated}
+	if e, ok := event.(entities.Event); ok {
+		notice.occurredAt = e.Header().OccurredAt
+	}
+	switch e := event.(type) {
+	case *IdeaCreatedEvent:
+		notice.changeType = IdeaChangeCreated
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	case *IdeaUpdatedEvent:
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	case *IdeaDeletedEvent:
+		notice.changeType = IdeaChangeDeleted
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	case *IdeaMovedEvent:
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	case *IdeaAutoTaggedEvent:
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	case *IdeasBulkTaggedEvent:
+		notice.ideaIDs = e.IdeaIDs
+	case *IdeaPriorityChangedEvent:
+		notice.ideaIDs = []uuid.UUID{e.IdeaID}
+	default:
+		return nil
+	}
+	
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for watcher := range uc.watchers[userID] {
+		select {
+		case watcher.notices <- notice:
+		default:
+			// Un observador lento no frena a quien publica: se cierra y el cliente se resincroniza
+			uc.removeLocked(userID, watcher)
+		}
+	}
+	return nil
+}
+
+// resolve lee la idea de un aviso y decide qué cambio recibe el observador; send es falso si no recibe ninguno
+func (uc *IdeaWatchUseCases) resolve(ctx context.Context, userID, ideaID uuid.UUID, notice ideaChangeNotice, filter IdeaWatchFilter) (IdeaChange, bool, error) {
+	change := IdeaChange{Type: notice.changeType, IdeaID: ideaID, OccurredAt: notice.occurredAt}
+	if notice.changeType == IdeaChangeDeleted {
+		return change, true, nil
+	}
+	
+	idea, err := uc.ideaRepo.GetByID(ctx, ideaID)
+	if err == entities.ErrIdeaNotFound {
+		// Se eliminó antes de que el observador leyera el cambio
+		change.Type = IdeaChangeDeleted
+		return change, true, nil
+	}
+	if err != nil {
+		return change, false, err
+	}
+	if !idea.IsOwnedBy(userID) {
+		return change, false, nil
+	}
+	
+	if !filter.Matches(idea) {
+		if notice.changeType == IdeaChangeCreated {
+			return change, false, nil
+		}
+		change.Type = IdeaChangeRemoved
+		return change, true, nil
+	}
+	
+	change.Idea = idea
+	return change, true, nil
+}
+
+func (uc *IdeaWatchUseCases) unregister(userID uuid.UUID, watcher *ideaWatcher) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.removeLocked(userID, watcher)
+}
+
+func (uc *IdeaWatchUseCases) removeLocked(userID uuid.UUID, watcher *ideaWatcher) {
+	watchers := uc.watchers[userID]
+	if _, ok := watchers[watcher]; !ok {
+		return
+	}
+	delete(watchers, watcher)
+	close(watcher.notices)
+	if len(watchers) == 0 {
+		delete(uc.watchers, userID)
+	}
+}
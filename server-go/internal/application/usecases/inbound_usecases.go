@@ -0,0 +1,269 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// MaxInboundAttachments es el número máximo de adjuntos que se guardan por mensaje de entrada
+const MaxInboundAttachments = 10
+
+// inboundTitleLength es la longitud máxima del título derivado del contenido cuando falta el asunto
+const inboundTitleLength = 80
+
+// InboundKind indica qué se crea con un mensaje de entrada
+type InboundKind string
+
+const (
+	InboundKindIdea     InboundKind = "idea"
+	InboundKindReminder InboundKind = "reminder"
+)
+
+// InboundAttachment es un archivo adjunto de un mensaje de entrada
+type InboundAttachment struct {
+	Filename    string
+	ContentType string
+	Content     io.Reader
+}
+
+// InboundMessage es un correo o petición JSON recibido en una dirección de entrada
+type InboundMessage struct {
+	Kind          InboundKind
+	Sender        string // remitente del correo; vacío en las peticiones JSON
+	Title         string
+	Content       string
+	Category      entities.IdeaCategory
+	Tags          []string
+	Priority      int32
+	ScheduledTime time.Time
+	ReminderType  entities.ReminderType
+	Channels      []string
+	Spam          bool // el proveedor de correo lo marcó como spam o con virus
+	Attachments   []InboundAttachment
+}
+
+// InboundResult contiene lo creado a partir de un mensaje de entrada
+type InboundResult struct {
+	Idea     *entities.Idea
+	Reminder *entities.Reminder
+	Files    []*entities.FileInfo
+}
+
+// InboundUseCases contiene los casos de uso para crear ideas y recordatorios desde correos
+// y peticiones HTTP enviados a direcciones secretas de cada usuario
+type InboundUseCases struct {
+	addressRepo ports.InboundAddressRepository
+	ideas       *IdeaUseCases
+	reminders   *ReminderUseCases
+	files       *FileUseCases
+	eventBus    ports.EventBus
+	clock       entities.Clock
+	ids         entities.IDGenerator
+}
+
+// NewInboundUseCases crea una nueva instancia de InboundUseCases
+func NewInboundUseCases(addressRepo ports.InboundAddressRepository, ideas *IdeaUseCases, reminders *ReminderUseCases, files *FileUseCases, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *InboundUseCases {
+	return &InboundUseCases{
+		addressRepo: addressRepo,
+		ideas:       ideas,
+		reminders:   reminders,
+		files:       files,
+		eventBus:    eventBus,
+		clock:       clock,
+		ids:         ids,
+	}
+}
+
+// CreateAddress crea una dirección de entrada y devuelve el token en claro, que no vuelve a estar
+// disponible. Si allowedSenders no está vacío solo se aceptan correos de esos remitentes.
+func (uc *InboundUseCases) CreateAddress(ctx context.Context, userID uuid.UUID, allowedSenders []string) (*entities.InboundAddress, string, error) {
+	address, token, err := entities.NewInboundAddress(uc.clock, uc.ids, userID, allowedSenders)
+	if err != nil {
+		return nil, "", err
+	}
+	
+	if err := address.Validate(); err != nil {
+		return nil, "", err
+	}
+	
+	if err := uc.addressRepo.Create(ctx, address); err != nil {
+		return nil, "", err
+	}
+	
+	return address, token, nil
+}
+
+// ListAddresses obtiene las direcciones de entrada de un usuario
+func (uc *InboundUseCases) ListAddresses(ctx context.Context, userID uuid.UUID) ([]*entities.InboundAddress, error) {
+	return uc.addressRepo.ListByUserID(ctx, userID)
+}
+
+// RevokeAddress revoca una dirección de entrada; los mensajes posteriores se rechazan
+func (uc *InboundUseCases) RevokeAddress(ctx context.Context, id, userID uuid.UUID) error {
+	address, err := uc.addressRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	
+	if !address.IsOwnedBy(userID) {
+		return entities.ErrInboundAddressUnauthorized
+	}
+	
+	return uc.addressRepo.Revoke(ctx, id, uc.clock.Now())
+}
+
+// Receive crea la idea o el recordatorio descrito por msg en la cuenta dueña de token y guarda sus
+// adjuntos como archivos. Una dirección revocada se trata como inexistente para no revelar que existió.
+func (uc *InboundUseCases) Receive(ctx context.Context, token string, msg InboundMessage) (*InboundResult, error) {
+	address, err := uc.addressRepo.GetByTokenHash(ctx, entities.HashInboundToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if address.IsRevoked() {
+		return nil, entities.ErrInboundAddressNotFound
+	}
+	
+	if msg.Spam {
+		return nil, entities.ErrInboundSpam
+	}
+	if msg.Sender != "" && !address.AllowsSender(msg.Sender) {
+		return nil, entities.ErrInboundSenderNotAllowed
+	}
+	if msg.Kind != InboundKindIdea && msg.Kind != InboundKindReminder {
+		return nil, entities.ErrInboundUnsupportedKind
+	}
+	if len(msg.Attachments) > MaxInboundAttachments {
+		return nil, entities.ErrInboundTooManyAttachments
+	}
+	
+	// Lo creado se atribuye al dueño de la dirección
+	userID := address.UserID
+	ctx = entities.ContextWithEventActor(ctx, userID)
+	
+	title := msg.Title
+	if title == "" {
+		title = titleFromContent(msg.Content)
+	}
+	
+	// Los adjuntos se guardan antes para poder referenciarlos en el contenido. El prefijo evita
+	// que un adjunto se guarde como nueva versión de un archivo del usuario con el mismo nombre.
+	result := &InboundResult{}
+	prefix := uc.ids.NewID().String()[:8]
+	for i, attachment := range msg.Attachments {
+		filename := fmt.Sprintf("inbound-%s-%s", prefix, attachmentName(attachment.Filename, i))
+		fileInfo, err := uc.files.UploadFile(ctx, filename, attachment.ContentType, attachment.Content, userID, false, "", "")
+		if err != nil {
+			uc.discardFiles(ctx, result.Files, userID)
+			return nil, err
+		}
+		result.Files = append(result.Files, fileInfo)
+	}
+	content := withAttachmentList(msg.Content, result.Files)
+	
+	var itemID uuid.UUID
+	switch msg.Kind {
+	case InboundKindIdea:
+		result.Idea, err = uc.ideas.CreateIdea(ctx, title, content, msg.Category, userID, msg.Tags, msg.Priority)
+		if err == nil {
+			itemID = result.Idea.ID
+		}
+	case InboundKindReminder:
+		result.Reminder, err = uc.reminders.CreateReminder(ctx, title, content, msg.ScheduledTime, msg.ReminderType, userID, false, entities.RecurrencePatternUnspecified, msg.Channels, uuid.Nil)
+		if err == nil {
+			itemID = result.Reminder.ID
+		}
+	}
+	if err != nil {
+		uc.discardFiles(ctx, result.Files, userID)
+		return nil, err
+	}
+	
+	// Si falla solo se pierde la fecha de último uso
+	uc.addressRepo.MarkUsed(ctx, address.ID, uc.clock.Now())
+	
+	// Publicar evento de mensaje de entrada recibido
+	if uc.eventBus != nil {
+		fileIDs := make([]uuid.UUID, len(result.Files))
+		for i, fileInfo := range result.Files {
+			fileIDs[i] = fileInfo.ID
+		}
+		event := &InboundMessageReceivedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			AddressID:   address.ID,
+			UserID:      userID,
+			Kind:        msg.Kind,
+			ItemID:      itemID,
+			FileIDs:     fileIDs,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return result, nil
+}
+
+// discardFiles elimina los adjuntos ya guardados de un mensaje que no se pudo procesar
+func (uc *InboundUseCases) discardFiles(ctx context.Context, files []*entities.FileInfo, userID uuid.UUID) {
+	for _, fileInfo := range files {
+		uc.files.DeleteFile(ctx, fileInfo.ID, userID)
+	}
+}
+
+// titleFromContent usa la primera línea no vacía del contenido como título
+func titleFromContent(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if runes := []rune(line); len(runes) > inboundTitleLength {
+			line = string(runes[:inboundTitleLength])
+		}
+		return line
+	}
+	return ""
+}
+
+// attachmentName quita las rutas del nombre del adjunto y nombra los que no lo tienen
+func attachmentName(filename string, index int) string {
+	filename = strings.TrimSpace(filename)
+	if i := strings.LastIndexAny(filename, `/\`); i >= 0 {
+		filename = filename[i+1:]
+	}
+	if filename == "" || filename == "." || filename == ".." {
+		return fmt.Sprintf("attachment-%d", index+1)
+	}
+	return filename
+}
+
+// withAttachmentList añade al contenido la lista de adjuntos guardados, para que la idea o el
+// recordatorio conserve la referencia a sus archivos
+func withAttachmentList(content string, files []*entities.FileInfo) string {
+	if len(files) == 0 {
+		return content
+	}
+	
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(content, "\n"))
+	b.WriteString("\n\nAttachments:")
+	for _, fileInfo := range files {
+		fmt.Fprintf(&b, "\n- %s (file %s)", fileInfo.Filename, fileInfo.ID)
+	}
+	return b.String()
+}
+
+// Events
+type InboundMessageReceivedEvent struct {
+	entities.EventHeader
+	AddressID uuid.UUID
+	UserID    uuid.UUID
+	Kind      InboundKind
+	ItemID    uuid.UUID
+	FileIDs   []uuid.UUID
+}
@@ -0,0 +1,219 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testInboundToken = "0123456789abcdef0123456789abcdef01234567"
+
+type inboundMocks struct {
+	addresses *mocks.InboundAddressRepository
+	ideas     *mocks.IdeaRepository
+	reminders *mocks.ReminderRepository
+	eventBus  *mocks.EventBus
+}
+
+func newTestInboundUseCases(t *testing.T) (*InboundUseCases, inboundMocks) {
+	m := inboundMocks{
+		addresses: mocks.NewInboundAddressRepository(t),
+		ideas:     mocks.NewIdeaRepository(t),
+		reminders: mocks.NewReminderRepository(t),
+		eventBus:  mocks.NewEventBus(t),
+	}
+	clock := entities.NewFakeClock(testNow)
+	ids := &entities.SequentialIDGenerator{}
+	ideas := NewIdeaUseCases(m.ideas, m.eventBus, clock, ids)
+	reminders := NewReminderUseCases(m.reminders, m.ideas, nil, m.eventBus, clock, ids)
+	return NewInboundUseCases(m.addresses, ideas, reminders, nil, m.eventBus, clock, ids), m
+}
+
+func inboundAddressFixture(allowedSenders ...string) *entities.InboundAddress {
+	return &entities.InboundAddress{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		TokenHash:      entities.HashInboundToken(testInboundToken),
+		AllowedSenders: allowedSenders,
+		CreatedAt:      testNow.Add(-24 * time.Hour),
+	}
+}
+
+func TestReceive_CreatesIdeaForAddressOwner(t *testing.T) {
+	// Arrange
+	useCase, m := newTestInboundUseCases(t)
+	address := inboundAddressFixture("@ejemplo.com")
+
+	m.addresses.On("GetByTokenHash", mock.Anything, address.TokenHash).Return(address, nil)
+	m.ideas.On("Create", mock.Anything, mock.MatchedBy(func(idea *entities.Idea) bool {
+		return idea.UserID == address.UserID && idea.Title == "Comprar el dominio"
+	})).Return(nil)
+	m.addresses.On("MarkUsed", mock.Anything, address.ID, testNow).Return(nil)
+	m.eventBus.On("Publish", mock.Anything, mock.AnythingOfType("*usecases.IdeaCreatedEvent")).Return(nil)
+	m.eventBus.On("Publish", mock.Anything, mock.MatchedBy(func(event *InboundMessageReceivedEvent) bool {
+		return event.UserID == address.UserID && event.Kind == InboundKindIdea
+	})).Return(nil)
+
+	// Act
+	// El token no distingue mayúsculas y el título sale de la primera línea con texto
+	result, err := useCase.Receive(context.Background(), "0123456789ABCDEF0123456789ABCDEF01234567", InboundMessage{
+		Kind:     InboundKindIdea,
+		Sender:   "Ana@Ejemplo.com",
+		Content:  "\n   Comprar el dominio  \nAntes de que lo registre otro",
+		Category: entities.IdeaCategoryBusiness,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result.Idea)
+	assert.Nil(t, result.Reminder)
+	assert.Equal(t, "Comprar el dominio", result.Idea.Title)
+	m.reminders.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestReceive_CreatesReminder(t *testing.T) {
+	// Arrange
+	useCase, m := newTestInboundUseCases(t)
+	address := inboundAddressFixture()
+	scheduledTime := testNow.Add(24 * time.Hour)
+
+	m.addresses.On("GetByTokenHash", mock.Anything, address.TokenHash).Return(address, nil)
+	m.reminders.On("Create", mock.Anything, mock.MatchedBy(func(reminder *entities.Reminder) bool {
+		return reminder.UserID == address.UserID && reminder.ScheduledTime.Equal(scheduledTime)
+	})).Return(nil)
+	m.addresses.On("MarkUsed", mock.Anything, address.ID, testNow).Return(nil)
+	m.eventBus.On("Publish", mock.Anything, mock.AnythingOfType("*usecases.ReminderCreatedEvent")).Return(nil)
+	m.eventBus.On("Publish", mock.Anything, mock.MatchedBy(func(event *InboundMessageReceivedEvent) bool {
+		return event.Kind == InboundKindReminder
+	})).Return(nil)
+
+	// Act
+	result, err := useCase.Receive(context.Background(), testInboundToken, InboundMessage{
+		Kind:          InboundKindReminder,
+		Title:         "Llamar al proveedor",
+		ScheduledTime: scheduledTime,
+		ReminderType:  entities.ReminderTypeCall,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result.Reminder)
+	assert.Nil(t, result.Idea)
+	assert.Equal(t, "Llamar al proveedor", result.Reminder.Title)
+	m.ideas.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestReceive_RejectsMessage(t *testing.T) {
+	revokedAt := testNow.Add(-time.Hour)
+	tests := []struct {
+		name    string
+		address func() *entities.InboundAddress
+		msg     InboundMessage
+		want    error
+	}{
+		{
+			name:    "unknown sender",
+			address: func() *entities.InboundAddress { return inboundAddressFixture("ana@ejemplo.com", "@empresa.com") },
+			msg:     InboundMessage{Kind: InboundKindIdea, Sender: "ana@otro.com", Title: "Idea"},
+			want:    entities.ErrInboundSenderNotAllowed,
+		},
+		{
+			name:    "sender without domain",
+			address: func() *entities.InboundAddress { return inboundAddressFixture("@empresa.com") },
+			msg:     InboundMessage{Kind: InboundKindIdea, Sender: "empresa.com", Title: "Idea"},
+			want:    entities.ErrInboundSenderNotAllowed,
+		},
+		{
+			name: "revoked address",
+			address: func() *entities.InboundAddress {
+				address := inboundAddressFixture()
+				address.RevokedAt = &revokedAt
+				return address
+			},
+			msg:  InboundMessage{Kind: InboundKindIdea, Title: "Idea"},
+			want: entities.ErrInboundAddressNotFound,
+		},
+		{
+			name:    "spam",
+			address: func() *entities.InboundAddress { return inboundAddressFixture() },
+			msg:     InboundMessage{Kind: InboundKindIdea, Title: "Idea", Spam: true},
+			want:    entities.ErrInboundSpam,
+		},
+		{
+			name:    "unsupported kind",
+			address: func() *entities.InboundAddress { return inboundAddressFixture() },
+			msg:     InboundMessage{Kind: "note", Title: "Idea"},
+			want:    entities.ErrInboundUnsupportedKind,
+		},
+		{
+			name:    "too many attachments",
+			address: func() *entities.InboundAddress { return inboundAddressFixture() },
+			msg:     InboundMessage{Kind: InboundKindIdea, Title: "Idea", Attachments: make([]InboundAttachment, MaxInboundAttachments+1)},
+			want:    entities.ErrInboundTooManyAttachments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useCase, m := newTestInboundUseCases(t)
+			address := tt.address()
+			m.addresses.On("GetByTokenHash", mock.Anything, address.TokenHash).Return(address, nil)
+
+			// Act
+			result, err := useCase.Receive(context.Background(), testInboundToken, tt.msg)
+
+			// Assert
+			assert.Equal(t, tt.want, err)
+			assert.Nil(t, result)
+			m.addresses.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReceive_UnknownAddress(t *testing.T) {
+	// Arrange
+	useCase, m := newTestInboundUseCases(t)
+	m.addresses.On("GetByTokenHash", mock.Anything, entities.HashInboundToken("desconocido")).Return(nil, entities.ErrInboundAddressNotFound)
+
+	// Act
+	result, err := useCase.Receive(context.Background(), "desconocido", InboundMessage{Kind: InboundKindIdea, Title: "Idea"})
+
+	// Assert
+	assert.Equal(t, entities.ErrInboundAddressNotFound, err)
+	assert.Nil(t, result)
+}
+
+func TestTitleFromContent(t *testing.T) {
+	long := strings.Repeat("ñ", inboundTitleLength+5)
+
+	assert.Equal(t, "Primera línea", titleFromContent("\n \t\n  Primera línea  \nSegunda"))
+	assert.Equal(t, "", titleFromContent(" \n\n"))
+	assert.Equal(t, []rune(long)[:inboundTitleLength], []rune(titleFromContent(long)))
+}
+
+func TestAttachmentName(t *testing.T) {
+	assert.Equal(t, "factura.pdf", attachmentName(" factura.pdf ", 0))
+	assert.Equal(t, "passwd", attachmentName("../../etc/passwd", 0))
+	assert.Equal(t, "foto.jpg", attachmentName(`C:\Users\ana\foto.jpg`, 0))
+	assert.Equal(t, "attachment-3", attachmentName("..", 2))
+	assert.Equal(t, "attachment-1", attachmentName("carpeta/", 0))
+}
+
+func TestWithAttachmentList(t *testing.T) {
+	fileID := uuid.MustParse("6f1f2d7b-9c10-4f0e-9a51-0b6c8f0e3c1a")
+
+	assert.Equal(t, "Contenido\n", withAttachmentList("Contenido\n", nil))
+	assert.Equal(t,
+		"Contenido\n\nAttachments:\n- inbound-factura.pdf (file 6f1f2d7b-9c10-4f0e-9a51-0b6c8f0e3c1a)",
+		withAttachmentList("Contenido\n\n", []*entities.FileInfo{{ID: fileID, Filename: "inbound-factura.pdf"}}),
+	)
+}
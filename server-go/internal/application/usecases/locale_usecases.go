@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// LocaleUseCases contiene los casos de uso para el idioma preferido de cada usuario, con el que
+// el servidor redacta sus respuestas y notificaciones
+type LocaleUseCases struct {
+	preferenceRepo ports.LocalePreferenceRepository
+	supported      map[string]bool
+	eventBus       ports.EventBus
+	clock          entities.Clock
+	ids            entities.IDGenerator
+}
+
+// NewLocaleUseCases crea una nueva instancia de LocaleUseCases; supportedLocales son los idiomas
+// que tienen catálogo de mensajes
+func NewLocaleUseCases(preferenceRepo ports.LocalePreferenceRepository, supportedLocales []string, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *LocaleUseCases {
+	supported := make(map[string]bool, len(supportedLocales))
+	for _, locale := range supportedLocales {
+		supported[normalizeLocale(locale)] = true
+	}
+	return &LocaleUseCases{
+		preferenceRepo: preferenceRepo,
+		supported:      supported,
+		eventBus:       eventBus,
+		clock:          clock,
+		ids:            ids,
+	}
+}
+
+// SupportedLocales devuelve los idiomas que se pueden elegir, en orden alfabético
+func (uc *LocaleUseCases) SupportedLocales() []string {
+	locales := make([]string, 0, len(uc.supported))
+	for locale := range uc.supported {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// GetLocale obtiene el idioma preferido de un usuario; devuelve "" si no eligió ninguno
+func (uc *LocaleUseCases) GetLocale(ctx context.Context, userID uuid.UUID) (string, error) {
+	locale, err := uc.preferenceRepo.GetLocale(ctx, userID)
+	if errors.Is(err, entities.ErrLocalePreferenceNotFound) {
+		return "", nil
+	}
+	return locale, err
+}
+
+// SetLocale guarda el idioma preferido de un usuario
+func (uc *LocaleUseCases) SetLocale(ctx context.Context, userID uuid.UUID, locale string) (string, error) {
+	locale = normalizeLocale(locale)
+	if !uc.supported[locale] {
+		return "", entities.ErrUnsupportedLocale
+	}
+	
+	if err := uc.preferenceRepo.SetLocale(ctx, userID, locale, uc.clock.Now()); err != nil {
+		return "", err
+	}
+	
+	// Publicar evento de idioma cambiado
+	if uc.eventBus != nil {
+		event := &LocalePreferenceChangedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			UserID:      userID,
+			Locale:      locale,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return locale, nil
+}
+
+// normalizeLocale deja solo el idioma principal de una etiqueta como "es-AR" o "pt_BR"
+func normalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
+
+// Events
+type LocalePreferenceChangedEvent struct {
+	entities.EventHeader
+	UserID uuid.UUID
+	Locale string
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// maxModerationRejections es el máximo de rechazos devueltos por consulta de auditoría
+const maxModerationRejections = 500
+
+// OrganizationMembers asigna cada usuario a su organización
+type OrganizationMembers map[uuid.UUID]string
+
+// Of devuelve la organización de userID, o "" si no pertenece a ninguna
+func (m OrganizationMembers) Of(userID uuid.UUID) string {
+	return m[userID]
+}
+
+// ModerationPolicies son las políticas de moderación por organización
+type ModerationPolicies struct {
+	// Default se aplica a los usuarios sin organización y a las organizaciones sin política propia
+	Default       entities.ModerationPolicy            `json:"default"`
+	Organizations map[string]entities.ModerationPolicy `json:"organizations"`
+	Members       OrganizationMembers                  `json:"members"`
+}
+
+// For devuelve la organización de userID, o "" si no pertenece a ninguna, y su política
+func (p ModerationPolicies) For(userID uuid.UUID) (string, entities.ModerationPolicy) {
+	organization := p.Members.Of(userID)
+	if policy, ok := p.Organizations[organization]; ok && organization != "" {
+		return organization, policy
+	}
+	return organization, p.Default
+}
+
+// ModerationTarget identifica el contenido revisado en la auditoría
+type ModerationTarget struct {
+	UserID     uuid.UUID
+	Scope      entities.ModerationScope
+	EntityType string
+	EntityID   uuid.UUID
+}
+
+// ModerationUseCases contiene los casos de uso para revisar el contenido compartido con otros usuarios
+type ModerationUseCases struct {
+	local         ports.ContentModerator
+	external      ports.ContentModerator
+	policies      ModerationPolicies
+	rejectionRepo ports.ModerationRejectionRepository
+	clock         entities.Clock
+	ids           entities.IDGenerator
+}
+
+// ModerationOption configura parámetros opcionales de ModerationUseCases
+type ModerationOption func(*ModerationUseCases)
+
+// WithExternalModerator revisa con moderator el contenido de las organizaciones cuya política lo
+// pide; si falla se usa el moderador local
+func WithExternalModerator(moderator ports.ContentModerator) ModerationOption {
+	return func(uc *ModerationUseCases) {
+		uc.external = moderator
+	}
+}
+
+// NewModerationUseCases crea una nueva instancia de ModerationUseCases
+func NewModerationUseCases(local ports.ContentModerator, policies ModerationPolicies, rejectionRepo ports.ModerationRejectionRepository, clock entities.Clock, ids entities.IDGenerator, options ...ModerationOption) *ModerationUseCases {
+	uc := &ModerationUseCases{
+		local:         local,
+		policies:      policies,
+		rejectionRepo: rejectionRepo,
+		clock:         clock,
+		ids:           ids,
+	}
+	for _, option := range options {
+		option(uc)
+	}
+	return uc
+}
+
+// Check revisa los textos que se van a compartir en target.Scope con la política de la organización
+// del usuario. Devuelve entities.ErrContentRejected, después de registrar el rechazo, si el
+// moderador los marca. El contenido de organización solo se revisa si el usuario pertenece a una.
+func (uc *ModerationUseCases) Check(ctx context.Context, target ModerationTarget, texts ...string) error {
+	organization, policy := uc.policies.For(target.UserID)
+	if target.Scope == entities.ModerationScopeOrganization && organization == "" {
+		return nil
+	}
+	if !policy.Applies(target.Scope) {
+		return nil
+	}
+
+	text := strings.Join(texts, "\n")
+	verdict, err := uc.moderate(ctx, text, policy)
+	if err != nil {
+		return err
+	}
+	if !verdict.Flagged {
+		return nil
+	}
+
+	rejection := entities.NewModerationRejection(uc.clock, uc.ids, target.UserID, organization, target.Scope, target.EntityType, target.EntityID, verdict)
+	// El contenido se rechaza aunque falle la auditoría
+	_ = uc.rejectionRepo.Create(ctx, rejection)
+	return entities.ErrContentRejected
+}
+
+func (uc *ModerationUseCases) moderate(ctx context.Context, text string, policy entities.ModerationPolicy) (*entities.ModerationVerdict, error) {
+	if policy.UseExternal && uc.external != nil {
+		if verdict, err := uc.external.Moderate(ctx, text, policy); err == nil {
+			return verdict, nil
+		}
+	}
+	return uc.local.Moderate(ctx, text, policy)
+}
+
+// ListRejections devuelve los rechazos más recientes de organization, o de todas si está vacía
+func (uc *ModerationUseCases) ListRejections(ctx context.Context, organization string, limit int) ([]*entities.ModerationRejection, error) {
+	if limit <= 0 || limit > maxModerationRejections {
+		limit = maxModerationRejections
+	}
+	return uc.rejectionRepo.List(ctx, organization, limit)
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// DefaultPhoneVerificationCodeTTL es la vigencia del código que se envía por SMS al registrar un teléfono
+const DefaultPhoneVerificationCodeTTL = 10 * time.Minute
+
+// PhoneUseCases contiene los casos de uso para registrar y verificar el teléfono al que se envían
+// las notificaciones por SMS
+type PhoneUseCases struct {
+	phoneRepo  ports.PhoneNumberRepository
+	smsSender  ports.SMSSender
+	dailyLimit int
+	eventBus   ports.EventBus
+	clock      entities.Clock
+	ids        entities.IDGenerator
+}
+
+// NewPhoneUseCases crea una nueva instancia de PhoneUseCases; dailyLimit es el máximo de SMS por
+// usuario y día, y también cuenta los códigos de verificación
+func NewPhoneUseCases(phoneRepo ports.PhoneNumberRepository, smsSender ports.SMSSender, dailyLimit int, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *PhoneUseCases {
+	return &PhoneUseCases{
+		phoneRepo:  phoneRepo,
+		smsSender:  smsSender,
+		dailyLimit: dailyLimit,
+		eventBus:   eventBus,
+		clock:      clock,
+		ids:        ids,
+	}
+}
+
+// StartPhoneVerification registra number sin verificar, reemplazando el teléfono anterior, y le
+// envía por SMS el código que hay que confirmar antes de DefaultPhoneVerificationCodeTTL
+func (uc *PhoneUseCases) StartPhoneVerification(ctx context.Context, userID uuid.UUID, number string) (*entities.PhoneNumber, error) {
+	phone, code, err := entities.NewPhoneNumber(uc.clock, userID, number, DefaultPhoneVerificationCodeTTL)
+	if err != nil {
+		return nil, err
+	}
+	
+	reserved, err := uc.phoneRepo.ReserveSMS(ctx, userID, phone.CreatedAt, uc.dailyLimit)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return nil, entities.ErrSMSDailyLimitReached
+	}
+	
+	if err := uc.phoneRepo.Save(ctx, phone); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.smsSender.SendSMS(ctx, phone.Number, fmt.Sprintf("Notebook verification code: %s", code)); err != nil {
+		return nil, fmt.Errorf("failed to send verification code: %w", err)
+	}
+	
+	return phone, nil
+}
+
+// ConfirmPhoneVerification verifica el teléfono del usuario con el código recibido. Los intentos
+// fallidos se guardan para que no se pueda probar códigos indefinidamente.
+func (uc *PhoneUseCases) ConfirmPhoneVerification(ctx context.Context, userID uuid.UUID, code string) (*entities.PhoneNumber, error) {
+	phone, err := uc.phoneRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if phone.IsVerified() {
+		return phone, nil
+	}
+	
+	if err := phone.Verify(code, uc.clock.Now()); err != nil {
+		if err == entities.ErrInvalidPhoneVerificationCode {
+			if saveErr := uc.phoneRepo.Save(ctx, phone); saveErr != nil {
+				return nil, saveErr
+			}
+		}
+		return nil, err
+	}
+	
+	if err := uc.phoneRepo.Save(ctx, phone); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de teléfono verificado
+	if uc.eventBus != nil {
+		event := &PhoneNumberVerifiedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return phone, nil
+}
+
+// GetPhoneNumber obtiene el teléfono registrado por un usuario
+func (uc *PhoneUseCases) GetPhoneNumber(ctx context.Context, userID uuid.UUID) (*entities.PhoneNumber, error) {
+	return uc.phoneRepo.GetByUserID(ctx, userID)
+}
+
+// DeletePhoneNumber elimina el teléfono de un usuario; deja de recibir notificaciones por SMS
+func (uc *PhoneUseCases) DeletePhoneNumber(ctx context.Context, userID uuid.UUID) error {
+	if err := uc.phoneRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+	
+	// Publicar evento de teléfono eliminado
+	if uc.eventBus != nil {
+		event := &PhoneNumberDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// Events
+type PhoneNumberVerifiedEvent struct {
+	entities.EventHeader
+	UserID uuid.UUID
+}
+
+type PhoneNumberDeletedEvent struct {
+	entities.EventHeader
+	UserID uuid.UUID
+}
@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// TemplateLibrary selecciona qué plantillas de progreso se listan
+type TemplateLibrary int
+
+const (
+	// TemplateLibraryOwn son las plantillas del usuario, compartidas o no
+	TemplateLibraryOwn TemplateLibrary = iota
+	// TemplateLibraryOrganization son las plantillas compartidas con la organización del usuario
+	TemplateLibraryOrganization
+)
+
+// ProgressTemplateUseCases contiene los casos de uso para las plantillas de progreso y su
+// biblioteca compartida por organización
+type ProgressTemplateUseCases struct {
+	templateRepo ports.ProgressTemplateRepository
+	progressRepo ports.ProgressRepository
+	members      OrganizationMembers
+	eventBus     ports.EventBus
+	clock        entities.Clock
+	ids          entities.IDGenerator
+}
+
+// NewProgressTemplateUseCases crea una nueva instancia de ProgressTemplateUseCases; sin members
+// ningún usuario pertenece a una organización y las plantillas no se pueden compartir
+func NewProgressTemplateUseCases(templateRepo ports.ProgressTemplateRepository, progressRepo ports.ProgressRepository, members OrganizationMembers, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ProgressTemplateUseCases {
+	return &ProgressTemplateUseCases{
+		templateRepo: templateRepo,
+		progressRepo: progressRepo,
+		members:      members,
+		eventBus:     eventBus,
+		clock:        clock,
+		ids:          ids,
+	}
+}
+
+// CreateTemplate crea una plantilla privada del usuario
+func (uc *ProgressTemplateUseCases) CreateTemplate(ctx context.Context, ownerID uuid.UUID, name, description string, milestones []entities.TemplateMilestone) (*entities.ProgressTemplate, error) {
+	template := entities.NewProgressTemplate(uc.clock, uc.ids, ownerID, name, description, milestones)
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	// Publicar evento de plantilla creada
+	if uc.eventBus != nil {
+		event := &ProgressTemplateCreatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, ownerID),
+			TemplateID:  template.ID,
+			OwnerID:     ownerID,
+			Name:        template.Name,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return template, nil
+}
+
+// ListTemplates devuelve las plantillas de library; la biblioteca de organización está vacía para
+// los usuarios sin organización
+func (uc *ProgressTemplateUseCases) ListTemplates(ctx context.Context, userID uuid.UUID, library TemplateLibrary) ([]*entities.ProgressTemplate, error) {
+	if library == TemplateLibraryOrganization {
+		organization := uc.members.Of(userID)
+		if organization == "" {
+			return nil, nil
+		}
+		return uc.templateRepo.ListByOrganization(ctx, organization)
+	}
+	return uc.templateRepo.ListByOwner(ctx, userID)
+}
+
+// ShareTemplate comparte la plantilla con la organización del dueño o, si shared es false, la
+// vuelve privada. Devuelve entities.ErrNoOrganization si el dueño no pertenece a ninguna.
+func (uc *ProgressTemplateUseCases) ShareTemplate(ctx context.Context, id, userID uuid.UUID, shared bool) (*entities.ProgressTemplate, error) {
+	template, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var organization string
+	if shared {
+		if organization = uc.members.Of(userID); organization == "" {
+			return nil, entities.ErrNoOrganization
+		}
+	}
+	if template.Organization == organization {
+		return template, nil
+	}
+
+	template.Share(organization, uc.clock.Now())
+	if err := uc.templateRepo.Update(ctx, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// DeleteTemplate elimina una plantilla; los registros de progreso creados con ella no cambian
+func (uc *ProgressTemplateUseCases) DeleteTemplate(ctx context.Context, id, userID uuid.UUID) error {
+	if _, err := uc.getOwned(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := uc.templateRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// Publicar evento de plantilla eliminada
+	if uc.eventBus != nil {
+		event := &ProgressTemplateDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			TemplateID:  id,
+			OwnerID:     userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return nil
+}
+
+// InstantiateTemplate crea un registro de progreso del usuario con los hitos de una plantilla
+// propia o compartida con su organización; los vencimientos cuentan desde start o, si es cero,
+// desde ahora
+func (uc *ProgressTemplateUseCases) InstantiateTemplate(ctx context.Context, id, userID uuid.UUID, projectName, description string, start time.Time) (*entities.Progress, error) {
+	template, err := uc.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !template.IsVisibleTo(userID, uc.members.Of(userID)) {
+		return nil, entities.ErrProgressTemplateUnauthorized
+	}
+
+	if start.IsZero() {
+		start = uc.clock.Now()
+	}
+	progress := template.Instantiate(uc.clock, uc.ids, userID, projectName, description, start)
+	if err := progress.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.progressRepo.Create(ctx, progress); err != nil {
+		return nil, err
+	}
+
+	// Publicar evento de progreso creado
+	if uc.eventBus != nil {
+		event := &ProgressCreatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ProgressID:  progress.ID,
+			UserID:      userID,
+			ProjectName: projectName,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return progress, nil
+}
+
+// getOwned obtiene una plantilla verificando que pertenezca a userID
+func (uc *ProgressTemplateUseCases) getOwned(ctx context.Context, id, userID uuid.UUID) (*entities.ProgressTemplate, error) {
+	template, err := uc.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !template.IsOwnedBy(userID) {
+		return nil, entities.ErrProgressTemplateUnauthorized
+	}
+
+	return template, nil
+}
+
+// Events
+type ProgressTemplateCreatedEvent struct {
+	entities.EventHeader
+	TemplateID uuid.UUID
+	OwnerID    uuid.UUID
+	Name       string
+}
+
+type ProgressTemplateDeletedEvent struct {
+	entities.EventHeader
+	TemplateID uuid.UUID
+	OwnerID    uuid.UUID
+}
@@ -0,0 +1,463 @@
+package usecases
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ProgressUseCases contiene los casos de uso para el progreso de proyectos y sus hitos
+type ProgressUseCases struct {
+	progressRepo ports.ProgressRepository
+	eventBus     ports.EventBus
+	clock        entities.Clock
+	ids          entities.IDGenerator
+	fieldRepo    ports.CustomFieldRepository
+	entryRepo    ports.TimeEntryRepository
+}
+
+// ProgressOption configura parámetros opcionales de ProgressUseCases
+type ProgressOption func(*ProgressUseCases)
+
+// WithProgressCustomFields permite asignar al progreso los campos personalizados que define cada usuario
+func WithProgressCustomFields(fieldRepo ports.CustomFieldRepository) ProgressOption {
+	return func(uc *ProgressUseCases) {
+		uc.fieldRepo = fieldRepo
+	}
+}
+
+// WithProgressTimeTracking permite registrar tiempo en los hitos y comparar el esfuerzo real con el
+// estimado
+func WithProgressTimeTracking(entryRepo ports.TimeEntryRepository) ProgressOption {
+	return func(uc *ProgressUseCases) {
+		uc.entryRepo = entryRepo
+	}
+}
+
+// NewProgressUseCases crea una nueva instancia de ProgressUseCases
+func NewProgressUseCases(progressRepo ports.ProgressRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator, opts ...ProgressOption) *ProgressUseCases {
+	uc := &ProgressUseCases{
+		progressRepo: progressRepo,
+		eventBus:     eventBus,
+		clock:        clock,
+		ids:          ids,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// CreateProgress crea un nuevo registro de progreso sin hitos
+func (uc *ProgressUseCases) CreateProgress(ctx context.Context, userID uuid.UUID, projectName, description string) (*entities.Progress, error) {
+	progress := entities.NewProgress(uc.clock, uc.ids, userID, projectName, description)
+	
+	if err := progress.Validate(); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.progressRepo.Create(ctx, progress); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de progreso creado
+	if uc.eventBus != nil {
+		event := &ProgressCreatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ProgressID:  progress.ID,
+			UserID:      userID,
+			ProjectName: projectName,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return progress, nil
+}
+
+// GetProgress obtiene un registro de progreso por ID
+func (uc *ProgressUseCases) GetProgress(ctx context.Context, id, userID uuid.UUID) (*entities.Progress, error) {
+	return uc.getOwned(ctx, id, userID)
+}
+
+// ListProgress obtiene el progreso de un usuario filtrado por completación, junto con el total
+// de registros que cumplen los filtros antes de paginar
+func (uc *ProgressUseCases) ListProgress(ctx context.Context, userID uuid.UUID, filters ports.ProgressFilters) ([]*entities.Progress, int, error) {
+	if err := validateProgressFilters(filters); err != nil {
+		return nil, 0, err
+	}
+	
+	// Las páginas empiezan en 1; sin página se devuelve la primera
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	
+	return uc.progressRepo.List(ctx, userID, filters)
+}
+
+// UpdateProgress actualiza un registro de progreso existente.
+// Si expectedVersion no coincide con la versión almacenada devuelve el progreso más reciente junto con ErrVersionConflict.
+// Si updateMask no está vacío solo se modifican los campos indicados, incluso si su nuevo valor es vacío.
+func (uc *ProgressUseCases) UpdateProgress(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, projectName, description string, completionPercentage float32, milestones []entities.ProgressMilestone, updateMask []string) (*entities.Progress, error) {
+	return uc.modify(ctx, id, userID, expectedVersion, func(progress *entities.Progress, now time.Time) error {
+		if len(updateMask) > 0 {
+			return progress.UpdateFields(updateMask, projectName, description, completionPercentage, milestones, now)
+		}
+		return progress.Update(projectName, description, completionPercentage, milestones, now)
+	})
+}
+
+// SetProgressCustomFields reemplaza los valores de los campos personalizados del progreso; fields
+// vacío los quita todos
+func (uc *ProgressUseCases) SetProgressCustomFields(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, fields entities.CustomFields) (*entities.Progress, error) {
+	if err := validateCustomFields(ctx, uc.fieldRepo, userID, entities.CustomFieldEntityProgress, fields); err != nil {
+		return nil, err
+	}
+	
+	return uc.modify(ctx, id, userID, expectedVersion, func(progress *entities.Progress, now time.Time) error {
+		progress.SetCustomFields(fields, now)
+		return nil
+	})
+}
+
+// AddMilestone añade un hito al progreso y recalcula el porcentaje de completación
+func (uc *ProgressUseCases) AddMilestone(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, name, description string, dueDate time.Time, estimatedEffort, estimatedDuration time.Duration) (*entities.Progress, error) {
+	milestone := entities.NewMilestone(uc.ids, name, description, dueDate)
+	milestone.EstimatedEffort = estimatedEffort
+	milestone.EstimatedDuration = estimatedDuration
+	if err := milestone.Validate(); err != nil {
+		return nil, err
+	}
+	
+	return uc.modify(ctx, id, userID, expectedVersion, func(progress *entities.Progress, now time.Time) error {
+		progress.AddMilestone(milestone, now)
+		return nil
+	})
+}
+
+// CompleteMilestone marca un hito como completado; completar un hito ya completado no tiene efecto
+func (uc *ProgressUseCases) CompleteMilestone(ctx context.Context, id, milestoneID, userID uuid.UUID, expectedVersion int64) (*entities.Progress, error) {
+	progress, changed, err := uc.setMilestoneCompleted(ctx, id, milestoneID, userID, expectedVersion, true)
+	if err != nil || !changed {
+		return progress, err
+	}
+	
+	// Publicar evento de hito completado
+	if uc.eventBus != nil {
+		milestone, _ := progress.FindMilestone(milestoneID)
+		event := &MilestoneCompletedEvent{
+			EventHeader:          newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ProgressID:           progress.ID,
+			MilestoneID:          milestoneID,
+			UserID:               userID,
+			Name:                 milestone.Name,
+			CompletionPercentage: progress.CompletionPercentage,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return progress, nil
+}
+
+// UncompleteMilestone vuelve a marcar un hito como pendiente; si ya lo estaba no tiene efecto
+func (uc *ProgressUseCases) UncompleteMilestone(ctx context.Context, id, milestoneID, userID uuid.UUID, expectedVersion int64) (*entities.Progress, error) {
+	progress, _, err := uc.setMilestoneCompleted(ctx, id, milestoneID, userID, expectedVersion, false)
+	return progress, err
+}
+
+// RemoveMilestone elimina un hito del progreso y recalcula el porcentaje de completación
+func (uc *ProgressUseCases) RemoveMilestone(ctx context.Context, id, milestoneID, userID uuid.UUID, expectedVersion int64) (*entities.Progress, error) {
+	return uc.modify(ctx, id, userID, expectedVersion, func(progress *entities.Progress, now time.Time) error {
+		if !progress.RemoveMilestone(milestoneID, now) {
+			return entities.ErrMilestoneNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteProgress elimina un registro de progreso
+func (uc *ProgressUseCases) DeleteProgress(ctx context.Context, id, userID uuid.UUID) error {
+	if _, err := uc.getOwned(ctx, id, userID); err != nil {
+		return err
+	}
+	
+	if err := uc.progressRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	
+	// Publicar evento de progreso eliminado
+	if uc.eventBus != nil {
+		event := &ProgressDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ProgressID:  id,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// StartTimer pone en marcha el cronómetro del usuario en un hito. Si ya estaba en marcha en ese
+// hito lo devuelve sin cambios; si lo estaba en otro, lo detiene primero y lo devuelve en stopped.
+func (uc *ProgressUseCases) StartTimer(ctx context.Context, id, milestoneID, userID uuid.UUID) (started, stopped *entities.TimeEntry, err error) {
+	if uc.entryRepo == nil {
+		return nil, nil, entities.ErrServiceUnavailable
+	}
+
+	progress, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := progress.FindMilestone(milestoneID); !ok {
+		return nil, nil, entities.ErrMilestoneNotFound
+	}
+
+	running, err := uc.entryRepo.GetRunning(ctx, userID)
+	switch {
+	case err == nil:
+		if running.MilestoneID == milestoneID {
+			return running, nil, nil
+		}
+		if err := uc.stopTimer(ctx, running); err != nil {
+			return nil, nil, err
+		}
+		stopped = running
+	case err != entities.ErrNoRunningTimer:
+		return nil, nil, err
+	}
+
+	entry := entities.NewTimeEntry(uc.clock, uc.ids, userID, id, milestoneID)
+	if err := uc.entryRepo.Create(ctx, entry); err != nil {
+		return nil, stopped, err
+	}
+	return entry, stopped, nil
+}
+
+// StopTimer detiene el cronómetro en marcha del usuario; devuelve entities.ErrNoRunningTimer si
+// no hay ninguno
+func (uc *ProgressUseCases) StopTimer(ctx context.Context, userID uuid.UUID) (*entities.TimeEntry, error) {
+	if uc.entryRepo == nil {
+		return nil, entities.ErrServiceUnavailable
+	}
+
+	running, err := uc.entryRepo.GetRunning(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.stopTimer(ctx, running); err != nil {
+		return nil, err
+	}
+	return running, nil
+}
+
+// GetEffort compara el esfuerzo estimado de los hitos del progreso con el tiempo registrado
+func (uc *ProgressUseCases) GetEffort(ctx context.Context, id, userID uuid.UUID) (*entities.ProgressEffort, error) {
+	if uc.entryRepo == nil {
+		return nil, entities.ErrServiceUnavailable
+	}
+
+	progress, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := uc.entryRepo.ListByProgress(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewProgressEffort(progress, entries, uc.clock.Now()), nil
+}
+
+// GetForecast prevé cuándo terminará el progreso simulando sus hitos pendientes con lo que
+// tardaron, frente a lo estimado, los hitos ya completados en los proyectos del usuario
+func (uc *ProgressUseCases) GetForecast(ctx context.Context, id, userID uuid.UUID) (*entities.ProgressForecast, error) {
+	progress, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := uc.progressRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	rng := rand.New(rand.NewSource(now.UnixNano()))
+	return entities.NewProgressForecast(progress, entities.MilestoneDurationRatios(history), now, rng, entities.ForecastRuns)
+}
+
+// stopTimer guarda el fin de entry y publica TimeTrackedEvent
+func (uc *ProgressUseCases) stopTimer(ctx context.Context, entry *entities.TimeEntry) error {
+	now := uc.clock.Now()
+	entry.Stop(now)
+	if err := uc.entryRepo.Stop(ctx, entry); err != nil {
+		return err
+	}
+
+	// Publicar evento de tiempo registrado
+	if uc.eventBus != nil {
+		event := &TimeTrackedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, entry.UserID),
+			EntryID:     entry.ID,
+			ProgressID:  entry.ProgressID,
+			MilestoneID: entry.MilestoneID,
+			UserID:      entry.UserID,
+			Duration:    entry.Duration(now),
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	return nil
+}
+
+// setMilestoneCompleted cambia el estado de un hito e indica si hubo cambios; si el hito ya tenía
+// ese estado devuelve el progreso sin guardarlo
+func (uc *ProgressUseCases) setMilestoneCompleted(ctx context.Context, id, milestoneID, userID uuid.UUID, expectedVersion int64, completed bool) (*entities.Progress, bool, error) {
+	current, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	
+	milestone, ok := current.FindMilestone(milestoneID)
+	if !ok {
+		return nil, false, entities.ErrMilestoneNotFound
+	}
+	if !current.HasVersion(expectedVersion) {
+		return current, false, entities.ErrVersionConflict
+	}
+	if milestone.Completed == completed {
+		return current, false, nil
+	}
+	
+	progress, err := uc.modify(ctx, id, userID, current.Version, func(progress *entities.Progress, now time.Time) error {
+		var found bool
+		if completed {
+			found = progress.CompleteMilestone(milestoneID, now)
+		} else {
+			found = progress.UncompleteMilestone(milestoneID, now)
+		}
+		if !found {
+			return entities.ErrMilestoneNotFound
+		}
+		return nil
+	})
+	return progress, err == nil, err
+}
+
+// modify aplica apply al progreso, lo valida, lo guarda y publica ProgressUpdatedEvent
+func (uc *ProgressUseCases) modify(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, apply func(progress *entities.Progress, now time.Time) error) (*entities.Progress, error) {
+	progress, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !progress.HasVersion(expectedVersion) {
+		return progress, entities.ErrVersionConflict
+	}
+	
+	if err := apply(progress, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	
+	if err := progress.Validate(); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.progressRepo.Update(ctx, progress); err != nil {
+		if err == entities.ErrVersionConflict {
+			// Otra escritura ganó la carrera: devolver el estado actual para que el cliente pueda fusionar
+			if latest, getErr := uc.progressRepo.GetByID(ctx, id); getErr == nil {
+				return latest, err
+			}
+		}
+		return nil, err
+	}
+	
+	// Publicar evento de progreso actualizado
+	if uc.eventBus != nil {
+		event := &ProgressUpdatedEvent{
+			EventHeader:          newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ProgressID:           progress.ID,
+			UserID:               userID,
+			ProjectName:          progress.ProjectName,
+			CompletionPercentage: progress.CompletionPercentage,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return progress, nil
+}
+
+// getOwned obtiene un registro de progreso verificando que pertenezca a userID
+func (uc *ProgressUseCases) getOwned(ctx context.Context, id, userID uuid.UUID) (*entities.Progress, error) {
+	progress, err := uc.progressRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !progress.IsOwnedBy(userID) {
+		return nil, entities.ErrProgressUnauthorized
+	}
+	
+	return progress, nil
+}
+
+// validateProgressFilters valida la paginación y el rango de completación de los filtros
+func validateProgressFilters(filters ports.ProgressFilters) error {
+	if filters.Page < 0 || filters.PageSize < 0 {
+		return entities.ErrInvalidPagination
+	}
+	for _, bound := range []*float32{filters.MinCompletion, filters.MaxCompletion} {
+		if bound != nil && (*bound < 0 || *bound > 100) {
+			return entities.ErrInvalidCompletionPercentage
+		}
+	}
+	if filters.MinCompletion != nil && filters.MaxCompletion != nil && *filters.MinCompletion > *filters.MaxCompletion {
+		return entities.ErrInvalidProgressFilters
+	}
+	if err := entities.ValidateSort(filters.Sort, entities.ProgressSortFields); err != nil {
+		return err
+	}
+	return validateCustomFieldFilters(filters.CustomFields)
+}
+
+// Events
+type ProgressCreatedEvent struct {
+	entities.EventHeader
+	ProgressID  uuid.UUID
+	UserID      uuid.UUID
+	ProjectName string
+}
+
+type ProgressUpdatedEvent struct {
+	entities.EventHeader
+	ProgressID           uuid.UUID
+	UserID               uuid.UUID
+	ProjectName          string
+	CompletionPercentage float32
+}
+
+type MilestoneCompletedEvent struct {
+	entities.EventHeader
+	ProgressID           uuid.UUID
+	MilestoneID          uuid.UUID
+	UserID               uuid.UUID
+	Name                 string
+	CompletionPercentage float32
+}
+
+type ProgressDeletedEvent struct {
+	entities.EventHeader
+	ProgressID uuid.UUID
+	UserID     uuid.UUID
+}
+
+type TimeTrackedEvent struct {
+	entities.EventHeader
+	EntryID     uuid.UUID
+	ProgressID  uuid.UUID
+	MilestoneID uuid.UUID
+	UserID      uuid.UUID
+	Duration    time.Duration
+}
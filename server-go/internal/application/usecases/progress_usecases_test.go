@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProgressUseCases(repo ports.ProgressRepository, eventBus ports.EventBus) *ProgressUseCases {
+	return NewProgressUseCases(repo, eventBus, entities.NewFakeClock(testNow), &entities.SequentialIDGenerator{})
+}
+
+func TestListProgress_PassesFiltersToRepository(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	useCase := newTestProgressUseCases(mockRepo, nil)
+
+	userID := uuid.New()
+	completed := false
+	minCompletion := float32(25)
+	filters := ports.ProgressFilters{
+		MinCompletion: &minCompletion,
+		Completed:     &completed,
+		PageSize:      2,
+		Sort:          []entities.SortField{{Field: "project_name"}},
+	}
+	page := []*entities.Progress{
+		{ID: uuid.New(), UserID: userID, ProjectName: "Alfa", CompletionPercentage: 40},
+		{ID: uuid.New(), UserID: userID, ProjectName: "Beta", CompletionPercentage: 60},
+	}
+
+	// Sin página se pide la primera; el filtrado, el orden y la paginación los hace el repositorio
+	expected := filters
+	expected.Page = 1
+	mockRepo.On("List", mock.Anything, userID, expected).Return(page, 5, nil)
+
+	// Act
+	progress, total, err := useCase.ListProgress(context.Background(), userID, filters)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, page, progress)
+	assert.Equal(t, 5, total)
+	mockRepo.AssertNotCalled(t, "GetByUserID")
+}
+
+func TestListProgress_InvalidCompletionRange(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	useCase := newTestProgressUseCases(mockRepo, nil)
+
+	minCompletion, maxCompletion := float32(80), float32(20)
+
+	// Act
+	progress, _, err := useCase.ListProgress(context.Background(), uuid.New(), ports.ProgressFilters{MinCompletion: &minCompletion, MaxCompletion: &maxCompletion})
+
+	// Assert
+	assert.Equal(t, entities.ErrInvalidProgressFilters, err)
+	assert.Nil(t, progress)
+	mockRepo.AssertNotCalled(t, "List")
+}
+
+func TestListProgress_InvalidSortField(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	useCase := newTestProgressUseCases(mockRepo, nil)
+
+	// Act
+	_, _, err := useCase.ListProgress(context.Background(), uuid.New(), ports.ProgressFilters{Sort: []entities.SortField{{Field: "milestones"}}})
+
+	// Assert
+	assert.ErrorIs(t, err, entities.ErrInvalidSortField)
+	mockRepo.AssertNotCalled(t, "List")
+}
+
+func TestListProgress_RepositoryError(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	useCase := newTestProgressUseCases(mockRepo, nil)
+
+	userID := uuid.New()
+	mockRepo.On("List", mock.Anything, userID, mock.AnythingOfType("ports.ProgressFilters")).Return(nil, 0, assert.AnError)
+
+	// Act
+	progress, total, err := useCase.ListProgress(context.Background(), userID, ports.ProgressFilters{Page: 2, PageSize: 10})
+
+	// Assert
+	assert.Equal(t, assert.AnError, err)
+	assert.Nil(t, progress)
+	assert.Zero(t, total)
+}
+
+func TestUpdateProgress_VersionConflictReturnsLatest(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestProgressUseCases(mockRepo, mockEventBus)
+
+	userID := uuid.New()
+	stored := &entities.Progress{ID: uuid.New(), UserID: userID, ProjectName: "Alfa", Version: 3}
+	latest := &entities.Progress{ID: stored.ID, UserID: userID, ProjectName: "Alfa v2", Version: 4}
+	mockRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil).Once()
+	mockRepo.On("Update", mock.Anything, stored).Return(entities.ErrVersionConflict)
+	mockRepo.On("GetByID", mock.Anything, stored.ID).Return(latest, nil).Once()
+
+	// Act
+	progress, err := useCase.UpdateProgress(context.Background(), stored.ID, userID, 3, "Alfa renombrado", "", 0, nil, []string{"project_name"})
+
+	// Assert
+	assert.Equal(t, entities.ErrVersionConflict, err)
+	assert.Equal(t, latest, progress)
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
+
+func TestUpdateProgress_StaleExpectedVersion(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewProgressRepository(t)
+	useCase := newTestProgressUseCases(mockRepo, nil)
+
+	userID := uuid.New()
+	stored := &entities.Progress{ID: uuid.New(), UserID: userID, ProjectName: "Alfa", Version: 3}
+	mockRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+
+	// Act
+	progress, err := useCase.UpdateProgress(context.Background(), stored.ID, userID, 2, "Alfa renombrado", "", 0, nil, []string{"project_name"})
+
+	// Assert
+	assert.Equal(t, entities.ErrVersionConflict, err)
+	assert.Equal(t, stored, progress)
+	mockRepo.AssertNotCalled(t, "Update")
+}
@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// PublicationUseCases contiene los casos de uso para publicar ideas en modo solo lectura
+type PublicationUseCases struct {
+	publicationRepo ports.IdeaPublicationRepository
+	ideaRepo        ports.IdeaRepository
+	eventBus        ports.EventBus
+	clock           entities.Clock
+	ids             entities.IDGenerator
+	views           ports.PublicationViewRecorder
+	moderation      *ModerationUseCases
+}
+
+// PublicationOption configura parámetros opcionales de PublicationUseCases
+type PublicationOption func(*PublicationUseCases)
+
+// WithPublicationViewRecorder cuenta las visitas con recorder en lugar de sumarlas una a una en
+// el repositorio, por ejemplo para guardarlas por lotes
+func WithPublicationViewRecorder(recorder ports.PublicationViewRecorder) PublicationOption {
+	return func(uc *PublicationUseCases) {
+		uc.views = recorder
+	}
+}
+
+// WithPublicationModeration revisa el texto de las ideas antes de publicarlas con la política de
+// moderación de la organización del usuario
+func WithPublicationModeration(moderation *ModerationUseCases) PublicationOption {
+	return func(uc *PublicationUseCases) {
+		uc.moderation = moderation
+	}
+}
+
+// NewPublicationUseCases crea una nueva instancia de PublicationUseCases
+func NewPublicationUseCases(publicationRepo ports.IdeaPublicationRepository, ideaRepo ports.IdeaRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator, options ...PublicationOption) *PublicationUseCases {
+	uc := &PublicationUseCases{
+		publicationRepo: publicationRepo,
+		ideaRepo:        ideaRepo,
+		eventBus:        eventBus,
+		clock:           clock,
+		ids:             ids,
+		views:           publicationRepo,
+	}
+	for _, option := range options {
+		option(uc)
+	}
+	return uc
+}
+
+// PublishIdea publica una idea del usuario; ttl cero no caduca. Si la idea ya estaba publicada
+// se conserva su dirección y solo se renueva la caducidad, aunque hubiera vencido.
+func (uc *PublicationUseCases) PublishIdea(ctx context.Context, ideaID, userID uuid.UUID, ttl time.Duration) (*entities.IdeaPublication, error) {
+	if ttl < 0 {
+		return nil, entities.ErrIdeaPublicationInvalidExpiry
+	}
+
+	idea, err := uc.ideaRepo.GetByID(ctx, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	if !idea.IsOwnedBy(userID) {
+		return nil, entities.ErrIdeaUnauthorized
+	}
+	if uc.moderation != nil {
+		target := ModerationTarget{UserID: userID, Scope: entities.ModerationScopePublic, EntityType: "idea", EntityID: ideaID}
+		if err := uc.moderation.Check(ctx, target, moderatedTexts(idea)...); err != nil {
+			return nil, err
+		}
+	}
+
+	var expiresAt *time.Time
+	if ttl > 0 {
+		t := uc.clock.Now().Add(ttl)
+		expiresAt = &t
+	}
+
+	publication, err := uc.publicationRepo.GetCurrentByIdeaID(ctx, ideaID)
+	switch err {
+	case nil:
+		if err := uc.publicationRepo.SetExpiry(ctx, publication.ID, expiresAt); err != nil {
+			return nil, err
+		}
+		publication.ExpiresAt = expiresAt
+	case entities.ErrIdeaPublicationNotFound:
+		publication, err = entities.NewIdeaPublication(uc.clock, uc.ids, idea, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.publicationRepo.Create(ctx, publication); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	// Publicar evento de idea publicada
+	if uc.eventBus != nil {
+		event := &IdeaPublishedEvent{
+			EventHeader:   newEventHeader(ctx, uc.clock, uc.ids, userID),
+			PublicationID: publication.ID,
+			IdeaID:        ideaID,
+			UserID:        userID,
+			Slug:          publication.Slug,
+			ExpiresAt:     expiresAt,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return publication, nil
+}
+
+// UnpublishIdea retira la publicación vigente de una idea; su dirección deja de funcionar
+func (uc *PublicationUseCases) UnpublishIdea(ctx context.Context, ideaID, userID uuid.UUID) error {
+	publication, err := uc.publicationRepo.GetCurrentByIdeaID(ctx, ideaID)
+	if err != nil {
+		return err
+	}
+	if !publication.IsOwnedBy(userID) {
+		return entities.ErrIdeaPublicationUnauthorized
+	}
+
+	if err := uc.publicationRepo.Unpublish(ctx, publication.ID, uc.clock.Now()); err != nil {
+		return err
+	}
+
+	// Publicar evento de idea retirada
+	if uc.eventBus != nil {
+		event := &IdeaUnpublishedEvent{
+			EventHeader:   newEventHeader(ctx, uc.clock, uc.ids, userID),
+			PublicationID: publication.ID,
+			IdeaID:        ideaID,
+			UserID:        userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return nil
+}
+
+// ListPublications lista las publicaciones vigentes del usuario con sus visitas
+func (uc *PublicationUseCases) ListPublications(ctx context.Context, userID uuid.UUID) ([]*entities.IdeaPublication, error) {
+	return uc.publicationRepo.ListByUserID(ctx, userID)
+}
+
+// ViewPublishedIdea devuelve la idea publicada con slug para mostrarla a cualquiera. Las
+// publicaciones retiradas no se distinguen de las inexistentes; countView indica si la
+// consulta cuenta como visita.
+func (uc *PublicationUseCases) ViewPublishedIdea(ctx context.Context, slug string, countView bool) (*entities.Idea, *entities.IdeaPublication, error) {
+	publication, err := uc.publicationRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if publication.IsUnpublished() {
+		return nil, nil, entities.ErrIdeaPublicationNotFound
+	}
+
+	now := uc.clock.Now()
+	if publication.IsExpired(now) {
+		return nil, nil, entities.ErrIdeaPublicationExpired
+	}
+
+	idea, err := uc.ideaRepo.GetByID(ctx, publication.IdeaID)
+	if err == entities.ErrIdeaNotFound {
+		return nil, nil, entities.ErrIdeaPublicationNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !countView {
+		return idea, publication, nil
+	}
+
+	// Con un acumulador, ViewCount no incluye las visitas pendientes de guardar
+	if err := uc.views.RecordView(ctx, publication.ID, now); err != nil {
+		return nil, nil, err
+	}
+	publication.ViewCount++
+	publication.LastViewedAt = &now
+
+	// Publicar evento de visita
+	if uc.eventBus != nil {
+		event := &IdeaPublicationViewedEvent{
+			EventHeader:   newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+			PublicationID: publication.ID,
+			IdeaID:        idea.ID,
+			UserID:        publication.UserID,
+			ViewCount:     publication.ViewCount,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return idea, publication, nil
+}
+
+// Events
+type IdeaPublishedEvent struct {
+	entities.EventHeader
+	PublicationID uuid.UUID
+	IdeaID        uuid.UUID
+	UserID        uuid.UUID
+	Slug          string
+	ExpiresAt     *time.Time
+}
+
+type IdeaUnpublishedEvent struct {
+	entities.EventHeader
+	PublicationID uuid.UUID
+	IdeaID        uuid.UUID
+	UserID        uuid.UUID
+}
+
+type IdeaPublicationViewedEvent struct {
+	entities.EventHeader
+	PublicationID uuid.UUID
+	IdeaID        uuid.UUID
+	UserID        uuid.UUID
+	ViewCount     int64
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// SubscribeReadCacheInvalidation registra en eventBus la invalidación de las respuestas de lectura
+// guardadas en cache para el usuario de cada idea o archivo que cambia. Las creaciones también
+// invalidan, porque la cache puede recordar que la idea o el archivo no existían
+func SubscribeReadCacheInvalidation(eventBus ports.EventBus, cache ports.ReadCache) error {
+	events := []any{
+		(*IdeaCreatedEvent)(nil), (*IdeaUpdatedEvent)(nil), (*IdeaDeletedEvent)(nil),
+		(*IdeaMovedEvent)(nil), (*IdeaAutoTaggedEvent)(nil), (*IdeasBulkTaggedEvent)(nil),
+		(*IdeaPriorityChangedEvent)(nil), (*IdeaCompactedEvent)(nil),
+		(*FileUploadedEvent)(nil), (*FileDeletedEvent)(nil), (*FileVersionRestoredEvent)(nil),
+	}
+
+	handler := func(ctx context.Context, event interface{}) error {
+		userID := ideaEventUser(event)
+		if userID == uuid.Nil {
+			userID = fileEventUser(event)
+		}
+		if userID != uuid.Nil {
+			cache.InvalidateUser(ctx, userID)
+		}
+		return nil
+	}
+	for _, event := range events {
+		if err := eventBus.Subscribe(eventType(event), handler); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", eventType(event), err)
+		}
+	}
+	return nil
+}
+
+// ideaEventUser devuelve el usuario dueño de la idea de un evento, o uuid.Nil si no es un evento de ideas
+func ideaEventUser(event interface{}) uuid.UUID {
+	switch e := event.(type) {
+	case *IdeaCreatedEvent:
+		return e.UserID
+	case *IdeaUpdatedEvent:
+		return e.UserID
+	case *IdeaDeletedEvent:
+		return e.UserID
+	case *IdeaMovedEvent:
+		return e.UserID
+	case *IdeaAutoTaggedEvent:
+		return e.UserID
+	case *IdeasBulkTaggedEvent:
+		return e.UserID
+	case *IdeaPriorityChangedEvent:
+		return e.UserID
+	case *IdeaCompactedEvent:
+		return e.UserID
+	}
+	return uuid.Nil
+}
+
+// fileEventUser devuelve el usuario dueño del archivo de un evento que cambia sus datos, o uuid.Nil
+func fileEventUser(event interface{}) uuid.UUID {
+	switch e := event.(type) {
+	case *FileUploadedEvent:
+		return e.UserID
+	case *FileDeletedEvent:
+		return e.UserID
+	case *FileVersionRestoredEvent:
+		return e.UserID
+	}
+	return uuid.Nil
+}
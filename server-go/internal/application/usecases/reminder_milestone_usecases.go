@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ReminderMilestoneUseCases mantiene consistentes los recordatorios vinculados a hitos: completar
+// el recordatorio completa su hito y, si el vínculo lo pide, completar el hito completa sus
+// recordatorios. Cada lado se completa con sus propios casos de uso, que publican sus eventos.
+type ReminderMilestoneUseCases struct {
+	reminders    *ReminderUseCases
+	progress     *ProgressUseCases
+	reminderRepo ports.ReminderRepository
+	eventBus     ports.EventBus
+	clock        entities.Clock
+	ids          entities.IDGenerator
+}
+
+// NewReminderMilestoneUseCases crea una nueva instancia de ReminderMilestoneUseCases
+func NewReminderMilestoneUseCases(reminders *ReminderUseCases, progress *ProgressUseCases, reminderRepo ports.ReminderRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ReminderMilestoneUseCases {
+	return &ReminderMilestoneUseCases{
+		reminders:    reminders,
+		progress:     progress,
+		reminderRepo: reminderRepo,
+		eventBus:     eventBus,
+		clock:        clock,
+		ids:          ids,
+	}
+}
+
+// LinkMilestone vincula un recordatorio del usuario a un hito de uno de sus registros de progreso,
+// reemplazando el vínculo anterior. Con completesReminder, completar el hito también completa el
+// recordatorio.
+func (uc *ReminderMilestoneUseCases) LinkMilestone(ctx context.Context, id, userID, progressID, milestoneID uuid.UUID, completesReminder bool, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.reminders.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+
+	progress, err := uc.progress.GetProgress(ctx, progressID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := progress.FindMilestone(milestoneID); !ok {
+		return nil, entities.ErrMilestoneNotFound
+	}
+
+	link := entities.ReminderMilestoneLink{
+		ProgressID:        progressID,
+		MilestoneID:       milestoneID,
+		CompletesReminder: completesReminder,
+	}
+	if err := reminder.LinkMilestone(link, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.reminders.conflictResult(ctx, id, err)
+	}
+
+	// Publicar evento de recordatorio vinculado
+	if uc.eventBus != nil {
+		event := &ReminderMilestoneLinkedEvent{
+			EventHeader:       newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:        reminder.ID,
+			ProgressID:        progressID,
+			MilestoneID:       milestoneID,
+			UserID:            userID,
+			CompletesReminder: completesReminder,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return reminder, nil
+}
+
+// UnlinkMilestone desvincula un recordatorio de su hito; si no estaba vinculado no tiene efecto
+func (uc *ReminderMilestoneUseCases) UnlinkMilestone(ctx context.Context, id, userID uuid.UUID, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.reminders.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+
+	link := reminder.MilestoneLink
+	if link == nil {
+		return reminder, nil
+	}
+	reminder.UnlinkMilestone(uc.clock.Now())
+
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.reminders.conflictResult(ctx, id, err)
+	}
+
+	// Publicar evento de recordatorio desvinculado
+	if uc.eventBus != nil {
+		event := &ReminderMilestoneUnlinkedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			ProgressID:  link.ProgressID,
+			MilestoneID: link.MilestoneID,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return reminder, nil
+}
+
+// CompleteReminder completa un recordatorio y el hito al que está vinculado, que pertenece al creador
+// del recordatorio aunque lo complete el asignado. Devuelve el progreso solo si el hito cambió.
+// El hito se completa primero y vuelve a quedar pendiente si el recordatorio no se puede guardar;
+// un recordatorio recurrente se reprograma sin completar el hito.
+func (uc *ReminderMilestoneUseCases) CompleteReminder(ctx context.Context, id, userID uuid.UUID, expectedVersion int64) (*entities.Reminder, *entities.Progress, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, recurs := reminder.NextOccurrence(uc.clock.Now())
+	link := reminder.MilestoneLink
+	// Si el recordatorio no va a quedar completado, ReminderUseCases decide qué hacer y qué error devolver
+	if link == nil || recurs ||
+		!reminder.CanBeCompletedBy(userID) ||
+		!reminder.HasVersion(expectedVersion) ||
+		!reminder.Status.CanTransitionTo(entities.ReminderStatusCompleted) {
+		completed, err := uc.reminders.CompleteReminder(ctx, id, userID, expectedVersion)
+		return completed, nil, err
+	}
+
+	progress, err := uc.completeLinkedMilestone(ctx, reminder.UserID, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	completed, err := uc.reminders.CompleteReminder(ctx, id, userID, expectedVersion)
+	if err != nil {
+		if progress != nil {
+			uc.progress.UncompleteMilestone(ctx, link.ProgressID, link.MilestoneID, reminder.UserID, progress.Version)
+		}
+		return completed, nil, err
+	}
+
+	return completed, progress, nil
+}
+
+// CompleteMilestone completa un hito del usuario y los recordatorios vinculados a él que lo piden.
+// Si un recordatorio no se puede completar devuelve el error sin progreso, pero el hito queda
+// completado: un hito completado sin sus recordatorios no rompe la regla de que un recordatorio
+// completado completa su hito.
+func (uc *ReminderMilestoneUseCases) CompleteMilestone(ctx context.Context, progressID, milestoneID, userID uuid.UUID, expectedVersion int64) (*entities.Progress, []*entities.Reminder, error) {
+	linked, err := uc.reminderRepo.GetByMilestoneID(ctx, milestoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress, err := uc.progress.CompleteMilestone(ctx, progressID, milestoneID, userID, expectedVersion)
+	if err != nil {
+		return progress, nil, err
+	}
+
+	var completed []*entities.Reminder
+	for _, reminder := range linked {
+		if !reminder.MilestoneLink.CompletesReminder || reminder.MilestoneLink.ProgressID != progressID || !reminder.IsOwnedBy(userID) {
+			continue
+		}
+		if reminder.Status == entities.ReminderStatusCompleted || !reminder.Status.CanTransitionTo(entities.ReminderStatusCompleted) {
+			continue
+		}
+
+		updated, err := uc.reminders.CompleteReminder(ctx, reminder.ID, userID, reminder.Version)
+		if err != nil {
+			return nil, completed, err
+		}
+		completed = append(completed, updated)
+	}
+
+	return progress, completed, nil
+}
+
+// completeLinkedMilestone completa el hito del vínculo y devuelve el progreso, o nil si el hito ya
+// estaba completado o ya no existe
+func (uc *ReminderMilestoneUseCases) completeLinkedMilestone(ctx context.Context, ownerID uuid.UUID, link *entities.ReminderMilestoneLink) (*entities.Progress, error) {
+	progress, err := uc.progress.GetProgress(ctx, link.ProgressID, ownerID)
+	if err == entities.ErrProgressNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, ok := progress.FindMilestone(link.MilestoneID)
+	if !ok || milestone.Completed {
+		return nil, nil
+	}
+
+	return uc.progress.CompleteMilestone(ctx, link.ProgressID, link.MilestoneID, ownerID, progress.Version)
+}
+
+// Events
+type ReminderMilestoneLinkedEvent struct {
+	entities.EventHeader
+	ReminderID        uuid.UUID
+	ProgressID        uuid.UUID
+	MilestoneID       uuid.UUID
+	UserID            uuid.UUID
+	CompletesReminder bool
+}
+
+type ReminderMilestoneUnlinkedEvent struct {
+	entities.EventHeader
+	ReminderID  uuid.UUID
+	ProgressID  uuid.UUID
+	MilestoneID uuid.UUID
+	UserID      uuid.UUID
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReminderMilestoneUseCases(reminderRepo *mocks.ReminderRepository, progressRepo *mocks.ProgressRepository) *ReminderMilestoneUseCases {
+	clock := entities.NewFakeClock(testNow)
+	ids := &entities.SequentialIDGenerator{}
+	reminders := NewReminderUseCases(reminderRepo, nil, nil, nil, clock, ids)
+	progress := NewProgressUseCases(progressRepo, nil, clock, ids)
+	return NewReminderMilestoneUseCases(reminders, progress, reminderRepo, nil, clock, ids)
+}
+
+func linkedReminderFixture() (*entities.Reminder, *entities.Progress) {
+	userID, milestoneID := uuid.New(), uuid.New()
+	progress := &entities.Progress{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ProjectName: "Lanzamiento",
+		Milestones:  []entities.ProgressMilestone{{ID: milestoneID, Name: "Diseño"}},
+		Version:     1,
+	}
+	reminder := &entities.Reminder{
+		ID:            uuid.New(),
+		Title:         "Revisar el diseño",
+		ScheduledTime: testNow.Add(time.Hour),
+		Status:        entities.ReminderStatusPending,
+		UserID:        userID,
+		MilestoneLink: &entities.ReminderMilestoneLink{ProgressID: progress.ID, MilestoneID: milestoneID},
+		Version:       1,
+	}
+	return reminder, progress
+}
+
+func TestCompleteReminder_CompletesLinkedMilestone(t *testing.T) {
+	// Arrange
+	reminderRepo := mocks.NewReminderRepository(t)
+	progressRepo := mocks.NewProgressRepository(t)
+	useCase := newTestReminderMilestoneUseCases(reminderRepo, progressRepo)
+	reminder, progress := linkedReminderFixture()
+
+	reminderRepo.On("GetByID", mock.Anything, reminder.ID).Return(reminder, nil)
+	reminderRepo.On("Update", mock.Anything, reminder).Return(nil)
+	progressRepo.On("GetByID", mock.Anything, progress.ID).Return(progress, nil)
+	progressRepo.On("Update", mock.Anything, progress).Return(nil)
+
+	// Act
+	completed, updated, err := useCase.CompleteReminder(context.Background(), reminder.ID, reminder.UserID, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReminderStatusCompleted, completed.Status)
+	require.NotNil(t, updated)
+	assert.True(t, updated.Milestones[0].Completed)
+	assert.Equal(t, float32(100), updated.CompletionPercentage)
+}
+
+func TestCompleteReminder_RevertsMilestoneWhenReminderFails(t *testing.T) {
+	// Arrange
+	reminderRepo := mocks.NewReminderRepository(t)
+	progressRepo := mocks.NewProgressRepository(t)
+	useCase := newTestReminderMilestoneUseCases(reminderRepo, progressRepo)
+	reminder, progress := linkedReminderFixture()
+	saveErr := errors.New("database is locked")
+
+	reminderRepo.On("GetByID", mock.Anything, reminder.ID).Return(reminder, nil)
+	reminderRepo.On("Update", mock.Anything, reminder).Return(saveErr)
+	progressRepo.On("GetByID", mock.Anything, progress.ID).Return(progress, nil)
+	progressRepo.On("Update", mock.Anything, progress).Return(nil)
+
+	// Act
+	_, updated, err := useCase.CompleteReminder(context.Background(), reminder.ID, reminder.UserID, 0)
+
+	// Assert
+	require.ErrorIs(t, err, saveErr)
+	assert.Nil(t, updated)
+	assert.False(t, progress.Milestones[0].Completed)
+	progressRepo.AssertNumberOfCalls(t, "Update", 2)
+}
+
+func TestCompleteMilestone_CompletesRemindersThatAskForIt(t *testing.T) {
+	// Arrange
+	reminderRepo := mocks.NewReminderRepository(t)
+	progressRepo := mocks.NewProgressRepository(t)
+	useCase := newTestReminderMilestoneUseCases(reminderRepo, progressRepo)
+	reminder, progress := linkedReminderFixture()
+	reminder.MilestoneLink.CompletesReminder = true
+	untouched := *reminder
+	untouched.ID = uuid.New()
+	untouched.MilestoneLink = &entities.ReminderMilestoneLink{ProgressID: progress.ID, MilestoneID: progress.Milestones[0].ID}
+	milestoneID := progress.Milestones[0].ID
+
+	reminderRepo.On("GetByMilestoneID", mock.Anything, milestoneID).Return([]*entities.Reminder{reminder, &untouched}, nil)
+	reminderRepo.On("GetByID", mock.Anything, reminder.ID).Return(reminder, nil)
+	reminderRepo.On("Update", mock.Anything, reminder).Return(nil)
+	progressRepo.On("GetByID", mock.Anything, progress.ID).Return(progress, nil)
+	progressRepo.On("Update", mock.Anything, progress).Return(nil)
+
+	// Act
+	updated, completed, err := useCase.CompleteMilestone(context.Background(), progress.ID, milestoneID, progress.UserID, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, updated.Milestones[0].Completed)
+	require.Len(t, completed, 1)
+	assert.Equal(t, reminder.ID, completed[0].ID)
+	assert.Equal(t, entities.ReminderStatusCompleted, completed[0].Status)
+	assert.Equal(t, entities.ReminderStatusPending, untouched.Status)
+}
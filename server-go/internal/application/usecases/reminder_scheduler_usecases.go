@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ReminderSchedulerUseCases marca como vencidos los recordatorios cuya hora ya pasó, avisa a los usuarios implicados
+// y escala los que nadie confirma.
+// Debe ejecutarse en una sola réplica a la vez para no duplicar notificaciones.
+type ReminderSchedulerUseCases struct {
+	reminderRepo    ports.ReminderRepository
+	notificationSvc ports.NotificationService
+	clock           entities.Clock
+	escalations     ports.ReminderEscalationQueue
+	eventBus        ports.EventBus
+	ids             entities.IDGenerator
+}
+
+// ReminderSchedulerOption configura dependencias opcionales de ReminderSchedulerUseCases
+type ReminderSchedulerOption func(*ReminderSchedulerUseCases)
+
+// WithReminderEscalationQueue envía los pasos de escalado a través de la cola en lugar de
+// hacerlo durante la pasada del planificador
+func WithReminderEscalationQueue(queue ports.ReminderEscalationQueue) ReminderSchedulerOption {
+	return func(uc *ReminderSchedulerUseCases) {
+		uc.escalations = queue
+	}
+}
+
+// WithReminderEventBus publica un ReminderOverdueEvent por cada recordatorio que se marca como vencido
+func WithReminderEventBus(eventBus ports.EventBus, ids entities.IDGenerator) ReminderSchedulerOption {
+	return func(uc *ReminderSchedulerUseCases) {
+		uc.eventBus = eventBus
+		uc.ids = ids
+	}
+}
+
+// NewReminderSchedulerUseCases crea una nueva instancia de ReminderSchedulerUseCases
+func NewReminderSchedulerUseCases(reminderRepo ports.ReminderRepository, notificationSvc ports.NotificationService, clock entities.Clock, opts ...ReminderSchedulerOption) *ReminderSchedulerUseCases {
+	uc := &ReminderSchedulerUseCases{
+		reminderRepo:    reminderRepo,
+		notificationSvc: notificationSvc,
+		clock:           clock,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// MarkOverdueReminders marca los recordatorios vencidos y devuelve cuántos se actualizaron
+func (uc *ReminderSchedulerUseCases) MarkOverdueReminders(ctx context.Context) (int, error) {
+	reminders, err := uc.reminderRepo.GetOverdueReminders(ctx)
+	if err != nil {
+		return 0, err
+	}
+	
+	marked := 0
+	for _, reminder := range reminders {
+		now := uc.clock.Now()
+		if !reminder.IsOverdue(now) {
+			continue
+		}
+		
+		reminder.MarkAsOverdue(now)
+		if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+			// Otro proceso lo modificó entre la lectura y la escritura; se revisa en la siguiente pasada
+			if errors.Is(err, entities.ErrVersionConflict) {
+				continue
+			}
+			return marked, err
+		}
+		marked++
+		
+		if uc.notificationSvc != nil {
+			for _, recipientID := range reminder.NotifiedUsers() {
+				uc.notificationSvc.SendNotification(
+					ctx,
+					recipientID,
+					reminder.Title,
+					reminder.Description,
+					"reminder_overdue",
+					reminder.NotificationChannels,
+					map[string]string{"reminder_id": reminder.ID.String()},
+				)
+			}
+		}
+		
+		// Publicar evento de recordatorio vencido
+		if uc.eventBus != nil {
+			event := &ReminderOverdueEvent{
+				EventHeader:   newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+				ReminderID:    reminder.ID,
+				UserID:        reminder.UserID,
+				Title:         reminder.Title,
+				ScheduledTime: reminder.ScheduledTime,
+			}
+			uc.eventBus.Publish(ctx, event)
+		}
+	}
+	
+	return marked, nil
+}
+
+// EscalateReminders busca los recordatorios Deadline vencidos sin confirmar a los que les toca
+// el siguiente paso de escalado y lo envía, o lo encola si hay cola. Devuelve cuántos escaló.
+func (uc *ReminderSchedulerUseCases) EscalateReminders(ctx context.Context) (int, error) {
+	reminders, err := uc.reminderRepo.GetEscalationCandidates(ctx)
+	if err != nil {
+		return 0, err
+	}
+	
+	escalated := 0
+	for _, reminder := range reminders {
+		if _, due := reminder.NextEscalation(uc.clock.Now()); !due {
+			continue
+		}
+		
+		if uc.escalations != nil {
+			err = uc.escalations.EnqueueReminderEscalation(ctx, reminder.ID)
+		} else {
+			err = uc.EscalateReminder(ctx, reminder.ID)
+		}
+		if err != nil {
+			return escalated, err
+		}
+		escalated++
+	}
+	
+	return escalated, nil
+}
+
+// EscalateReminder envía el siguiente paso de escalado del recordatorio si todavía le toca: el
+// usuario pudo confirmarlo o completarlo desde que se encoló, o el paso ya se envió.
+func (uc *ReminderSchedulerUseCases) EscalateReminder(ctx context.Context, reminderID uuid.UUID) error {
+	reminder, err := uc.reminderRepo.GetByID(ctx, reminderID)
+	if errors.Is(err, entities.ErrReminderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	
+	now := uc.clock.Now()
+	step, due := reminder.NextEscalation(now)
+	if !due {
+		return nil
+	}
+	
+	// Se guarda antes de avisar: si dos réplicas lo procesan a la vez solo una envía el paso
+	reminder.Escalate(now)
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		if errors.Is(err, entities.ErrVersionConflict) {
+			return nil
+		}
+		return err
+	}
+	
+	if uc.notificationSvc == nil {
+		return nil
+	}
+	recipients := reminder.NotifiedUsers()
+	if step.NotifyBackupContact {
+		recipients = append(recipients, reminder.EscalationPolicy.BackupContactID)
+	}
+	metadata := map[string]string{
+		"reminder_id":      reminder.ID.String(),
+		"owner_id":         reminder.UserID.String(),
+		"escalation_level": strconv.Itoa(reminder.EscalationLevel),
+	}
+	for _, recipientID := range recipients {
+		uc.notificationSvc.SendNotification(ctx, recipientID, reminder.Title, reminder.Description, "reminder_escalated", step.Channels, metadata)
+	}
+	
+	return nil
+}
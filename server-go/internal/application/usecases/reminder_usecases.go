@@ -0,0 +1,615 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ReminderUseCases contiene los casos de uso para recordatorios
+type ReminderUseCases struct {
+	reminderRepo    ports.ReminderRepository
+	ideaRepo        ports.IdeaRepository
+	notificationSvc ports.NotificationService
+	eventBus        ports.EventBus
+	clock           entities.Clock
+	ids             entities.IDGenerator
+}
+
+// NewReminderUseCases crea una nueva instancia de ReminderUseCases; ideaRepo verifica las ideas
+// a las que se vinculan los recordatorios
+func NewReminderUseCases(reminderRepo ports.ReminderRepository, ideaRepo ports.IdeaRepository, notificationSvc ports.NotificationService, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ReminderUseCases {
+	return &ReminderUseCases{
+		reminderRepo:    reminderRepo,
+		ideaRepo:        ideaRepo,
+		notificationSvc: notificationSvc,
+		eventBus:        eventBus,
+		clock:           clock,
+		ids:             ids,
+	}
+}
+
+// CreateReminder crea un nuevo recordatorio; ideaID vincula el recordatorio a una idea del
+// usuario (uuid.Nil para no vincularlo)
+func (uc *ReminderUseCases) CreateReminder(ctx context.Context, title, description string, scheduledTime time.Time, reminderType entities.ReminderType, userID uuid.UUID, recurring bool, recurrencePattern entities.RecurrencePattern, channels []string, ideaID uuid.UUID) (*entities.Reminder, error) {
+	reminder := entities.NewReminder(uc.clock, uc.ids, title, description, scheduledTime, reminderType, userID, recurring, recurrencePattern, channels)
+	reminder.IdeaID = ideaID
+	
+	if err := reminder.Validate(); err != nil {
+		return nil, err
+	}
+	
+	if reminder.IsLinkedToIdea() {
+		idea, err := uc.ideaRepo.GetByID(ctx, ideaID)
+		if err != nil {
+			return nil, err
+		}
+		if !idea.IsOwnedBy(userID) {
+			return nil, entities.ErrIdeaUnauthorized
+		}
+	}
+	
+	if err := uc.reminderRepo.Create(ctx, reminder); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de recordatorio creado
+	if uc.eventBus != nil {
+		event := &ReminderCreatedEvent{
+			EventHeader:   newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:    reminder.ID,
+			UserID:        userID,
+			Title:         title,
+			ScheduledTime: reminder.ScheduledTime,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// GetReminder obtiene un recordatorio por ID, del usuario o asignado a él. No lo modifica: los vencidos
+// los marca ReminderSchedulerUseCases.MarkOverdueReminders
+func (uc *ReminderUseCases) GetReminder(ctx context.Context, id, userID uuid.UUID) (*entities.Reminder, error) {
+	return uc.getVisible(ctx, id, userID)
+}
+
+// ListReminders obtiene los recordatorios de un usuario con filtros; filters.Scope elige entre los creados por él,
+// los asignados a él o ambos.
+func (uc *ReminderUseCases) ListReminders(ctx context.Context, userID uuid.UUID, filters ports.ReminderFilters) ([]*entities.Reminder, int, error) {
+	if err := validateReminderFilters(filters); err != nil {
+		return nil, 0, err
+	}
+	
+	return uc.reminderRepo.GetByUserID(ctx, userID, filters)
+}
+
+// UpdateReminder actualiza un recordatorio existente.
+// Si expectedVersion no coincide con la versión almacenada devuelve el recordatorio más reciente junto con ErrVersionConflict.
+// Si updateMask no está vacío solo se modifican los campos indicados, incluso si su nuevo valor es vacío.
+// Un recordatorio vencido que se reprograma a una hora futura vuelve a quedar pendiente.
+func (uc *ReminderUseCases) UpdateReminder(ctx context.Context, id, userID uuid.UUID, expectedVersion int64, title, description string, scheduledTime time.Time, reminderType entities.ReminderType, status entities.ReminderStatus, recurring bool, recurrencePattern entities.RecurrencePattern, updateMask []string) (*entities.Reminder, error) {
+	reminder, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	now := uc.clock.Now()
+	previous := reminder.Status
+	previousTime := reminder.ScheduledTime
+	if len(updateMask) > 0 {
+		if err := reminder.UpdateFields(updateMask, title, description, scheduledTime, reminderType, status, recurring, recurrencePattern, now); err != nil {
+			return nil, err
+		}
+	} else {
+		reminder.Update(title, description, scheduledTime, reminderType, status, recurring, recurrencePattern, now)
+	}
+	
+	if !previous.CanTransitionTo(reminder.Status) {
+		return nil, entities.ErrInvalidReminderTransition
+	}
+	if reminder.Status == entities.ReminderStatusOverdue && reminder.ScheduledTime.After(now) {
+		reminder.Status = entities.ReminderStatusPending
+	}
+	// Una nueva hora es un nuevo vencimiento: el escalado vuelve a empezar
+	if !reminder.ScheduledTime.Equal(previousTime) {
+		reminder.ResetEscalation()
+	}
+	
+	if err := reminder.Validate(); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	// Publicar evento de recordatorio actualizado
+	if uc.eventBus != nil {
+		event := &ReminderUpdatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      userID,
+			Title:       reminder.Title,
+			Status:      reminder.Status,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// CompleteReminder marca un recordatorio como completado; puede hacerlo su creador o el usuario que aceptó
+// la asignación, en cuyo caso se avisa al creador. Si es recurrente con un patrón de intervalo fijo se
+// reprograma para la siguiente repetición y vuelve a quedar pendiente.
+func (uc *ReminderUseCases) CompleteReminder(ctx context.Context, id, userID uuid.UUID, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.CanBeCompletedBy(userID) {
+		return nil, entities.ErrReminderUnauthorized
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	if !reminder.Status.CanTransitionTo(entities.ReminderStatusCompleted) {
+		return nil, entities.ErrInvalidReminderTransition
+	}
+	
+	now := uc.clock.Now()
+	next, recurs := reminder.NextOccurrence(now)
+	if recurs {
+		reminder.Reschedule(next, now)
+	} else {
+		reminder.Complete(now)
+	}
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	if !reminder.IsOwnedBy(userID) {
+		uc.notifyAssignment(ctx, reminder, reminder.UserID, "Assigned reminder completed", "reminder_assignment_completed")
+	}
+	
+	// Publicar evento de recordatorio completado
+	if uc.eventBus != nil {
+		event := &ReminderCompletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      userID,
+			Title:       reminder.Title,
+		}
+		if recurs {
+			event.NextScheduledTime = next
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// CancelReminder cancela un recordatorio; un recordatorio completado no se puede cancelar
+func (uc *ReminderUseCases) CancelReminder(ctx context.Context, id, userID uuid.UUID, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	if !reminder.Status.CanTransitionTo(entities.ReminderStatusCancelled) {
+		return nil, entities.ErrInvalidReminderTransition
+	}
+	
+	reminder.Cancel(uc.clock.Now())
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	// Publicar evento de recordatorio cancelado
+	if uc.eventBus != nil {
+		event := &ReminderCancelledEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// AssignReminder delega un recordatorio del usuario en assigneeID, que debe aceptarlo; se le avisa de la
+// asignación. Reasignar un recordatorio ya asignado vuelve a pedir la aceptación.
+func (uc *ReminderUseCases) AssignReminder(ctx context.Context, id, userID, assigneeID uuid.UUID, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	previous := reminder.AssigneeID
+	if err := reminder.Assign(assigneeID, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	if previous != uuid.Nil && previous != assigneeID {
+		uc.notifyAssignment(ctx, reminder, previous, "Reminder no longer assigned to you", "reminder_unassigned")
+	}
+	uc.notifyAssignment(ctx, reminder, assigneeID, "Reminder assigned to you", "reminder_assigned")
+	
+	// Publicar evento de recordatorio asignado
+	if uc.eventBus != nil {
+		event := &ReminderAssignedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      userID,
+			AssigneeID:  assigneeID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// UnassignReminder devuelve un recordatorio asignado a su creador y avisa al usuario que lo tenía
+func (uc *ReminderUseCases) UnassignReminder(ctx context.Context, id, userID uuid.UUID, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	previous := reminder.AssigneeID
+	if previous == uuid.Nil {
+		return reminder, nil
+	}
+	notify := reminder.AssignmentStatus != entities.ReminderAssignmentDeclined
+	reminder.Unassign(uc.clock.Now())
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	if notify {
+		uc.notifyAssignment(ctx, reminder, previous, "Reminder no longer assigned to you", "reminder_unassigned")
+	}
+	
+	// Publicar evento de recordatorio desasignado
+	if uc.eventBus != nil {
+		event := &ReminderUnassignedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      userID,
+			AssigneeID:  previous,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// RespondToReminderAssignment registra si el usuario asignado acepta o rechaza el recordatorio y avisa a su creador.
+// Un recordatorio rechazado deja de aparecer entre los del usuario asignado.
+func (uc *ReminderUseCases) RespondToReminderAssignment(ctx context.Context, id, userID uuid.UUID, accept bool, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.IsAssignedTo(userID) {
+		return nil, entities.ErrReminderUnauthorized
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	if err := reminder.RespondToAssignment(accept, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	if accept {
+		uc.notifyAssignment(ctx, reminder, reminder.UserID, "Reminder assignment accepted", "reminder_assignment_accepted")
+	} else {
+		uc.notifyAssignment(ctx, reminder, reminder.UserID, "Reminder assignment declined", "reminder_assignment_declined")
+	}
+	
+	// Publicar evento de respuesta a la asignación
+	if uc.eventBus != nil {
+		event := &ReminderAssignmentRespondedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  reminder.ID,
+			UserID:      reminder.UserID,
+			AssigneeID:  userID,
+			Accepted:    accept,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// SetReminderEscalationPolicy configura cómo insistir con un recordatorio Deadline que nadie confirma; nil
+// quita la política. Los pasos ya enviados se olvidan.
+func (uc *ReminderUseCases) SetReminderEscalationPolicy(ctx context.Context, id, userID uuid.UUID, policy *entities.ReminderEscalationPolicy, expectedVersion int64) (*entities.Reminder, error) {
+	reminder, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.HasVersion(expectedVersion) {
+		return reminder, entities.ErrVersionConflict
+	}
+	
+	if err := reminder.SetEscalationPolicy(policy, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	return reminder, nil
+}
+
+// AcknowledgeReminder confirma que el usuario vio el recordatorio y detiene su escalado; pueden hacerlo
+// su creador o el asignado que lo aceptó. Confirmarlo dos veces no cambia nada.
+func (uc *ReminderUseCases) AcknowledgeReminder(ctx context.Context, id, userID uuid.UUID) (*entities.Reminder, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.CanBeCompletedBy(userID) {
+		return nil, entities.ErrReminderUnauthorized
+	}
+	if reminder.AcknowledgedAt != nil {
+		return reminder, nil
+	}
+	
+	reminder.Acknowledge(uc.clock.Now())
+	
+	if err := uc.reminderRepo.Update(ctx, reminder); err != nil {
+		return uc.conflictResult(ctx, id, err)
+	}
+	
+	// Publicar evento de recordatorio confirmado
+	if uc.eventBus != nil {
+		event := &ReminderAcknowledgedEvent{
+			EventHeader:     newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:      reminder.ID,
+			UserID:          reminder.UserID,
+			EscalationLevel: reminder.EscalationLevel,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return reminder, nil
+}
+
+// DeleteReminder elimina un recordatorio
+func (uc *ReminderUseCases) DeleteReminder(ctx context.Context, id, userID uuid.UUID) error {
+	if _, err := uc.getOwned(ctx, id, userID); err != nil {
+		return err
+	}
+	
+	if err := uc.reminderRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	
+	// Publicar evento de recordatorio eliminado
+	if uc.eventBus != nil {
+		event := &ReminderDeletedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			ReminderID:  id,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// getOwned obtiene un recordatorio verificando que pertenezca a userID
+func (uc *ReminderUseCases) getOwned(ctx context.Context, id, userID uuid.UUID) (*entities.Reminder, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.IsOwnedBy(userID) {
+		return nil, entities.ErrReminderUnauthorized
+	}
+	
+	return reminder, nil
+}
+
+// getVisible obtiene un recordatorio verificando que userID lo haya creado o lo tenga asignado
+func (uc *ReminderUseCases) getVisible(ctx context.Context, id, userID uuid.UUID) (*entities.Reminder, error) {
+	reminder, err := uc.reminderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	
+	if !reminder.IsVisibleTo(userID) {
+		return nil, entities.ErrReminderUnauthorized
+	}
+	
+	return reminder, nil
+}
+
+// notifyAssignment avisa a recipientID de un cambio en la asignación del recordatorio; el título del
+// recordatorio va como mensaje
+func (uc *ReminderUseCases) notifyAssignment(ctx context.Context, reminder *entities.Reminder, recipientID uuid.UUID, title, notificationType string) {
+	if uc.notificationSvc == nil {
+		return
+	}
+	uc.notificationSvc.SendNotification(
+		ctx,
+		recipientID,
+		title,
+		reminder.Title,
+		notificationType,
+		reminder.NotificationChannels,
+		map[string]string{
+			"reminder_id": reminder.ID.String(),
+			"owner_id":    reminder.UserID.String(),
+			"assignee_id": reminder.AssigneeID.String(),
+		},
+	)
+}
+
+// conflictResult devuelve el estado actual del recordatorio si otra escritura ganó la carrera,
+// para que el cliente pueda fusionar
+func (uc *ReminderUseCases) conflictResult(ctx context.Context, id uuid.UUID, err error) (*entities.Reminder, error) {
+	if err == entities.ErrVersionConflict {
+		if latest, getErr := uc.reminderRepo.GetByID(ctx, id); getErr == nil {
+			return latest, err
+		}
+	}
+	return nil, err
+}
+
+// validateReminderFilters valida la paginación, los enums y el rango de fechas de los filtros
+func validateReminderFilters(filters ports.ReminderFilters) error {
+	if filters.Page < 0 || filters.PageSize < 0 || !filters.Count.IsValid() {
+		return entities.ErrInvalidPagination
+	}
+	if !filters.Type.IsValid() {
+		return entities.ErrInvalidReminderType
+	}
+	if !filters.Status.IsValid() {
+		return entities.ErrInvalidReminderStatus
+	}
+	if !filters.Scope.IsValid() {
+		return entities.ErrInvalidReminderScope
+	}
+	if err := entities.ValidateSort(filters.Sort, entities.ReminderSortFields); err != nil {
+		return err
+	}
+	
+	var from, to time.Time
+	var err error
+	if filters.FromDate != nil {
+		if from, err = time.Parse(time.RFC3339, *filters.FromDate); err != nil {
+			return entities.ErrInvalidReminderDateRange
+		}
+	}
+	if filters.ToDate != nil {
+		if to, err = time.Parse(time.RFC3339, *filters.ToDate); err != nil {
+			return entities.ErrInvalidReminderDateRange
+		}
+	}
+	if filters.FromDate != nil && filters.ToDate != nil && from.After(to) {
+		return entities.ErrInvalidReminderDateRange
+	}
+	
+	return nil
+}
+
+// Events
+type ReminderCreatedEvent struct {
+	entities.EventHeader
+	ReminderID    uuid.UUID
+	UserID        uuid.UUID
+	Title         string
+	ScheduledTime time.Time
+}
+
+type ReminderUpdatedEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+	Title      string
+	Status     entities.ReminderStatus
+}
+
+// ReminderCompletedEvent lleva NextScheduledTime en cero salvo que el recordatorio se haya reprogramado
+type ReminderCompletedEvent struct {
+	entities.EventHeader
+	ReminderID        uuid.UUID
+	UserID            uuid.UUID
+	Title             string
+	NextScheduledTime time.Time
+}
+
+type ReminderCancelledEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+}
+
+type ReminderOverdueEvent struct {
+	entities.EventHeader
+	ReminderID    uuid.UUID
+	UserID        uuid.UUID
+	Title         string
+	ScheduledTime time.Time
+}
+
+type ReminderDeletedEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+}
+
+type ReminderAssignedEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+	AssigneeID uuid.UUID
+}
+
+type ReminderUnassignedEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+	AssigneeID uuid.UUID
+}
+
+// ReminderAssignmentRespondedEvent lleva en UserID al creador del recordatorio
+type ReminderAssignmentRespondedEvent struct {
+	entities.EventHeader
+	ReminderID uuid.UUID
+	UserID     uuid.UUID
+	AssigneeID uuid.UUID
+	Accepted   bool
+}
+
+// ReminderAcknowledgedEvent lleva en EscalationLevel los pasos de escalado enviados antes de la confirmación
+type ReminderAcknowledgedEvent struct {
+	entities.EventHeader
+	ReminderID      uuid.UUID
+	UserID          uuid.UUID
+	EscalationLevel int
+}
@@ -0,0 +1,216 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReminderUseCases(reminderRepo ports.ReminderRepository, ideaRepo ports.IdeaRepository, eventBus ports.EventBus) *ReminderUseCases {
+	return NewReminderUseCases(reminderRepo, ideaRepo, nil, eventBus, entities.NewFakeClock(testNow), &entities.SequentialIDGenerator{})
+}
+
+func pastDueReminderFixture(userID uuid.UUID) *entities.Reminder {
+	return &entities.Reminder{
+		ID:            uuid.New(),
+		Title:         "Llamar al proveedor",
+		ScheduledTime: testNow.Add(-time.Hour),
+		Type:          entities.ReminderTypeCall,
+		Status:        entities.ReminderStatusPending,
+		UserID:        userID,
+		Version:       1,
+	}
+}
+
+func TestCreateReminder_Success(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, mockEventBus)
+
+	userID := uuid.New()
+	scheduledTime := testNow.Add(24 * time.Hour)
+
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entities.Reminder")).Return(nil)
+	mockEventBus.On("Publish", mock.Anything, mock.AnythingOfType("*usecases.ReminderCreatedEvent")).Return(nil)
+
+	// Act
+	reminder, err := useCase.CreateReminder(context.Background(), "Revisar contrato", "", scheduledTime, entities.ReminderTypeTask, userID, false, entities.RecurrencePatternUnspecified, []string{"push"}, uuid.Nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), reminder.ID)
+	assert.Equal(t, entities.ReminderStatusPending, reminder.Status)
+	assert.Equal(t, scheduledTime, reminder.ScheduledTime)
+	assert.Equal(t, testNow, reminder.CreatedAt)
+	assert.Equal(t, int64(1), reminder.Version)
+
+	mockRepo.AssertExpectations(t)
+	mockEventBus.AssertExpectations(t)
+}
+
+func TestCreateReminder_ValidationError(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, mockEventBus)
+
+	// Act
+	reminder, err := useCase.CreateReminder(context.Background(), "", "", testNow, entities.ReminderTypeTask, uuid.New(), false, entities.RecurrencePatternUnspecified, nil, uuid.Nil)
+
+	// Assert
+	assert.Equal(t, entities.ErrReminderTitleRequired, err)
+	assert.Nil(t, reminder)
+	mockRepo.AssertNotCalled(t, "Create")
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
+
+func TestCreateReminder_IdeaOfAnotherUser(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockIdeaRepo := mocks.NewIdeaRepository(t)
+	useCase := newTestReminderUseCases(mockRepo, mockIdeaRepo, nil)
+
+	idea := &entities.Idea{ID: uuid.New(), UserID: uuid.New()}
+	mockIdeaRepo.On("GetByID", mock.Anything, idea.ID).Return(idea, nil)
+
+	// Act
+	reminder, err := useCase.CreateReminder(context.Background(), "Revisar contrato", "", testNow.Add(time.Hour), entities.ReminderTypeTask, uuid.New(), false, entities.RecurrencePatternUnspecified, nil, idea.ID)
+
+	// Assert
+	assert.Equal(t, entities.ErrIdeaUnauthorized, err)
+	assert.Nil(t, reminder)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestGetReminder_DoesNotMarkOverdue(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, mockEventBus)
+
+	userID := uuid.New()
+	stored := pastDueReminderFixture(userID)
+	mockRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+
+	// Act
+	reminder, err := useCase.GetReminder(context.Background(), stored.ID, userID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReminderStatusPending, reminder.Status)
+	assert.Equal(t, int64(1), reminder.Version)
+
+	// Leer no escribe: los vencidos los marca el planificador
+	mockRepo.AssertNotCalled(t, "Update")
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
+
+func TestGetReminder_Unauthorized(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, nil)
+
+	stored := pastDueReminderFixture(uuid.New())
+	mockRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+
+	// Act
+	reminder, err := useCase.GetReminder(context.Background(), stored.ID, uuid.New())
+
+	// Assert
+	assert.Equal(t, entities.ErrReminderUnauthorized, err)
+	assert.Nil(t, reminder)
+}
+
+func TestListReminders_DoesNotMarkOverdue(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, mockEventBus)
+
+	userID := uuid.New()
+	stored := []*entities.Reminder{pastDueReminderFixture(userID), pastDueReminderFixture(userID)}
+	filters := ports.ReminderFilters{Scope: entities.ReminderScopeCreatedByMe, Page: 1, PageSize: 10}
+	mockRepo.On("GetByUserID", mock.Anything, userID, filters).Return(stored, 2, nil)
+
+	// Act
+	reminders, total, err := useCase.ListReminders(context.Background(), userID, filters)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, reminders, 2)
+	for _, reminder := range reminders {
+		assert.Equal(t, entities.ReminderStatusPending, reminder.Status)
+	}
+
+	mockRepo.AssertNotCalled(t, "Update")
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
+
+func TestListReminders_InvalidDateRange(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	useCase := newTestReminderUseCases(mockRepo, nil, nil)
+
+	from, to := "2024-02-01T00:00:00Z", "2024-01-01T00:00:00Z"
+
+	// Act
+	reminders, _, err := useCase.ListReminders(context.Background(), uuid.New(), ports.ReminderFilters{FromDate: &from, ToDate: &to})
+
+	// Assert
+	assert.Equal(t, entities.ErrInvalidReminderDateRange, err)
+	assert.Nil(t, reminders)
+	mockRepo.AssertNotCalled(t, "GetByUserID")
+}
+
+func TestMarkOverdueReminders_NotifiesAndPublishes(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockNotifications := mocks.NewNotificationService(t)
+	mockEventBus := mocks.NewEventBus(t)
+	scheduler := NewReminderSchedulerUseCases(mockRepo, mockNotifications, entities.NewFakeClock(testNow), WithReminderEventBus(mockEventBus, &entities.SequentialIDGenerator{}))
+
+	reminder := pastDueReminderFixture(uuid.New())
+	mockRepo.On("GetOverdueReminders", mock.Anything).Return([]*entities.Reminder{reminder}, nil)
+	mockRepo.On("Update", mock.Anything, reminder).Return(nil)
+	mockNotifications.On("SendNotification", mock.Anything, reminder.UserID, reminder.Title, reminder.Description, "reminder_overdue", reminder.NotificationChannels, mock.Anything).Return(nil)
+	mockEventBus.On("Publish", mock.Anything, mock.AnythingOfType("*usecases.ReminderOverdueEvent")).Return(nil)
+
+	// Act
+	marked, err := scheduler.MarkOverdueReminders(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	assert.Equal(t, entities.ReminderStatusOverdue, reminder.Status)
+	assert.Equal(t, testNow, reminder.UpdatedAt)
+	mockEventBus.AssertExpectations(t)
+}
+
+func TestMarkOverdueReminders_SkipsVersionConflicts(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewReminderRepository(t)
+	mockEventBus := mocks.NewEventBus(t)
+	scheduler := NewReminderSchedulerUseCases(mockRepo, nil, entities.NewFakeClock(testNow), WithReminderEventBus(mockEventBus, &entities.SequentialIDGenerator{}))
+
+	reminder := pastDueReminderFixture(uuid.New())
+	mockRepo.On("GetOverdueReminders", mock.Anything).Return([]*entities.Reminder{reminder}, nil)
+	mockRepo.On("Update", mock.Anything, reminder).Return(entities.ErrVersionConflict)
+
+	// Act
+	marked, err := scheduler.MarkOverdueReminders(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, marked)
+	mockEventBus.AssertNotCalled(t, "Publish")
+}
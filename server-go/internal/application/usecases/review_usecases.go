@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultReviewQueueSize es el número de ideas de la cola cuando la consulta no indica uno
+	DefaultReviewQueueSize = 20
+	// MaxReviewQueueSize es el máximo de ideas de la cola de repaso
+	MaxReviewQueueSize = 100
+)
+
+// ReviewItem es una idea de la cola de repaso junto con su inscripción
+type ReviewItem struct {
+	Idea   *entities.Idea
+	Review *entities.IdeaReview
+}
+
+// ReviewUseCases contiene los casos de uso del repaso espaciado de ideas
+type ReviewUseCases struct {
+	ideaRepo   ports.IdeaRepository
+	reviewRepo ports.IdeaReviewRepository
+	eventBus   ports.EventBus
+	clock      entities.Clock
+	ids        entities.IDGenerator
+}
+
+// NewReviewUseCases crea una nueva instancia de ReviewUseCases
+func NewReviewUseCases(ideaRepo ports.IdeaRepository, reviewRepo ports.IdeaReviewRepository, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ReviewUseCases {
+	return &ReviewUseCases{
+		ideaRepo:   ideaRepo,
+		reviewRepo: reviewRepo,
+		eventBus:   eventBus,
+		clock:      clock,
+		ids:        ids,
+	}
+}
+
+// EnrollIdea inscribe una idea del usuario en el repaso; aparece en la cola desde ese momento
+func (uc *ReviewUseCases) EnrollIdea(ctx context.Context, ideaID, userID uuid.UUID) (*entities.IdeaReview, error) {
+	idea, err := uc.ideaRepo.GetByID(ctx, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	if !idea.IsOwnedBy(userID) {
+		return nil, entities.ErrIdeaUnauthorized
+	}
+	
+	review := entities.NewIdeaReview(uc.clock, idea)
+	if err := uc.reviewRepo.Create(ctx, review); err != nil {
+		return nil, err
+	}
+	
+	// Publicar evento de idea inscrita
+	if uc.eventBus != nil {
+		event := &IdeaEnrolledForReviewEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:      idea.ID,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return review, nil
+}
+
+// UnenrollIdea retira una idea del repaso; su historial de repasos se pierde
+func (uc *ReviewUseCases) UnenrollIdea(ctx context.Context, ideaID, userID uuid.UUID) error {
+	review, err := uc.reviewRepo.GetByIdeaID(ctx, ideaID)
+	if err != nil {
+		return err
+	}
+	if !review.IsOwnedBy(userID) {
+		return entities.ErrIdeaReviewUnauthorized
+	}
+	
+	return uc.reviewRepo.Delete(ctx, ideaID)
+}
+
+// GetReviewQueue devuelve las ideas que el usuario tiene que repasar hoy, según su zona horaria
+// loc, las más atrasadas primero, y el total pendiente. Las ideas archivadas siguen inscritas
+// pero no se muestran hasta que vuelvan a estar activas.
+func (uc *ReviewUseCases) GetReviewQueue(ctx context.Context, userID uuid.UUID, loc *time.Location, limit int) ([]ReviewItem, int, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if limit <= 0 {
+		limit = DefaultReviewQueueSize
+	}
+	limit = min(limit, MaxReviewQueueSize)
+	
+	reviews, totalCount, err := uc.reviewRepo.ListDue(ctx, userID, entities.EndOfDay(uc.clock.Now(), loc), limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	
+	items := make([]ReviewItem, 0, len(reviews))
+	for _, review := range reviews {
+		idea, err := uc.ideaRepo.GetByID(ctx, review.IdeaID)
+		if errors.Is(err, entities.ErrIdeaNotFound) {
+			totalCount--
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if idea.Status == entities.IdeaStatusArchived {
+			totalCount--
+			continue
+		}
+		items = append(items, ReviewItem{Idea: idea, Review: review})
+	}
+	
+	return items, totalCount, nil
+}
+
+// MarkReviewed registra un repaso de la idea con la calificación grade y calcula cuándo vuelve a
+// la cola. Si la inscripción cambió mientras tanto, por ejemplo por un repaso repetido desde otro
+// dispositivo, devuelve la inscripción actual con ErrVersionConflict.
+func (uc *ReviewUseCases) MarkReviewed(ctx context.Context, ideaID, userID uuid.UUID, grade entities.ReviewGrade) (*entities.IdeaReview, error) {
+	if !grade.IsValid() {
+		return nil, entities.ErrInvalidReviewGrade
+	}
+	
+	review, err := uc.reviewRepo.GetByIdeaID(ctx, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	if !review.IsOwnedBy(userID) {
+		return nil, entities.ErrIdeaReviewUnauthorized
+	}
+	
+	if err := review.Review(grade, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.reviewRepo.Update(ctx, review); err != nil {
+		if err == entities.ErrVersionConflict {
+			if latest, getErr := uc.reviewRepo.GetByIdeaID(ctx, ideaID); getErr == nil {
+				return latest, err
+			}
+		}
+		return nil, err
+	}
+	
+	// Publicar evento de idea repasada
+	if uc.eventBus != nil {
+		event := &IdeaReviewedEvent{
+			EventHeader:  newEventHeader(ctx, uc.clock, uc.ids, userID),
+			IdeaID:       ideaID,
+			UserID:       userID,
+			Grade:        grade,
+			IntervalDays: review.IntervalDays,
+			DueAt:        review.DueAt,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return review, nil
+}
+
+// Events
+type IdeaEnrolledForReviewEvent struct {
+	entities.EventHeader
+	IdeaID uuid.UUID
+	UserID uuid.UUID
+}
+
+type IdeaReviewedEvent struct {
+	entities.EventHeader
+	IdeaID       uuid.UUID
+	UserID       uuid.UUID
+	Grade        entities.ReviewGrade
+	IntervalDays int
+	DueAt        time.Time
+}
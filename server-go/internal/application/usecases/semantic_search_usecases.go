@@ -0,0 +1,246 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultSearchLimit es el número de resultados cuando la consulta no indica uno
+	DefaultSearchLimit = 20
+	// MaxSearchLimit es el máximo de resultados de una búsqueda
+	MaxSearchLimit = 100
+	// searchCandidateFactor es cuántos candidatos por resultado se piden a cada búsqueda antes de mezclarlas
+	searchCandidateFactor = 3
+	// rrfK suaviza la fusión por rango recíproco: con un valor alto los primeros puestos de cada
+	// búsqueda pesan poco más que los siguientes
+	rrfK = 60
+	// embeddingBatchSize es el número de ideas recorridas por consulta al completar los vectores
+	embeddingBatchSize = 100
+)
+
+// allIdeaStatuses son los estados recorridos al completar los vectores de las ideas existentes
+var allIdeaStatuses = []entities.IdeaStatus{
+	entities.IdeaStatusDraft,
+	entities.IdeaStatusActive,
+	entities.IdeaStatusOnHold,
+	entities.IdeaStatusCompleted,
+	entities.IdeaStatusArchived,
+}
+
+// IdeaSearchResult es una idea encontrada por la búsqueda. Score mezcla el puesto de la idea en la
+// búsqueda por palabras y en la semántica; Similarity es 0 si la idea no vino de la semántica.
+type IdeaSearchResult struct {
+	Idea         *entities.Idea
+	Score        float64
+	Similarity   float64
+	KeywordMatch bool
+}
+
+// SemanticSearchUseCases contiene los casos de uso para calcular los vectores de contenido de las
+// ideas y buscarlas por significado además de por palabras
+type SemanticSearchUseCases struct {
+	ideaRepo      ports.IdeaRepository
+	embeddingRepo ports.IdeaEmbeddingRepository
+	embedder      ports.EmbeddingService
+	minSimilarity float64
+	eventBus      ports.EventBus
+	clock         entities.Clock
+	ids           entities.IDGenerator
+}
+
+// NewSemanticSearchUseCases crea una nueva instancia de SemanticSearchUseCases; las ideas cuya
+// similitud con la consulta no llega a minSimilarity no se consideran parecidas
+func NewSemanticSearchUseCases(ideaRepo ports.IdeaRepository, embeddingRepo ports.IdeaEmbeddingRepository, embedder ports.EmbeddingService, minSimilarity float64, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *SemanticSearchUseCases {
+	return &SemanticSearchUseCases{
+		ideaRepo:      ideaRepo,
+		embeddingRepo: embeddingRepo,
+		embedder:      embedder,
+		minSimilarity: minSimilarity,
+		eventBus:      eventBus,
+		clock:         clock,
+		ids:           ids,
+	}
+}
+
+// EmbedIdea calcula y guarda el vector de una idea. Las ideas eliminadas antes de procesarse y las
+// que no cambiaron desde el último cálculo se omiten sin error.
+func (uc *SemanticSearchUseCases) EmbedIdea(ctx context.Context, ideaID uuid.UUID) error {
+	idea, err := uc.ideaRepo.GetByID(ctx, ideaID)
+	if err == entities.ErrIdeaNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	
+	current, err := uc.embeddingRepo.GetByIdeaID(ctx, ideaID)
+	if err == nil && current.IsCurrent(idea, uc.embedder.Model()) {
+		return nil
+	}
+	if err != nil && err != entities.ErrIdeaEmbeddingNotFound {
+		return err
+	}
+	
+	vectors, err := uc.embedder.Embed(ctx, []string{entities.EmbeddingText(idea)})
+	if err != nil {
+		return err
+	}
+	
+	embedding := entities.NewIdeaEmbedding(uc.clock, idea, uc.embedder.Model(), vectors[0])
+	if err := uc.embeddingRepo.Upsert(ctx, embedding); err != nil {
+		return err
+	}
+	
+	// Publicar evento de idea vectorizada
+	if uc.eventBus != nil {
+		event := &IdeaEmbeddedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+			IdeaID:      idea.ID,
+			UserID:      idea.UserID,
+			Model:       embedding.Model,
+			Dimensions:  len(embedding.Vector),
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+	
+	return nil
+}
+
+// BackfillEmbeddings calcula los vectores que faltan o quedaron desactualizados, por ejemplo de
+// las ideas anteriores a la búsqueda semántica o tras cambiar de modelo. Una idea que falla no
+// detiene a las demás; devuelve cuántas ideas recorrió y el primer error.
+func (uc *SemanticSearchUseCases) BackfillEmbeddings(ctx context.Context) (int, error) {
+	processed := 0
+	var firstErr error
+	afterID := uuid.Nil
+	for {
+		ideas, err := uc.ideaRepo.ListByStatus(ctx, allIdeaStatuses, afterID, embeddingBatchSize)
+		if err != nil {
+			return processed, err
+		}
+		for _, idea := range ideas {
+			if err := ctx.Err(); err != nil {
+				return processed, err
+			}
+			if err := uc.EmbedIdea(ctx, idea.ID); err != nil && firstErr == nil {
+				firstErr = err
+			}
+			processed++
+		}
+		if len(ideas) < embeddingBatchSize {
+			return processed, firstErr
+		}
+		afterID = ideas[len(ideas)-1].ID
+	}
+}
+
+// SearchIdeas busca las ideas del usuario por palabras y por significado y mezcla ambos resultados
+// por rango recíproco. Si el servicio de vectores falla se devuelven solo los resultados por
+// palabras y semantic es false.
+func (uc *SemanticSearchUseCases) SearchIdeas(ctx context.Context, userID uuid.UUID, query string, limit int) (results []IdeaSearchResult, semantic bool, err error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, false, entities.ErrEmptySearchQuery
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+	candidates := limit * searchCandidateFactor
+	
+	keywordIdeas, err := uc.ideaRepo.Search(ctx, userID, query, candidates)
+	if err != nil {
+		return nil, false, err
+	}
+	
+	matches, semantic, err := uc.nearest(ctx, userID, query, candidates)
+	if err != nil {
+		return nil, false, err
+	}
+	
+	byID := make(map[uuid.UUID]*IdeaSearchResult)
+	for rank, idea := range keywordIdeas {
+		byID[idea.ID] = &IdeaSearchResult{Idea: idea, Score: reciprocalRank(rank), KeywordMatch: true}
+	}
+	for rank, match := range matches {
+		result, ok := byID[match.IdeaID]
+		if !ok {
+			idea, err := uc.ideaRepo.GetByID(ctx, match.IdeaID)
+			if errors.Is(err, entities.ErrIdeaNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, false, err
+			}
+			if !idea.IsOwnedBy(userID) {
+				continue
+			}
+			result = &IdeaSearchResult{Idea: idea}
+			byID[match.IdeaID] = result
+		}
+		result.Score += reciprocalRank(rank)
+		result.Similarity = match.Similarity
+	}
+	
+	results = make([]IdeaSearchResult, 0, len(byID))
+	for _, result := range byID {
+		results = append(results, *result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Similarity != results[j].Similarity {
+			return results[i].Similarity > results[j].Similarity
+		}
+		return results[i].Idea.UpdatedAt.After(results[j].Idea.UpdatedAt)
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	
+	return results, semantic, nil
+}
+
+// nearest devuelve las ideas parecidas a la consulta; los errores del servicio de vectores no
+// impiden la búsqueda por palabras, los del repositorio sí
+func (uc *SemanticSearchUseCases) nearest(ctx context.Context, userID uuid.UUID, query string, limit int) ([]ports.IdeaMatch, bool, error) {
+	vectors, err := uc.embedder.Embed(ctx, []string{query})
+	if err != nil || len(vectors) != 1 {
+		return nil, false, nil
+	}
+	
+	matches, err := uc.embeddingRepo.Nearest(ctx, userID, uc.embedder.Model(), vectors[0], limit)
+	if err != nil {
+		return nil, false, err
+	}
+	
+	similar := matches[:0]
+	for _, match := range matches {
+		if match.Similarity >= uc.minSimilarity {
+			similar = append(similar, match)
+		}
+	}
+	return similar, true, nil
+}
+
+// reciprocalRank es la contribución de un resultado según su puesto (desde 0) en una búsqueda
+func reciprocalRank(rank int) float64 {
+	return 1 / float64(rrfK+rank+1)
+}
+
+// Events
+type IdeaEmbeddedEvent struct {
+	entities.EventHeader
+	IdeaID     uuid.UUID
+	UserID     uuid.UUID
+	Model      string
+	Dimensions int
+}
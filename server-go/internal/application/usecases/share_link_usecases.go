@@ -0,0 +1,277 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// DefaultShareLinkTTL es la vigencia de un enlace cuando no se indica otra
+	DefaultShareLinkTTL = 24 * time.Hour
+	// MaxShareLinkTTL es la vigencia máxima permitida para un enlace
+	MaxShareLinkTTL = 30 * 24 * time.Hour
+)
+
+// ShareLinkClient identifica a quien usa un enlace compartido, para la auditoría
+type ShareLinkClient struct {
+	IP        string
+	UserAgent string
+}
+
+// ShareLinkUseCases contiene los casos de uso para compartir archivos con personas que no son usuarios
+type ShareLinkUseCases struct {
+	linkRepo       ports.ShareLinkRepository
+	fileRepo       ports.FileRepository
+	storageService ports.FileStorageService
+	eventBus       ports.EventBus
+	clock          entities.Clock
+	ids            entities.IDGenerator
+}
+
+// NewShareLinkUseCases crea una nueva instancia de ShareLinkUseCases
+func NewShareLinkUseCases(linkRepo ports.ShareLinkRepository, fileRepo ports.FileRepository, storageService ports.FileStorageService, eventBus ports.EventBus, clock entities.Clock, ids entities.IDGenerator) *ShareLinkUseCases {
+	return &ShareLinkUseCases{
+		linkRepo:       linkRepo,
+		fileRepo:       fileRepo,
+		storageService: storageService,
+		eventBus:       eventBus,
+		clock:          clock,
+		ids:            ids,
+	}
+}
+
+// CreateShareLink crea un enlace de descarga para un archivo del usuario y devuelve el token en claro,
+// que no vuelve a estar disponible. ttl cero usa DefaultShareLinkTTL; maxDownloads cero no limita.
+func (uc *ShareLinkUseCases) CreateShareLink(ctx context.Context, fileID, userID uuid.UUID, ttl time.Duration, password string, maxDownloads int64) (*entities.ShareLink, string, error) {
+	fileInfo, err := uc.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !fileInfo.IsOwnedBy(userID) {
+		return nil, "", entities.ErrFileUnauthorized
+	}
+
+	if ttl == 0 {
+		ttl = DefaultShareLinkTTL
+	}
+	if ttl < 0 || ttl > MaxShareLinkTTL {
+		return nil, "", entities.ErrShareLinkInvalidExpiry
+	}
+
+	var passwordHash string
+	if password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, "", err
+		}
+		passwordHash = string(hash)
+	}
+
+	link, token, err := entities.NewShareLink(uc.clock, uc.ids, fileID, userID, uc.clock.Now().Add(ttl), passwordHash, maxDownloads)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := link.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := uc.linkRepo.Create(ctx, link); err != nil {
+		return nil, "", err
+	}
+
+	// Publicar evento de enlace creado
+	if uc.eventBus != nil {
+		event := &ShareLinkCreatedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			LinkID:      link.ID,
+			FileID:      fileID,
+			UserID:      userID,
+			ExpiresAt:   link.ExpiresAt,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return link, token, nil
+}
+
+// ListShareLinks lista los enlaces de un archivo del usuario
+func (uc *ShareLinkUseCases) ListShareLinks(ctx context.Context, fileID, userID uuid.UUID) ([]*entities.ShareLink, error) {
+	fileInfo, err := uc.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fileInfo.IsOwnedBy(userID) {
+		return nil, entities.ErrFileUnauthorized
+	}
+
+	return uc.linkRepo.ListByFileID(ctx, fileID)
+}
+
+// RevokeShareLink revoca un enlace; las descargas posteriores se rechazan
+func (uc *ShareLinkUseCases) RevokeShareLink(ctx context.Context, linkID, userID uuid.UUID) error {
+	link, err := uc.linkRepo.GetByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	if !link.IsOwnedBy(userID) {
+		return entities.ErrShareLinkUnauthorized
+	}
+
+	if err := uc.linkRepo.Revoke(ctx, linkID, uc.clock.Now()); err != nil {
+		return err
+	}
+
+	// Publicar evento de enlace revocado
+	if uc.eventBus != nil {
+		event := &ShareLinkRevokedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, userID),
+			LinkID:      linkID,
+			FileID:      link.FileID,
+			UserID:      userID,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return nil
+}
+
+// OpenSharedFile valida un token de descarga y devuelve el archivo compartido.
+// Cada intento sobre un enlace existente queda registrado en la auditoría; si el
+// registro de un intento rechazado falla, se devuelve igualmente el motivo del rechazo.
+func (uc *ShareLinkUseCases) OpenSharedFile(ctx context.Context, token, password string, client ShareLinkClient) (*entities.FileInfo, io.ReadCloser, error) {
+	link, outcome, err := uc.authorize(ctx, token, password)
+	if err != nil {
+		if outcome != "" {
+			uc.recordAccess(ctx, link, outcome, client)
+		}
+		return nil, nil, err
+	}
+
+	fileInfo, err := uc.fileRepo.GetByID(ctx, link.FileID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := uc.storageService.RetrieveFile(ctx, fileInfo.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// El contador se incrementa una vez abierto el archivo para no consumir descargas por fallos de almacenamiento
+	if err := uc.linkRepo.IncrementDownloads(ctx, link.ID); err != nil {
+		reader.Close()
+		if err == entities.ErrShareLinkDownloadLimitReached {
+			uc.recordAccess(ctx, link, entities.ShareLinkAccessLimitReached, client)
+		}
+		return nil, nil, err
+	}
+
+	if err := uc.recordAccess(ctx, link, entities.ShareLinkAccessGranted, client); err != nil {
+		reader.Close()
+		return nil, nil, err
+	}
+
+	// Publicar evento de descarga por enlace
+	if uc.eventBus != nil {
+		event := &ShareLinkDownloadedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, uc.ids, uuid.Nil),
+			LinkID:      link.ID,
+			FileID:      fileInfo.ID,
+			UserID:      link.UserID,
+			Filename:    fileInfo.Filename,
+			ClientIP:    client.IP,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return fileInfo, reader, nil
+}
+
+// StatSharedFile hace las comprobaciones de OpenSharedFile, y la del límite de descargas, sin
+// consumir una descarga ni registrar el intento: responde a las peticiones HEAD
+func (uc *ShareLinkUseCases) StatSharedFile(ctx context.Context, token, password string) (*entities.FileInfo, error) {
+	link, _, err := uc.authorize(ctx, token, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.IsDownloadLimitReached() {
+		return nil, entities.ErrShareLinkDownloadLimitReached
+	}
+
+	return uc.fileRepo.GetByID(ctx, link.FileID)
+}
+
+// authorize busca el enlace del token y comprueba que siga vigente y que password sea la suya. Si
+// lo rechaza devuelve también el resultado a auditar, vacío si el intento no se registra
+func (uc *ShareLinkUseCases) authorize(ctx context.Context, token, password string) (*entities.ShareLink, entities.ShareLinkAccessOutcome, error) {
+	link, err := uc.linkRepo.GetByTokenHash(ctx, entities.HashShareToken(token))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if link.IsRevoked() {
+		return link, entities.ShareLinkAccessRevoked, entities.ErrShareLinkRevoked
+	}
+
+	if link.IsExpired(uc.clock.Now()) {
+		return link, entities.ShareLinkAccessExpired, entities.ErrShareLinkExpired
+	}
+
+	if link.HasPassword() {
+		if password == "" {
+			return link, "", entities.ErrShareLinkPasswordRequired
+		}
+		if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+			return link, entities.ShareLinkAccessInvalidPassword, entities.ErrShareLinkInvalidPassword
+		}
+	}
+
+	return link, "", nil
+}
+
+func (uc *ShareLinkUseCases) recordAccess(ctx context.Context, link *entities.ShareLink, outcome entities.ShareLinkAccessOutcome, client ShareLinkClient) error {
+	return uc.linkRepo.RecordAccess(ctx, &entities.ShareLinkAccess{
+		ID:         uc.ids.NewID(),
+		LinkID:     link.ID,
+		Outcome:    outcome,
+		ClientIP:   client.IP,
+		UserAgent:  client.UserAgent,
+		AccessedAt: uc.clock.Now(),
+	})
+}
+
+// Events
+type ShareLinkCreatedEvent struct {
+	entities.EventHeader
+	LinkID    uuid.UUID
+	FileID    uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
+
+type ShareLinkRevokedEvent struct {
+	entities.EventHeader
+	LinkID uuid.UUID
+	FileID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ShareLinkDownloadedEvent struct {
+	entities.EventHeader
+	LinkID   uuid.UUID
+	FileID   uuid.UUID
+	UserID   uuid.UUID
+	Filename string
+	ClientIP string
+}
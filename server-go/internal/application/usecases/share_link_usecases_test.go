@@ -0,0 +1,228 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testShareToken = "token-de-prueba"
+
+type shareLinkMocks struct {
+	links   *mocks.ShareLinkRepository
+	files   *mocks.FileRepository
+	storage *mocks.FileStorageService
+}
+
+func newTestShareLinkUseCases(t *testing.T) (*ShareLinkUseCases, shareLinkMocks) {
+	m := shareLinkMocks{
+		links:   mocks.NewShareLinkRepository(t),
+		files:   mocks.NewFileRepository(t),
+		storage: mocks.NewFileStorageService(t),
+	}
+	useCase := NewShareLinkUseCases(m.links, m.files, m.storage, nil, entities.NewFakeClock(testNow), &entities.SequentialIDGenerator{})
+	return useCase, m
+}
+
+func shareLinkFixture(t *testing.T, password string) (*entities.ShareLink, *entities.FileInfo) {
+	t.Helper()
+	fileInfo := &entities.FileInfo{ID: uuid.New(), Filename: "informe.pdf", ContentType: "application/pdf", Path: "files/informe.pdf"}
+	link := &entities.ShareLink{
+		ID:        uuid.New(),
+		FileID:    fileInfo.ID,
+		UserID:    uuid.New(),
+		TokenHash: entities.HashShareToken(testShareToken),
+		ExpiresAt: testNow.Add(time.Hour),
+		CreatedAt: testNow.Add(-time.Hour),
+	}
+	if password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		require.NoError(t, err)
+		link.PasswordHash = string(hash)
+	}
+	return link, fileInfo
+}
+
+func expectAccess(m shareLinkMocks, outcome entities.ShareLinkAccessOutcome) {
+	m.links.On("RecordAccess", mock.Anything, mock.MatchedBy(func(access *entities.ShareLinkAccess) bool {
+		return access.Outcome == outcome
+	})).Return(nil).Once()
+}
+
+func TestOpenSharedFile_Granted(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, fileInfo := shareLinkFixture(t, "secreto")
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	m.files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil)
+	m.storage.On("RetrieveFile", mock.Anything, fileInfo.Path).Return(io.NopCloser(strings.NewReader("contenido")), nil)
+	m.links.On("IncrementDownloads", mock.Anything, link.ID).Return(nil)
+	expectAccess(m, entities.ShareLinkAccessGranted)
+
+	// Act
+	opened, reader, err := useCase.OpenSharedFile(context.Background(), testShareToken, "secreto", ShareLinkClient{IP: "203.0.113.7"})
+
+	// Assert
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, fileInfo, opened)
+}
+
+func TestOpenSharedFile_Expired(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, _ := shareLinkFixture(t, "")
+	link.ExpiresAt = testNow
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	expectAccess(m, entities.ShareLinkAccessExpired)
+
+	// Act
+	_, _, err := useCase.OpenSharedFile(context.Background(), testShareToken, "", ShareLinkClient{})
+
+	// Assert
+	assert.Equal(t, entities.ErrShareLinkExpired, err)
+	m.links.AssertNotCalled(t, "IncrementDownloads", mock.Anything, mock.Anything)
+}
+
+func TestOpenSharedFile_Revoked(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, _ := shareLinkFixture(t, "")
+	revokedAt := testNow.Add(-time.Minute)
+	link.RevokedAt = &revokedAt
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	expectAccess(m, entities.ShareLinkAccessRevoked)
+
+	// Act
+	_, _, err := useCase.OpenSharedFile(context.Background(), testShareToken, "", ShareLinkClient{})
+
+	// Assert
+	assert.Equal(t, entities.ErrShareLinkRevoked, err)
+	m.links.AssertNotCalled(t, "IncrementDownloads", mock.Anything, mock.Anything)
+}
+
+func TestOpenSharedFile_WrongPassword(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, _ := shareLinkFixture(t, "secreto")
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	expectAccess(m, entities.ShareLinkAccessInvalidPassword)
+
+	// Act
+	_, _, err := useCase.OpenSharedFile(context.Background(), testShareToken, "otra", ShareLinkClient{})
+
+	// Assert
+	assert.Equal(t, entities.ErrShareLinkInvalidPassword, err)
+	m.files.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestOpenSharedFile_MissingPasswordIsNotAudited(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, _ := shareLinkFixture(t, "secreto")
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+
+	// Act
+	_, _, err := useCase.OpenSharedFile(context.Background(), testShareToken, "", ShareLinkClient{})
+
+	// Assert
+	assert.Equal(t, entities.ErrShareLinkPasswordRequired, err)
+	m.links.AssertNotCalled(t, "RecordAccess", mock.Anything, mock.Anything)
+}
+
+func TestOpenSharedFile_LimitReached(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, fileInfo := shareLinkFixture(t, "")
+	reader := &closeRecorder{Reader: strings.NewReader("contenido")}
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	m.files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil)
+	m.storage.On("RetrieveFile", mock.Anything, fileInfo.Path).Return(reader, nil)
+	m.links.On("IncrementDownloads", mock.Anything, link.ID).Return(entities.ErrShareLinkDownloadLimitReached)
+	expectAccess(m, entities.ShareLinkAccessLimitReached)
+
+	// Act
+	_, _, err := useCase.OpenSharedFile(context.Background(), testShareToken, "", ShareLinkClient{})
+
+	// Assert
+	assert.Equal(t, entities.ErrShareLinkDownloadLimitReached, err)
+	assert.True(t, reader.closed)
+}
+
+func TestStatSharedFile_DoesNotCountOrAudit(t *testing.T) {
+	// Arrange
+	useCase, m := newTestShareLinkUseCases(t)
+	link, fileInfo := shareLinkFixture(t, "secreto")
+
+	m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+	m.files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil)
+
+	// Act
+	stat, err := useCase.StatSharedFile(context.Background(), testShareToken, "secreto")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, fileInfo, stat)
+	m.links.AssertNotCalled(t, "IncrementDownloads", mock.Anything, mock.Anything)
+	m.links.AssertNotCalled(t, "RecordAccess", mock.Anything, mock.Anything)
+	m.storage.AssertNotCalled(t, "RetrieveFile", mock.Anything, mock.Anything)
+}
+
+func TestStatSharedFile_RejectsWithoutAuditing(t *testing.T) {
+	revokedAt := testNow.Add(-time.Minute)
+	tests := []struct {
+		name     string
+		modify   func(link *entities.ShareLink)
+		password string
+		want     error
+	}{
+		{"expired", func(link *entities.ShareLink) { link.ExpiresAt = testNow }, "secreto", entities.ErrShareLinkExpired},
+		{"revoked", func(link *entities.ShareLink) { link.RevokedAt = &revokedAt }, "secreto", entities.ErrShareLinkRevoked},
+		{"wrong password", func(link *entities.ShareLink) {}, "otra", entities.ErrShareLinkInvalidPassword},
+		{"limit reached", func(link *entities.ShareLink) { link.MaxDownloads, link.DownloadCount = 3, 3 }, "secreto", entities.ErrShareLinkDownloadLimitReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useCase, m := newTestShareLinkUseCases(t)
+			link, _ := shareLinkFixture(t, "secreto")
+			tt.modify(link)
+			m.links.On("GetByTokenHash", mock.Anything, link.TokenHash).Return(link, nil)
+
+			// Act
+			_, err := useCase.StatSharedFile(context.Background(), testShareToken, tt.password)
+
+			// Assert
+			assert.Equal(t, tt.want, err)
+			m.links.AssertNotCalled(t, "RecordAccess", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// closeRecorder registra si se cerró el lector del archivo
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// StatisticsUseCases mantiene las estadísticas materializadas del panel de cada usuario. Los
+// eventos de dominio recalculan solo la sección que cambió y el panel lee la proyección sin
+// consultas de agregación.
+type StatisticsUseCases struct {
+	statsRepo ports.StatisticsRepository
+	clock     entities.Clock
+}
+
+// NewStatisticsUseCases crea una nueva instancia de StatisticsUseCases; Subscribe la conecta
+// con los eventos que mantienen la proyección
+func NewStatisticsUseCases(statsRepo ports.StatisticsRepository, clock entities.Clock) *StatisticsUseCases {
+	return &StatisticsUseCases{
+		statsRepo: statsRepo,
+		clock:     clock,
+	}
+}
+
+// Subscribe registra en eventBus los manejadores que refrescan cada sección
+func (uc *StatisticsUseCases) Subscribe(eventBus ports.EventBus) error {
+	subscriptions := []struct {
+		events  []any
+		handler ports.EventHandler
+	}{
+		{
+			events: []any{
+				(*IdeaCreatedEvent)(nil), (*IdeaUpdatedEvent)(nil), (*IdeaDeletedEvent)(nil),
+				(*IdeaMovedEvent)(nil), (*IdeaAutoTaggedEvent)(nil),
+			},
+			handler: uc.onIdeasChanged,
+		},
+		{
+			events: []any{
+				(*ReminderCreatedEvent)(nil), (*ReminderUpdatedEvent)(nil), (*ReminderCompletedEvent)(nil),
+				(*ReminderCancelledEvent)(nil), (*ReminderDeletedEvent)(nil),
+			},
+			handler: uc.onRemindersChanged,
+		},
+		{
+			events:  []any{(*FileUploadedEvent)(nil), (*FileDeletedEvent)(nil), (*FileVersionRestoredEvent)(nil)},
+			handler: uc.onFilesChanged,
+		},
+	}
+	
+	for _, subscription := range subscriptions {
+		for _, event := range subscription.events {
+			if err := eventBus.Subscribe(eventType(event), subscription.handler); err != nil {
+				return fmt.Errorf("failed to subscribe to %s: %w", eventType(event), err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetStatistics obtiene las estadísticas de un usuario. La primera vez las calcula completas y,
+// al cambiar de semana, recalcula los recordatorios que vencen en la nueva.
+func (uc *StatisticsUseCases) GetStatistics(ctx context.Context, userID uuid.UUID) (*entities.UserStatistics, error) {
+	stats, err := uc.statsRepo.Get(ctx, userID)
+	if err == entities.ErrStatisticsNotFound {
+		if err := uc.Rebuild(ctx, userID); err != nil {
+			return nil, err
+		}
+		return uc.statsRepo.Get(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	now := uc.clock.Now()
+	weekStart := entities.StatisticsWeekStart(now)
+	if stats.WeekStart.Equal(weekStart) {
+		return stats, nil
+	}
+	if err := uc.statsRepo.RefreshReminders(ctx, userID, weekStart, now); err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.Get(ctx, userID)
+}
+
+// Rebuild recalcula todas las secciones de un usuario, por ejemplo tras restaurar datos sin
+// pasar por los casos de uso
+func (uc *StatisticsUseCases) Rebuild(ctx context.Context, userID uuid.UUID) error {
+	now := uc.clock.Now()
+	if err := uc.statsRepo.RefreshIdeas(ctx, userID, now); err != nil {
+		return err
+	}
+	if err := uc.statsRepo.RefreshReminders(ctx, userID, entities.StatisticsWeekStart(now), now); err != nil {
+		return err
+	}
+	return uc.statsRepo.RefreshStorage(ctx, userID, now)
+}
+
+func (uc *StatisticsUseCases) onIdeasChanged(ctx context.Context, event interface{}) error {
+	var userID uuid.UUID
+	switch e := event.(type) {
+	case *IdeaCreatedEvent:
+		userID = e.UserID
+	case *IdeaUpdatedEvent:
+		userID = e.UserID
+	case *IdeaDeletedEvent:
+		userID = e.UserID
+	case *IdeaMovedEvent:
+		userID = e.UserID
+	case *IdeaAutoTaggedEvent:
+		userID = e.UserID
+	default:
+		return nil
+	}
+	return uc.statsRepo.RefreshIdeas(ctx, userID, uc.clock.Now())
+}
+
+func (uc *StatisticsUseCases) onRemindersChanged(ctx context.Context, event interface{}) error {
+	var userID uuid.UUID
+	switch e := event.(type) {
+	case *ReminderCreatedEvent:
+		userID = e.UserID
+	case *ReminderUpdatedEvent:
+		userID = e.UserID
+	case *ReminderCompletedEvent:
+		userID = e.UserID
+	case *ReminderCancelledEvent:
+		userID = e.UserID
+	case *ReminderDeletedEvent:
+		userID = e.UserID
+	default:
+		return nil
+	}
+	now := uc.clock.Now()
+	return uc.statsRepo.RefreshReminders(ctx, userID, entities.StatisticsWeekStart(now), now)
+}
+
+func (uc *StatisticsUseCases) onFilesChanged(ctx context.Context, event interface{}) error {
+	var userID uuid.UUID
+	switch e := event.(type) {
+	case *FileUploadedEvent:
+		userID = e.UserID
+	case *FileDeletedEvent:
+		userID = e.UserID
+	case *FileVersionRestoredEvent:
+		userID = e.UserID
+	default:
+		return nil
+	}
+	return uc.statsRepo.RefreshStorage(ctx, userID, uc.clock.Now())
+}
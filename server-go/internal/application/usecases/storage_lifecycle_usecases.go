@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// lifecycleBatchSize es el número de archivos físicos movidos por consulta al aplicar el ciclo de vida
+const lifecycleBatchSize = 100
+
+// StorageLifecycleReport resume una ejecución del ciclo de vida del almacenamiento
+type StorageLifecycleReport struct {
+	Transitioned int
+	Failed       int
+}
+
+// StorageLifecycleUseCases mueve al nivel frío los archivos físicos sin uso reciente y los
+// devuelve al nivel principal cuando se vuelven a descargar
+type StorageLifecycleUseCases struct {
+	fileRepo       ports.FileRepository
+	storageService ports.FileStorageService
+	tiering        ports.StorageTiering
+	eventBus       ports.EventBus
+	clock          entities.Clock
+	coldAfter      time.Duration
+}
+
+// NewStorageLifecycleUseCases crea una nueva instancia de StorageLifecycleUseCases; los archivos
+// cuya última versión tiene más de coldAfter pasan al nivel frío (0 desactiva la transición)
+func NewStorageLifecycleUseCases(fileRepo ports.FileRepository, storageService ports.FileStorageService, tiering ports.StorageTiering, eventBus ports.EventBus, clock entities.Clock, coldAfter time.Duration) *StorageLifecycleUseCases {
+	return &StorageLifecycleUseCases{
+		fileRepo:       fileRepo,
+		storageService: storageService,
+		tiering:        tiering,
+		eventBus:       eventBus,
+		clock:          clock,
+		coldAfter:      coldAfter,
+	}
+}
+
+// ApplyLifecycle mueve al nivel frío los archivos físicos que cumplen la regla. Un archivo que no
+// se pudo mover se reintenta en la siguiente ejecución; debe ejecutarse en una sola réplica a la vez.
+func (uc *StorageLifecycleUseCases) ApplyLifecycle(ctx context.Context) (*StorageLifecycleReport, error) {
+	report := &StorageLifecycleReport{}
+	if uc.coldAfter <= 0 {
+		return report, nil
+	}
+
+	before := uc.clock.Now().Add(-uc.coldAfter)
+	for {
+		paths, err := uc.fileRepo.ListTierCandidates(ctx, entities.StorageTierHot, before, lifecycleBatchSize)
+		if err != nil {
+			return report, err
+		}
+
+		moved := 0
+		for _, path := range paths {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			if _, err := uc.move(ctx, path, entities.StorageTierCold); err != nil {
+				report.Failed++
+				continue
+			}
+			moved++
+		}
+		report.Transitioned += moved
+
+		// Si ningún archivo del lote se movió, la siguiente consulta devolvería los mismos
+		if len(paths) < lifecycleBatchSize || moved == 0 {
+			return report, nil
+		}
+	}
+}
+
+// Restore devuelve al nivel principal el archivo físico de fileInfo y la versión con su nueva ruta.
+// Si otra petición lo restauró mientras tanto, devuelve la versión actualizada.
+func (uc *StorageLifecycleUseCases) Restore(ctx context.Context, fileInfo *entities.FileInfo) (*entities.FileInfo, error) {
+	if !fileInfo.IsCold() {
+		return fileInfo, nil
+	}
+
+	path, err := uc.move(ctx, fileInfo.Path, entities.StorageTierHot)
+	if err == entities.ErrFileNotFound {
+		return uc.fileRepo.GetByID(ctx, fileInfo.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	restored := *fileInfo
+	restored.Path = path
+	restored.StorageTier = entities.StorageTierHot
+	return &restored, nil
+}
+
+// GetTierUsage obtiene el almacenamiento de todos los usuarios por nivel
+func (uc *StorageLifecycleUseCases) GetTierUsage(ctx context.Context) ([]ports.TierUsage, error) {
+	return uc.fileRepo.GetTierUsage(ctx)
+}
+
+// move copia el archivo físico en path al nivel tier, apunta a la copia todas las versiones que lo
+// referencian y elimina el original. Devuelve entities.ErrFileNotFound si ninguna versión lo referencia.
+func (uc *StorageLifecycleUseCases) move(ctx context.Context, path string, tier entities.StorageTier) (string, error) {
+	newPath, err := uc.tiering.CopyToTier(ctx, path, tier)
+	if err != nil {
+		return "", err
+	}
+
+	moved, err := uc.fileRepo.MoveStorage(ctx, path, newPath, tier, uc.clock.Now())
+	if err == nil && moved == 0 {
+		// Las versiones se eliminaron o se movieron durante la copia
+		err = entities.ErrFileNotFound
+	}
+	if err != nil {
+		uc.storageService.DeleteFile(ctx, newPath)
+		return "", err
+	}
+
+	// Si falla, la reconciliación del almacenamiento elimina el original huérfano
+	uc.storageService.DeleteFile(ctx, path)
+
+	// Publicar evento de cambio de nivel
+	if uc.eventBus != nil {
+		event := &FileStorageTierChangedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, nil, uuid.Nil),
+			OldPath:     path,
+			NewPath:     newPath,
+			Tier:        tier,
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return newPath, nil
+}
+
+// Events
+type FileStorageTierChangedEvent struct {
+	entities.EventHeader
+	OldPath string
+	NewPath string
+	Tier    entities.StorageTier
+}
\ No newline at end of file
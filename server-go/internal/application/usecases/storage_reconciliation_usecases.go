@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultOrphanGracePeriod es la antigüedad mínima de un archivo físico sin registro para
+	// considerarlo huérfano; protege las subidas que aún no registraron el archivo
+	DefaultOrphanGracePeriod = time.Hour
+	// reconcileBatchSize es el número de registros leídos por consulta al recorrer la tabla de archivos
+	reconcileBatchSize = 500
+)
+
+// StorageReconciliationReport resume las discrepancias entre el almacenamiento y la tabla de archivos
+type StorageReconciliationReport struct {
+	ScannedBlobs   int
+	ScannedRecords int
+	// OrphanedBlobs son archivos físicos que ninguna versión referencia (la subida falló tras almacenarlos)
+	OrphanedBlobs []ports.StoredBlob
+	// DanglingRecords son versiones cuyo archivo físico ya no existe
+	DanglingRecords []*entities.FileInfo
+	RemovedBlobs    int
+	RemovedRecords  int
+}
+
+// StorageReconciliationUseCases detecta y, opcionalmente, repara las discrepancias entre el
+// almacenamiento y la tabla de archivos. Debe ejecutarse en una sola réplica a la vez.
+type StorageReconciliationUseCases struct {
+	fileRepo        ports.FileRepository
+	inventory       ports.StorageInventory
+	storageService  ports.FileStorageService
+	notificationSvc ports.NotificationService
+	clock           entities.Clock
+	gracePeriod     time.Duration
+}
+
+// NewStorageReconciliationUseCases crea una nueva instancia de StorageReconciliationUseCases
+func NewStorageReconciliationUseCases(fileRepo ports.FileRepository, inventory ports.StorageInventory, storageService ports.FileStorageService, notificationSvc ports.NotificationService, clock entities.Clock, gracePeriod time.Duration) *StorageReconciliationUseCases {
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultOrphanGracePeriod
+	}
+	return &StorageReconciliationUseCases{
+		fileRepo:        fileRepo,
+		inventory:       inventory,
+		storageService:  storageService,
+		notificationSvc: notificationSvc,
+		clock:           clock,
+		gracePeriod:     gracePeriod,
+	}
+}
+
+// Reconcile compara el almacenamiento con la tabla de archivos. Con repair elimina los archivos
+// huérfanos y los registros sin archivo físico, avisando a sus dueños; antes de cada reparación
+// se vuelve a comprobar la discrepancia para no borrar nada que haya cambiado durante el recorrido.
+func (uc *StorageReconciliationUseCases) Reconcile(ctx context.Context, repair bool) (*StorageReconciliationReport, error) {
+	report := &StorageReconciliationReport{}
+	cutoff := uc.clock.Now().Add(-uc.gracePeriod)
+
+	// Los registros se leen antes que el almacenamiento: un archivo subido durante el
+	// recorrido tiene una fecha posterior al corte y no se considera huérfano
+	referenced := make(map[string][]*entities.FileInfo)
+	afterID := uuid.Nil
+	for {
+		files, err := uc.fileRepo.ListAll(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range files {
+			referenced[fileInfo.Path] = append(referenced[fileInfo.Path], fileInfo)
+		}
+		report.ScannedRecords += len(files)
+		if len(files) < reconcileBatchSize {
+			break
+		}
+		afterID = files[len(files)-1].ID
+	}
+
+	stored := make(map[string]bool)
+	err := uc.inventory.Walk(ctx, func(blob ports.StoredBlob) error {
+		report.ScannedBlobs++
+		stored[blob.Path] = true
+		if _, ok := referenced[blob.Path]; !ok && blob.ModifiedAt.Before(cutoff) {
+			report.OrphanedBlobs = append(report.OrphanedBlobs, blob)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for path, files := range referenced {
+		if !stored[path] {
+			report.DanglingRecords = append(report.DanglingRecords, files...)
+		}
+	}
+
+	if !repair {
+		return report, nil
+	}
+
+	for _, blob := range report.OrphanedBlobs {
+		removed, err := uc.removeOrphanedBlob(ctx, blob.Path)
+		if err != nil {
+			return report, err
+		}
+		if removed {
+			report.RemovedBlobs++
+		}
+	}
+
+	for _, fileInfo := range report.DanglingRecords {
+		removed, err := uc.removeDanglingRecord(ctx, fileInfo)
+		if err != nil {
+			return report, err
+		}
+		if removed {
+			report.RemovedRecords++
+		}
+	}
+
+	return report, nil
+}
+
+func (uc *StorageReconciliationUseCases) removeOrphanedBlob(ctx context.Context, path string) (bool, error) {
+	count, err := uc.fileRepo.CountByPath(ctx, path)
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	if err := uc.storageService.DeleteFile(ctx, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *StorageReconciliationUseCases) removeDanglingRecord(ctx context.Context, fileInfo *entities.FileInfo) (bool, error) {
+	exists, err := uc.inventory.Exists(ctx, fileInfo.Path)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	// El cambio de nivel copia el archivo, actualiza la ruta del registro y solo después borra el
+	// original, así que se relee el registro después de comprobar el archivo: si la ruta cambió,
+	// el registro apunta a la copia y no debe eliminarse
+	current, err := uc.fileRepo.GetByID(ctx, fileInfo.ID)
+	if err == entities.ErrFileNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if current.Path != fileInfo.Path {
+		return false, nil
+	}
+
+	err = uc.fileRepo.Delete(ctx, fileInfo.ID)
+	// Ya se eliminó por otra vía durante el recorrido
+	if err == entities.ErrFileNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if uc.notificationSvc != nil {
+		uc.notificationSvc.SendNotification(
+			ctx,
+			fileInfo.UserID,
+			"File unavailable",
+			fmt.Sprintf("Version %d of %s is no longer available in storage and was removed", fileInfo.Version, fileInfo.Filename),
+			"file_missing",
+			nil,
+			map[string]string{
+				"file_id":    fileInfo.ID.String(),
+				"logical_id": fileInfo.LogicalID.String(),
+				"filename":   fileInfo.Filename,
+				"version":    strconv.FormatInt(fileInfo.Version, 10),
+			},
+		)
+	}
+	return true, nil
+}
\ No newline at end of file
@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type reconciliationTestEnv struct {
+	useCase       *StorageReconciliationUseCases
+	fileRepo      *mocks.FileRepository
+	inventory     *mocks.StorageInventory
+	storage       *mocks.FileStorageService
+	notifications *mocks.NotificationService
+}
+
+func newTestStorageReconciliation(t *testing.T) *reconciliationTestEnv {
+	env := &reconciliationTestEnv{
+		fileRepo:      mocks.NewFileRepository(t),
+		inventory:     mocks.NewStorageInventory(t),
+		storage:       mocks.NewFileStorageService(t),
+		notifications: mocks.NewNotificationService(t),
+	}
+	env.useCase = NewStorageReconciliationUseCases(env.fileRepo, env.inventory, env.storage, env.notifications, entities.NewFakeClock(testNow), time.Hour)
+	return env
+}
+
+// withSnapshot prepara el recorrido inicial: los registros de la tabla y los archivos almacenados
+func (env *reconciliationTestEnv) withSnapshot(files []*entities.FileInfo, blobs []ports.StoredBlob) {
+	env.fileRepo.On("ListAll", mock.Anything, uuid.Nil, reconcileBatchSize).Return(files, nil)
+	env.inventory.EXPECT().Walk(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(ports.StoredBlob) error) error {
+		for _, blob := range blobs {
+			if err := fn(blob); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func storedFileFixture(path string) *entities.FileInfo {
+	return &entities.FileInfo{
+		ID:        uuid.New(),
+		LogicalID: uuid.New(),
+		Filename:  "informe.pdf",
+		Path:      path,
+		Version:   2,
+		UserID:    uuid.New(),
+		CreatedAt: testNow.Add(-24 * time.Hour),
+	}
+}
+
+func TestReconcile_DetectsOrphanedBlobsAfterGracePeriod(t *testing.T) {
+	// Arrange
+	env := newTestStorageReconciliation(t)
+	referenced := storedFileFixture("files/referenced")
+	orphaned := ports.StoredBlob{Path: "files/orphaned", ModifiedAt: testNow.Add(-2 * time.Hour)}
+	uploading := ports.StoredBlob{Path: "files/uploading", ModifiedAt: testNow.Add(-time.Minute)}
+	env.withSnapshot([]*entities.FileInfo{referenced}, []ports.StoredBlob{
+		{Path: referenced.Path, ModifiedAt: testNow.Add(-24 * time.Hour)},
+		orphaned,
+		uploading,
+	})
+
+	// Act
+	report, err := env.useCase.Reconcile(context.Background(), false)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.ScannedBlobs)
+	assert.Equal(t, 1, report.ScannedRecords)
+	assert.Equal(t, []ports.StoredBlob{orphaned}, report.OrphanedBlobs)
+	assert.Empty(t, report.DanglingRecords)
+	assert.Zero(t, report.RemovedBlobs)
+	env.storage.AssertNotCalled(t, "DeleteFile", mock.Anything, mock.Anything)
+}
+
+func TestReconcile_RepairRemovesOrphanedBlobs(t *testing.T) {
+	// Arrange
+	env := newTestStorageReconciliation(t)
+	orphaned := ports.StoredBlob{Path: "files/orphaned", ModifiedAt: testNow.Add(-2 * time.Hour)}
+	registered := ports.StoredBlob{Path: "files/registered-meanwhile", ModifiedAt: testNow.Add(-2 * time.Hour)}
+	env.withSnapshot(nil, []ports.StoredBlob{orphaned, registered})
+
+	env.fileRepo.On("CountByPath", mock.Anything, orphaned.Path).Return(0, nil)
+	env.fileRepo.On("CountByPath", mock.Anything, registered.Path).Return(1, nil)
+	env.storage.On("DeleteFile", mock.Anything, orphaned.Path).Return(nil)
+
+	// Act
+	report, err := env.useCase.Reconcile(context.Background(), true)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, report.OrphanedBlobs, 2)
+	assert.Equal(t, 1, report.RemovedBlobs)
+	env.storage.AssertNotCalled(t, "DeleteFile", mock.Anything, registered.Path)
+}
+
+func TestReconcile_DetectsDanglingRecords(t *testing.T) {
+	// Arrange
+	env := newTestStorageReconciliation(t)
+	dangling := storedFileFixture("files/missing")
+	env.withSnapshot([]*entities.FileInfo{dangling}, nil)
+
+	// Act
+	report, err := env.useCase.Reconcile(context.Background(), false)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []*entities.FileInfo{dangling}, report.DanglingRecords)
+	env.fileRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestReconcile_RepairRemovesDanglingRecordAndNotifiesOwner(t *testing.T) {
+	// Arrange
+	env := newTestStorageReconciliation(t)
+	dangling := storedFileFixture("files/missing")
+	env.withSnapshot([]*entities.FileInfo{dangling}, nil)
+
+	env.inventory.On("Exists", mock.Anything, dangling.Path).Return(false, nil)
+	env.fileRepo.On("GetByID", mock.Anything, dangling.ID).Return(dangling, nil)
+	env.fileRepo.On("Delete", mock.Anything, dangling.ID).Return(nil)
+	env.notifications.On("SendNotification", mock.Anything, dangling.UserID, "File unavailable", mock.Anything, "file_missing", []string(nil), mock.MatchedBy(func(metadata map[string]string) bool {
+		return metadata["file_id"] == dangling.ID.String() && metadata["version"] == "2"
+	})).Return(nil)
+
+	// Act
+	report, err := env.useCase.Reconcile(context.Background(), true)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.RemovedRecords)
+}
+
+func TestReconcile_RepairKeepsRecordsThatChangedDuringTheScan(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(env *reconciliationTestEnv, fileInfo *entities.FileInfo)
+	}{
+		{
+			name: "file stored again",
+			setup: func(env *reconciliationTestEnv, fileInfo *entities.FileInfo) {
+				env.inventory.On("Exists", mock.Anything, fileInfo.Path).Return(true, nil)
+			},
+		},
+		{
+			// El trabajo de niveles (o Restore al descargar) movió el archivo: el registro
+			// ya apunta a la copia y el original se borró después
+			name: "file moved to another tier",
+			setup: func(env *reconciliationTestEnv, fileInfo *entities.FileInfo) {
+				moved := *fileInfo
+				moved.Path = "cold/files/missing"
+				moved.StorageTier = entities.StorageTierCold
+				env.inventory.On("Exists", mock.Anything, fileInfo.Path).Return(false, nil)
+				env.fileRepo.On("GetByID", mock.Anything, fileInfo.ID).Return(&moved, nil)
+			},
+		},
+		{
+			name: "record deleted",
+			setup: func(env *reconciliationTestEnv, fileInfo *entities.FileInfo) {
+				env.inventory.On("Exists", mock.Anything, fileInfo.Path).Return(false, nil)
+				env.fileRepo.On("GetByID", mock.Anything, fileInfo.ID).Return(nil, entities.ErrFileNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			env := newTestStorageReconciliation(t)
+			fileInfo := storedFileFixture("files/missing")
+			env.withSnapshot([]*entities.FileInfo{fileInfo}, nil)
+			tt.setup(env, fileInfo)
+
+			// Act
+			report, err := env.useCase.Reconcile(context.Background(), true)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Len(t, report.DanglingRecords, 1)
+			assert.Zero(t, report.RemovedRecords)
+			env.fileRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+			env.notifications.AssertNotCalled(t, "SendNotification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
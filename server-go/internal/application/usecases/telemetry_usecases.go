@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxClientMetricBatchSize es el número máximo de eventos por lote enviado por la app
+	MaxClientMetricBatchSize = 500
+	// maxDeviceIDLength es la longitud máxima del identificador de instalación del dispositivo
+	maxDeviceIDLength = 100
+)
+
+// DefaultClientMetricSampling son las tasas de muestreo por tipo de evento si no se configuran
+// otras: las sincronizaciones son muy frecuentes y basta una muestra, los fallos se guardan todos
+var DefaultClientMetricSampling = map[entities.ClientMetricKind]float64{
+	entities.ClientMetricSyncDuration:    0.2,
+	entities.ClientMetricCrashBreadcrumb: 1,
+	entities.ClientMetricFeatureUsage:    1,
+}
+
+// ClientMetricReport resume la recepción de un lote de telemetría
+type ClientMetricReport struct {
+	Accepted   int
+	SampledOut int
+	Rejected   int
+}
+
+// TelemetryUseCases recibe la telemetría de la app, la muestrea, elimina los datos personales y
+// la guarda de forma asíncrona a través de la cola
+type TelemetryUseCases struct {
+	metricRepo ports.ClientMetricRepository
+	queue      ports.ClientMetricQueue
+	clock      entities.Clock
+	ids        entities.IDGenerator
+	sampling   map[entities.ClientMetricKind]float64
+	retention  time.Duration
+}
+
+// TelemetryOption configura parámetros opcionales de TelemetryUseCases
+type TelemetryOption func(*TelemetryUseCases)
+
+// WithClientMetricSampling define la fracción (entre 0 y 1) de usuarios cuyos eventos de tipo kind se guardan
+func WithClientMetricSampling(kind entities.ClientMetricKind, rate float64) TelemetryOption {
+	return func(uc *TelemetryUseCases) {
+		uc.sampling[kind] = min(max(rate, 0), 1)
+	}
+}
+
+// NewTelemetryUseCases crea una nueva instancia de TelemetryUseCases; los eventos se conservan
+// durante retention (0 los conserva indefinidamente)
+func NewTelemetryUseCases(metricRepo ports.ClientMetricRepository, queue ports.ClientMetricQueue, clock entities.Clock, ids entities.IDGenerator, retention time.Duration, options ...TelemetryOption) *TelemetryUseCases {
+	sampling := make(map[entities.ClientMetricKind]float64, len(DefaultClientMetricSampling))
+	for kind, rate := range DefaultClientMetricSampling {
+		sampling[kind] = rate
+	}
+	
+	uc := &TelemetryUseCases{
+		metricRepo: metricRepo,
+		queue:      queue,
+		clock:      clock,
+		ids:        ids,
+		sampling:   sampling,
+		retention:  retention,
+	}
+	for _, option := range options {
+		option(uc)
+	}
+	return uc
+}
+
+// IngestClientMetrics encola para guardar los eventos válidos y muestreados de un lote enviado por
+// un dispositivo del usuario. Los eventos no válidos se descartan sin rechazar el resto del lote.
+func (uc *TelemetryUseCases) IngestClientMetrics(ctx context.Context, userID uuid.UUID, deviceID, appVersion string, metrics []*entities.ClientMetric) (*ClientMetricReport, error) {
+	if len(metrics) > MaxClientMetricBatchSize {
+		return nil, entities.ErrClientMetricBatchTooLarge
+	}
+	if len(deviceID) > maxDeviceIDLength || len(appVersion) > maxDeviceIDLength {
+		return nil, entities.ErrInvalidClientMetric
+	}
+	
+	report := &ClientMetricReport{}
+	now := uc.clock.Now()
+	accepted := make([]*entities.ClientMetric, 0, len(metrics))
+	for _, metric := range metrics {
+		if err := metric.Validate(now); err != nil {
+			report.Rejected++
+			continue
+		}
+		if !entities.SampledIn(userID, metric.Kind, uc.sampling[metric.Kind]) {
+			report.SampledOut++
+			continue
+		}
+	
+		metric.ID = uc.ids.NewID()
+		metric.UserID = userID
+		metric.DeviceID = deviceID
+		metric.AppVersion = appVersion
+		metric.ReceivedAt = now
+		metric.ScrubPII()
+		accepted = append(accepted, metric)
+	}
+	
+	if len(accepted) > 0 {
+		if err := uc.queue.EnqueueClientMetrics(ctx, accepted); err != nil {
+			return nil, err
+		}
+	}
+	report.Accepted = len(accepted)
+	
+	return report, nil
+}
+
+// StoreClientMetrics guarda un lote ya muestreado; lo llama el consumidor de la cola
+func (uc *TelemetryUseCases) StoreClientMetrics(ctx context.Context, metrics []*entities.ClientMetric) error {
+	return uc.metricRepo.CreateBatch(ctx, metrics)
+}
+
+// PurgeClientMetrics elimina los eventos que superan el periodo de retención
+func (uc *TelemetryUseCases) PurgeClientMetrics(ctx context.Context) (int64, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+	return uc.metricRepo.DeleteOlderThan(ctx, uc.clock.Now().Add(-uc.retention))
+}
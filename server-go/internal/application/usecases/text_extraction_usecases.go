@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// TextExtractionUseCases contiene los casos de uso para extraer e indexar el texto de los archivos
+type TextExtractionUseCases struct {
+	fileRepo       ports.FileRepository
+	textRepo       ports.FileTextRepository
+	storageService ports.FileStorageService
+	extractors     []ports.TextExtractor
+	eventBus       ports.EventBus
+	clock          entities.Clock
+}
+
+// NewTextExtractionUseCases crea una nueva instancia de TextExtractionUseCases; para cada
+// archivo se usa el primer extractor de extractors que soporte su tipo de contenido
+func NewTextExtractionUseCases(fileRepo ports.FileRepository, textRepo ports.FileTextRepository, storageService ports.FileStorageService, extractors []ports.TextExtractor, eventBus ports.EventBus, clock entities.Clock) *TextExtractionUseCases {
+	return &TextExtractionUseCases{
+		fileRepo:       fileRepo,
+		textRepo:       textRepo,
+		storageService: storageService,
+		extractors:     extractors,
+		eventBus:       eventBus,
+		clock:          clock,
+	}
+}
+
+// ExtractText extrae el texto de un archivo y lo guarda para la búsqueda. Los archivos
+// eliminados antes de procesarse y los tipos sin extractor se omiten sin error.
+func (uc *TextExtractionUseCases) ExtractText(ctx context.Context, fileID uuid.UUID) error {
+	fileInfo, err := uc.fileRepo.GetByID(ctx, fileID)
+	if err == entities.ErrFileNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	extractor := uc.extractorFor(fileInfo.ContentType)
+	if extractor == nil {
+		return nil
+	}
+
+	reader, err := uc.storageService.RetrieveFile(ctx, fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	content, err := extractor.Extract(ctx, fileInfo.ContentType, reader)
+	if err != nil {
+		return err
+	}
+
+	text := entities.NewFileText(uc.clock, fileInfo, content, extractor.Name())
+	if err := uc.textRepo.Upsert(ctx, text); err != nil {
+		return err
+	}
+
+	// Publicar evento de texto extraído
+	if uc.eventBus != nil {
+		event := &FileTextExtractedEvent{
+			EventHeader: newEventHeader(ctx, uc.clock, nil, uuid.Nil),
+			FileID:      fileInfo.ID,
+			UserID:      fileInfo.UserID,
+			Extractor:   text.Extractor,
+			Length:      len(text.Content),
+		}
+		uc.eventBus.Publish(ctx, event)
+	}
+
+	return nil
+}
+
+func (uc *TextExtractionUseCases) extractorFor(contentType string) ports.TextExtractor {
+	for _, extractor := range uc.extractors {
+		if extractor.Supports(contentType) {
+			return extractor
+		}
+	}
+	return nil
+}
+
+// Events
+type FileTextExtractedEvent struct {
+	entities.EventHeader
+	FileID    uuid.UUID
+	UserID    uuid.UUID
+	Extractor string
+	Length    int
+}
@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// runInTx ejecuta fn dentro de una transacción, confirmándola solo si fn no falla
+func runInTx(ctx context.Context, uow ports.UnitOfWork, fn func(tx ports.Tx) error) error {
+	tx, err := uow.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	
+	if err := fn(tx); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	// weeklySummaryBatchSize es el número de destinatarios leídos por consulta
+	weeklySummaryBatchSize = 100
+	// weeklySummaryNotificationType identifica los resúmenes en la bandeja de notificaciones
+	weeklySummaryNotificationType = "weekly_summary"
+	// emailChannel es el canal de notificaciones que entrega por email
+	emailChannel = "email"
+	// Metadatos con el cuerpo ya redactado del email, que el canal "email" envía tal cual
+	metadataEmailHTML = "email_html"
+	metadataEmailText = "email_text"
+)
+
+// WeeklySummaryReport resume una ejecución del envío de resúmenes semanales
+type WeeklySummaryReport struct {
+	Sent int
+	// Skipped cuenta los usuarios que ya recibieron el resumen de la semana o que no tuvieron actividad
+	Skipped int
+	// Failed cuenta los resúmenes que no se pudieron enviar; se reintentan en la siguiente ejecución
+	Failed int
+}
+
+// WeeklySummaryUseCases envía a cada usuario que lo aceptó el resumen de su última semana
+// completa. Debe ejecutarse en una sola réplica a la vez.
+type WeeklySummaryUseCases struct {
+	preferenceRepo  ports.EmailPreferenceRepository
+	summaryRepo     ports.WeeklySummaryRepository
+	progressRepo    ports.ProgressRepository
+	localeRepo      ports.LocalePreferenceRepository
+	renderer        ports.ReportRenderer
+	notificationSvc ports.NotificationService
+	clock           entities.Clock
+}
+
+// NewWeeklySummaryUseCases crea una nueva instancia de WeeklySummaryUseCases; localeRepo puede
+// ser nil, en cuyo caso los resúmenes se redactan en el idioma por defecto
+func NewWeeklySummaryUseCases(preferenceRepo ports.EmailPreferenceRepository, summaryRepo ports.WeeklySummaryRepository, progressRepo ports.ProgressRepository, localeRepo ports.LocalePreferenceRepository, renderer ports.ReportRenderer, notificationSvc ports.NotificationService, clock entities.Clock) *WeeklySummaryUseCases {
+	return &WeeklySummaryUseCases{
+		preferenceRepo:  preferenceRepo,
+		summaryRepo:     summaryRepo,
+		progressRepo:    progressRepo,
+		localeRepo:      localeRepo,
+		renderer:        renderer,
+		notificationSvc: notificationSvc,
+		clock:           clock,
+	}
+}
+
+// SendWeeklySummaries envía el resumen de la última semana completa a los usuarios que todavía no
+// lo recibieron. Los errores de un usuario no detienen el envío a los demás.
+func (uc *WeeklySummaryUseCases) SendWeeklySummaries(ctx context.Context) (*WeeklySummaryReport, error) {
+	report := &WeeklySummaryReport{}
+	now := uc.clock.Now()
+	periodEnd := entities.WeeklySummaryPeriodEnd(now)
+
+	afterID := uuid.Nil
+	for {
+		recipients, err := uc.preferenceRepo.ListWeeklySummaryRecipients(ctx, afterID, weeklySummaryBatchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(recipients) == 0 {
+			return report, nil
+		}
+		afterID = recipients[len(recipients)-1].UserID
+
+		for _, recipient := range recipients {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			sent, err := uc.send(ctx, recipient.UserID, periodEnd)
+			switch {
+			case err != nil:
+				report.Failed++
+			case sent:
+				report.Sent++
+			default:
+				report.Skipped++
+			}
+		}
+	}
+}
+
+// send envía el resumen de la semana que termina en periodEnd a userID; devuelve false si no
+// hacía falta enviarlo
+func (uc *WeeklySummaryUseCases) send(ctx context.Context, userID uuid.UUID, periodEnd time.Time) (bool, error) {
+	previous, err := uc.summaryRepo.GetLatest(ctx, userID)
+	if errors.Is(err, entities.ErrWeeklySummaryNotFound) {
+		previous = nil
+	} else if err != nil {
+		return false, err
+	}
+	if previous != nil && !previous.PeriodEnd.Before(periodEnd) {
+		return false, nil
+	}
+
+	activity, err := uc.summaryRepo.CountActivity(ctx, userID, periodEnd.AddDate(0, 0, -7), periodEnd)
+	if err != nil {
+		return false, err
+	}
+	projects, err := uc.progressRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	summary := entities.NewWeeklySummary(userID, periodEnd, activity, projects, previous)
+	summary.SentAt = uc.clock.Now()
+	if summary.IsEmpty() {
+		// Se guarda igualmente para no volver a calcular la semana en cada ejecución
+		return false, uc.summaryRepo.SaveLatest(ctx, summary)
+	}
+
+	locale := ""
+	if uc.localeRepo != nil {
+		if locale, err = uc.localeRepo.GetLocale(ctx, userID); err != nil && !errors.Is(err, entities.ErrLocalePreferenceNotFound) {
+			return false, err
+		}
+	}
+	rendered, err := uc.renderer.RenderWeeklySummary(summary, locale)
+	if err != nil {
+		return false, err
+	}
+
+	metadata := map[string]string{
+		metadataEmailHTML: rendered.HTML,
+		metadataEmailText: rendered.Text,
+		"period_start":    summary.PeriodStart.Format(time.RFC3339),
+		"period_end":      summary.PeriodEnd.Format(time.RFC3339),
+	}
+	err = uc.notificationSvc.SendNotification(ctx, userID, rendered.Subject, rendered.Text, weeklySummaryNotificationType, []string{emailChannel}, metadata)
+	if err != nil {
+		return false, err
+	}
+
+	return true, uc.summaryRepo.SaveLatest(ctx, summary)
+}
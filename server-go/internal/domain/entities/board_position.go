@@ -0,0 +1,104 @@
+package entities
+
+import "strings"
+
+// positionDigits son los dígitos de las posiciones del tablero en base 62, en orden ASCII para que
+// el orden lexicográfico de las claves coincida con el de las posiciones
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// PositionBetween devuelve una posición que queda entre before y after en orden lexicográfico.
+// before vacío indica el principio de la columna y after vacío el final. Las posiciones son
+// fracciones en base 62 sin el "0." inicial, así que siempre cabe una nueva entre dos distintas
+// sin mover las demás.
+func PositionBetween(before, after string) (string, error) {
+	if !validPosition(before) || !validPosition(after) {
+		return "", ErrInvalidBoardPosition
+	}
+	if after != "" && before >= after {
+		return "", ErrInvalidBoardPosition
+	}
+	return positionMidpoint(before, after), nil
+}
+
+// SpreadPositions devuelve n posiciones crecientes repartidas de manera uniforme; se usan para
+// renumerar una columna cuyas posiciones no permiten intercalar una idea
+func SpreadPositions(n int) []string {
+	width, space := 1, len(positionDigits)
+	for space <= n {
+		width++
+		space *= len(positionDigits)
+	}
+	step := space / (n + 1)
+
+	positions := make([]string, n)
+	for i := range positions {
+		value := (i + 1) * step
+		digits := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = positionDigits[value%len(positionDigits)]
+			value /= len(positionDigits)
+		}
+		positions[i] = strings.TrimRight(string(digits), "0")
+	}
+	return positions
+}
+
+// positionMidpoint calcula el punto medio de a < b; b vacío representa el final de la columna.
+// Ninguna de las dos termina en "0", de modo que siempre hay lugar antes de una posición.
+func positionMidpoint(a, b string) string {
+	if b != "" {
+		// Se conserva el prefijo común y se busca el punto medio de lo que sigue
+		n := 0
+		for n < len(b) && positionDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + positionMidpoint(rest, b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(positionDigits, a[0])
+	}
+	digitB := len(positionDigits)
+	if b != "" {
+		digitB = strings.IndexByte(positionDigits, b[0])
+	}
+	if digitB-digitA > 1 {
+		return string(positionDigits[(digitA+digitB+1)/2])
+	}
+
+	// Los primeros dígitos son consecutivos
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(positionDigits[digitA]) + positionMidpoint(rest, "")
+}
+
+func positionDigitAt(position string, i int) byte {
+	if i < len(position) {
+		return position[i]
+	}
+	return positionDigits[0]
+}
+
+func validPosition(position string) bool {
+	if strings.HasSuffix(position, positionDigits[:1]) {
+		return false
+	}
+	for i := 0; i < len(position); i++ {
+		if strings.IndexByte(positionDigits, position[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
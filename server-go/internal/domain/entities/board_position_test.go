@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionBetween(t *testing.T) {
+	position, err := PositionBetween("", "")
+	assert.NoError(t, err)
+
+	// Insertar repetidamente al principio y entre dos vecinos no debe agotar las posiciones
+	first, last := position, position
+	for i := 0; i < 200; i++ {
+		before, err := PositionBetween("", first)
+		assert.NoError(t, err)
+		assert.Less(t, before, first)
+		first = before
+
+		between, err := PositionBetween(first, last)
+		assert.NoError(t, err)
+		assert.Less(t, first, between)
+		assert.Less(t, between, last)
+		last = between
+	}
+
+	_, err = PositionBetween("b", "a")
+	assert.ErrorIs(t, err, ErrInvalidBoardPosition)
+	_, err = PositionBetween("a0", "")
+	assert.ErrorIs(t, err, ErrInvalidBoardPosition)
+}
+
+func TestSpreadPositions(t *testing.T) {
+	positions := SpreadPositions(100)
+	assert.Len(t, positions, 100)
+	for i := 1; i < len(positions); i++ {
+		assert.Less(t, positions[i-1], positions[i])
+		_, err := PositionBetween(positions[i-1], positions[i])
+		assert.NoError(t, err)
+	}
+}
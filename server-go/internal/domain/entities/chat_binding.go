@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatProvider es el servicio de mensajería al que se envían las notificaciones de un chat
+type ChatProvider string
+
+const (
+	ChatProviderSlack    ChatProvider = "slack"
+	ChatProviderTelegram ChatProvider = "telegram"
+)
+
+// IsValid verifica si el proveedor es conocido
+func (p ChatProvider) IsValid() bool {
+	return p == ChatProviderSlack || p == ChatProviderTelegram
+}
+
+// chatCodeBytes es la entropía de los códigos de vinculación; en base32 son 8 caracteres fáciles
+// de escribir en un comando de Slack y válidos en el parámetro start de Telegram
+const chatCodeBytes = 5
+
+// ChatBinding vincula a un usuario con un chat de Slack o de Telegram que recibe sus notificaciones.
+// Se crea pendiente, con un código de un solo uso que el usuario envía desde el chat para confirmarlo.
+type ChatBinding struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Provider      ChatProvider
+	ChatID        string // canal de Slack o chat de Telegram; vacío mientras está pendiente
+	CodeHash      string // vacío una vez vinculado
+	CodeExpiresAt time.Time
+	CreatedAt     time.Time
+	LinkedAt      *time.Time
+}
+
+// NewChatBinding crea un vínculo pendiente para userID y devuelve también el código en claro
+func NewChatBinding(clock Clock, ids IDGenerator, userID uuid.UUID, provider ChatProvider, codeTTL time.Duration) (*ChatBinding, string, error) {
+	raw := make([]byte, chatCodeBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate chat binding code: %w", err)
+	}
+	code := base32.StdEncoding.EncodeToString(raw)
+
+	now := clock.Now()
+	return &ChatBinding{
+		ID:            ids.NewID(),
+		UserID:        userID,
+		Provider:      provider,
+		CodeHash:      HashChatBindingCode(code),
+		CodeExpiresAt: now.Add(codeTTL),
+		CreatedAt:     now,
+	}, code, nil
+}
+
+// HashChatBindingCode devuelve el hash con el que se guarda y busca un código de vinculación
+func HashChatBindingCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate valida que el vínculo sea correcto
+func (b *ChatBinding) Validate() error {
+	if b.UserID == uuid.Nil {
+		return ErrChatBindingUserIDRequired
+	}
+	if !b.Provider.IsValid() {
+		return ErrInvalidChatProvider
+	}
+	return nil
+}
+
+// IsLinked verifica si el usuario ya confirmó el vínculo desde el chat
+func (b *ChatBinding) IsLinked() bool {
+	return b.LinkedAt != nil
+}
+
+// IsCodeExpired verifica si el código de vinculación ya no puede usarse
+func (b *ChatBinding) IsCodeExpired(now time.Time) bool {
+	return !now.Before(b.CodeExpiresAt)
+}
+
+// IsOwnedBy verifica si el vínculo pertenece al usuario especificado
+func (b *ChatBinding) IsOwnedBy(userID uuid.UUID) bool {
+	return b.UserID == userID
+}
+
+// Link confirma el vínculo con chatID e invalida el código
+func (b *ChatBinding) Link(chatID string, now time.Time) {
+	b.ChatID = chatID
+	b.CodeHash = ""
+	b.LinkedAt = &now
+}
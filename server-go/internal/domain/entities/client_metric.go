@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientMetricKind es el tipo de un evento de telemetría enviado por la app
+type ClientMetricKind string
+
+const (
+	// ClientMetricSyncDuration mide una sincronización; Value son los milisegundos que tardó
+	ClientMetricSyncDuration ClientMetricKind = "sync_duration"
+	// ClientMetricCrashBreadcrumb es un paso previo a un fallo de la app; Message lo describe
+	ClientMetricCrashBreadcrumb ClientMetricKind = "crash_breadcrumb"
+	// ClientMetricFeatureUsage cuenta el uso de una función; Value es el número de usos
+	ClientMetricFeatureUsage ClientMetricKind = "feature_usage"
+)
+
+// ClientMetricKinds son los tipos de evento que se aceptan
+var ClientMetricKinds = []ClientMetricKind{ClientMetricSyncDuration, ClientMetricCrashBreadcrumb, ClientMetricFeatureUsage}
+
+const (
+	// MaxClientMetricNameLength es la longitud máxima del nombre de un evento
+	MaxClientMetricNameLength = 100
+	// MaxClientMetricMessageLength es la longitud en bytes a la que se recorta el mensaje de un evento
+	MaxClientMetricMessageLength = 500
+	// MaxClientMetricAttributes es el número máximo de atributos de un evento
+	MaxClientMetricAttributes = 20
+	// maxClientMetricAttributeLength es la longitud en bytes a la que se recorta cada atributo
+	maxClientMetricAttributeLength = 200
+	// maxClientMetricSkew es cuánto puede adelantarse al servidor el reloj del dispositivo
+	maxClientMetricSkew = time.Hour
+)
+
+// ClientMetric es un evento de telemetría de la app Android. Se guarda después de eliminar los
+// datos personales de sus textos, así que no debe usarse para identificar contenido del usuario.
+type ClientMetric struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	DeviceID   string
+	AppVersion string
+	Kind       ClientMetricKind
+	Name       string
+	Value      float64
+	Message    string
+	Attributes map[string]string
+	OccurredAt time.Time
+	ReceivedAt time.Time
+}
+
+// IsValid verifica si el tipo de evento es uno de los admitidos
+func (k ClientMetricKind) IsValid() bool {
+	for _, kind := range ClientMetricKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate valida el evento; OccurredAt no puede ser posterior a now más el desfase admitido
+func (m *ClientMetric) Validate(now time.Time) error {
+	if !m.Kind.IsValid() {
+		return ErrInvalidClientMetric
+	}
+	if m.Name == "" || len(m.Name) > MaxClientMetricNameLength {
+		return ErrInvalidClientMetric
+	}
+	if len(m.Attributes) > MaxClientMetricAttributes {
+		return ErrInvalidClientMetric
+	}
+	if m.OccurredAt.IsZero() || m.OccurredAt.After(now.Add(maxClientMetricSkew)) {
+		return ErrInvalidClientMetric
+	}
+	if m.Kind == ClientMetricSyncDuration && m.Value < 0 {
+		return ErrInvalidClientMetric
+	}
+	return nil
+}
+
+// sensitiveAttributeKeys son los fragmentos de nombre de atributo cuyo valor se descarta entero
+var sensitiveAttributeKeys = []string{"mail", "phone", "username", "user_name", "address", "token", "password", "secret", "content", "title"}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	urlPattern   = regexp.MustCompile(`https?://\S+`)
+	ipv4Pattern  = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}\b`)
+	// Teléfonos, tarjetas y otros identificadores numéricos largos
+	digitsPattern = regexp.MustCompile(`\+?\d[\d \-]{6,}\d`)
+)
+
+// ScrubPII elimina del nombre, del mensaje y de los atributos los datos personales reconocibles: correos,
+// URLs, direcciones IP y secuencias largas de dígitos. Los atributos con nombres sensibles se
+// descartan y los textos se recortan a su longitud máxima.
+func (m *ClientMetric) ScrubPII() {
+	m.Name = ScrubPII(m.Name)
+	m.Message = truncateText(ScrubPII(m.Message), MaxClientMetricMessageLength)
+
+	attributes := make(map[string]string, len(m.Attributes))
+	for key, value := range m.Attributes {
+		if isSensitiveAttribute(key) {
+			continue
+		}
+		attributes[key] = truncateText(ScrubPII(value), maxClientMetricAttributeLength)
+	}
+	m.Attributes = attributes
+}
+
+// ScrubPII sustituye en text los datos personales reconocibles por marcadores
+func ScrubPII(text string) string {
+	text = emailPattern.ReplaceAllString(text, "[email]")
+	text = urlPattern.ReplaceAllString(text, "[url]")
+	text = ipv4Pattern.ReplaceAllString(text, "[ip]")
+	return digitsPattern.ReplaceAllString(text, "[number]")
+}
+
+func isSensitiveAttribute(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveAttributeKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// SampledIn decide si se guardan los eventos de tipo kind del usuario con la tasa rate (entre 0 y 1).
+// La decisión depende solo del usuario y del tipo, así que se conservan todos los eventos de los
+// usuarios muestreados y sus sesiones se pueden reconstruir.
+func SampledIn(userID uuid.UUID, kind ClientMetricKind, rate float64) bool {
+	if rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	hash.Write(userID[:])
+	hash.Write([]byte(kind))
+	return float64(hash.Sum32()%10000) < rate*10000
+}
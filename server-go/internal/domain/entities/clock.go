@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Clock abstrae la obtención de la hora actual para poder controlarla en pruebas
+type Clock interface {
+	Now() time.Time
+}
+
+// IDGenerator abstrae la generación de identificadores de entidades
+type IDGenerator interface {
+	NewID() uuid.UUID
+}
+
+// SystemClock devuelve la hora real del sistema
+type SystemClock struct{}
+
+// Now devuelve la hora actual
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// UUIDGenerator genera UUID v4 aleatorios
+type UUIDGenerator struct{}
+
+// NewID genera un nuevo UUID aleatorio
+func (UUIDGenerator) NewID() uuid.UUID {
+	return uuid.New()
+}
+
+// FakeClock es un reloj controlable manualmente, pensado para pruebas
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock crea un reloj detenido en el instante indicado
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now devuelve el instante actual del reloj
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance adelanta el reloj la duración indicada
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set fija el reloj en el instante indicado
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// SequentialIDGenerator genera UUID deterministas y crecientes, pensado para pruebas
+type SequentialIDGenerator struct {
+	mu   sync.Mutex
+	next uint64
+}
+
+// NewID devuelve el siguiente UUID de la secuencia (00000000-0000-0000-0000-000000000001, ...)
+func (g *SequentialIDGenerator) NewID() uuid.UUID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.next++
+
+	var id uuid.UUID
+	n := g.next
+	for i := len(id) - 1; i >= 0 && n > 0; i-- {
+		id[i] = byte(n)
+		n >>= 8
+	}
+	return id
+}
@@ -0,0 +1,263 @@
+package entities
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldType representa el tipo de valor de un campo personalizado
+type CustomFieldType int32
+
+const (
+	CustomFieldTypeUnspecified CustomFieldType = 0
+	CustomFieldTypeText        CustomFieldType = 1
+	CustomFieldTypeNumber      CustomFieldType = 2
+	CustomFieldTypeDate        CustomFieldType = 3
+	CustomFieldTypeEnum        CustomFieldType = 4
+)
+
+// IsValid verifica si el tipo es uno de los definidos
+func (t CustomFieldType) IsValid() bool {
+	return t >= CustomFieldTypeText && t <= CustomFieldTypeEnum
+}
+
+// CustomFieldEntity representa el tipo de registro al que se añade un campo personalizado
+type CustomFieldEntity int32
+
+const (
+	CustomFieldEntityUnspecified CustomFieldEntity = 0
+	CustomFieldEntityIdea        CustomFieldEntity = 1
+	CustomFieldEntityProgress    CustomFieldEntity = 2
+)
+
+// IsValid verifica si el tipo de registro es uno de los definidos
+func (e CustomFieldEntity) IsValid() bool {
+	return e == CustomFieldEntityIdea || e == CustomFieldEntityProgress
+}
+
+// CustomFieldOperator representa la comparación de un filtro por campo personalizado
+type CustomFieldOperator int32
+
+const (
+	CustomFieldOperatorEqual          CustomFieldOperator = 0
+	CustomFieldOperatorLess           CustomFieldOperator = 1
+	CustomFieldOperatorLessOrEqual    CustomFieldOperator = 2
+	CustomFieldOperatorGreater        CustomFieldOperator = 3
+	CustomFieldOperatorGreaterOrEqual CustomFieldOperator = 4
+)
+
+const (
+	// MaxCustomFieldsPerEntity es el máximo de campos que un usuario define para un tipo de registro
+	MaxCustomFieldsPerEntity = 50
+	// MaxCustomFieldOptions es el máximo de opciones de un campo de tipo Enum
+	MaxCustomFieldOptions = 50
+	// maxCustomFieldTextLength es el máximo de caracteres de un valor de texto
+	maxCustomFieldTextLength = 1000
+)
+
+// customFieldKeyPattern limita las claves a identificadores cortos en minúsculas, que además se
+// usan como ruta dentro de la columna JSON
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// CustomFieldDefinition es un campo con tipo que un usuario añade a sus ideas o a sus registros de
+// progreso. Los valores se guardan en cada registro bajo Key.
+type CustomFieldDefinition struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	EntityType CustomFieldEntity
+	Key        string
+	Name       string
+	Type       CustomFieldType
+	// Options son los valores admitidos por un campo de tipo Enum
+	Options   []string
+	CreatedAt time.Time
+}
+
+// NewCustomFieldDefinition crea la definición de un campo personalizado
+func NewCustomFieldDefinition(clock Clock, ids IDGenerator, userID uuid.UUID, entityType CustomFieldEntity, key, name string, fieldType CustomFieldType, options []string) *CustomFieldDefinition {
+	return &CustomFieldDefinition{
+		ID:         ids.NewID(),
+		UserID:     userID,
+		EntityType: entityType,
+		Key:        strings.TrimSpace(key),
+		Name:       strings.TrimSpace(name),
+		Type:       fieldType,
+		Options:    options,
+		CreatedAt:  clock.Now(),
+	}
+}
+
+// IsOwnedBy verifica si la definición pertenece al usuario especificado
+func (d *CustomFieldDefinition) IsOwnedBy(userID uuid.UUID) bool {
+	return d.UserID == userID
+}
+
+// Validate valida la clave, el tipo y, en los campos Enum, que haya opciones distintas y no vacías
+func (d *CustomFieldDefinition) Validate() error {
+	if d.UserID == uuid.Nil || !d.EntityType.IsValid() || !d.Type.IsValid() {
+		return ErrInvalidCustomField
+	}
+	if !customFieldKeyPattern.MatchString(d.Key) || d.Name == "" {
+		return ErrInvalidCustomField
+	}
+	if d.Type != CustomFieldTypeEnum {
+		if len(d.Options) > 0 {
+			return ErrInvalidCustomField
+		}
+		return nil
+	}
+	if len(d.Options) == 0 || len(d.Options) > MaxCustomFieldOptions {
+		return ErrInvalidCustomField
+	}
+	seen := make(map[string]bool, len(d.Options))
+	for _, option := range d.Options {
+		if option == "" || seen[option] {
+			return ErrInvalidCustomField
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+// Check verifica que value sea del tipo del campo y, si es Enum, una de sus opciones
+func (d *CustomFieldDefinition) Check(value CustomFieldValue) error {
+	if value.Type != d.Type {
+		return ErrInvalidCustomFieldValue
+	}
+	switch d.Type {
+	case CustomFieldTypeText:
+		if utf8.RuneCountInString(value.Text) > maxCustomFieldTextLength {
+			return ErrInvalidCustomFieldValue
+		}
+	case CustomFieldTypeNumber:
+		if !isFinite(value.Number) {
+			return ErrInvalidCustomFieldValue
+		}
+	case CustomFieldTypeEnum:
+		for _, option := range d.Options {
+			if option == value.Text {
+				return nil
+			}
+		}
+		return ErrInvalidCustomFieldValue
+	}
+	return nil
+}
+
+// CustomFieldValue es el valor de un campo personalizado; solo tiene valor el atributo que
+// corresponde a Type
+type CustomFieldValue struct {
+	Type   CustomFieldType
+	Text   string // Text y Enum
+	Number float64
+	Date   time.Time // solo la fecha, a medianoche UTC
+}
+
+// NewCustomFieldDate quita la hora de t para guardarlo en un campo de tipo Date
+func NewCustomFieldDate(t time.Time) CustomFieldValue {
+	y, m, d := t.Date()
+	return CustomFieldValue{Type: CustomFieldTypeDate, Date: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// Compare devuelve -1, 0 o 1 según value sea menor, igual o mayor que other; ok es falso si los
+// tipos no coinciden
+func (v CustomFieldValue) Compare(other CustomFieldValue) (result int, ok bool) {
+	if v.Type != other.Type {
+		return 0, false
+	}
+	switch v.Type {
+	case CustomFieldTypeNumber:
+		switch {
+		case v.Number < other.Number:
+			return -1, true
+		case v.Number > other.Number:
+			return 1, true
+		}
+		return 0, true
+	case CustomFieldTypeDate:
+		return v.Date.Compare(other.Date), true
+	default:
+		return strings.Compare(v.Text, other.Text), true
+	}
+}
+
+// CustomFields son los valores de los campos personalizados de un registro, por clave
+type CustomFields map[string]CustomFieldValue
+
+// ValidateCustomFields verifica que cada valor corresponda a uno de los campos definidos y sea
+// válido para él
+func ValidateCustomFields(fields CustomFields, definitions []*CustomFieldDefinition) error {
+	byKey := make(map[string]*CustomFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+	for key, value := range fields {
+		definition, ok := byKey[key]
+		if !ok {
+			return ErrUnknownCustomField
+		}
+		if err := definition.Check(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CustomFieldFilter filtra los registros cuyo campo Key cumple la comparación con Value; los
+// campos de texto y Enum solo admiten la igualdad
+type CustomFieldFilter struct {
+	Key      string
+	Operator CustomFieldOperator
+	Value    CustomFieldValue
+}
+
+// Validate valida la clave, el tipo del valor y que el operador sea aplicable a él
+func (f CustomFieldFilter) Validate() error {
+	if !customFieldKeyPattern.MatchString(f.Key) || !f.Value.Type.IsValid() {
+		return ErrInvalidCustomFieldFilter
+	}
+	if f.Operator < CustomFieldOperatorEqual || f.Operator > CustomFieldOperatorGreaterOrEqual {
+		return ErrInvalidCustomFieldFilter
+	}
+	if f.Operator != CustomFieldOperatorEqual && (f.Value.Type == CustomFieldTypeText || f.Value.Type == CustomFieldTypeEnum) {
+		return ErrInvalidCustomFieldFilter
+	}
+	if f.Value.Type == CustomFieldTypeNumber && !isFinite(f.Value.Number) {
+		return ErrInvalidCustomFieldFilter
+	}
+	return nil
+}
+
+// isFinite descarta NaN e infinitos, que no tienen representación en JSON ni un orden útil
+func isFinite(number float64) bool {
+	return !math.IsNaN(number) && !math.IsInf(number, 0)
+}
+
+// Matches verifica si fields cumple el filtro; un registro sin el campo no lo cumple
+func (f CustomFieldFilter) Matches(fields CustomFields) bool {
+	value, ok := fields[f.Key]
+	if !ok {
+		return false
+	}
+	cmp, ok := value.Compare(f.Value)
+	if !ok {
+		return false
+	}
+	switch f.Operator {
+	case CustomFieldOperatorLess:
+		return cmp < 0
+	case CustomFieldOperatorLessOrEqual:
+		return cmp <= 0
+	case CustomFieldOperatorGreater:
+		return cmp > 0
+	case CustomFieldOperatorGreaterOrEqual:
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
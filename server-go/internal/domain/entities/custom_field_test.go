@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFields_ValidateAndFilter(t *testing.T) {
+	userID := uuid.New()
+	definitions := []*CustomFieldDefinition{
+		NewCustomFieldDefinition(SystemClock{}, UUIDGenerator{}, userID, CustomFieldEntityIdea, "budget", "Budget", CustomFieldTypeNumber, nil),
+		NewCustomFieldDefinition(SystemClock{}, UUIDGenerator{}, userID, CustomFieldEntityIdea, "stage", "Stage", CustomFieldTypeEnum, []string{"seed", "growth"}),
+	}
+	for _, definition := range definitions {
+		require.NoError(t, definition.Validate())
+	}
+
+	fields := CustomFields{
+		"budget": {Type: CustomFieldTypeNumber, Number: 1500},
+		"stage":  {Type: CustomFieldTypeEnum, Text: "seed"},
+	}
+	require.NoError(t, ValidateCustomFields(fields, definitions))
+	assert.ErrorIs(t, ValidateCustomFields(CustomFields{"stage": {Type: CustomFieldTypeEnum, Text: "exit"}}, definitions), ErrInvalidCustomFieldValue)
+	assert.ErrorIs(t, ValidateCustomFields(CustomFields{"owner": {Type: CustomFieldTypeText, Text: "ana"}}, definitions), ErrUnknownCustomField)
+
+	over := CustomFieldFilter{Key: "budget", Operator: CustomFieldOperatorGreater, Value: CustomFieldValue{Type: CustomFieldTypeNumber, Number: 1000}}
+	require.NoError(t, over.Validate())
+	assert.True(t, over.Matches(fields))
+	assert.False(t, over.Matches(CustomFields{}))
+
+	textRange := CustomFieldFilter{Key: "stage", Operator: CustomFieldOperatorLess, Value: CustomFieldValue{Type: CustomFieldTypeEnum, Text: "seed"}}
+	assert.ErrorIs(t, textRange.Validate(), ErrInvalidCustomFieldFilter)
+}
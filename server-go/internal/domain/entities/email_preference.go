@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailPreference es la dirección a la que se envían las notificaciones del canal "email" de un
+// usuario y los informes que aceptó recibir
+type EmailPreference struct {
+	UserID  uuid.UUID
+	Address string
+	// WeeklySummary indica si el usuario recibe el resumen semanal
+	WeeklySummary bool
+	UpdatedAt     time.Time
+}
+
+// NewEmailPreference valida address y la normaliza a la dirección sin nombre visible
+func NewEmailPreference(clock Clock, userID uuid.UUID, address string, weeklySummary bool) (*EmailPreference, error) {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(address))
+	if err != nil || parsed.Name != "" {
+		return nil, ErrInvalidEmailAddress
+	}
+	return &EmailPreference{
+		UserID:        userID,
+		Address:       strings.ToLower(parsed.Address),
+		WeeklySummary: weeklySummary,
+		UpdatedAt:     clock.Now(),
+	}, nil
+}
@@ -9,25 +9,117 @@ var (
 	ErrIdeaUserIDRequired  = errors.New("idea user ID is required")
 	ErrIdeaNotFound        = errors.New("idea not found")
 	ErrIdeaUnauthorized    = errors.New("unauthorized to access idea")
+	ErrInvalidTag          = errors.New("invalid tag")
+	ErrTooManyIdeasForBulk = errors.New("too many ideas for a bulk operation")
 )
 
 // Domain errors for Reminders
 var (
-	ErrReminderTitleRequired       = errors.New("reminder title is required")
-	ErrReminderUserIDRequired      = errors.New("reminder user ID is required")
+	ErrReminderTitleRequired         = errors.New("reminder title is required")
+	ErrReminderUserIDRequired        = errors.New("reminder user ID is required")
 	ErrReminderScheduledTimeRequired = errors.New("reminder scheduled time is required")
-	ErrReminderNotFound            = errors.New("reminder not found")
-	ErrReminderUnauthorized        = errors.New("unauthorized to access reminder")
+	ErrReminderNotFound              = errors.New("reminder not found")
+	ErrReminderUnauthorized          = errors.New("unauthorized to access reminder")
+	ErrInvalidReminderType           = errors.New("invalid reminder type")
+	ErrInvalidReminderStatus         = errors.New("invalid reminder status")
+	ErrInvalidReminderTransition     = errors.New("invalid reminder status transition")
+	ErrInvalidReminderDateRange      = errors.New("invalid reminder date range")
+	ErrInvalidReminderAssignee       = errors.New("reminder must be assigned to another user")
+	ErrReminderAssignmentNotPending  = errors.New("reminder assignment is not pending")
+	ErrInvalidReminderScope          = errors.New("invalid reminder scope")
+	ErrInvalidEscalationPolicy       = errors.New("invalid reminder escalation policy")
+	ErrEscalationRequiresDeadline    = errors.New("only deadline reminders can escalate")
 )
 
 // Domain errors for Files
 var (
-	ErrFileNameRequired    = errors.New("file name is required")
-	ErrFileUserIDRequired  = errors.New("file user ID is required")
-	ErrFileNotFound        = errors.New("file not found")
-	ErrFileUnauthorized    = errors.New("unauthorized to access file")
-	ErrFileSizeExceeded    = errors.New("file size exceeded maximum allowed")
-	ErrInvalidFileType     = errors.New("invalid file type")
+	ErrFileNameRequired             = errors.New("file name is required")
+	ErrFileUserIDRequired           = errors.New("file user ID is required")
+	ErrFileNotFound                 = errors.New("file not found")
+	ErrFileUnauthorized             = errors.New("unauthorized to access file")
+	ErrFileSizeExceeded             = errors.New("file size exceeded maximum allowed")
+	ErrInvalidFileType              = errors.New("invalid file type")
+	ErrFileChecksumMismatch         = errors.New("file checksum does not match the expected checksum")
+	ErrUnsupportedChecksumAlgorithm = errors.New("unsupported checksum algorithm")
+)
+
+// Domain errors for Share Links
+var (
+	ErrShareLinkFileIDRequired       = errors.New("share link file ID is required")
+	ErrShareLinkInvalidExpiry        = errors.New("share link expiry must be in the future and within the allowed maximum")
+	ErrShareLinkInvalidDownloadLimit = errors.New("share link download limit cannot be negative")
+	ErrShareLinkNotFound             = errors.New("share link not found")
+	ErrShareLinkUnauthorized         = errors.New("unauthorized to access share link")
+	ErrShareLinkExpired              = errors.New("share link expired")
+	ErrShareLinkRevoked              = errors.New("share link revoked")
+	ErrShareLinkPasswordRequired     = errors.New("share link password is required")
+	ErrShareLinkInvalidPassword      = errors.New("invalid share link password")
+	ErrShareLinkDownloadLimitReached = errors.New("share link download limit reached")
+)
+
+// Domain errors for Inbound Addresses
+var (
+	ErrInboundAddressUserIDRequired = errors.New("inbound address user ID is required")
+	ErrInboundAddressNotFound       = errors.New("inbound address not found")
+	ErrInboundAddressUnauthorized   = errors.New("unauthorized to access inbound address")
+	ErrInboundInvalidSender         = errors.New("invalid inbound allowed sender")
+	ErrInboundSenderNotAllowed      = errors.New("sender not allowed for inbound address")
+	ErrInboundSpam                  = errors.New("inbound message rejected as spam")
+	ErrInboundUnsupportedKind       = errors.New("unsupported inbound item kind")
+	ErrInboundTooManyAttachments    = errors.New("too many inbound attachments")
+)
+
+// Domain errors for Chat Bindings
+var (
+	ErrChatBindingUserIDRequired = errors.New("chat binding user ID is required")
+	ErrChatBindingNotFound       = errors.New("chat binding not found")
+	ErrChatBindingUnauthorized   = errors.New("unauthorized to access chat binding")
+	ErrChatBindingCodeExpired    = errors.New("chat binding code expired")
+	ErrChatAlreadyBound          = errors.New("chat is already bound to another user")
+	ErrInvalidChatProvider       = errors.New("invalid chat provider")
+	ErrUnsupportedChatAction     = errors.New("unsupported chat action")
+)
+
+// Domain errors for Locale Preferences
+var (
+	ErrLocalePreferenceNotFound = errors.New("locale preference not found")
+	ErrUnsupportedLocale        = errors.New("unsupported locale")
+)
+
+// Domain errors for Priority Rules
+var (
+	ErrPriorityRuleNameRequired = errors.New("priority rule name is required")
+	ErrPriorityRuleDeltaZero    = errors.New("priority rule delta must not be zero")
+	ErrPriorityRuleNoCondition  = errors.New("priority rule needs an age, idle or deadline condition")
+	ErrInvalidPriorityRule      = errors.New("invalid priority rule")
+)
+
+// Domain errors for the Ideas Board
+var (
+	ErrInvalidBoardColumn   = errors.New("invalid board column")
+	ErrInvalidBoardPosition = errors.New("invalid board position")
+)
+
+// Domain errors for Semantic Search
+var (
+	ErrEmptySearchQuery      = errors.New("search query is empty")
+	ErrIdeaEmbeddingNotFound = errors.New("idea embedding not found")
+)
+
+// Domain errors for Review
+var (
+	ErrIdeaReviewNotFound     = errors.New("idea is not enrolled for review")
+	ErrIdeaAlreadyEnrolled    = errors.New("idea is already enrolled for review")
+	ErrInvalidReviewGrade     = errors.New("review grade must be between 0 and 5")
+	ErrIdeaReviewUnauthorized = errors.New("unauthorized access to idea review")
+)
+
+// Domain errors for Idea Publishing
+var (
+	ErrIdeaPublicationNotFound      = errors.New("idea publication not found")
+	ErrIdeaPublicationUnauthorized  = errors.New("unauthorized to access idea publication")
+	ErrIdeaPublicationExpired       = errors.New("idea publication expired")
+	ErrIdeaPublicationInvalidExpiry = errors.New("idea publication expiry must be in the future")
 )
 
 // Domain errors for Progress
@@ -37,6 +129,75 @@ var (
 	ErrProgressNotFound            = errors.New("progress not found")
 	ErrProgressUnauthorized        = errors.New("unauthorized to access progress")
 	ErrInvalidCompletionPercentage = errors.New("completion percentage must be between 0 and 100")
+	ErrMilestoneNameRequired       = errors.New("milestone name is required")
+	ErrMilestoneNotFound           = errors.New("milestone not found")
+	ErrInvalidProgressFilters      = errors.New("invalid progress completion filters")
+	ErrInvalidEstimatedEffort      = errors.New("milestone estimated effort cannot be negative")
+	ErrNoRunningTimer              = errors.New("no time tracking timer is running")
+	ErrTimerAlreadyRunning         = errors.New("a time tracking timer is already running")
+	ErrInvalidEstimatedDuration    = errors.New("milestone estimated duration cannot be negative")
+	ErrNothingToForecast           = errors.New("no pending milestones with an estimated duration to forecast")
+	ErrInvalidMilestoneWeight      = errors.New("milestone weight cannot be negative")
+)
+
+// Domain errors for Progress Templates
+var (
+	ErrProgressTemplateNotFound     = errors.New("progress template not found")
+	ErrProgressTemplateUnauthorized = errors.New("unauthorized to access progress template")
+	ErrProgressTemplateNameRequired = errors.New("progress template name is required")
+	ErrInvalidTemplateDueOffset     = errors.New("template milestone due offset cannot be negative")
+	ErrTooManyTemplateMilestones    = errors.New("too many milestones in progress template")
+	ErrNoOrganization               = errors.New("user does not belong to an organization")
+)
+
+// Domain errors for Notifications
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+// Domain errors for SMS
+var (
+	ErrPhoneNumberNotFound              = errors.New("phone number not found")
+	ErrInvalidPhoneNumber               = errors.New("invalid phone number")
+	ErrInvalidPhoneVerificationCode     = errors.New("invalid phone verification code")
+	ErrPhoneVerificationCodeExpired     = errors.New("phone verification code expired")
+	ErrPhoneVerificationTooManyAttempts = errors.New("too many phone verification attempts")
+	ErrSMSDailyLimitReached             = errors.New("daily SMS limit reached")
+)
+
+// Domain errors for Custom Fields
+var (
+	ErrCustomFieldNotFound      = errors.New("custom field not found")
+	ErrCustomFieldUnauthorized  = errors.New("unauthorized access to custom field")
+	ErrCustomFieldKeyExists     = errors.New("custom field key already exists")
+	ErrInvalidCustomField       = errors.New("invalid custom field definition")
+	ErrTooManyCustomFields      = errors.New("too many custom fields")
+	ErrUnknownCustomField       = errors.New("unknown custom field")
+	ErrInvalidCustomFieldValue  = errors.New("invalid custom field value")
+	ErrInvalidCustomFieldFilter = errors.New("invalid custom field filter")
+)
+
+// Domain errors for Statistics
+var (
+	ErrStatisticsNotFound = errors.New("statistics not found")
+)
+
+// Domain errors for Telemetry
+var (
+	ErrInvalidClientMetric       = errors.New("invalid client metric")
+	ErrClientMetricBatchTooLarge = errors.New("client metric batch too large")
+)
+
+// Domain errors for Email and Weekly Summaries
+var (
+	ErrEmailPreferenceNotFound = errors.New("email preference not found")
+	ErrInvalidEmailAddress     = errors.New("invalid email address")
+	ErrWeeklySummaryNotFound   = errors.New("weekly summary not found")
+)
+
+// Domain errors for Content Moderation
+var (
+	ErrContentRejected = errors.New("content rejected by the content policy")
 )
 
 // General domain errors
@@ -44,4 +205,8 @@ var (
 	ErrInvalidUUID        = errors.New("invalid UUID format")
 	ErrInvalidPagination  = errors.New("invalid pagination parameters")
 	ErrInvalidSortField   = errors.New("invalid sort field")
-)
\ No newline at end of file
+	ErrVersionConflict    = errors.New("entity version conflict")
+	ErrInvalidUpdateMask  = errors.New("invalid update mask path")
+	ErrServiceUnavailable = errors.New("service temporarily unavailable")
+	ErrLockNotAcquired    = errors.New("lock held by another instance")
+)
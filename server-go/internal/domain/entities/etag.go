@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// entityETag deriva la ETag de una entidad de su ID, su versión y su última modificación, de modo
+// que cambia con cada escritura. Es débil: la misma ETag indica una representación equivalente,
+// no idéntica byte a byte (por ejemplo, con otro idioma en los mensajes).
+func entityETag(id uuid.UUID, version int64, updatedAt time.Time) string {
+	hash := sha256.New()
+	hash.Write(id[:])
+	fmt.Fprintf(hash, "%d:%d", version, updatedAt.UnixNano())
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:8]) + `"`
+}
+
+// ETag devuelve la ETag de la idea
+func (i *Idea) ETag() string {
+	return entityETag(i.ID, i.Version, i.UpdatedAt)
+}
+
+// ETagMatches verifica si ifNoneMatch, una lista de ETags separadas por comas o "*", incluye etag.
+// La comparación es débil: se ignora el prefijo W/.
+func ETagMatches(ifNoneMatch, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || (candidate != "" && strings.TrimPrefix(candidate, "W/") == etag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdea_ETag(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	idea := NewIdea(clock, &SequentialIDGenerator{}, "Title", "Content", IdeaCategoryBusiness, uuid.New(), nil, 1)
+
+	etag := idea.ETag()
+	assert.True(t, strings.HasPrefix(etag, `W/"`))
+	assert.Equal(t, etag, idea.ETag())
+	assert.True(t, ETagMatches(etag, etag))
+	assert.True(t, ETagMatches(`"other", `+strings.TrimPrefix(etag, "W/"), etag))
+	assert.True(t, ETagMatches("*", etag))
+	assert.False(t, ETagMatches(`W/"other"`, etag))
+
+	idea.Update("Updated", "Content", nil, IdeaCategoryBusiness, IdeaStatusDraft, 1, clock.Now().Add(time.Minute))
+	assert.NotEqual(t, etag, idea.ETag())
+	assert.False(t, ETagMatches(etag, idea.ETag()))
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventSchemaVersion es la versión del formato de los eventos de dominio; se incrementa cuando
+// un evento cambia de forma incompatible para que los consumidores puedan distinguirlos
+const EventSchemaVersion = 1
+
+// EventHeader contiene los metadatos comunes a todos los eventos de dominio. Los eventos la
+// incrustan para que los consumidores y el registro de auditoría puedan reconstruir quién
+// provocó cada cambio y qué cadena de eventos lo originó.
+type EventHeader struct {
+	EventID       uuid.UUID
+	ActorID       uuid.UUID // usuario que originó el evento; uuid.Nil en tareas del sistema
+	RequestID     string
+	CorrelationID string // compartido por todos los eventos originados por la misma petición
+	CausationID   string // ID del evento o petición que provocó directamente este evento
+	OccurredAt    time.Time
+	SchemaVersion int
+}
+
+// Header devuelve la cabecera del evento; al incrustar EventHeader, los eventos implementan Event
+func (h EventHeader) Header() EventHeader {
+	return h
+}
+
+// Event es cualquier evento de dominio con cabecera
+type Event interface {
+	Header() EventHeader
+}
+
+// EventContext contiene los metadatos de la petición en curso con los que se rellenan
+// las cabeceras de los eventos que publica
+type EventContext struct {
+	ActorID       uuid.UUID
+	RequestID     string
+	CorrelationID string
+	CausationID   string
+}
+
+type eventContextKey struct{}
+
+// EventContextFromContext obtiene los metadatos de eventos guardados en ctx
+func EventContextFromContext(ctx context.Context) EventContext {
+	eventCtx, _ := ctx.Value(eventContextKey{}).(EventContext)
+	return eventCtx
+}
+
+// ContextWithEventContext reemplaza los metadatos de eventos de ctx, por ejemplo al restaurarlos
+// de un mensaje encolado
+func ContextWithEventContext(ctx context.Context, eventCtx EventContext) context.Context {
+	return context.WithValue(ctx, eventContextKey{}, eventCtx)
+}
+
+// ContextWithEventActor registra en ctx al usuario autenticado que realiza la petición
+func ContextWithEventActor(ctx context.Context, actorID uuid.UUID) context.Context {
+	eventCtx := EventContextFromContext(ctx)
+	eventCtx.ActorID = actorID
+	return ContextWithEventContext(ctx, eventCtx)
+}
+
+// ContextWithEventRequest registra en ctx la petición en curso. Si correlationID está vacío la
+// petición inicia una nueva cadena y se usa requestID; la petición es la causa de sus eventos.
+func ContextWithEventRequest(ctx context.Context, requestID, correlationID string) context.Context {
+	if correlationID == "" {
+		correlationID = requestID
+	}
+	eventCtx := EventContextFromContext(ctx)
+	eventCtx.RequestID = requestID
+	eventCtx.CorrelationID = correlationID
+	eventCtx.CausationID = requestID
+	return ContextWithEventContext(ctx, eventCtx)
+}
+
+// ContextWithEventCause registra en ctx que el trabajo en curso es consecuencia de cause: los
+// eventos que se publiquen heredan su petición, su correlación y, si ctx no lo tiene, su actor
+func ContextWithEventCause(ctx context.Context, cause EventHeader) context.Context {
+	eventCtx := EventContextFromContext(ctx)
+	if eventCtx.ActorID == uuid.Nil {
+		eventCtx.ActorID = cause.ActorID
+	}
+	if cause.RequestID != "" {
+		eventCtx.RequestID = cause.RequestID
+	}
+	if cause.CorrelationID != "" {
+		eventCtx.CorrelationID = cause.CorrelationID
+	}
+	eventCtx.CausationID = cause.EventID.String()
+	return ContextWithEventContext(ctx, eventCtx)
+}
@@ -1,41 +1,92 @@
 package entities
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// FileInfo representa la información de un archivo en el dominio
+// ChecksumAlgorithmSHA256 es el algoritmo con el que se calcula el checksum de los archivos subidos;
+// los archivos anteriores a la validación de integridad tienen ChecksumAlgorithm vacío
+const ChecksumAlgorithmSHA256 = "sha256"
+
+// FileInfo representa una versión de un archivo en el dominio; las versiones
+// del mismo archivo comparten LogicalID y se numeran desde 1
 type FileInfo struct {
-	ID              uuid.UUID
-	Filename        string
-	ContentType     string
-	Size            int64
-	Checksum        string
-	CreatedAt       time.Time
-	UserID          uuid.UUID
-	Compressed      bool
-	CompressionType string
-	Path            string
+	ID                uuid.UUID
+	Filename          string
+	ContentType       string
+	Size              int64
+	Checksum          string
+	ChecksumAlgorithm string
+	CreatedAt         time.Time
+	UserID            uuid.UUID
+	Compressed        bool
+	CompressionType   string
+	Path              string
+	LogicalID         uuid.UUID
+	Version           int64
+	Preview           *FilePreview
+	StorageTier       StorageTier
 }
 
 // NewFileInfo crea una nueva información de archivo
-func NewFileInfo(filename, contentType, checksum, path string, size int64, userID uuid.UUID, compressed bool, compressionType string) *FileInfo {
+func NewFileInfo(clock Clock, ids IDGenerator, filename, contentType, checksum, path string, size int64, userID uuid.UUID, compressed bool, compressionType string) *FileInfo {
+	id := ids.NewID()
 	return &FileInfo{
-		ID:              uuid.New(),
+		ID:              id,
 		Filename:        filename,
 		ContentType:     contentType,
 		Size:            size,
 		Checksum:        checksum,
-		CreatedAt:       time.Now(),
+		CreatedAt:       clock.Now(),
 		UserID:          userID,
 		Compressed:      compressed,
 		CompressionType: compressionType,
 		Path:            path,
+		LogicalID:       id,
+		Version:         1,
+		StorageTier:     StorageTierHot,
 	}
 }
 
+// FollowVersion convierte el archivo en la versión siguiente a latest
+func (f *FileInfo) FollowVersion(latest *FileInfo) {
+	f.LogicalID = latest.LogicalID
+	f.Version = latest.Version + 1
+}
+
+// NewRestoredVersion crea una nueva versión con el contenido de version, posterior a latest.
+// Comparte la ruta física con la versión restaurada en lugar de copiar el archivo.
+func NewRestoredVersion(clock Clock, ids IDGenerator, version, latest *FileInfo) *FileInfo {
+	restored := *version
+	restored.ID = ids.NewID()
+	restored.CreatedAt = clock.Now()
+	restored.FollowVersion(latest)
+	return &restored
+}
+
+// NewDeduplicatedFile crea la información de un archivo cuyo contenido ya está almacenado en
+// existing; comparte su ruta física, su checksum y sus metadatos de vista previa
+func NewDeduplicatedFile(clock Clock, ids IDGenerator, filename, contentType string, userID uuid.UUID, existing *FileInfo) *FileInfo {
+	fileInfo := NewFileInfo(clock, ids, filename, contentType, existing.Checksum, existing.Path, existing.Size, userID, existing.Compressed, existing.CompressionType)
+	fileInfo.ChecksumAlgorithm = existing.ChecksumAlgorithm
+	fileInfo.Preview = existing.Preview
+	fileInfo.StorageTier = existing.StorageTier
+	return fileInfo
+}
+
+// MatchesChecksum verifica si expected (hexadecimal, sin distinguir mayúsculas) coincide con el checksum del archivo
+func (f *FileInfo) MatchesChecksum(expected string) bool {
+	return ChecksumEquals(expected, f.Checksum)
+}
+
+// ChecksumEquals compara dos checksums hexadecimales sin distinguir mayúsculas
+func ChecksumEquals(expected, actual string) bool {
+	return strings.EqualFold(strings.TrimSpace(expected), actual)
+}
+
 // IsOwnedBy verifica si el archivo pertenece al usuario especificado
 func (f *FileInfo) IsOwnedBy(userID uuid.UUID) bool {
 	return f.UserID == userID
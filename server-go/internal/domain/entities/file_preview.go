@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// FilePreview contiene los metadatos que permiten a los clientes mostrar una vista previa
+// sin descargar el archivo; los campos que no aplican al tipo de archivo quedan en cero
+type FilePreview struct {
+	Width     int32
+	Height    int32
+	PageCount int32
+	Duration  time.Duration
+	// EXIF contiene etiquetas descriptivas de la cámara (Make, Model, Orientation, DateTimeOriginal, ...)
+	EXIF map[string]string
+	// GPSStripped indica que la ubicación GPS se borró del archivo antes de almacenarlo
+	GPSStripped bool
+}
+
+// IsEmpty indica si no se encontró ningún metadato
+func (p *FilePreview) IsEmpty() bool {
+	return p.Width == 0 && p.Height == 0 && p.PageCount == 0 && p.Duration == 0 && len(p.EXIF) == 0 && !p.GPSStripped
+}
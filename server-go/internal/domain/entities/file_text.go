@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxExtractedTextLength es el máximo de bytes de texto que se guardan por archivo,
+// para que un documento enorme no desborde el índice de búsqueda
+const MaxExtractedTextLength = 1 << 20
+
+// FileText representa el texto extraído del contenido de un archivo (OCR o capa de texto),
+// indexado para que la búsqueda de archivos encuentre su contenido
+type FileText struct {
+	FileID      uuid.UUID
+	UserID      uuid.UUID
+	Content     string
+	Extractor   string
+	ExtractedAt time.Time
+}
+
+// NewFileText crea el texto extraído de fileInfo, recortado a MaxExtractedTextLength.
+// Se descartan los bytes que no son UTF-8 válido y los caracteres nulos, que las bases de datos rechazan.
+func NewFileText(clock Clock, fileInfo *FileInfo, content, extractor string) *FileText {
+	content = strings.ReplaceAll(strings.ToValidUTF8(content, ""), "\x00", "")
+	return &FileText{
+		FileID:      fileInfo.ID,
+		UserID:      fileInfo.UserID,
+		Content:     truncateText(strings.TrimSpace(content), MaxExtractedTextLength),
+		Extractor:   extractor,
+		ExtractedAt: clock.Now(),
+	}
+}
+
+// SupportsTextExtraction indica si el tipo de archivo puede contener texto extraíble
+func (f *FileInfo) SupportsTextExtraction() bool {
+	return f.IsImage() || f.IsDocument()
+}
+
+// truncateText recorta text a como máximo limit bytes sin partir un carácter UTF-8
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+	return text[:limit]
+}
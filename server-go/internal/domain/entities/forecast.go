@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// ForecastRuns es el número de simulaciones de una previsión
+const ForecastRuns = 10000
+
+// ProgressForecast compara la fecha de fin que resulta de las estimaciones de los hitos pendientes
+// con la que se obtiene al simularlos según lo que tardaron de verdad los hitos ya completados
+type ProgressForecast struct {
+	// Estimated es el fin si cada hito pendiente tarda exactamente lo estimado
+	Estimated time.Time
+	// P50, P85 y P95 son los percentiles de la fecha de fin simulada
+	P50 time.Time
+	P85 time.Time
+	P95 time.Time
+	// Samples es el número de hitos completados usados como histórico; sin histórico la simulación
+	// coincide con la estimación
+	Samples int
+	// Unestimated son los hitos pendientes sin duración estimada, que no cuentan en la previsión
+	Unestimated int
+	Runs        int
+}
+
+// MilestoneDurationRatios devuelve la razón entre lo que tardó cada hito completado con duración
+// estimada y lo estimado. Un hito empieza cuando se completa el anterior del mismo proyecto o,
+// si es el primero, cuando se crea el proyecto.
+func MilestoneDurationRatios(history []*Progress) []float64 {
+	var ratios []float64
+	for _, progress := range history {
+		var completed []ProgressMilestone
+		for _, milestone := range progress.GetCompletedMilestones() {
+			if milestone.CompletedAt != nil {
+				completed = append(completed, milestone)
+			}
+		}
+		sort.Slice(completed, func(i, j int) bool { return completed[i].CompletedAt.Before(*completed[j].CompletedAt) })
+
+		start := progress.CreatedAt
+		for _, milestone := range completed {
+			actual := milestone.CompletedAt.Sub(start)
+			start = *milestone.CompletedAt
+			if milestone.EstimatedDuration > 0 && actual > 0 {
+				ratios = append(ratios, float64(actual)/float64(milestone.EstimatedDuration))
+			}
+		}
+	}
+	return ratios
+}
+
+// NewProgressForecast simula runs veces los hitos pendientes de progress, uno detrás de otro
+// desde now, multiplicando la estimación de cada uno por una razón tomada al azar de ratios.
+// Devuelve ErrNothingToForecast si ningún hito pendiente tiene duración estimada.
+func NewProgressForecast(progress *Progress, ratios []float64, now time.Time, rng *rand.Rand, runs int) (*ProgressForecast, error) {
+	if runs < 1 {
+		runs = 1
+	}
+	forecast := &ProgressForecast{Samples: len(ratios), Runs: runs}
+	var estimates []time.Duration
+	var estimated time.Duration
+	for _, milestone := range progress.GetPendingMilestones() {
+		if milestone.EstimatedDuration == 0 {
+			forecast.Unestimated++
+			continue
+		}
+		estimates = append(estimates, milestone.EstimatedDuration)
+		estimated += milestone.EstimatedDuration
+	}
+	if len(estimates) == 0 {
+		return nil, ErrNothingToForecast
+	}
+	forecast.Estimated = now.Add(estimated)
+
+	totals := make([]time.Duration, runs)
+	for run := range totals {
+		for _, estimate := range estimates {
+			ratio := 1.0
+			if len(ratios) > 0 {
+				ratio = ratios[rng.Intn(len(ratios))]
+			}
+			totals[run] += time.Duration(float64(estimate) * ratio)
+		}
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i] < totals[j] })
+
+	forecast.P50 = now.Add(percentile(totals, 0.50))
+	forecast.P85 = now.Add(percentile(totals, 0.85))
+	forecast.P95 = now.Add(percentile(totals, 0.95))
+	return forecast, nil
+}
+
+// percentile devuelve el menor valor de sorted que cubre la fracción p de la muestra
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	index := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
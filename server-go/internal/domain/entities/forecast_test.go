@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressForecast(t *testing.T) {
+	// Arrange
+	const day = 24 * time.Hour
+	ids := &SequentialIDGenerator{}
+	clock := NewFakeClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	userID := uuid.New()
+
+	// Los hitos completados tardaron el doble de lo estimado
+	done := NewProgress(clock, ids, userID, "Anterior", "")
+	for i := 0; i < 2; i++ {
+		milestone := NewMilestone(ids, "Hecho", "", clock.Now())
+		milestone.EstimatedDuration = 2 * day
+		done.AddMilestone(milestone, clock.Now())
+	}
+	clock.Advance(4 * day)
+	done.CompleteMilestone(done.Milestones[0].ID, clock.Now())
+	clock.Advance(4 * day)
+	done.CompleteMilestone(done.Milestones[1].ID, clock.Now())
+
+	progress := NewProgress(clock, ids, userID, "Actual", "")
+	pending := NewMilestone(ids, "Pendiente", "", clock.Now())
+	pending.EstimatedDuration = 3 * day
+	progress.AddMilestone(pending, clock.Now())
+	progress.AddMilestone(NewMilestone(ids, "Sin estimar", "", clock.Now()), clock.Now())
+
+	// Act
+	ratios := MilestoneDurationRatios([]*Progress{done, progress})
+	forecast, err := NewProgressForecast(progress, ratios, clock.Now(), rand.New(rand.NewSource(1)), 100)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2, 2}, ratios)
+	assert.Equal(t, 2, forecast.Samples)
+	assert.Equal(t, 1, forecast.Unestimated)
+	assert.Equal(t, clock.Now().Add(3*day), forecast.Estimated)
+	assert.Equal(t, clock.Now().Add(6*day), forecast.P50)
+	assert.Equal(t, clock.Now().Add(6*day), forecast.P95)
+}
+
+func TestProgressForecastNothingToForecast(t *testing.T) {
+	ids := &SequentialIDGenerator{}
+	clock := NewFakeClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	progress := NewProgress(clock, ids, uuid.New(), "Actual", "")
+	progress.AddMilestone(NewMilestone(ids, "Sin estimar", "", clock.Now()), clock.Now())
+
+	_, err := NewProgressForecast(progress, nil, clock.Now(), rand.New(rand.NewSource(1)), 100)
+
+	assert.Equal(t, ErrNothingToForecast, err)
+}
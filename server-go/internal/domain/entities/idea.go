@@ -43,13 +43,40 @@ type Idea struct {
 	UserID       uuid.UUID
 	RelatedIdeas []uuid.UUID
 	Priority     int32
+	// Position ordena la idea dentro de su columna del tablero (ver PositionBetween); las ideas
+	// sin posición van primero
+	Position string
+	// CustomFields son los valores de los campos que el usuario definió para sus ideas
+	CustomFields CustomFields
+	Version      int64
+	// CompactedAt no es nil si el contenido de la idea archivada se movió al archivo comprimido;
+	// mientras tanto Content solo tiene un extracto (ver IdeaExcerpt)
+	CompactedAt *time.Time
+}
+
+// IdeaExcerptLength es el máximo de caracteres del contenido que conserva una idea compactada
+const IdeaExcerptLength = 280
+
+// IdeaExcerpt devuelve el comienzo de content que se conserva en la fila de una idea compactada
+// para los listados y la búsqueda
+func IdeaExcerpt(content string) string {
+	runes := []rune(content)
+	if len(runes) <= IdeaExcerptLength {
+		return content
+	}
+	return string(runes[:IdeaExcerptLength])
+}
+
+// IsCompacted verifica si el contenido completo de la idea está en el archivo comprimido
+func (i *Idea) IsCompacted() bool {
+	return i.CompactedAt != nil
 }
 
 // NewIdea crea una nueva idea con valores por defecto
-func NewIdea(title, content string, category IdeaCategory, userID uuid.UUID, tags []string, priority int32) *Idea {
-	now := time.Now()
+func NewIdea(clock Clock, ids IDGenerator, title, content string, category IdeaCategory, userID uuid.UUID, tags []string, priority int32) *Idea {
+	now := clock.Now()
 	return &Idea{
-		ID:           uuid.New(),
+		ID:           ids.NewID(),
 		Title:        title,
 		Content:      content,
 		Tags:         tags,
@@ -60,11 +87,12 @@ func NewIdea(title, content string, category IdeaCategory, userID uuid.UUID, tag
 		UserID:       userID,
 		RelatedIdeas: make([]uuid.UUID, 0),
 		Priority:     priority,
+		Version:      1,
 	}
 }
 
 // Update actualiza los campos modificables de la idea
-func (i *Idea) Update(title, content string, tags []string, category IdeaCategory, status IdeaStatus, priority int32) {
+func (i *Idea) Update(title, content string, tags []string, category IdeaCategory, status IdeaStatus, priority int32, now time.Time) {
 	if title != "" {
 		i.Title = title
 	}
@@ -83,31 +111,128 @@ func (i *Idea) Update(title, content string, tags []string, category IdeaCategor
 	if priority >= 0 {
 		i.Priority = priority
 	}
-	i.UpdatedAt = time.Now()
+	i.UpdatedAt = now
+}
+
+// UpdateFields actualiza únicamente los campos indicados en paths (nombres de campo del proto).
+// A diferencia de Update, un valor vacío en un campo incluido en la máscara limpia ese campo.
+func (i *Idea) UpdateFields(paths []string, title, content string, tags []string, category IdeaCategory, status IdeaStatus, priority int32, now time.Time) error {
+	for _, path := range paths {
+		switch path {
+		case "title", "content", "tags", "category", "status", "priority":
+		default:
+			return ErrInvalidUpdateMask
+		}
+	}
+	
+	for _, path := range paths {
+		switch path {
+		case "title":
+			i.Title = title
+		case "content":
+			i.Content = content
+		case "tags":
+			if tags == nil {
+				tags = []string{}
+			}
+			i.Tags = tags
+		case "category":
+			i.Category = category
+		case "status":
+			i.Status = status
+		case "priority":
+			i.Priority = priority
+		}
+	}
+	i.UpdatedAt = now
+	return nil
+}
+
+// HasTag verifica si la idea tiene la etiqueta
+func (i *Idea) HasTag(tag string) bool {
+	for _, existing := range i.Tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag añade la etiqueta si la idea no la tiene; devuelve si la añadió
+func (i *Idea) AddTag(tag string, now time.Time) bool {
+	if i.HasTag(tag) {
+		return false
+	}
+	i.Tags = append(i.Tags, tag)
+	i.UpdatedAt = now
+	return true
+}
+
+// RemoveTag quita la etiqueta si la idea la tiene; devuelve si la quitó
+func (i *Idea) RemoveTag(tag string, now time.Time) bool {
+	for idx, existing := range i.Tags {
+		if existing == tag {
+			i.Tags = append(i.Tags[:idx], i.Tags[idx+1:]...)
+			i.UpdatedAt = now
+			return true
+		}
+	}
+	return false
+}
+
+// SetPriority cambia la prioridad de la idea; lo usan las reglas de envejecimiento, que no
+// modifican ningún otro campo
+func (i *Idea) SetPriority(priority int32, now time.Time) {
+	i.Priority = priority
+	i.UpdatedAt = now
+}
+
+// SetCustomFields reemplaza los valores de los campos personalizados; se validan contra las
+// definiciones del usuario con ValidateCustomFields
+func (i *Idea) SetCustomFields(fields CustomFields, now time.Time) {
+	i.CustomFields = fields
+	i.UpdatedAt = now
+}
+
+// MoveTo cambia el estado de la idea y su posición en la columna del tablero
+func (i *Idea) MoveTo(status IdeaStatus, position string, now time.Time) {
+	i.Status = status
+	i.Position = position
+	i.UpdatedAt = now
+}
+
+// IsOpen verifica si la idea sigue en curso (borrador, activa o en pausa)
+func (i *Idea) IsOpen() bool {
+	return i.Status == IdeaStatusDraft || i.Status == IdeaStatusActive || i.Status == IdeaStatusOnHold
 }
 
 // AddRelatedIdea añade una idea relacionada
-func (i *Idea) AddRelatedIdea(ideaID uuid.UUID) {
+func (i *Idea) AddRelatedIdea(ideaID uuid.UUID, now time.Time) {
 	for _, id := range i.RelatedIdeas {
 		if id == ideaID {
 			return // Ya existe
 		}
 	}
 	i.RelatedIdeas = append(i.RelatedIdeas, ideaID)
-	i.UpdatedAt = time.Now()
+	i.UpdatedAt = now
 }
 
 // RemoveRelatedIdea elimina una idea relacionada
-func (i *Idea) RemoveRelatedIdea(ideaID uuid.UUID) {
+func (i *Idea) RemoveRelatedIdea(ideaID uuid.UUID, now time.Time) {
 	for idx, id := range i.RelatedIdeas {
 		if id == ideaID {
 			i.RelatedIdeas = append(i.RelatedIdeas[:idx], i.RelatedIdeas[idx+1:]...)
-			i.UpdatedAt = time.Now()
+			i.UpdatedAt = now
 			return
 		}
 	}
 }
 
+// HasVersion verifica si la versión esperada coincide con la actual (0 omite la verificación)
+func (i *Idea) HasVersion(expected int64) bool {
+	return expected == 0 || i.Version == expected
+}
+
 // IsOwnedBy verifica si la idea pertenece al usuario especificado
 func (i *Idea) IsOwnedBy(userID uuid.UUID) bool {
 	return i.UserID == userID
@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxEmbeddingTextLength es el máximo de bytes de una idea que se envían al modelo de vectores;
+// el resto del contenido no influye en la búsqueda semántica
+const MaxEmbeddingTextLength = 16 << 10
+
+// IdeaEmbedding representa el vector de contenido de una idea calculado por un modelo de
+// vectores, usado para encontrar ideas parecidas a una consulta
+type IdeaEmbedding struct {
+	IdeaID uuid.UUID
+	UserID uuid.UUID
+	Model  string
+	Vector []float32
+	// ContentHash identifica el texto del que se calculó el vector, para no recalcularlo si no cambia
+	ContentHash string
+	EmbeddedAt  time.Time
+}
+
+// NewIdeaEmbedding crea el vector de idea calculado por model
+func NewIdeaEmbedding(clock Clock, idea *Idea, model string, vector []float32) *IdeaEmbedding {
+	return &IdeaEmbedding{
+		IdeaID:      idea.ID,
+		UserID:      idea.UserID,
+		Model:       model,
+		Vector:      vector,
+		ContentHash: EmbeddingContentHash(EmbeddingText(idea)),
+		EmbeddedAt:  clock.Now(),
+	}
+}
+
+// IsCurrent indica si el vector se calculó con model a partir del texto actual de la idea
+func (e *IdeaEmbedding) IsCurrent(idea *Idea, model string) bool {
+	return e.Model == model && e.ContentHash == EmbeddingContentHash(EmbeddingText(idea))
+}
+
+// EmbeddingText devuelve el texto de la idea que se envía al modelo: título, contenido y etiquetas
+func EmbeddingText(idea *Idea) string {
+	parts := []string{strings.TrimSpace(idea.Title), strings.TrimSpace(idea.Content)}
+	if len(idea.Tags) > 0 {
+		parts = append(parts, strings.Join(idea.Tags, ", "))
+	}
+	return truncateText(strings.Join(parts, "\n\n"), MaxEmbeddingTextLength)
+}
+
+func EmbeddingContentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// CosineSimilarity devuelve la similitud coseno de dos vectores, entre -1 y 1; los vectores de
+// distinta dimensión o nulos tienen similitud 0
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
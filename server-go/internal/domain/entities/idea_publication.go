@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// publicationSlugBytes es la entropía del sufijo aleatorio del slug: sin él, cualquiera podría
+	// adivinar la dirección de una idea publicada a partir de su título
+	publicationSlugBytes = 5
+	// maxSlugTitleLength es el máximo de caracteres del título que se incluyen en el slug
+	maxSlugTitleLength = 48
+)
+
+var slugEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// slugAccents quita los acentos más comunes en español, portugués y francés
+var slugAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// IdeaPublication es la publicación de una idea en modo solo lectura en una dirección pública,
+// sin autenticación. Deja de verse al despublicarla o al vencer ExpiresAt, si tiene.
+type IdeaPublication struct {
+	ID            uuid.UUID
+	IdeaID        uuid.UUID
+	UserID        uuid.UUID
+	Slug          string
+	ExpiresAt     *time.Time
+	ViewCount     int64
+	LastViewedAt  *time.Time
+	CreatedAt     time.Time
+	UnpublishedAt *time.Time
+}
+
+// NewIdeaPublication publica idea con un slug derivado de su título; expiresAt nil no caduca
+func NewIdeaPublication(clock Clock, ids IDGenerator, idea *Idea, expiresAt *time.Time) (*IdeaPublication, error) {
+	raw := make([]byte, publicationSlugBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate publication slug: %w", err)
+	}
+	slug := slugEncoding.EncodeToString(raw)
+	if title := Slugify(idea.Title); title != "" {
+		slug = title + "-" + slug
+	}
+
+	return &IdeaPublication{
+		ID:        ids.NewID(),
+		IdeaID:    idea.ID,
+		UserID:    idea.UserID,
+		Slug:      slug,
+		ExpiresAt: expiresAt,
+		CreatedAt: clock.Now(),
+	}, nil
+}
+
+// Slugify escribe text en minúsculas, sin acentos y con guiones en lugar de espacios y signos
+func Slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range slugAccents.Replace(strings.ToLower(text)) {
+		if r >= unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			dash = true
+			continue
+		}
+		if dash && b.Len() > 0 {
+			if b.Len()+2 > maxSlugTitleLength {
+				break
+			}
+			b.WriteByte('-')
+		}
+		if b.Len() >= maxSlugTitleLength {
+			break
+		}
+		dash = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// IsOwnedBy verifica si la publicación pertenece al usuario especificado
+func (p *IdeaPublication) IsOwnedBy(userID uuid.UUID) bool {
+	return p.UserID == userID
+}
+
+// IsUnpublished indica si el usuario retiró la publicación
+func (p *IdeaPublication) IsUnpublished() bool {
+	return p.UnpublishedAt != nil
+}
+
+// IsExpired indica si la publicación ya caducó en el instante now
+func (p *IdeaPublication) IsExpired(now time.Time) bool {
+	return p.ExpiresAt != nil && !now.Before(*p.ExpiresAt)
+}
+
+// IsVisible indica si la publicación se puede ver en el instante now
+func (p *IdeaPublication) IsVisible(now time.Time) bool {
+	return !p.IsUnpublished() && !p.IsExpired(now)
+}
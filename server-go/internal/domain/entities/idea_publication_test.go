@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "cancion-para-el-ano-nuevo", Slugify("  Canción para el Año Nuevo!  "))
+	assert.Equal(t, "api-v2-rate-limits", Slugify("API v2: rate-limits"))
+	assert.Equal(t, "", Slugify("日本語"))
+	assert.LessOrEqual(t, len(Slugify(strings.Repeat("palabra ", 20))), maxSlugTitleLength)
+}
@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewGrade es la calidad del recuerdo de una idea al repasarla, de 0 (no la recordaba) a 5
+// (la recordaba perfectamente), como en SM-2
+type ReviewGrade int
+
+const (
+	ReviewGradeBlackout  ReviewGrade = 0
+	ReviewGradeWrong     ReviewGrade = 1
+	ReviewGradeHard      ReviewGrade = 2
+	ReviewGradeDifficult ReviewGrade = 3
+	ReviewGradeGood      ReviewGrade = 4
+	ReviewGradePerfect   ReviewGrade = 5
+)
+
+// IsValid verifica si la calificación está entre 0 y 5
+func (g ReviewGrade) IsValid() bool {
+	return g >= ReviewGradeBlackout && g <= ReviewGradePerfect
+}
+
+// IsPassing indica si la idea se recordó lo suficiente para alargar el intervalo
+func (g ReviewGrade) IsPassing() bool {
+	return g >= ReviewGradeDifficult
+}
+
+const (
+	// DefaultEaseFactor es el factor de facilidad con el que empieza toda idea inscrita
+	DefaultEaseFactor = 2.5
+	// MinEaseFactor evita que las ideas difíciles vuelvan a aparecer casi a diario para siempre
+	MinEaseFactor = 1.3
+)
+
+// IdeaReview es la inscripción de una idea en el repaso espaciado: la idea vuelve a aparecer en
+// la cola de repaso a intervalos que crecen cada vez que el usuario la recuerda bien
+type IdeaReview struct {
+	IdeaID         uuid.UUID
+	UserID         uuid.UUID
+	EaseFactor     float64
+	IntervalDays   int
+	Repetitions    int // repasos aprobados seguidos
+	DueAt          time.Time
+	LastReviewedAt *time.Time
+	EnrolledAt     time.Time
+	Version        int64
+}
+
+// NewIdeaReview inscribe una idea en el repaso; aparece en la cola desde el momento de inscribirla
+func NewIdeaReview(clock Clock, idea *Idea) *IdeaReview {
+	now := clock.Now()
+	return &IdeaReview{
+		IdeaID:     idea.ID,
+		UserID:     idea.UserID,
+		EaseFactor: DefaultEaseFactor,
+		DueAt:      now,
+		EnrolledAt: now,
+		Version:    1,
+	}
+}
+
+// IsDue indica si la idea toca repasarla antes de until
+func (r *IdeaReview) IsDue(until time.Time) bool {
+	return r.DueAt.Before(until)
+}
+
+// Review registra un repaso con el algoritmo SM-2: un repaso aprobado alarga el intervalo (1 día,
+// 6 días y luego el anterior por el factor de facilidad) y uno fallido lo reinicia a 1 día. El
+// factor baja con las calificaciones bajas y nunca queda por debajo de MinEaseFactor.
+func (r *IdeaReview) Review(grade ReviewGrade, now time.Time) error {
+	if !grade.IsValid() {
+		return ErrInvalidReviewGrade
+	}
+
+	if grade.IsPassing() {
+		switch r.Repetitions {
+		case 0:
+			r.IntervalDays = 1
+		case 1:
+			r.IntervalDays = 6
+		default:
+			r.IntervalDays = int(math.Round(float64(r.IntervalDays) * r.EaseFactor))
+		}
+		r.Repetitions++
+	} else {
+		r.Repetitions = 0
+		r.IntervalDays = 1
+	}
+
+	q := float64(ReviewGradePerfect - grade)
+	r.EaseFactor = max(MinEaseFactor, r.EaseFactor+0.1-q*(0.08+q*0.02))
+	r.DueAt = now.AddDate(0, 0, r.IntervalDays)
+	r.LastReviewedAt = &now
+	return nil
+}
+
+// IsOwnedBy verifica si la inscripción pertenece al usuario
+func (r *IdeaReview) IsOwnedBy(userID uuid.UUID) bool {
+	return r.UserID == userID
+}
+
+// EndOfDay devuelve el comienzo del día siguiente a t en loc: lo que vence antes toca repasarlo hoy
+func EndOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	year, month, day := t.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdeaReview_Review(t *testing.T) {
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Title", "Content", IdeaCategoryPersonal, uuid.New(), nil, 1)
+	review := NewIdeaReview(NewFakeClock(now), idea)
+	assert.True(t, review.IsDue(EndOfDay(now, time.UTC)))
+
+	// Los repasos aprobados alargan el intervalo: 1, 6 y luego por el factor de facilidad
+	for _, expected := range []int{1, 6, 15} {
+		assert.NoError(t, review.Review(ReviewGradeGood, now))
+		assert.Equal(t, expected, review.IntervalDays)
+	}
+	assert.Equal(t, now.AddDate(0, 0, 15), review.DueAt)
+	assert.InDelta(t, DefaultEaseFactor, review.EaseFactor, 1e-9)
+
+	// Un repaso fallido reinicia el intervalo y baja el factor sin pasar del mínimo
+	assert.NoError(t, review.Review(ReviewGradeBlackout, now))
+	assert.Equal(t, 1, review.IntervalDays)
+	assert.Equal(t, 0, review.Repetitions)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, review.Review(ReviewGradeBlackout, now))
+	}
+	assert.Equal(t, MinEaseFactor, review.EaseFactor)
+
+	assert.ErrorIs(t, review.Review(ReviewGrade(6), now), ErrInvalidReviewGrade)
+}
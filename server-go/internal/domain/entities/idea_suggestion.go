@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// IdeaSuggestion son las etiquetas y la categoría que un clasificador propone para una idea.
+// Las confianzas van de 0 a 1; Category es IdeaCategoryUnspecified si no propone ninguna.
+type IdeaSuggestion struct {
+	Tags               []TagSuggestion
+	Category           IdeaCategory
+	CategoryConfidence float64
+	Classifier         string
+}
+
+// TagSuggestion es una etiqueta propuesta con la confianza del clasificador
+type TagSuggestion struct {
+	Tag        string
+	Confidence float64
+}
+
+// NormalizeTag escribe una etiqueta como se guarda en las sugerencias: en minúsculas y sin "#"
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#")))
+}
+
+// For devuelve lo que la sugerencia aporta a idea con al menos minConfidence: las etiquetas que
+// todavía no tiene, hasta maxTags y las más seguras primero, y la categoría si es distinta
+func (s *IdeaSuggestion) For(idea *Idea, minConfidence float64, maxTags int) *IdeaSuggestion {
+	existing := make(map[string]bool, len(idea.Tags))
+	for _, tag := range idea.Tags {
+		existing[NormalizeTag(tag)] = true
+	}
+
+	filtered := &IdeaSuggestion{Classifier: s.Classifier}
+	for _, suggestion := range s.Tags {
+		tag := NormalizeTag(suggestion.Tag)
+		if tag == "" || existing[tag] || suggestion.Confidence < minConfidence {
+			continue
+		}
+		existing[tag] = true
+		filtered.Tags = append(filtered.Tags, TagSuggestion{Tag: tag, Confidence: suggestion.Confidence})
+	}
+	sort.SliceStable(filtered.Tags, func(i, j int) bool {
+		return filtered.Tags[i].Confidence > filtered.Tags[j].Confidence
+	})
+	if len(filtered.Tags) > maxTags {
+		filtered.Tags = filtered.Tags[:maxTags]
+	}
+
+	if s.Category != IdeaCategoryUnspecified && s.Category != idea.Category && s.CategoryConfidence >= minConfidence {
+		filtered.Category = s.Category
+		filtered.CategoryConfidence = s.CategoryConfidence
+	}
+	return filtered
+}
+
+// IsEmpty indica si la sugerencia no propone nada
+func (s *IdeaSuggestion) IsEmpty() bool {
+	return len(s.Tags) == 0 && s.Category == IdeaCategoryUnspecified
+}
+
+// ApplySuggestion completa los datos que faltan a la idea: las etiquetas si no tiene ninguna y la
+// categoría si no tiene. Nunca reemplaza lo que el usuario eligió; indica si cambió algo.
+func (i *Idea) ApplySuggestion(suggestion *IdeaSuggestion, now time.Time) bool {
+	changed := false
+	if len(i.Tags) == 0 && len(suggestion.Tags) > 0 {
+		i.Tags = make([]string, len(suggestion.Tags))
+		for j, tag := range suggestion.Tags {
+			i.Tags[j] = tag.Tag
+		}
+		changed = true
+	}
+	if i.Category == IdeaCategoryUnspecified && suggestion.Category != IdeaCategoryUnspecified {
+		i.Category = suggestion.Category
+		changed = true
+	}
+	if changed {
+		i.UpdatedAt = now
+	}
+	return changed
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdeaSuggestion_ForAndApply(t *testing.T) {
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Title", "Content", IdeaCategoryUnspecified, uuid.New(), []string{"Work"}, 1)
+	suggestion := &IdeaSuggestion{
+		Tags: []TagSuggestion{
+			{Tag: "work", Confidence: 0.9},
+			{Tag: "#Travel", Confidence: 0.7},
+			{Tag: "someday", Confidence: 0.3},
+		},
+		Category:           IdeaCategoryBusiness,
+		CategoryConfidence: 0.8,
+	}
+
+	filtered := suggestion.For(idea, 0.5, 5)
+	assert.Equal(t, []TagSuggestion{{Tag: "travel", Confidence: 0.7}}, filtered.Tags)
+	assert.Equal(t, IdeaCategoryBusiness, filtered.Category)
+
+	// Las etiquetas que el usuario ya eligió no se reemplazan
+	assert.True(t, idea.ApplySuggestion(filtered, time.Now()))
+	assert.Equal(t, []string{"Work"}, idea.Tags)
+	assert.Equal(t, IdeaCategoryBusiness, idea.Category)
+	assert.False(t, idea.ApplySuggestion(filtered, time.Now()))
+}
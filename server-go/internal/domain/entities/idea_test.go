@@ -17,12 +17,14 @@ func TestNewIdea(t *testing.T) {
 	userID := uuid.New()
 	tags := []string{"test", "idea"}
 	priority := int32(5)
+	clock := NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	ids := &SequentialIDGenerator{}
 
 	// Act
-	idea := NewIdea(title, content, category, userID, tags, priority)
+	idea := NewIdea(clock, ids, title, content, category, userID, tags, priority)
 
 	// Assert
-	assert.NotEqual(t, uuid.Nil, idea.ID)
+	assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), idea.ID)
 	assert.Equal(t, title, idea.Title)
 	assert.Equal(t, content, idea.Content)
 	assert.Equal(t, category, idea.Category)
@@ -30,16 +32,17 @@ func TestNewIdea(t *testing.T) {
 	assert.Equal(t, tags, idea.Tags)
 	assert.Equal(t, priority, idea.Priority)
 	assert.Equal(t, IdeaStatusDraft, idea.Status)
-	assert.NotZero(t, idea.CreatedAt)
-	assert.NotZero(t, idea.UpdatedAt)
+	assert.Equal(t, int64(1), idea.Version)
+	assert.Equal(t, clock.Now(), idea.CreatedAt)
+	assert.Equal(t, clock.Now(), idea.UpdatedAt)
 	assert.Empty(t, idea.RelatedIdeas)
 }
 
 func TestIdea_Update(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
-	originalUpdatedAt := idea.UpdatedAt
-	time.Sleep(time.Millisecond) // Ensure time difference
+	clock := NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	idea := NewIdea(clock, UUIDGenerator{}, "Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
+	clock.Advance(time.Minute)
 
 	newTitle := "Updated Title"
 	newContent := "Updated content"
@@ -49,7 +52,7 @@ func TestIdea_Update(t *testing.T) {
 	newPriority := int32(8)
 
 	// Act
-	idea.Update(newTitle, newContent, newTags, newCategory, newStatus, newPriority)
+	idea.Update(newTitle, newContent, newTags, newCategory, newStatus, newPriority, clock.Now())
 
 	// Assert
 	assert.Equal(t, newTitle, idea.Title)
@@ -58,30 +61,58 @@ func TestIdea_Update(t *testing.T) {
 	assert.Equal(t, newCategory, idea.Category)
 	assert.Equal(t, newStatus, idea.Status)
 	assert.Equal(t, newPriority, idea.Priority)
-	assert.True(t, idea.UpdatedAt.After(originalUpdatedAt))
+	assert.Equal(t, clock.Now(), idea.UpdatedAt)
 }
 
 func TestIdea_UpdateWithEmptyValues(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
 	originalTitle := idea.Title
 	originalContent := idea.Content
 
 	// Act - update with empty values
-	idea.Update("", "", nil, IdeaCategoryUnspecified, IdeaStatusUnspecified, -1)
+	idea.Update("", "", nil, IdeaCategoryUnspecified, IdeaStatusUnspecified, -1, time.Now())
 
 	// Assert - original values should be preserved
 	assert.Equal(t, originalTitle, idea.Title)
 	assert.Equal(t, originalContent, idea.Content)
 }
 
+func TestIdea_UpdateFields_ClearsMaskedFields(t *testing.T) {
+	// Arrange
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
+
+	// Act - clear tags explicitly while leaving the rest untouched
+	err := idea.UpdateFields([]string{"tags"}, "", "", nil, IdeaCategoryUnspecified, IdeaStatusUnspecified, 0, time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, idea.Tags)
+	assert.NotNil(t, idea.Tags)
+	assert.Equal(t, "Original", idea.Title)
+	assert.Equal(t, IdeaCategoryPersonal, idea.Category)
+	assert.Equal(t, int32(1), idea.Priority)
+}
+
+func TestIdea_UpdateFields_InvalidPath(t *testing.T) {
+	// Arrange
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
+
+	// Act
+	err := idea.UpdateFields([]string{"title", "user_id"}, "Changed", "", nil, IdeaCategoryUnspecified, IdeaStatusUnspecified, 0, time.Now())
+
+	// Assert - nothing is applied when any path is invalid
+	assert.Equal(t, ErrInvalidUpdateMask, err)
+	assert.Equal(t, "Original", idea.Title)
+}
+
 func TestIdea_AddRelatedIdea(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 	relatedID := uuid.New()
 
 	// Act
-	idea.AddRelatedIdea(relatedID)
+	idea.AddRelatedIdea(relatedID, time.Now())
 
 	// Assert
 	assert.Len(t, idea.RelatedIdeas, 1)
@@ -90,12 +121,12 @@ func TestIdea_AddRelatedIdea(t *testing.T) {
 
 func TestIdea_AddRelatedIdea_Duplicate(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 	relatedID := uuid.New()
-	idea.AddRelatedIdea(relatedID)
+	idea.AddRelatedIdea(relatedID, time.Now())
 
 	// Act - add same ID again
-	idea.AddRelatedIdea(relatedID)
+	idea.AddRelatedIdea(relatedID, time.Now())
 
 	// Assert - should not duplicate
 	assert.Len(t, idea.RelatedIdeas, 1)
@@ -104,14 +135,14 @@ func TestIdea_AddRelatedIdea_Duplicate(t *testing.T) {
 
 func TestIdea_RemoveRelatedIdea(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 	relatedID1 := uuid.New()
 	relatedID2 := uuid.New()
-	idea.AddRelatedIdea(relatedID1)
-	idea.AddRelatedIdea(relatedID2)
+	idea.AddRelatedIdea(relatedID1, time.Now())
+	idea.AddRelatedIdea(relatedID2, time.Now())
 
 	// Act
-	idea.RemoveRelatedIdea(relatedID1)
+	idea.RemoveRelatedIdea(relatedID1, time.Now())
 
 	// Assert
 	assert.Len(t, idea.RelatedIdeas, 1)
@@ -121,13 +152,13 @@ func TestIdea_RemoveRelatedIdea(t *testing.T) {
 
 func TestIdea_RemoveRelatedIdea_NotFound(t *testing.T) {
 	// Arrange
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 	relatedID1 := uuid.New()
 	relatedID2 := uuid.New()
-	idea.AddRelatedIdea(relatedID1)
+	idea.AddRelatedIdea(relatedID1, time.Now())
 
 	// Act - try to remove non-existent ID
-	idea.RemoveRelatedIdea(relatedID2)
+	idea.RemoveRelatedIdea(relatedID2, time.Now())
 
 	// Assert - should not affect existing ideas
 	assert.Len(t, idea.RelatedIdeas, 1)
@@ -138,7 +169,7 @@ func TestIdea_IsOwnedBy(t *testing.T) {
 	// Arrange
 	userID := uuid.New()
 	otherUserID := uuid.New()
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, userID, []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, userID, []string{}, 1)
 
 	// Act & Assert
 	assert.True(t, idea.IsOwnedBy(userID))
@@ -154,14 +185,14 @@ func TestIdea_Validate(t *testing.T) {
 		{
 			name: "valid idea",
 			setupIdea: func() *Idea {
-				return NewIdea("Valid Title", "Valid content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+				return NewIdea(SystemClock{}, UUIDGenerator{}, "Valid Title", "Valid content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 			},
 			expectError: nil,
 		},
 		{
 			name: "missing title",
 			setupIdea: func() *Idea {
-				idea := NewIdea("", "Valid content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+				idea := NewIdea(SystemClock{}, UUIDGenerator{}, "", "Valid content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 				return idea
 			},
 			expectError: ErrIdeaTitleRequired,
@@ -169,7 +200,7 @@ func TestIdea_Validate(t *testing.T) {
 		{
 			name: "missing content",
 			setupIdea: func() *Idea {
-				idea := NewIdea("Valid Title", "", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+				idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Valid Title", "", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 				return idea
 			},
 			expectError: ErrIdeaContentRequired,
@@ -177,7 +208,7 @@ func TestIdea_Validate(t *testing.T) {
 		{
 			name: "missing user ID",
 			setupIdea: func() *Idea {
-				idea := NewIdea("Valid Title", "Valid content", IdeaCategoryBusiness, uuid.Nil, []string{}, 1)
+				idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Valid Title", "Valid content", IdeaCategoryBusiness, uuid.Nil, []string{}, 1)
 				return idea
 			},
 			expectError: ErrIdeaUserIDRequired,
@@ -231,25 +262,25 @@ func BenchmarkNewIdea(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		NewIdea("Benchmark Idea", "Benchmark content", IdeaCategoryBusiness, userID, tags, 5)
+		NewIdea(SystemClock{}, UUIDGenerator{}, "Benchmark Idea", "Benchmark content", IdeaCategoryBusiness, userID, tags, 5)
 	}
 }
 
 func BenchmarkIdea_Update(b *testing.B) {
-	idea := NewIdea("Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Original", "Original content", IdeaCategoryPersonal, uuid.New(), []string{"original"}, 1)
 	tags := []string{"updated", "benchmark"}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		idea.Update("Updated", "Updated content", tags, IdeaCategoryTechnical, IdeaStatusActive, 8)
+		idea.Update("Updated", "Updated content", tags, IdeaCategoryTechnical, IdeaStatusActive, 8, time.Now())
 	}
 }
 
 func BenchmarkIdea_AddRelatedIdea(b *testing.B) {
-	idea := NewIdea("Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
+	idea := NewIdea(SystemClock{}, UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, 1)
 	
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		idea.AddRelatedIdea(uuid.New())
+		idea.AddRelatedIdea(uuid.New(), time.Now())
 	}
 }
\ No newline at end of file
@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// inboundTokenBytes es la entropía de los tokens de entrada; en hexadecimal caben en la parte
+// local de una dirección de correo, que no distingue mayúsculas
+const inboundTokenBytes = 20
+
+// InboundAddress es una dirección secreta de un usuario a la que se pueden enviar correos o
+// peticiones JSON para crear ideas y recordatorios. El token solo se conoce al crearla; se guarda su hash.
+type InboundAddress struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	TokenHash      string
+	AllowedSenders []string // direcciones o dominios ("@ejemplo.com"); vacío acepta cualquier remitente
+	CreatedAt      time.Time
+	LastUsedAt     *time.Time
+	RevokedAt      *time.Time
+}
+
+// NewInboundAddress crea una dirección de entrada para userID y devuelve también el token en claro
+func NewInboundAddress(clock Clock, ids IDGenerator, userID uuid.UUID, allowedSenders []string) (*InboundAddress, string, error) {
+	raw := make([]byte, inboundTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate inbound token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+
+	senders := make([]string, 0, len(allowedSenders))
+	for _, sender := range allowedSenders {
+		senders = append(senders, strings.ToLower(strings.TrimSpace(sender)))
+	}
+
+	return &InboundAddress{
+		ID:             ids.NewID(),
+		UserID:         userID,
+		TokenHash:      HashInboundToken(token),
+		AllowedSenders: senders,
+		CreatedAt:      clock.Now(),
+	}, token, nil
+}
+
+// HashInboundToken devuelve el hash con el que se guarda y busca un token de entrada
+func HashInboundToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(token)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate valida que la dirección sea correcta
+func (a *InboundAddress) Validate() error {
+	if a.UserID == uuid.Nil {
+		return ErrInboundAddressUserIDRequired
+	}
+	for _, sender := range a.AllowedSenders {
+		if !strings.Contains(sender, "@") || strings.HasSuffix(sender, "@") {
+			return ErrInboundInvalidSender
+		}
+	}
+	return nil
+}
+
+// IsRevoked verifica si la dirección fue revocada
+func (a *InboundAddress) IsRevoked() bool {
+	return a.RevokedAt != nil
+}
+
+// IsOwnedBy verifica si la dirección pertenece al usuario especificado
+func (a *InboundAddress) IsOwnedBy(userID uuid.UUID) bool {
+	return a.UserID == userID
+}
+
+// AllowsSender verifica si sender puede enviar a la dirección
+func (a *InboundAddress) AllowsSender(sender string) bool {
+	if len(a.AllowedSenders) == 0 {
+		return true
+	}
+	sender = strings.ToLower(strings.TrimSpace(sender))
+	at := strings.LastIndex(sender, "@")
+	if at < 0 {
+		return false
+	}
+	for _, allowed := range a.AllowedSenders {
+		if allowed == sender || allowed == sender[at:] {
+			return true
+		}
+	}
+	return false
+}
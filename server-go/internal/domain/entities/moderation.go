@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ModerationScope es el contexto en el que se comparte un contenido; las políticas eligen en cuáles
+// se revisa
+type ModerationScope string
+
+const (
+	// ModerationScopeOrganization son las ideas creadas o editadas dentro de una organización
+	ModerationScopeOrganization ModerationScope = "organization"
+	// ModerationScopePublic son las ideas publicadas con un enlace público
+	ModerationScopePublic ModerationScope = "public"
+)
+
+// ModerationPolicy configura la revisión del contenido de una organización
+type ModerationPolicy struct {
+	Enabled bool `json:"enabled"`
+	// Scopes son los contextos revisados; vacío revisa todos
+	Scopes []ModerationScope `json:"scopes"`
+	// BlockedTerms se suman a la lista de términos del moderador local
+	BlockedTerms []string `json:"blocked_terms"`
+	// UseExternal revisa con el moderador externo, si está configurado, en lugar del local
+	UseExternal bool `json:"use_external"`
+}
+
+// Applies indica si la política revisa el contenido compartido en scope
+func (p ModerationPolicy) Applies(scope ModerationScope) bool {
+	if !p.Enabled {
+		return false
+	}
+	if len(p.Scopes) == 0 {
+		return true
+	}
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ModerationVerdict es la decisión de un moderador sobre un texto
+type ModerationVerdict struct {
+	Flagged bool
+	// Reason es la categoría del rechazo, como "profanity" o la que devuelva el moderador externo
+	Reason string
+	// Matches son los términos que motivaron el rechazo; los moderadores externos pueden no darlos
+	Matches   []string
+	Moderator string
+}
+
+// ModerationRejection es el registro de auditoría de un contenido rechazado. No guarda el texto
+// completo, solo los términos encontrados.
+type ModerationRejection struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Organization string
+	Scope        ModerationScope
+	EntityType   string // "idea"
+	EntityID     uuid.UUID
+	Reason       string
+	Matches      []string
+	Moderator    string
+	CreatedAt    time.Time
+}
+
+// NewModerationRejection registra el rechazo de un contenido de userID
+func NewModerationRejection(clock Clock, ids IDGenerator, userID uuid.UUID, organization string, scope ModerationScope, entityType string, entityID uuid.UUID, verdict *ModerationVerdict) *ModerationRejection {
+	return &ModerationRejection{
+		ID:           ids.NewID(),
+		UserID:       userID,
+		Organization: organization,
+		Scope:        scope,
+		EntityType:   entityType,
+		EntityID:     entityID,
+		Reason:       verdict.Reason,
+		Matches:      verdict.Matches,
+		Moderator:    verdict.Moderator,
+		CreatedAt:    clock.Now(),
+	}
+}
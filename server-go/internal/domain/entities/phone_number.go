@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// phoneCodeDigits es la longitud del código de verificación que se envía por SMS
+	phoneCodeDigits = 6
+	// MaxPhoneVerificationAttempts es el número de códigos erróneos tras el que hay que pedir otro;
+	// con seis dígitos impide adivinarlo
+	MaxPhoneVerificationAttempts = 5
+)
+
+// PhoneNumber es el teléfono de un usuario para las notificaciones por SMS. Solo recibe SMS una vez
+// verificado con el código que se le envía al registrarlo.
+type PhoneNumber struct {
+	UserID        uuid.UUID
+	Number        string // formato E.164, p. ej. +34600111222
+	CodeHash      string // vacío una vez verificado
+	CodeExpiresAt time.Time
+	Attempts      int
+	VerifiedAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewPhoneNumber registra number sin verificar para userID y devuelve también el código en claro
+func NewPhoneNumber(clock Clock, userID uuid.UUID, number string, codeTTL time.Duration) (*PhoneNumber, string, error) {
+	normalized, err := NormalizePhoneNumber(number)
+	if err != nil {
+		return nil, "", err
+	}
+
+	code, err := newPhoneVerificationCode()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := clock.Now()
+	return &PhoneNumber{
+		UserID:        userID,
+		Number:        normalized,
+		CodeHash:      hashPhoneVerificationCode(userID, code),
+		CodeExpiresAt: now.Add(codeTTL),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, code, nil
+}
+
+// NormalizePhoneNumber quita espacios, guiones, puntos y paréntesis y exige el formato E.164:
+// un + seguido de entre 8 y 15 dígitos
+func NormalizePhoneNumber(number string) (string, error) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(number) {
+		switch {
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+
+	normalized := b.String()
+	digits := len(normalized) - 1
+	if !strings.HasPrefix(normalized, "+") || digits < 8 || digits > 15 || normalized[1] == '0' {
+		return "", ErrInvalidPhoneNumber
+	}
+	return normalized, nil
+}
+
+func newPhoneVerificationCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < phoneCodeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate phone verification code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", phoneCodeDigits, n), nil
+}
+
+// hashPhoneVerificationCode incluye al usuario para que el mismo código no tenga el mismo hash en dos cuentas
+func hashPhoneVerificationCode(userID uuid.UUID, code string) string {
+	sum := sha256.Sum256([]byte(userID.String() + ":" + strings.TrimSpace(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsVerified verifica si el usuario confirmó el teléfono con el código
+func (p *PhoneNumber) IsVerified() bool {
+	return p.VerifiedAt != nil
+}
+
+// Verify confirma el teléfono si code es el código vigente. Cada código erróneo cuenta como intento;
+// superado MaxPhoneVerificationAttempts el código deja de valer aunque sea correcto.
+func (p *PhoneNumber) Verify(code string, now time.Time) error {
+	if p.IsVerified() {
+		return nil
+	}
+	if p.Attempts >= MaxPhoneVerificationAttempts {
+		return ErrPhoneVerificationTooManyAttempts
+	}
+	if !now.Before(p.CodeExpiresAt) {
+		return ErrPhoneVerificationCodeExpired
+	}
+
+	p.UpdatedAt = now
+	if subtle.ConstantTimeCompare([]byte(hashPhoneVerificationCode(p.UserID, code)), []byte(p.CodeHash)) != 1 {
+		p.Attempts++
+		return ErrInvalidPhoneVerificationCode
+	}
+
+	p.CodeHash = ""
+	p.Attempts = 0
+	p.VerifiedAt = &now
+	return nil
+}
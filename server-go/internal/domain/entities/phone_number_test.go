@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneNumber_Verify(t *testing.T) {
+	number, err := NormalizePhoneNumber(" +34 (600) 111-222 ")
+	require.NoError(t, err)
+	assert.Equal(t, "+34600111222", number)
+	_, err = NormalizePhoneNumber("600111222")
+	assert.ErrorIs(t, err, ErrInvalidPhoneNumber)
+
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	phone, code, err := NewPhoneNumber(NewFakeClock(now), uuid.New(), number, 10*time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, code, phoneCodeDigits)
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	assert.ErrorIs(t, phone.Verify(wrong, now), ErrInvalidPhoneVerificationCode)
+	assert.Equal(t, 1, phone.Attempts)
+	assert.ErrorIs(t, phone.Verify(code, now.Add(10*time.Minute)), ErrPhoneVerificationCodeExpired)
+	require.NoError(t, phone.Verify(code, now))
+	assert.True(t, phone.IsVerified())
+}
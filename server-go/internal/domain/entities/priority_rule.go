@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// PriorityRule sube o baja la prioridad de las ideas en curso que cumplen todas sus condiciones.
+// Las condiciones en cero no se exigen, pero cada regla necesita al menos una.
+type PriorityRule struct {
+	Name string
+	// Statuses limita la regla a ideas en esos estados; vacío aplica a todas las ideas en curso
+	Statuses []IdeaStatus
+	// MinAge exige que la idea se haya creado hace al menos ese tiempo
+	MinAge time.Duration
+	// IdleFor exige que la idea no haya cambiado en ese tiempo. Como aplicar la regla modifica la
+	// idea, también marca cada cuánto se vuelve a aplicar.
+	IdleFor time.Duration
+	// DeadlineWithin exige un recordatorio vinculado sin completar que venza dentro de ese plazo
+	DeadlineWithin time.Duration
+	// Delta se suma a la prioridad; negativo para bajarla
+	Delta int32
+	// Limit es la prioridad máxima a la que sube una regla con Delta positivo, o la mínima a la que
+	// baja una con Delta negativo
+	Limit int32
+}
+
+// Validate valida que la regla tenga nombre, efecto y al menos una condición
+func (r PriorityRule) Validate() error {
+	if r.Name == "" {
+		return ErrPriorityRuleNameRequired
+	}
+	if r.Delta == 0 {
+		return fmt.Errorf("%s: %w", r.Name, ErrPriorityRuleDeltaZero)
+	}
+	if r.MinAge < 0 || r.IdleFor < 0 || r.DeadlineWithin < 0 {
+		return fmt.Errorf("%s: negative duration: %w", r.Name, ErrInvalidPriorityRule)
+	}
+	if r.MinAge == 0 && r.IdleFor == 0 && r.DeadlineWithin == 0 {
+		return fmt.Errorf("%s: %w", r.Name, ErrPriorityRuleNoCondition)
+	}
+	for _, status := range r.Statuses {
+		if status != IdeaStatusDraft && status != IdeaStatusActive && status != IdeaStatusOnHold {
+			return fmt.Errorf("%s: status %d is not an open status: %w", r.Name, status, ErrInvalidPriorityRule)
+		}
+	}
+	return nil
+}
+
+// Matches verifica si la regla se aplica a la idea; nextDeadline es la hora del recordatorio
+// vinculado sin completar más próximo, o nil si no tiene
+func (r PriorityRule) Matches(idea *Idea, nextDeadline *time.Time, now time.Time) bool {
+	if !idea.IsOpen() || r.Apply(idea.Priority) == idea.Priority {
+		return false
+	}
+	if len(r.Statuses) > 0 && !r.hasStatus(idea.Status) {
+		return false
+	}
+	if r.MinAge > 0 && now.Sub(idea.CreatedAt) < r.MinAge {
+		return false
+	}
+	if r.IdleFor > 0 && now.Sub(idea.UpdatedAt) < r.IdleFor {
+		return false
+	}
+	if r.DeadlineWithin > 0 && (nextDeadline == nil || nextDeadline.Sub(now) > r.DeadlineWithin) {
+		return false
+	}
+	return true
+}
+
+// Apply devuelve la prioridad resultante sin pasar del límite. Una prioridad que ya está más allá
+// del límite no se modifica.
+func (r PriorityRule) Apply(priority int32) int32 {
+	switch {
+	case r.Delta > 0 && priority < r.Limit:
+		return min(priority+r.Delta, r.Limit)
+	case r.Delta < 0 && priority > r.Limit:
+		return max(priority+r.Delta, r.Limit)
+	}
+	return priority
+}
+
+func (r PriorityRule) hasStatus(status IdeaStatus) bool {
+	for _, s := range r.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
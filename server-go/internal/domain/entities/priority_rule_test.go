@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityRule_Matches(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	deadline := now.Add(24 * time.Hour)
+	farDeadline := now.Add(10 * 24 * time.Hour)
+
+	bump := PriorityRule{Name: "deadline", DeadlineWithin: 48 * time.Hour, Delta: 2, Limit: 5}
+	decay := PriorityRule{Name: "stale", Statuses: []IdeaStatus{IdeaStatusDraft}, IdleFor: 30 * 24 * time.Hour, Delta: -1, Limit: 0}
+
+	newIdea := func(status IdeaStatus, priority int32, updatedAt time.Time) *Idea {
+		idea := NewIdea(NewFakeClock(updatedAt), UUIDGenerator{}, "Test", "Content", IdeaCategoryBusiness, uuid.New(), []string{}, priority)
+		idea.Status = status
+		return idea
+	}
+
+	tests := []struct {
+		name         string
+		rule         PriorityRule
+		idea         *Idea
+		nextDeadline *time.Time
+		expected     bool
+	}{
+		{"deadline within window", bump, newIdea(IdeaStatusActive, 1, now), &deadline, true},
+		{"deadline outside window", bump, newIdea(IdeaStatusActive, 1, now), &farDeadline, false},
+		{"no deadline", bump, newIdea(IdeaStatusActive, 1, now), nil, false},
+		{"already at limit", bump, newIdea(IdeaStatusActive, 5, now), &deadline, false},
+		{"closed idea", bump, newIdea(IdeaStatusCompleted, 1, now), &deadline, false},
+		{"idle draft", decay, newIdea(IdeaStatusDraft, 2, now.Add(-31*24*time.Hour)), nil, true},
+		{"recently updated draft", decay, newIdea(IdeaStatusDraft, 2, now.Add(-time.Hour)), nil, false},
+		{"idle idea in other status", decay, newIdea(IdeaStatusActive, 2, now.Add(-31*24*time.Hour)), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rule.Matches(tt.idea, tt.nextDeadline, now))
+		})
+	}
+}
+
+func TestPriorityRule_ApplyRespectsLimit(t *testing.T) {
+	bump := PriorityRule{Name: "bump", IdleFor: time.Hour, Delta: 2, Limit: 5}
+	decay := PriorityRule{Name: "decay", IdleFor: time.Hour, Delta: -3, Limit: 1}
+
+	assert.Equal(t, int32(3), bump.Apply(1))
+	assert.Equal(t, int32(5), bump.Apply(4))
+	assert.Equal(t, int32(7), bump.Apply(7))
+	assert.Equal(t, int32(1), decay.Apply(2))
+	assert.Equal(t, int32(0), decay.Apply(0))
+}
+
+func TestPriorityRule_Validate(t *testing.T) {
+	assert.NoError(t, PriorityRule{Name: "ok", MinAge: time.Hour, Delta: 1, Limit: 3}.Validate())
+	assert.ErrorIs(t, PriorityRule{MinAge: time.Hour, Delta: 1}.Validate(), ErrPriorityRuleNameRequired)
+	assert.ErrorIs(t, PriorityRule{Name: "zero", MinAge: time.Hour}.Validate(), ErrPriorityRuleDeltaZero)
+	assert.ErrorIs(t, PriorityRule{Name: "always", Delta: 1}.Validate(), ErrPriorityRuleNoCondition)
+	assert.ErrorIs(t, PriorityRule{Name: "closed", MinAge: time.Hour, Delta: 1, Statuses: []IdeaStatus{IdeaStatusArchived}}.Validate(), ErrInvalidPriorityRule)
+}
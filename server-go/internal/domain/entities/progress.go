@@ -14,6 +14,13 @@ type ProgressMilestone struct {
 	Completed   bool
 	DueDate     time.Time
 	CompletedAt *time.Time
+	// EstimatedEffort es el tiempo de trabajo previsto; cero si no se estimó
+	EstimatedEffort time.Duration
+	// EstimatedDuration es el tiempo de calendario previsto desde que termina el hito anterior;
+	// cero si no se estimó
+	EstimatedDuration time.Duration
+	// Weight es el peso del hito en el porcentaje de completación; cero equivale a 1
+	Weight float32
 }
 
 // Progress representa el progreso de un proyecto
@@ -24,15 +31,17 @@ type Progress struct {
 	Description            string
 	CompletionPercentage   float32
 	Milestones             []ProgressMilestone
+	CustomFields           CustomFields
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
+	Version                int64
 }
 
 // NewProgress crea un nuevo registro de progreso
-func NewProgress(userID uuid.UUID, projectName, description string) *Progress {
-	now := time.Now()
+func NewProgress(clock Clock, ids IDGenerator, userID uuid.UUID, projectName, description string) *Progress {
+	now := clock.Now()
 	return &Progress{
-		ID:                   uuid.New(),
+		ID:                   ids.NewID(),
 		UserID:               userID,
 		ProjectName:          projectName,
 		Description:          description,
@@ -40,13 +49,14 @@ func NewProgress(userID uuid.UUID, projectName, description string) *Progress {
 		Milestones:           make([]ProgressMilestone, 0),
 		CreatedAt:            now,
 		UpdatedAt:            now,
+		Version:              1,
 	}
 }
 
 // NewMilestone crea un nuevo hito
-func NewMilestone(name, description string, dueDate time.Time) ProgressMilestone {
+func NewMilestone(ids IDGenerator, name, description string, dueDate time.Time) ProgressMilestone {
 	return ProgressMilestone{
-		ID:          uuid.New(),
+		ID:          ids.NewID(),
 		Name:        name,
 		Description: description,
 		Completed:   false,
@@ -55,8 +65,25 @@ func NewMilestone(name, description string, dueDate time.Time) ProgressMilestone
 	}
 }
 
+// Validate valida que el hito tenga los campos requeridos
+func (m ProgressMilestone) Validate() error {
+	if m.Name == "" {
+		return ErrMilestoneNameRequired
+	}
+	if m.EstimatedEffort < 0 {
+		return ErrInvalidEstimatedEffort
+	}
+	if m.EstimatedDuration < 0 {
+		return ErrInvalidEstimatedDuration
+	}
+	if m.Weight < 0 {
+		return ErrInvalidMilestoneWeight
+	}
+	return nil
+}
+
 // Update actualiza los campos modificables del progreso
-func (p *Progress) Update(projectName, description string, completionPercentage float32, milestones []ProgressMilestone) error {
+func (p *Progress) Update(projectName, description string, completionPercentage float32, milestones []ProgressMilestone, now time.Time) error {
 	if projectName != "" {
 		p.ProjectName = projectName
 	}
@@ -71,22 +98,61 @@ func (p *Progress) Update(projectName, description string, completionPercentage
 	if milestones != nil {
 		p.Milestones = milestones
 	}
-	p.UpdatedAt = time.Now()
+	p.UpdatedAt = now
+	return nil
+}
+
+// UpdateFields actualiza únicamente los campos indicados en paths (nombres de campo del proto)
+func (p *Progress) UpdateFields(paths []string, projectName, description string, completionPercentage float32, milestones []ProgressMilestone, now time.Time) error {
+	for _, path := range paths {
+		switch path {
+		case "project_name", "description", "milestones":
+		case "completion_percentage":
+			if completionPercentage < 0 || completionPercentage > 100 {
+				return ErrInvalidCompletionPercentage
+			}
+		default:
+			return ErrInvalidUpdateMask
+		}
+	}
+	
+	for _, path := range paths {
+		switch path {
+		case "project_name":
+			p.ProjectName = projectName
+		case "description":
+			p.Description = description
+		case "completion_percentage":
+			p.CompletionPercentage = completionPercentage
+		case "milestones":
+			if milestones == nil {
+				milestones = make([]ProgressMilestone, 0)
+			}
+			p.Milestones = milestones
+		}
+	}
+	p.UpdatedAt = now
 	return nil
 }
 
+// SetCustomFields reemplaza los valores de los campos personalizados; se validan contra las
+// definiciones del usuario con ValidateCustomFields
+func (p *Progress) SetCustomFields(fields CustomFields, now time.Time) {
+	p.CustomFields = fields
+	p.UpdatedAt = now
+}
+
 // AddMilestone añade un nuevo hito
-func (p *Progress) AddMilestone(milestone ProgressMilestone) {
+func (p *Progress) AddMilestone(milestone ProgressMilestone, now time.Time) {
 	p.Milestones = append(p.Milestones, milestone)
-	p.UpdatedAt = time.Now()
+	p.UpdatedAt = now
 }
 
 // CompleteMilestone marca un hito como completado
-func (p *Progress) CompleteMilestone(milestoneID uuid.UUID) bool {
+func (p *Progress) CompleteMilestone(milestoneID uuid.UUID, now time.Time) bool {
 	for i := range p.Milestones {
 		if p.Milestones[i].ID == milestoneID {
 			p.Milestones[i].Completed = true
-			now := time.Now()
 			p.Milestones[i].CompletedAt = &now
 			p.UpdatedAt = now
 			p.recalculateCompletion()
@@ -97,12 +163,12 @@ func (p *Progress) CompleteMilestone(milestoneID uuid.UUID) bool {
 }
 
 // UncompleteMilestone marca un hito como no completado
-func (p *Progress) UncompleteMilestone(milestoneID uuid.UUID) bool {
+func (p *Progress) UncompleteMilestone(milestoneID uuid.UUID, now time.Time) bool {
 	for i := range p.Milestones {
 		if p.Milestones[i].ID == milestoneID {
 			p.Milestones[i].Completed = false
 			p.Milestones[i].CompletedAt = nil
-			p.UpdatedAt = time.Now()
+			p.UpdatedAt = now
 			p.recalculateCompletion()
 			return true
 		}
@@ -111,11 +177,11 @@ func (p *Progress) UncompleteMilestone(milestoneID uuid.UUID) bool {
 }
 
 // RemoveMilestone elimina un hito
-func (p *Progress) RemoveMilestone(milestoneID uuid.UUID) bool {
+func (p *Progress) RemoveMilestone(milestoneID uuid.UUID, now time.Time) bool {
 	for i, milestone := range p.Milestones {
 		if milestone.ID == milestoneID {
 			p.Milestones = append(p.Milestones[:i], p.Milestones[i+1:]...)
-			p.UpdatedAt = time.Now()
+			p.UpdatedAt = now
 			p.recalculateCompletion()
 			return true
 		}
@@ -123,20 +189,29 @@ func (p *Progress) RemoveMilestone(milestoneID uuid.UUID) bool {
 	return false
 }
 
-// recalculateCompletion recalcula el porcentaje de completación basado en los hitos
+// weight devuelve el peso efectivo del hito
+func (m ProgressMilestone) weight() float32 {
+	if m.Weight == 0 {
+		return 1
+	}
+	return m.Weight
+}
+
+// recalculateCompletion recalcula el porcentaje de completación basado en el peso de los hitos
 func (p *Progress) recalculateCompletion() {
 	if len(p.Milestones) == 0 {
 		return
 	}
 	
-	completed := 0
+	var completed, total float32
 	for _, milestone := range p.Milestones {
+		total += milestone.weight()
 		if milestone.Completed {
-			completed++
+			completed += milestone.weight()
 		}
 	}
 	
-	p.CompletionPercentage = float32(completed) / float32(len(p.Milestones)) * 100
+	p.CompletionPercentage = completed / total * 100
 }
 
 // GetCompletedMilestones obtiene los hitos completados
@@ -162,9 +237,8 @@ func (p *Progress) GetPendingMilestones() []ProgressMilestone {
 }
 
 // GetOverdueMilestones obtiene los hitos vencidos
-func (p *Progress) GetOverdueMilestones() []ProgressMilestone {
+func (p *Progress) GetOverdueMilestones(now time.Time) []ProgressMilestone {
 	var overdue []ProgressMilestone
-	now := time.Now()
 	for _, milestone := range p.Milestones {
 		if !milestone.Completed && milestone.DueDate.Before(now) {
 			overdue = append(overdue, milestone)
@@ -173,6 +247,26 @@ func (p *Progress) GetOverdueMilestones() []ProgressMilestone {
 	return overdue
 }
 
+// FindMilestone obtiene un hito por su ID
+func (p *Progress) FindMilestone(milestoneID uuid.UUID) (ProgressMilestone, bool) {
+	for _, milestone := range p.Milestones {
+		if milestone.ID == milestoneID {
+			return milestone, true
+		}
+	}
+	return ProgressMilestone{}, false
+}
+
+// IsCompleted verifica si el proyecto alcanzó el 100% de completación
+func (p *Progress) IsCompleted() bool {
+	return p.CompletionPercentage >= 100
+}
+
+// HasVersion verifica si la versión esperada coincide con la actual (0 omite la verificación)
+func (p *Progress) HasVersion(expected int64) bool {
+	return expected == 0 || p.Version == expected
+}
+
 // IsOwnedBy verifica si el progreso pertenece al usuario especificado
 func (p *Progress) IsOwnedBy(userID uuid.UUID) bool {
 	return p.UserID == userID
@@ -189,5 +283,10 @@ func (p *Progress) Validate() error {
 	if p.CompletionPercentage < 0 || p.CompletionPercentage > 100 {
 		return ErrInvalidCompletionPercentage
 	}
+	for _, milestone := range p.Milestones {
+		if err := milestone.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,99 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxTemplateMilestones es el máximo de hitos de una plantilla de progreso
+const MaxTemplateMilestones = 100
+
+// TemplateMilestone es un hito de una plantilla; al instanciarla se convierte en un hito del
+// progreso con fecha de vencimiento relativa al inicio
+type TemplateMilestone struct {
+	Name        string
+	Description string
+	// DueOffset es el plazo desde el inicio del proyecto hasta el vencimiento del hito
+	DueOffset time.Duration
+	// Weight es el peso del hito en el porcentaje de completación; cero equivale a 1
+	Weight float32
+}
+
+// ProgressTemplate es un conjunto reutilizable de hitos con el que crear registros de progreso.
+// Las plantillas son privadas de su dueño hasta que las comparte con su organización.
+type ProgressTemplate struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	Description string
+	Milestones  []TemplateMilestone
+	// Organization es la organización con la que se comparte la plantilla; vacía si es privada
+	Organization string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewProgressTemplate crea una plantilla privada
+func NewProgressTemplate(clock Clock, ids IDGenerator, ownerID uuid.UUID, name, description string, milestones []TemplateMilestone) *ProgressTemplate {
+	now := clock.Now()
+	return &ProgressTemplate{
+		ID:          ids.NewID(),
+		OwnerID:     ownerID,
+		Name:        name,
+		Description: description,
+		Milestones:  milestones,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Validate valida el nombre de la plantilla y sus hitos
+func (t *ProgressTemplate) Validate() error {
+	if t.Name == "" {
+		return ErrProgressTemplateNameRequired
+	}
+	if len(t.Milestones) > MaxTemplateMilestones {
+		return ErrTooManyTemplateMilestones
+	}
+	for _, milestone := range t.Milestones {
+		if milestone.Name == "" {
+			return ErrMilestoneNameRequired
+		}
+		if milestone.DueOffset < 0 {
+			return ErrInvalidTemplateDueOffset
+		}
+		if milestone.Weight < 0 {
+			return ErrInvalidMilestoneWeight
+		}
+	}
+	return nil
+}
+
+// IsOwnedBy verifica si la plantilla pertenece al usuario especificado
+func (t *ProgressTemplate) IsOwnedBy(userID uuid.UUID) bool {
+	return t.OwnerID == userID
+}
+
+// IsVisibleTo indica si un usuario de organization puede usar la plantilla
+func (t *ProgressTemplate) IsVisibleTo(userID uuid.UUID, organization string) bool {
+	return t.IsOwnedBy(userID) || (t.Organization != "" && t.Organization == organization)
+}
+
+// Share comparte la plantilla con organization, o la vuelve privada si está vacía
+func (t *ProgressTemplate) Share(organization string, now time.Time) {
+	t.Organization = organization
+	t.UpdatedAt = now
+}
+
+// Instantiate crea un registro de progreso de userID con los hitos de la plantilla, que vencen
+// contando desde start
+func (t *ProgressTemplate) Instantiate(clock Clock, ids IDGenerator, userID uuid.UUID, projectName, description string, start time.Time) *Progress {
+	progress := NewProgress(clock, ids, userID, projectName, description)
+	for _, milestone := range t.Milestones {
+		created := NewMilestone(ids, milestone.Name, milestone.Description, start.Add(milestone.DueOffset))
+		created.Weight = milestone.Weight
+		progress.Milestones = append(progress.Milestones, created)
+	}
+	return progress
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTemplateInstantiate(t *testing.T) {
+	// Arrange
+	const day = 24 * time.Hour
+	ids := &SequentialIDGenerator{}
+	clock := NewFakeClock(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	ownerID, memberID := uuid.New(), uuid.New()
+	template := NewProgressTemplate(clock, ids, ownerID, "Lanzamiento", "", []TemplateMilestone{
+		{Name: "Diseño", DueOffset: 7 * day, Weight: 3},
+		{Name: "Publicación", DueOffset: 30 * day},
+	})
+	require.NoError(t, template.Validate())
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	progress := template.Instantiate(clock, ids, memberID, "App", "", start)
+	progress.CompleteMilestone(progress.Milestones[0].ID, clock.Now())
+
+	// Assert
+	require.Len(t, progress.Milestones, 2)
+	assert.Equal(t, memberID, progress.UserID)
+	assert.Equal(t, start.Add(7*day), progress.Milestones[0].DueDate)
+	assert.Equal(t, start.Add(30*day), progress.Milestones[1].DueDate)
+	assert.Equal(t, float32(75), progress.CompletionPercentage)
+
+	assert.False(t, template.IsVisibleTo(memberID, "acme"))
+	template.Share("acme", clock.Now())
+	assert.True(t, template.IsVisibleTo(memberID, "acme"))
+	assert.False(t, template.IsVisibleTo(memberID, "otra"))
+}
@@ -42,6 +42,58 @@ const (
 	RecurrencePatternCustom      RecurrencePattern = 5
 )
 
+// ReminderAssignmentStatus representa el estado de la asignación de un recordatorio a otro usuario
+type ReminderAssignmentStatus int32
+
+const (
+	ReminderAssignmentNone     ReminderAssignmentStatus = 0
+	ReminderAssignmentPending  ReminderAssignmentStatus = 1
+	ReminderAssignmentAccepted ReminderAssignmentStatus = 2
+	ReminderAssignmentDeclined ReminderAssignmentStatus = 3
+)
+
+// ReminderScope indica qué recordatorios de un usuario se listan según quién los creó o a quién se asignaron
+type ReminderScope int32
+
+const (
+	// ReminderScopeAll incluye los creados por el usuario y los asignados a él que no rechazó
+	ReminderScopeAll          ReminderScope = 0
+	ReminderScopeCreatedByMe  ReminderScope = 1
+	ReminderScopeAssignedToMe ReminderScope = 2
+)
+
+// IsValid verifica si el tipo es uno de los definidos (ReminderTypeUnspecified incluido)
+func (t ReminderType) IsValid() bool {
+	return t >= ReminderTypeUnspecified && t <= ReminderTypeCall
+}
+
+// IsValid verifica si el estado es uno de los definidos (ReminderStatusUnspecified incluido)
+func (s ReminderStatus) IsValid() bool {
+	return s >= ReminderStatusUnspecified && s <= ReminderStatusOverdue
+}
+
+// IsValid verifica si el alcance es uno de los definidos
+func (s ReminderScope) IsValid() bool {
+	return s >= ReminderScopeAll && s <= ReminderScopeAssignedToMe
+}
+
+// CanTransitionTo verifica si un recordatorio en el estado s puede pasar al estado next.
+// Completed es final, Cancelled solo puede reactivarse como Pending y Overdue solo lo asigna el sistema.
+func (s ReminderStatus) CanTransitionTo(next ReminderStatus) bool {
+	if s == next {
+		return true
+	}
+	switch {
+	case next == ReminderStatusUnspecified, next == ReminderStatusOverdue:
+		return false
+	case s == ReminderStatusCompleted:
+		return false
+	case s == ReminderStatusCancelled:
+		return next == ReminderStatusPending
+	}
+	return true
+}
+
 // Reminder representa un recordatorio en el dominio
 type Reminder struct {
 	ID                    uuid.UUID
@@ -56,13 +108,34 @@ type Reminder struct {
 	UpdatedAt             time.Time
 	UserID                uuid.UUID
 	NotificationChannels  []string
+	// IdeaID es la idea a la que pertenece el recordatorio; uuid.Nil si no está vinculado
+	IdeaID                uuid.UUID
+	// AssigneeID es el usuario al que el creador delegó el recordatorio; uuid.Nil si no está asignado
+	AssigneeID            uuid.UUID
+	AssignmentStatus      ReminderAssignmentStatus
+	// EscalationPolicy insiste con los recordatorios Deadline vencidos sin confirmar; nil no escala
+	EscalationPolicy      *ReminderEscalationPolicy
+	// EscalationLevel es el número de pasos de escalado ya enviados
+	EscalationLevel       int
+	AcknowledgedAt        *time.Time
+	// MilestoneLink es el hito que se completa al completar el recordatorio; nil si no está vinculado
+	MilestoneLink         *ReminderMilestoneLink
+	Version               int64
+}
+
+// ReminderMilestoneLink vincula un recordatorio con un hito de un registro de progreso de su creador
+type ReminderMilestoneLink struct {
+	ProgressID  uuid.UUID
+	MilestoneID uuid.UUID
+	// CompletesReminder hace que completar el hito también complete el recordatorio
+	CompletesReminder bool
 }
 
 // NewReminder crea un nuevo recordatorio
-func NewReminder(title, description string, scheduledTime time.Time, reminderType ReminderType, userID uuid.UUID, recurring bool, recurrencePattern RecurrencePattern, channels []string) *Reminder {
-	now := time.Now()
+func NewReminder(clock Clock, ids IDGenerator, title, description string, scheduledTime time.Time, reminderType ReminderType, userID uuid.UUID, recurring bool, recurrencePattern RecurrencePattern, channels []string) *Reminder {
+	now := clock.Now()
 	return &Reminder{
-		ID:                   uuid.New(),
+		ID:                   ids.NewID(),
 		Title:                title,
 		Description:          description,
 		ScheduledTime:        scheduledTime,
@@ -74,11 +147,12 @@ func NewReminder(title, description string, scheduledTime time.Time, reminderTyp
 		UpdatedAt:            now,
 		UserID:               userID,
 		NotificationChannels: channels,
+		Version:              1,
 	}
 }
 
 // Update actualiza los campos modificables del recordatorio
-func (r *Reminder) Update(title, description string, scheduledTime time.Time, reminderType ReminderType, status ReminderStatus, recurring bool, recurrencePattern RecurrencePattern) {
+func (r *Reminder) Update(title, description string, scheduledTime time.Time, reminderType ReminderType, status ReminderStatus, recurring bool, recurrencePattern RecurrencePattern, now time.Time) {
 	if title != "" {
 		r.Title = title
 	}
@@ -98,40 +172,198 @@ func (r *Reminder) Update(title, description string, scheduledTime time.Time, re
 	if recurrencePattern != RecurrencePatternUnspecified {
 		r.RecurrencePattern = recurrencePattern
 	}
-	r.UpdatedAt = time.Now()
+	r.UpdatedAt = now
+}
+
+// UpdateFields actualiza únicamente los campos indicados en paths (nombres de campo del proto)
+func (r *Reminder) UpdateFields(paths []string, title, description string, scheduledTime time.Time, reminderType ReminderType, status ReminderStatus, recurring bool, recurrencePattern RecurrencePattern, now time.Time) error {
+	for _, path := range paths {
+		switch path {
+		case "title", "description", "scheduled_time", "type", "status", "recurring", "recurrence_pattern":
+		default:
+			return ErrInvalidUpdateMask
+		}
+	}
+	
+	for _, path := range paths {
+		switch path {
+		case "title":
+			r.Title = title
+		case "description":
+			r.Description = description
+		case "scheduled_time":
+			r.ScheduledTime = scheduledTime
+		case "type":
+			r.Type = reminderType
+		case "status":
+			r.Status = status
+		case "recurring":
+			r.Recurring = recurring
+		case "recurrence_pattern":
+			r.RecurrencePattern = recurrencePattern
+		}
+	}
+	r.UpdatedAt = now
+	return nil
+}
+
+// IsLinkedToIdea verifica si el recordatorio pertenece a una idea
+func (r *Reminder) IsLinkedToIdea() bool {
+	return r.IdeaID != uuid.Nil
+}
+
+// IsLinkedToMilestone verifica si el recordatorio está vinculado a un hito
+func (r *Reminder) IsLinkedToMilestone() bool {
+	return r.MilestoneLink != nil
+}
+
+// LinkMilestone vincula el recordatorio a un hito, reemplazando el vínculo anterior; un recordatorio
+// completado o cancelado no se puede vincular
+func (r *Reminder) LinkMilestone(link ReminderMilestoneLink, now time.Time) error {
+	if r.Status == ReminderStatusCompleted || r.Status == ReminderStatusCancelled {
+		return ErrInvalidReminderTransition
+	}
+	r.MilestoneLink = &link
+	r.UpdatedAt = now
+	return nil
+}
+
+// UnlinkMilestone desvincula el recordatorio de su hito
+func (r *Reminder) UnlinkMilestone(now time.Time) {
+	r.MilestoneLink = nil
+	r.UpdatedAt = now
 }
 
 // Complete marca el recordatorio como completado
-func (r *Reminder) Complete() {
+func (r *Reminder) Complete(now time.Time) {
 	r.Status = ReminderStatusCompleted
-	r.UpdatedAt = time.Now()
+	r.UpdatedAt = now
 }
 
 // Cancel marca el recordatorio como cancelado
-func (r *Reminder) Cancel() {
+func (r *Reminder) Cancel(now time.Time) {
 	r.Status = ReminderStatusCancelled
-	r.UpdatedAt = time.Now()
+	r.UpdatedAt = now
+}
+
+// NextOccurrence devuelve la primera repetición posterior a now según el patrón de recurrencia.
+// Devuelve false si el recordatorio no es recurrente o su patrón no define un intervalo fijo.
+func (r *Reminder) NextOccurrence(now time.Time) (time.Time, bool) {
+	if !r.Recurring {
+		return time.Time{}, false
+	}
+	
+	var years, months, days int
+	switch r.RecurrencePattern {
+	case RecurrencePatternDaily:
+		days = 1
+	case RecurrencePatternWeekly:
+		days = 7
+	case RecurrencePatternMonthly:
+		months = 1
+	case RecurrencePatternYearly:
+		years = 1
+	default:
+		return time.Time{}, false
+	}
+	
+	next := r.ScheduledTime.AddDate(years, months, days)
+	for !next.After(now) {
+		next = next.AddDate(years, months, days)
+	}
+	return next, true
+}
+
+// Reschedule programa el recordatorio para scheduledTime y lo deja pendiente
+func (r *Reminder) Reschedule(scheduledTime, now time.Time) {
+	r.ScheduledTime = scheduledTime
+	r.Status = ReminderStatusPending
+	r.ResetEscalation()
+	r.UpdatedAt = now
 }
 
 // MarkAsOverdue marca el recordatorio como vencido
-func (r *Reminder) MarkAsOverdue() {
+func (r *Reminder) MarkAsOverdue(now time.Time) {
 	if r.Status == ReminderStatusPending || r.Status == ReminderStatusActive {
 		r.Status = ReminderStatusOverdue
-		r.UpdatedAt = time.Now()
+		r.UpdatedAt = now
 	}
 }
 
 // IsOverdue verifica si el recordatorio está vencido
-func (r *Reminder) IsOverdue() bool {
-	return time.Now().After(r.ScheduledTime) && 
+func (r *Reminder) IsOverdue(now time.Time) bool {
+	return now.After(r.ScheduledTime) && 
 		   (r.Status == ReminderStatusPending || r.Status == ReminderStatusActive)
 }
 
+// HasVersion verifica si la versión esperada coincide con la actual (0 omite la verificación)
+func (r *Reminder) HasVersion(expected int64) bool {
+	return expected == 0 || r.Version == expected
+}
+
 // IsOwnedBy verifica si el recordatorio pertenece al usuario especificado
 func (r *Reminder) IsOwnedBy(userID uuid.UUID) bool {
 	return r.UserID == userID
 }
 
+// IsAssignedTo verifica si el recordatorio está asignado al usuario y este no lo rechazó
+func (r *Reminder) IsAssignedTo(userID uuid.UUID) bool {
+	return r.AssigneeID != uuid.Nil && r.AssigneeID == userID && r.AssignmentStatus != ReminderAssignmentDeclined
+}
+
+// IsVisibleTo verifica si el usuario puede ver el recordatorio: su creador o su asignado
+func (r *Reminder) IsVisibleTo(userID uuid.UUID) bool {
+	return r.IsOwnedBy(userID) || r.IsAssignedTo(userID)
+}
+
+// CanBeCompletedBy verifica si el usuario puede completar el recordatorio: su creador o el asignado que lo aceptó
+func (r *Reminder) CanBeCompletedBy(userID uuid.UUID) bool {
+	return r.IsOwnedBy(userID) || (r.IsAssignedTo(userID) && r.AssignmentStatus == ReminderAssignmentAccepted)
+}
+
+// Assign delega el recordatorio en assigneeID, que debe aceptarlo. Reasignarlo reinicia la aceptación.
+func (r *Reminder) Assign(assigneeID uuid.UUID, now time.Time) error {
+	if assigneeID == uuid.Nil || assigneeID == r.UserID {
+		return ErrInvalidReminderAssignee
+	}
+	if r.Status == ReminderStatusCompleted || r.Status == ReminderStatusCancelled {
+		return ErrInvalidReminderTransition
+	}
+	r.AssigneeID = assigneeID
+	r.AssignmentStatus = ReminderAssignmentPending
+	r.UpdatedAt = now
+	return nil
+}
+
+// Unassign devuelve el recordatorio a su creador
+func (r *Reminder) Unassign(now time.Time) {
+	r.AssigneeID = uuid.Nil
+	r.AssignmentStatus = ReminderAssignmentNone
+	r.UpdatedAt = now
+}
+
+// RespondToAssignment registra si el asignado acepta o rechaza la asignación pendiente
+func (r *Reminder) RespondToAssignment(accept bool, now time.Time) error {
+	if r.AssignmentStatus != ReminderAssignmentPending {
+		return ErrReminderAssignmentNotPending
+	}
+	if accept {
+		r.AssignmentStatus = ReminderAssignmentAccepted
+	} else {
+		r.AssignmentStatus = ReminderAssignmentDeclined
+	}
+	r.UpdatedAt = now
+	return nil
+}
+
+// NotifiedUsers devuelve a quién se avisa del recordatorio: el creador y el asignado que lo aceptó
+func (r *Reminder) NotifiedUsers() []uuid.UUID {
+	if r.AssigneeID != uuid.Nil && r.AssignmentStatus == ReminderAssignmentAccepted {
+		return []uuid.UUID{r.UserID, r.AssigneeID}
+	}
+	return []uuid.UUID{r.UserID}
+}
+
 // Validate valida que el recordatorio tenga los campos requeridos
 func (r *Reminder) Validate() error {
 	if r.Title == "" {
@@ -143,5 +375,14 @@ func (r *Reminder) Validate() error {
 	if r.ScheduledTime.IsZero() {
 		return ErrReminderScheduledTimeRequired
 	}
+	if !r.Type.IsValid() {
+		return ErrInvalidReminderType
+	}
+	if !r.Status.IsValid() || r.Status == ReminderStatusUnspecified {
+		return ErrInvalidReminderStatus
+	}
+	if r.EscalationPolicy != nil && r.Type != ReminderTypeDeadline {
+		return ErrEscalationRequiresDeadline
+	}
 	return nil
 }
\ No newline at end of file
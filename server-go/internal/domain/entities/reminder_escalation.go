@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxReminderEscalationSteps es el máximo de avisos adicionales de una política de escalado
+const MaxReminderEscalationSteps = 5
+
+// ReminderEscalationStep es un aviso adicional que se envía si el recordatorio sigue sin
+// confirmarse After después de su hora
+type ReminderEscalationStep struct {
+	After    time.Duration
+	Channels []string
+	// NotifyBackupContact avisa al contacto de respaldo de la política además de al usuario
+	NotifyBackupContact bool
+}
+
+// ReminderEscalationPolicy define cómo insistir con un recordatorio de tipo Deadline que nadie
+// confirmó: cada paso usa canales más insistentes que el anterior (push, SMS, email...)
+type ReminderEscalationPolicy struct {
+	Steps           []ReminderEscalationStep
+	BackupContactID uuid.UUID
+}
+
+// Validate verifica que los pasos estén ordenados por tiempo, tengan canales y que haya
+// contacto de respaldo si algún paso lo necesita
+func (p *ReminderEscalationPolicy) Validate() error {
+	if len(p.Steps) == 0 || len(p.Steps) > MaxReminderEscalationSteps {
+		return ErrInvalidEscalationPolicy
+	}
+	var previous time.Duration
+	for _, step := range p.Steps {
+		if step.After <= previous || len(step.Channels) == 0 {
+			return ErrInvalidEscalationPolicy
+		}
+		if step.NotifyBackupContact && p.BackupContactID == uuid.Nil {
+			return ErrInvalidEscalationPolicy
+		}
+		previous = step.After
+	}
+	return nil
+}
+
+// SetEscalationPolicy reemplaza la política de escalado; nil la quita. Los avisos ya enviados
+// se olvidan, así que la nueva política empieza desde el primer paso.
+func (r *Reminder) SetEscalationPolicy(policy *ReminderEscalationPolicy, now time.Time) error {
+	if policy != nil {
+		if r.Type != ReminderTypeDeadline {
+			return ErrEscalationRequiresDeadline
+		}
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+	r.EscalationPolicy = policy
+	r.ResetEscalation()
+	r.UpdatedAt = now
+	return nil
+}
+
+// NextEscalation devuelve el siguiente paso de escalado si ya le toca en el instante now: el
+// recordatorio tiene que estar vencido y sin confirmar
+func (r *Reminder) NextEscalation(now time.Time) (ReminderEscalationStep, bool) {
+	if r.EscalationPolicy == nil || r.Type != ReminderTypeDeadline || r.Status != ReminderStatusOverdue || r.AcknowledgedAt != nil {
+		return ReminderEscalationStep{}, false
+	}
+	if r.EscalationLevel >= len(r.EscalationPolicy.Steps) {
+		return ReminderEscalationStep{}, false
+	}
+	step := r.EscalationPolicy.Steps[r.EscalationLevel]
+	if now.Before(r.ScheduledTime.Add(step.After)) {
+		return ReminderEscalationStep{}, false
+	}
+	return step, true
+}
+
+// Escalate registra que se envió el siguiente paso de escalado
+func (r *Reminder) Escalate(now time.Time) {
+	r.EscalationLevel++
+	r.UpdatedAt = now
+}
+
+// Acknowledge confirma que el usuario vio el recordatorio; detiene el escalado
+func (r *Reminder) Acknowledge(now time.Time) {
+	if r.AcknowledgedAt == nil {
+		r.AcknowledgedAt = &now
+	}
+	r.UpdatedAt = now
+}
+
+// ResetEscalation vuelve a empezar el escalado, p. ej. al reprogramar el recordatorio
+func (r *Reminder) ResetEscalation() {
+	r.EscalationLevel = 0
+	r.AcknowledgedAt = nil
+}
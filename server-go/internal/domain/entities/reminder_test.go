@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminder_Assignment(t *testing.T) {
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	owner, assignee := uuid.New(), uuid.New()
+	reminder := NewReminder(NewFakeClock(now), UUIDGenerator{}, "Title", "", now.Add(time.Hour), ReminderTypeTask, owner, false, RecurrencePatternUnspecified, nil)
+
+	assert.ErrorIs(t, reminder.Assign(owner, now), ErrInvalidReminderAssignee)
+	require.NoError(t, reminder.Assign(assignee, now))
+	assert.True(t, reminder.IsVisibleTo(assignee))
+	// Hasta que acepte, el asignado lo ve pero no puede completarlo ni recibe sus avisos
+	assert.False(t, reminder.CanBeCompletedBy(assignee))
+	assert.Equal(t, []uuid.UUID{owner}, reminder.NotifiedUsers())
+
+	require.NoError(t, reminder.RespondToAssignment(true, now))
+	assert.True(t, reminder.CanBeCompletedBy(assignee))
+	assert.Equal(t, []uuid.UUID{owner, assignee}, reminder.NotifiedUsers())
+	assert.ErrorIs(t, reminder.RespondToAssignment(false, now), ErrReminderAssignmentNotPending)
+
+	// Reasignarlo vuelve a pedir la aceptación; al rechazarlo deja de ser visible para el asignado
+	require.NoError(t, reminder.Assign(assignee, now))
+	require.NoError(t, reminder.RespondToAssignment(false, now))
+	assert.False(t, reminder.IsVisibleTo(assignee))
+	assert.True(t, reminder.IsVisibleTo(owner))
+}
+
+func TestReminder_NextEscalation(t *testing.T) {
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	reminder := NewReminder(NewFakeClock(now), UUIDGenerator{}, "Title", "", now, ReminderTypeDeadline, uuid.New(), false, RecurrencePatternUnspecified, nil)
+	policy := &ReminderEscalationPolicy{
+		Steps: []ReminderEscalationStep{
+			{After: 10 * time.Minute, Channels: []string{"push"}},
+			{After: 30 * time.Minute, Channels: []string{"email"}, NotifyBackupContact: true},
+		},
+	}
+	assert.ErrorIs(t, reminder.SetEscalationPolicy(policy, now), ErrInvalidEscalationPolicy)
+	policy.BackupContactID = uuid.New()
+	require.NoError(t, reminder.SetEscalationPolicy(policy, now))
+
+	// Solo escala una vez vencido y cuando llega la hora de cada paso
+	_, due := reminder.NextEscalation(now.Add(time.Hour))
+	assert.False(t, due)
+	reminder.MarkAsOverdue(now)
+	_, due = reminder.NextEscalation(now.Add(5 * time.Minute))
+	assert.False(t, due)
+	step, due := reminder.NextEscalation(now.Add(10 * time.Minute))
+	require.True(t, due)
+	assert.Equal(t, []string{"push"}, step.Channels)
+	reminder.Escalate(now)
+	_, due = reminder.NextEscalation(now.Add(20 * time.Minute))
+	assert.False(t, due)
+
+	// La confirmación detiene el escalado
+	reminder.Acknowledge(now)
+	_, due = reminder.NextEscalation(now.Add(time.Hour))
+	assert.False(t, due)
+
+	reminder.Type = ReminderTypeTask
+	assert.ErrorIs(t, reminder.Validate(), ErrEscalationRequiresDeadline)
+}
@@ -0,0 +1,127 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// shareTokenBytes es la entropía de los tokens de descarga compartida
+const shareTokenBytes = 32
+
+// ShareLink representa un enlace de descarga de un archivo para personas que no son usuarios.
+// El token solo se conoce al crearlo; se guarda su hash.
+type ShareLink struct {
+	ID            uuid.UUID
+	FileID        uuid.UUID
+	UserID        uuid.UUID
+	TokenHash     string
+	PasswordHash  string
+	ExpiresAt     time.Time
+	MaxDownloads  int64
+	DownloadCount int64
+	CreatedAt     time.Time
+	RevokedAt     *time.Time
+}
+
+// ShareLinkAccessOutcome representa el resultado de un intento de descarga por enlace
+type ShareLinkAccessOutcome string
+
+const (
+	ShareLinkAccessGranted         ShareLinkAccessOutcome = "granted"
+	ShareLinkAccessExpired         ShareLinkAccessOutcome = "expired"
+	ShareLinkAccessRevoked         ShareLinkAccessOutcome = "revoked"
+	ShareLinkAccessInvalidPassword ShareLinkAccessOutcome = "invalid_password"
+	ShareLinkAccessLimitReached    ShareLinkAccessOutcome = "limit_reached"
+)
+
+// ShareLinkAccess es una entrada de auditoría de un intento de descarga por enlace
+type ShareLinkAccess struct {
+	ID         uuid.UUID
+	LinkID     uuid.UUID
+	Outcome    ShareLinkAccessOutcome
+	ClientIP   string
+	UserAgent  string
+	AccessedAt time.Time
+}
+
+// NewShareLink crea un enlace para fileID y devuelve también el token en claro
+func NewShareLink(clock Clock, ids IDGenerator, fileID, userID uuid.UUID, expiresAt time.Time, passwordHash string, maxDownloads int64) (*ShareLink, string, error) {
+	raw := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return &ShareLink{
+		ID:           ids.NewID(),
+		FileID:       fileID,
+		UserID:       userID,
+		TokenHash:    HashShareToken(token),
+		PasswordHash: passwordHash,
+		ExpiresAt:    expiresAt,
+		MaxDownloads: maxDownloads,
+		CreatedAt:    clock.Now(),
+	}, token, nil
+}
+
+// HashShareToken devuelve el hash con el que se guarda y busca un token
+func HashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate valida que el enlace sea correcto
+func (l *ShareLink) Validate() error {
+	if l.FileID == uuid.Nil {
+		return ErrShareLinkFileIDRequired
+	}
+	if l.UserID == uuid.Nil {
+		return ErrFileUserIDRequired
+	}
+	if !l.ExpiresAt.After(l.CreatedAt) {
+		return ErrShareLinkInvalidExpiry
+	}
+	if l.MaxDownloads < 0 {
+		return ErrShareLinkInvalidDownloadLimit
+	}
+	return nil
+}
+
+// IsOwnedBy verifica si el enlace fue creado por el usuario especificado
+func (l *ShareLink) IsOwnedBy(userID uuid.UUID) bool {
+	return l.UserID == userID
+}
+
+// IsRevoked indica si el enlace fue revocado
+func (l *ShareLink) IsRevoked() bool {
+	return l.RevokedAt != nil
+}
+
+// IsExpired indica si el enlace ya caducó en el instante now
+func (l *ShareLink) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
+
+// IsDownloadLimitReached indica si el enlace ya agotó sus descargas; sin límite nunca las agota
+func (l *ShareLink) IsDownloadLimitReached() bool {
+	return l.MaxDownloads > 0 && l.DownloadCount >= l.MaxDownloads
+}
+
+// HasPassword indica si el enlace está protegido con contraseña
+func (l *ShareLink) HasPassword() bool {
+	return l.PasswordHash != ""
+}
+
+// Revoke marca el enlace como revocado
+func (l *ShareLink) Revoke(clock Clock) {
+	if l.RevokedAt == nil {
+		now := clock.Now()
+		l.RevokedAt = &now
+	}
+}
@@ -0,0 +1,44 @@
+package entities
+
+// MaxSortFields es el máximo de criterios de ordenación de un listado
+const MaxSortFields = 3
+
+// SortField es un criterio de ordenación de un listado. Los listados se ordenan por sus criterios
+// en orden y, en caso de empate, por ID para que la paginación sea estable.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Campos por los que se permite ordenar cada listado
+var (
+	IdeaSortFields     = []string{"created_at", "updated_at", "title", "priority", "position"}
+	FileSortFields     = []string{"created_at", "filename", "size", "content_type"}
+	ReminderSortFields = []string{"scheduled_time", "created_at", "updated_at", "title", "type", "status"}
+	ProgressSortFields = []string{"created_at", "updated_at", "project_name", "completion_percentage"}
+)
+
+// ValidateSort verifica que sort tenga como mucho MaxSortFields criterios, todos de allowed y sin
+// repetir ninguno
+func ValidateSort(sort []SortField, allowed []string) error {
+	if len(sort) > MaxSortFields {
+		return ErrInvalidSortField
+	}
+	seen := make(map[string]bool, len(sort))
+	for _, field := range sort {
+		if seen[field.Field] || !containsSortField(allowed, field.Field) {
+			return ErrInvalidSortField
+		}
+		seen[field.Field] = true
+	}
+	return nil
+}
+
+func containsSortField(allowed []string, field string) bool {
+	for _, candidate := range allowed {
+		if candidate == field {
+			return true
+		}
+	}
+	return false
+}
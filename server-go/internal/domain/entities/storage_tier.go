@@ -0,0 +1,16 @@
+package entities
+
+// StorageTier es el nivel de almacenamiento en el que está el archivo físico de una versión
+type StorageTier string
+
+const (
+	// StorageTierHot es el almacenamiento principal, donde se guardan las subidas
+	StorageTierHot StorageTier = "hot"
+	// StorageTierCold es el almacenamiento más barato al que pasan los archivos sin uso reciente
+	StorageTierCold StorageTier = "cold"
+)
+
+// IsCold indica si el archivo físico de la versión está en el nivel frío
+func (f *FileInfo) IsCold() bool {
+	return f.StorageTier == StorageTierCold
+}
\ No newline at end of file
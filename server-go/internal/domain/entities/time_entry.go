@@ -0,0 +1,128 @@
+package entities
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeEntry es un intervalo de trabajo registrado en un hito. Cada usuario tiene como máximo un
+// cronómetro en marcha, el registro sin StoppedAt.
+type TimeEntry struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	ProgressID  uuid.UUID
+	MilestoneID uuid.UUID
+	StartedAt   time.Time
+	StoppedAt   *time.Time
+}
+
+// NewTimeEntry pone en marcha un cronómetro sobre un hito
+func NewTimeEntry(clock Clock, ids IDGenerator, userID, progressID, milestoneID uuid.UUID) *TimeEntry {
+	return &TimeEntry{
+		ID:          ids.NewID(),
+		UserID:      userID,
+		ProgressID:  progressID,
+		MilestoneID: milestoneID,
+		StartedAt:   clock.Now(),
+	}
+}
+
+// IsRunning indica si el cronómetro sigue en marcha
+func (e *TimeEntry) IsRunning() bool {
+	return e.StoppedAt == nil
+}
+
+// Stop detiene el cronómetro en now
+func (e *TimeEntry) Stop(now time.Time) {
+	if now.Before(e.StartedAt) {
+		now = e.StartedAt
+	}
+	e.StoppedAt = &now
+}
+
+// Duration devuelve el tiempo registrado; el de un cronómetro en marcha se cuenta hasta now
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.StoppedAt != nil {
+		end = *e.StoppedAt
+	}
+	if end.Before(e.StartedAt) {
+		return 0
+	}
+	return end.Sub(e.StartedAt)
+}
+
+// MilestoneEffort compara el esfuerzo estimado de un hito con el tiempo registrado en él
+type MilestoneEffort struct {
+	MilestoneID uuid.UUID
+	Name        string
+	Estimated   time.Duration
+	Actual      time.Duration
+}
+
+// DailyEffort es el tiempo registrado en el proyecto en un día (UTC)
+type DailyEffort struct {
+	Day    time.Time
+	Actual time.Duration
+}
+
+// ProgressEffort es el informe de esfuerzo estimado frente al real de un proyecto
+type ProgressEffort struct {
+	Milestones []MilestoneEffort
+	// Daily tiene solo los días con tiempo registrado, en orden cronológico
+	Daily     []DailyEffort
+	Estimated time.Duration
+	// Actual incluye el tiempo registrado en hitos que ya se eliminaron del proyecto
+	Actual time.Duration
+	// Running es el cronómetro en marcha en alguno de los hitos del proyecto, o nil
+	Running *TimeEntry
+}
+
+// NewProgressEffort resume los registros de tiempo de progress; el cronómetro en marcha cuenta
+// hasta now
+func NewProgressEffort(progress *Progress, entries []*TimeEntry, now time.Time) *ProgressEffort {
+	actual := make(map[uuid.UUID]time.Duration)
+	daily := make(map[time.Time]time.Duration)
+	effort := &ProgressEffort{}
+	for _, entry := range entries {
+		duration := entry.Duration(now)
+		actual[entry.MilestoneID] += duration
+		effort.Actual += duration
+		if entry.IsRunning() {
+			effort.Running = entry
+		}
+		splitByDay(entry.StartedAt, entry.StartedAt.Add(duration), daily)
+	}
+
+	for _, milestone := range progress.Milestones {
+		effort.Milestones = append(effort.Milestones, MilestoneEffort{
+			MilestoneID: milestone.ID,
+			Name:        milestone.Name,
+			Estimated:   milestone.EstimatedEffort,
+			Actual:      actual[milestone.ID],
+		})
+		effort.Estimated += milestone.EstimatedEffort
+	}
+
+	for day, duration := range daily {
+		effort.Daily = append(effort.Daily, DailyEffort{Day: day, Actual: duration})
+	}
+	sort.Slice(effort.Daily, func(i, j int) bool { return effort.Daily[i].Day.Before(effort.Daily[j].Day) })
+	return effort
+}
+
+// splitByDay reparte el intervalo [start, end) entre los días UTC que abarca
+func splitByDay(start, end time.Time, daily map[time.Time]time.Duration) {
+	start, end = start.UTC(), end.UTC()
+	for start.Before(end) {
+		day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		next := day.AddDate(0, 0, 1)
+		if next.After(end) {
+			next = end
+		}
+		daily[day] += next.Sub(start)
+		start = next
+	}
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProgressEffort(t *testing.T) {
+	// Arrange
+	ids := &SequentialIDGenerator{}
+	clock := NewFakeClock(time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC))
+	userID := uuid.New()
+	progress := NewProgress(clock, ids, userID, "Proyecto", "")
+	design := NewMilestone(ids, "Diseño", "", clock.Now())
+	design.EstimatedEffort = 3 * time.Hour
+	build := NewMilestone(ids, "Construcción", "", clock.Now())
+	build.EstimatedEffort = 5 * time.Hour
+	progress.AddMilestone(design, clock.Now())
+	progress.AddMilestone(build, clock.Now())
+
+	// Un registro cruza la medianoche y el otro sigue en marcha
+	overnight := NewTimeEntry(clock, ids, userID, progress.ID, design.ID)
+	overnight.Stop(clock.Now().Add(2 * time.Hour))
+	clock.Advance(26 * time.Hour)
+	running := NewTimeEntry(clock, ids, userID, progress.ID, build.ID)
+	now := clock.Now().Add(30 * time.Minute)
+
+	// Act
+	effort := NewProgressEffort(progress, []*TimeEntry{overnight, running}, now)
+
+	// Assert
+	assert.Equal(t, 8*time.Hour, effort.Estimated)
+	assert.Equal(t, 150*time.Minute, effort.Actual)
+	assert.Same(t, running, effort.Running)
+	require.Len(t, effort.Milestones, 2)
+	assert.Equal(t, 2*time.Hour, effort.Milestones[0].Actual)
+	assert.Equal(t, 30*time.Minute, effort.Milestones[1].Actual)
+	assert.Equal(t, []DailyEffort{
+		{Day: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Actual: time.Hour},
+		{Day: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Actual: time.Hour},
+		{Day: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), Actual: 30 * time.Minute},
+	}, effort.Daily)
+}
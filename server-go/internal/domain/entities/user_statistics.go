@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserStatistics es la proyección materializada con las estadísticas del panel de un usuario.
+// Se mantiene al día a partir de los eventos de dominio, recalculando solo la sección afectada,
+// para no agregar todas las tablas en cada carga del panel.
+type UserStatistics struct {
+	UserID          uuid.UUID
+	IdeasByStatus   map[IdeaStatus]int
+	IdeasByCategory map[IdeaCategory]int
+	// RemindersDueThisWeek cuenta los recordatorios sin completar ni cancelar que vencen en la
+	// semana que empieza en WeekStart
+	RemindersDueThisWeek int
+	WeekStart            time.Time
+	StorageFiles         int
+	StorageBytes         int64
+	UpdatedAt            time.Time
+}
+
+// TotalIdeas devuelve el número de ideas del usuario
+func (s *UserStatistics) TotalIdeas() int {
+	total := 0
+	for _, count := range s.IdeasByStatus {
+		total += count
+	}
+	return total
+}
+
+// StatisticsWeekStart devuelve el lunes a medianoche UTC de la semana de t
+func StatisticsWeekStart(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeeklyActivity cuenta la actividad de un usuario en un periodo
+type WeeklyActivity struct {
+	IdeasCreated       int
+	RemindersCompleted int
+	// OverdueReminders son los recordatorios sin completar ni cancelar vencidos al final del periodo
+	OverdueReminders int
+}
+
+// ProjectProgressDelta es el avance de un proyecto desde el resumen anterior
+type ProjectProgressDelta struct {
+	ProgressID  uuid.UUID `json:"progress_id"`
+	ProjectName string    `json:"project_name"`
+	// Previous es el porcentaje del resumen anterior, o 0 si el proyecto no aparecía en él
+	Previous float32 `json:"previous"`
+	Current  float32 `json:"current"`
+}
+
+// Delta devuelve los puntos porcentuales avanzados; negativo si el proyecto retrocedió
+func (d ProjectProgressDelta) Delta() float32 {
+	return d.Current - d.Previous
+}
+
+// WeeklySummary es el resumen de la semana de un usuario que se le envía por email. El último
+// enviado se guarda para calcular el avance de los proyectos en el siguiente.
+type WeeklySummary struct {
+	UserID      uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	WeeklyActivity
+	// OverdueMilestones son los hitos sin completar con fecha límite anterior al final del periodo
+	OverdueMilestones int
+	Projects          []ProjectProgressDelta
+	SentAt            time.Time
+}
+
+// NewWeeklySummary resume la semana que termina en periodEnd; previous es el resumen enviado
+// anteriormente, o nil si es el primero
+func NewWeeklySummary(userID uuid.UUID, periodEnd time.Time, activity WeeklyActivity, projects []*Progress, previous *WeeklySummary) *WeeklySummary {
+	summary := &WeeklySummary{
+		UserID:         userID,
+		PeriodStart:    periodEnd.AddDate(0, 0, -7),
+		PeriodEnd:      periodEnd,
+		WeeklyActivity: activity,
+	}
+
+	before := make(map[uuid.UUID]float32)
+	if previous != nil {
+		for _, project := range previous.Projects {
+			before[project.ProgressID] = project.Current
+		}
+	}
+	for _, project := range projects {
+		summary.Projects = append(summary.Projects, ProjectProgressDelta{
+			ProgressID:  project.ID,
+			ProjectName: project.ProjectName,
+			Previous:    before[project.ID],
+			Current:     project.CompletionPercentage,
+		})
+		for _, milestone := range project.Milestones {
+			if !milestone.Completed && !milestone.DueDate.IsZero() && milestone.DueDate.Before(periodEnd) {
+				summary.OverdueMilestones++
+			}
+		}
+	}
+	return summary
+}
+
+// ProgressDelta devuelve el total de puntos porcentuales avanzados en todos los proyectos
+func (s *WeeklySummary) ProgressDelta() float32 {
+	var total float32
+	for _, project := range s.Projects {
+		total += project.Delta()
+	}
+	return total
+}
+
+// IsEmpty indica si no hubo actividad ni quedan tareas pendientes, en cuyo caso no se envía
+func (s *WeeklySummary) IsEmpty() bool {
+	return s.IdeasCreated == 0 && s.RemindersCompleted == 0 && s.OverdueReminders == 0 &&
+		s.OverdueMilestones == 0 && s.ProgressDelta() == 0
+}
+
+// WeeklySummaryPeriodEnd devuelve el final de la última semana completa en now: el lunes a
+// medianoche UTC de la semana en curso
+func WeeklySummaryPeriodEnd(now time.Time) time.Time {
+	return StatisticsWeekStart(now)
+}
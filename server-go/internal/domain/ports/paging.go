@@ -0,0 +1,63 @@
+package ports
+
+// PageInfo describe la página devuelta por un listado. Total sigue el CountMode del listado:
+// con CountNone es solo una cota inferior que alcanza para saber si hay otra página.
+type PageInfo struct {
+	Page     int
+	PageSize int
+	Total    int
+	Count    CountMode
+	HasMore  bool
+}
+
+// NewPageInfo arma la información de una página a partir del total que devolvió el repositorio
+// para la misma página, ya ajustado con PageTotal
+func NewPageInfo(page, pageSize, total int, mode CountMode) PageInfo {
+	return PageInfo{
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+		Count:    mode,
+		HasMore:  pageSize > 0 && page*pageSize < total,
+	}
+}
+
+// NextPage devuelve el número de la página siguiente, o 0 si no hay más resultados
+func (p PageInfo) NextPage() int {
+	if !p.HasMore {
+		return 0
+	}
+	return p.Page + 1
+}
+
+// PrevPage devuelve el número de la página anterior, o 0 en la primera página
+func (p PageInfo) PrevPage() int {
+	if p.Page <= 1 {
+		return 0
+	}
+	return p.Page - 1
+}
+
+// PageTotal quita la fila de más que piden los repositorios sin conteo para saber si hay otra
+// página y completa el total que no se contó según lo que se vio al leer la página
+func PageTotal[T any](items []T, total, page, pageSize int, mode CountMode) ([]T, int) {
+	if mode == CountExact {
+		return items, total
+	}
+	if pageSize <= 0 {
+		return items, len(items)
+	}
+
+	seen := (page-1)*pageSize + len(items)
+	if mode == CountNone {
+		if len(items) > pageSize {
+			return items[:pageSize], seen
+		}
+		return items, seen
+	}
+	if len(items) > 0 && len(items) < pageSize {
+		// Última página: el total ya es exacto
+		return items, seen
+	}
+	return items, max(total, seen)
+}
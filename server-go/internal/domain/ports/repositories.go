@@ -2,36 +2,81 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	"github.com/google/uuid"
 )
 
+// Los mocks de todas las interfaces de ports se generan en ports/mocks; hay que regenerarlos con
+// go generate ./... después de cambiar una interfaz
+//go:generate mockery --all --with-expecter --case underscore --output ./mocks --outpkg mocks
+
 // IdeaRepository define la interfaz para el repositorio de ideas
 type IdeaRepository interface {
 	Create(ctx context.Context, idea *entities.Idea) error
+	// GetByID devuelve el contenido completo de las ideas compactadas y las saca del archivo
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Idea, error)
+	// GetByUserID devuelve las ideas compactadas con solo el extracto de su contenido
 	GetByUserID(ctx context.Context, userID uuid.UUID, filters IdeaFilters) ([]*entities.Idea, int, error)
 	Update(ctx context.Context, idea *entities.Idea) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByStatus recorre las ideas de todos los usuarios en esos estados ordenadas por ID,
+	// empezando después de afterID (uuid.Nil para empezar desde el principio)
+	ListByStatus(ctx context.Context, statuses []entities.IdeaStatus, afterID uuid.UUID, limit int) ([]*entities.Idea, error)
+	// Search busca las palabras de query en el título y el contenido de las ideas del usuario y
+	// devuelve hasta limit ideas, las más relevantes primero
+	Search(ctx context.Context, userID uuid.UUID, query string, limit int) ([]*entities.Idea, error)
 }
 
 // ReminderRepository define la interfaz para el repositorio de recordatorios
 type ReminderRepository interface {
 	Create(ctx context.Context, reminder *entities.Reminder) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Reminder, error)
+	// GetByUserID incluye, según filters.Scope, los recordatorios asignados al usuario
 	GetByUserID(ctx context.Context, userID uuid.UUID, filters ReminderFilters) ([]*entities.Reminder, int, error)
 	Update(ctx context.Context, reminder *entities.Reminder) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetOverdueReminders(ctx context.Context) ([]*entities.Reminder, error)
+	// GetEscalationCandidates obtiene los recordatorios Deadline vencidos y sin confirmar que
+	// tienen política de escalado, les toque o no el siguiente paso
+	GetEscalationCandidates(ctx context.Context) ([]*entities.Reminder, error)
+	// GetUpcomingByIdeaIDs obtiene los recordatorios sin completar ni cancelar vinculados a esas
+	// ideas que vencen antes de before, incluidos los ya vencidos
+	GetUpcomingByIdeaIDs(ctx context.Context, ideaIDs []uuid.UUID, before time.Time) ([]*entities.Reminder, error)
+	// GetByMilestoneID obtiene los recordatorios vinculados al hito, en cualquier estado
+	GetByMilestoneID(ctx context.Context, milestoneID uuid.UUID) ([]*entities.Reminder, error)
 }
 
 // FileRepository define la interfaz para el repositorio de archivos
 type FileRepository interface {
 	Create(ctx context.Context, fileInfo *entities.FileInfo) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.FileInfo, error)
+	// GetByUserID devuelve solo la última versión de cada archivo
 	GetByUserID(ctx context.Context, userID uuid.UUID, filters FileFilters) ([]*entities.FileInfo, int, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// GetLatestVersion devuelve la última versión del archivo del usuario con ese nombre,
+	// o entities.ErrFileNotFound si no existe
+	GetLatestVersion(ctx context.Context, userID uuid.UUID, filename string) (*entities.FileInfo, error)
+	// ListVersions devuelve las versiones de un archivo, de la más reciente a la más antigua
+	ListVersions(ctx context.Context, logicalID uuid.UUID) ([]*entities.FileInfo, error)
+	// CountByPath cuenta las versiones que referencian un archivo físico
+	CountByPath(ctx context.Context, path string) (int, error)
+	// FindByChecksum devuelve la versión más reciente del usuario con ese checksum SHA-256 y ese
+	// tamaño, o entities.ErrFileNotFound si no existe
+	FindByChecksum(ctx context.Context, userID uuid.UUID, checksum string, size int64) (*entities.FileInfo, error)
+	GetStorageUsage(ctx context.Context, userID uuid.UUID) (*StorageUsage, error)
+	// ListAll recorre las versiones de todos los usuarios ordenadas por ID, empezando
+	// después de afterID (uuid.Nil para empezar desde el principio)
+	ListAll(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.FileInfo, error)
+	// ListTierCandidates devuelve hasta limit rutas de archivos físicos del nivel tier cuya
+	// última versión y último cambio de nivel son anteriores a before
+	ListTierCandidates(ctx context.Context, tier entities.StorageTier, before time.Time, limit int) ([]string, error)
+	// MoveStorage cambia la ruta y el nivel de todas las versiones que referencian oldPath
+	// y devuelve cuántas se actualizaron
+	MoveStorage(ctx context.Context, oldPath, newPath string, tier entities.StorageTier, movedAt time.Time) (int, error)
+	// GetTierUsage resume el almacenamiento de todos los usuarios por nivel
+	GetTierUsage(ctx context.Context) ([]TierUsage, error)
 }
 
 // ProgressRepository define la interfaz para el repositorio de progreso
@@ -39,21 +84,292 @@ type ProgressRepository interface {
 	Create(ctx context.Context, progress *entities.Progress) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Progress, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.Progress, error)
+	// List devuelve la página de progreso del usuario que cumple filters y el total antes de paginar
+	List(ctx context.Context, userID uuid.UUID, filters ProgressFilters) ([]*entities.Progress, int, error)
 	Update(ctx context.Context, progress *entities.Progress) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// ProgressTemplateRepository define la interfaz para las plantillas de progreso
+type ProgressTemplateRepository interface {
+	Create(ctx context.Context, template *entities.ProgressTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.ProgressTemplate, error)
+	// ListByOwner devuelve las plantillas del usuario, compartidas o no, ordenadas por nombre
+	ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*entities.ProgressTemplate, error)
+	// ListByOrganization devuelve las plantillas compartidas con organization, ordenadas por nombre
+	ListByOrganization(ctx context.Context, organization string) ([]*entities.ProgressTemplate, error)
+	Update(ctx context.Context, template *entities.ProgressTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// TimeEntryRepository define la interfaz para el tiempo registrado en los hitos
+type TimeEntryRepository interface {
+	// Create devuelve entities.ErrTimerAlreadyRunning si el usuario ya tiene un cronómetro en marcha
+	Create(ctx context.Context, entry *entities.TimeEntry) error
+	// Stop guarda el fin de un cronómetro en marcha; devuelve entities.ErrNoRunningTimer si ya se detuvo
+	Stop(ctx context.Context, entry *entities.TimeEntry) error
+	// GetRunning devuelve el cronómetro en marcha del usuario, o entities.ErrNoRunningTimer
+	GetRunning(ctx context.Context, userID uuid.UUID) (*entities.TimeEntry, error)
+	// ListByProgress devuelve los registros de un proyecto ordenados por inicio
+	ListByProgress(ctx context.Context, progressID uuid.UUID) ([]*entities.TimeEntry, error)
+}
+
+// FileTextRepository define la interfaz para el texto extraído de los archivos
+type FileTextRepository interface {
+	// Upsert guarda el texto de un archivo, reemplazando el de una extracción anterior
+	Upsert(ctx context.Context, text *entities.FileText) error
+}
+
+// IdeaEmbeddingRepository define la interfaz para los vectores de contenido de las ideas
+type IdeaEmbeddingRepository interface {
+	// Upsert guarda el vector de una idea, reemplazando el anterior
+	Upsert(ctx context.Context, embedding *entities.IdeaEmbedding) error
+	GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaEmbedding, error)
+	// Nearest devuelve las limit ideas del usuario cuyos vectores del modelo model son más
+	// parecidos a vector, las más parecidas primero
+	Nearest(ctx context.Context, userID uuid.UUID, model string, vector []float32, limit int) ([]IdeaMatch, error)
+}
+
+// IdeaReviewRepository define la interfaz para las ideas inscritas en el repaso espaciado
+type IdeaReviewRepository interface {
+	// Create inscribe una idea; devuelve ErrIdeaAlreadyEnrolled si ya lo estaba
+	Create(ctx context.Context, review *entities.IdeaReview) error
+	GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaReview, error)
+	// Update guarda un repaso si nadie modificó la inscripción desde que se leyó; si no,
+	// devuelve ErrVersionConflict
+	Update(ctx context.Context, review *entities.IdeaReview) error
+	Delete(ctx context.Context, ideaID uuid.UUID) error
+	// ListDue devuelve hasta limit inscripciones del usuario que vencen antes de until, las más
+	// atrasadas primero, y el total de las que vencen
+	ListDue(ctx context.Context, userID uuid.UUID, until time.Time, limit int) ([]*entities.IdeaReview, int, error)
+}
+
+// IdeaPublicationRepository define la interfaz para las publicaciones de ideas
+type IdeaPublicationRepository interface {
+	Create(ctx context.Context, publication *entities.IdeaPublication) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.IdeaPublication, error)
+	GetBySlug(ctx context.Context, slug string) (*entities.IdeaPublication, error)
+	// GetCurrentByIdeaID obtiene la publicación no retirada de una idea, aunque haya caducado
+	GetCurrentByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaPublication, error)
+	// ListByUserID obtiene las publicaciones no retiradas del usuario, de la más reciente a la más antigua
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.IdeaPublication, error)
+	SetExpiry(ctx context.Context, id uuid.UUID, expiresAt *time.Time) error
+	// Unpublish retira una publicación; retirarla dos veces conserva la primera fecha
+	Unpublish(ctx context.Context, id uuid.UUID, unpublishedAt time.Time) error
+	// RecordView suma una visita y guarda su fecha
+	RecordView(ctx context.Context, id uuid.UUID, viewedAt time.Time) error
+	// RecordViews suma de una vez visitas acumuladas; las publicaciones que ya no existen se ignoran
+	RecordViews(ctx context.Context, views []PublicationViews) error
+}
+
+// PublicationViews son las visitas acumuladas de una publicación pendientes de guardar
+type PublicationViews struct {
+	PublicationID uuid.UUID
+	Count         int64
+	LastViewedAt  time.Time
+}
+
+// PublicationViewRecorder define dónde se cuentan las visitas a las publicaciones: el
+// repositorio directamente o un acumulador que las guarda por lotes
+type PublicationViewRecorder interface {
+	RecordView(ctx context.Context, id uuid.UUID, viewedAt time.Time) error
+}
+
+// ShareLinkRepository define la interfaz para el repositorio de enlaces de descarga compartida
+type ShareLinkRepository interface {
+	Create(ctx context.Context, link *entities.ShareLink) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.ShareLink, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.ShareLink, error)
+	ListByFileID(ctx context.Context, fileID uuid.UUID) ([]*entities.ShareLink, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	// IncrementDownloads suma una descarga de forma atómica; devuelve
+	// entities.ErrShareLinkDownloadLimitReached si el enlace ya alcanzó su límite.
+	IncrementDownloads(ctx context.Context, id uuid.UUID) error
+	RecordAccess(ctx context.Context, access *entities.ShareLinkAccess) error
+}
+
+// InboundAddressRepository define la interfaz para el repositorio de direcciones de entrada
+type InboundAddressRepository interface {
+	Create(ctx context.Context, address *entities.InboundAddress) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.InboundAddress, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.InboundAddress, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.InboundAddress, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	// MarkUsed registra la fecha del último mensaje recibido en la dirección
+	MarkUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+// ChatBindingRepository define la interfaz para el repositorio de vínculos con chats de Slack y Telegram
+type ChatBindingRepository interface {
+	Create(ctx context.Context, binding *entities.ChatBinding) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.ChatBinding, error)
+	// GetPendingByCodeHash obtiene el vínculo pendiente de provider con el código indicado
+	GetPendingByCodeHash(ctx context.Context, provider entities.ChatProvider, codeHash string) (*entities.ChatBinding, error)
+	// GetByChat obtiene el vínculo confirmado de un chat
+	GetByChat(ctx context.Context, provider entities.ChatProvider, chatID string) (*entities.ChatBinding, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.ChatBinding, error)
+	// Link guarda la confirmación del vínculo; devuelve entities.ErrChatAlreadyBound si el chat ya está vinculado
+	Link(ctx context.Context, binding *entities.ChatBinding) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// LocalePreferenceRepository define la interfaz para el repositorio del idioma preferido de cada usuario
+type LocalePreferenceRepository interface {
+	// GetLocale devuelve entities.ErrLocalePreferenceNotFound si el usuario no eligió un idioma
+	GetLocale(ctx context.Context, userID uuid.UUID) (string, error)
+	SetLocale(ctx context.Context, userID uuid.UUID, locale string, updatedAt time.Time) error
+}
+
+// IdeaArchiveRepository define la interfaz para el archivo comprimido de las ideas archivadas.
+// Una idea compactada conserva en ideas una fila con un extracto de su contenido para los listados
+// y la búsqueda; IdeaRepository.GetByID la restaura al accederse.
+type IdeaArchiveRepository interface {
+	// ListCompactionCandidates devuelve hasta limit ideas archivadas sin compactar cuya última
+	// modificación es anterior a before
+	ListCompactionCandidates(ctx context.Context, before time.Time, limit int) ([]*entities.Idea, error)
+	// Compact guarda comprimido el contenido de idea y deja en su fila solo el extracto. Devuelve
+	// entities.ErrVersionConflict si la idea cambió desde que se leyó.
+	Compact(ctx context.Context, idea *entities.Idea, compactedAt time.Time) error
+}
+
+// StatisticsRepository define la interfaz para las estadísticas materializadas de cada usuario.
+// Cada Refresh recalcula una sola sección de un usuario con consultas acotadas a sus filas.
+type StatisticsRepository interface {
+	// Get devuelve entities.ErrStatisticsNotFound si aún no se calcularon las del usuario
+	Get(ctx context.Context, userID uuid.UUID) (*entities.UserStatistics, error)
+	RefreshIdeas(ctx context.Context, userID uuid.UUID, now time.Time) error
+	// RefreshReminders cuenta los recordatorios que vencen en la semana que empieza en weekStart
+	RefreshReminders(ctx context.Context, userID uuid.UUID, weekStart, now time.Time) error
+	RefreshStorage(ctx context.Context, userID uuid.UUID, now time.Time) error
+}
+
+// ClientMetricRepository define la interfaz para la telemetría enviada por la app
+type ClientMetricRepository interface {
+	// CreateBatch guarda los eventos en una sola sentencia; los que ya estaban guardados se ignoran,
+	// así que un lote se puede reintentar
+	CreateBatch(ctx context.Context, metrics []*entities.ClientMetric) error
+	DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// EmailPreferenceRepository define la interfaz para las direcciones de email de los usuarios
+type EmailPreferenceRepository interface {
+	// Get devuelve entities.ErrEmailPreferenceNotFound si el usuario no registró una dirección
+	Get(ctx context.Context, userID uuid.UUID) (*entities.EmailPreference, error)
+	Upsert(ctx context.Context, preference *entities.EmailPreference) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// ListWeeklySummaryRecipients recorre los usuarios que aceptaron el resumen semanal ordenados
+	// por ID, empezando después de afterID (uuid.Nil para empezar desde el principio)
+	ListWeeklySummaryRecipients(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.EmailPreference, error)
+}
+
+// WeeklySummaryRepository define la interfaz para los datos del resumen semanal
+type WeeklySummaryRepository interface {
+	// CountActivity cuenta la actividad del usuario entre from (inclusive) y to
+	CountActivity(ctx context.Context, userID uuid.UUID, from, to time.Time) (entities.WeeklyActivity, error)
+	// GetLatest devuelve el último resumen enviado, o entities.ErrWeeklySummaryNotFound
+	GetLatest(ctx context.Context, userID uuid.UUID) (*entities.WeeklySummary, error)
+	// SaveLatest reemplaza el último resumen enviado del usuario
+	SaveLatest(ctx context.Context, summary *entities.WeeklySummary) error
+}
+
+// ModerationRejectionRepository define la interfaz para la auditoría de contenidos rechazados
+type ModerationRejectionRepository interface {
+	Create(ctx context.Context, rejection *entities.ModerationRejection) error
+	// List devuelve los rechazos más recientes primero; organization vacía incluye todas
+	List(ctx context.Context, organization string, limit int) ([]*entities.ModerationRejection, error)
+}
+
+// CustomFieldRepository define la interfaz para las definiciones de campos personalizados
+type CustomFieldRepository interface {
+	// Create devuelve ErrCustomFieldKeyExists si el usuario ya tiene un campo con esa clave para
+	// el mismo tipo de registro
+	Create(ctx context.Context, definition *entities.CustomFieldDefinition) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.CustomFieldDefinition, error)
+	// ListByUserID devuelve los campos del usuario para entityType, ordenados por creación
+	ListByUserID(ctx context.Context, userID uuid.UUID, entityType entities.CustomFieldEntity) ([]*entities.CustomFieldDefinition, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// PhoneNumberRepository define la interfaz para el repositorio de teléfonos de los usuarios y
+// del consumo diario de SMS
+type PhoneNumberRepository interface {
+	// Save crea o reemplaza el teléfono del usuario
+	Save(ctx context.Context, phone *entities.PhoneNumber) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.PhoneNumber, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// ReserveSMS suma un SMS al consumo del usuario en day si no llegó a limit; devuelve false si ya llegó
+	ReserveSMS(ctx context.Context, userID uuid.UUID, day time.Time, limit int) (bool, error)
+}
+
+// NotificationInbox define la interfaz para el buzón persistente de notificaciones enviadas,
+// usado para reenviar las que un cliente no recibió mientras estaba desconectado
+type NotificationInbox interface {
+	Append(ctx context.Context, notification Notification) error
+	// ListAfter devuelve en orden de envío las notificaciones del usuario posteriores a afterID.
+	// Devuelve entities.ErrNotificationNotFound si afterID no existe o ya fue depurada.
+	ListAfter(ctx context.Context, userID, afterID uuid.UUID, limit int) ([]Notification, error)
+	// List devuelve una página de las notificaciones del usuario, de la más reciente a la más
+	// antigua, junto con el total según filters.Count
+	List(ctx context.Context, userID uuid.UUID, filters NotificationFilters) ([]Notification, int, error)
+	DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// UnitOfWork define la interfaz para agrupar escrituras de varios repositorios en una transacción
+type UnitOfWork interface {
+	Begin(ctx context.Context) (Tx, error)
+}
+
+// Tx representa una transacción en curso con repositorios ligados a ella
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+	Ideas() IdeaRepository
+	Files() FileRepository
+}
+
+// IdeaMatch es una idea encontrada por similitud de vectores; Similarity es la similitud coseno
+type IdeaMatch struct {
+	IdeaID     uuid.UUID
+	Similarity float64
+}
+
 // Filtros para consultas
 
+// CountMode elige cómo calculan los listados el total de resultados, que con COUNT(*) es la
+// parte más lenta en tablas grandes
+type CountMode int
+
+const (
+	// CountExact cuenta todas las filas que cumplen los filtros
+	CountExact CountMode = iota
+	// CountNone no cuenta: el total devuelto es solo una cota inferior, las filas hasta el final
+	// de la página más una si hay otra página después
+	CountNone
+	// CountEstimated usa la estimación del planificador de PostgreSQL; donde no hay estimación
+	// barata se cuenta exacto. Nunca es menor que las filas ya vistas.
+	CountEstimated
+)
+
+// IsValid verifica si el modo es uno de los definidos
+func (m CountMode) IsValid() bool {
+	return m >= CountExact && m <= CountEstimated
+}
+
 // IdeaFilters contiene los filtros para buscar ideas
 type IdeaFilters struct {
 	Category entities.IdeaCategory
 	Status   entities.IdeaStatus
 	Tags     []string
-	Page     int
-	PageSize int
-	SortBy   string
-	SortDesc bool
+	// CustomFields deben cumplirse todos
+	CustomFields []entities.CustomFieldFilter
+	Page         int
+	PageSize     int
+	// Sort usa campos de entities.IdeaSortFields; vacío ordena por fecha de creación
+	Sort  []entities.SortField
+	Count CountMode
+	// WithoutContent deja vacío el contenido de las ideas, que no se lee de la base de datos;
+	// lo usan las listas que solo muestran el título y los metadatos
+	WithoutContent bool
 }
 
 // ReminderFilters contiene los filtros para buscar recordatorios
@@ -62,15 +378,57 @@ type ReminderFilters struct {
 	Status   entities.ReminderStatus
 	FromDate *string // ISO 8601 format
 	ToDate   *string // ISO 8601 format
+	Scope    entities.ReminderScope
 	Page     int
 	PageSize int
+	// Sort usa campos de entities.ReminderSortFields; vacío ordena por fecha programada
+	Sort  []entities.SortField
+	Count CountMode
+}
+
+// ProgressFilters contiene los filtros para listar el progreso de un usuario
+type ProgressFilters struct {
+	MinCompletion *float32 // porcentaje mínimo, inclusive
+	MaxCompletion *float32 // porcentaje máximo, inclusive
+	Completed     *bool    // true: solo proyectos al 100%; false: solo los pendientes
+	CustomFields  []entities.CustomFieldFilter
+	Page          int
+	PageSize      int
+	// Sort usa campos de entities.ProgressSortFields; vacío ordena del más reciente al más antiguo
+	Sort []entities.SortField
 }
 
-// FileFilters contiene los filtros para buscar archivos
+// StorageUsage resume el almacenamiento de un usuario; los archivos físicos
+// compartidos por varias versiones se cuentan una sola vez
+type StorageUsage struct {
+	Files    int
+	Versions int
+	Bytes    int64
+	Tiers    []TierUsage
+}
+
+// TierUsage resume los archivos físicos de un nivel de almacenamiento
+type TierUsage struct {
+	Tier  entities.StorageTier
+	Blobs int
+	Bytes int64
+}
+
+// FileFilters contiene los filtros para buscar archivos; SearchQuery busca en el
+// nombre del archivo y en el texto extraído de su contenido
 type FileFilters struct {
 	ContentTypeFilter string
+	SearchQuery       string
 	Page              int
 	PageSize          int
-	SortBy            string
-	SortDesc          bool
+	// Sort usa campos de entities.FileSortFields; vacío ordena por fecha de creación
+	Sort  []entities.SortField
+	Count CountMode
+}
+
+// NotificationFilters contiene la paginación del buzón de notificaciones
+type NotificationFilters struct {
+	Page     int
+	PageSize int
+	Count    CountMode
 }
\ No newline at end of file
@@ -3,7 +3,9 @@ package ports
 import (
 	"context"
 	"io"
+	"time"
 
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	"github.com/google/uuid"
 )
 
@@ -16,6 +18,116 @@ type FileStorageService interface {
 	DecompressFile(data []byte, compressionType string) ([]byte, error)
 }
 
+// StorageInventory define la interfaz para recorrer los archivos físicos del almacenamiento
+type StorageInventory interface {
+	// Walk llama a fn con cada archivo almacenado; Path tiene el formato que devuelve StoreFile
+	Walk(ctx context.Context, fn func(blob StoredBlob) error) error
+	Exists(ctx context.Context, path string) (bool, error)
+}
+
+// StoredBlob representa un archivo físico del almacenamiento
+type StoredBlob struct {
+	Path       string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// StorageTiering define la interfaz para copiar archivos físicos entre niveles de almacenamiento;
+// las rutas que devuelve se leen y eliminan con el mismo FileStorageService
+type StorageTiering interface {
+	// CopyToTier copia el archivo en path al nivel tier y devuelve su nueva ruta; el original se conserva
+	CopyToTier(ctx context.Context, path string, tier entities.StorageTier) (string, error)
+}
+
+// TextExtractor define la interfaz para extraer el texto de un archivo (OCR, capa de texto de PDF, etc.)
+type TextExtractor interface {
+	// Name identifica al extractor en el texto guardado
+	Name() string
+	Supports(contentType string) bool
+	Extract(ctx context.Context, contentType string, reader io.Reader) (string, error)
+}
+
+// TextExtractionQueue define la interfaz para encolar la extracción asíncrona de texto de un archivo
+type TextExtractionQueue interface {
+	EnqueueTextExtraction(ctx context.Context, fileID uuid.UUID) error
+}
+
+// SMSSender define la interfaz para enviar un SMS a un teléfono en formato E.164
+type SMSSender interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// EmailMessage es un email con una versión HTML y otra en texto plano
+type EmailMessage struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// EmailSender define la interfaz para enviar emails
+type EmailSender interface {
+	SendEmail(ctx context.Context, message EmailMessage) error
+}
+
+// RenderedReport es un informe listo para enviar por email
+type RenderedReport struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// ReportRenderer define la interfaz para redactar los informes periódicos de los usuarios
+type ReportRenderer interface {
+	// RenderWeeklySummary redacta el resumen en locale, o en inglés si no hay traducción
+	RenderWeeklySummary(summary *entities.WeeklySummary, locale string) (*RenderedReport, error)
+}
+
+// ReminderEscalationQueue define la interfaz para encolar el siguiente paso de escalado de un recordatorio
+type ReminderEscalationQueue interface {
+	EnqueueReminderEscalation(ctx context.Context, reminderID uuid.UUID) error
+}
+
+// EmbeddingService define la interfaz para calcular los vectores de contenido usados por la búsqueda semántica
+type EmbeddingService interface {
+	// Model identifica el modelo; los vectores de modelos distintos no son comparables
+	Model() string
+	// Embed devuelve un vector por cada texto, en el mismo orden
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// IdeaEmbeddingQueue define la interfaz para encolar el cálculo asíncrono del vector de una idea
+type IdeaEmbeddingQueue interface {
+	EnqueueIdeaEmbedding(ctx context.Context, ideaID uuid.UUID) error
+}
+
+// ClientMetricQueue define la interfaz para encolar el guardado asíncrono de la telemetría de la app
+type ClientMetricQueue interface {
+	EnqueueClientMetrics(ctx context.Context, metrics []*entities.ClientMetric) error
+}
+
+// IdeaClassifier define la interfaz para proponer etiquetas y categoría a partir del texto de una idea
+type IdeaClassifier interface {
+	// Name identifica al clasificador en las sugerencias
+	Name() string
+	Classify(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error)
+}
+
+// ContentModerator define la interfaz para revisar el texto que un usuario comparte con otros
+type ContentModerator interface {
+	// Name identifica al moderador en la auditoría de rechazos
+	Name() string
+	// Moderate revisa text con la política de la organización, que puede añadir términos bloqueados
+	Moderate(ctx context.Context, text string, policy entities.ModerationPolicy) (*entities.ModerationVerdict, error)
+}
+
+// PreviewExtractor define la interfaz para extraer metadatos de vista previa mientras se almacena un archivo
+type PreviewExtractor interface {
+	// Wrap devuelve el reader que debe almacenarse en lugar de reader (por ejemplo, sin la ubicación GPS)
+	// y una función que, una vez leído por completo, devuelve los metadatos encontrados o nil
+	Wrap(contentType string, reader io.Reader) (io.Reader, func() *entities.FilePreview)
+}
+
 // NotificationService define la interfaz para el servicio de notificaciones
 type NotificationService interface {
 	SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error
@@ -25,12 +137,30 @@ type NotificationService interface {
 
 // Notification representa una notificación
 type Notification struct {
-	ID       uuid.UUID
-	Title    string
-	Message  string
-	Type     string
-	UserID   uuid.UUID
-	Metadata map[string]string
+	ID        uuid.UUID
+	Title     string
+	Message   string
+	Type      string
+	UserID    uuid.UUID
+	Channels  []string
+	Metadata  map[string]string
+	CreatedAt time.Time
+}
+
+// MatchesChannels indica si la notificación debe entregarse a una suscripción a subscribed.
+// Una suscripción sin canales recibe todo y una notificación sin canales va a todas las suscripciones.
+func (n Notification) MatchesChannels(subscribed []string) bool {
+	if len(subscribed) == 0 || len(n.Channels) == 0 {
+		return true
+	}
+	for _, channel := range n.Channels {
+		for _, wanted := range subscribed {
+			if channel == wanted {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // CompressionService define la interfaz para el servicio de compresión
@@ -47,4 +177,52 @@ type EventBus interface {
 }
 
 // EventHandler define el manejador de eventos
-type EventHandler func(ctx context.Context, event interface{}) error
\ No newline at end of file
+type EventHandler func(ctx context.Context, event interface{}) error
+
+// ReadCache define la interfaz para la caché de respuestas de lectura, que se invalida cuando
+// cambian los datos de un usuario
+type ReadCache interface {
+	InvalidateUser(ctx context.Context, userID uuid.UUID)
+}
+
+// ChangeFeed define la interfaz para el flujo de cambios de entidades persistidas
+type ChangeFeed interface {
+	Subscribe(ctx context.Context) (<-chan EntityChange, error)
+}
+
+// EntityChange representa un cambio en una fila persistida
+type EntityChange struct {
+	Table     string
+	Operation string // INSERT, UPDATE o DELETE
+	EntityID  uuid.UUID
+	UserID    uuid.UUID
+	Version   int64
+}
+
+// QueryDiagnostics define la interfaz para consultar las sentencias SQL más lentas
+type QueryDiagnostics interface {
+	TopSlowQueries(limit int) []SlowQuery
+}
+
+// SlowQuery representa una sentencia que superó el umbral de consulta lenta
+type SlowQuery struct {
+	Name       string
+	SQL        string
+	Duration   time.Duration
+	ExecutedAt time.Time
+	ArgCount   int
+	RequestID  string
+}
+
+// DistributedLocker define la interfaz para locks compartidos entre réplicas del servidor
+type DistributedLocker interface {
+	// TryLock intenta adquirir el lock sin bloquear; devuelve entities.ErrLockNotAcquired si otra instancia lo tiene
+	TryLock(ctx context.Context, name string) (LockLease, error)
+}
+
+// LockLease representa un lock adquirido
+type LockLease interface {
+	// Lost se cierra si el lock se pierde antes de liberarlo (por ejemplo, al caer la conexión)
+	Lost() <-chan struct{}
+	Release(ctx context.Context) error
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WithModeration habilita la consulta de la auditoría de contenidos rechazados
+func WithModeration(moderation *usecases.ModerationUseCases) AdminOption {
+	return func(s *AdminServer) {
+		s.moderation = moderation
+	}
+}
+
+// ListModerationRejections lista los contenidos rechazados más recientes de una organización
+func (s *AdminServer) ListModerationRejections(ctx context.Context, req *pb.ListModerationRejectionsRequest) (*pb.ListModerationRejectionsResponse, error) {
+	if s.moderation == nil {
+		return &pb.ListModerationRejectionsResponse{
+			Success: false,
+			Message: "Content moderation is not configured",
+		}, status.Error(codes.Unavailable, "content moderation not configured")
+	}
+
+	rejections, err := s.moderation.ListRejections(ctx, req.Organization, int(req.Limit))
+	if err != nil {
+		return &pb.ListModerationRejectionsResponse{
+			Success: false,
+			Message: "Failed to list moderation rejections",
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	protoRejections := make([]*pb.ModerationRejection, len(rejections))
+	for i, rejection := range rejections {
+		protoRejections[i] = &pb.ModerationRejection{
+			Id:           rejection.ID.String(),
+			UserId:       rejection.UserID.String(),
+			Organization: rejection.Organization,
+			Scope:        string(rejection.Scope),
+			EntityType:   rejection.EntityType,
+			EntityId:     rejection.EntityID.String(),
+			Reason:       rejection.Reason,
+			Matches:      rejection.Matches,
+			Moderator:    rejection.Moderator,
+			CreatedAt:    timestamppb.New(rejection.CreatedAt),
+		}
+	}
+
+	return &pb.ListModerationRejectionsResponse{
+		Rejections: protoRejections,
+		Success:    true,
+		Message:    "Moderation rejections retrieved successfully",
+	}, nil
+}
@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/replication"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StreamChanges envía a una réplica los cambios de esta instancia hasta que se cierre la conexión
+func (s *AdminServer) StreamChanges(req *pb.StreamChangesRequest, stream pb.AdminService_StreamChangesServer) error {
+	if s.publisher == nil {
+		return status.Error(codes.FailedPrecondition, "change stream is not enabled")
+	}
+
+	err := s.publisher.Stream(stream.Context(), req.AfterSequence, func(event replication.Event) error {
+		return stream.Send(replicationEventToProto(event))
+	})
+	if errors.Is(err, replication.ErrFollowerTooSlow) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil && stream.Context().Err() == nil {
+		return status.Error(codes.Unavailable, fmt.Sprintf("change stream ended: %v", err))
+	}
+	return nil
+}
+
+// GetReplicationStatus devuelve el rol de la instancia y, en una réplica, el estado del flujo de cambios
+func (s *AdminServer) GetReplicationStatus(ctx context.Context, req *pb.GetReplicationStatusRequest) (*pb.GetReplicationStatusResponse, error) {
+	if s.replica == nil {
+		return &pb.GetReplicationStatusResponse{
+			Success: false,
+			Message: "Replication is not configured",
+		}, status.Error(codes.Unavailable, "replication not configured")
+	}
+
+	return &pb.GetReplicationStatusResponse{
+		Status:  s.replicationStatus(),
+		Success: true,
+		Message: "Replication status retrieved successfully",
+	}, nil
+}
+
+// PromoteToPrimary convierte una réplica en primario. No aísla al primario anterior:
+// debe estar caído o fuera de servicio antes de promover
+func (s *AdminServer) PromoteToPrimary(ctx context.Context, req *pb.PromoteToPrimaryRequest) (*pb.PromoteToPrimaryResponse, error) {
+	if s.replica == nil {
+		return &pb.PromoteToPrimaryResponse{
+			Success: false,
+			Message: "Replication is not configured",
+		}, status.Error(codes.Unavailable, "replication not configured")
+	}
+
+	if err := s.replica.Promote(ctx); err != nil {
+		code := codes.Internal
+		if errors.Is(err, replication.ErrAlreadyPrimary) {
+			code = codes.FailedPrecondition
+		}
+		return &pb.PromoteToPrimaryResponse{
+			Status:  s.replicationStatus(),
+			Success: false,
+			Message: fmt.Sprintf("Failed to promote: %v", err),
+		}, status.Error(code, err.Error())
+	}
+
+	return &pb.PromoteToPrimaryResponse{
+		Status:  s.replicationStatus(),
+		Success: true,
+		Message: "Instance promoted to primary",
+	}, nil
+}
+
+func (s *AdminServer) replicationStatus() *pb.ReplicationStatus {
+	result := &pb.ReplicationStatus{
+		Role:        string(s.replica.Role()),
+		PrimaryAddr: s.replica.PrimaryAddr(),
+	}
+	if promotedAt := s.replica.PromotedAt(); !promotedAt.IsZero() {
+		result.PromotedAt = timestamppb.New(promotedAt)
+	}
+	if s.follower != nil {
+		followerStatus := s.follower.Status()
+		result.Connected = followerStatus.Connected
+		result.LastSequence = followerStatus.LastSequence
+		result.LagMs = followerStatus.Lag.Milliseconds()
+		result.AppliedChanges = followerStatus.Applied
+		result.Gaps = followerStatus.Gaps
+		if !followerStatus.LastEventAt.IsZero() {
+			result.LastEventAt = timestamppb.New(followerStatus.LastEventAt)
+		}
+	}
+	return result
+}
+
+func replicationEventToProto(event replication.Event) *pb.ReplicationEvent {
+	result := &pb.ReplicationEvent{
+		Sequence:  event.Sequence,
+		SentAt:    timestamppb.New(event.SentAt),
+		Heartbeat: event.Heartbeat,
+	}
+	if !event.Heartbeat {
+		result.Table = event.Change.Table
+		result.Operation = event.Change.Operation
+		result.EntityId = event.Change.EntityID.String()
+		result.UserId = event.Change.UserID.String()
+		result.Version = event.Change.Version
+	}
+	return result
+}
+
+// ReplicationSource sigue el flujo de cambios del primario a través de su API de administración
+type ReplicationSource struct {
+	client pb.AdminServiceClient
+	apiKey string
+}
+
+// NewReplicationSource crea el origen de cambios de una réplica; apiKey es la ADMIN_API_KEY del primario
+func NewReplicationSource(conn grpc.ClientConnInterface, apiKey string) *ReplicationSource {
+	return &ReplicationSource{
+		client: pb.NewAdminServiceClient(conn),
+		apiKey: apiKey,
+	}
+}
+
+// Stream implementa replication.Source
+func (s *ReplicationSource) Stream(ctx context.Context, afterSequence uint64, send func(replication.Event) error) error {
+	if s.apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", s.apiKey)
+	}
+	stream, err := s.client.StreamChanges(ctx, &pb.StreamChangesRequest{AfterSequence: afterSequence})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		event, err := replicationEventFromProto(msg)
+		if err != nil {
+			continue // Ignorar eventos malformados
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+}
+
+func replicationEventFromProto(msg *pb.ReplicationEvent) (replication.Event, error) {
+	event := replication.Event{
+		Sequence:  msg.Sequence,
+		SentAt:    msg.SentAt.AsTime(),
+		Heartbeat: msg.Heartbeat,
+	}
+	if msg.Heartbeat {
+		return event, nil
+	}
+
+	entityID, err := uuid.Parse(msg.EntityId)
+	if err != nil {
+		return event, err
+	}
+	userID, _ := uuid.Parse(msg.UserId)
+	event.Change = ports.EntityChange{
+		Table:     msg.Table,
+		Operation: msg.Operation,
+		EntityID:  entityID,
+		UserID:    userID,
+		Version:   msg.Version,
+	}
+	return event, nil
+}
@@ -0,0 +1,530 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/jobs"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/maintenance"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/queue"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/replication"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminServer implementa el servicio de administración usado por notebookctl
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	messageQueue *queue.MessageQueue
+	tokenManager *security.TokenManager
+	logger       *logging.StructuredLogger
+	jobRegistry  *jobs.Registry
+	maintenance  *maintenance.Mode
+	replica      *replication.Node
+	publisher    *replication.Publisher
+	follower     *replication.Follower
+	moderation   *usecases.ModerationUseCases
+}
+
+// AdminOption configura dependencias opcionales del servidor de administración
+type AdminOption func(*AdminServer)
+
+// WithMessageQueue habilita la gestión de la cola de mensajes fallidos
+func WithMessageQueue(messageQueue *queue.MessageQueue) AdminOption {
+	return func(s *AdminServer) {
+		s.messageQueue = messageQueue
+	}
+}
+
+// WithTokenManager habilita la rotación de tokens
+func WithTokenManager(tokenManager *security.TokenManager) AdminOption {
+	return func(s *AdminServer) {
+		s.tokenManager = tokenManager
+	}
+}
+
+// WithLogger habilita el cambio de nivel de log en caliente
+func WithLogger(logger *logging.StructuredLogger) AdminOption {
+	return func(s *AdminServer) {
+		s.logger = logger
+	}
+}
+
+// WithJobRegistry habilita la consulta y ejecución manual de tareas en segundo plano
+func WithJobRegistry(registry *jobs.Registry) AdminOption {
+	return func(s *AdminServer) {
+		s.jobRegistry = registry
+	}
+}
+
+// WithMaintenanceMode habilita el cambio al modo mantenimiento
+func WithMaintenanceMode(mode *maintenance.Mode) AdminOption {
+	return func(s *AdminServer) {
+		s.maintenance = mode
+	}
+}
+
+// WithReplication habilita el flujo de cambios para las réplicas y la promoción a primario;
+// follower es nil en una instancia que arrancó como primario
+func WithReplication(node *replication.Node, publisher *replication.Publisher, follower *replication.Follower) AdminOption {
+	return func(s *AdminServer) {
+		s.replica = node
+		s.publisher = publisher
+		s.follower = follower
+	}
+}
+
+// NewAdminServer crea una nueva instancia del servidor de administración
+func NewAdminServer(options ...AdminOption) *AdminServer {
+	server := &AdminServer{}
+
+	for _, option := range options {
+		option(server)
+	}
+
+	return server
+}
+
+// ListDeadLetters lista los mensajes de la cola de mensajes fallidos
+func (s *AdminServer) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.ListDeadLettersResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+
+	messages := s.messageQueue.ListDLQ(req.Topic)
+	protoMessages := make([]*pb.DeadLetter, len(messages))
+	for i, msg := range messages {
+		protoMessages[i] = &pb.DeadLetter{
+			Id:         msg.ID,
+			Topic:      msg.Topic,
+			RetryCount: int32(msg.RetryCount),
+			CreatedAt:  timestamppb.New(msg.CreatedAt),
+			Headers:    msg.Headers,
+		}
+	}
+
+	return &pb.ListDeadLettersResponse{
+		Messages: protoMessages,
+		Success:  true,
+		Message:  "Dead letters retrieved successfully",
+	}, nil
+}
+
+// RequeueDeadLetters devuelve mensajes fallidos a la cola principal
+func (s *AdminServer) RequeueDeadLetters(ctx context.Context, req *pb.RequeueDeadLettersRequest) (*pb.RequeueDeadLettersResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.RequeueDeadLettersResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+
+	ids := req.MessageIds
+	if req.All {
+		ids = nil
+		for _, msg := range s.messageQueue.ListDLQ(req.Topic) {
+			ids = append(ids, msg.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return &pb.RequeueDeadLettersResponse{
+			Success: false,
+			Message: "No message IDs provided",
+		}, status.Error(codes.InvalidArgument, "message_ids or all is required")
+	}
+
+	var requeued int32
+	var failed []string
+	for _, id := range ids {
+		if err := s.messageQueue.RequeueFromDLQ(id); err != nil {
+			failed = append(failed, id)
+			continue
+		}
+		requeued++
+	}
+
+	return &pb.RequeueDeadLettersResponse{
+		RequeuedCount: requeued,
+		FailedIds:     failed,
+		Success:       len(failed) == 0,
+		Message:       fmt.Sprintf("Requeued %d of %d messages", requeued, len(ids)),
+	}, nil
+}
+
+// ListQuarantined lista los mensajes en cuarentena con el pánico y el log de su handler
+func (s *AdminServer) ListQuarantined(ctx context.Context, req *pb.ListQuarantinedRequest) (*pb.ListQuarantinedResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.ListQuarantinedResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+
+	messages := s.messageQueue.ListQuarantined(req.Topic)
+	protoMessages := make([]*pb.QuarantinedMessage, len(messages))
+	for i, msg := range messages {
+		panicCount, _ := msg.Metadata[queue.PanicCountKey].(int)
+		panicValue, _ := msg.Metadata[queue.PanicValueKey].(string)
+		stackTrace, _ := msg.Metadata[queue.PanicStackKey].(string)
+		handlerLog, _ := msg.Metadata[queue.HandlerLogKey].([]string)
+		protoMessages[i] = &pb.QuarantinedMessage{
+			Id:         msg.ID,
+			Topic:      msg.Topic,
+			PanicCount: int32(panicCount),
+			PanicValue: panicValue,
+			StackTrace: stackTrace,
+			HandlerLog: handlerLog,
+			CreatedAt:  timestamppb.New(msg.CreatedAt),
+			Headers:    msg.Headers,
+		}
+	}
+
+	return &pb.ListQuarantinedResponse{
+		Messages: protoMessages,
+		Success:  true,
+		Message:  "Quarantined messages retrieved successfully",
+	}, nil
+}
+
+// ReplayQuarantined devuelve mensajes en cuarentena a la cola, normalmente tras corregir el handler
+func (s *AdminServer) ReplayQuarantined(ctx context.Context, req *pb.ReplayQuarantinedRequest) (*pb.ReplayQuarantinedResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.ReplayQuarantinedResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+	if len(req.MessageIds) == 0 {
+		return &pb.ReplayQuarantinedResponse{
+			Success: false,
+			Message: "No message IDs provided",
+		}, status.Error(codes.InvalidArgument, "message_ids is required")
+	}
+
+	var replayed int32
+	var failed []string
+	for _, id := range req.MessageIds {
+		if err := s.messageQueue.ReplayQuarantined(id); err != nil {
+			failed = append(failed, id)
+			continue
+		}
+		replayed++
+	}
+
+	return &pb.ReplayQuarantinedResponse{
+		ReplayedCount: replayed,
+		FailedIds:     failed,
+		Success:       len(failed) == 0,
+		Message:       fmt.Sprintf("Replayed %d of %d messages", replayed, len(req.MessageIds)),
+	}, nil
+}
+
+// PauseTopic detiene la entrega de los mensajes de un tópico sin dejar de aceptarlos
+func (s *AdminServer) PauseTopic(ctx context.Context, req *pb.PauseTopicRequest) (*pb.PauseTopicResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.PauseTopicResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+	if req.Topic == "" {
+		return &pb.PauseTopicResponse{
+			Success: false,
+			Message: "Topic is required",
+		}, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	s.messageQueue.Pause(req.Topic)
+
+	return &pb.PauseTopicResponse{
+		PausedTopics: s.messageQueue.PausedTopics(),
+		Success:      true,
+		Message:      fmt.Sprintf("Topic %s paused", req.Topic),
+	}, nil
+}
+
+// ResumeTopic reanuda la entrega de un tópico pausado, empezando por los mensajes retenidos
+func (s *AdminServer) ResumeTopic(ctx context.Context, req *pb.ResumeTopicRequest) (*pb.ResumeTopicResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.ResumeTopicResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+	if req.Topic == "" {
+		return &pb.ResumeTopicResponse{
+			Success: false,
+			Message: "Topic is required",
+		}, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	s.messageQueue.Resume(req.Topic)
+
+	return &pb.ResumeTopicResponse{
+		PausedTopics: s.messageQueue.PausedTopics(),
+		Success:      true,
+		Message:      fmt.Sprintf("Topic %s resumed", req.Topic),
+	}, nil
+}
+
+// DrainQueue detiene la entrega de mensajes y espera a que terminen los handlers en curso,
+// antes de un despliegue. La entrega no se reanuda hasta reiniciar el servidor
+func (s *AdminServer) DrainQueue(ctx context.Context, req *pb.DrainQueueRequest) (*pb.DrainQueueResponse, error) {
+	if s.messageQueue == nil {
+		return &pb.DrainQueueResponse{
+			Success: false,
+			Message: "Message queue is not configured",
+		}, status.Error(codes.Unavailable, "message queue not configured")
+	}
+
+	timeout := 30 * time.Second
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := s.messageQueue.Drain(drainCtx); err != nil {
+		return &pb.DrainQueueResponse{
+			Drained:          false,
+			InFlightMessages: s.messageQueue.GetMetrics().InFlightMessages,
+			Success:          false,
+			Message:          fmt.Sprintf("Queue not drained after %s", timeout),
+		}, status.Error(codes.DeadlineExceeded, "queue not drained before the timeout")
+	}
+
+	return &pb.DrainQueueResponse{
+		Drained: true,
+		Success: true,
+		Message: "Queue drained successfully",
+	}, nil
+}
+
+// RotateToken emite un token nuevo y revoca el anterior
+func (s *AdminServer) RotateToken(ctx context.Context, req *pb.RotateTokenRequest) (*pb.RotateTokenResponse, error) {
+	if s.tokenManager == nil {
+		return &pb.RotateTokenResponse{
+			Success: false,
+			Message: "Token manager is not configured",
+		}, status.Error(codes.Unavailable, "token manager not configured")
+	}
+
+	token, err := s.tokenManager.RefreshToken(req.Token)
+	if err != nil {
+		return &pb.RotateTokenResponse{
+			Success: false,
+			Message: "Token could not be rotated",
+		}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid token: %v", err))
+	}
+
+	claims, err := s.tokenManager.ValidateToken(token)
+	if err != nil {
+		return &pb.RotateTokenResponse{
+			Success: false,
+			Message: "Rotated token is invalid",
+		}, status.Error(codes.Internal, "failed to validate rotated token")
+	}
+
+	return &pb.RotateTokenResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(claims.ExpiresAt),
+		Success:   true,
+		Message:   "Token rotated successfully",
+	}, nil
+}
+
+// ListJobs lista las tareas en segundo plano registradas y el estado de su última ejecución
+func (s *AdminServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	if s.jobRegistry == nil {
+		return &pb.ListJobsResponse{
+			Success: false,
+			Message: "Job registry is not configured",
+		}, status.Error(codes.Unavailable, "job registry not configured")
+	}
+
+	statuses := s.jobRegistry.List()
+	protoJobs := make([]*pb.Job, len(statuses))
+	for i, jobStatus := range statuses {
+		protoJobs[i] = jobToProto(jobStatus)
+	}
+
+	return &pb.ListJobsResponse{
+		Jobs:    protoJobs,
+		Paused:  s.jobRegistry.Paused(),
+		Success: true,
+		Message: "Jobs retrieved successfully",
+	}, nil
+}
+
+// RunJob ejecuta una tarea registrada y espera a que termine
+func (s *AdminServer) RunJob(ctx context.Context, req *pb.RunJobRequest) (*pb.RunJobResponse, error) {
+	if s.jobRegistry == nil {
+		return &pb.RunJobResponse{
+			Success: false,
+			Message: "Job registry is not configured",
+		}, status.Error(codes.Unavailable, "job registry not configured")
+	}
+
+	jobStatus, err := s.jobRegistry.Trigger(ctx, req.Name)
+	switch {
+	case errors.Is(err, jobs.ErrJobNotFound):
+		statuses := s.jobRegistry.List()
+		names := make([]string, len(statuses))
+		for i, st := range statuses {
+			names[i] = st.Name
+		}
+		return &pb.RunJobResponse{
+			Success: false,
+			Message: fmt.Sprintf("Unknown job, available jobs: %s", strings.Join(names, ", ")),
+		}, status.Error(codes.NotFound, "job not found")
+	case errors.Is(err, jobs.ErrJobRunning):
+		return &pb.RunJobResponse{
+			Job:     jobToProto(jobStatus),
+			Success: false,
+			Message: "Job is already running",
+		}, status.Error(codes.FailedPrecondition, "job already running")
+	case errors.Is(err, entities.ErrLockNotAcquired):
+		return &pb.RunJobResponse{
+			Job:     jobToProto(jobStatus),
+			Success: false,
+			Message: "Job is running on another instance",
+		}, status.Error(codes.Aborted, "job locked by another instance")
+	case err != nil:
+		return &pb.RunJobResponse{
+			DurationMs: jobStatus.LastDuration.Milliseconds(),
+			Job:        jobToProto(jobStatus),
+			Success:    false,
+			Message:    err.Error(),
+		}, status.Error(codes.Internal, fmt.Sprintf("job failed: %v", err))
+	}
+
+	return &pb.RunJobResponse{
+		DurationMs: jobStatus.LastDuration.Milliseconds(),
+		Job:        jobToProto(jobStatus),
+		Success:    true,
+		Message:    "Job completed successfully",
+	}, nil
+}
+
+// SetLogLevel cambia el nivel de log sin reiniciar el servidor
+func (s *AdminServer) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	if s.logger == nil {
+		return &pb.SetLogLevelResponse{
+			Success: false,
+			Message: "Logger is not configured",
+		}, status.Error(codes.Unavailable, "logger not configured")
+	}
+
+	level, err := logging.ParseLogLevel(req.Level)
+	if err != nil {
+		return &pb.SetLogLevelResponse{
+			Success: false,
+			Message: err.Error(),
+		}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	previous := s.logger.GetLevel()
+	s.logger.SetLevel(level)
+
+	return &pb.SetLogLevelResponse{
+		PreviousLevel: previous.String(),
+		Level:         level.String(),
+		Success:       true,
+		Message:       "Log level updated successfully",
+	}, nil
+}
+
+// SetMaintenanceMode activa o desactiva el modo mantenimiento
+func (s *AdminServer) SetMaintenanceMode(ctx context.Context, req *pb.SetMaintenanceModeRequest) (*pb.SetMaintenanceModeResponse, error) {
+	if s.maintenance == nil {
+		return &pb.SetMaintenanceModeResponse{
+			Success: false,
+			Message: "Maintenance mode is not configured",
+		}, status.Error(codes.Unavailable, "maintenance mode not configured")
+	}
+	if req.RetryAfterSeconds < 0 {
+		return &pb.SetMaintenanceModeResponse{
+			Success: false,
+			Message: "retry_after_seconds must not be negative",
+		}, status.Error(codes.InvalidArgument, "negative retry_after_seconds")
+	}
+
+	var state maintenance.State
+	message := "Maintenance mode disabled"
+	if req.Enabled {
+		state = s.maintenance.Enable(req.Message, time.Duration(req.RetryAfterSeconds)*time.Second)
+		message = "Maintenance mode enabled"
+	} else {
+		state = s.maintenance.Disable()
+	}
+
+	return &pb.SetMaintenanceModeResponse{
+		State:   maintenanceStateToProto(state),
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// GetMaintenanceMode devuelve el estado del modo mantenimiento
+func (s *AdminServer) GetMaintenanceMode(ctx context.Context, req *pb.GetMaintenanceModeRequest) (*pb.GetMaintenanceModeResponse, error) {
+	if s.maintenance == nil {
+		return &pb.GetMaintenanceModeResponse{
+			Success: false,
+			Message: "Maintenance mode is not configured",
+		}, status.Error(codes.Unavailable, "maintenance mode not configured")
+	}
+
+	return &pb.GetMaintenanceModeResponse{
+		State:   maintenanceStateToProto(s.maintenance.State()),
+		Success: true,
+		Message: "Maintenance mode retrieved successfully",
+	}, nil
+}
+
+func maintenanceStateToProto(state maintenance.State) *pb.MaintenanceState {
+	result := &pb.MaintenanceState{
+		Enabled:           state.Enabled,
+		Message:           state.Message,
+		RetryAfterSeconds: int64(state.RetryAfter / time.Second),
+	}
+	if !state.ChangedAt.IsZero() {
+		result.ChangedAt = timestamppb.New(state.ChangedAt)
+	}
+	return result
+}
+
+func jobToProto(jobStatus jobs.JobStatus) *pb.Job {
+	job := &pb.Job{
+		Name:            jobStatus.Name,
+		IntervalSeconds: int64(jobStatus.Interval / time.Second),
+		Singleton:       jobStatus.Singleton,
+		Running:         jobStatus.Running,
+		LastResult:      string(jobStatus.LastResult),
+		LastError:       jobStatus.LastError,
+		LastDurationMs:  jobStatus.LastDuration.Milliseconds(),
+		RunCount:        jobStatus.RunCount,
+		FailureCount:    jobStatus.FailureCount,
+	}
+	if !jobStatus.LastStartedAt.IsZero() {
+		job.LastStartedAt = timestamppb.New(jobStatus.LastStartedAt)
+	}
+	if !jobStatus.LastFinishedAt.IsZero() {
+		job.LastFinishedAt = timestamppb.New(jobStatus.LastFinishedAt)
+	}
+	if !jobStatus.NextRunAt.IsZero() {
+		job.NextRunAt = timestamppb.New(jobStatus.NextRunAt)
+	}
+	return job
+}
@@ -0,0 +1,201 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetBoard implementa la obtención del tablero de ideas agrupadas por estado
+func (s *NotebookServer) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardResponse, error) {
+	if s.boardUseCases == nil {
+		return &pb.GetBoardResponse{
+			Success: false,
+			Message: "Idea board is not enabled",
+		}, status.Error(codes.Unavailable, "idea board not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetBoardResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.PageSize < 0 {
+		return &pb.GetBoardResponse{
+			Success: false,
+			Message: "Invalid page size",
+		}, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+
+	columns, err := s.boardUseCases.GetBoard(ctx, userID, int(req.PageSize))
+	if err != nil {
+		return &pb.GetBoardResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get board: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	pbColumns := make([]*pb.BoardColumn, len(columns))
+	for i, column := range columns {
+		pbIdeas := make([]*pb.Idea, len(column.Ideas))
+		for j, idea := range column.Ideas {
+			pbIdeas[j] = convert.IdeaToProto(idea)
+		}
+		pbColumns[i] = &pb.BoardColumn{
+			Status:     pb.IdeaStatus(column.Status),
+			Ideas:      pbIdeas,
+			TotalCount: int32(column.TotalCount),
+		}
+	}
+
+	return &pb.GetBoardResponse{
+		Columns: pbColumns,
+		Success: true,
+		Message: "Board retrieved successfully",
+	}, nil
+}
+
+// MoveIdea implementa el cambio de columna y posición de una idea en el tablero
+func (s *NotebookServer) MoveIdea(ctx context.Context, req *pb.MoveIdeaRequest) (*pb.MoveIdeaResponse, error) {
+	if s.boardUseCases == nil {
+		return &pb.MoveIdeaResponse{
+			Success: false,
+			Message: "Idea board is not enabled",
+		}, status.Error(codes.Unavailable, "idea board not enabled")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.MoveIdeaResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.MoveIdeaResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	var afterID, beforeID uuid.UUID
+	if req.AfterIdeaId != "" {
+		if afterID, err = uuid.Parse(req.AfterIdeaId); err != nil {
+			return &pb.MoveIdeaResponse{
+				Success: false,
+				Message: "Invalid after idea ID format",
+			}, status.Error(codes.InvalidArgument, "invalid after idea ID")
+		}
+	}
+	if req.BeforeIdeaId != "" {
+		if beforeID, err = uuid.Parse(req.BeforeIdeaId); err != nil {
+			return &pb.MoveIdeaResponse{
+				Success: false,
+				Message: "Invalid before idea ID format",
+			}, status.Error(codes.InvalidArgument, "invalid before idea ID")
+		}
+	}
+
+	idea, err := s.boardUseCases.MoveIdea(ctx, id, userID, entities.IdeaStatus(req.Status), afterID, beforeID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrIdeaNotFound {
+			return &pb.MoveIdeaResponse{
+				Success: false,
+				Message: "Idea not found",
+			}, domainError(codes.NotFound, "idea not found", err)
+		}
+		if err == entities.ErrIdeaUnauthorized {
+			return &pb.MoveIdeaResponse{
+				Success: false,
+				Message: "Unauthorized access to idea",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrInvalidBoardColumn || err == entities.ErrInvalidBoardPosition {
+			return &pb.MoveIdeaResponse{
+				Success: false,
+				Message: "Invalid board position",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrVersionConflict && idea != nil {
+			// La idea más reciente viaja en los detalles del status para que el cliente pueda reintentar
+			latest := convert.IdeaToProto(idea)
+			st := status.New(codes.Aborted, "idea version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.MoveIdeaResponse{
+				Idea:    latest,
+				Success: false,
+				Message: "Idea was modified concurrently",
+			}, st.Err()
+		}
+		return &pb.MoveIdeaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to move idea: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MoveIdeaResponse{
+		Idea:    convert.IdeaToProto(idea),
+		Success: true,
+		Message: "Idea moved successfully",
+	}, nil
+}
+
+// WatchBoard envía los cambios de columna y de posición de las ideas del usuario
+func (s *NotebookServer) WatchBoard(req *pb.WatchBoardRequest, stream pb.NotebookService_WatchBoardServer) error {
+	if s.boardUseCases == nil {
+		return status.Error(codes.Unavailable, "idea board not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid user ID format")
+	}
+
+	notificationCh, err := s.notificationSvc.SubscribeToNotifications(stream.Context(), userID, []string{"board"})
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("Failed to subscribe to board events: %v", err))
+	}
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case notification, ok := <-notificationCh:
+			if !ok {
+				// El cliente debe reconectar y volver a pedir el tablero para no perder movimientos
+				return status.Error(codes.Unavailable, "board subscription closed, reload the board and reconnect")
+			}
+			if notification.Type != "board_idea_moved" {
+				continue
+			}
+			if err := stream.Send(convert.BoardEventToProto(notification)); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if err := stream.Send(&pb.BoardEvent{
+				Heartbeat: true,
+				MovedAt:   timestamppb.Now(),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
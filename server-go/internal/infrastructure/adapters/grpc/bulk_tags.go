@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errInvalidBulkIdeaID indica un ID mal formado en idea_ids
+var errInvalidBulkIdeaID = errors.New("invalid idea ID")
+
+// BulkTagIdeas implementa el etiquetado de todas las ideas que cumplen un filtro
+func (s *NotebookServer) BulkTagIdeas(ctx context.Context, req *pb.BulkTagIdeasRequest) (*pb.BulkTagIdeasResponse, error) {
+	if s.bulkTags == nil {
+		return &pb.BulkTagIdeasResponse{
+			Success: false,
+			Message: "Bulk tag operations are not enabled",
+		}, status.Error(codes.Unavailable, "bulk tag operations not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.BulkTagIdeasResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	filters, ideaIDs, err := bulkTagFilters(req.Category, req.Status, req.Tags, req.CustomFieldFilters, req.IdeaIds)
+	if err != nil {
+		code, message := bulkTagErrorStatus(err)
+		return &pb.BulkTagIdeasResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	affected, err := s.bulkTags.BulkTagIdeas(ctx, userID, filters, ideaIDs, req.Tag)
+	if err != nil {
+		code, message := bulkTagErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to tag ideas: %v", err)
+		}
+		return &pb.BulkTagIdeasResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.BulkTagIdeasResponse{
+		AffectedCount: int32(affected),
+		Success:       true,
+		Message:       "Ideas tagged successfully",
+	}, nil
+}
+
+// BulkUntagIdeas implementa la eliminación de una etiqueta de todas las ideas que cumplen un filtro
+func (s *NotebookServer) BulkUntagIdeas(ctx context.Context, req *pb.BulkUntagIdeasRequest) (*pb.BulkUntagIdeasResponse, error) {
+	if s.bulkTags == nil {
+		return &pb.BulkUntagIdeasResponse{
+			Success: false,
+			Message: "Bulk tag operations are not enabled",
+		}, status.Error(codes.Unavailable, "bulk tag operations not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.BulkUntagIdeasResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	filters, ideaIDs, err := bulkTagFilters(req.Category, req.Status, req.Tags, req.CustomFieldFilters, req.IdeaIds)
+	if err != nil {
+		code, message := bulkTagErrorStatus(err)
+		return &pb.BulkUntagIdeasResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	affected, err := s.bulkTags.BulkUntagIdeas(ctx, userID, filters, ideaIDs, req.Tag)
+	if err != nil {
+		code, message := bulkTagErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to untag ideas: %v", err)
+		}
+		return &pb.BulkUntagIdeasResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.BulkUntagIdeasResponse{
+		AffectedCount: int32(affected),
+		Success:       true,
+		Message:       "Ideas untagged successfully",
+	}, nil
+}
+
+// bulkTagFilters convierte el filtro de las peticiones de etiquetado masivo
+func bulkTagFilters(category pb.IdeaCategory, ideaStatus pb.IdeaStatus, tags []string, customFieldFilters []*pb.CustomFieldFilter, ideaIDs []string) (ports.IdeaFilters, []uuid.UUID, error) {
+	customFields, err := convert.CustomFieldFiltersFromProto(customFieldFilters)
+	if err != nil {
+		return ports.IdeaFilters{}, nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ideaIDs))
+	for i, id := range ideaIDs {
+		ids[i], err = uuid.Parse(id)
+		if err != nil {
+			return ports.IdeaFilters{}, nil, errInvalidBulkIdeaID
+		}
+	}
+
+	return ports.IdeaFilters{
+		Category:     entities.IdeaCategory(category),
+		Status:       entities.IdeaStatus(ideaStatus),
+		Tags:         tags,
+		CustomFields: customFields,
+	}, ids, nil
+}
+
+// bulkTagErrorStatus traduce los errores del etiquetado masivo; codes.Internal indica un error inesperado
+func bulkTagErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case errInvalidBulkIdeaID:
+		return codes.InvalidArgument, "Invalid idea ID format"
+	case entities.ErrInvalidTag:
+		return codes.InvalidArgument, "Invalid tag"
+	case entities.ErrInvalidCustomFieldFilter:
+		return codes.InvalidArgument, "Invalid custom field filter"
+	case entities.ErrTooManyIdeasForBulk:
+		return codes.FailedPrecondition, "Too many ideas match the filter"
+	case entities.ErrVersionConflict:
+		return codes.Aborted, "Ideas were modified concurrently"
+	}
+	return codes.Internal, ""
+}
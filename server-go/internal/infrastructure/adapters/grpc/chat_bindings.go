@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartChatBinding implementa el inicio de la vinculación de un chat
+func (s *NotebookServer) StartChatBinding(ctx context.Context, req *pb.StartChatBindingRequest) (*pb.StartChatBindingResponse, error) {
+	if s.chatUseCases == nil {
+		return &pb.StartChatBindingResponse{
+			Success: false,
+			Message: "Chat notifications are not enabled",
+		}, status.Error(codes.Unavailable, "chat notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.StartChatBindingResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	provider := entities.ChatProvider(req.Provider)
+	binding, code, err := s.chatUseCases.StartBinding(ctx, userID, provider)
+	if err != nil {
+		if err == entities.ErrInvalidChatProvider || err == entities.ErrChatBindingUserIDRequired {
+			return &pb.StartChatBindingResponse{
+				Success: false,
+				Message: err.Error(),
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.StartChatBindingResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to start chat binding: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.StartChatBindingResponse{
+		ChatBinding:   convert.ChatBindingToProto(binding),
+		Code:          code,
+		CodeExpiresAt: timestamppb.New(binding.CodeExpiresAt),
+		Success:       true,
+		Message:       "Chat binding started successfully",
+	}
+	if provider == entities.ChatProviderTelegram && s.telegramBot != "" {
+		response.Link = "https://t.me/" + s.telegramBot + "?start=" + url.QueryEscape(code)
+	}
+	return response, nil
+}
+
+// ListChatBindings implementa la lista de chats vinculados de un usuario
+func (s *NotebookServer) ListChatBindings(ctx context.Context, req *pb.ListChatBindingsRequest) (*pb.ListChatBindingsResponse, error) {
+	if s.chatUseCases == nil {
+		return &pb.ListChatBindingsResponse{
+			Success: false,
+			Message: "Chat notifications are not enabled",
+		}, status.Error(codes.Unavailable, "chat notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListChatBindingsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	bindings, err := s.chatUseCases.ListBindings(ctx, userID)
+	if err != nil {
+		return &pb.ListChatBindingsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list chat bindings: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	protoBindings := make([]*pb.ChatBinding, len(bindings))
+	for i, binding := range bindings {
+		protoBindings[i] = convert.ChatBindingToProto(binding)
+	}
+
+	return &pb.ListChatBindingsResponse{
+		ChatBindings: protoBindings,
+		Success:      true,
+		Message:      "Chat bindings retrieved successfully",
+	}, nil
+}
+
+// DeleteChatBinding implementa la eliminación de un vínculo con un chat
+func (s *NotebookServer) DeleteChatBinding(ctx context.Context, req *pb.DeleteChatBindingRequest) (*pb.DeleteChatBindingResponse, error) {
+	if s.chatUseCases == nil {
+		return &pb.DeleteChatBindingResponse{
+			Success: false,
+			Message: "Chat notifications are not enabled",
+		}, status.Error(codes.Unavailable, "chat notifications not enabled")
+	}
+
+	bindingID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.DeleteChatBindingResponse{
+			Success: false,
+			Message: "Invalid chat binding ID format",
+		}, status.Error(codes.InvalidArgument, "invalid chat binding ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.DeleteChatBindingResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	err = s.chatUseCases.DeleteBinding(ctx, bindingID, userID)
+	if err != nil {
+		if err == entities.ErrChatBindingNotFound {
+			return &pb.DeleteChatBindingResponse{
+				Success: false,
+				Message: "Chat binding not found",
+			}, domainError(codes.NotFound, "chat binding not found", err)
+		}
+		if err == entities.ErrChatBindingUnauthorized {
+			return &pb.DeleteChatBindingResponse{
+				Success: false,
+				Message: "Unauthorized access to chat binding",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.DeleteChatBindingResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to delete chat binding: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteChatBindingResponse{
+		Success: true,
+		Message: "Chat binding deleted successfully",
+	}, nil
+}
@@ -0,0 +1,63 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+func benchIdea() *entities.Idea {
+	now := time.Now()
+	return &entities.Idea{
+		ID:           uuid.New(),
+		Title:        "Idea de prueba",
+		Content:      strings.Repeat("Contenido de la idea. ", 200),
+		Tags:         []string{"trabajo", "producto", "q3"},
+		Category:     entities.IdeaCategoryBusiness,
+		Status:       entities.IdeaStatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		UserID:       uuid.New(),
+		RelatedIdeas: []uuid.UUID{uuid.New(), uuid.New()},
+		Priority:     3,
+		Version:      7,
+	}
+}
+
+func BenchmarkConvertIdeaToProto(b *testing.B) {
+	idea := benchIdea()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		IdeaToProto(idea)
+	}
+}
+
+func BenchmarkIdeaProtoMarshal(b *testing.B) {
+	message := IdeaToProto(benchIdea())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := proto.Marshal(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkIdeaProtoMarshalV2(b *testing.B) {
+	message := IdeaToProtoV2(benchIdea())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := proto.Marshal(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Package convert traduce entre las entidades del dominio y los mensajes protobuf de la API.
+//
+// Las funciones XToProto y XFromProto son inversas: un mensaje que va y vuelve conserva todos los
+// campos que existen en ambos lados. Los tests de contrato fallan si un campo nuevo del proto no
+// se completa, así que al agregar un campo hay que convertirlo o justificar por qué no.
+package convert
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// optionalTimestamp devuelve nil si t es nil
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timeFromProto devuelve el instante cero si el mensaje no trae timestamp
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func optionalTimeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// optionalUUID devuelve vacío para uuid.Nil
+func optionalUUID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+// parseOptionalUUID devuelve uuid.Nil para un ID vacío
+func parseOptionalUUID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+func uuidsToProto(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+func uuidsFromProto(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	result := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = parsed
+	}
+	return result, nil
+}
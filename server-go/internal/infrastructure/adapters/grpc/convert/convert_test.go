@@ -0,0 +1,242 @@
+package convert
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// go test ./internal/infrastructure/adapters/grpc/convert -update regenera testdata
+var update = flag.Bool("update", false, "reescribir los archivos golden de testdata")
+
+// converted tiene el resultado de cada conversor a proto sobre su entidad de prueba
+func converted() map[string]proto.Message {
+	return map[string]proto.Message{
+		"idea":                    IdeaToProto(fixtureIdea()),
+		"idea_v2":                 IdeaToProtoV2(fixtureIdea()),
+		"idea_change":             IdeaChangeToProto(fixtureIdeaChange()),
+		"idea_review":             IdeaReviewToProto(fixtureIdeaReview()),
+		"idea_publication":        IdeaPublicationToProto(fixtureIdeaPublication(), fixturePublicationURL),
+		"board_event":             BoardEventToProto(fixtureBoardMove()),
+		"statistics":              StatisticsToProto(fixtureStatistics()),
+		"reminder":                ReminderToProto(fixtureReminder()),
+		"file_info":               FileInfoToProto(fixtureFileInfo()),
+		"share_link":              ShareLinkToProto(fixtureShareLink()),
+		"custom_field_definition": CustomFieldDefinitionToProto(fixtureCustomFieldDefinition()),
+		"progress":                ProgressToProto(fixtureProgress()),
+		"time_entry":              TimeEntryToProto(fixtureTimeEntry(), fixtureLater),
+		"progress_effort":         ProgressEffortToProto(fixtureProgressEffort()),
+		"progress_forecast":       ProgressForecastToProto(fixtureProgressForecast()),
+		"progress_template":       ProgressTemplateToProto(fixtureProgressTemplate()),
+		"notification":            NotificationToProto(fixtureNotification(), true),
+		"chat_binding":            ChatBindingToProto(fixtureChatBinding()),
+		"inbound_address":         InboundAddressToProto(fixtureInboundAddress()),
+		"phone_number":            PhoneNumberToProto(fixturePhoneNumber()),
+		"client_metric":           ClientMetricToProto(fixtureClientMetric()),
+	}
+}
+
+// unconverted son los campos que ningún conversor completa a propósito
+var unconverted = map[protoreflect.FullName]string{
+	"notebook.NotificationResponse.heartbeat": "lo envía el stream entre notificaciones",
+	"notebook.BoardEvent.heartbeat":           "lo envía el stream entre eventos",
+	"notebook.IdeaChangeEvent.heartbeat":      "lo envía el stream entre cambios",
+}
+
+// TestConvertersPopulateEveryField falla cuando el proto gana un campo que los conversores no
+// completan: hay que convertirlo o agregarlo a unconverted explicando por qué
+func TestConvertersPopulateEveryField(t *testing.T) {
+	for name, message := range converted() {
+		t.Run(name, func(t *testing.T) {
+			covered := make(map[protoreflect.FullName]bool)
+			reached := make(map[protoreflect.FullName]protoreflect.MessageDescriptor)
+			collectPopulated(message.ProtoReflect(), covered, reached)
+
+			for _, descriptor := range reached {
+				fields := descriptor.Fields()
+				for i := 0; i < fields.Len(); i++ {
+					field := fields.Get(i).FullName()
+					if !covered[field] && unconverted[field] == "" {
+						t.Errorf("%s no se completa a partir de la entidad", field)
+					}
+				}
+			}
+		})
+	}
+}
+
+// collectPopulated marca los campos con valor de message y de los mensajes de la API que
+// contiene. En listas y mapas basta con que el campo tenga valor en algún elemento.
+func collectPopulated(message protoreflect.Message, covered map[protoreflect.FullName]bool, reached map[protoreflect.FullName]protoreflect.MessageDescriptor) {
+	descriptor := message.Descriptor()
+	// Los tipos conocidos como Timestamp no son parte del contrato
+	if !strings.HasPrefix(string(descriptor.ParentFile().Package()), "notebook") {
+		return
+	}
+	reached[descriptor.FullName()] = descriptor
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		covered[field.FullName()] = true
+		switch {
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+					collectPopulated(entry.Message(), covered, reached)
+					return true
+				})
+			}
+		case field.IsList():
+			if field.Message() != nil {
+				for i := 0; i < value.List().Len(); i++ {
+					collectPopulated(value.List().Get(i).Message(), covered, reached)
+				}
+			}
+		case field.Message() != nil:
+			collectPopulated(value.Message(), covered, reached)
+		}
+		return true
+	})
+}
+
+// TestConvertersMatchGoldenFiles detecta cambios en la forma en que se serializan las entidades,
+// como un campo que se deja de enviar o un enum que cambia de valor
+func TestConvertersMatchGoldenFiles(t *testing.T) {
+	for name, message := range converted() {
+		t.Run(name, func(t *testing.T) {
+			got := canonicalJSON(t, message)
+			path := filepath.Join("testdata", name+".golden.json")
+
+			if *update {
+				require.NoError(t, os.WriteFile(path, got, 0o644))
+				return
+			}
+			want, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(got))
+		})
+	}
+}
+
+// canonicalJSON reformatea la salida de protojson, que no es estable byte a byte entre versiones
+func canonicalJSON(t *testing.T, message proto.Message) []byte {
+	t.Helper()
+	data, err := protojson.Marshal(message)
+	require.NoError(t, err)
+
+	var value interface{}
+	require.NoError(t, json.Unmarshal(data, &value))
+	formatted, err := json.MarshalIndent(value, "", "  ")
+	require.NoError(t, err)
+	return append(formatted, '\n')
+}
+
+// roundTrip serializa message y lo lee en un mensaje nuevo, como lo recibiría el otro extremo
+func roundTrip[M proto.Message](t *testing.T, message M) M {
+	t.Helper()
+	data, err := proto.Marshal(message)
+	require.NoError(t, err)
+	received := message.ProtoReflect().New().Interface().(M)
+	require.NoError(t, proto.Unmarshal(data, received))
+	return received
+}
+
+func TestIdeaRoundTrip(t *testing.T) {
+	idea, err := IdeaFromProto(roundTrip(t, IdeaToProto(fixtureIdea())))
+	require.NoError(t, err)
+	require.Equal(t, fixtureIdea(), idea)
+}
+
+func TestReminderRoundTrip(t *testing.T) {
+	reminder, err := ReminderFromProto(roundTrip(t, ReminderToProto(fixtureReminder())))
+	require.NoError(t, err)
+	require.Equal(t, fixtureReminder(), reminder)
+
+	// Sin idea, asignación ni política los IDs opcionales viajan vacíos
+	plain := fixtureReminder()
+	plain.IdeaID, plain.AssigneeID = uuid.Nil, uuid.Nil
+	plain.AssignmentStatus = entities.ReminderAssignmentNone
+	plain.EscalationPolicy, plain.EscalationLevel = nil, 0
+	plain.AcknowledgedAt = nil
+	plain.MilestoneLink = nil
+	message := ReminderToProto(plain)
+	require.Empty(t, message.IdeaId)
+	require.Empty(t, message.AssigneeId)
+	reminder, err = ReminderFromProto(roundTrip(t, message))
+	require.NoError(t, err)
+	require.Equal(t, plain, reminder)
+}
+
+func TestFileInfoRoundTrip(t *testing.T) {
+	fileInfo, err := FileInfoFromProto(roundTrip(t, FileInfoToProto(fixtureFileInfo())))
+	require.NoError(t, err)
+	require.Equal(t, fixtureFileInfo(), fileInfo)
+}
+
+func TestProgressRoundTrip(t *testing.T) {
+	progress, err := ProgressFromProto(roundTrip(t, ProgressToProto(fixtureProgress())))
+	require.NoError(t, err)
+	require.Equal(t, fixtureProgress(), progress)
+}
+
+func TestTemplateMilestonesRoundTrip(t *testing.T) {
+	template := roundTrip(t, ProgressTemplateToProto(fixtureProgressTemplate()))
+	require.Equal(t, fixtureProgressTemplate().Milestones, TemplateMilestonesFromProto(template.Milestones))
+}
+
+func TestNotificationRoundTrip(t *testing.T) {
+	notification, err := NotificationFromProto(roundTrip(t, NotificationToProto(fixtureNotification(), false)))
+	require.NoError(t, err)
+	require.Equal(t, fixtureNotification(), notification)
+}
+
+func TestCustomFieldDefinitionRoundTrip(t *testing.T) {
+	field, err := CustomFieldDefinitionFromProto(roundTrip(t, CustomFieldDefinitionToProto(fixtureCustomFieldDefinition())))
+	require.NoError(t, err)
+	require.Equal(t, fixtureCustomFieldDefinition(), field)
+}
+
+func TestIdeaReviewRoundTrip(t *testing.T) {
+	review, err := IdeaReviewFromProto(roundTrip(t, IdeaReviewToProto(fixtureIdeaReview())))
+	require.NoError(t, err)
+
+	// El usuario y la versión no viajan en el proto
+	want := fixtureIdeaReview()
+	want.UserID, want.Version = uuid.Nil, 0
+	require.Equal(t, want, review)
+}
+
+func TestClientMetricRoundTrip(t *testing.T) {
+	metric := ClientMetricFromProto(roundTrip(t, ClientMetricToProto(fixtureClientMetric())))
+	require.Equal(t, fixtureClientMetric(), metric)
+
+	unknown := ClientMetricFromProto(&pb.ClientMetric{Kind: pb.ClientMetricKind(99), Name: "sync"})
+	require.Empty(t, unknown.Kind)
+}
+
+func TestFromProtoRejectsMalformedIDs(t *testing.T) {
+	message := IdeaToProto(fixtureIdea())
+	message.RelatedIdeas = []string{"no-es-un-uuid"}
+	_, err := IdeaFromProto(message)
+	require.Error(t, err)
+
+	reminder := ReminderToProto(fixtureReminder())
+	reminder.EscalationPolicy.BackupContactId = "no-es-un-uuid"
+	_, err = ReminderFromProto(reminder)
+	require.Error(t, err)
+
+	progress := ProgressToProto(fixtureProgress())
+	progress.CustomFields["entrega"] = &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Date{Date: "01/05/2024"}}
+	_, err = ProgressFromProto(progress)
+	require.ErrorIs(t, err, entities.ErrInvalidCustomFieldValue)
+}
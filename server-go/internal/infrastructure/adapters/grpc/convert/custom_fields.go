@@ -0,0 +1,120 @@
+package convert
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func CustomFieldDefinitionToProto(field *entities.CustomFieldDefinition) *pb.CustomFieldDefinition {
+	return &pb.CustomFieldDefinition{
+		Id:         field.ID.String(),
+		UserId:     field.UserID.String(),
+		EntityType: pb.CustomFieldEntity(field.EntityType),
+		Key:        field.Key,
+		Name:       field.Name,
+		Type:       pb.CustomFieldType(field.Type),
+		Options:    field.Options,
+		CreatedAt:  timestamppb.New(field.CreatedAt),
+	}
+}
+
+func CustomFieldDefinitionFromProto(field *pb.CustomFieldDefinition) (*entities.CustomFieldDefinition, error) {
+	id, err := uuid.Parse(field.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(field.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.CustomFieldDefinition{
+		ID:         id,
+		UserID:     userID,
+		EntityType: entities.CustomFieldEntity(field.EntityType),
+		Key:        field.Key,
+		Name:       field.Name,
+		Type:       entities.CustomFieldType(field.Type),
+		Options:    field.Options,
+		CreatedAt:  timeFromProto(field.CreatedAt),
+	}, nil
+}
+
+// CustomFieldsToProto devuelve nil si la entidad no tiene campos personalizados
+func CustomFieldsToProto(fields entities.CustomFields) map[string]*pb.CustomFieldValue {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make(map[string]*pb.CustomFieldValue, len(fields))
+	for key, value := range fields {
+		result[key] = CustomFieldValueToProto(value)
+	}
+	return result
+}
+
+func CustomFieldValueToProto(value entities.CustomFieldValue) *pb.CustomFieldValue {
+	switch value.Type {
+	case entities.CustomFieldTypeNumber:
+		return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Number{Number: value.Number}}
+	case entities.CustomFieldTypeDate:
+		return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Date{Date: value.Date.Format(time.DateOnly)}}
+	case entities.CustomFieldTypeEnum:
+		return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_EnumValue{EnumValue: value.Text}}
+	default:
+		return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Text{Text: value.Text}}
+	}
+}
+
+func CustomFieldsFromProto(fields map[string]*pb.CustomFieldValue) (entities.CustomFields, error) {
+	result := make(entities.CustomFields, len(fields))
+	for key, value := range fields {
+		converted, err := CustomFieldValueFromProto(value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+// CustomFieldValueFromProto devuelve ErrInvalidCustomFieldValue si el valor está vacío o la
+// fecha no tiene el formato AAAA-MM-DD
+func CustomFieldValueFromProto(value *pb.CustomFieldValue) (entities.CustomFieldValue, error) {
+	switch v := value.GetValue().(type) {
+	case *pb.CustomFieldValue_Text:
+		return entities.CustomFieldValue{Type: entities.CustomFieldTypeText, Text: v.Text}, nil
+	case *pb.CustomFieldValue_Number:
+		return entities.CustomFieldValue{Type: entities.CustomFieldTypeNumber, Number: v.Number}, nil
+	case *pb.CustomFieldValue_Date:
+		date, err := time.Parse(time.DateOnly, v.Date)
+		if err != nil {
+			return entities.CustomFieldValue{}, entities.ErrInvalidCustomFieldValue
+		}
+		return entities.NewCustomFieldDate(date), nil
+	case *pb.CustomFieldValue_EnumValue:
+		return entities.CustomFieldValue{Type: entities.CustomFieldTypeEnum, Text: v.EnumValue}, nil
+	}
+	return entities.CustomFieldValue{}, entities.ErrInvalidCustomFieldValue
+}
+
+func CustomFieldFiltersFromProto(filters []*pb.CustomFieldFilter) ([]entities.CustomFieldFilter, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	result := make([]entities.CustomFieldFilter, len(filters))
+	for i, filter := range filters {
+		value, err := CustomFieldValueFromProto(filter.Value)
+		if err != nil {
+			return nil, entities.ErrInvalidCustomFieldFilter
+		}
+		result[i] = entities.CustomFieldFilter{
+			Key:      filter.Key,
+			Operator: entities.CustomFieldOperator(filter.Operator),
+			Value:    value,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package convert
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FileInfoToProto(fileInfo *entities.FileInfo) *pb.FileInfo {
+	return &pb.FileInfo{
+		Id:                fileInfo.ID.String(),
+		Filename:          fileInfo.Filename,
+		ContentType:       fileInfo.ContentType,
+		Size:              fileInfo.Size,
+		Checksum:          fileInfo.Checksum,
+		ChecksumAlgorithm: fileInfo.ChecksumAlgorithm,
+		CreatedAt:         timestamppb.New(fileInfo.CreatedAt),
+		UserId:            fileInfo.UserID.String(),
+		Compressed:        fileInfo.Compressed,
+		CompressionType:   fileInfo.CompressionType,
+		Path:              fileInfo.Path,
+		LogicalId:         fileInfo.LogicalID.String(),
+		Version:           fileInfo.Version,
+		Preview:           FilePreviewToProto(fileInfo.Preview),
+		StorageTier:       string(fileInfo.StorageTier),
+	}
+}
+
+func FileInfoFromProto(fileInfo *pb.FileInfo) (*entities.FileInfo, error) {
+	id, err := uuid.Parse(fileInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(fileInfo.UserId)
+	if err != nil {
+		return nil, err
+	}
+	logicalID, err := parseOptionalUUID(fileInfo.LogicalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.FileInfo{
+		ID:                id,
+		Filename:          fileInfo.Filename,
+		ContentType:       fileInfo.ContentType,
+		Size:              fileInfo.Size,
+		Checksum:          fileInfo.Checksum,
+		ChecksumAlgorithm: fileInfo.ChecksumAlgorithm,
+		CreatedAt:         timeFromProto(fileInfo.CreatedAt),
+		UserID:            userID,
+		Compressed:        fileInfo.Compressed,
+		CompressionType:   fileInfo.CompressionType,
+		Path:              fileInfo.Path,
+		LogicalID:         logicalID,
+		Version:           fileInfo.Version,
+		Preview:           FilePreviewFromProto(fileInfo.Preview),
+		StorageTier:       entities.StorageTier(fileInfo.StorageTier),
+	}, nil
+}
+
+// FilePreviewToProto devuelve nil si no se pudieron extraer metadatos; la duración se expresa en
+// milisegundos
+func FilePreviewToProto(preview *entities.FilePreview) *pb.FilePreview {
+	if preview == nil {
+		return nil
+	}
+	return &pb.FilePreview{
+		Width:       preview.Width,
+		Height:      preview.Height,
+		PageCount:   preview.PageCount,
+		DurationMs:  preview.Duration.Milliseconds(),
+		Exif:        preview.EXIF,
+		GpsStripped: preview.GPSStripped,
+	}
+}
+
+func FilePreviewFromProto(preview *pb.FilePreview) *entities.FilePreview {
+	if preview == nil {
+		return nil
+	}
+	return &entities.FilePreview{
+		Width:       preview.Width,
+		Height:      preview.Height,
+		PageCount:   preview.PageCount,
+		Duration:    time.Duration(preview.DurationMs) * time.Millisecond,
+		EXIF:        preview.Exif,
+		GPSStripped: preview.GpsStripped,
+	}
+}
+
+// ShareLinkToProto solo indica si el enlace tiene contraseña; el token y los hashes no salen del servidor
+func ShareLinkToProto(link *entities.ShareLink) *pb.ShareLink {
+	return &pb.ShareLink{
+		Id:                link.ID.String(),
+		FileId:            link.FileID.String(),
+		ExpiresAt:         timestamppb.New(link.ExpiresAt),
+		PasswordProtected: link.HasPassword(),
+		MaxDownloads:      link.MaxDownloads,
+		DownloadCount:     link.DownloadCount,
+		CreatedAt:         timestamppb.New(link.CreatedAt),
+		RevokedAt:         optionalTimestamp(link.RevokedAt),
+	}
+}
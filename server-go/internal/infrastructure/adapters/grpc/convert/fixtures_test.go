@@ -0,0 +1,373 @@
+package convert
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// Las entidades de prueba completan todos los campos que llegan al proto, para que los tests de
+// contrato detecten los que un conversor deja sin completar. Los valores son fijos porque se
+// comparan con los archivos de testdata.
+
+var (
+	fixtureTime  = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	fixtureLater = fixtureTime.Add(90 * time.Minute)
+
+	fixtureUserID      = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	fixtureIdeaID      = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	fixtureRelatedID   = uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	fixtureOtherUserID = uuid.MustParse("44444444-4444-4444-4444-444444444444")
+	fixtureReminderID  = uuid.MustParse("55555555-5555-5555-5555-555555555555")
+	fixtureFileID      = uuid.MustParse("66666666-6666-6666-6666-666666666666")
+	fixtureLogicalID   = uuid.MustParse("77777777-7777-7777-7777-777777777777")
+	fixtureProgressID  = uuid.MustParse("88888888-8888-8888-8888-888888888888")
+	fixtureMilestoneID = uuid.MustParse("99999999-9999-9999-9999-999999999999")
+	fixtureID          = uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	fixtureSecondID    = uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+// fixtureCustomFields tiene un valor de cada tipo
+func fixtureCustomFields() entities.CustomFields {
+	return entities.CustomFields{
+		"entrega":    entities.NewCustomFieldDate(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+		"equipo":     {Type: entities.CustomFieldTypeEnum, Text: "movil"},
+		"estimacion": {Type: entities.CustomFieldTypeNumber, Number: 5.5},
+		"nota":       {Type: entities.CustomFieldTypeText, Text: "revisar con diseño"},
+	}
+}
+
+func fixtureIdea() *entities.Idea {
+	return &entities.Idea{
+		ID:           fixtureIdeaID,
+		Title:        "Notas por voz",
+		Content:      "Transcribir las notas de voz y guardarlas como ideas",
+		Tags:         []string{"producto", "voz"},
+		Category:     entities.IdeaCategoryTechnical,
+		Status:       entities.IdeaStatusActive,
+		CreatedAt:    fixtureTime,
+		UpdatedAt:    fixtureLater,
+		UserID:       fixtureUserID,
+		RelatedIdeas: []uuid.UUID{fixtureRelatedID},
+		Priority:     3,
+		Position:     "m",
+		CustomFields: fixtureCustomFields(),
+		Version:      7,
+		CompactedAt:  timePtr(fixtureLater),
+	}
+}
+
+func fixtureIdeaChange() usecases.IdeaChange {
+	return usecases.IdeaChange{
+		Type:       usecases.IdeaChangeUpdated,
+		IdeaID:     fixtureIdeaID,
+		Idea:       fixtureIdea(),
+		OccurredAt: fixtureLater,
+	}
+}
+
+func fixtureIdeaReview() *entities.IdeaReview {
+	return &entities.IdeaReview{
+		IdeaID:         fixtureIdeaID,
+		UserID:         fixtureUserID,
+		EaseFactor:     2.36,
+		IntervalDays:   6,
+		Repetitions:    2,
+		DueAt:          fixtureTime.AddDate(0, 0, 6),
+		LastReviewedAt: timePtr(fixtureTime),
+		EnrolledAt:     time.Date(2024, 2, 20, 9, 0, 0, 0, time.UTC),
+		Version:        3,
+	}
+}
+
+func fixtureIdeaPublication() *entities.IdeaPublication {
+	return &entities.IdeaPublication{
+		ID:           fixtureID,
+		IdeaID:       fixtureIdeaID,
+		UserID:       fixtureUserID,
+		Slug:         "notas-por-voz-x7k2",
+		ExpiresAt:    timePtr(fixtureTime.AddDate(0, 1, 0)),
+		ViewCount:    42,
+		LastViewedAt: timePtr(fixtureLater),
+		CreatedAt:    fixtureTime,
+	}
+}
+
+const fixturePublicationURL = "https://notas.example.com/p/notas-por-voz-x7k2"
+
+// fixtureBoardMove es la notificación que publica el tablero al mover una idea
+func fixtureBoardMove() ports.Notification {
+	return ports.Notification{
+		ID:     fixtureID,
+		Type:   "board_idea_moved",
+		UserID: fixtureUserID,
+		Metadata: map[string]string{
+			"idea_id":         fixtureIdeaID.String(),
+			"status":          "3",
+			"previous_status": "2",
+			"position":        "n",
+			"version":         "8",
+		},
+		CreatedAt: fixtureLater,
+	}
+}
+
+func fixtureStatistics() *entities.UserStatistics {
+	return &entities.UserStatistics{
+		UserID: fixtureUserID,
+		IdeasByStatus: map[entities.IdeaStatus]int{
+			entities.IdeaStatusActive: 4,
+			entities.IdeaStatusDraft:  2,
+		},
+		IdeasByCategory: map[entities.IdeaCategory]int{
+			entities.IdeaCategoryTechnical: 5,
+			entities.IdeaCategoryBusiness:  1,
+		},
+		RemindersDueThisWeek: 3,
+		WeekStart:            time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+		StorageFiles:         12,
+		StorageBytes:         5 << 20,
+		UpdatedAt:            fixtureLater,
+	}
+}
+
+func fixtureReminder() *entities.Reminder {
+	return &entities.Reminder{
+		ID:                   fixtureReminderID,
+		Title:                "Ensayar la demo",
+		Description:          "Con el equipo de producto",
+		ScheduledTime:        time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC),
+		Type:                 entities.ReminderTypeDeadline,
+		Status:               entities.ReminderStatusOverdue,
+		Recurring:            true,
+		RecurrencePattern:    entities.RecurrencePatternWeekly,
+		CreatedAt:            fixtureTime,
+		UpdatedAt:            fixtureLater,
+		UserID:               fixtureUserID,
+		NotificationChannels: []string{"push", "email"},
+		IdeaID:               fixtureIdeaID,
+		AssigneeID:           fixtureOtherUserID,
+		AssignmentStatus:     entities.ReminderAssignmentAccepted,
+		EscalationPolicy: &entities.ReminderEscalationPolicy{
+			Steps: []entities.ReminderEscalationStep{
+				{After: 30 * time.Minute, Channels: []string{"push"}},
+				{After: 2 * time.Hour, Channels: []string{"email", "sms"}, NotifyBackupContact: true},
+			},
+			BackupContactID: fixtureOtherUserID,
+		},
+		EscalationLevel: 1,
+		AcknowledgedAt:  timePtr(fixtureLater),
+		MilestoneLink: &entities.ReminderMilestoneLink{
+			ProgressID:        fixtureProgressID,
+			MilestoneID:       fixtureMilestoneID,
+			CompletesReminder: true,
+		},
+		Version: 4,
+	}
+}
+
+func fixtureFileInfo() *entities.FileInfo {
+	return &entities.FileInfo{
+		ID:                fixtureFileID,
+		Filename:          "pizarra.jpg",
+		ContentType:       "image/jpeg",
+		Size:              204800,
+		Checksum:          "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		ChecksumAlgorithm: entities.ChecksumAlgorithmSHA256,
+		CreatedAt:         fixtureTime,
+		UserID:            fixtureUserID,
+		Compressed:        true,
+		CompressionType:   "gzip",
+		Path:              "uploads/11111111/pizarra.jpg",
+		LogicalID:         fixtureLogicalID,
+		Version:           2,
+		Preview: &entities.FilePreview{
+			Width:       1920,
+			Height:      1080,
+			PageCount:   1,
+			Duration:    1500 * time.Millisecond,
+			EXIF:        map[string]string{"Make": "Canon", "Model": "EOS R6"},
+			GPSStripped: true,
+		},
+		StorageTier: entities.StorageTierCold,
+	}
+}
+
+func fixtureShareLink() *entities.ShareLink {
+	return &entities.ShareLink{
+		ID:            fixtureID,
+		FileID:        fixtureFileID,
+		UserID:        fixtureUserID,
+		TokenHash:     "token-hash",
+		PasswordHash:  "password-hash",
+		ExpiresAt:     fixtureTime.AddDate(0, 0, 7),
+		MaxDownloads:  10,
+		DownloadCount: 3,
+		CreatedAt:     fixtureTime,
+		RevokedAt:     timePtr(fixtureLater),
+	}
+}
+
+func fixtureCustomFieldDefinition() *entities.CustomFieldDefinition {
+	return &entities.CustomFieldDefinition{
+		ID:         fixtureID,
+		UserID:     fixtureUserID,
+		EntityType: entities.CustomFieldEntityIdea,
+		Key:        "equipo",
+		Name:       "Equipo",
+		Type:       entities.CustomFieldTypeEnum,
+		Options:    []string{"movil", "web"},
+		CreatedAt:  fixtureTime,
+	}
+}
+
+func fixtureProgress() *entities.Progress {
+	return &entities.Progress{
+		ID:                   fixtureProgressID,
+		UserID:               fixtureUserID,
+		ProjectName:          "Lanzamiento de la app",
+		Description:          "Versión 1.0 en las tiendas",
+		CompletionPercentage: 62.5,
+		Milestones: []entities.ProgressMilestone{
+			{
+				ID:                fixtureMilestoneID,
+				Name:              "Beta cerrada",
+				Description:       "Con 50 usuarios",
+				Completed:         true,
+				DueDate:           time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+				CompletedAt:       timePtr(fixtureTime),
+				EstimatedEffort:   8 * time.Hour,
+				EstimatedDuration: 14 * 24 * time.Hour,
+				Weight:            3,
+			},
+			{
+				ID:                fixtureSecondID,
+				Name:              "Publicación",
+				DueDate:           time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				EstimatedEffort:   2 * time.Hour,
+				EstimatedDuration: 3 * 24 * time.Hour,
+			},
+		},
+		CustomFields: fixtureCustomFields(),
+		CreatedAt:    fixtureTime,
+		UpdatedAt:    fixtureLater,
+		Version:      5,
+	}
+}
+
+func fixtureTimeEntry() *entities.TimeEntry {
+	return &entities.TimeEntry{
+		ID:          fixtureID,
+		UserID:      fixtureUserID,
+		ProgressID:  fixtureProgressID,
+		MilestoneID: fixtureMilestoneID,
+		StartedAt:   fixtureTime,
+		StoppedAt:   timePtr(fixtureLater),
+	}
+}
+
+func fixtureProgressEffort() *entities.ProgressEffort {
+	return &entities.ProgressEffort{
+		Milestones: []entities.MilestoneEffort{
+			{MilestoneID: fixtureMilestoneID, Name: "Beta cerrada", Estimated: 8 * time.Hour, Actual: 90 * time.Minute},
+			{MilestoneID: fixtureSecondID, Name: "Publicación", Estimated: 2 * time.Hour},
+		},
+		Daily:     []entities.DailyEffort{{Day: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Actual: 90 * time.Minute}},
+		Estimated: 10 * time.Hour,
+		Actual:    90 * time.Minute,
+	}
+}
+
+func fixtureProgressForecast() *entities.ProgressForecast {
+	return &entities.ProgressForecast{
+		Estimated:   time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC),
+		P50:         time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+		P85:         time.Date(2024, 3, 7, 10, 0, 0, 0, time.UTC),
+		P95:         time.Date(2024, 3, 9, 10, 0, 0, 0, time.UTC),
+		Samples:     12,
+		Unestimated: 1,
+		Runs:        entities.ForecastRuns,
+	}
+}
+
+func fixtureProgressTemplate() *entities.ProgressTemplate {
+	return &entities.ProgressTemplate{
+		ID:          fixtureID,
+		OwnerID:     fixtureUserID,
+		Name:        "Lanzamiento móvil",
+		Description: "Hitos habituales de una app nueva",
+		Milestones: []entities.TemplateMilestone{
+			{Name: "Beta cerrada", Description: "Con 50 usuarios", DueOffset: 14 * 24 * time.Hour, Weight: 3},
+			{Name: "Publicación", DueOffset: 30 * 24 * time.Hour},
+		},
+		Organization: "acme",
+		CreatedAt:    fixtureTime,
+		UpdatedAt:    fixtureLater,
+	}
+}
+
+func fixtureNotification() ports.Notification {
+	return ports.Notification{
+		ID:        fixtureID,
+		Title:     "Ensayar la demo",
+		Message:   "El recordatorio venció",
+		Type:      "reminder_overdue",
+		UserID:    fixtureUserID,
+		Channels:  []string{"push"},
+		Metadata:  map[string]string{"reminder_id": fixtureReminderID.String()},
+		CreatedAt: fixtureLater,
+	}
+}
+
+func fixtureChatBinding() *entities.ChatBinding {
+	return &entities.ChatBinding{
+		ID:            fixtureID,
+		UserID:        fixtureUserID,
+		Provider:      entities.ChatProviderTelegram,
+		ChatID:        "12345",
+		CodeExpiresAt: fixtureTime.Add(10 * time.Minute),
+		CreatedAt:     fixtureTime,
+		LinkedAt:      timePtr(fixtureLater),
+	}
+}
+
+func fixtureInboundAddress() *entities.InboundAddress {
+	return &entities.InboundAddress{
+		ID:             fixtureID,
+		UserID:         fixtureUserID,
+		TokenHash:      "token-hash",
+		AllowedSenders: []string{"ana@example.com", "@example.org"},
+		CreatedAt:      fixtureTime,
+		LastUsedAt:     timePtr(fixtureLater),
+		RevokedAt:      timePtr(fixtureLater.Add(time.Hour)),
+	}
+}
+
+func fixturePhoneNumber() *entities.PhoneNumber {
+	return &entities.PhoneNumber{
+		UserID:     fixtureUserID,
+		Number:     "+34600111222",
+		VerifiedAt: timePtr(fixtureLater),
+		CreatedAt:  fixtureTime,
+		UpdatedAt:  fixtureLater,
+	}
+}
+
+// fixtureClientMetric solo tiene los campos que envía el cliente
+func fixtureClientMetric() *entities.ClientMetric {
+	return &entities.ClientMetric{
+		Kind:       entities.ClientMetricSyncDuration,
+		Name:       "sync",
+		Value:      1250.5,
+		Message:    "sincronización completa",
+		Attributes: map[string]string{"network": "wifi"},
+		OccurredAt: fixtureTime,
+	}
+}
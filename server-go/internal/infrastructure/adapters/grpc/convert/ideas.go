@@ -0,0 +1,205 @@
+package convert
+
+import (
+	"sort"
+	"strconv"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func IdeaToProto(idea *entities.Idea) *pb.Idea {
+	return &pb.Idea{
+		Id:           idea.ID.String(),
+		Title:        idea.Title,
+		Content:      idea.Content,
+		Tags:         idea.Tags,
+		Category:     pb.IdeaCategory(idea.Category),
+		Status:       pb.IdeaStatus(idea.Status),
+		CreatedAt:    timestamppb.New(idea.CreatedAt),
+		UpdatedAt:    timestamppb.New(idea.UpdatedAt),
+		UserId:       idea.UserID.String(),
+		RelatedIdeas: uuidsToProto(idea.RelatedIdeas),
+		Priority:     idea.Priority,
+		Version:      idea.Version,
+		Position:     idea.Position,
+		CustomFields: CustomFieldsToProto(idea.CustomFields),
+		CompactedAt:  optionalTimestamp(idea.CompactedAt),
+	}
+}
+
+func IdeaFromProto(idea *pb.Idea) (*entities.Idea, error) {
+	id, err := uuid.Parse(idea.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(idea.UserId)
+	if err != nil {
+		return nil, err
+	}
+	relatedIdeas, err := uuidsFromProto(idea.RelatedIdeas)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.Idea{
+		ID:           id,
+		Title:        idea.Title,
+		Content:      idea.Content,
+		Tags:         idea.Tags,
+		Category:     entities.IdeaCategory(idea.Category),
+		Status:       entities.IdeaStatus(idea.Status),
+		CreatedAt:    timeFromProto(idea.CreatedAt),
+		UpdatedAt:    timeFromProto(idea.UpdatedAt),
+		UserID:       userID,
+		RelatedIdeas: relatedIdeas,
+		Priority:     idea.Priority,
+		Position:     idea.Position,
+		Version:      idea.Version,
+		CompactedAt:  optionalTimeFromProto(idea.CompactedAt),
+	}
+	if len(idea.CustomFields) > 0 {
+		result.CustomFields, err = CustomFieldsFromProto(idea.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// IdeaToProtoV2 convierte a la v2, que no expone la posición en el tablero, los campos
+// personalizados ni la compactación, y agrega la ETag
+func IdeaToProtoV2(idea *entities.Idea) *pbv2.Idea {
+	return &pbv2.Idea{
+		Id:           idea.ID.String(),
+		Title:        idea.Title,
+		Content:      idea.Content,
+		Tags:         idea.Tags,
+		Category:     pbv2.IdeaCategory(idea.Category),
+		Status:       pbv2.IdeaStatus(idea.Status),
+		CreatedAt:    timestamppb.New(idea.CreatedAt),
+		UpdatedAt:    timestamppb.New(idea.UpdatedAt),
+		UserId:       idea.UserID.String(),
+		RelatedIdeas: uuidsToProto(idea.RelatedIdeas),
+		Priority:     idea.Priority,
+		Version:      idea.Version,
+		Etag:         idea.ETag(),
+	}
+}
+
+func IdeaChangeToProto(change usecases.IdeaChange) *pb.IdeaChangeEvent {
+	event := &pb.IdeaChangeEvent{
+		Type:       pb.IdeaChangeType(change.Type),
+		IdeaId:     change.IdeaID.String(),
+		OccurredAt: timestamppb.New(change.OccurredAt),
+	}
+	if change.Idea != nil {
+		event.Idea = IdeaToProto(change.Idea)
+	}
+	return event
+}
+
+func IdeaReviewToProto(review *entities.IdeaReview) *pb.IdeaReview {
+	return &pb.IdeaReview{
+		IdeaId:         review.IdeaID.String(),
+		EaseFactor:     review.EaseFactor,
+		IntervalDays:   int32(review.IntervalDays),
+		Repetitions:    int32(review.Repetitions),
+		DueAt:          timestamppb.New(review.DueAt),
+		EnrolledAt:     timestamppb.New(review.EnrolledAt),
+		LastReviewedAt: optionalTimestamp(review.LastReviewedAt),
+	}
+}
+
+// IdeaReviewFromProto no recupera el usuario ni la versión, que el proto no expone
+func IdeaReviewFromProto(review *pb.IdeaReview) (*entities.IdeaReview, error) {
+	ideaID, err := uuid.Parse(review.IdeaId)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IdeaReview{
+		IdeaID:         ideaID,
+		EaseFactor:     review.EaseFactor,
+		IntervalDays:   int(review.IntervalDays),
+		Repetitions:    int(review.Repetitions),
+		DueAt:          timeFromProto(review.DueAt),
+		LastReviewedAt: optionalTimeFromProto(review.LastReviewedAt),
+		EnrolledAt:     timeFromProto(review.EnrolledAt),
+	}, nil
+}
+
+// IdeaPublicationToProto recibe la URL pública aparte porque depende de la configuración del servidor
+func IdeaPublicationToProto(publication *entities.IdeaPublication, url string) *pb.IdeaPublication {
+	return &pb.IdeaPublication{
+		Id:           publication.ID.String(),
+		IdeaId:       publication.IdeaID.String(),
+		Slug:         publication.Slug,
+		Url:          url,
+		ViewCount:    publication.ViewCount,
+		CreatedAt:    timestamppb.New(publication.CreatedAt),
+		ExpiresAt:    optionalTimestamp(publication.ExpiresAt),
+		LastViewedAt: optionalTimestamp(publication.LastViewedAt),
+	}
+}
+
+// BoardEventToProto lee el movimiento de los metadatos de la notificación que publica el tablero
+func BoardEventToProto(notification ports.Notification) *pb.BoardEvent {
+	newStatus, _ := strconv.Atoi(notification.Metadata["status"])
+	previousStatus, _ := strconv.Atoi(notification.Metadata["previous_status"])
+	version, _ := strconv.ParseInt(notification.Metadata["version"], 10, 64)
+
+	return &pb.BoardEvent{
+		IdeaId:         notification.Metadata["idea_id"],
+		Status:         pb.IdeaStatus(newStatus),
+		PreviousStatus: pb.IdeaStatus(previousStatus),
+		Position:       notification.Metadata["position"],
+		Version:        version,
+		MovedAt:        timestamppb.New(notification.CreatedAt),
+	}
+}
+
+// StatisticsToProto ordena los conteos por estado y por categoría para que la respuesta sea estable
+func StatisticsToProto(stats *entities.UserStatistics) *pb.UserStatistics {
+	byStatus := make([]*pb.IdeaStatusCount, 0, len(stats.IdeasByStatus))
+	for ideaStatus, count := range stats.IdeasByStatus {
+		byStatus = append(byStatus, &pb.IdeaStatusCount{Status: pb.IdeaStatus(ideaStatus), Count: int32(count)})
+	}
+	sort.Slice(byStatus, func(i, j int) bool { return byStatus[i].Status < byStatus[j].Status })
+
+	byCategory := make([]*pb.IdeaCategoryCount, 0, len(stats.IdeasByCategory))
+	for category, count := range stats.IdeasByCategory {
+		byCategory = append(byCategory, &pb.IdeaCategoryCount{Category: pb.IdeaCategory(category), Count: int32(count)})
+	}
+	sort.Slice(byCategory, func(i, j int) bool { return byCategory[i].Category < byCategory[j].Category })
+
+	return &pb.UserStatistics{
+		IdeasByStatus:        byStatus,
+		IdeasByCategory:      byCategory,
+		TotalIdeas:           int32(stats.TotalIdeas()),
+		RemindersDueThisWeek: int32(stats.RemindersDueThisWeek),
+		WeekStart:            timestamppb.New(stats.WeekStart),
+		StorageFileCount:     int32(stats.StorageFiles),
+		StorageBytes:         stats.StorageBytes,
+		UpdatedAt:            timestamppb.New(stats.UpdatedAt),
+	}
+}
+
+// SortFromProto usa sort_by y sort_desc solo si la petición no trae sort
+func SortFromProto(fields []*pb.SortField, sortBy string, sortDesc bool) []entities.SortField {
+	if len(fields) == 0 {
+		if sortBy == "" {
+			return nil
+		}
+		return []entities.SortField{{Field: sortBy, Desc: sortDesc}}
+	}
+	result := make([]entities.SortField, len(fields))
+	for i, field := range fields {
+		result[i] = entities.SortField{Field: field.Field, Desc: field.Desc}
+	}
+	return result
+}
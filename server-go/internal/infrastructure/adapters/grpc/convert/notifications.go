@@ -0,0 +1,75 @@
+package convert
+
+import (
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotificationToProto marca como replayed las notificaciones reenviadas desde el buzón
+func NotificationToProto(notification ports.Notification, replayed bool) *pb.NotificationResponse {
+	return &pb.NotificationResponse{
+		Id:        notification.ID.String(),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Type:      notification.Type,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+		UserId:    notification.UserID.String(),
+		Metadata:  notification.Metadata,
+		Channels:  notification.Channels,
+		Replayed:  replayed,
+	}
+}
+
+func NotificationFromProto(notification *pb.NotificationResponse) (ports.Notification, error) {
+	id, err := uuid.Parse(notification.Id)
+	if err != nil {
+		return ports.Notification{}, err
+	}
+	userID, err := uuid.Parse(notification.UserId)
+	if err != nil {
+		return ports.Notification{}, err
+	}
+	return ports.Notification{
+		ID:        id,
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Type:      notification.Type,
+		UserID:    userID,
+		Channels:  notification.Channels,
+		Metadata:  notification.Metadata,
+		CreatedAt: timeFromProto(notification.CreatedAt),
+	}, nil
+}
+
+// ChatBindingToProto no expone el chat vinculado ni el código de vinculación
+func ChatBindingToProto(binding *entities.ChatBinding) *pb.ChatBinding {
+	return &pb.ChatBinding{
+		Id:        binding.ID.String(),
+		Provider:  string(binding.Provider),
+		Linked:    binding.IsLinked(),
+		CreatedAt: timestamppb.New(binding.CreatedAt),
+		LinkedAt:  optionalTimestamp(binding.LinkedAt),
+	}
+}
+
+func InboundAddressToProto(address *entities.InboundAddress) *pb.InboundAddress {
+	return &pb.InboundAddress{
+		Id:             address.ID.String(),
+		AllowedSenders: address.AllowedSenders,
+		CreatedAt:      timestamppb.New(address.CreatedAt),
+		LastUsedAt:     optionalTimestamp(address.LastUsedAt),
+		RevokedAt:      optionalTimestamp(address.RevokedAt),
+	}
+}
+
+func PhoneNumberToProto(phone *entities.PhoneNumber) *pb.PhoneNumber {
+	return &pb.PhoneNumber{
+		Number:     phone.Number,
+		Verified:   phone.IsVerified(),
+		CreatedAt:  timestamppb.New(phone.CreatedAt),
+		VerifiedAt: optionalTimestamp(phone.VerifiedAt),
+	}
+}
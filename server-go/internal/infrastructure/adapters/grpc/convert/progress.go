@@ -0,0 +1,201 @@
+package convert
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// estimatedDurationUnit es la unidad de la duración estimada de los hitos y de los plazos de las
+// plantillas en la API
+const estimatedDurationUnit = 24 * time.Hour
+
+func ProgressToProto(progress *entities.Progress) *pb.Progress {
+	milestones := make([]*pb.ProgressMilestone, len(progress.Milestones))
+	for i, milestone := range progress.Milestones {
+		milestones[i] = &pb.ProgressMilestone{
+			Id:                     milestone.ID.String(),
+			Name:                   milestone.Name,
+			Description:            milestone.Description,
+			Completed:              milestone.Completed,
+			DueDate:                timestamppb.New(milestone.DueDate),
+			CompletedAt:            optionalTimestamp(milestone.CompletedAt),
+			EstimatedEffortMinutes: int64(milestone.EstimatedEffort / time.Minute),
+			EstimatedDurationDays:  int32(milestone.EstimatedDuration / estimatedDurationUnit),
+			Weight:                 milestone.Weight,
+		}
+	}
+
+	return &pb.Progress{
+		Id:                   progress.ID.String(),
+		UserId:               progress.UserID.String(),
+		ProjectName:          progress.ProjectName,
+		Description:          progress.Description,
+		CompletionPercentage: progress.CompletionPercentage,
+		Milestones:           milestones,
+		CreatedAt:            timestamppb.New(progress.CreatedAt),
+		UpdatedAt:            timestamppb.New(progress.UpdatedAt),
+		Version:              progress.Version,
+		CustomFields:         CustomFieldsToProto(progress.CustomFields),
+	}
+}
+
+func ProgressFromProto(progress *pb.Progress) (*entities.Progress, error) {
+	id, err := uuid.Parse(progress.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(progress.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, err := MilestonesFromProto(progress.Milestones)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.Progress{
+		ID:                   id,
+		UserID:               userID,
+		ProjectName:          progress.ProjectName,
+		Description:          progress.Description,
+		CompletionPercentage: progress.CompletionPercentage,
+		Milestones:           milestones,
+		CreatedAt:            timeFromProto(progress.CreatedAt),
+		UpdatedAt:            timeFromProto(progress.UpdatedAt),
+		Version:              progress.Version,
+	}
+	if len(progress.CustomFields) > 0 {
+		result.CustomFields, err = CustomFieldsFromProto(progress.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// MilestonesFromProto convierte los hitos de un progreso; devuelve nil si no hay ninguno
+func MilestonesFromProto(milestones []*pb.ProgressMilestone) ([]entities.ProgressMilestone, error) {
+	if len(milestones) == 0 {
+		return nil, nil
+	}
+
+	result := make([]entities.ProgressMilestone, len(milestones))
+	for i, milestone := range milestones {
+		milestoneID, err := uuid.Parse(milestone.Id)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = entities.ProgressMilestone{
+			ID:                milestoneID,
+			Name:              milestone.Name,
+			Description:       milestone.Description,
+			Completed:         milestone.Completed,
+			DueDate:           timeFromProto(milestone.DueDate),
+			CompletedAt:       optionalTimeFromProto(milestone.CompletedAt),
+			EstimatedEffort:   time.Duration(milestone.EstimatedEffortMinutes) * time.Minute,
+			EstimatedDuration: time.Duration(milestone.EstimatedDurationDays) * estimatedDurationUnit,
+			Weight:            milestone.Weight,
+		}
+	}
+	return result, nil
+}
+
+// TimeEntryToProto convierte un registro de tiempo; la duración de un cronómetro en marcha se
+// cuenta hasta now
+func TimeEntryToProto(entry *entities.TimeEntry, now time.Time) *pb.TimeEntry {
+	if entry == nil {
+		return nil
+	}
+	return &pb.TimeEntry{
+		Id:              entry.ID.String(),
+		ProgressId:      entry.ProgressID.String(),
+		MilestoneId:     entry.MilestoneID.String(),
+		StartedAt:       timestamppb.New(entry.StartedAt),
+		StoppedAt:       optionalTimestamp(entry.StoppedAt),
+		DurationSeconds: int64(entry.Duration(now) / time.Second),
+	}
+}
+
+// ProgressEffortToProto convierte el informe de esfuerzo; el cronómetro en marcha se convierte
+// aparte con TimeEntryToProto
+func ProgressEffortToProto(effort *entities.ProgressEffort) *pb.ProgressEffort {
+	milestones := make([]*pb.MilestoneEffort, len(effort.Milestones))
+	for i, milestone := range effort.Milestones {
+		milestones[i] = &pb.MilestoneEffort{
+			MilestoneId:      milestone.MilestoneID.String(),
+			Name:             milestone.Name,
+			EstimatedSeconds: int64(milestone.Estimated / time.Second),
+			ActualSeconds:    int64(milestone.Actual / time.Second),
+		}
+	}
+	daily := make([]*pb.DailyEffort, len(effort.Daily))
+	for i, day := range effort.Daily {
+		daily[i] = &pb.DailyEffort{
+			Date:          day.Day.Format(time.DateOnly),
+			ActualSeconds: int64(day.Actual / time.Second),
+		}
+	}
+
+	return &pb.ProgressEffort{
+		EstimatedSeconds: int64(effort.Estimated / time.Second),
+		ActualSeconds:    int64(effort.Actual / time.Second),
+		Milestones:       milestones,
+		Daily:            daily,
+	}
+}
+
+func ProgressForecastToProto(forecast *entities.ProgressForecast) *pb.ProgressForecast {
+	return &pb.ProgressForecast{
+		EstimatedCompletion:   timestamppb.New(forecast.Estimated),
+		P50Completion:         timestamppb.New(forecast.P50),
+		P85Completion:         timestamppb.New(forecast.P85),
+		P95Completion:         timestamppb.New(forecast.P95),
+		HistorySamples:        int32(forecast.Samples),
+		UnestimatedMilestones: int32(forecast.Unestimated),
+		Runs:                  int32(forecast.Runs),
+	}
+}
+
+func ProgressTemplateToProto(template *entities.ProgressTemplate) *pb.ProgressTemplate {
+	milestones := make([]*pb.TemplateMilestone, len(template.Milestones))
+	for i, milestone := range template.Milestones {
+		milestones[i] = &pb.TemplateMilestone{
+			Name:          milestone.Name,
+			Description:   milestone.Description,
+			DueOffsetDays: int32(milestone.DueOffset / estimatedDurationUnit),
+			Weight:        milestone.Weight,
+		}
+	}
+
+	return &pb.ProgressTemplate{
+		Id:           template.ID.String(),
+		OwnerId:      template.OwnerID.String(),
+		Name:         template.Name,
+		Description:  template.Description,
+		Milestones:   milestones,
+		Organization: template.Organization,
+		CreatedAt:    timestamppb.New(template.CreatedAt),
+		UpdatedAt:    timestamppb.New(template.UpdatedAt),
+	}
+}
+
+func TemplateMilestonesFromProto(milestones []*pb.TemplateMilestone) []entities.TemplateMilestone {
+	if len(milestones) == 0 {
+		return nil
+	}
+	result := make([]entities.TemplateMilestone, len(milestones))
+	for i, milestone := range milestones {
+		result[i] = entities.TemplateMilestone{
+			Name:        milestone.Name,
+			Description: milestone.Description,
+			DueOffset:   time.Duration(milestone.DueOffsetDays) * estimatedDurationUnit,
+			Weight:      milestone.Weight,
+		}
+	}
+	return result
+}
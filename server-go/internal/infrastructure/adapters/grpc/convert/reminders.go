@@ -0,0 +1,154 @@
+package convert
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ReminderToProto(reminder *entities.Reminder) *pb.Reminder {
+	result := &pb.Reminder{
+		Id:                   reminder.ID.String(),
+		Title:                reminder.Title,
+		Description:          reminder.Description,
+		ScheduledTime:        timestamppb.New(reminder.ScheduledTime),
+		Type:                 pb.ReminderType(reminder.Type),
+		Status:               pb.ReminderStatus(reminder.Status),
+		Recurring:            reminder.Recurring,
+		RecurrencePattern:    pb.RecurrencePattern(reminder.RecurrencePattern),
+		CreatedAt:            timestamppb.New(reminder.CreatedAt),
+		UpdatedAt:            timestamppb.New(reminder.UpdatedAt),
+		UserId:               reminder.UserID.String(),
+		NotificationChannels: reminder.NotificationChannels,
+		Version:              reminder.Version,
+		IdeaId:               optionalUUID(reminder.IdeaID),
+		AssigneeId:           optionalUUID(reminder.AssigneeID),
+		AssignmentStatus:     pb.ReminderAssignmentStatus(reminder.AssignmentStatus),
+		AcknowledgedAt:       optionalTimestamp(reminder.AcknowledgedAt),
+	}
+	if reminder.EscalationPolicy != nil {
+		result.EscalationPolicy = EscalationPolicyToProto(reminder.EscalationPolicy)
+		result.EscalationLevel = int32(reminder.EscalationLevel)
+	}
+	if link := reminder.MilestoneLink; link != nil {
+		result.MilestoneLink = &pb.ReminderMilestoneLink{
+			ProgressId:        link.ProgressID.String(),
+			MilestoneId:       link.MilestoneID.String(),
+			CompletesReminder: link.CompletesReminder,
+		}
+	}
+	return result
+}
+
+func ReminderFromProto(reminder *pb.Reminder) (*entities.Reminder, error) {
+	id, err := uuid.Parse(reminder.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(reminder.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ideaID, err := parseOptionalUUID(reminder.IdeaId)
+	if err != nil {
+		return nil, err
+	}
+	assigneeID, err := parseOptionalUUID(reminder.AssigneeId)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := EscalationPolicyFromProto(reminder.EscalationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	link, err := milestoneLinkFromProto(reminder.MilestoneLink)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Reminder{
+		ID:                   id,
+		Title:                reminder.Title,
+		Description:          reminder.Description,
+		ScheduledTime:        timeFromProto(reminder.ScheduledTime),
+		Type:                 entities.ReminderType(reminder.Type),
+		Status:               entities.ReminderStatus(reminder.Status),
+		Recurring:            reminder.Recurring,
+		RecurrencePattern:    entities.RecurrencePattern(reminder.RecurrencePattern),
+		CreatedAt:            timeFromProto(reminder.CreatedAt),
+		UpdatedAt:            timeFromProto(reminder.UpdatedAt),
+		UserID:               userID,
+		NotificationChannels: reminder.NotificationChannels,
+		IdeaID:               ideaID,
+		AssigneeID:           assigneeID,
+		AssignmentStatus:     entities.ReminderAssignmentStatus(reminder.AssignmentStatus),
+		EscalationPolicy:     policy,
+		EscalationLevel:      int(reminder.EscalationLevel),
+		AcknowledgedAt:       optionalTimeFromProto(reminder.AcknowledgedAt),
+		MilestoneLink:        link,
+		Version:              reminder.Version,
+	}, nil
+}
+
+func milestoneLinkFromProto(link *pb.ReminderMilestoneLink) (*entities.ReminderMilestoneLink, error) {
+	if link == nil {
+		return nil, nil
+	}
+
+	progressID, err := uuid.Parse(link.ProgressId)
+	if err != nil {
+		return nil, err
+	}
+	milestoneID, err := uuid.Parse(link.MilestoneId)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ReminderMilestoneLink{
+		ProgressID:        progressID,
+		MilestoneID:       milestoneID,
+		CompletesReminder: link.CompletesReminder,
+	}, nil
+}
+
+// EscalationPolicyToProto redondea los pasos a minutos, la resolución de la API
+func EscalationPolicyToProto(policy *entities.ReminderEscalationPolicy) *pb.ReminderEscalationPolicy {
+	result := &pb.ReminderEscalationPolicy{
+		Steps:           make([]*pb.ReminderEscalationStep, len(policy.Steps)),
+		BackupContactId: optionalUUID(policy.BackupContactID),
+	}
+	for i, step := range policy.Steps {
+		result.Steps[i] = &pb.ReminderEscalationStep{
+			AfterMinutes:        int32(step.After / time.Minute),
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	return result
+}
+
+// EscalationPolicyFromProto devuelve nil si la petición no trae política
+func EscalationPolicyFromProto(policy *pb.ReminderEscalationPolicy) (*entities.ReminderEscalationPolicy, error) {
+	if policy == nil || len(policy.Steps) == 0 {
+		return nil, nil
+	}
+
+	backupContactID, err := parseOptionalUUID(policy.BackupContactId)
+	if err != nil {
+		return nil, err
+	}
+	result := &entities.ReminderEscalationPolicy{
+		Steps:           make([]entities.ReminderEscalationStep, len(policy.Steps)),
+		BackupContactID: backupContactID,
+	}
+	for i, step := range policy.Steps {
+		result.Steps[i] = entities.ReminderEscalationStep{
+			After:               time.Duration(step.AfterMinutes) * time.Minute,
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package convert
+
+import (
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var clientMetricKinds = map[pb.ClientMetricKind]entities.ClientMetricKind{
+	pb.ClientMetricKind_CLIENT_METRIC_KIND_SYNC_DURATION:    entities.ClientMetricSyncDuration,
+	pb.ClientMetricKind_CLIENT_METRIC_KIND_CRASH_BREADCRUMB: entities.ClientMetricCrashBreadcrumb,
+	pb.ClientMetricKind_CLIENT_METRIC_KIND_FEATURE_USAGE:    entities.ClientMetricFeatureUsage,
+}
+
+// ClientMetricFromProto deja el tipo vacío si el cliente envía uno desconocido, para que la
+// validación del caso de uso lo rechace
+func ClientMetricFromProto(metric *pb.ClientMetric) *entities.ClientMetric {
+	clientMetric := &entities.ClientMetric{
+		Kind:       clientMetricKinds[metric.Kind],
+		Name:       metric.Name,
+		Value:      metric.Value,
+		Message:    metric.Message,
+		Attributes: metric.Attributes,
+	}
+	if metric.OccurredAt != nil {
+		clientMetric.OccurredAt = metric.OccurredAt.AsTime()
+	}
+	return clientMetric
+}
+
+// ClientMetricToProto convierte solo lo que envía el cliente; el usuario, el dispositivo y la
+// recepción los completa el servidor
+func ClientMetricToProto(metric *entities.ClientMetric) *pb.ClientMetric {
+	result := &pb.ClientMetric{
+		Name:       metric.Name,
+		Value:      metric.Value,
+		Message:    metric.Message,
+		Attributes: metric.Attributes,
+	}
+	for kind, entityKind := range clientMetricKinds {
+		if entityKind == metric.Kind {
+			result.Kind = kind
+		}
+	}
+	if !metric.OccurredAt.IsZero() {
+		result.OccurredAt = timestamppb.New(metric.OccurredAt)
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// errorDomainV2 identifica el origen de los errores en los detalles ErrorInfo de la v2
+const errorDomainV2 = "notebook.v2"
+
+// ideaResourceType es el tipo de recurso informado en los detalles ResourceInfo
+const ideaResourceType = "notebook.v2.Idea"
+
+// pageTokenPrefix versiona el formato del cursor para poder cambiarlo sin romper clientes
+const pageTokenPrefix = "p1:"
+
+// convertSortFromProtoV2 usa sort_by y sort_desc solo si la petición no trae sort
+func convertSortFromProtoV2(sort []*pbv2.SortField, sortBy string, sortDesc bool) []entities.SortField {
+	if len(sort) == 0 {
+		if sortBy == "" {
+			return nil
+		}
+		return []entities.SortField{{Field: sortBy, Desc: sortDesc}}
+	}
+	result := make([]entities.SortField, len(sort))
+	for i, field := range sort {
+		result[i] = entities.SortField{Field: field.Field, Desc: field.Desc}
+	}
+	return result
+}
+
+// encodePageToken genera un cursor opaco para la página indicada
+func encodePageToken(page int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix + strconv.Itoa(page)))
+}
+
+// decodePageToken devuelve la página codificada en el cursor; un cursor vacío es la primera página
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 1, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) <= len(pageTokenPrefix) || string(decoded[:len(pageTokenPrefix)]) != pageTokenPrefix {
+		return 0, errors.New("malformed page token")
+	}
+
+	page, err := strconv.Atoi(string(decoded[len(pageTokenPrefix):]))
+	if err != nil || page < 1 {
+		return 0, errors.New("malformed page token")
+	}
+	return page, nil
+}
+
+// applyReadMask limpia los campos de primer nivel que no están en la máscara; una máscara vacía no filtra nada
+func applyReadMask(message proto.Message, mask *fieldmaskpb.FieldMask) error {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil
+	}
+	if _, err := fieldmaskpb.New(message, paths...); err != nil {
+		return err
+	}
+
+	keep := make(map[protoreflect.Name]bool, len(paths))
+	for _, path := range paths {
+		keep[protoreflect.Name(path)] = true
+	}
+
+	reflected := message.ProtoReflect()
+	reflected.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[field.Name()] {
+			reflected.Clear(field)
+		}
+		return true
+	})
+	return nil
+}
+
+// readMaskOmits verifica si la máscara deja fuera el campo; una máscara vacía no deja fuera ninguno
+func readMaskOmits(mask *fieldmaskpb.FieldMask, field string) bool {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return false
+	}
+	for _, path := range paths {
+		if path == field {
+			return false
+		}
+	}
+	return true
+}
+
+// statusWithDetails crea un status de gRPC adjuntando detalles google.rpc
+func statusWithDetails(code codes.Code, message string, details ...protoiface.MessageV1) error {
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// invalidArgumentV2 informa un campo inválido de la petición con un detalle BadRequest
+func invalidArgumentV2(field, description string) error {
+	return statusWithDetails(codes.InvalidArgument, field+": "+description, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+}
+
+// parseUUIDV2 valida un identificador de la petición
+func parseUUIDV2(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, invalidArgumentV2(field, "must be a valid UUID")
+	}
+	return id, nil
+}
+
+// ideaErrorToStatusV2 traduce los errores de dominio de ideas a status con detalles
+func ideaErrorToStatusV2(err error, ideaID string) error {
+	switch {
+	case errors.Is(err, entities.ErrIdeaNotFound):
+		return statusWithDetails(codes.NotFound, "idea not found",
+			errorInfo(pb.ErrorCode_ERROR_CODE_IDEA_NOT_FOUND, errorDomainV2),
+			&errdetails.ResourceInfo{
+				ResourceType: ideaResourceType,
+				ResourceName: ideaID,
+				Description:  err.Error(),
+			})
+	case errors.Is(err, entities.ErrIdeaUnauthorized):
+		return statusWithDetails(codes.PermissionDenied, "unauthorized",
+			errorInfo(pb.ErrorCode_ERROR_CODE_IDEA_UNAUTHORIZED, errorDomainV2))
+	case errors.Is(err, entities.ErrIdeaTitleRequired):
+		return invalidArgumentV2("idea.title", err.Error())
+	case errors.Is(err, entities.ErrIdeaContentRequired):
+		return invalidArgumentV2("idea.content", err.Error())
+	case errors.Is(err, entities.ErrInvalidUpdateMask):
+		return invalidArgumentV2("update_mask", err.Error())
+	case errors.Is(err, entities.ErrInvalidSortField):
+		return invalidArgumentV2("sort", err.Error())
+	case errors.Is(err, entities.ErrInvalidPagination):
+		return invalidArgumentV2("count_mode", err.Error())
+	case errors.Is(err, entities.ErrServiceUnavailable):
+		return statusWithDetails(codes.Unavailable, "service temporarily unavailable",
+			errorInfo(pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE, errorDomainV2))
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCustomField implementa la definición de un campo personalizado
+func (s *NotebookServer) CreateCustomField(ctx context.Context, req *pb.CreateCustomFieldRequest) (*pb.CreateCustomFieldResponse, error) {
+	if s.customFields == nil {
+		return &pb.CreateCustomFieldResponse{
+			Success: false,
+			Message: "Custom fields are not enabled",
+		}, status.Error(codes.Unavailable, "custom fields not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CreateCustomFieldResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	field, err := s.customFields.CreateField(
+		ctx,
+		userID,
+		entities.CustomFieldEntity(req.EntityType),
+		req.Key,
+		req.Name,
+		entities.CustomFieldType(req.Type),
+		req.Options,
+	)
+	if err != nil {
+		code, message := customFieldErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to create custom field: %v", err)
+		}
+		return &pb.CreateCustomFieldResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.CreateCustomFieldResponse{
+		Field:   convert.CustomFieldDefinitionToProto(field),
+		Success: true,
+		Message: "Custom field created successfully",
+	}, nil
+}
+
+// ListCustomFields implementa la lista de campos personalizados de un tipo de registro
+func (s *NotebookServer) ListCustomFields(ctx context.Context, req *pb.ListCustomFieldsRequest) (*pb.ListCustomFieldsResponse, error) {
+	if s.customFields == nil {
+		return &pb.ListCustomFieldsResponse{
+			Success: false,
+			Message: "Custom fields are not enabled",
+		}, status.Error(codes.Unavailable, "custom fields not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	fields, err := s.customFields.ListFields(ctx, userID, entities.CustomFieldEntity(req.EntityType))
+	if err != nil {
+		code, message := customFieldErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to list custom fields: %v", err)
+		}
+		return &pb.ListCustomFieldsResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	protoFields := make([]*pb.CustomFieldDefinition, len(fields))
+	for i, field := range fields {
+		protoFields[i] = convert.CustomFieldDefinitionToProto(field)
+	}
+
+	return &pb.ListCustomFieldsResponse{
+		Fields:  protoFields,
+		Success: true,
+		Message: "Custom fields retrieved successfully",
+	}, nil
+}
+
+// DeleteCustomField implementa la eliminación de un campo personalizado
+func (s *NotebookServer) DeleteCustomField(ctx context.Context, req *pb.DeleteCustomFieldRequest) (*pb.DeleteCustomFieldResponse, error) {
+	if s.customFields == nil {
+		return &pb.DeleteCustomFieldResponse{
+			Success: false,
+			Message: "Custom fields are not enabled",
+		}, status.Error(codes.Unavailable, "custom fields not enabled")
+	}
+
+	fieldID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.DeleteCustomFieldResponse{
+			Success: false,
+			Message: "Invalid custom field ID format",
+		}, status.Error(codes.InvalidArgument, "invalid custom field ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.DeleteCustomFieldResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := s.customFields.DeleteField(ctx, fieldID, userID); err != nil {
+		code, message := customFieldErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to delete custom field: %v", err)
+		}
+		return &pb.DeleteCustomFieldResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.DeleteCustomFieldResponse{
+		Success: true,
+		Message: "Custom field deleted successfully",
+	}, nil
+}
+
+// SetIdeaCustomFields implementa la asignación de los valores de los campos personalizados de una idea
+func (s *NotebookServer) SetIdeaCustomFields(ctx context.Context, req *pb.SetIdeaCustomFieldsRequest) (*pb.SetIdeaCustomFieldsResponse, error) {
+	ideaID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.SetIdeaCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SetIdeaCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	fields, err := convert.CustomFieldsFromProto(req.CustomFields)
+	if err != nil {
+		return &pb.SetIdeaCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid custom field value",
+		}, domainError(codes.InvalidArgument, err.Error(), err)
+	}
+
+	idea, err := s.ideaUseCases.SetIdeaCustomFields(ctx, ideaID, userID, fields, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && idea != nil {
+			latest := convert.IdeaToProto(idea)
+			st := status.New(codes.Aborted, "idea version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.SetIdeaCustomFieldsResponse{
+				Idea:    latest,
+				Success: false,
+				Message: "Idea was modified concurrently",
+			}, st.Err()
+		}
+		code, message := customFieldErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to set idea custom fields: %v", err)
+		}
+		return &pb.SetIdeaCustomFieldsResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.SetIdeaCustomFieldsResponse{
+		Idea:    convert.IdeaToProto(idea),
+		Success: true,
+		Message: "Idea custom fields updated successfully",
+	}, nil
+}
+
+// SetProgressCustomFields implementa la asignación de los valores de los campos personalizados de un progreso
+func (s *NotebookServer) SetProgressCustomFields(ctx context.Context, req *pb.SetProgressCustomFieldsRequest) (*pb.SetProgressCustomFieldsResponse, error) {
+	progressID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.SetProgressCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SetProgressCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	fields, err := convert.CustomFieldsFromProto(req.CustomFields)
+	if err != nil {
+		return &pb.SetProgressCustomFieldsResponse{
+			Success: false,
+			Message: "Invalid custom field value",
+		}, domainError(codes.InvalidArgument, err.Error(), err)
+	}
+
+	progress, err := s.progressUseCases.SetProgressCustomFields(ctx, progressID, userID, req.ExpectedVersion, fields)
+	if err != nil {
+		if err == entities.ErrVersionConflict && progress != nil {
+			latest := convert.ProgressToProto(progress)
+			st := status.New(codes.Aborted, "progress version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.SetProgressCustomFieldsResponse{
+				Progress: latest,
+				Success:  false,
+				Message:  "Progress was modified concurrently",
+			}, st.Err()
+		}
+		code, message := customFieldErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to set progress custom fields: %v", err)
+		}
+		return &pb.SetProgressCustomFieldsResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.SetProgressCustomFieldsResponse{
+		Progress: convert.ProgressToProto(progress),
+		Success:  true,
+		Message:  "Progress custom fields updated successfully",
+	}, nil
+}
+
+// customFieldErrorStatus traduce los errores de los campos personalizados y de los registros a los
+// que se asignan; codes.Internal indica un error inesperado
+func customFieldErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrCustomFieldNotFound:
+		return codes.NotFound, "Custom field not found"
+	case entities.ErrCustomFieldUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to custom field"
+	case entities.ErrCustomFieldKeyExists:
+		return codes.AlreadyExists, "A custom field with this key already exists"
+	case entities.ErrInvalidCustomField:
+		return codes.InvalidArgument, "Invalid custom field"
+	case entities.ErrTooManyCustomFields:
+		return codes.ResourceExhausted, "Too many custom fields"
+	case entities.ErrUnknownCustomField:
+		return codes.InvalidArgument, "Unknown custom field"
+	case entities.ErrInvalidCustomFieldValue:
+		return codes.InvalidArgument, "Invalid custom field value"
+	case entities.ErrInvalidCustomFieldFilter:
+		return codes.InvalidArgument, "Invalid custom field filter"
+	case entities.ErrIdeaNotFound:
+		return codes.NotFound, "Idea not found"
+	case entities.ErrIdeaUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to idea"
+	case entities.ErrProgressNotFound:
+		return codes.NotFound, "Progress not found"
+	case entities.ErrProgressUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to progress"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetEmailPreferences implementa la obtención de la dirección de email de un usuario
+func (s *NotebookServer) GetEmailPreferences(ctx context.Context, req *pb.GetEmailPreferencesRequest) (*pb.GetEmailPreferencesResponse, error) {
+	if s.emailUseCases == nil {
+		return &pb.GetEmailPreferencesResponse{
+			Success: false,
+			Message: "Email is not enabled",
+		}, status.Error(codes.Unavailable, "email not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetEmailPreferencesResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	preference, err := s.emailUseCases.GetPreference(ctx, userID)
+	if err != nil {
+		return &pb.GetEmailPreferencesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get email preferences: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetEmailPreferencesResponse{
+		Preferences: emailPreferencesToProto(preference),
+		Success:     true,
+		Message:     "Email preferences retrieved successfully",
+	}, nil
+}
+
+// SetEmailPreferences implementa el cambio de la dirección de email de un usuario
+func (s *NotebookServer) SetEmailPreferences(ctx context.Context, req *pb.SetEmailPreferencesRequest) (*pb.SetEmailPreferencesResponse, error) {
+	if s.emailUseCases == nil {
+		return &pb.SetEmailPreferencesResponse{
+			Success: false,
+			Message: "Email is not enabled",
+		}, status.Error(codes.Unavailable, "email not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SetEmailPreferencesResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	preference, err := s.emailUseCases.SetPreference(ctx, userID, req.Address, req.WeeklySummary)
+	if err != nil {
+		if err == entities.ErrInvalidEmailAddress {
+			return &pb.SetEmailPreferencesResponse{
+				Success: false,
+				Message: "Invalid email address",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.SetEmailPreferencesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to set email preferences: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetEmailPreferencesResponse{
+		Preferences: emailPreferencesToProto(preference),
+		Success:     true,
+		Message:     "Email preferences updated successfully",
+	}, nil
+}
+
+// emailPreferencesToProto convierte la preferencia del usuario; sin dirección devuelve las
+// preferencias vacías
+func emailPreferencesToProto(preference *entities.EmailPreference) *pb.EmailPreferences {
+	if preference == nil {
+		return &pb.EmailPreferences{}
+	}
+	return &pb.EmailPreferences{
+		Address:       preference.Address,
+		WeeklySummary: preference.WeeklySummary,
+		UpdatedAt:     timestamppb.New(preference.UpdatedAt),
+	}
+}
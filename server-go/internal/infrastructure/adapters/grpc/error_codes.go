@@ -0,0 +1,271 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifica el origen de los errores en los detalles ErrorInfo de la v1
+const errorDomain = "notebook"
+
+// domainErrorCodes asocia cada error de dominio con el código estable que reciben los clientes.
+// Los errores de validación sin un código propio comparten ERROR_CODE_VALIDATION_FAILED
+var domainErrorCodes = map[error]pb.ErrorCode{
+	// Ideas
+	entities.ErrIdeaNotFound:        pb.ErrorCode_ERROR_CODE_IDEA_NOT_FOUND,
+	entities.ErrIdeaUnauthorized:    pb.ErrorCode_ERROR_CODE_IDEA_UNAUTHORIZED,
+	entities.ErrIdeaTitleRequired:   pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrIdeaContentRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrIdeaUserIDRequired:  pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidTag:          pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrTooManyIdeasForBulk: pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+
+	// Recordatorios
+	entities.ErrReminderNotFound:              pb.ErrorCode_ERROR_CODE_REMINDER_NOT_FOUND,
+	entities.ErrReminderUnauthorized:          pb.ErrorCode_ERROR_CODE_REMINDER_UNAUTHORIZED,
+	entities.ErrInvalidReminderTransition:     pb.ErrorCode_ERROR_CODE_INVALID_REMINDER_TRANSITION,
+	entities.ErrReminderAssignmentNotPending:  pb.ErrorCode_ERROR_CODE_REMINDER_ASSIGNMENT_NOT_PENDING,
+	entities.ErrReminderTitleRequired:         pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrReminderUserIDRequired:        pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrReminderScheduledTimeRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidReminderType:           pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidReminderStatus:         pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidReminderDateRange:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidReminderAssignee:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidReminderScope:          pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidEscalationPolicy:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrEscalationRequiresDeadline:    pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Archivos
+	entities.ErrFileNotFound:                 pb.ErrorCode_ERROR_CODE_FILE_NOT_FOUND,
+	entities.ErrFileUnauthorized:             pb.ErrorCode_ERROR_CODE_FILE_UNAUTHORIZED,
+	entities.ErrFileSizeExceeded:             pb.ErrorCode_ERROR_CODE_FILE_TOO_LARGE,
+	entities.ErrInvalidFileType:              pb.ErrorCode_ERROR_CODE_INVALID_FILE_TYPE,
+	entities.ErrFileChecksumMismatch:         pb.ErrorCode_ERROR_CODE_FILE_CHECKSUM_MISMATCH,
+	entities.ErrFileNameRequired:             pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrFileUserIDRequired:           pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrUnsupportedChecksumAlgorithm: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Enlaces compartidos
+	entities.ErrShareLinkNotFound:             pb.ErrorCode_ERROR_CODE_SHARE_LINK_NOT_FOUND,
+	entities.ErrShareLinkUnauthorized:         pb.ErrorCode_ERROR_CODE_SHARE_LINK_UNAUTHORIZED,
+	entities.ErrShareLinkExpired:              pb.ErrorCode_ERROR_CODE_SHARE_LINK_EXPIRED,
+	entities.ErrShareLinkRevoked:              pb.ErrorCode_ERROR_CODE_SHARE_LINK_REVOKED,
+	entities.ErrShareLinkPasswordRequired:     pb.ErrorCode_ERROR_CODE_SHARE_LINK_PASSWORD_REQUIRED,
+	entities.ErrShareLinkInvalidPassword:      pb.ErrorCode_ERROR_CODE_SHARE_LINK_INVALID_PASSWORD,
+	entities.ErrShareLinkDownloadLimitReached: pb.ErrorCode_ERROR_CODE_SHARE_LINK_DOWNLOAD_LIMIT_REACHED,
+	entities.ErrShareLinkFileIDRequired:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrShareLinkInvalidExpiry:        pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrShareLinkInvalidDownloadLimit: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Entrada por correo
+	entities.ErrInboundAddressNotFound:       pb.ErrorCode_ERROR_CODE_INBOUND_ADDRESS_NOT_FOUND,
+	entities.ErrInboundAddressUnauthorized:   pb.ErrorCode_ERROR_CODE_INBOUND_ADDRESS_UNAUTHORIZED,
+	entities.ErrInboundSenderNotAllowed:      pb.ErrorCode_ERROR_CODE_INBOUND_SENDER_NOT_ALLOWED,
+	entities.ErrInboundSpam:                  pb.ErrorCode_ERROR_CODE_INBOUND_SPAM,
+	entities.ErrInboundAddressUserIDRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInboundInvalidSender:         pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInboundUnsupportedKind:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInboundTooManyAttachments:    pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+
+	// Chat
+	entities.ErrChatBindingNotFound:       pb.ErrorCode_ERROR_CODE_CHAT_BINDING_NOT_FOUND,
+	entities.ErrChatBindingUnauthorized:   pb.ErrorCode_ERROR_CODE_CHAT_BINDING_UNAUTHORIZED,
+	entities.ErrChatBindingCodeExpired:    pb.ErrorCode_ERROR_CODE_CHAT_BINDING_CODE_EXPIRED,
+	entities.ErrChatAlreadyBound:          pb.ErrorCode_ERROR_CODE_CHAT_ALREADY_BOUND,
+	entities.ErrChatBindingUserIDRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidChatProvider:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrUnsupportedChatAction:     pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Idioma
+	entities.ErrLocalePreferenceNotFound: pb.ErrorCode_ERROR_CODE_LOCALE_PREFERENCE_NOT_FOUND,
+	entities.ErrUnsupportedLocale:        pb.ErrorCode_ERROR_CODE_UNSUPPORTED_LOCALE,
+
+	// Prioridad, tablero y búsqueda
+	entities.ErrPriorityRuleNameRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrPriorityRuleDeltaZero:    pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrPriorityRuleNoCondition:  pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidPriorityRule:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidBoardColumn:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidBoardPosition:     pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrEmptySearchQuery:         pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrIdeaEmbeddingNotFound:    pb.ErrorCode_ERROR_CODE_IDEA_EMBEDDING_NOT_FOUND,
+
+	// Repaso y publicación
+	entities.ErrIdeaReviewNotFound:           pb.ErrorCode_ERROR_CODE_IDEA_REVIEW_NOT_FOUND,
+	entities.ErrIdeaAlreadyEnrolled:          pb.ErrorCode_ERROR_CODE_IDEA_ALREADY_ENROLLED,
+	entities.ErrIdeaReviewUnauthorized:       pb.ErrorCode_ERROR_CODE_IDEA_REVIEW_UNAUTHORIZED,
+	entities.ErrInvalidReviewGrade:           pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrIdeaPublicationNotFound:      pb.ErrorCode_ERROR_CODE_IDEA_PUBLICATION_NOT_FOUND,
+	entities.ErrIdeaPublicationUnauthorized:  pb.ErrorCode_ERROR_CODE_IDEA_PUBLICATION_UNAUTHORIZED,
+	entities.ErrIdeaPublicationExpired:       pb.ErrorCode_ERROR_CODE_IDEA_PUBLICATION_EXPIRED,
+	entities.ErrIdeaPublicationInvalidExpiry: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Progreso
+	entities.ErrProgressNotFound:            pb.ErrorCode_ERROR_CODE_PROGRESS_NOT_FOUND,
+	entities.ErrProgressUnauthorized:        pb.ErrorCode_ERROR_CODE_PROGRESS_UNAUTHORIZED,
+	entities.ErrMilestoneNotFound:           pb.ErrorCode_ERROR_CODE_MILESTONE_NOT_FOUND,
+	entities.ErrProgressProjectNameRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrProgressUserIDRequired:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidCompletionPercentage: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrMilestoneNameRequired:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidProgressFilters:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidEstimatedEffort:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrNoRunningTimer:              pb.ErrorCode_ERROR_CODE_NO_RUNNING_TIMER,
+	entities.ErrTimerAlreadyRunning:         pb.ErrorCode_ERROR_CODE_TIMER_ALREADY_RUNNING,
+	entities.ErrInvalidEstimatedDuration:    pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrNothingToForecast:           pb.ErrorCode_ERROR_CODE_NOTHING_TO_FORECAST,
+	entities.ErrInvalidMilestoneWeight:      pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Plantillas de progreso
+	entities.ErrProgressTemplateNotFound:     pb.ErrorCode_ERROR_CODE_PROGRESS_TEMPLATE_NOT_FOUND,
+	entities.ErrProgressTemplateUnauthorized: pb.ErrorCode_ERROR_CODE_PROGRESS_TEMPLATE_UNAUTHORIZED,
+	entities.ErrProgressTemplateNameRequired: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidTemplateDueOffset:     pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrTooManyTemplateMilestones:    pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+	entities.ErrNoOrganization:               pb.ErrorCode_ERROR_CODE_NO_ORGANIZATION,
+
+	// Notificaciones, estadísticas, teléfono y email
+	entities.ErrNotificationNotFound:             pb.ErrorCode_ERROR_CODE_NOTIFICATION_NOT_FOUND,
+	entities.ErrStatisticsNotFound:               pb.ErrorCode_ERROR_CODE_STATISTICS_NOT_FOUND,
+	entities.ErrPhoneNumberNotFound:              pb.ErrorCode_ERROR_CODE_PHONE_NUMBER_NOT_FOUND,
+	entities.ErrInvalidPhoneNumber:               pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidPhoneVerificationCode:     pb.ErrorCode_ERROR_CODE_INVALID_PHONE_VERIFICATION_CODE,
+	entities.ErrPhoneVerificationCodeExpired:     pb.ErrorCode_ERROR_CODE_PHONE_VERIFICATION_CODE_EXPIRED,
+	entities.ErrPhoneVerificationTooManyAttempts: pb.ErrorCode_ERROR_CODE_PHONE_VERIFICATION_TOO_MANY_ATTEMPTS,
+	entities.ErrSMSDailyLimitReached:             pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+	entities.ErrInvalidEmailAddress:              pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Campos personalizados
+	entities.ErrCustomFieldNotFound:      pb.ErrorCode_ERROR_CODE_CUSTOM_FIELD_NOT_FOUND,
+	entities.ErrCustomFieldUnauthorized:  pb.ErrorCode_ERROR_CODE_CUSTOM_FIELD_UNAUTHORIZED,
+	entities.ErrCustomFieldKeyExists:     pb.ErrorCode_ERROR_CODE_CUSTOM_FIELD_KEY_EXISTS,
+	entities.ErrUnknownCustomField:       pb.ErrorCode_ERROR_CODE_UNKNOWN_CUSTOM_FIELD,
+	entities.ErrTooManyCustomFields:      pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+	entities.ErrInvalidCustomField:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidCustomFieldValue:  pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidCustomFieldFilter: pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+
+	// Telemetría
+	entities.ErrInvalidClientMetric:       pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrClientMetricBatchTooLarge: pb.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED,
+
+	// Moderación
+	entities.ErrContentRejected: pb.ErrorCode_ERROR_CODE_CONTENT_REJECTED,
+
+	// Generales
+	entities.ErrInvalidUUID:        pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidPagination:  pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidSortField:   pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrInvalidUpdateMask:  pb.ErrorCode_ERROR_CODE_VALIDATION_FAILED,
+	entities.ErrVersionConflict:    pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT,
+	entities.ErrServiceUnavailable: pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE,
+	entities.ErrLockNotAcquired:    pb.ErrorCode_ERROR_CODE_LOCK_NOT_ACQUIRED,
+}
+
+// statusErrorCodes es el código genérico de los errores que no vienen de un error de dominio
+var statusErrorCodes = map[codes.Code]pb.ErrorCode{
+	codes.InvalidArgument:    pb.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+	codes.OutOfRange:         pb.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+	codes.NotFound:           pb.ErrorCode_ERROR_CODE_NOT_FOUND,
+	codes.PermissionDenied:   pb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+	codes.Unauthenticated:    pb.ErrorCode_ERROR_CODE_UNAUTHENTICATED,
+	codes.FailedPrecondition: pb.ErrorCode_ERROR_CODE_FAILED_PRECONDITION,
+	codes.AlreadyExists:      pb.ErrorCode_ERROR_CODE_CONFLICT,
+	codes.Aborted:            pb.ErrorCode_ERROR_CODE_CONFLICT,
+	codes.ResourceExhausted:  pb.ErrorCode_ERROR_CODE_RATE_LIMITED,
+	codes.Unavailable:        pb.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE,
+	codes.DeadlineExceeded:   pb.ErrorCode_ERROR_CODE_DEADLINE_EXCEEDED,
+	codes.Internal:           pb.ErrorCode_ERROR_CODE_INTERNAL,
+	codes.Unknown:            pb.ErrorCode_ERROR_CODE_INTERNAL,
+	codes.DataLoss:           pb.ErrorCode_ERROR_CODE_INTERNAL,
+	codes.Unimplemented:      pb.ErrorCode_ERROR_CODE_INTERNAL,
+}
+
+// errorCodeOf devuelve el código del error de dominio que envuelve err, o ERROR_CODE_UNSPECIFIED
+func errorCodeOf(err error) pb.ErrorCode {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if code, ok := domainErrorCodes[err]; ok {
+			return code
+		}
+	}
+	return pb.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// errorReason es el ErrorInfo.reason de un código: su nombre sin el prefijo ERROR_CODE_
+func errorReason(code pb.ErrorCode) string {
+	return strings.TrimPrefix(code.String(), "ERROR_CODE_")
+}
+
+// errorInfo construye el detalle ErrorInfo con el código para el dominio indicado
+func errorInfo(code pb.ErrorCode, domain string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: errorReason(code),
+		Domain: domain,
+	}
+}
+
+// domainError construye el status de un error de dominio con su ErrorCode; si err no es un error
+// de dominio conocido se usa el genérico de code
+func domainError(code codes.Code, message string, err error) error {
+	errorCode := errorCodeOf(err)
+	if errorCode == pb.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		errorCode = statusErrorCodes[code]
+	}
+	if errorCode == pb.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		return status.Error(code, message)
+	}
+	return statusWithDetails(code, message, errorInfo(errorCode, errorDomain))
+}
+
+// withErrorCode añade el código genérico a los errores que todavía no llevan un ErrorInfo
+func withErrorCode(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.FromContextError(err)
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.ErrorInfo); ok {
+			return err
+		}
+	}
+
+	errorCode, ok := statusErrorCodes[st.Code()]
+	if !ok {
+		return err
+	}
+	if detailed, detailErr := st.WithDetails(errorInfo(errorCode, errorDomain)); detailErr == nil {
+		return detailed.Err()
+	}
+	return err
+}
+
+// ErrorCodeUnaryInterceptor garantiza que todos los errores devueltos lleven un ErrorCode,
+// incluidos los de autenticación, límites de tasa y handlers sin un error de dominio
+func ErrorCodeUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, withErrorCode(err)
+		}
+		return resp, nil
+	}
+}
+
+// ErrorCodeStreamInterceptor es el equivalente de ErrorCodeUnaryInterceptor para streams
+func ErrorCodeStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return withErrorCode(err)
+		}
+		return nil
+	}
+}
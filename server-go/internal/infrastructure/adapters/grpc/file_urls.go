@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetFileUrl implementa la obtención de una URL firmada para descargar un archivo por HTTP
+func (s *NotebookServer) GetFileUrl(ctx context.Context, req *pb.GetFileUrlRequest) (*pb.GetFileUrlResponse, error) {
+	if s.fileURLs == nil {
+		return &pb.GetFileUrlResponse{
+			Success: false,
+			Message: "File URLs are not enabled",
+		}, status.Error(codes.Unavailable, "file urls not enabled")
+	}
+
+	fileID, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return &pb.GetFileUrlResponse{
+			Success: false,
+			Message: "Invalid file ID format",
+		}, status.Error(codes.InvalidArgument, "invalid file ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetFileUrlResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	// Solo el dueño puede obtener la URL
+	if _, err := s.fileUseCases.GetFileInfo(ctx, fileID, userID); err != nil {
+		if err == entities.ErrFileNotFound {
+			return &pb.GetFileUrlResponse{
+				Success: false,
+				Message: "File not found",
+			}, domainError(codes.NotFound, "file not found", err)
+		}
+		if err == entities.ErrFileUnauthorized {
+			return &pb.GetFileUrlResponse{
+				Success: false,
+				Message: "Unauthorized access to file",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.GetFileUrlResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get file: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	url, expiresAt, err := s.fileURLs.FileURL(fileID, userID, time.Now())
+	if err != nil {
+		return &pb.GetFileUrlResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to sign file URL: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetFileUrlResponse{
+		Url:       url,
+		ExpiresAt: timestamppb.New(expiresAt),
+		Success:   true,
+		Message:   "File URL created successfully",
+	}, nil
+}
\ No newline at end of file
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchIdeas envía los cambios de las ideas del usuario que cumplen los filtros de la petición
+func (s *NotebookServer) WatchIdeas(req *pb.WatchIdeasRequest, stream pb.NotebookService_WatchIdeasServer) error {
+	if s.ideaWatch == nil {
+		return status.Error(codes.Unavailable, "idea changes stream not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid user ID format")
+	}
+
+	changes := s.ideaWatch.Watch(stream.Context(), userID, usecases.IdeaWatchFilter{
+		Category: entities.IdeaCategory(req.Category),
+		Status:   entities.IdeaStatus(req.Status),
+		Tags:     req.Tags,
+	})
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				if err := stream.Context().Err(); err != nil {
+					return err
+				}
+				// El cliente debe volver a listar las ideas para no perder cambios
+				return status.Error(codes.Unavailable, "idea changes stream closed, reload the ideas and reconnect")
+			}
+			if err := stream.Send(convert.IdeaChangeToProto(change)); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if err := stream.Send(&pb.IdeaChangeEvent{
+				Heartbeat:  true,
+				OccurredAt: timestamppb.Now(),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
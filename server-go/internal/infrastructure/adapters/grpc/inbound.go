@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateInboundAddress implementa la creación de direcciones de entrada
+func (s *NotebookServer) CreateInboundAddress(ctx context.Context, req *pb.CreateInboundAddressRequest) (*pb.CreateInboundAddressResponse, error) {
+	if s.inboundUseCases == nil {
+		return &pb.CreateInboundAddressResponse{
+			Success: false,
+			Message: "Inbound addresses are not enabled",
+		}, status.Error(codes.Unavailable, "inbound addresses not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CreateInboundAddressResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	address, token, err := s.inboundUseCases.CreateAddress(ctx, userID, req.AllowedSenders)
+	if err != nil {
+		if err == entities.ErrInboundInvalidSender || err == entities.ErrInboundAddressUserIDRequired {
+			return &pb.CreateInboundAddressResponse{
+				Success: false,
+				Message: err.Error(),
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.CreateInboundAddressResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to create inbound address: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &pb.CreateInboundAddressResponse{
+		InboundAddress: convert.InboundAddressToProto(address),
+		Token:          token,
+		Success:        true,
+		Message:        "Inbound address created successfully",
+	}
+	if s.inboundBaseURL != "" {
+		response.Url = strings.TrimSuffix(s.inboundBaseURL, "/") + "/" + token
+	}
+	if s.inboundDomain != "" {
+		response.Email = token + "@" + s.inboundDomain
+	}
+	return response, nil
+}
+
+// ListInboundAddresses implementa la lista de direcciones de entrada de un usuario
+func (s *NotebookServer) ListInboundAddresses(ctx context.Context, req *pb.ListInboundAddressesRequest) (*pb.ListInboundAddressesResponse, error) {
+	if s.inboundUseCases == nil {
+		return &pb.ListInboundAddressesResponse{
+			Success: false,
+			Message: "Inbound addresses are not enabled",
+		}, status.Error(codes.Unavailable, "inbound addresses not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListInboundAddressesResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	addresses, err := s.inboundUseCases.ListAddresses(ctx, userID)
+	if err != nil {
+		return &pb.ListInboundAddressesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list inbound addresses: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	protoAddresses := make([]*pb.InboundAddress, len(addresses))
+	for i, address := range addresses {
+		protoAddresses[i] = convert.InboundAddressToProto(address)
+	}
+
+	return &pb.ListInboundAddressesResponse{
+		InboundAddresses: protoAddresses,
+		Success:          true,
+		Message:          "Inbound addresses retrieved successfully",
+	}, nil
+}
+
+// RevokeInboundAddress implementa la revocación de direcciones de entrada
+func (s *NotebookServer) RevokeInboundAddress(ctx context.Context, req *pb.RevokeInboundAddressRequest) (*pb.RevokeInboundAddressResponse, error) {
+	if s.inboundUseCases == nil {
+		return &pb.RevokeInboundAddressResponse{
+			Success: false,
+			Message: "Inbound addresses are not enabled",
+		}, status.Error(codes.Unavailable, "inbound addresses not enabled")
+	}
+
+	addressID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.RevokeInboundAddressResponse{
+			Success: false,
+			Message: "Invalid inbound address ID format",
+		}, status.Error(codes.InvalidArgument, "invalid inbound address ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.RevokeInboundAddressResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	err = s.inboundUseCases.RevokeAddress(ctx, addressID, userID)
+	if err != nil {
+		if err == entities.ErrInboundAddressNotFound {
+			return &pb.RevokeInboundAddressResponse{
+				Success: false,
+				Message: "Inbound address not found",
+			}, domainError(codes.NotFound, "inbound address not found", err)
+		}
+		if err == entities.ErrInboundAddressUnauthorized {
+			return &pb.RevokeInboundAddressResponse{
+				Success: false,
+				Message: "Unauthorized access to inbound address",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.RevokeInboundAddressResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to revoke inbound address: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevokeInboundAddressResponse{
+		Success: true,
+		Message: "Inbound address revoked successfully",
+	}, nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLocalePreference implementa la obtención del idioma preferido de un usuario
+func (s *NotebookServer) GetLocalePreference(ctx context.Context, req *pb.GetLocalePreferenceRequest) (*pb.GetLocalePreferenceResponse, error) {
+	if s.localeUseCases == nil {
+		return &pb.GetLocalePreferenceResponse{
+			Success: false,
+			Message: "Locale preferences are not enabled",
+		}, status.Error(codes.Unavailable, "locale preferences not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetLocalePreferenceResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	locale, err := s.localeUseCases.GetLocale(ctx, userID)
+	if err != nil {
+		return &pb.GetLocalePreferenceResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get locale preference: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetLocalePreferenceResponse{
+		Locale:           locale,
+		SupportedLocales: s.localeUseCases.SupportedLocales(),
+		Success:          true,
+		Message:          "Locale preference retrieved successfully",
+	}, nil
+}
+
+// SetLocalePreference implementa el cambio del idioma preferido de un usuario
+func (s *NotebookServer) SetLocalePreference(ctx context.Context, req *pb.SetLocalePreferenceRequest) (*pb.SetLocalePreferenceResponse, error) {
+	if s.localeUseCases == nil {
+		return &pb.SetLocalePreferenceResponse{
+			Success: false,
+			Message: "Locale preferences are not enabled",
+		}, status.Error(codes.Unavailable, "locale preferences not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SetLocalePreferenceResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	locale, err := s.localeUseCases.SetLocale(ctx, userID, req.Locale)
+	if err != nil {
+		if err == entities.ErrUnsupportedLocale {
+			return &pb.SetLocalePreferenceResponse{
+				Success: false,
+				Message: "Unsupported locale",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.SetLocalePreferenceResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to set locale preference: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetLocalePreferenceResponse{
+		Locale:  locale,
+		Success: true,
+		Message: "Locale preference updated successfully",
+	}, nil
+}
@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartPhoneVerification implementa el registro de un teléfono y el envío de su código por SMS
+func (s *NotebookServer) StartPhoneVerification(ctx context.Context, req *pb.StartPhoneVerificationRequest) (*pb.StartPhoneVerificationResponse, error) {
+	if s.phoneUseCases == nil {
+		return &pb.StartPhoneVerificationResponse{
+			Success: false,
+			Message: "SMS notifications are not enabled",
+		}, status.Error(codes.Unavailable, "sms notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.StartPhoneVerificationResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	phone, err := s.phoneUseCases.StartPhoneVerification(ctx, userID, req.Number)
+	if err != nil {
+		code, message := phoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to start phone verification: %v", err)
+		}
+		return &pb.StartPhoneVerificationResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.StartPhoneVerificationResponse{
+		PhoneNumber:   convert.PhoneNumberToProto(phone),
+		CodeExpiresAt: timestamppb.New(phone.CodeExpiresAt),
+		Success:       true,
+		Message:       "Verification code sent successfully",
+	}, nil
+}
+
+// ConfirmPhoneVerification implementa la verificación del teléfono con el código recibido por SMS
+func (s *NotebookServer) ConfirmPhoneVerification(ctx context.Context, req *pb.ConfirmPhoneVerificationRequest) (*pb.ConfirmPhoneVerificationResponse, error) {
+	if s.phoneUseCases == nil {
+		return &pb.ConfirmPhoneVerificationResponse{
+			Success: false,
+			Message: "SMS notifications are not enabled",
+		}, status.Error(codes.Unavailable, "sms notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ConfirmPhoneVerificationResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	phone, err := s.phoneUseCases.ConfirmPhoneVerification(ctx, userID, req.Code)
+	if err != nil {
+		code, message := phoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to confirm phone verification: %v", err)
+		}
+		return &pb.ConfirmPhoneVerificationResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.ConfirmPhoneVerificationResponse{
+		PhoneNumber: convert.PhoneNumberToProto(phone),
+		Success:     true,
+		Message:     "Phone number verified successfully",
+	}, nil
+}
+
+// GetPhoneNumber implementa la obtención del teléfono registrado por un usuario
+func (s *NotebookServer) GetPhoneNumber(ctx context.Context, req *pb.GetPhoneNumberRequest) (*pb.GetPhoneNumberResponse, error) {
+	if s.phoneUseCases == nil {
+		return &pb.GetPhoneNumberResponse{
+			Success: false,
+			Message: "SMS notifications are not enabled",
+		}, status.Error(codes.Unavailable, "sms notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetPhoneNumberResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	phone, err := s.phoneUseCases.GetPhoneNumber(ctx, userID)
+	if err != nil {
+		code, message := phoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to get phone number: %v", err)
+		}
+		return &pb.GetPhoneNumberResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.GetPhoneNumberResponse{
+		PhoneNumber: convert.PhoneNumberToProto(phone),
+		Success:     true,
+		Message:     "Phone number retrieved successfully",
+	}, nil
+}
+
+// DeletePhoneNumber implementa la eliminación del teléfono de un usuario
+func (s *NotebookServer) DeletePhoneNumber(ctx context.Context, req *pb.DeletePhoneNumberRequest) (*pb.DeletePhoneNumberResponse, error) {
+	if s.phoneUseCases == nil {
+		return &pb.DeletePhoneNumberResponse{
+			Success: false,
+			Message: "SMS notifications are not enabled",
+		}, status.Error(codes.Unavailable, "sms notifications not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.DeletePhoneNumberResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := s.phoneUseCases.DeletePhoneNumber(ctx, userID); err != nil {
+		code, message := phoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to delete phone number: %v", err)
+		}
+		return &pb.DeletePhoneNumberResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.DeletePhoneNumberResponse{
+		Success: true,
+		Message: "Phone number deleted successfully",
+	}, nil
+}
+
+// phoneErrorStatus traduce los errores del registro de teléfonos; codes.Internal indica un error inesperado
+func phoneErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrPhoneNumberNotFound:
+		return codes.NotFound, "Phone number not found"
+	case entities.ErrInvalidPhoneNumber:
+		return codes.InvalidArgument, "Invalid phone number"
+	case entities.ErrInvalidPhoneVerificationCode:
+		return codes.InvalidArgument, "Invalid verification code"
+	case entities.ErrPhoneVerificationCodeExpired:
+		return codes.FailedPrecondition, "Verification code expired"
+	case entities.ErrPhoneVerificationTooManyAttempts:
+		return codes.FailedPrecondition, "Too many verification attempts"
+	case entities.ErrSMSDailyLimitReached:
+		return codes.ResourceExhausted, "Daily SMS limit reached"
+	}
+	return codes.Internal, ""
+}
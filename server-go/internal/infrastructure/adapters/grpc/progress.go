@@ -0,0 +1,388 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProgress implementa la obtención de un progreso junto con su esfuerzo estimado y registrado
+func (s *NotebookServer) GetProgress(ctx context.Context, req *pb.GetProgressRequest) (*pb.GetProgressResponse, error) {
+	if s.progressUseCases == nil {
+		return &pb.GetProgressResponse{
+			Success: false,
+			Message: "Progress is not enabled",
+		}, status.Error(codes.Unavailable, "progress not enabled")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.GetProgressResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetProgressResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	progress, err := s.progressUseCases.GetProgress(ctx, id, userID)
+	if err != nil {
+		code, message := progressErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to get progress: %v", err)
+		}
+		return &pb.GetProgressResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	response := &pb.GetProgressResponse{
+		Progress: convert.ProgressToProto(progress),
+		Success:  true,
+		Message:  "Progress retrieved successfully",
+	}
+
+	// Sin registro de tiempo el progreso se devuelve igualmente, sin el informe de esfuerzo
+	effort, err := s.progressUseCases.GetEffort(ctx, id, userID)
+	switch err {
+	case nil:
+		response.Effort = convert.ProgressEffortToProto(effort)
+		response.RunningTimer = convert.TimeEntryToProto(effort.Running, time.Now())
+	case entities.ErrServiceUnavailable:
+	default:
+		return &pb.GetProgressResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get progress effort: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ListProgress implementa la lista del progreso de un usuario
+func (s *NotebookServer) ListProgress(ctx context.Context, req *pb.ListProgressRequest) (*pb.ListProgressResponse, error) {
+	if s.progressUseCases == nil {
+		return &pb.ListProgressResponse{
+			Success: false,
+			Message: "Progress is not enabled",
+		}, status.Error(codes.Unavailable, "progress not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListProgressResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	page, err := pageFromRequest(req.Page, req.Cursor)
+	if err != nil {
+		return &pb.ListProgressResponse{
+			Success: false,
+			Message: "Invalid cursor",
+		}, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	customFieldFilters, err := convert.CustomFieldFiltersFromProto(req.CustomFieldFilters)
+	if err != nil {
+		return &pb.ListProgressResponse{
+			Success: false,
+			Message: "Invalid custom field filter",
+		}, domainError(codes.InvalidArgument, err.Error(), err)
+	}
+
+	filters := ports.ProgressFilters{
+		CustomFields: customFieldFilters,
+		Page:         page,
+		PageSize:     int(req.PageSize),
+		Sort:         convert.SortFromProto(req.Sort, "", false),
+	}
+	switch req.Completion {
+	case pb.ProgressCompletionFilter_PROGRESS_COMPLETION_PENDING:
+		completed := false
+		filters.Completed = &completed
+	case pb.ProgressCompletionFilter_PROGRESS_COMPLETION_COMPLETED:
+		completed := true
+		filters.Completed = &completed
+	}
+
+	// Valor por defecto para paginación
+	if filters.PageSize <= 0 {
+		filters.PageSize = 10
+	}
+
+	progress, totalCount, err := s.progressUseCases.ListProgress(ctx, userID, filters)
+	if err != nil {
+		code, message := progressListErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to list progress: %v", err)
+		}
+		return &pb.ListProgressResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	protoProgress := make([]*pb.Progress, len(progress))
+	for i, item := range progress {
+		protoProgress[i] = convert.ProgressToProto(item)
+	}
+
+	// El listado de progreso siempre cuenta el total exacto
+	pageInfo := ports.NewPageInfo(filters.Page, filters.PageSize, totalCount, ports.CountExact)
+	return &pb.ListProgressResponse{
+		Progress: protoProgress,
+		PageInfo: pageInfoToProto(pageInfo),
+		Success:  true,
+		Message:  "Progress retrieved successfully",
+	}, nil
+}
+
+// TrackTime implementa la puesta en marcha y la detención del cronómetro de un usuario en un hito
+func (s *NotebookServer) TrackTime(ctx context.Context, req *pb.TrackTimeRequest) (*pb.TrackTimeResponse, error) {
+	if s.progressUseCases == nil {
+		return &pb.TrackTimeResponse{
+			Success: false,
+			Message: "Progress is not enabled",
+		}, status.Error(codes.Unavailable, "progress not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.TrackTimeResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	var entry, stopped *entities.TimeEntry
+	var message string
+	switch req.Action {
+	case pb.TimeTrackingAction_TIME_TRACKING_ACTION_START:
+		progressID, err := uuid.Parse(req.ProgressId)
+		if err != nil {
+			return &pb.TrackTimeResponse{
+				Success: false,
+				Message: "Invalid progress ID format",
+			}, status.Error(codes.InvalidArgument, "invalid progress ID")
+		}
+		milestoneID, err := uuid.Parse(req.MilestoneId)
+		if err != nil {
+			return &pb.TrackTimeResponse{
+				Success: false,
+				Message: "Invalid milestone ID format",
+			}, status.Error(codes.InvalidArgument, "invalid milestone ID")
+		}
+		entry, stopped, err = s.progressUseCases.StartTimer(ctx, progressID, milestoneID, userID)
+		if err != nil {
+			return trackTimeError(err)
+		}
+		message = "Timer started successfully"
+	case pb.TimeTrackingAction_TIME_TRACKING_ACTION_STOP:
+		entry, err = s.progressUseCases.StopTimer(ctx, userID)
+		if err != nil {
+			return trackTimeError(err)
+		}
+		message = "Timer stopped successfully"
+	default:
+		return &pb.TrackTimeResponse{
+			Success: false,
+			Message: "Invalid time tracking action",
+		}, status.Error(codes.InvalidArgument, "invalid time tracking action")
+	}
+
+	now := time.Now()
+	return &pb.TrackTimeResponse{
+		Entry:   convert.TimeEntryToProto(entry, now),
+		Stopped: convert.TimeEntryToProto(stopped, now),
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// GetForecast implementa la previsión de la fecha de fin de un progreso
+func (s *NotebookServer) GetForecast(ctx context.Context, req *pb.GetForecastRequest) (*pb.GetForecastResponse, error) {
+	if s.progressUseCases == nil {
+		return &pb.GetForecastResponse{
+			Success: false,
+			Message: "Progress is not enabled",
+		}, status.Error(codes.Unavailable, "progress not enabled")
+	}
+
+	progressID, err := uuid.Parse(req.ProgressId)
+	if err != nil {
+		return &pb.GetForecastResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetForecastResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	forecast, err := s.progressUseCases.GetForecast(ctx, progressID, userID)
+	if err != nil {
+		code, message := progressErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to get forecast: %v", err)
+		}
+		return &pb.GetForecastResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.GetForecastResponse{
+		Forecast: convert.ProgressForecastToProto(forecast),
+		Success:  true,
+		Message:  "Forecast retrieved successfully",
+	}, nil
+}
+
+// UpdateProgress implementa la actualización del progreso de un proyecto
+func (s *NotebookServer) UpdateProgress(ctx context.Context, req *pb.UpdateProgressRequest) (*pb.UpdateProgressResponse, error) {
+	progressID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.UpdateProgressResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UpdateProgressResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	milestones, err := convert.MilestonesFromProto(req.Milestones)
+	if err != nil {
+		return &pb.UpdateProgressResponse{
+			Success: false,
+			Message: "Invalid milestone ID format",
+		}, status.Error(codes.InvalidArgument, "invalid milestone ID")
+	}
+
+	progress, err := s.progressUseCases.UpdateProgress(
+		ctx,
+		progressID,
+		userID,
+		req.ExpectedVersion,
+		req.ProjectName,
+		req.Description,
+		req.CompletionPercentage,
+		milestones,
+		req.GetUpdateMask().GetPaths(),
+	)
+	if err != nil {
+		if err == entities.ErrVersionConflict && progress != nil {
+			// El progreso más reciente viaja en los detalles del status para que el cliente pueda fusionar
+			latest := convert.ProgressToProto(progress)
+			st := status.New(codes.Aborted, "progress version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.UpdateProgressResponse{
+				Progress: latest,
+				Success:  false,
+				Message:  "Progress was modified concurrently",
+			}, st.Err()
+		}
+		code, message := progressUpdateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to update progress: %v", err)
+		}
+		return &pb.UpdateProgressResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.UpdateProgressResponse{
+		Progress: convert.ProgressToProto(progress),
+		Success:  true,
+		Message:  "Progress updated successfully",
+	}, nil
+}
+
+func trackTimeError(err error) (*pb.TrackTimeResponse, error) {
+	code, message := progressErrorStatus(err)
+	if code == codes.Internal {
+		message = fmt.Sprintf("Failed to track time: %v", err)
+	}
+	return &pb.TrackTimeResponse{
+		Success: false,
+		Message: message,
+	}, domainError(code, err.Error(), err)
+}
+
+// progressListErrorStatus traduce los errores de los filtros de ListProgress; codes.Internal
+// indica un error inesperado
+func progressListErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrInvalidPagination:
+		return codes.InvalidArgument, "Invalid pagination"
+	case entities.ErrInvalidSortField:
+		return codes.InvalidArgument, "Invalid sort field"
+	case entities.ErrInvalidCustomFieldFilter:
+		return codes.InvalidArgument, "Invalid custom field filter"
+	}
+	return progressErrorStatus(err)
+}
+
+// progressUpdateErrorStatus traduce los errores de UpdateProgress; codes.Internal indica un
+// error inesperado
+func progressUpdateErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrInvalidUpdateMask:
+		return codes.InvalidArgument, "Invalid update mask"
+	case entities.ErrInvalidCompletionPercentage:
+		return codes.InvalidArgument, "Completion percentage must be between 0 and 100"
+	case entities.ErrProgressProjectNameRequired:
+		return codes.InvalidArgument, "Project name is required"
+	}
+	return progressErrorStatus(err)
+}
+
+// progressErrorStatus traduce los errores del progreso y del registro de tiempo; codes.Internal
+// indica un error inesperado
+func progressErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrProgressNotFound:
+		return codes.NotFound, "Progress not found"
+	case entities.ErrProgressUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to progress"
+	case entities.ErrMilestoneNotFound:
+		return codes.NotFound, "Milestone not found"
+	case entities.ErrNoRunningTimer:
+		return codes.FailedPrecondition, "No timer is running"
+	case entities.ErrTimerAlreadyRunning:
+		return codes.Aborted, "Another timer is already running"
+	case entities.ErrNothingToForecast:
+		return codes.FailedPrecondition, "No pending milestones with an estimated duration"
+	case entities.ErrServiceUnavailable:
+		return codes.Unavailable, "Time tracking is not enabled"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,251 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateProgressTemplate implementa la creación de una plantilla de progreso
+func (s *NotebookServer) CreateProgressTemplate(ctx context.Context, req *pb.CreateProgressTemplateRequest) (*pb.CreateProgressTemplateResponse, error) {
+	if s.progressTemplates == nil {
+		return &pb.CreateProgressTemplateResponse{
+			Success: false,
+			Message: "Progress templates are not enabled",
+		}, status.Error(codes.Unavailable, "progress templates not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CreateProgressTemplateResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	template, err := s.progressTemplates.CreateTemplate(ctx, userID, req.Name, req.Description, convert.TemplateMilestonesFromProto(req.Milestones))
+	if err != nil {
+		code, message := progressTemplateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to create progress template: %v", err)
+		}
+		return &pb.CreateProgressTemplateResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.CreateProgressTemplateResponse{
+		Template: convert.ProgressTemplateToProto(template),
+		Success:  true,
+		Message:  "Progress template created successfully",
+	}, nil
+}
+
+// ListProgressTemplates implementa la lista de plantillas propias o de la organización
+func (s *NotebookServer) ListProgressTemplates(ctx context.Context, req *pb.ListProgressTemplatesRequest) (*pb.ListProgressTemplatesResponse, error) {
+	if s.progressTemplates == nil {
+		return &pb.ListProgressTemplatesResponse{
+			Success: false,
+			Message: "Progress templates are not enabled",
+		}, status.Error(codes.Unavailable, "progress templates not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListProgressTemplatesResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	library := usecases.TemplateLibraryOwn
+	if req.Library == pb.TemplateLibrary_TEMPLATE_LIBRARY_ORGANIZATION {
+		library = usecases.TemplateLibraryOrganization
+	}
+
+	templates, err := s.progressTemplates.ListTemplates(ctx, userID, library)
+	if err != nil {
+		return &pb.ListProgressTemplatesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list progress templates: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*pb.ProgressTemplate, len(templates))
+	for i, template := range templates {
+		result[i] = convert.ProgressTemplateToProto(template)
+	}
+
+	return &pb.ListProgressTemplatesResponse{
+		Templates: result,
+		Success:   true,
+		Message:   "Progress templates retrieved successfully",
+	}, nil
+}
+
+// ShareProgressTemplate implementa el cambio de visibilidad de una plantilla en la organización
+func (s *NotebookServer) ShareProgressTemplate(ctx context.Context, req *pb.ShareProgressTemplateRequest) (*pb.ShareProgressTemplateResponse, error) {
+	if s.progressTemplates == nil {
+		return &pb.ShareProgressTemplateResponse{
+			Success: false,
+			Message: "Progress templates are not enabled",
+		}, status.Error(codes.Unavailable, "progress templates not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ShareProgressTemplateResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return &pb.ShareProgressTemplateResponse{
+			Success: false,
+			Message: "Invalid template ID format",
+		}, status.Error(codes.InvalidArgument, "invalid template ID")
+	}
+
+	template, err := s.progressTemplates.ShareTemplate(ctx, templateID, userID, req.Shared)
+	if err != nil {
+		code, message := progressTemplateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to share progress template: %v", err)
+		}
+		return &pb.ShareProgressTemplateResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.ShareProgressTemplateResponse{
+		Template: convert.ProgressTemplateToProto(template),
+		Success:  true,
+		Message:  "Progress template updated successfully",
+	}, nil
+}
+
+// DeleteProgressTemplate implementa la eliminación de una plantilla de progreso
+func (s *NotebookServer) DeleteProgressTemplate(ctx context.Context, req *pb.DeleteProgressTemplateRequest) (*pb.DeleteProgressTemplateResponse, error) {
+	if s.progressTemplates == nil {
+		return &pb.DeleteProgressTemplateResponse{
+			Success: false,
+			Message: "Progress templates are not enabled",
+		}, status.Error(codes.Unavailable, "progress templates not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.DeleteProgressTemplateResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return &pb.DeleteProgressTemplateResponse{
+			Success: false,
+			Message: "Invalid template ID format",
+		}, status.Error(codes.InvalidArgument, "invalid template ID")
+	}
+
+	if err := s.progressTemplates.DeleteTemplate(ctx, templateID, userID); err != nil {
+		code, message := progressTemplateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to delete progress template: %v", err)
+		}
+		return &pb.DeleteProgressTemplateResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.DeleteProgressTemplateResponse{
+		Success: true,
+		Message: "Progress template deleted successfully",
+	}, nil
+}
+
+// InstantiateTemplate implementa la creación de un registro de progreso a partir de una plantilla
+func (s *NotebookServer) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	if s.progressTemplates == nil {
+		return &pb.InstantiateTemplateResponse{
+			Success: false,
+			Message: "Progress templates are not enabled",
+		}, status.Error(codes.Unavailable, "progress templates not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.InstantiateTemplateResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return &pb.InstantiateTemplateResponse{
+			Success: false,
+			Message: "Invalid template ID format",
+		}, status.Error(codes.InvalidArgument, "invalid template ID")
+	}
+
+	var start time.Time
+	if req.StartDate != nil {
+		start = req.StartDate.AsTime()
+	}
+
+	progress, err := s.progressTemplates.InstantiateTemplate(ctx, templateID, userID, req.ProjectName, req.Description, start)
+	if err != nil {
+		code, message := progressTemplateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to instantiate progress template: %v", err)
+		}
+		return &pb.InstantiateTemplateResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.InstantiateTemplateResponse{
+		Progress: convert.ProgressToProto(progress),
+		Success:  true,
+		Message:  "Progress created successfully",
+	}, nil
+}
+
+// progressTemplateErrorStatus traduce los errores de las plantillas y del progreso que se crea con
+// ellas; codes.Internal indica un error inesperado
+func progressTemplateErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrProgressTemplateNotFound:
+		return codes.NotFound, "Progress template not found"
+	case entities.ErrProgressTemplateUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to progress template"
+	case entities.ErrProgressTemplateNameRequired:
+		return codes.InvalidArgument, "Template name is required"
+	case entities.ErrMilestoneNameRequired:
+		return codes.InvalidArgument, "Milestone name is required"
+	case entities.ErrInvalidTemplateDueOffset:
+		return codes.InvalidArgument, "Invalid milestone due offset"
+	case entities.ErrInvalidMilestoneWeight:
+		return codes.InvalidArgument, "Invalid milestone weight"
+	case entities.ErrTooManyTemplateMilestones:
+		return codes.ResourceExhausted, "Too many milestones in template"
+	case entities.ErrNoOrganization:
+		return codes.FailedPrecondition, "You do not belong to an organization"
+	case entities.ErrProgressProjectNameRequired:
+		return codes.InvalidArgument, "Project name is required"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublishIdea implementa la publicación de una idea en modo solo lectura
+func (s *NotebookServer) PublishIdea(ctx context.Context, req *pb.PublishIdeaRequest) (*pb.PublishIdeaResponse, error) {
+	if s.publications == nil {
+		return &pb.PublishIdeaResponse{
+			Success: false,
+			Message: "Idea publishing is not enabled",
+		}, status.Error(codes.Unavailable, "idea publishing not enabled")
+	}
+
+	ideaID, err := uuid.Parse(req.IdeaId)
+	if err != nil {
+		return &pb.PublishIdeaResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.PublishIdeaResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	publication, err := s.publications.PublishIdea(ctx, ideaID, userID, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		if err == entities.ErrIdeaNotFound {
+			return &pb.PublishIdeaResponse{
+				Success: false,
+				Message: "Idea not found",
+			}, domainError(codes.NotFound, "idea not found", err)
+		}
+		if err == entities.ErrIdeaUnauthorized {
+			return &pb.PublishIdeaResponse{
+				Success: false,
+				Message: "Unauthorized access to idea",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrIdeaPublicationInvalidExpiry {
+			return &pb.PublishIdeaResponse{
+				Success: false,
+				Message: err.Error(),
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrContentRejected {
+			return &pb.PublishIdeaResponse{
+				Success: false,
+				Message: "Idea rejected by the content policy",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.PublishIdeaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to publish idea: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.PublishIdeaResponse{
+		Publication: convert.IdeaPublicationToProto(publication, s.publicIdeaURL(publication.Slug)),
+		Success:     true,
+		Message:     "Idea published successfully",
+	}, nil
+}
+
+// UnpublishIdea implementa la retirada de la publicación de una idea
+func (s *NotebookServer) UnpublishIdea(ctx context.Context, req *pb.UnpublishIdeaRequest) (*pb.UnpublishIdeaResponse, error) {
+	if s.publications == nil {
+		return &pb.UnpublishIdeaResponse{
+			Success: false,
+			Message: "Idea publishing is not enabled",
+		}, status.Error(codes.Unavailable, "idea publishing not enabled")
+	}
+
+	ideaID, err := uuid.Parse(req.IdeaId)
+	if err != nil {
+		return &pb.UnpublishIdeaResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UnpublishIdeaResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := s.publications.UnpublishIdea(ctx, ideaID, userID); err != nil {
+		if err == entities.ErrIdeaPublicationNotFound {
+			return &pb.UnpublishIdeaResponse{
+				Success: false,
+				Message: "Idea is not published",
+			}, domainError(codes.NotFound, err.Error(), err)
+		}
+		if err == entities.ErrIdeaPublicationUnauthorized {
+			return &pb.UnpublishIdeaResponse{
+				Success: false,
+				Message: "Unauthorized access to idea publication",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.UnpublishIdeaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to unpublish idea: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UnpublishIdeaResponse{
+		Success: true,
+		Message: "Idea unpublished successfully",
+	}, nil
+}
+
+// ListIdeaPublications implementa el listado de las ideas publicadas del usuario con sus visitas
+func (s *NotebookServer) ListIdeaPublications(ctx context.Context, req *pb.ListIdeaPublicationsRequest) (*pb.ListIdeaPublicationsResponse, error) {
+	if s.publications == nil {
+		return &pb.ListIdeaPublicationsResponse{
+			Success: false,
+			Message: "Idea publishing is not enabled",
+		}, status.Error(codes.Unavailable, "idea publishing not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListIdeaPublicationsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	publications, err := s.publications.ListPublications(ctx, userID)
+	if err != nil {
+		return &pb.ListIdeaPublicationsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list idea publications: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPublications := make([]*pb.IdeaPublication, len(publications))
+	for i, publication := range publications {
+		pbPublications[i] = convert.IdeaPublicationToProto(publication, s.publicIdeaURL(publication.Slug))
+	}
+
+	return &pb.ListIdeaPublicationsResponse{
+		Publications: pbPublications,
+		Success:      true,
+		Message:      "Idea publications retrieved successfully",
+	}, nil
+}
+
+func (s *NotebookServer) publicIdeaURL(slug string) string {
+	if s.publicBaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.publicBaseURL, "/") + "/" + slug
+}
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AssignReminder implementa la delegación de un recordatorio en otro usuario
+func (s *NotebookServer) AssignReminder(ctx context.Context, req *pb.AssignReminderRequest) (*pb.AssignReminderResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.AssignReminderResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.AssignReminderResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	assigneeID, err := uuid.Parse(req.AssigneeId)
+	if err != nil {
+		return &pb.AssignReminderResponse{
+			Success: false,
+			Message: "Invalid assignee ID format",
+		}, status.Error(codes.InvalidArgument, "invalid assignee ID")
+	}
+
+	reminder, err := s.reminderUseCases.AssignReminder(ctx, reminderID, userID, assigneeID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.AssignReminderResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderAssignmentErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to assign reminder: %v", err)
+		}
+		return &pb.AssignReminderResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.AssignReminderResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder assigned successfully",
+	}, nil
+}
+
+// UnassignReminder implementa la devolución de un recordatorio asignado a su creador
+func (s *NotebookServer) UnassignReminder(ctx context.Context, req *pb.UnassignReminderRequest) (*pb.UnassignReminderResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.UnassignReminderResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UnassignReminderResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	reminder, err := s.reminderUseCases.UnassignReminder(ctx, reminderID, userID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.UnassignReminderResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderAssignmentErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to unassign reminder: %v", err)
+		}
+		return &pb.UnassignReminderResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.UnassignReminderResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder unassigned successfully",
+	}, nil
+}
+
+// RespondToReminderAssignment implementa la aceptación o el rechazo de un recordatorio asignado
+func (s *NotebookServer) RespondToReminderAssignment(ctx context.Context, req *pb.RespondToReminderAssignmentRequest) (*pb.RespondToReminderAssignmentResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.RespondToReminderAssignmentResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.RespondToReminderAssignmentResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	reminder, err := s.reminderUseCases.RespondToReminderAssignment(ctx, reminderID, userID, req.Accept, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.RespondToReminderAssignmentResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderAssignmentErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to respond to reminder assignment: %v", err)
+		}
+		return &pb.RespondToReminderAssignmentResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	message := "Reminder assignment declined"
+	if req.Accept {
+		message = "Reminder assignment accepted"
+	}
+	return &pb.RespondToReminderAssignmentResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  message,
+	}, nil
+}
+
+// reminderConflictStatus lleva el recordatorio más reciente en los detalles del status para que el cliente pueda fusionar
+func reminderConflictStatus(latest *pb.Reminder) error {
+	st := status.New(codes.Aborted, "reminder version conflict")
+	if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// reminderAssignmentErrorStatus traduce los errores de la asignación de recordatorios; codes.Internal
+// indica un error inesperado
+func reminderAssignmentErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrReminderNotFound:
+		return codes.NotFound, "Reminder not found"
+	case entities.ErrReminderUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to reminder"
+	case entities.ErrInvalidReminderAssignee:
+		return codes.InvalidArgument, "Reminder must be assigned to another user"
+	case entities.ErrInvalidReminderTransition:
+		return codes.FailedPrecondition, "Completed or cancelled reminders cannot be assigned"
+	case entities.ErrReminderAssignmentNotPending:
+		return codes.FailedPrecondition, "Reminder assignment is not pending"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetReminderEscalationPolicy implementa la configuración del escalado de un recordatorio
+func (s *NotebookServer) SetReminderEscalationPolicy(ctx context.Context, req *pb.SetReminderEscalationPolicyRequest) (*pb.SetReminderEscalationPolicyResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.SetReminderEscalationPolicyResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SetReminderEscalationPolicyResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	policy, err := convert.EscalationPolicyFromProto(req.Policy)
+	if err != nil {
+		return &pb.SetReminderEscalationPolicyResponse{
+			Success: false,
+			Message: "Invalid backup contact ID format",
+		}, status.Error(codes.InvalidArgument, "invalid backup contact ID")
+	}
+
+	reminder, err := s.reminderUseCases.SetReminderEscalationPolicy(ctx, reminderID, userID, policy, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.SetReminderEscalationPolicyResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderEscalationErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to set reminder escalation policy: %v", err)
+		}
+		return &pb.SetReminderEscalationPolicyResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.SetReminderEscalationPolicyResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder escalation policy updated successfully",
+	}, nil
+}
+
+// AcknowledgeReminder implementa la confirmación de un recordatorio, que detiene su escalado
+func (s *NotebookServer) AcknowledgeReminder(ctx context.Context, req *pb.AcknowledgeReminderRequest) (*pb.AcknowledgeReminderResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.AcknowledgeReminderResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.AcknowledgeReminderResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	reminder, err := s.reminderUseCases.AcknowledgeReminder(ctx, reminderID, userID)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.AcknowledgeReminderResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderEscalationErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to acknowledge reminder: %v", err)
+		}
+		return &pb.AcknowledgeReminderResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.AcknowledgeReminderResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder acknowledged successfully",
+	}, nil
+}
+
+// reminderEscalationErrorStatus traduce los errores del escalado de recordatorios; codes.Internal
+// indica un error inesperado
+func reminderEscalationErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrReminderNotFound:
+		return codes.NotFound, "Reminder not found"
+	case entities.ErrReminderUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to reminder"
+	case entities.ErrInvalidEscalationPolicy:
+		return codes.InvalidArgument, "Invalid escalation policy"
+	case entities.ErrEscalationRequiresDeadline:
+		return codes.FailedPrecondition, "Only deadline reminders can escalate"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,268 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LinkReminderToMilestone implementa la vinculación de un recordatorio a un hito
+func (s *NotebookServer) LinkReminderToMilestone(ctx context.Context, req *pb.LinkReminderToMilestoneRequest) (*pb.LinkReminderToMilestoneResponse, error) {
+	if s.milestoneLinks == nil {
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: "Reminder milestone links are not enabled",
+		}, status.Error(codes.Unavailable, "reminder milestone links not enabled")
+	}
+
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	progressID, err := uuid.Parse(req.ProgressId)
+	if err != nil {
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+	milestoneID, err := uuid.Parse(req.MilestoneId)
+	if err != nil {
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: "Invalid milestone ID format",
+		}, status.Error(codes.InvalidArgument, "invalid milestone ID")
+	}
+
+	reminder, err := s.milestoneLinks.LinkMilestone(ctx, reminderID, userID, progressID, milestoneID, req.CompletesReminder, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.LinkReminderToMilestoneResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderMilestoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to link reminder to milestone: %v", err)
+		}
+		return &pb.LinkReminderToMilestoneResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.LinkReminderToMilestoneResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder linked to milestone successfully",
+	}, nil
+}
+
+// UnlinkReminderFromMilestone implementa la desvinculación de un recordatorio de su hito
+func (s *NotebookServer) UnlinkReminderFromMilestone(ctx context.Context, req *pb.UnlinkReminderFromMilestoneRequest) (*pb.UnlinkReminderFromMilestoneResponse, error) {
+	if s.milestoneLinks == nil {
+		return &pb.UnlinkReminderFromMilestoneResponse{
+			Success: false,
+			Message: "Reminder milestone links are not enabled",
+		}, status.Error(codes.Unavailable, "reminder milestone links not enabled")
+	}
+
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.UnlinkReminderFromMilestoneResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UnlinkReminderFromMilestoneResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	reminder, err := s.milestoneLinks.UnlinkMilestone(ctx, reminderID, userID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.UnlinkReminderFromMilestoneResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderMilestoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to unlink reminder from milestone: %v", err)
+		}
+		return &pb.UnlinkReminderFromMilestoneResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.UnlinkReminderFromMilestoneResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder unlinked from milestone successfully",
+	}, nil
+}
+
+// CompleteReminder implementa la completación de un recordatorio junto con su hito vinculado
+func (s *NotebookServer) CompleteReminder(ctx context.Context, req *pb.CompleteReminderRequest) (*pb.CompleteReminderResponse, error) {
+	if s.milestoneLinks == nil {
+		return &pb.CompleteReminderResponse{
+			Success: false,
+			Message: "Reminder milestone links are not enabled",
+		}, status.Error(codes.Unavailable, "reminder milestone links not enabled")
+	}
+
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.CompleteReminderResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CompleteReminderResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	reminder, progress, err := s.milestoneLinks.CompleteReminder(ctx, reminderID, userID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			latest := convert.ReminderToProto(reminder)
+			return &pb.CompleteReminderResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderMilestoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to complete reminder: %v", err)
+		}
+		return &pb.CompleteReminderResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	response := &pb.CompleteReminderResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder completed successfully",
+	}
+	if progress != nil {
+		response.Progress = convert.ProgressToProto(progress)
+	}
+	return response, nil
+}
+
+// CompleteMilestone implementa la completación de un hito junto con los recordatorios vinculados
+func (s *NotebookServer) CompleteMilestone(ctx context.Context, req *pb.CompleteMilestoneRequest) (*pb.CompleteMilestoneResponse, error) {
+	if s.milestoneLinks == nil {
+		return &pb.CompleteMilestoneResponse{
+			Success: false,
+			Message: "Reminder milestone links are not enabled",
+		}, status.Error(codes.Unavailable, "reminder milestone links not enabled")
+	}
+
+	progressID, err := uuid.Parse(req.ProgressId)
+	if err != nil {
+		return &pb.CompleteMilestoneResponse{
+			Success: false,
+			Message: "Invalid progress ID format",
+		}, status.Error(codes.InvalidArgument, "invalid progress ID")
+	}
+	milestoneID, err := uuid.Parse(req.MilestoneId)
+	if err != nil {
+		return &pb.CompleteMilestoneResponse{
+			Success: false,
+			Message: "Invalid milestone ID format",
+		}, status.Error(codes.InvalidArgument, "invalid milestone ID")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CompleteMilestoneResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	progress, reminders, err := s.milestoneLinks.CompleteMilestone(ctx, progressID, milestoneID, userID, req.ExpectedVersion)
+	if err != nil {
+		if err == entities.ErrVersionConflict && progress != nil {
+			latest := convert.ProgressToProto(progress)
+			st := status.New(codes.Aborted, "progress version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.CompleteMilestoneResponse{
+				Progress: latest,
+				Success:  false,
+				Message:  "Progress was modified concurrently",
+			}, st.Err()
+		}
+		code, message := reminderMilestoneErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to complete milestone: %v", err)
+		}
+		return &pb.CompleteMilestoneResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	completed := make([]*pb.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		completed[i] = convert.ReminderToProto(reminder)
+	}
+
+	return &pb.CompleteMilestoneResponse{
+		Progress:           convert.ProgressToProto(progress),
+		CompletedReminders: completed,
+		Success:            true,
+		Message:            "Milestone completed successfully",
+	}, nil
+}
+
+// reminderMilestoneErrorStatus traduce los errores de los recordatorios y del progreso que se
+// modifican juntos; codes.Internal indica un error inesperado
+func reminderMilestoneErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrReminderNotFound:
+		return codes.NotFound, "Reminder not found"
+	case entities.ErrReminderUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to reminder"
+	case entities.ErrInvalidReminderTransition:
+		return codes.FailedPrecondition, "Reminder is already completed or cancelled"
+	case entities.ErrVersionConflict:
+		return codes.Aborted, "Reminder was modified concurrently"
+	}
+	return progressErrorStatus(err)
+}
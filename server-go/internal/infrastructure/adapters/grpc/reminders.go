@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListReminders implementa la lista de recordatorios creados por el usuario o asignados a él
+func (s *NotebookServer) ListReminders(ctx context.Context, req *pb.ListRemindersRequest) (*pb.ListRemindersResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListRemindersResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	page, err := pageFromRequest(req.Page, req.Cursor)
+	if err != nil {
+		return &pb.ListRemindersResponse{
+			Success: false,
+			Message: "Invalid cursor",
+		}, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	filters := ports.ReminderFilters{
+		Type:     entities.ReminderType(req.Type),
+		Status:   entities.ReminderStatus(req.Status),
+		Scope:    entities.ReminderScope(req.Scope),
+		Page:     page,
+		PageSize: int(req.PageSize),
+		Sort:     convert.SortFromProto(req.Sort, "", false),
+		Count:    ports.CountMode(req.CountMode),
+	}
+	if req.FromDate != nil {
+		from := req.FromDate.AsTime().Format(time.RFC3339)
+		filters.FromDate = &from
+	}
+	if req.ToDate != nil {
+		to := req.ToDate.AsTime().Format(time.RFC3339)
+		filters.ToDate = &to
+	}
+
+	// Valor por defecto para paginación
+	if filters.PageSize <= 0 {
+		filters.PageSize = 10
+	}
+
+	reminders, totalCount, err := s.reminderUseCases.ListReminders(ctx, userID, filters)
+	if err != nil {
+		code, message := reminderListErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to list reminders: %v", err)
+		}
+		return &pb.ListRemindersResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	protoReminders := make([]*pb.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		protoReminders[i] = convert.ReminderToProto(reminder)
+	}
+
+	pageInfo := ports.NewPageInfo(filters.Page, filters.PageSize, totalCount, filters.Count)
+	reportedTotal, estimated := convertTotalToProto(totalCount, filters.Count)
+	return &pb.ListRemindersResponse{
+		Reminders:           protoReminders,
+		TotalCount:          reportedTotal,
+		Page:                int32(filters.Page),
+		PageSize:            int32(filters.PageSize),
+		Success:             true,
+		Message:             "Reminders retrieved successfully",
+		HasMore:             pageInfo.HasMore,
+		TotalCountEstimated: estimated,
+		PageInfo:            pageInfoToProto(pageInfo),
+	}, nil
+}
+
+// UpdateReminder implementa la actualización de recordatorios
+func (s *NotebookServer) UpdateReminder(ctx context.Context, req *pb.UpdateReminderRequest) (*pb.UpdateReminderResponse, error) {
+	reminderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.UpdateReminderResponse{
+			Success: false,
+			Message: "Invalid reminder ID format",
+		}, status.Error(codes.InvalidArgument, "invalid reminder ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UpdateReminderResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	var scheduledTime time.Time
+	if req.ScheduledTime != nil {
+		scheduledTime = req.ScheduledTime.AsTime()
+	}
+
+	reminder, err := s.reminderUseCases.UpdateReminder(
+		ctx,
+		reminderID,
+		userID,
+		req.ExpectedVersion,
+		req.Title,
+		req.Description,
+		scheduledTime,
+		entities.ReminderType(req.Type),
+		entities.ReminderStatus(req.Status),
+		req.Recurring,
+		entities.RecurrencePattern(req.RecurrencePattern),
+		req.GetUpdateMask().GetPaths(),
+	)
+	if err != nil {
+		if err == entities.ErrVersionConflict && reminder != nil {
+			// El recordatorio más reciente viaja en los detalles del status para que el cliente pueda fusionar
+			latest := convert.ReminderToProto(reminder)
+			return &pb.UpdateReminderResponse{
+				Reminder: latest,
+				Success:  false,
+				Message:  "Reminder was modified concurrently",
+			}, reminderConflictStatus(latest)
+		}
+		code, message := reminderUpdateErrorStatus(err)
+		if code == codes.Internal {
+			message = fmt.Sprintf("Failed to update reminder: %v", err)
+		}
+		return &pb.UpdateReminderResponse{
+			Success: false,
+			Message: message,
+		}, domainError(code, err.Error(), err)
+	}
+
+	return &pb.UpdateReminderResponse{
+		Reminder: convert.ReminderToProto(reminder),
+		Success:  true,
+		Message:  "Reminder updated successfully",
+	}, nil
+}
+
+// reminderUpdateErrorStatus traduce los errores de UpdateReminder; codes.Internal indica un
+// error inesperado
+func reminderUpdateErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrReminderNotFound:
+		return codes.NotFound, "Reminder not found"
+	case entities.ErrReminderUnauthorized:
+		return codes.PermissionDenied, "Unauthorized access to reminder"
+	case entities.ErrInvalidUpdateMask:
+		return codes.InvalidArgument, "Invalid update mask"
+	case entities.ErrReminderTitleRequired, entities.ErrReminderScheduledTimeRequired:
+		return codes.InvalidArgument, "Title and scheduled time are required"
+	case entities.ErrInvalidReminderType:
+		return codes.InvalidArgument, "Invalid reminder type"
+	case entities.ErrInvalidReminderStatus:
+		return codes.InvalidArgument, "Invalid reminder status"
+	case entities.ErrInvalidReminderTransition:
+		return codes.FailedPrecondition, "Invalid reminder status transition"
+	case entities.ErrEscalationRequiresDeadline:
+		return codes.FailedPrecondition, "Only deadline reminders can escalate"
+	}
+	return codes.Internal, ""
+}
+
+// reminderListErrorStatus traduce los errores de los filtros de ListReminders; codes.Internal
+// indica un error inesperado
+func reminderListErrorStatus(err error) (codes.Code, string) {
+	switch err {
+	case entities.ErrInvalidPagination:
+		return codes.InvalidArgument, "Invalid count mode"
+	case entities.ErrInvalidSortField:
+		return codes.InvalidArgument, "Invalid sort field"
+	case entities.ErrInvalidReminderType:
+		return codes.InvalidArgument, "Invalid reminder type"
+	case entities.ErrInvalidReminderStatus:
+		return codes.InvalidArgument, "Invalid reminder status"
+	case entities.ErrInvalidReminderScope:
+		return codes.InvalidArgument, "Invalid reminder scope"
+	case entities.ErrInvalidReminderDateRange:
+		return codes.InvalidArgument, "Invalid date range"
+	}
+	return codes.Internal, ""
+}
@@ -0,0 +1,246 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollIdeaForReview implementa la inscripción de una idea en el repaso espaciado
+func (s *NotebookServer) EnrollIdeaForReview(ctx context.Context, req *pb.EnrollIdeaForReviewRequest) (*pb.EnrollIdeaForReviewResponse, error) {
+	if s.reviewUseCases == nil {
+		return &pb.EnrollIdeaForReviewResponse{
+			Success: false,
+			Message: "Idea review is not enabled",
+		}, status.Error(codes.Unavailable, "idea review not enabled")
+	}
+
+	ideaID, err := uuid.Parse(req.IdeaId)
+	if err != nil {
+		return &pb.EnrollIdeaForReviewResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.EnrollIdeaForReviewResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	review, err := s.reviewUseCases.EnrollIdea(ctx, ideaID, userID)
+	if err != nil {
+		if err == entities.ErrIdeaNotFound {
+			return &pb.EnrollIdeaForReviewResponse{
+				Success: false,
+				Message: "Idea not found",
+			}, domainError(codes.NotFound, "idea not found", err)
+		}
+		if err == entities.ErrIdeaUnauthorized {
+			return &pb.EnrollIdeaForReviewResponse{
+				Success: false,
+				Message: "Unauthorized access to idea",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrIdeaAlreadyEnrolled {
+			return &pb.EnrollIdeaForReviewResponse{
+				Success: false,
+				Message: "Idea is already enrolled for review",
+			}, domainError(codes.AlreadyExists, err.Error(), err)
+		}
+		return &pb.EnrollIdeaForReviewResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to enroll idea for review: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.EnrollIdeaForReviewResponse{
+		Review:  convert.IdeaReviewToProto(review),
+		Success: true,
+		Message: "Idea enrolled for review successfully",
+	}, nil
+}
+
+// UnenrollIdeaFromReview implementa la baja de una idea del repaso espaciado
+func (s *NotebookServer) UnenrollIdeaFromReview(ctx context.Context, req *pb.UnenrollIdeaFromReviewRequest) (*pb.UnenrollIdeaFromReviewResponse, error) {
+	if s.reviewUseCases == nil {
+		return &pb.UnenrollIdeaFromReviewResponse{
+			Success: false,
+			Message: "Idea review is not enabled",
+		}, status.Error(codes.Unavailable, "idea review not enabled")
+	}
+
+	ideaID, err := uuid.Parse(req.IdeaId)
+	if err != nil {
+		return &pb.UnenrollIdeaFromReviewResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.UnenrollIdeaFromReviewResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := s.reviewUseCases.UnenrollIdea(ctx, ideaID, userID); err != nil {
+		if err == entities.ErrIdeaReviewNotFound {
+			return &pb.UnenrollIdeaFromReviewResponse{
+				Success: false,
+				Message: "Idea is not enrolled for review",
+			}, domainError(codes.NotFound, err.Error(), err)
+		}
+		if err == entities.ErrIdeaReviewUnauthorized {
+			return &pb.UnenrollIdeaFromReviewResponse{
+				Success: false,
+				Message: "Unauthorized access to idea review",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.UnenrollIdeaFromReviewResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to unenroll idea from review: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.UnenrollIdeaFromReviewResponse{
+		Success: true,
+		Message: "Idea unenrolled from review successfully",
+	}, nil
+}
+
+// GetReviewQueue implementa la obtención de las ideas que toca repasar hoy
+func (s *NotebookServer) GetReviewQueue(ctx context.Context, req *pb.GetReviewQueueRequest) (*pb.GetReviewQueueResponse, error) {
+	if s.reviewUseCases == nil {
+		return &pb.GetReviewQueueResponse{
+			Success: false,
+			Message: "Idea review is not enabled",
+		}, status.Error(codes.Unavailable, "idea review not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetReviewQueueResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	loc := time.UTC
+	if req.TimeZone != "" {
+		if loc, err = time.LoadLocation(req.TimeZone); err != nil {
+			return &pb.GetReviewQueueResponse{
+				Success: false,
+				Message: "Invalid time zone",
+			}, status.Error(codes.InvalidArgument, "invalid time zone")
+		}
+	}
+
+	if req.Limit < 0 {
+		return &pb.GetReviewQueueResponse{
+			Success: false,
+			Message: "Invalid limit",
+		}, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+
+	items, totalCount, err := s.reviewUseCases.GetReviewQueue(ctx, userID, loc, int(req.Limit))
+	if err != nil {
+		return &pb.GetReviewQueueResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get review queue: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	pbItems := make([]*pb.ReviewQueueItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.ReviewQueueItem{
+			Idea:   convert.IdeaToProto(item.Idea),
+			Review: convert.IdeaReviewToProto(item.Review),
+		}
+	}
+
+	return &pb.GetReviewQueueResponse{
+		Items:      pbItems,
+		TotalCount: int32(totalCount),
+		Success:    true,
+		Message:    "Review queue retrieved successfully",
+	}, nil
+}
+
+// MarkReviewed implementa el registro de un repaso y el cálculo del siguiente intervalo
+func (s *NotebookServer) MarkReviewed(ctx context.Context, req *pb.MarkReviewedRequest) (*pb.MarkReviewedResponse, error) {
+	if s.reviewUseCases == nil {
+		return &pb.MarkReviewedResponse{
+			Success: false,
+			Message: "Idea review is not enabled",
+		}, status.Error(codes.Unavailable, "idea review not enabled")
+	}
+
+	ideaID, err := uuid.Parse(req.IdeaId)
+	if err != nil {
+		return &pb.MarkReviewedResponse{
+			Success: false,
+			Message: "Invalid idea ID format",
+		}, status.Error(codes.InvalidArgument, "invalid idea ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.MarkReviewedResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	review, err := s.reviewUseCases.MarkReviewed(ctx, ideaID, userID, entities.ReviewGrade(req.Grade))
+	if err != nil {
+		if err == entities.ErrInvalidReviewGrade {
+			return &pb.MarkReviewedResponse{
+				Success: false,
+				Message: "Grade must be between 0 and 5",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrIdeaReviewNotFound {
+			return &pb.MarkReviewedResponse{
+				Success: false,
+				Message: "Idea is not enrolled for review",
+			}, domainError(codes.NotFound, err.Error(), err)
+		}
+		if err == entities.ErrIdeaReviewUnauthorized {
+			return &pb.MarkReviewedResponse{
+				Success: false,
+				Message: "Unauthorized access to idea review",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrVersionConflict && review != nil {
+			// El repaso ya se registró desde otra sesión; se devuelve la inscripción vigente
+			return &pb.MarkReviewedResponse{
+				Review:  convert.IdeaReviewToProto(review),
+				Success: false,
+				Message: "Idea review was modified concurrently",
+			}, domainError(codes.Aborted, "idea review version conflict", err)
+		}
+		return &pb.MarkReviewedResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to mark idea as reviewed: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MarkReviewedResponse{
+		Review:  convert.IdeaReviewToProto(review),
+		Success: true,
+		Message: "Idea marked as reviewed successfully",
+	}, nil
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SemanticSearchIdeas implementa la búsqueda de ideas por significado y por palabras
+func (s *NotebookServer) SemanticSearchIdeas(ctx context.Context, req *pb.SemanticSearchIdeasRequest) (*pb.SemanticSearchIdeasResponse, error) {
+	if s.semanticSearch == nil {
+		return &pb.SemanticSearchIdeasResponse{
+			Success: false,
+			Message: "Semantic search is not enabled",
+		}, status.Error(codes.Unavailable, "semantic search not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SemanticSearchIdeasResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.Limit < 0 {
+		return &pb.SemanticSearchIdeasResponse{
+			Success: false,
+			Message: "Invalid limit",
+		}, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+
+	results, semantic, err := s.semanticSearch.SearchIdeas(ctx, userID, req.Query, int(req.Limit))
+	if err != nil {
+		if err == entities.ErrEmptySearchQuery {
+			return &pb.SemanticSearchIdeasResponse{
+				Success: false,
+				Message: "Search query is required",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.SemanticSearchIdeasResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to search ideas: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	pbResults := make([]*pb.IdeaSearchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = &pb.IdeaSearchResult{
+			Idea:         convert.IdeaToProto(result.Idea),
+			Score:        result.Score,
+			Similarity:   result.Similarity,
+			KeywordMatch: result.KeywordMatch,
+		}
+	}
+
+	return &pb.SemanticSearchIdeasResponse{
+		Results:  pbResults,
+		Semantic: semantic,
+		Success:  true,
+		Message:  "Ideas searched successfully",
+	}, nil
+}
@@ -2,13 +2,19 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/appversion"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/cdn"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/maintenance"
 	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -19,11 +25,232 @@ import (
 // NotebookServer implementa el servidor gRPC para el servicio de cuaderno
 type NotebookServer struct {
 	pb.UnimplementedNotebookServiceServer
-	ideaUseCases     *usecases.IdeaUseCases
-	reminderUseCases *usecases.ReminderUseCases
-	fileUseCases     *usecases.FileUseCases
-	progressUseCases *usecases.ProgressUseCases
-	notificationSvc  ports.NotificationService
+	ideaUseCases      *usecases.IdeaUseCases
+	reminderUseCases  *usecases.ReminderUseCases
+	fileUseCases      *usecases.FileUseCases
+	progressUseCases  *usecases.ProgressUseCases
+	notificationSvc   ports.NotificationService
+	queryDiagnostics  ports.QueryDiagnostics
+	notificationInbox ports.NotificationInbox
+	heartbeatInterval time.Duration
+	shareLinkUseCases *usecases.ShareLinkUseCases
+	shareBaseURL      string
+	maxUploadSize     int64
+	fileURLs          *cdn.FileURLs
+	inboundUseCases   *usecases.InboundUseCases
+	inboundBaseURL    string
+	inboundDomain     string
+	chatUseCases      *usecases.ChatUseCases
+	telegramBot       string
+	localeUseCases    *usecases.LocaleUseCases
+	boardUseCases     *usecases.BoardUseCases
+	semanticSearch    *usecases.SemanticSearchUseCases
+	classification    *usecases.ClassificationUseCases
+	reviewUseCases    *usecases.ReviewUseCases
+	publications      *usecases.PublicationUseCases
+	publicBaseURL     string
+	phoneUseCases     *usecases.PhoneUseCases
+	emailUseCases     *usecases.EmailUseCases
+	customFields      *usecases.CustomFieldUseCases
+	progressTemplates *usecases.ProgressTemplateUseCases
+	milestoneLinks    *usecases.ReminderMilestoneUseCases
+	bulkTags          *usecases.BulkTagUseCases
+	statistics        *usecases.StatisticsUseCases
+	telemetry         *usecases.TelemetryUseCases
+	ideaWatch         *usecases.IdeaWatchUseCases
+	maintenance       *maintenance.Mode
+	versionGate       *appversion.Gate
+}
+
+// replayBatchSize es el número de notificaciones leídas del buzón por consulta al reanudar
+const replayBatchSize = 100
+
+// ServerOption configura dependencias opcionales del servidor gRPC
+type ServerOption func(*NotebookServer)
+
+// WithQueryDiagnostics habilita el reporte de consultas lentas en GetDiagnostics
+func WithQueryDiagnostics(diagnostics ports.QueryDiagnostics) ServerOption {
+	return func(s *NotebookServer) {
+		s.queryDiagnostics = diagnostics
+	}
+}
+
+// WithNotificationInbox permite reanudar suscripciones desde el buzón persistente
+func WithNotificationInbox(inbox ports.NotificationInbox) ServerOption {
+	return func(s *NotebookServer) {
+		s.notificationInbox = inbox
+	}
+}
+
+// WithNotificationHeartbeat define cada cuánto se envía un latido en las suscripciones
+func WithNotificationHeartbeat(interval time.Duration) ServerOption {
+	return func(s *NotebookServer) {
+		s.heartbeatInterval = interval
+	}
+}
+
+// WithMaxUploadSize define el tamaño máximo en bytes de los archivos subidos
+func WithMaxUploadSize(maxBytes int64) ServerOption {
+	return func(s *NotebookServer) {
+		s.maxUploadSize = maxBytes
+	}
+}
+
+// WithFileURLs habilita GetFileUrl, que entrega URLs firmadas del endpoint HTTP de archivos
+func WithFileURLs(fileURLs *cdn.FileURLs) ServerOption {
+	return func(s *NotebookServer) {
+		s.fileURLs = fileURLs
+	}
+}
+
+// WithShareLinks habilita los enlaces de descarga compartida; baseURL es la dirección
+// pública del endpoint HTTP de descargas, a la que se añade el token
+func WithShareLinks(shareLinkUseCases *usecases.ShareLinkUseCases, baseURL string) ServerOption {
+	return func(s *NotebookServer) {
+		s.shareLinkUseCases = shareLinkUseCases
+		s.shareBaseURL = baseURL
+	}
+}
+
+// WithInbound habilita las direcciones de entrada; baseURL es la dirección pública del endpoint
+// HTTP de entrada y emailDomain el dominio de las direcciones de correo
+func WithInbound(inboundUseCases *usecases.InboundUseCases, baseURL, emailDomain string) ServerOption {
+	return func(s *NotebookServer) {
+		s.inboundUseCases = inboundUseCases
+		s.inboundBaseURL = baseURL
+		s.inboundDomain = emailDomain
+	}
+}
+
+// WithChatBindings habilita los vínculos con chats de Slack y Telegram; telegramBot es el nombre
+// de usuario del bot de Telegram, con el que se arma el enlace de vinculación
+func WithChatBindings(chatUseCases *usecases.ChatUseCases, telegramBot string) ServerOption {
+	return func(s *NotebookServer) {
+		s.chatUseCases = chatUseCases
+		s.telegramBot = telegramBot
+	}
+}
+
+// WithLocales habilita la elección del idioma preferido de cada usuario
+func WithLocales(localeUseCases *usecases.LocaleUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.localeUseCases = localeUseCases
+	}
+}
+
+// WithBoard habilita el tablero de ideas por estado
+func WithBoard(boardUseCases *usecases.BoardUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.boardUseCases = boardUseCases
+	}
+}
+
+// WithSemanticSearch habilita la búsqueda de ideas por significado
+func WithSemanticSearch(semanticSearch *usecases.SemanticSearchUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.semanticSearch = semanticSearch
+	}
+}
+
+// WithClassification incluye etiquetas y categoría sugeridas al crear o editar ideas
+func WithClassification(classification *usecases.ClassificationUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.classification = classification
+	}
+}
+
+// WithReviews habilita el repaso espaciado de ideas
+func WithReviews(reviewUseCases *usecases.ReviewUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.reviewUseCases = reviewUseCases
+	}
+}
+
+// WithPublishing habilita la publicación de ideas; baseURL es la dirección pública del
+// endpoint HTTP de ideas publicadas, a la que se añade el slug
+func WithPublishing(publications *usecases.PublicationUseCases, baseURL string) ServerOption {
+	return func(s *NotebookServer) {
+		s.publications = publications
+		s.publicBaseURL = baseURL
+	}
+}
+
+// WithPhoneNumbers habilita el registro y la verificación del teléfono para las notificaciones por SMS
+func WithPhoneNumbers(phoneUseCases *usecases.PhoneUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.phoneUseCases = phoneUseCases
+	}
+}
+
+// WithEmail habilita el registro de la dirección de email para las notificaciones y los informes
+func WithEmail(emailUseCases *usecases.EmailUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.emailUseCases = emailUseCases
+	}
+}
+
+// WithCustomFields habilita la definición de campos personalizados para ideas y progreso
+func WithCustomFields(customFieldUseCases *usecases.CustomFieldUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.customFields = customFieldUseCases
+	}
+}
+
+// WithProgressTemplates habilita las plantillas de progreso y su biblioteca por organización
+func WithProgressTemplates(progressTemplates *usecases.ProgressTemplateUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.progressTemplates = progressTemplates
+	}
+}
+
+// WithReminderMilestoneLinks habilita los vínculos entre recordatorios e hitos y los RPC que
+// completan ambos lados a la vez
+func WithReminderMilestoneLinks(milestoneLinks *usecases.ReminderMilestoneUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.milestoneLinks = milestoneLinks
+	}
+}
+
+// WithBulkTags habilita el etiquetado masivo de ideas
+func WithBulkTags(bulkTags *usecases.BulkTagUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.bulkTags = bulkTags
+	}
+}
+
+// WithStatistics habilita las estadísticas materializadas del panel
+func WithStatistics(statistics *usecases.StatisticsUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.statistics = statistics
+	}
+}
+
+// WithTelemetry habilita la recepción de la telemetría de la app
+func WithTelemetry(telemetry *usecases.TelemetryUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.telemetry = telemetry
+	}
+}
+
+// WithIdeaWatch habilita el envío en tiempo real de los cambios de las ideas
+func WithIdeaWatch(ideaWatch *usecases.IdeaWatchUseCases) ServerOption {
+	return func(s *NotebookServer) {
+		s.ideaWatch = ideaWatch
+	}
+}
+
+// WithMaintenanceBanner envía un aviso a las suscripciones de notificaciones al entrar y salir del modo mantenimiento
+func WithMaintenanceBanner(mode *maintenance.Mode) ServerOption {
+	return func(s *NotebookServer) {
+		s.maintenance = mode
+	}
+}
+
+// WithVersionGate publica en GetServerInfo la versión mínima de la app que acepta el servidor
+func WithVersionGate(gate *appversion.Gate) ServerOption {
+	return func(s *NotebookServer) {
+		s.versionGate = gate
+	}
 }
 
 // NewNotebookServer crea una nueva instancia del servidor gRPC
@@ -33,14 +260,23 @@ func NewNotebookServer(
 	fileUseCases *usecases.FileUseCases,
 	progressUseCases *usecases.ProgressUseCases,
 	notificationSvc ports.NotificationService,
+	options ...ServerOption,
 ) *NotebookServer {
-	return &NotebookServer{
-		ideaUseCases:     ideaUseCases,
-		reminderUseCases: reminderUseCases,
-		fileUseCases:     fileUseCases,
-		progressUseCases: progressUseCases,
-		notificationSvc:  notificationSvc,
+	server := &NotebookServer{
+		ideaUseCases:      ideaUseCases,
+		reminderUseCases:  reminderUseCases,
+		fileUseCases:      fileUseCases,
+		progressUseCases:  progressUseCases,
+		notificationSvc:   notificationSvc,
+		heartbeatInterval: 30 * time.Second,
+		maxUploadSize:     DefaultMaxUploadSize,
+	}
+	
+	for _, option := range options {
+		option(server)
 	}
+	
+	return server
 }
 
 // CreateIdea implementa la creación de ideas
@@ -63,6 +299,12 @@ func (s *NotebookServer) CreateIdea(ctx context.Context, req *pb.CreateIdeaReque
 		req.Priority,
 	)
 	if err != nil {
+		if err == entities.ErrContentRejected {
+			return &pb.CreateIdeaResponse{
+				Success: false,
+				Message: "Idea rejected by the content policy",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
 		return &pb.CreateIdeaResponse{
 			Success: false,
 			Message: fmt.Sprintf("Failed to create idea: %v", err),
@@ -70,9 +312,10 @@ func (s *NotebookServer) CreateIdea(ctx context.Context, req *pb.CreateIdeaReque
 	}
 
 	return &pb.CreateIdeaResponse{
-		Idea:    s.convertIdeaToProto(idea),
-		Success: true,
-		Message: "Idea created successfully",
+		Idea:        convert.IdeaToProto(idea),
+		Success:     true,
+		Message:     "Idea created successfully",
+		Suggestions: s.suggestionsFor(ctx, idea),
 	}, nil
 }
 
@@ -100,13 +343,13 @@ func (s *NotebookServer) GetIdea(ctx context.Context, req *pb.GetIdeaRequest) (*
 			return &pb.GetIdeaResponse{
 				Success: false,
 				Message: "Idea not found",
-			}, status.Error(codes.NotFound, "idea not found")
+			}, domainError(codes.NotFound, "idea not found", err)
 		}
 		if err == entities.ErrIdeaUnauthorized {
 			return &pb.GetIdeaResponse{
 				Success: false,
 				Message: "Unauthorized access to idea",
-			}, status.Error(codes.PermissionDenied, "unauthorized")
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
 		}
 		return &pb.GetIdeaResponse{
 			Success: false,
@@ -114,10 +357,21 @@ func (s *NotebookServer) GetIdea(ctx context.Context, req *pb.GetIdeaRequest) (*
 		}, status.Error(codes.Internal, err.Error())
 	}
 
+	etag := idea.ETag()
+	if req.IfNoneMatch != "" && entities.ETagMatches(req.IfNoneMatch, etag) {
+		return &pb.GetIdeaResponse{
+			Success:     true,
+			Message:     "Idea not modified",
+			Etag:        etag,
+			NotModified: true,
+		}, nil
+	}
+
 	return &pb.GetIdeaResponse{
-		Idea:    s.convertIdeaToProto(idea),
+		Idea:    convert.IdeaToProto(idea),
 		Success: true,
 		Message: "Idea retrieved successfully",
+		Etag:    etag,
 	}, nil
 }
 
@@ -131,26 +385,67 @@ func (s *NotebookServer) ListIdeas(ctx context.Context, req *pb.ListIdeasRequest
 		}, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
 
-	filters := ports.IdeaFilters{
-		Category: entities.IdeaCategory(req.Category),
-		Status:   entities.IdeaStatus(req.Status),
-		Tags:     req.Tags,
-		Page:     int(req.Page),
-		PageSize: int(req.PageSize),
-		SortBy:   req.SortBy,
-		SortDesc: req.SortDesc,
+	customFieldFilters, err := convert.CustomFieldFiltersFromProto(req.CustomFieldFilters)
+	if err != nil {
+		return &pb.ListIdeasResponse{
+			Success: false,
+			Message: "Invalid custom field filter",
+		}, domainError(codes.InvalidArgument, err.Error(), err)
+	}
+
+	page, err := pageFromRequest(req.Page, req.Cursor)
+	if err != nil {
+		return &pb.ListIdeasResponse{
+			Success: false,
+			Message: "Invalid cursor",
+		}, status.Error(codes.InvalidArgument, "invalid cursor")
 	}
 
-	// Valores por defecto para paginación
-	if filters.Page <= 0 {
-		filters.Page = 1
+	filters := ports.IdeaFilters{
+		Category:     entities.IdeaCategory(req.Category),
+		Status:       entities.IdeaStatus(req.Status),
+		Tags:         req.Tags,
+		Page:         page,
+		PageSize:     int(req.PageSize),
+		CustomFields: customFieldFilters,
+		Sort:         convert.SortFromProto(req.Sort, req.SortBy, req.SortDesc),
+		Count:        ports.CountMode(req.CountMode),
 	}
+
+	// Valor por defecto para paginación
 	if filters.PageSize <= 0 {
 		filters.PageSize = 10
 	}
 
+	// La máscara se valida antes de consultar; si no pide el contenido no se lee de la base de datos
+	if err := applyReadMask(&pb.Idea{}, req.ReadMask); err != nil {
+		return &pb.ListIdeasResponse{
+			Success: false,
+			Message: "Invalid read mask",
+		}, domainError(codes.InvalidArgument, err.Error(), err)
+	}
+	filters.WithoutContent = readMaskOmits(req.ReadMask, "content")
+
 	ideas, totalCount, err := s.ideaUseCases.ListIdeas(ctx, userID, filters)
 	if err != nil {
+		if err == entities.ErrInvalidCustomFieldFilter {
+			return &pb.ListIdeasResponse{
+				Success: false,
+				Message: "Invalid custom field filter",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrInvalidSortField {
+			return &pb.ListIdeasResponse{
+				Success: false,
+				Message: "Invalid sort field",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrInvalidPagination {
+			return &pb.ListIdeasResponse{
+				Success: false,
+				Message: "Invalid count mode",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
 		return &pb.ListIdeasResponse{
 			Success: false,
 			Message: fmt.Sprintf("Failed to list ideas: %v", err),
@@ -159,16 +454,22 @@ func (s *NotebookServer) ListIdeas(ctx context.Context, req *pb.ListIdeasRequest
 
 	protoIdeas := make([]*pb.Idea, len(ideas))
 	for i, idea := range ideas {
-		protoIdeas[i] = s.convertIdeaToProto(idea)
+		protoIdeas[i] = convert.IdeaToProto(idea)
+		applyReadMask(protoIdeas[i], req.ReadMask)
 	}
 
+	pageInfo := ports.NewPageInfo(filters.Page, filters.PageSize, totalCount, filters.Count)
+	reportedTotal, estimated := convertTotalToProto(totalCount, filters.Count)
 	return &pb.ListIdeasResponse{
-		Ideas:      protoIdeas,
-		TotalCount: int32(totalCount),
-		Page:       int32(filters.Page),
-		PageSize:   int32(filters.PageSize),
-		Success:    true,
-		Message:    "Ideas retrieved successfully",
+		Ideas:               protoIdeas,
+		TotalCount:          reportedTotal,
+		Page:                int32(filters.Page),
+		PageSize:            int32(filters.PageSize),
+		Success:             true,
+		Message:             "Ideas retrieved successfully",
+		HasMore:             pageInfo.HasMore,
+		TotalCountEstimated: estimated,
+		PageInfo:            pageInfoToProto(pageInfo),
 	}, nil
 }
 
@@ -194,25 +495,52 @@ func (s *NotebookServer) UpdateIdea(ctx context.Context, req *pb.UpdateIdeaReque
 		ctx,
 		ideaID,
 		userID,
+		req.ExpectedVersion,
 		req.Title,
 		req.Content,
 		req.Tags,
 		entities.IdeaCategory(req.Category),
 		entities.IdeaStatus(req.Status),
 		req.Priority,
+		req.GetUpdateMask().GetPaths(),
 	)
 	if err != nil {
 		if err == entities.ErrIdeaNotFound {
 			return &pb.UpdateIdeaResponse{
 				Success: false,
 				Message: "Idea not found",
-			}, status.Error(codes.NotFound, "idea not found")
+			}, domainError(codes.NotFound, "idea not found", err)
 		}
 		if err == entities.ErrIdeaUnauthorized {
 			return &pb.UpdateIdeaResponse{
 				Success: false,
 				Message: "Unauthorized access to idea",
-			}, status.Error(codes.PermissionDenied, "unauthorized")
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrInvalidUpdateMask {
+			return &pb.UpdateIdeaResponse{
+				Success: false,
+				Message: "Invalid update mask",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrContentRejected {
+			return &pb.UpdateIdeaResponse{
+				Success: false,
+				Message: "Idea rejected by the content policy",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		if err == entities.ErrVersionConflict && idea != nil {
+			// La idea más reciente viaja en los detalles del status para que el cliente pueda fusionar
+			latest := convert.IdeaToProto(idea)
+			st := status.New(codes.Aborted, "idea version conflict")
+			if detailed, detailErr := st.WithDetails(errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomain), latest); detailErr == nil {
+				st = detailed
+			}
+			return &pb.UpdateIdeaResponse{
+				Idea:    latest,
+				Success: false,
+				Message: "Idea was modified concurrently",
+			}, st.Err()
 		}
 		return &pb.UpdateIdeaResponse{
 			Success: false,
@@ -221,9 +549,10 @@ func (s *NotebookServer) UpdateIdea(ctx context.Context, req *pb.UpdateIdeaReque
 	}
 
 	return &pb.UpdateIdeaResponse{
-		Idea:    s.convertIdeaToProto(idea),
-		Success: true,
-		Message: "Idea updated successfully",
+		Idea:        convert.IdeaToProto(idea),
+		Success:     true,
+		Message:     "Idea updated successfully",
+		Suggestions: s.suggestionsFor(ctx, idea),
 	}, nil
 }
 
@@ -251,13 +580,13 @@ func (s *NotebookServer) DeleteIdea(ctx context.Context, req *pb.DeleteIdeaReque
 			return &pb.DeleteIdeaResponse{
 				Success: false,
 				Message: "Idea not found",
-			}, status.Error(codes.NotFound, "idea not found")
+			}, domainError(codes.NotFound, "idea not found", err)
 		}
 		if err == entities.ErrIdeaUnauthorized {
 			return &pb.DeleteIdeaResponse{
 				Success: false,
 				Message: "Unauthorized access to idea",
-			}, status.Error(codes.PermissionDenied, "unauthorized")
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
 		}
 		return &pb.DeleteIdeaResponse{
 			Success: false,
@@ -273,59 +602,216 @@ func (s *NotebookServer) DeleteIdea(ctx context.Context, req *pb.DeleteIdeaReque
 
 // UploadFile implementa la subida de archivos con streaming
 func (s *NotebookServer) UploadFile(stream pb.NotebookService_UploadFileServer) error {
-	var metadata *pb.FileMetadata
-	var fileData []byte
+	fileInfo, err := s.receiveUpload(stream.Context(), stream.Recv, nil)
+	if err != nil {
+		return err
+	}
 
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			break
+	return stream.SendAndClose(s.uploadResponse(fileInfo))
+}
+
+// ListFiles implementa la lista y búsqueda de archivos
+func (s *NotebookServer) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.ListFilesResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListFilesResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	page, err := pageFromRequest(req.Page, req.Cursor)
+	if err != nil {
+		return &pb.ListFilesResponse{
+			Success: false,
+			Message: "Invalid cursor",
+		}, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	filters := ports.FileFilters{
+		ContentTypeFilter: req.ContentTypeFilter,
+		SearchQuery:       strings.TrimSpace(req.SearchQuery),
+		Page:              page,
+		PageSize:          int(req.PageSize),
+		Sort:              convert.SortFromProto(req.Sort, req.SortBy, req.SortDesc),
+		Count:             ports.CountMode(req.CountMode),
+	}
+
+	// Valor por defecto para paginación
+	if filters.PageSize <= 0 {
+		filters.PageSize = 10
+	}
+
+	files, totalCount, err := s.fileUseCases.ListFiles(ctx, userID, filters)
+	if err != nil {
+		if err == entities.ErrInvalidSortField {
+			return &pb.ListFilesResponse{
+				Success: false,
+				Message: err.Error(),
+			}, domainError(codes.InvalidArgument, err.Error(), err)
 		}
-		if err != nil {
-			return status.Error(codes.Internal, fmt.Sprintf("Failed to receive chunk: %v", err))
+		if err == entities.ErrInvalidPagination {
+			return &pb.ListFilesResponse{
+				Success: false,
+				Message: "Invalid count mode",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
 		}
+		return &pb.ListFilesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list files: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	protoFiles := make([]*pb.FileInfo, len(files))
+	for i, fileInfo := range files {
+		protoFiles[i] = convert.FileInfoToProto(fileInfo)
+	}
+
+	pageInfo := ports.NewPageInfo(filters.Page, filters.PageSize, totalCount, filters.Count)
+	reportedTotal, estimated := convertTotalToProto(totalCount, filters.Count)
+	return &pb.ListFilesResponse{
+		Files:               protoFiles,
+		TotalCount:          reportedTotal,
+		Page:                int32(filters.Page),
+		PageSize:            int32(filters.PageSize),
+		Success:             true,
+		Message:             "Files retrieved successfully",
+		HasMore:             pageInfo.HasMore,
+		TotalCountEstimated: estimated,
+		PageInfo:            pageInfoToProto(pageInfo),
+	}, nil
+}
+
+// ListFileVersions implementa la lista de versiones de un archivo
+func (s *NotebookServer) ListFileVersions(ctx context.Context, req *pb.ListFileVersionsRequest) (*pb.ListFileVersionsResponse, error) {
+	fileID, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return &pb.ListFileVersionsResponse{
+			Success: false,
+			Message: "Invalid file ID format",
+		}, status.Error(codes.InvalidArgument, "invalid file ID")
+	}
 
-		switch data := req.Data.(type) {
-		case *pb.UploadFileRequest_Metadata:
-			metadata = data.Metadata
-		case *pb.UploadFileRequest_Chunk:
-			fileData = append(fileData, data.Chunk...)
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListFileVersionsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	versions, err := s.fileUseCases.ListFileVersions(ctx, fileID, userID)
+	if err != nil {
+		if err == entities.ErrFileNotFound {
+			return &pb.ListFileVersionsResponse{
+				Success: false,
+				Message: "File not found",
+			}, domainError(codes.NotFound, "file not found", err)
 		}
+		if err == entities.ErrFileUnauthorized {
+			return &pb.ListFileVersionsResponse{
+				Success: false,
+				Message: "Unauthorized access to file",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.ListFileVersionsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list file versions: %v", err),
+		}, status.Error(codes.Internal, err.Error())
 	}
 
-	if metadata == nil {
-		return status.Error(codes.InvalidArgument, "File metadata is required")
+	protoVersions := make([]*pb.FileInfo, len(versions))
+	for i, version := range versions {
+		protoVersions[i] = convert.FileInfoToProto(version)
 	}
 
-	userID, err := uuid.Parse(metadata.UserId)
+	return &pb.ListFileVersionsResponse{
+		Versions: protoVersions,
+		Success:  true,
+		Message:  "File versions retrieved successfully",
+	}, nil
+}
+
+// RestoreVersion implementa la restauración de una versión de archivo
+func (s *NotebookServer) RestoreVersion(ctx context.Context, req *pb.RestoreVersionRequest) (*pb.RestoreVersionResponse, error) {
+	versionID, err := uuid.Parse(req.VersionId)
 	if err != nil {
-		return status.Error(codes.InvalidArgument, "Invalid user ID format")
+		return &pb.RestoreVersionResponse{
+			Success: false,
+			Message: "Invalid version ID format",
+		}, status.Error(codes.InvalidArgument, "invalid version ID")
 	}
 
-	// Crear un reader desde los datos del archivo
-	reader := &bytesReader{data: fileData}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.RestoreVersionResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
 
-	fileInfo, err := s.fileUseCases.UploadFile(
-		stream.Context(),
-		metadata.Filename,
-		metadata.ContentType,
-		reader,
-		userID,
-		metadata.Compress,
-		metadata.CompressionType,
-	)
+	fileInfo, err := s.fileUseCases.RestoreVersion(ctx, versionID, userID)
 	if err != nil {
-		return status.Error(codes.Internal, fmt.Sprintf("Failed to upload file: %v", err))
+		if err == entities.ErrFileNotFound {
+			return &pb.RestoreVersionResponse{
+				Success: false,
+				Message: "File version not found",
+			}, domainError(codes.NotFound, "file version not found", err)
+		}
+		if err == entities.ErrFileUnauthorized {
+			return &pb.RestoreVersionResponse{
+				Success: false,
+				Message: "Unauthorized access to file",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.RestoreVersionResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to restore file version: %v", err),
+		}, status.Error(codes.Internal, err.Error())
 	}
 
-	response := &pb.UploadFileResponse{
-		FileInfo: s.convertFileInfoToProto(fileInfo),
+	return &pb.RestoreVersionResponse{
+		FileInfo: convert.FileInfoToProto(fileInfo),
 		Success:  true,
-		Message:  "File uploaded successfully",
-		UploadId: fileInfo.ID.String(),
+		Message:  "File version restored successfully",
+	}, nil
+}
+
+// GetStorageUsage implementa la consulta del almacenamiento usado por un usuario
+func (s *NotebookServer) GetStorageUsage(ctx context.Context, req *pb.GetStorageUsageRequest) (*pb.GetStorageUsageResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetStorageUsageResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	usage, err := s.fileUseCases.GetStorageUsage(ctx, userID)
+	if err != nil {
+		return &pb.GetStorageUsageResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get storage usage: %v", err),
+		}, status.Error(codes.Internal, err.Error())
 	}
 
-	return stream.SendAndClose(response)
+	tiers := make([]*pb.StorageTierUsage, len(usage.Tiers))
+	for i, tier := range usage.Tiers {
+		tiers[i] = &pb.StorageTierUsage{
+			Tier:       string(tier.Tier),
+			FileCount:  int32(tier.Blobs),
+			TotalBytes: tier.Bytes,
+		}
+	}
+
+	return &pb.GetStorageUsageResponse{
+		FileCount:    int32(usage.Files),
+		VersionCount: int32(usage.Versions),
+		TotalBytes:   usage.Bytes,
+		Tiers:        tiers,
+		Success:      true,
+		Message:      "Storage usage retrieved successfully",
+	}, nil
 }
 
 // SubscribeNotifications implementa la suscripción a notificaciones
@@ -335,24 +821,68 @@ func (s *NotebookServer) SubscribeNotifications(req *pb.NotificationSubscription
 		return status.Error(codes.InvalidArgument, "Invalid user ID format")
 	}
 
+	var resumeAfterID uuid.UUID
+	if req.ResumeAfterId != "" {
+		if s.notificationInbox == nil {
+			return status.Error(codes.FailedPrecondition, "notification resume is not enabled")
+		}
+		resumeAfterID, err = uuid.Parse(req.ResumeAfterId)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "Invalid resume notification ID format")
+		}
+	}
+
+	// La suscripción se abre antes de leer el buzón para no perder lo enviado entre ambos pasos
 	notificationCh, err := s.notificationSvc.SubscribeToNotifications(stream.Context(), userID, req.Channels)
 	if err != nil {
 		return status.Error(codes.Internal, fmt.Sprintf("Failed to subscribe to notifications: %v", err))
 	}
 
+	var replayed map[uuid.UUID]bool
+	if resumeAfterID != uuid.Nil {
+		replayed, err = s.replayNotifications(stream, userID, resumeAfterID, req.Channels)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Sin modo mantenimiento el canal queda nil y nunca se selecciona
+	var maintenanceCh <-chan maintenance.State
+	if s.maintenance != nil {
+		maintenanceCh = s.maintenance.Subscribe(stream.Context())
+		if state := s.maintenance.State(); state.Enabled {
+			if err := stream.Send(maintenanceBanner(state, userID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
 	for {
 		select {
-		case notification := <-notificationCh:
-			protoNotification := &pb.NotificationResponse{
-				Id:        notification.ID.String(),
-				Title:     notification.Title,
-				Message:   notification.Message,
-				Type:      notification.Type,
-				CreatedAt: timestamppb.New(time.Now()),
-				UserId:    userID.String(),
-				Metadata:  notification.Metadata,
+		case state := <-maintenanceCh:
+			if err := stream.Send(maintenanceBanner(state, userID)); err != nil {
+				return err
+			}
+		case notification, ok := <-notificationCh:
+			if !ok {
+				// El hub cierra el canal de los suscriptores que no consumen a tiempo; el cliente debe reconectar
+				return status.Error(codes.Unavailable, "notification subscription closed, reconnect to resume")
 			}
-			if err := stream.Send(protoNotification); err != nil {
+			if replayed[notification.ID] {
+				continue
+			}
+			if err := stream.Send(convert.NotificationToProto(notification, false)); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if err := stream.Send(&pb.NotificationResponse{
+				Heartbeat: true,
+				CreatedAt: timestamppb.Now(),
+				UserId:    userID.String(),
+			}); err != nil {
 				return err
 			}
 		case <-stream.Context().Done():
@@ -361,55 +891,196 @@ func (s *NotebookServer) SubscribeNotifications(req *pb.NotificationSubscription
 	}
 }
 
-// Métodos auxiliares para conversiones
+// maintenanceBanner es el aviso de inicio o fin del modo mantenimiento; no se guarda en el buzón
+func maintenanceBanner(state maintenance.State, userID uuid.UUID) *pb.NotificationResponse {
+	title := "Maintenance finished"
+	message := "All features are available again"
+	if state.Enabled {
+		title = "Maintenance in progress"
+		message = state.Message
+		if message == "" {
+			message = "Changes are temporarily disabled; you can keep reading your notebook"
+		}
+	}
+	return &pb.NotificationResponse{
+		Id:        uuid.New().String(),
+		Title:     title,
+		Message:   message,
+		Type:      "maintenance",
+		CreatedAt: timestamppb.New(state.ChangedAt),
+		UserId:    userID.String(),
+		Metadata: map[string]string{
+			"enabled":             strconv.FormatBool(state.Enabled),
+			"retry_after_seconds": strconv.FormatInt(int64(state.RetryAfter/time.Second), 10),
+		},
+	}
+}
+
+// replayNotifications reenvía las notificaciones del buzón posteriores a afterID y devuelve sus IDs
+func (s *NotebookServer) replayNotifications(stream pb.NotebookService_SubscribeNotificationsServer, userID, afterID uuid.UUID, channels []string) (map[uuid.UUID]bool, error) {
+	replayed := make(map[uuid.UUID]bool)
+	for {
+		notifications, err := s.notificationInbox.ListAfter(stream.Context(), userID, afterID, replayBatchSize)
+		if err != nil {
+			if errors.Is(err, entities.ErrNotificationNotFound) {
+				return nil, domainError(codes.FailedPrecondition, "resume notification expired, resubscribe without resume_after_id", err)
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to replay notifications: %v", err))
+		}
+
+		for _, notification := range notifications {
+			replayed[notification.ID] = true
+			if !notification.MatchesChannels(channels) {
+				continue
+			}
+			if err := stream.Send(convert.NotificationToProto(notification, true)); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(notifications) < replayBatchSize {
+			return replayed, nil
+		}
+		afterID = notifications[len(notifications)-1].ID
+	}
+}
+
+// ListNotifications implementa el historial de notificaciones guardadas en el buzón
+func (s *NotebookServer) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	if s.notificationInbox == nil {
+		return &pb.ListNotificationsResponse{
+			Success: false,
+			Message: "Notification inbox is not enabled",
+		}, status.Error(codes.Unavailable, "notification inbox not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListNotificationsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	page, err := pageFromRequest(req.Page, req.Cursor)
+	if err != nil {
+		return &pb.ListNotificationsResponse{
+			Success: false,
+			Message: "Invalid cursor",
+		}, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	filters := ports.NotificationFilters{
+		Page:     page,
+		PageSize: int(req.PageSize),
+		Count:    ports.CountMode(req.CountMode),
+	}
+	if req.PageSize < 0 || !filters.Count.IsValid() {
+		return &pb.ListNotificationsResponse{
+			Success: false,
+			Message: "Invalid pagination",
+		}, domainError(codes.InvalidArgument, entities.ErrInvalidPagination.Error(), entities.ErrInvalidPagination)
+	}
+
+	// Valor por defecto para paginación
+	if filters.PageSize == 0 {
+		filters.PageSize = 10
+	}
+
+	notifications, totalCount, err := s.notificationInbox.List(ctx, userID, filters)
+	if err != nil {
+		return &pb.ListNotificationsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list notifications: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
 
-func (s *NotebookServer) convertIdeaToProto(idea *entities.Idea) *pb.Idea {
-	relatedIdeas := make([]string, len(idea.RelatedIdeas))
-	for i, id := range idea.RelatedIdeas {
-		relatedIdeas[i] = id.String()
+	protoNotifications := make([]*pb.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		protoNotifications[i] = convert.NotificationToProto(notification, false)
 	}
 
-	return &pb.Idea{
-		Id:           idea.ID.String(),
-		Title:        idea.Title,
-		Content:      idea.Content,
-		Tags:         idea.Tags,
-		Category:     pb.IdeaCategory(idea.Category),
-		Status:       pb.IdeaStatus(idea.Status),
-		CreatedAt:    timestamppb.New(idea.CreatedAt),
-		UpdatedAt:    timestamppb.New(idea.UpdatedAt),
-		UserId:       idea.UserID.String(),
-		RelatedIdeas: relatedIdeas,
-		Priority:     idea.Priority,
+	pageInfo := ports.NewPageInfo(filters.Page, filters.PageSize, totalCount, filters.Count)
+	return &pb.ListNotificationsResponse{
+		Notifications: protoNotifications,
+		PageInfo:      pageInfoToProto(pageInfo),
+		Success:       true,
+		Message:       "Notifications retrieved successfully",
+	}, nil
+}
+
+// GetDiagnostics implementa la consulta de diagnósticos del servidor
+func (s *NotebookServer) GetDiagnostics(ctx context.Context, req *pb.GetDiagnosticsRequest) (*pb.GetDiagnosticsResponse, error) {
+	if s.queryDiagnostics == nil {
+		return &pb.GetDiagnosticsResponse{
+			Success: false,
+			Message: "Diagnostics are not enabled",
+		}, status.Error(codes.Unavailable, "diagnostics not enabled")
+	}
+
+	limit := int(req.SlowQueryLimit)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	slowQueries := s.queryDiagnostics.TopSlowQueries(limit)
+	protoQueries := make([]*pb.SlowQuery, len(slowQueries))
+	for i, query := range slowQueries {
+		protoQueries[i] = &pb.SlowQuery{
+			Name:       query.Name,
+			Sql:        query.SQL,
+			DurationMs: query.Duration.Milliseconds(),
+			ExecutedAt: timestamppb.New(query.ExecutedAt),
+			ArgCount:   int32(query.ArgCount),
+			RequestId:  query.RequestID,
+		}
 	}
+
+	return &pb.GetDiagnosticsResponse{
+		SlowQueries: protoQueries,
+		Success:     true,
+		Message:     "Diagnostics retrieved successfully",
+	}, nil
 }
 
-func (s *NotebookServer) convertFileInfoToProto(fileInfo *entities.FileInfo) *pb.FileInfo {
-	return &pb.FileInfo{
-		Id:              fileInfo.ID.String(),
-		Filename:        fileInfo.Filename,
-		ContentType:     fileInfo.ContentType,
-		Size:            fileInfo.Size,
-		Checksum:        fileInfo.Checksum,
-		CreatedAt:       timestamppb.New(fileInfo.CreatedAt),
-		UserId:          fileInfo.UserID.String(),
-		Compressed:      fileInfo.Compressed,
-		CompressionType: fileInfo.CompressionType,
-		Path:            fileInfo.Path,
+// Métodos auxiliares para conversiones
+
+// convertTotalToProto devuelve el total_count de un listado según el modo de conteo: sin conteo
+// el total de los repositorios es solo una cota inferior y se devuelve 0
+func convertTotalToProto(total int, mode ports.CountMode) (int32, bool) {
+	switch mode {
+	case ports.CountNone:
+		return 0, false
+	case ports.CountEstimated:
+		return int32(total), true
 	}
+	return int32(total), false
 }
 
-// bytesReader es un helper para convertir []byte a io.Reader
-type bytesReader struct {
-	data []byte
-	pos  int
+// pageFromRequest devuelve la página de un listado: la del cursor si la petición trae uno, si no
+// page, que vale 1 si no se indica
+func pageFromRequest(page int32, cursor string) (int, error) {
+	if cursor != "" {
+		return decodePageToken(cursor)
+	}
+	if page <= 0 {
+		return 1, nil
+	}
+	return int(page), nil
 }
 
-func (r *bytesReader) Read(p []byte) (n int, err error) {
-	if r.pos >= len(r.data) {
-		return 0, io.EOF
+// pageInfoToProto convierte la página de un listado; los cursores usan el formato de page_token de la API v2
+func pageInfoToProto(info ports.PageInfo) *pb.PageInfo {
+	total, _ := convertTotalToProto(info.Total, info.Count)
+	message := &pb.PageInfo{
+		TotalEstimate: total,
+		HasMore:       info.HasMore,
+	}
+	if next := info.NextPage(); next > 0 {
+		message.NextCursor = encodePageToken(next)
 	}
-	n = copy(p, r.data[r.pos:])
-	r.pos += n
-	return n, nil
-}
\ No newline at end of file
+	if prev := info.PrevPage(); prev > 0 {
+		message.PrevCursor = encodePageToken(prev)
+	}
+	return message
+}
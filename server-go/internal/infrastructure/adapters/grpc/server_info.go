@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/buildinfo"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetServerInfo implementa la consulta de la versión y las capacidades del servidor
+func (s *NotebookServer) GetServerInfo(ctx context.Context, req *pb.GetServerInfoRequest) (*pb.GetServerInfoResponse, error) {
+	info := buildinfo.Get()
+
+	response := &pb.GetServerInfoResponse{
+		Version:            info.Version,
+		GitCommit:          info.Commit,
+		ProtoSchemaVersion: info.SchemaVersion,
+		Features:           s.enabledFeatures(),
+		ApiVersions: []string{
+			pb.NotebookService_ServiceDesc.ServiceName,
+			pbv2.NotebookService_ServiceDesc.ServiceName,
+		},
+		GoVersion: info.GoVersion,
+		Success:   true,
+		Message:   "Server info retrieved successfully",
+	}
+	if s.versionGate != nil {
+		response.MinClientVersion = s.versionGate.MinVersion()
+		response.UpgradeUrl = s.versionGate.UpgradeURL()
+	}
+	if !info.Date.IsZero() {
+		response.BuildDate = timestamppb.New(info.Date)
+	}
+	return response, nil
+}
+
+// enabledFeatures lista las funciones opcionales configuradas con ServerOption, en orden estable
+func (s *NotebookServer) enabledFeatures() []string {
+	features := []struct {
+		name    string
+		enabled bool
+	}{
+		{"diagnostics", s.queryDiagnostics != nil},
+		{"notification_replay", s.notificationInbox != nil},
+		{"file_urls", s.fileURLs != nil},
+		{"share_links", s.shareLinkUseCases != nil},
+		{"inbound", s.inboundUseCases != nil},
+		{"chat_bindings", s.chatUseCases != nil},
+		{"locales", s.localeUseCases != nil},
+		{"board", s.boardUseCases != nil},
+		{"semantic_search", s.semanticSearch != nil},
+		{"classification", s.classification != nil},
+		{"reviews", s.reviewUseCases != nil},
+		{"publishing", s.publications != nil},
+		{"phone_numbers", s.phoneUseCases != nil},
+		{"custom_fields", s.customFields != nil},
+		{"bulk_tags", s.bulkTags != nil},
+		{"statistics", s.statistics != nil},
+		{"telemetry", s.telemetry != nil},
+		{"idea_watch", s.ideaWatch != nil},
+	}
+
+	enabled := make([]string, 0, len(features))
+	for _, feature := range features {
+		if feature.enabled {
+			enabled = append(enabled, feature.name)
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,209 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// NotebookServerV2 implementa la versión 2 del servicio de cuaderno.
+// Comparte los casos de uso con NotebookServer; solo cambia el contrato del API.
+type NotebookServerV2 struct {
+	pbv2.UnimplementedNotebookServiceServer
+	ideaUseCases *usecases.IdeaUseCases
+}
+
+// NewNotebookServerV2 crea una nueva instancia del servidor gRPC v2
+func NewNotebookServerV2(ideaUseCases *usecases.IdeaUseCases) *NotebookServerV2 {
+	return &NotebookServerV2{
+		ideaUseCases: ideaUseCases,
+	}
+}
+
+// CreateIdea implementa la creación de ideas
+func (s *NotebookServerV2) CreateIdea(ctx context.Context, req *pbv2.CreateIdeaRequest) (*pbv2.Idea, error) {
+	userID, err := parseUUIDV2("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Idea == nil {
+		return nil, invalidArgumentV2("idea", "is required")
+	}
+
+	idea, err := s.ideaUseCases.CreateIdea(
+		ctx,
+		req.Idea.Title,
+		req.Idea.Content,
+		entities.IdeaCategory(req.Idea.Category),
+		userID,
+		req.Idea.Tags,
+		req.Idea.Priority,
+	)
+	if err != nil {
+		return nil, ideaErrorToStatusV2(err, "")
+	}
+
+	return convert.IdeaToProtoV2(idea), nil
+}
+
+// GetIdea implementa la obtención de ideas
+func (s *NotebookServerV2) GetIdea(ctx context.Context, req *pbv2.GetIdeaRequest) (*pbv2.Idea, error) {
+	ideaID, err := parseUUIDV2("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUUIDV2("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	idea, err := s.ideaUseCases.GetIdea(ctx, ideaID, userID)
+	if err != nil {
+		return nil, ideaErrorToStatusV2(err, req.Id)
+	}
+
+	etag := idea.ETag()
+	if req.IfNoneMatch != "" && entities.ETagMatches(req.IfNoneMatch, etag) {
+		return &pbv2.Idea{Id: idea.ID.String(), Etag: etag}, nil
+	}
+
+	protoIdea := convert.IdeaToProtoV2(idea)
+	if err := applyReadMask(protoIdea, req.ReadMask); err != nil {
+		return nil, invalidArgumentV2("read_mask", err.Error())
+	}
+	// read_mask no puede quitar la ETag: el cliente la necesita para la siguiente petición
+	protoIdea.Etag = etag
+	return protoIdea, nil
+}
+
+// ListIdeas implementa la lista de ideas paginada con cursores
+func (s *NotebookServerV2) ListIdeas(ctx context.Context, req *pbv2.ListIdeasRequest) (*pbv2.ListIdeasResponse, error) {
+	userID, err := parseUUIDV2("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, invalidArgumentV2("page_token", err.Error())
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize < 0 {
+		return nil, invalidArgumentV2("page_size", "must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = 10
+	}
+
+	filters := ports.IdeaFilters{
+		Category: entities.IdeaCategory(req.Category),
+		Status:   entities.IdeaStatus(req.Status),
+		Tags:     req.Tags,
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     convertSortFromProtoV2(req.Sort, req.SortBy, req.SortDesc),
+		Count:    ports.CountMode(req.CountMode),
+		// Si read_mask no pide el contenido no se lee de la base de datos
+		WithoutContent: readMaskOmits(req.ReadMask, "content"),
+	}
+
+	ideas, totalCount, err := s.ideaUseCases.ListIdeas(ctx, userID, filters)
+	if err != nil {
+		return nil, ideaErrorToStatusV2(err, "")
+	}
+
+	protoIdeas := make([]*pbv2.Idea, len(ideas))
+	for i, idea := range ideas {
+		protoIdeas[i] = convert.IdeaToProtoV2(idea)
+		if err := applyReadMask(protoIdeas[i], req.ReadMask); err != nil {
+			return nil, invalidArgumentV2("read_mask", err.Error())
+		}
+	}
+
+	response := &pbv2.ListIdeasResponse{
+		Ideas: protoIdeas,
+	}
+	// Sin conteo el total es solo una cota inferior; sirve para el cursor pero no se devuelve
+	switch filters.Count {
+	case ports.CountExact:
+		response.TotalCount = int32(totalCount)
+	case ports.CountEstimated:
+		response.TotalCount = int32(totalCount)
+		response.TotalCountEstimated = true
+	}
+	if page*pageSize < totalCount {
+		response.NextPageToken = encodePageToken(page + 1)
+	}
+	return response, nil
+}
+
+// UpdateIdea implementa la actualización de ideas; update_mask es obligatorio
+func (s *NotebookServerV2) UpdateIdea(ctx context.Context, req *pbv2.UpdateIdeaRequest) (*pbv2.Idea, error) {
+	userID, err := parseUUIDV2("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Idea == nil {
+		return nil, invalidArgumentV2("idea", "is required")
+	}
+	ideaID, err := parseUUIDV2("idea.id", req.Idea.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, invalidArgumentV2("update_mask", "must list at least one field")
+	}
+
+	idea, err := s.ideaUseCases.UpdateIdea(
+		ctx,
+		ideaID,
+		userID,
+		req.ExpectedVersion,
+		req.Idea.Title,
+		req.Idea.Content,
+		req.Idea.Tags,
+		entities.IdeaCategory(req.Idea.Category),
+		entities.IdeaStatus(req.Idea.Status),
+		req.Idea.Priority,
+		req.UpdateMask.Paths,
+	)
+	if err != nil {
+		if errors.Is(err, entities.ErrVersionConflict) && idea != nil {
+			// La idea más reciente viaja en los detalles para que el cliente pueda fusionar
+			return nil, statusWithDetails(codes.Aborted, "idea version conflict",
+				errorInfo(pb.ErrorCode_ERROR_CODE_VERSION_CONFLICT, errorDomainV2),
+				convert.IdeaToProtoV2(idea),
+			)
+		}
+		return nil, ideaErrorToStatusV2(err, req.Idea.Id)
+	}
+
+	return convert.IdeaToProtoV2(idea), nil
+}
+
+// DeleteIdea implementa la eliminación de ideas
+func (s *NotebookServerV2) DeleteIdea(ctx context.Context, req *pbv2.DeleteIdeaRequest) (*emptypb.Empty, error) {
+	ideaID, err := parseUUIDV2("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUUIDV2("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ideaUseCases.DeleteIdea(ctx, ideaID, userID); err != nil {
+		return nil, ideaErrorToStatusV2(err, req.Id)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateShareLink implementa la creación de enlaces de descarga compartida
+func (s *NotebookServer) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.CreateShareLinkResponse, error) {
+	if s.shareLinkUseCases == nil {
+		return &pb.CreateShareLinkResponse{
+			Success: false,
+			Message: "Share links are not enabled",
+		}, status.Error(codes.Unavailable, "share links not enabled")
+	}
+
+	fileID, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return &pb.CreateShareLinkResponse{
+			Success: false,
+			Message: "Invalid file ID format",
+		}, status.Error(codes.InvalidArgument, "invalid file ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CreateShareLinkResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	link, token, err := s.shareLinkUseCases.CreateShareLink(
+		ctx,
+		fileID,
+		userID,
+		time.Duration(req.TtlSeconds)*time.Second,
+		req.Password,
+		req.MaxDownloads,
+	)
+	if err != nil {
+		if err == entities.ErrFileNotFound {
+			return &pb.CreateShareLinkResponse{
+				Success: false,
+				Message: "File not found",
+			}, domainError(codes.NotFound, "file not found", err)
+		}
+		if err == entities.ErrFileUnauthorized {
+			return &pb.CreateShareLinkResponse{
+				Success: false,
+				Message: "Unauthorized access to file",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		if err == entities.ErrShareLinkInvalidExpiry || err == entities.ErrShareLinkInvalidDownloadLimit {
+			return &pb.CreateShareLinkResponse{
+				Success: false,
+				Message: err.Error(),
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.CreateShareLinkResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to create share link: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CreateShareLinkResponse{
+		ShareLink: convert.ShareLinkToProto(link),
+		Token:     token,
+		Url:       s.shareURL(token),
+		Success:   true,
+		Message:   "Share link created successfully",
+	}, nil
+}
+
+// ListShareLinks implementa la lista de enlaces de un archivo
+func (s *NotebookServer) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	if s.shareLinkUseCases == nil {
+		return &pb.ListShareLinksResponse{
+			Success: false,
+			Message: "Share links are not enabled",
+		}, status.Error(codes.Unavailable, "share links not enabled")
+	}
+
+	fileID, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return &pb.ListShareLinksResponse{
+			Success: false,
+			Message: "Invalid file ID format",
+		}, status.Error(codes.InvalidArgument, "invalid file ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.ListShareLinksResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	links, err := s.shareLinkUseCases.ListShareLinks(ctx, fileID, userID)
+	if err != nil {
+		if err == entities.ErrFileNotFound {
+			return &pb.ListShareLinksResponse{
+				Success: false,
+				Message: "File not found",
+			}, domainError(codes.NotFound, "file not found", err)
+		}
+		if err == entities.ErrFileUnauthorized {
+			return &pb.ListShareLinksResponse{
+				Success: false,
+				Message: "Unauthorized access to file",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.ListShareLinksResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list share links: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	protoLinks := make([]*pb.ShareLink, len(links))
+	for i, link := range links {
+		protoLinks[i] = convert.ShareLinkToProto(link)
+	}
+
+	return &pb.ListShareLinksResponse{
+		ShareLinks: protoLinks,
+		Success:    true,
+		Message:    "Share links retrieved successfully",
+	}, nil
+}
+
+// RevokeShareLink implementa la revocación de enlaces
+func (s *NotebookServer) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkResponse, error) {
+	if s.shareLinkUseCases == nil {
+		return &pb.RevokeShareLinkResponse{
+			Success: false,
+			Message: "Share links are not enabled",
+		}, status.Error(codes.Unavailable, "share links not enabled")
+	}
+
+	linkID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.RevokeShareLinkResponse{
+			Success: false,
+			Message: "Invalid share link ID format",
+		}, status.Error(codes.InvalidArgument, "invalid share link ID")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.RevokeShareLinkResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	err = s.shareLinkUseCases.RevokeShareLink(ctx, linkID, userID)
+	if err != nil {
+		if err == entities.ErrShareLinkNotFound {
+			return &pb.RevokeShareLinkResponse{
+				Success: false,
+				Message: "Share link not found",
+			}, domainError(codes.NotFound, "share link not found", err)
+		}
+		if err == entities.ErrShareLinkUnauthorized {
+			return &pb.RevokeShareLinkResponse{
+				Success: false,
+				Message: "Unauthorized access to share link",
+			}, domainError(codes.PermissionDenied, "unauthorized", err)
+		}
+		return &pb.RevokeShareLinkResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to revoke share link: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevokeShareLinkResponse{
+		Success: true,
+		Message: "Share link revoked successfully",
+	}, nil
+}
+
+// shareURL devuelve la URL pública de descarga, o vacío si no se configuró la dirección base
+func (s *NotebookServer) shareURL(token string) string {
+	if s.shareBaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.shareBaseURL, "/") + "/" + token
+}
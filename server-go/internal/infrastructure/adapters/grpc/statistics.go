@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStatistics implementa la consulta de las estadísticas del panel de un usuario
+func (s *NotebookServer) GetStatistics(ctx context.Context, req *pb.GetStatisticsRequest) (*pb.GetStatisticsResponse, error) {
+	if s.statistics == nil {
+		return &pb.GetStatisticsResponse{
+			Success: false,
+			Message: "Statistics are not enabled",
+		}, status.Error(codes.Unavailable, "statistics not enabled")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetStatisticsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	stats, err := s.statistics.GetStatistics(ctx, userID)
+	if err != nil {
+		return &pb.GetStatisticsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get statistics: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetStatisticsResponse{
+		Statistics: convert.StatisticsToProto(stats),
+		Success:    true,
+		Message:    "Statistics retrieved successfully",
+	}, nil
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+)
+
+// suggestionsFor devuelve las etiquetas y la categoría sugeridas para idea, o nil si la
+// clasificación no está habilitada o falla: las sugerencias nunca hacen fallar la petición
+func (s *NotebookServer) suggestionsFor(ctx context.Context, idea *entities.Idea) *pb.IdeaSuggestions {
+	if s.classification == nil {
+		return nil
+	}
+
+	suggestion, err := s.classification.SuggestForIdea(ctx, idea)
+	if err != nil || suggestion.IsEmpty() {
+		return nil
+	}
+
+	tags := make([]*pb.TagSuggestion, len(suggestion.Tags))
+	for i, tag := range suggestion.Tags {
+		tags[i] = &pb.TagSuggestion{Tag: tag.Tag, Confidence: tag.Confidence}
+	}
+	return &pb.IdeaSuggestions{
+		Tags:               tags,
+		Category:           pb.IdeaCategory(suggestion.Category),
+		CategoryConfidence: suggestion.CategoryConfidence,
+		Classifier:         suggestion.Classifier,
+	}
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"fmt"
+	"io"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IngestClientMetrics implementa la recepción de la telemetría de la app; cada mensaje del stream
+// se encola por separado y la respuesta suma los resultados de todos
+func (s *NotebookServer) IngestClientMetrics(stream pb.NotebookService_IngestClientMetricsServer) error {
+	if s.telemetry == nil {
+		return status.Error(codes.Unavailable, "client metrics not enabled")
+	}
+
+	var userID uuid.UUID
+	total := &usecases.ClientMetricReport{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.IngestClientMetricsResponse{
+				Accepted:   int32(total.Accepted),
+				SampledOut: int32(total.SampledOut),
+				Rejected:   int32(total.Rejected),
+				Success:    true,
+				Message:    "Client metrics received successfully",
+			})
+		}
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("Failed to receive client metrics: %v", err))
+		}
+
+		reqUserID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid user ID")
+		}
+		if userID == uuid.Nil {
+			userID = reqUserID
+		} else if reqUserID != userID {
+			return status.Error(codes.InvalidArgument, "all client metrics in a stream must belong to the same user")
+		}
+
+		metrics := make([]*entities.ClientMetric, len(req.Metrics))
+		for i, metric := range req.Metrics {
+			metrics[i] = convert.ClientMetricFromProto(metric)
+		}
+
+		report, err := s.telemetry.IngestClientMetrics(stream.Context(), userID, req.DeviceId, req.AppVersion, metrics)
+		if err != nil {
+			return telemetryErrorToStatus(err)
+		}
+		total.Accepted += report.Accepted
+		total.SampledOut += report.SampledOut
+		total.Rejected += report.Rejected
+	}
+}
+
+func telemetryErrorToStatus(err error) error {
+	switch err {
+	case entities.ErrClientMetricBatchTooLarge:
+		return domainError(codes.InvalidArgument, fmt.Sprintf("at most %d client metrics per message", usecases.MaxClientMetricBatchSize), err)
+	case entities.ErrInvalidClientMetric:
+		return domainError(codes.InvalidArgument, "invalid device ID or app version", err)
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("Failed to ingest client metrics: %v", err))
+}
@@ -0,0 +1,217 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc/convert"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultMaxUploadSize es el tamaño máximo de archivo aceptado si no se configura otro
+	DefaultMaxUploadSize = 512 << 20
+	// uploadProgressInterval es cada cuántos bytes recibidos se informa del progreso
+	uploadProgressInterval = 1 << 20
+)
+
+// errUploadConsumerStopped indica que el caso de uso dejó de leer antes de recibir todos los fragmentos
+var errUploadConsumerStopped = errors.New("upload consumer stopped")
+
+// UploadFileWithProgress implementa la subida de archivos informando del progreso
+func (s *NotebookServer) UploadFileWithProgress(stream pb.NotebookService_UploadFileWithProgressServer) error {
+	fileInfo, err := s.receiveUpload(stream.Context(), stream.Recv, func(received, total int64) error {
+		return stream.Send(&pb.UploadFileProgress{
+			Data: &pb.UploadFileProgress_Progress{
+				Progress: &pb.UploadProgress{BytesReceived: received, TotalSize: total},
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.UploadFileProgress{
+		Data: &pb.UploadFileProgress_Result{
+			Result: s.uploadResponse(fileInfo),
+		},
+	})
+}
+
+// receiveUpload lee el stream de subida y entrega los fragmentos al caso de uso a medida
+// que llegan a través de un io.Pipe, de modo que el archivo nunca se acumula en memoria
+func (s *NotebookServer) receiveUpload(ctx context.Context, recv func() (*pb.UploadFileRequest, error), progress func(received, total int64) error) (*entities.FileInfo, error) {
+	first, err := recv()
+	if err == io.EOF {
+		return nil, status.Error(codes.InvalidArgument, "File metadata is required")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to receive chunk: %v", err))
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "File metadata must be sent before the first chunk")
+	}
+
+	userID, err := uuid.Parse(metadata.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid user ID format")
+	}
+
+	if metadata.Checksum != "" && metadata.ChecksumAlgorithm != "" && metadata.ChecksumAlgorithm != entities.ChecksumAlgorithmSHA256 {
+		return nil, status.Error(codes.InvalidArgument, entities.ErrUnsupportedChecksumAlgorithm.Error())
+	}
+
+	if metadata.TotalSize > s.maxUploadSize {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("file exceeds the maximum upload size of %d bytes", s.maxUploadSize))
+	}
+
+	type uploadResult struct {
+		fileInfo *entities.FileInfo
+		err      error
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	done := make(chan uploadResult, 1)
+	supervisor.Go("grpc.upload", func() {
+		fileInfo, err := s.fileUseCases.UploadFile(
+			ctx,
+			metadata.Filename,
+			metadata.ContentType,
+			pipeReader,
+			userID,
+			metadata.Compress,
+			metadata.CompressionType,
+			metadata.Checksum,
+		)
+		// Si el caso de uso termina sin leer todo, las escrituras pendientes fallan en lugar de bloquearse
+		pipeReader.CloseWithError(errUploadConsumerStopped)
+		done <- uploadResult{fileInfo: fileInfo, err: err}
+	})
+
+	if err := s.pipeUploadChunks(recv, pipeWriter, metadata.TotalSize, progress); err != nil {
+		pipeWriter.CloseWithError(err)
+		result := <-done
+		if errors.Is(err, errUploadConsumerStopped) && result.err != nil {
+			return nil, uploadErrorToStatus(result.err)
+		}
+		return nil, err
+	}
+	pipeWriter.Close()
+
+	result := <-done
+	if result.err != nil {
+		return nil, uploadErrorToStatus(result.err)
+	}
+
+	return result.fileInfo, nil
+}
+
+// pipeUploadChunks copia los fragmentos recibidos en w hasta el final del stream
+func (s *NotebookServer) pipeUploadChunks(recv func() (*pb.UploadFileRequest, error), w io.Writer, total int64, progress func(received, total int64) error) error {
+	var received, reported int64
+	for {
+		req, err := recv()
+		if err == io.EOF {
+			if progress != nil && received > reported {
+				return progress(received, total)
+			}
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("Failed to receive chunk: %v", err))
+		}
+
+		chunk, ok := req.Data.(*pb.UploadFileRequest_Chunk)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "File metadata can only be sent once")
+		}
+
+		received += int64(len(chunk.Chunk))
+		if received > s.maxUploadSize {
+			return status.Error(codes.ResourceExhausted, fmt.Sprintf("file exceeds the maximum upload size of %d bytes", s.maxUploadSize))
+		}
+
+		if _, err := w.Write(chunk.Chunk); err != nil {
+			return err
+		}
+
+		if progress != nil && received-reported >= uploadProgressInterval {
+			reported = received
+			if err := progress(received, total); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CheckFileExists registra el archivo sin recibir su contenido si el usuario ya lo tiene almacenado
+func (s *NotebookServer) CheckFileExists(ctx context.Context, req *pb.CheckFileExistsRequest) (*pb.CheckFileExistsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.CheckFileExistsResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		}, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.ChecksumAlgorithm != "" && req.ChecksumAlgorithm != entities.ChecksumAlgorithmSHA256 {
+		return &pb.CheckFileExistsResponse{
+			Success: false,
+			Message: "Unsupported checksum algorithm",
+		}, status.Error(codes.InvalidArgument, entities.ErrUnsupportedChecksumAlgorithm.Error())
+	}
+
+	fileInfo, err := s.fileUseCases.CheckFileExists(ctx, req.Filename, req.ContentType, req.Checksum, req.Size, userID)
+	if err != nil {
+		if err == entities.ErrFileNameRequired {
+			return &pb.CheckFileExistsResponse{
+				Success: false,
+				Message: "Filename is required",
+			}, domainError(codes.InvalidArgument, err.Error(), err)
+		}
+		return &pb.CheckFileExistsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to check file: %v", err),
+		}, status.Error(codes.Internal, err.Error())
+	}
+
+	if fileInfo == nil {
+		return &pb.CheckFileExistsResponse{
+			Exists:  false,
+			Success: true,
+			Message: "File content not found, upload required",
+		}, nil
+	}
+
+	return &pb.CheckFileExistsResponse{
+		Exists:   true,
+		FileInfo: convert.FileInfoToProto(fileInfo),
+		Success:  true,
+		Message:  "File already stored, upload skipped",
+	}, nil
+}
+
+func (s *NotebookServer) uploadResponse(fileInfo *entities.FileInfo) *pb.UploadFileResponse {
+	return &pb.UploadFileResponse{
+		FileInfo: convert.FileInfoToProto(fileInfo),
+		Success:  true,
+		Message:  "File uploaded successfully",
+		UploadId: fileInfo.ID.String(),
+	}
+}
+
+func uploadErrorToStatus(err error) error {
+	if err == entities.ErrFileChecksumMismatch {
+		return status.Error(codes.DataLoss, "file checksum mismatch, upload discarded")
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("Failed to upload file: %v", err))
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// advisoryLockCheckInterval es cada cuánto se verifica que la sesión que retiene el lock siga viva
+const advisoryLockCheckInterval = 5 * time.Second
+
+type advisoryLocker struct {
+	db *pgxpool.Pool
+}
+
+var _ ports.DistributedLocker = (*advisoryLocker)(nil)
+
+// NewAdvisoryLocker crea un lock distribuido basado en advisory locks de sesión de PostgreSQL
+func NewAdvisoryLocker(db *pgxpool.Pool) ports.DistributedLocker {
+	return &advisoryLocker{db: db}
+}
+
+// TryLock intenta adquirir el advisory lock asociado al nombre
+func (l *advisoryLocker) TryLock(ctx context.Context, name string) (ports.LockLease, error) {
+	// Los advisory locks de sesión pertenecen a la conexión, así que se retiene fuera del pool
+	conn, err := l.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection for lock %s: %w", name, err)
+	}
+
+	key := advisoryLockKey(name)
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("failed to try lock %s: %w", name, err)
+	}
+	if !acquired {
+		conn.Release()
+		return nil, entities.ErrLockNotAcquired
+	}
+
+	lease := &advisoryLease{
+		conn: conn,
+		key:  key,
+		lost: make(chan struct{}),
+		stop: make(chan struct{}),
+	}
+	supervisor.Go("postgres.advisory_lock_monitor", lease.monitor)
+
+	return lease, nil
+}
+
+type advisoryLease struct {
+	mu       sync.Mutex
+	conn     *pgxpool.Conn
+	key      int64
+	lost     chan struct{}
+	stop     chan struct{}
+	released bool
+}
+
+// Lost se cierra cuando la conexión que retiene el lock deja de responder
+func (l *advisoryLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release libera el lock y devuelve la conexión al pool
+func (l *advisoryLease) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.released {
+		return nil
+	}
+	l.released = true
+	close(l.stop)
+
+	_, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key)
+	if err != nil {
+		// Cerrar la sesión libera el lock igualmente
+		l.conn.Conn().Close(ctx)
+	}
+	l.conn.Release()
+
+	if err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
+
+func (l *advisoryLease) monitor() {
+	ticker := time.NewTicker(advisoryLockCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			if !l.alive() {
+				close(l.lost)
+				return
+			}
+		}
+	}
+}
+
+func (l *advisoryLease) alive() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.released {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), advisoryLockCheckInterval)
+	defer cancel()
+	return l.conn.Ping(ctx) == nil
+}
+
+// advisoryLockKey convierte el nombre del lock en la clave bigint que usa PostgreSQL
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// changeFeedChannel es el canal de NOTIFY usado por el trigger notify_entity_change
+const changeFeedChannel = "entity_changes"
+
+const (
+	// changeFeedReconnectDelay es la pausa tras el primer fallo; se duplica en cada fallo seguido
+	changeFeedReconnectDelay = 2 * time.Second
+	// changeFeedMaxReconnectDelay acota la pausa mientras la base de datos siga caída
+	changeFeedMaxReconnectDelay = time.Minute
+)
+
+// ChangeFeedStats resume el estado de la conexión LISTEN
+type ChangeFeedStats struct {
+	Listening bool
+	// Errors cuenta las veces que la conexión LISTEN terminó con un error
+	Errors int64
+	// Reconnects cuenta las veces que se volvió a escuchar tras un error
+	Reconnects        int64
+	MalformedPayloads int64
+	LastError         string
+	LastErrorAt       time.Time
+}
+
+// ChangeFeed distribuye las notificaciones LISTEN/NOTIFY de PostgreSQL a los suscriptores
+type ChangeFeed struct {
+	db     *pgxpool.Pool
+	logger *logging.StructuredLogger
+	// reconnectDelay y maxReconnectDelay acotan el backoff exponencial entre reintentos
+	reconnectDelay    time.Duration
+	maxReconnectDelay time.Duration
+	mu                sync.Mutex
+	subscribers       map[chan ports.EntityChange]struct{}
+	stats             ChangeFeedStats
+}
+
+// changePayload es el JSON emitido por el trigger notify_entity_change
+type changePayload struct {
+	Table     string `json:"table"`
+	Operation string `json:"operation"`
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Version   int64  `json:"version"`
+}
+
+var _ ports.ChangeFeed = (*ChangeFeed)(nil)
+
+// NewChangeFeed crea un nuevo flujo de cambios; logger puede ser nil
+func NewChangeFeed(db *pgxpool.Pool, logger *logging.StructuredLogger) *ChangeFeed {
+	return &ChangeFeed{
+		db:                db,
+		logger:            logger,
+		reconnectDelay:    changeFeedReconnectDelay,
+		maxReconnectDelay: changeFeedMaxReconnectDelay,
+		subscribers:       make(map[chan ports.EntityChange]struct{}),
+	}
+}
+
+// Start escucha notificaciones hasta que se cancele el contexto, reconectando ante errores
+// con un backoff exponencial que vuelve al mínimo cuando la escucha se restablece
+func (f *ChangeFeed) Start(ctx context.Context) {
+	delay := f.reconnectDelay
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			f.mu.Lock()
+			f.stats.Reconnects++
+			f.mu.Unlock()
+		}
+
+		// listen solo retorna ante un error de conexión o al cancelar el contexto
+		err := f.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		f.mu.Lock()
+		if f.stats.Listening {
+			delay = f.reconnectDelay
+		}
+		f.stats.Listening = false
+		f.stats.Errors++
+		f.stats.LastError = err.Error()
+		f.stats.LastErrorAt = time.Now()
+		f.mu.Unlock()
+
+		if f.logger != nil {
+			f.logger.Error("change feed listen failed", err, map[string]interface{}{
+				"channel":     changeFeedChannel,
+				"retry_in_ms": delay.Milliseconds(),
+			})
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay = nextReconnectDelay(delay, f.maxReconnectDelay)
+	}
+}
+
+// Stats devuelve el estado de la conexión LISTEN y sus contadores de errores
+func (f *ChangeFeed) Stats() ChangeFeedStats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.stats
+}
+
+// Metrics es un collector de metrics.MetricsCollector para la conexión LISTEN
+func (f *ChangeFeed) Metrics() []metrics.Metric {
+	stats := f.Stats()
+
+	listening := 0.0
+	if stats.Listening {
+		listening = 1
+	}
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "change_feed_listening", Type: metrics.Gauge, Value: listening, Timestamp: now},
+		{Name: "change_feed_errors_total", Type: metrics.Counter, Value: float64(stats.Errors), Timestamp: now},
+		{Name: "change_feed_reconnects_total", Type: metrics.Counter, Value: float64(stats.Reconnects), Timestamp: now},
+		{Name: "change_feed_malformed_payloads_total", Type: metrics.Counter, Value: float64(stats.MalformedPayloads), Timestamp: now},
+	}
+}
+
+// Subscribe registra un suscriptor; el canal se cierra al cancelar el contexto
+func (f *ChangeFeed) Subscribe(ctx context.Context) (<-chan ports.EntityChange, error) {
+	ch := make(chan ports.EntityChange, 64)
+
+	f.mu.Lock()
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	supervisor.Go("postgres.change_feed_subscriber", func() {
+		<-ctx.Done()
+		f.mu.Lock()
+		delete(f.subscribers, ch)
+		close(ch)
+		f.mu.Unlock()
+	})
+
+	return ch, nil
+}
+
+func (f *ChangeFeed) listen(ctx context.Context) error {
+	conn, err := f.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire listen connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+changeFeedChannel); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", changeFeedChannel, err)
+	}
+
+	f.mu.Lock()
+	f.stats.Listening = true
+	f.mu.Unlock()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+
+		change, err := parseChangePayload(notification.Payload)
+		if err != nil {
+			// Un payload malformado se descarta sin cortar la escucha
+			f.mu.Lock()
+			f.stats.MalformedPayloads++
+			f.mu.Unlock()
+			if f.logger != nil {
+				f.logger.Warn("malformed change feed payload", map[string]interface{}{
+					"channel": changeFeedChannel,
+					"error":   err.Error(),
+				})
+			}
+			continue
+		}
+		f.broadcast(change)
+	}
+}
+
+func (f *ChangeFeed) broadcast(change ports.EntityChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- change:
+		default:
+			// Suscriptor lento: se descarta el cambio en lugar de bloquear el listener
+		}
+	}
+}
+
+// nextReconnectDelay duplica la pausa entre reintentos sin superar limit
+func nextReconnectDelay(delay, limit time.Duration) time.Duration {
+	delay *= 2
+	if delay > limit {
+		return limit
+	}
+	return delay
+}
+
+func parseChangePayload(raw string) (ports.EntityChange, error) {
+	var payload changePayload
+	if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+		return ports.EntityChange{}, err
+	}
+
+	entityID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return ports.EntityChange{}, err
+	}
+	userID, _ := uuid.Parse(payload.UserID)
+
+	return ports.EntityChange{
+		Table:     payload.Table,
+		Operation: payload.Operation,
+		EntityID:  entityID,
+		UserID:    userID,
+		Version:   payload.Version,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangePayload(t *testing.T) {
+	entityID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    ports.EntityChange
+		wantErr bool
+	}{
+		{
+			name: "complete",
+			raw:  `{"table":"ideas","operation":"UPDATE","id":"` + entityID.String() + `","user_id":"` + userID.String() + `","version":3}`,
+			want: ports.EntityChange{Table: "ideas", Operation: "UPDATE", EntityID: entityID, UserID: userID, Version: 3},
+		},
+		{
+			// Las tablas sin propietario emiten un user_id vacío
+			name: "without user",
+			raw:  `{"table":"categories","operation":"DELETE","id":"` + entityID.String() + `"}`,
+			want: ports.EntityChange{Table: "categories", Operation: "DELETE", EntityID: entityID},
+		},
+		{name: "invalid json", raw: `{"table":`, wantErr: true},
+		{name: "invalid id", raw: `{"table":"ideas","operation":"INSERT","id":"no-es-un-uuid"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := parseChangePayload(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, change)
+		})
+	}
+}
+
+func TestChangeFeed_BroadcastFansOutToSubscribers(t *testing.T) {
+	feed := NewChangeFeed(nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+	second, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+
+	change := ports.EntityChange{Table: "ideas", Operation: "INSERT", EntityID: uuid.New(), Version: 1}
+	feed.broadcast(change)
+
+	assert.Equal(t, change, <-first)
+	assert.Equal(t, change, <-second)
+}
+
+func TestChangeFeed_SlowSubscriberDoesNotBlock(t *testing.T) {
+	feed := NewChangeFeed(nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+
+	// Con el buffer lleno los cambios sobrantes se descartan en lugar de bloquear el listener
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < cap(changes)+10; i++ {
+			feed.broadcast(ports.EntityChange{Version: int64(i)})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("broadcast blocked on a full subscriber")
+	}
+	assert.Len(t, changes, cap(changes))
+}
+
+func TestChangeFeed_CancelledSubscriberIsRemoved(t *testing.T) {
+	feed := NewChangeFeed(nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	changes, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+	cancel()
+
+	// El canal se cierra al cancelar y deja de recibir cambios
+	select {
+	case _, ok := <-changes:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscriber channel was not closed")
+	}
+	feed.broadcast(ports.EntityChange{Table: "ideas"})
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+	assert.Empty(t, feed.subscribers)
+}
+
+func TestNextReconnectDelay(t *testing.T) {
+	delay := changeFeedReconnectDelay
+	var delays []time.Duration
+	for i := 0; i < 7; i++ {
+		delays = append(delays, delay)
+		delay = nextReconnectDelay(delay, changeFeedMaxReconnectDelay)
+	}
+
+	assert.Equal(t, []time.Duration{
+		2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second, time.Minute, time.Minute,
+	}, delays)
+}
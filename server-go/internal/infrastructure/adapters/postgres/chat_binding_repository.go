@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const chatBindingColumns = `id, user_id, provider, chat_id, code_hash, code_expires_at, created_at, linked_at`
+
+type chatBindingRepository struct {
+	db querier
+}
+
+var _ ports.ChatBindingRepository = (*chatBindingRepository)(nil)
+
+// NewChatBindingRepository crea un nuevo repositorio de vínculos con chats
+func NewChatBindingRepository(db *pgxpool.Pool) ports.ChatBindingRepository {
+	return &chatBindingRepository{db: db}
+}
+
+// Create registra un vínculo pendiente
+func (r *chatBindingRepository) Create(ctx context.Context, binding *entities.ChatBinding) error {
+	query := `
+		INSERT INTO chat_bindings (` + chatBindingColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		binding.ID,
+		binding.UserID,
+		string(binding.Provider),
+		nullIfEmpty(binding.ChatID),
+		nullIfEmpty(binding.CodeHash),
+		binding.CodeExpiresAt,
+		binding.CreatedAt,
+		binding.LinkedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create chat binding: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un vínculo por su ID
+func (r *chatBindingRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ChatBinding, error) {
+	return r.getOne(ctx, `SELECT `+chatBindingColumns+` FROM chat_bindings WHERE id = $1`, id)
+}
+
+// GetPendingByCodeHash obtiene el vínculo pendiente con el código indicado
+func (r *chatBindingRepository) GetPendingByCodeHash(ctx context.Context, provider entities.ChatProvider, codeHash string) (*entities.ChatBinding, error) {
+	return r.getOne(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE provider = $1 AND code_hash = $2 AND linked_at IS NULL`,
+		string(provider), codeHash,
+	)
+}
+
+// GetByChat obtiene el vínculo confirmado de un chat
+func (r *chatBindingRepository) GetByChat(ctx context.Context, provider entities.ChatProvider, chatID string) (*entities.ChatBinding, error) {
+	return r.getOne(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE provider = $1 AND chat_id = $2`,
+		string(provider), chatID,
+	)
+}
+
+// ListByUserID obtiene los vínculos de un usuario, del más reciente al más antiguo
+func (r *chatBindingRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.ChatBinding, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE user_id = $1 ORDER BY created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chat bindings: %w", err)
+	}
+	defer rows.Close()
+
+	var bindings []*entities.ChatBinding
+	for rows.Next() {
+		binding, err := scanChatBinding(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan chat binding: %w", err)
+		}
+		bindings = append(bindings, binding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate chat bindings: %w", err)
+	}
+
+	return bindings, nil
+}
+
+// Link guarda la confirmación de un vínculo pendiente
+func (r *chatBindingRepository) Link(ctx context.Context, binding *entities.ChatBinding) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE chat_bindings SET chat_id = $2, code_hash = NULL, linked_at = $3 WHERE id = $1 AND linked_at IS NULL`,
+		binding.ID, binding.ChatID, binding.LinkedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return entities.ErrChatAlreadyBound
+		}
+		return fmt.Errorf("failed to link chat binding: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrChatBindingNotFound
+	}
+
+	return nil
+}
+
+// Delete elimina un vínculo
+func (r *chatBindingRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM chat_bindings WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete chat binding: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrChatBindingNotFound
+	}
+
+	return nil
+}
+
+func (r *chatBindingRepository) getOne(ctx context.Context, query string, args ...any) (*entities.ChatBinding, error) {
+	binding, err := scanChatBinding(r.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrChatBindingNotFound
+		}
+		return nil, fmt.Errorf("failed to get chat binding: %w", err)
+	}
+
+	return binding, nil
+}
+
+func scanChatBinding(row pgx.Row) (*entities.ChatBinding, error) {
+	var binding entities.ChatBinding
+	var provider string
+	var chatID, codeHash *string
+	err := row.Scan(
+		&binding.ID,
+		&binding.UserID,
+		&provider,
+		&chatID,
+		&codeHash,
+		&binding.CodeExpiresAt,
+		&binding.CreatedAt,
+		&binding.LinkedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.Provider = entities.ChatProvider(provider)
+	if chatID != nil {
+		binding.ChatID = *chatID
+	}
+	if codeHash != nil {
+		binding.CodeHash = *codeHash
+	}
+	return &binding, nil
+}
+
+// nullIfEmpty guarda las cadenas vacías como NULL para que no choquen en los índices únicos parciales
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
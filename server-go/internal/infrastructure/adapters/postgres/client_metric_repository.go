@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// clientMetricColumns es el número de columnas que se insertan por evento
+const clientMetricColumns = 11
+
+type clientMetricRepository struct {
+	db querier
+}
+
+var _ ports.ClientMetricRepository = (*clientMetricRepository)(nil)
+
+// NewClientMetricRepository crea un nuevo repositorio de telemetría de la app
+func NewClientMetricRepository(db *pgxpool.Pool) ports.ClientMetricRepository {
+	return &clientMetricRepository{db: db}
+}
+
+// CreateBatch guarda los eventos con un único INSERT; los IDs repetidos se ignoran
+func (r *clientMetricRepository) CreateBatch(ctx context.Context, metrics []*entities.ClientMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	rows := make([]string, len(metrics))
+	args := make([]interface{}, 0, len(metrics)*clientMetricColumns)
+	for i, metric := range metrics {
+		placeholders := make([]string, clientMetricColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", i*clientMetricColumns+j+1)
+		}
+		rows[i] = "(" + strings.Join(placeholders, ", ") + ")"
+
+		attributes := metric.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		args = append(args,
+			metric.ID,
+			metric.UserID,
+			metric.DeviceID,
+			metric.AppVersion,
+			string(metric.Kind),
+			metric.Name,
+			metric.Value,
+			metric.Message,
+			attributes,
+			metric.OccurredAt,
+			metric.ReceivedAt,
+		)
+	}
+
+	query := `
+		INSERT INTO client_metrics (id, user_id, device_id, app_version, kind, name, value, message, attributes, occurred_at, received_at)
+		VALUES ` + strings.Join(rows, ", ") + `
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create client metrics: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteOlderThan depura los eventos recibidos antes de cutoff
+func (r *clientMetricRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM client_metrics WHERE received_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old client metrics: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
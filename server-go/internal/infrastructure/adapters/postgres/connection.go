@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -16,6 +17,7 @@ type Config struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	Tracer   pgx.QueryTracer
 }
 
 // NewConnection crea una nueva conexión a la base de datos PostgreSQL
@@ -33,6 +35,9 @@ func NewConnection(config Config) (*pgxpool.Pool, error) {
 	poolConfig.MinConns = 5
 	poolConfig.MaxConnLifetime = time.Hour
 	poolConfig.MaxConnIdleTime = time.Minute * 30
+	if config.Tracer != nil {
+		poolConfig.ConnConfig.Tracer = config.Tracer
+	}
 
 	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
@@ -50,31 +55,3 @@ func NewConnection(config Config) (*pgxpool.Pool, error) {
 
 	return pool, nil
 }
-
-// NewReminderRepository crea un nuevo repositorio de recordatorios
-func NewReminderRepository(db *pgxpool.Pool) *reminderRepository {
-	return &reminderRepository{db: db}
-}
-
-// NewFileRepository crea un nuevo repositorio de archivos
-func NewFileRepository(db *pgxpool.Pool) *fileRepository {
-	return &fileRepository{db: db}
-}
-
-// NewProgressRepository crea un nuevo repositorio de progreso
-func NewProgressRepository(db *pgxpool.Pool) *progressRepository {
-	return &progressRepository{db: db}
-}
-
-// Estructuras placeholder para los repositorios
-type reminderRepository struct {
-	db *pgxpool.Pool
-}
-
-type fileRepository struct {
-	db *pgxpool.Pool
-}
-
-type progressRepository struct {
-	db *pgxpool.Pool
-}
\ No newline at end of file
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const customFieldColumns = `id, user_id, entity_type, key, name, field_type, options, created_at`
+
+// customFieldTypeNames son los nombres con los que se guarda el tipo de cada valor en la columna
+// custom_fields, para poder filtrar sin consultar las definiciones
+var customFieldTypeNames = map[entities.CustomFieldType]string{
+	entities.CustomFieldTypeText:   "text",
+	entities.CustomFieldTypeNumber: "number",
+	entities.CustomFieldTypeDate:   "date",
+	entities.CustomFieldTypeEnum:   "enum",
+}
+
+var customFieldOperators = map[entities.CustomFieldOperator]string{
+	entities.CustomFieldOperatorLess:           "<",
+	entities.CustomFieldOperatorLessOrEqual:    "<=",
+	entities.CustomFieldOperatorGreater:        ">",
+	entities.CustomFieldOperatorGreaterOrEqual: ">=",
+}
+
+// customFieldRecord es la representación JSON de un valor dentro de la columna custom_fields; las
+// fechas se guardan como AAAA-MM-DD para que se comparen como texto
+type customFieldRecord struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type customFieldRepository struct {
+	db querier
+}
+
+var _ ports.CustomFieldRepository = (*customFieldRepository)(nil)
+
+// NewCustomFieldRepository crea un nuevo repositorio de definiciones de campos personalizados
+func NewCustomFieldRepository(db *pgxpool.Pool) ports.CustomFieldRepository {
+	return &customFieldRepository{db: db}
+}
+
+// Create guarda la definición de un campo personalizado
+func (r *customFieldRepository) Create(ctx context.Context, definition *entities.CustomFieldDefinition) error {
+	options := definition.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO custom_fields (`+customFieldColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		definition.ID,
+		definition.UserID,
+		int(definition.EntityType),
+		definition.Key,
+		definition.Name,
+		int(definition.Type),
+		options,
+		definition.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return entities.ErrCustomFieldKeyExists
+		}
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene la definición de un campo personalizado
+func (r *customFieldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CustomFieldDefinition, error) {
+	definition, err := scanCustomField(r.db.QueryRow(ctx,
+		`SELECT `+customFieldColumns+` FROM custom_fields WHERE id = $1`, id,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrCustomFieldNotFound
+		}
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+
+	return definition, nil
+}
+
+// ListByUserID obtiene los campos que un usuario definió para un tipo de registro
+func (r *customFieldRepository) ListByUserID(ctx context.Context, userID uuid.UUID, entityType entities.CustomFieldEntity) ([]*entities.CustomFieldDefinition, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+customFieldColumns+` FROM custom_fields WHERE user_id = $1 AND entity_type = $2 ORDER BY created_at`,
+		userID, int(entityType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom fields: %w", err)
+	}
+	defer rows.Close()
+
+	var definitions []*entities.CustomFieldDefinition
+	for rows.Next() {
+		definition, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan custom field: %w", err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating custom fields: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// Delete elimina la definición de un campo personalizado
+func (r *customFieldRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM custom_fields WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+func scanCustomField(row pgx.Row) (*entities.CustomFieldDefinition, error) {
+	var definition entities.CustomFieldDefinition
+	var entityType, fieldType int
+
+	err := row.Scan(
+		&definition.ID,
+		&definition.UserID,
+		&entityType,
+		&definition.Key,
+		&definition.Name,
+		&fieldType,
+		&definition.Options,
+		&definition.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	definition.EntityType = entities.CustomFieldEntity(entityType)
+	definition.Type = entities.CustomFieldType(fieldType)
+	if len(definition.Options) == 0 {
+		definition.Options = nil
+	}
+
+	return &definition, nil
+}
+
+func encodeCustomFields(fields entities.CustomFields) ([]byte, error) {
+	records := make(map[string]customFieldRecord, len(fields))
+	for key, value := range fields {
+		records[key] = customFieldRecord{Type: customFieldTypeNames[value.Type], Value: customFieldJSONValue(value)}
+	}
+	return json.Marshal(records)
+}
+
+func decodeCustomFields(data []byte) (entities.CustomFields, error) {
+	if len(data) == 0 {
+		return entities.CustomFields{}, nil
+	}
+
+	var records map[string]customFieldRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	fields := make(entities.CustomFields, len(records))
+	for key, record := range records {
+		var value entities.CustomFieldValue
+		for fieldType, name := range customFieldTypeNames {
+			if name == record.Type {
+				value.Type = fieldType
+			}
+		}
+		switch raw := record.Value.(type) {
+		case float64:
+			value.Number = raw
+		case string:
+			if value.Type != entities.CustomFieldTypeDate {
+				value.Text = raw
+				break
+			}
+			date, err := time.Parse(time.DateOnly, raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date in custom field %q: %w", key, err)
+			}
+			value.Date = date
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+// customFieldJSONValue es el valor tal como se guarda en JSON y se compara en los filtros
+func customFieldJSONValue(value entities.CustomFieldValue) any {
+	switch value.Type {
+	case entities.CustomFieldTypeNumber:
+		return value.Number
+	case entities.CustomFieldTypeDate:
+		return value.Date.Format(time.DateOnly)
+	default:
+		return value.Text
+	}
+}
+
+// customFieldConditions traduce los filtros a condiciones sobre la columna custom_fields a partir
+// del parámetro argIndex. La igualdad usa @>, que aprovecha el índice GIN de la columna. Los
+// filtros se vuelven a validar porque un operador desconocido dejaría la condición incompleta.
+func customFieldConditions(filters []entities.CustomFieldFilter, argIndex int) (string, []any, error) {
+	var conditions string
+	var args []any
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return "", nil, err
+		}
+		record := customFieldRecord{Type: customFieldTypeNames[filter.Value.Type], Value: customFieldJSONValue(filter.Value)}
+		if filter.Operator == entities.CustomFieldOperatorEqual {
+			contained, err := json.Marshal(map[string]customFieldRecord{filter.Key: record})
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to encode custom field filter: %w", err)
+			}
+			conditions += fmt.Sprintf(" AND custom_fields @> $%d::jsonb", argIndex)
+			args = append(args, string(contained))
+			argIndex++
+			continue
+		}
+
+		value := fmt.Sprintf("custom_fields->$%d->>'value'", argIndex)
+		if filter.Value.Type == entities.CustomFieldTypeNumber {
+			value = "(" + value + ")::double precision"
+		}
+		conditions += fmt.Sprintf(" AND custom_fields->$%d->>'type' = $%d AND %s %s $%d",
+			argIndex, argIndex+1, value, customFieldOperators[filter.Operator], argIndex+2)
+		args = append(args, filter.Key, record.Type, record.Value)
+		argIndex += 3
+	}
+	return conditions, args, nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type emailPreferenceRepository struct {
+	db querier
+}
+
+var _ ports.EmailPreferenceRepository = (*emailPreferenceRepository)(nil)
+
+// NewEmailPreferenceRepository crea un nuevo repositorio de direcciones de email
+func NewEmailPreferenceRepository(db *pgxpool.Pool) ports.EmailPreferenceRepository {
+	return &emailPreferenceRepository{db: db}
+}
+
+// Get obtiene la dirección de email de un usuario
+func (r *emailPreferenceRepository) Get(ctx context.Context, userID uuid.UUID) (*entities.EmailPreference, error) {
+	var preference entities.EmailPreference
+	err := r.db.QueryRow(ctx,
+		`SELECT user_id, address, weekly_summary, updated_at FROM user_emails WHERE user_id = $1`, userID,
+	).Scan(&preference.UserID, &preference.Address, &preference.WeeklySummary, &preference.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrEmailPreferenceNotFound
+		}
+		return nil, fmt.Errorf("failed to get email preference: %w", err)
+	}
+
+	return &preference, nil
+}
+
+// Upsert guarda la dirección de email de un usuario
+func (r *emailPreferenceRepository) Upsert(ctx context.Context, preference *entities.EmailPreference) error {
+	query := `
+		INSERT INTO user_emails (user_id, address, weekly_summary, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			address = EXCLUDED.address, weekly_summary = EXCLUDED.weekly_summary, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query, preference.UserID, preference.Address, preference.WeeklySummary, preference.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save email preference: %w", err)
+	}
+
+	return nil
+}
+
+// Delete elimina la dirección de email de un usuario
+func (r *emailPreferenceRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM user_emails WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete email preference: %w", err)
+	}
+
+	return nil
+}
+
+// ListWeeklySummaryRecipients lista por páginas los usuarios que aceptaron el resumen semanal
+func (r *emailPreferenceRepository) ListWeeklySummaryRecipients(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.EmailPreference, error) {
+	query := `
+		SELECT user_id, address, weekly_summary, updated_at
+		FROM user_emails
+		WHERE weekly_summary AND user_id > $1
+		ORDER BY user_id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weekly summary recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var preferences []*entities.EmailPreference
+	for rows.Next() {
+		var preference entities.EmailPreference
+		if err := rows.Scan(&preference.UserID, &preference.Address, &preference.WeeklySummary, &preference.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan email preference: %w", err)
+		}
+		preferences = append(preferences, &preference)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list weekly summary recipients: %w", err)
+	}
+
+	return preferences, nil
+}
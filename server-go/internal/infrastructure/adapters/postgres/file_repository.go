@@ -0,0 +1,391 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// fileSortColumns contiene las columnas por las que se permite ordenar archivos
+var fileSortColumns = map[string]string{
+	"created_at":   "created_at",
+	"filename":     "filename",
+	"size":         "size",
+	"content_type": "content_type",
+}
+
+type fileRepository struct {
+	db querier
+}
+
+var _ ports.FileRepository = (*fileRepository)(nil)
+
+// NewFileRepository crea un nuevo repositorio de archivos
+func NewFileRepository(db *pgxpool.Pool) ports.FileRepository {
+	return &fileRepository{db: db}
+}
+
+// Create registra la información de un archivo
+func (r *fileRepository) Create(ctx context.Context, fileInfo *entities.FileInfo) error {
+	query := `
+		INSERT INTO files (id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		fileInfo.ID,
+		fileInfo.Filename,
+		fileInfo.ContentType,
+		fileInfo.Size,
+		fileInfo.Checksum,
+		fileInfo.CreatedAt,
+		fileInfo.UserID,
+		fileInfo.Compressed,
+		fileInfo.CompressionType,
+		fileInfo.Path,
+		fileInfo.LogicalID,
+		fileInfo.Version,
+		fileInfo.ChecksumAlgorithm,
+		fileInfo.Preview,
+		fileInfo.StorageTier,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene la información de un archivo por su ID
+func (r *fileRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.FileInfo, error) {
+	query := `
+		SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier
+		FROM files
+		WHERE id = $1
+	`
+
+	fileInfo, err := scanFileInfo(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// GetByUserID obtiene los archivos de un usuario con filtros
+func (r *fileRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.FileFilters) ([]*entities.FileInfo, int, error) {
+	order, err := orderBy(filters.Sort, fileSortColumns, entities.SortField{Field: "created_at"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Solo la última versión de cada archivo
+	where := ` FROM files WHERE user_id = $1 AND version = (SELECT MAX(v.version) FROM files v WHERE v.logical_id = files.logical_id)`
+	args := []interface{}{userID}
+	if filters.ContentTypeFilter != "" {
+		where += ` AND content_type LIKE $2`
+		args = append(args, filters.ContentTypeFilter+"%")
+	}
+	if filters.SearchQuery != "" {
+		// El texto extraído se busca por palabras con el índice GIN; el nombre, por subcadena
+		where += fmt.Sprintf(` AND (filename ILIKE $%d OR id IN (SELECT file_id FROM file_texts WHERE search_vector @@ plainto_tsquery('simple', $%d)))`, len(args)+1, len(args)+2)
+		args = append(args, "%"+filters.SearchQuery+"%", filters.SearchQuery)
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count files: %w", err)
+	}
+
+	selectQuery := `SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier` +
+		where + order + limitClause(filters.Page, filters.PageSize, filters.Count)
+
+	rows, err := r.db.Query(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan file: %w", err)
+		}
+		files = append(files, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating files: %w", err)
+	}
+
+	files, totalCount = ports.PageTotal(files, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return files, totalCount, nil
+}
+
+// Delete elimina el registro de un archivo
+func (r *fileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM files WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrFileNotFound
+	}
+
+	return nil
+}
+
+// GetLatestVersion obtiene la última versión del archivo del usuario con ese nombre
+func (r *fileRepository) GetLatestVersion(ctx context.Context, userID uuid.UUID, filename string) (*entities.FileInfo, error) {
+	query := `
+		SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier
+		FROM files
+		WHERE user_id = $1 AND filename = $2
+		ORDER BY version DESC, created_at DESC
+		LIMIT 1
+	`
+
+	fileInfo, err := scanFileInfo(r.db.QueryRow(ctx, query, userID, filename))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest file version: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// FindByChecksum obtiene la versión más reciente del usuario con ese checksum SHA-256 y ese tamaño
+func (r *fileRepository) FindByChecksum(ctx context.Context, userID uuid.UUID, checksum string, size int64) (*entities.FileInfo, error) {
+	query := `
+		SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier
+		FROM files
+		WHERE user_id = $1 AND checksum = $2 AND size = $3 AND checksum_algorithm = $4
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	fileInfo, err := scanFileInfo(r.db.QueryRow(ctx, query, userID, checksum, size, entities.ChecksumAlgorithmSHA256))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to find file by checksum: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// ListVersions obtiene las versiones de un archivo, de la más reciente a la más antigua
+func (r *fileRepository) ListVersions(ctx context.Context, logicalID uuid.UUID) ([]*entities.FileInfo, error) {
+	query := `
+		SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier
+		FROM files
+		WHERE logical_id = $1
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, logicalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan file version: %w", err)
+		}
+		versions = append(versions, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating file versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// CountByPath cuenta las versiones que referencian un archivo físico
+func (r *fileRepository) CountByPath(ctx context.Context, path string) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM files WHERE path = $1`, path).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count file references: %w", err)
+	}
+	return count, nil
+}
+
+// GetStorageUsage calcula el almacenamiento de un usuario sumando todas las versiones
+func (r *fileRepository) GetStorageUsage(ctx context.Context, userID uuid.UUID) (*ports.StorageUsage, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(DISTINCT logical_id) FROM files WHERE user_id = $1),
+			(SELECT COUNT(*) FROM files WHERE user_id = $1),
+			(SELECT COALESCE(SUM(size), 0) FROM (SELECT DISTINCT path, size FROM files WHERE user_id = $1) AS stored)
+	`
+
+	var usage ports.StorageUsage
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&usage.Files, &usage.Versions, &usage.Bytes); err != nil {
+		return nil, fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	tiers, err := r.tierUsage(ctx, `WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage.Tiers = tiers
+
+	return &usage, nil
+}
+
+// GetTierUsage resume el almacenamiento de todos los usuarios por nivel
+func (r *fileRepository) GetTierUsage(ctx context.Context) ([]ports.TierUsage, error) {
+	return r.tierUsage(ctx, ``)
+}
+
+// tierUsage agrupa por nivel los archivos físicos de las versiones que cumplen where
+func (r *fileRepository) tierUsage(ctx context.Context, where string, args ...interface{}) ([]ports.TierUsage, error) {
+	query := `
+		SELECT storage_tier, COUNT(*), COALESCE(SUM(size), 0)
+		FROM (SELECT DISTINCT path, size, storage_tier FROM files ` + where + `) AS stored
+		GROUP BY storage_tier
+		ORDER BY storage_tier
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier usage: %w", err)
+	}
+	defer rows.Close()
+
+	var tiers []ports.TierUsage
+	for rows.Next() {
+		var usage ports.TierUsage
+		if err := rows.Scan(&usage.Tier, &usage.Blobs, &usage.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to scan tier usage: %w", err)
+		}
+		tiers = append(tiers, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tier usage: %w", err)
+	}
+
+	return tiers, nil
+}
+
+// ListTierCandidates devuelve los archivos físicos del nivel tier sin versiones ni cambios de nivel desde before
+func (r *fileRepository) ListTierCandidates(ctx context.Context, tier entities.StorageTier, before time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT path
+		FROM files
+		WHERE storage_tier = $1
+		GROUP BY path
+		HAVING MAX(created_at) < $2 AND (MAX(tier_changed_at) IS NULL OR MAX(tier_changed_at) < $2)
+		ORDER BY MAX(created_at)
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, query, tier, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tier candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, fmt.Errorf("failed to scan tier candidate: %w", err)
+		}
+		paths = append(paths, path)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tier candidates: %w", err)
+	}
+
+	return paths, nil
+}
+
+// MoveStorage cambia la ruta y el nivel de todas las versiones que referencian oldPath
+func (r *fileRepository) MoveStorage(ctx context.Context, oldPath, newPath string, tier entities.StorageTier, movedAt time.Time) (int, error) {
+	result, err := r.db.Exec(ctx,
+		`UPDATE files SET path = $2, storage_tier = $3, tier_changed_at = $4 WHERE path = $1`,
+		oldPath, newPath, tier, movedAt,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move file storage: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// ListAll recorre las versiones de todos los usuarios ordenadas por ID
+func (r *fileRepository) ListAll(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.FileInfo, error) {
+	query := `
+		SELECT id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier
+		FROM files
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan file: %w", err)
+		}
+		files = append(files, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating files: %w", err)
+	}
+
+	return files, nil
+}
+
+func scanFileInfo(row pgx.Row) (*entities.FileInfo, error) {
+	var fileInfo entities.FileInfo
+	err := row.Scan(
+		&fileInfo.ID,
+		&fileInfo.Filename,
+		&fileInfo.ContentType,
+		&fileInfo.Size,
+		&fileInfo.Checksum,
+		&fileInfo.CreatedAt,
+		&fileInfo.UserID,
+		&fileInfo.Compressed,
+		&fileInfo.CompressionType,
+		&fileInfo.Path,
+		&fileInfo.LogicalID,
+		&fileInfo.Version,
+		&fileInfo.ChecksumAlgorithm,
+		&fileInfo.Preview,
+		&fileInfo.StorageTier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo, nil
+}
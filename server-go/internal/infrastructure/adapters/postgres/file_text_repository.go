@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type fileTextRepository struct {
+	db querier
+}
+
+var _ ports.FileTextRepository = (*fileTextRepository)(nil)
+
+// NewFileTextRepository crea un nuevo repositorio de texto extraído de archivos
+func NewFileTextRepository(db *pgxpool.Pool) ports.FileTextRepository {
+	return &fileTextRepository{db: db}
+}
+
+// Upsert guarda el texto de un archivo; el vector de búsqueda lo calcula la base de datos
+func (r *fileTextRepository) Upsert(ctx context.Context, text *entities.FileText) error {
+	query := `
+		INSERT INTO file_texts (file_id, user_id, content, extractor, extracted_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (file_id) DO UPDATE
+		SET content = EXCLUDED.content, extractor = EXCLUDED.extractor, extracted_at = EXCLUDED.extracted_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		text.FileID,
+		text.UserID,
+		text.Content,
+		text.Extractor,
+		text.ExtractedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save file text: %w", err)
+	}
+
+	return nil
+}
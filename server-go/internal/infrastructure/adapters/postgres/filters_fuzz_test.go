@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// go test ./internal/infrastructure/adapters/postgres -run '^$' -fuzz FuzzIdeaConditions
+
+var placeholderPattern = regexp.MustCompile(`\$(\d+)`)
+
+// fuzzIdeaFilters arma los filtros de un listado de ideas a partir de los valores del fuzzer
+func fuzzIdeaFilters(category, status int32, tags, key string, operator, valueType int32, text string, number float64, date int64) ports.IdeaFilters {
+	filters := ports.IdeaFilters{
+		Category: entities.IdeaCategory(category),
+		Status:   entities.IdeaStatus(status),
+	}
+	if tags != "" {
+		filters.Tags = strings.Split(tags, ",")
+	}
+	if key != "" {
+		filters.CustomFields = []entities.CustomFieldFilter{{
+			Key:      key,
+			Operator: entities.CustomFieldOperator(operator),
+			Value: entities.CustomFieldValue{
+				Type:   entities.CustomFieldType(valueType),
+				Text:   text,
+				Number: number,
+				Date:   time.Unix(date, 0).UTC(),
+			},
+		}}
+	}
+	return filters
+}
+
+// FuzzIdeaConditions verifica que los filtros inválidos se rechacen con el error del dominio, que
+// los parámetros se numeren sin huecos a partir de $2 y que el texto del cliente nunca llegue al SQL
+func FuzzIdeaConditions(f *testing.F) {
+	f.Add(int32(1), int32(1), "producto,voz", "estimacion", int32(4), int32(2), "", 5.5, int64(0))
+	f.Add(int32(0), int32(0), "", "entrega", int32(2), int32(3), "", 0.0, int64(1714521600))
+	f.Add(int32(0), int32(0), "x", "equipo", int32(1), int32(4), "movil", 0.0, int64(0))
+	f.Add(int32(0), int32(0), "", "nota", int32(3), int32(1), "'; DROP TABLE ideas; --", 0.0, int64(0))
+	f.Add(int32(0), int32(0), "", "estimacion", int32(1), int32(2), "", 0.0, int64(0))
+	f.Add(int32(0), int32(0), "", "a' OR '1'='1", int32(1), int32(1), "", 0.0, int64(0))
+	f.Add(int32(0), int32(0), "", "estimacion", int32(99), int32(2), "", 1.0, int64(0))
+
+	userID := uuid.New()
+	f.Fuzz(func(t *testing.T, category, status int32, tags, key string, operator, valueType int32, text string, number float64, date int64) {
+		filters := fuzzIdeaFilters(category, status, tags, key, operator, valueType, text, number, date)
+		conditions, args, err := ideaConditions(userID, filters)
+
+		if len(filters.CustomFields) > 0 && filters.CustomFields[0].Validate() != nil {
+			if err != entities.ErrInvalidCustomFieldFilter {
+				t.Fatalf("filtro inválido %+v: se esperaba ErrInvalidCustomFieldFilter, se obtuvo %v", filters.CustomFields[0], err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("ideaConditions: %v", err)
+		}
+
+		used := make(map[int]bool)
+		for _, match := range placeholderPattern.FindAllStringSubmatch(conditions, -1) {
+			index, _ := strconv.Atoi(match[1])
+			used[index] = true
+		}
+		for index := 2; index <= len(args); index++ {
+			if !used[index] {
+				t.Fatalf("el parámetro $%d no aparece en %q", index, conditions)
+			}
+			delete(used, index)
+		}
+		if len(used) > 0 {
+			t.Fatalf("%q usa parámetros sin argumento: %v", conditions, used)
+		}
+
+		// Con otros valores de la misma forma el SQL no cambia, así que no interpola nada del cliente
+		sameShape := fuzzIdeaFilters(category, status, strings.Repeat("t,", len(filters.Tags)), "", 0, 0, "", 0, 0)
+		sameShape.Tags = sameShape.Tags[:len(filters.Tags)]
+		if len(filters.CustomFields) > 0 {
+			filter := filters.CustomFields[0]
+			filter.Key, filter.Value.Text = "campo", "valor"
+			sameShape.CustomFields = []entities.CustomFieldFilter{filter}
+		}
+		reference, _, err := ideaConditions(userID, sameShape)
+		if err != nil {
+			t.Fatalf("ideaConditions con valores de referencia: %v", err)
+		}
+		if reference != conditions {
+			t.Fatalf("el SQL depende de los valores del filtro:\n%q\n%q", conditions, reference)
+		}
+	})
+}
+
+// FuzzOrderBy verifica que solo se acepten columnas permitidas y que siempre se desempate por id
+func FuzzOrderBy(f *testing.F) {
+	f.Add("title", false, "created_at", true)
+	f.Add("priority", true, "", false)
+	f.Add("title; DROP TABLE ideas", false, "", false)
+	f.Add("", false, "", false)
+
+	f.Fuzz(func(t *testing.T, first string, firstDesc bool, second string, secondDesc bool) {
+		var sortFields []entities.SortField
+		for _, field := range []entities.SortField{{Field: first, Desc: firstDesc}, {Field: second, Desc: secondDesc}} {
+			if field.Field != "" {
+				sortFields = append(sortFields, field)
+			}
+		}
+
+		order, err := orderBy(sortFields, ideaSortColumns, entities.SortField{Field: "created_at"})
+		allowed := true
+		for _, field := range sortFields {
+			if _, ok := ideaSortColumns[field.Field]; !ok {
+				allowed = false
+			}
+		}
+		if !allowed {
+			if err != entities.ErrInvalidSortField {
+				t.Fatalf("campos %+v: se esperaba ErrInvalidSortField, se obtuvo %v", sortFields, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("orderBy: %v", err)
+		}
+		if !strings.HasPrefix(order, " ORDER BY ") || !strings.HasSuffix(order, ", id ASC") {
+			t.Fatalf("cláusula inesperada %q", order)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+type ideaArchiveRepository struct {
+	db querier
+}
+
+var _ ports.IdeaArchiveRepository = (*ideaArchiveRepository)(nil)
+
+// NewIdeaArchiveRepository crea un nuevo repositorio del archivo comprimido de ideas
+func NewIdeaArchiveRepository(db *pgxpool.Pool) ports.IdeaArchiveRepository {
+	return &ideaArchiveRepository{db: db}
+}
+
+// ListCompactionCandidates obtiene las ideas archivadas sin compactar modificadas antes de before,
+// las más antiguas primero
+func (r *ideaArchiveRepository) ListCompactionCandidates(ctx context.Context, before time.Time, limit int) ([]*entities.Idea, error) {
+	query := `
+		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at
+		FROM ideas
+		WHERE status = $1 AND compacted_at IS NULL AND updated_at < $2
+		ORDER BY updated_at, id
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, query, int(entities.IdeaStatusArchived), before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query compaction candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		var idea entities.Idea
+		var tags pq.StringArray
+		var relatedIdeas pq.StringArray
+		var customFields []byte
+		var category, status int
+
+		err := rows.Scan(
+			&idea.ID,
+			&idea.Title,
+			&idea.Content,
+			&tags,
+			&category,
+			&status,
+			&idea.CreatedAt,
+			&idea.UpdatedAt,
+			&idea.UserID,
+			&relatedIdeas,
+			&idea.Priority,
+			&idea.Position,
+			&customFields,
+			&idea.Version,
+			&idea.CompactedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+
+		idea.Tags = []string(tags)
+		idea.Category = entities.IdeaCategory(category)
+		idea.Status = entities.IdeaStatus(status)
+		if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+			return nil, fmt.Errorf("invalid custom_fields: %w", err)
+		}
+
+		idea.RelatedIdeas = make([]uuid.UUID, len(relatedIdeas))
+		for i, idStr := range relatedIdeas {
+			if relatedID, err := uuid.Parse(idStr); err == nil {
+				idea.RelatedIdeas[i] = relatedID
+			}
+		}
+
+		ideas = append(ideas, &idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating compaction candidates: %w", err)
+	}
+
+	return ideas, nil
+}
+
+// Compact guarda el contenido comprimido y recorta la fila en una sola sentencia; si la idea
+// cambió desde que se leyó no se guarda nada
+func (r *ideaArchiveRepository) Compact(ctx context.Context, idea *entities.Idea, compactedAt time.Time) error {
+	bundle, err := encodeIdeaBundle(idea.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea bundle: %w", err)
+	}
+
+	query := `
+		WITH compacted AS (
+			UPDATE ideas SET content = $2, compacted_at = $3
+			WHERE id = $1 AND version = $4 AND compacted_at IS NULL
+			RETURNING id
+		)
+		INSERT INTO idea_archives (idea_id, bundle, archived_at)
+		SELECT id, $5, $3 FROM compacted
+		ON CONFLICT (idea_id) DO UPDATE SET bundle = EXCLUDED.bundle, archived_at = EXCLUDED.archived_at
+	`
+
+	result, err := r.db.Exec(ctx, query, idea.ID, entities.IdeaExcerpt(idea.Content), compactedAt, idea.Version, bundle)
+	if err != nil {
+		return fmt.Errorf("failed to compact idea: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// restoreIdea devuelve a la fila de una idea compactada su contenido completo y elimina su copia
+// comprimida; devuelve el contenido restaurado
+func restoreIdea(ctx context.Context, db querier, id uuid.UUID) (string, error) {
+	var bundle []byte
+	if err := db.QueryRow(ctx, `SELECT bundle FROM idea_archives WHERE idea_id = $1`, id).Scan(&bundle); err != nil {
+		return "", err
+	}
+	content, err := decodeIdeaBundle(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	query := `
+		WITH restored AS (
+			UPDATE ideas SET content = $2, compacted_at = NULL
+			WHERE id = $1 AND compacted_at IS NOT NULL
+			RETURNING id
+		)
+		DELETE FROM idea_archives WHERE idea_id IN (SELECT id FROM restored)
+	`
+	if _, err := db.Exec(ctx, query, id, content); err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// ideaBundle es el contenido de una idea compactada, guardado como JSON comprimido con gzip
+type ideaBundle struct {
+	Content string `json:"content"`
+}
+
+func encodeIdeaBundle(content string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(writer).Encode(ideaBundle{Content: content}); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeIdeaBundle(data []byte) (string, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	var bundle ideaBundle
+	if err := json.NewDecoder(reader).Decode(&bundle); err != nil {
+		return "", err
+	}
+	return bundle.Content, nil
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ideaEmbeddingRepository struct {
+	db querier
+}
+
+var _ ports.IdeaEmbeddingRepository = (*ideaEmbeddingRepository)(nil)
+
+// NewIdeaEmbeddingRepository crea un nuevo repositorio de vectores de ideas sobre pgvector
+func NewIdeaEmbeddingRepository(db *pgxpool.Pool) ports.IdeaEmbeddingRepository {
+	return &ideaEmbeddingRepository{db: db}
+}
+
+// Upsert guarda el vector de una idea, reemplazando el anterior
+func (r *ideaEmbeddingRepository) Upsert(ctx context.Context, embedding *entities.IdeaEmbedding) error {
+	query := `
+		INSERT INTO idea_embeddings (idea_id, user_id, model, embedding, content_hash, embedded_at)
+		VALUES ($1, $2, $3, $4::vector, $5, $6)
+		ON CONFLICT (idea_id) DO UPDATE
+		SET model = EXCLUDED.model, embedding = EXCLUDED.embedding,
+		    content_hash = EXCLUDED.content_hash, embedded_at = EXCLUDED.embedded_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		embedding.IdeaID,
+		embedding.UserID,
+		embedding.Model,
+		formatVector(embedding.Vector),
+		embedding.ContentHash,
+		embedding.EmbeddedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save idea embedding: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIdeaID obtiene el vector de una idea
+func (r *ideaEmbeddingRepository) GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaEmbedding, error) {
+	query := `
+		SELECT idea_id, user_id, model, embedding::text, content_hash, embedded_at
+		FROM idea_embeddings
+		WHERE idea_id = $1
+	`
+
+	var embedding entities.IdeaEmbedding
+	var vector string
+	err := r.db.QueryRow(ctx, query, ideaID).Scan(
+		&embedding.IdeaID,
+		&embedding.UserID,
+		&embedding.Model,
+		&vector,
+		&embedding.ContentHash,
+		&embedding.EmbeddedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrIdeaEmbeddingNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea embedding: %w", err)
+	}
+
+	if embedding.Vector, err = parseVector(vector); err != nil {
+		return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+	}
+
+	return &embedding, nil
+}
+
+// Nearest ordena por distancia coseno (<=>) los vectores del usuario. Sin dimensión fija la
+// columna no admite un índice aproximado, así que se recorren los vectores del usuario.
+func (r *ideaEmbeddingRepository) Nearest(ctx context.Context, userID uuid.UUID, model string, vector []float32, limit int) ([]ports.IdeaMatch, error) {
+	query := `
+		SELECT idea_id, 1 - (embedding <=> $3::vector)
+		FROM idea_embeddings
+		WHERE user_id = $1 AND model = $2 AND vector_dims(embedding) = $4
+		ORDER BY embedding <=> $3::vector
+		LIMIT $5
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, model, formatVector(vector), len(vector), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query idea embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []ports.IdeaMatch
+	for rows.Next() {
+		var match ports.IdeaMatch
+		if err := rows.Scan(&match.IdeaID, &match.Similarity); err != nil {
+			return nil, fmt.Errorf("failed to scan idea embedding: %w", err)
+		}
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating idea embeddings: %w", err)
+	}
+
+	return matches, nil
+}
+
+// formatVector escribe un vector en el formato de texto de pgvector: [1,2,3]
+func formatVector(vector []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, value := range vector {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(value), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func parseVector(text string) ([]float32, error) {
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "["), "]")
+	if text == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(text, ",")
+	vector := make([]float32, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return nil, err
+		}
+		vector[i] = float32(value)
+	}
+	return vector, nil
+}
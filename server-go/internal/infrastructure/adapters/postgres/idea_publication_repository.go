@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const ideaPublicationColumns = `id, idea_id, user_id, slug, expires_at, view_count, last_viewed_at, created_at, unpublished_at`
+
+type ideaPublicationRepository struct {
+	db querier
+}
+
+var _ ports.IdeaPublicationRepository = (*ideaPublicationRepository)(nil)
+
+// NewIdeaPublicationRepository crea un nuevo repositorio de publicaciones de ideas
+func NewIdeaPublicationRepository(db *pgxpool.Pool) ports.IdeaPublicationRepository {
+	return &ideaPublicationRepository{db: db}
+}
+
+// Create registra una publicación
+func (r *ideaPublicationRepository) Create(ctx context.Context, publication *entities.IdeaPublication) error {
+	query := `
+		INSERT INTO idea_publications (` + ideaPublicationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		publication.ID,
+		publication.IdeaID,
+		publication.UserID,
+		publication.Slug,
+		publication.ExpiresAt,
+		publication.ViewCount,
+		publication.LastViewedAt,
+		publication.CreatedAt,
+		publication.UnpublishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create idea publication: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una publicación por su ID
+func (r *ideaPublicationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx, `SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE id = $1`, id)
+}
+
+// GetBySlug obtiene una publicación por su slug
+func (r *ideaPublicationRepository) GetBySlug(ctx context.Context, slug string) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx, `SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE slug = $1`, slug)
+}
+
+// GetCurrentByIdeaID obtiene la publicación no retirada de una idea
+func (r *ideaPublicationRepository) GetCurrentByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx,
+		`SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE idea_id = $1 AND unpublished_at IS NULL`,
+		ideaID,
+	)
+}
+
+// ListByUserID obtiene las publicaciones no retiradas de un usuario, de la más reciente a la más antigua
+func (r *ideaPublicationRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.IdeaPublication, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE user_id = $1 AND unpublished_at IS NULL ORDER BY created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idea publications: %w", err)
+	}
+	defer rows.Close()
+
+	var publications []*entities.IdeaPublication
+	for rows.Next() {
+		publication, err := scanIdeaPublication(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea publication: %w", err)
+		}
+		publications = append(publications, publication)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate idea publications: %w", err)
+	}
+
+	return publications, nil
+}
+
+// SetExpiry cambia la caducidad de una publicación
+func (r *ideaPublicationRepository) SetExpiry(ctx context.Context, id uuid.UUID, expiresAt *time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE idea_publications SET expires_at = $2 WHERE id = $1`, id, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to update idea publication expiry: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrIdeaPublicationNotFound
+	}
+
+	return nil
+}
+
+// Unpublish retira una publicación; retirarla dos veces conserva la primera fecha
+func (r *ideaPublicationRepository) Unpublish(ctx context.Context, id uuid.UUID, unpublishedAt time.Time) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE idea_publications SET unpublished_at = COALESCE(unpublished_at, $2) WHERE id = $1`,
+		id, unpublishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to unpublish idea: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrIdeaPublicationNotFound
+	}
+
+	return nil
+}
+
+// RecordView suma una visita a una publicación
+func (r *ideaPublicationRepository) RecordView(ctx context.Context, id uuid.UUID, viewedAt time.Time) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE idea_publications SET view_count = view_count + 1, last_viewed_at = $2 WHERE id = $1`,
+		id, viewedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record idea publication view: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrIdeaPublicationNotFound
+	}
+
+	return nil
+}
+
+// RecordViews suma las visitas acumuladas con un único UPDATE; last_viewed_at nunca retrocede
+func (r *ideaPublicationRepository) RecordViews(ctx context.Context, views []ports.PublicationViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	rows := make([]string, len(views))
+	args := make([]interface{}, 0, len(views)*3)
+	for i, view := range views {
+		rows[i] = fmt.Sprintf("($%d::uuid, $%d::bigint, $%d::timestamptz)", i*3+1, i*3+2, i*3+3)
+		args = append(args, view.PublicationID, view.Count, view.LastViewedAt)
+	}
+
+	query := `
+		UPDATE idea_publications AS p
+		SET view_count = p.view_count + v.views,
+			last_viewed_at = GREATEST(p.last_viewed_at, v.viewed_at)
+		FROM (VALUES ` + strings.Join(rows, ", ") + `) AS v (id, views, viewed_at)
+		WHERE p.id = v.id
+	`
+
+	if _, err := r.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to record idea publication views: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ideaPublicationRepository) getOne(ctx context.Context, query string, arg any) (*entities.IdeaPublication, error) {
+	publication, err := scanIdeaPublication(r.db.QueryRow(ctx, query, arg))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrIdeaPublicationNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea publication: %w", err)
+	}
+
+	return publication, nil
+}
+
+func scanIdeaPublication(row pgx.Row) (*entities.IdeaPublication, error) {
+	var publication entities.IdeaPublication
+	err := row.Scan(
+		&publication.ID,
+		&publication.IdeaID,
+		&publication.UserID,
+		&publication.Slug,
+		&publication.ExpiresAt,
+		&publication.ViewCount,
+		&publication.LastViewedAt,
+		&publication.CreatedAt,
+		&publication.UnpublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &publication, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
 	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
@@ -14,10 +13,21 @@ import (
 	"github.com/lib/pq"
 )
 
+// ideaSortColumns contiene las columnas por las que se permite ordenar ideas
+var ideaSortColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"title":      "title",
+	"priority":   "priority",
+	"position":   "position",
+}
+
 type ideaRepository struct {
-	db *pgxpool.Pool
+	db querier
 }
 
+var _ ports.IdeaRepository = (*ideaRepository)(nil)
+
 // NewIdeaRepository crea una nueva instancia del repositorio de ideas
 func NewIdeaRepository(db *pgxpool.Pool) ports.IdeaRepository {
 	return &ideaRepository{db: db}
@@ -26,8 +36,8 @@ func NewIdeaRepository(db *pgxpool.Pool) ports.IdeaRepository {
 // Create crea una nueva idea en la base de datos
 func (r *ideaRepository) Create(ctx context.Context, idea *entities.Idea) error {
 	query := `
-		INSERT INTO ideas (id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO ideas (id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	`
 	
 	relatedIdeaStrings := make([]string, len(idea.RelatedIdeas))
@@ -35,7 +45,12 @@ func (r *ideaRepository) Create(ctx context.Context, idea *entities.Idea) error
 		relatedIdeaStrings[i] = id.String()
 	}
 
-	_, err := r.db.Exec(ctx, query,
+	customFields, err := encodeCustomFields(idea.CustomFields)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea: %w", err)
+	}
+
+	_, err = r.db.Exec(ctx, query,
 		idea.ID,
 		idea.Title,
 		idea.Content,
@@ -47,6 +62,10 @@ func (r *ideaRepository) Create(ctx context.Context, idea *entities.Idea) error
 		idea.UserID,
 		pq.Array(relatedIdeaStrings),
 		idea.Priority,
+		idea.Position,
+		customFields,
+		idea.Version,
+		idea.CompactedAt,
 	)
 
 	if err != nil {
@@ -59,7 +78,7 @@ func (r *ideaRepository) Create(ctx context.Context, idea *entities.Idea) error
 // GetByID obtiene una idea por su ID
 func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Idea, error) {
 	query := `
-		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority
+		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at
 		FROM ideas
 		WHERE id = $1
 	`
@@ -67,6 +86,7 @@ func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.I
 	var idea entities.Idea
 	var tags pq.StringArray
 	var relatedIdeas pq.StringArray
+	var customFields []byte
 	var category, status int
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -81,6 +101,10 @@ func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.I
 		&idea.UserID,
 		&relatedIdeas,
 		&idea.Priority,
+		&idea.Position,
+		&customFields,
+		&idea.Version,
+		&idea.CompactedAt,
 	)
 
 	if err != nil {
@@ -93,6 +117,9 @@ func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.I
 	idea.Tags = []string(tags)
 	idea.Category = entities.IdeaCategory(category)
 	idea.Status = entities.IdeaStatus(status)
+	if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+		return nil, fmt.Errorf("invalid custom_fields: %w", err)
+	}
 
 	// Convertir related ideas de strings a UUIDs
 	idea.RelatedIdeas = make([]uuid.UUID, len(relatedIdeas))
@@ -104,73 +131,84 @@ func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.I
 		idea.RelatedIdeas[i] = relatedID
 	}
 
+	if idea.IsCompacted() {
+		if idea.Content, err = restoreIdea(ctx, r.db, id); err != nil {
+			return nil, fmt.Errorf("failed to restore idea: %w", err)
+		}
+		idea.CompactedAt = nil
+	}
+
 	return &idea, nil
 }
 
-// GetByUserID obtiene las ideas de un usuario con filtros
-func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
-	// Construir query base
-	baseQuery := `FROM ideas WHERE user_id = $1`
-	countQuery := `SELECT COUNT(*) ` + baseQuery
-	selectQuery := `
-		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority
-	` + baseQuery
-
+// ideaConditions construye las condiciones de filters que siguen a user_id = $1 y sus argumentos,
+// empezando por el propio userID; los parámetros se numeran en el orden de args
+func ideaConditions(userID uuid.UUID, filters ports.IdeaFilters) (string, []interface{}, error) {
+	var conditions string
 	args := []interface{}{userID}
 	argIndex := 2
 
-	// Aplicar filtros
 	if filters.Category != entities.IdeaCategoryUnspecified {
-		baseQuery += fmt.Sprintf(" AND category = $%d", argIndex)
-		selectQuery = strings.Replace(selectQuery, baseQuery[:len(baseQuery)-len(fmt.Sprintf(" AND category = $%d", argIndex))], baseQuery, 1)
-		countQuery = strings.Replace(countQuery, baseQuery[:len(baseQuery)-len(fmt.Sprintf(" AND category = $%d", argIndex))], baseQuery, 1)
+		conditions += fmt.Sprintf(" AND category = $%d", argIndex)
 		args = append(args, int(filters.Category))
 		argIndex++
 	}
 
 	if filters.Status != entities.IdeaStatusUnspecified {
-		filter := fmt.Sprintf(" AND status = $%d", argIndex)
-		baseQuery += filter
-		selectQuery += filter
-		countQuery += filter
+		conditions += fmt.Sprintf(" AND status = $%d", argIndex)
 		args = append(args, int(filters.Status))
 		argIndex++
 	}
 
 	if len(filters.Tags) > 0 {
-		filter := fmt.Sprintf(" AND tags && $%d", argIndex)
-		baseQuery += filter
-		selectQuery += filter
-		countQuery += filter
+		conditions += fmt.Sprintf(" AND tags && $%d", argIndex)
 		args = append(args, pq.Array(filters.Tags))
 		argIndex++
 	}
 
+	if len(filters.CustomFields) > 0 {
+		filter, filterArgs, err := customFieldConditions(filters.CustomFields, argIndex)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions += filter
+		args = append(args, filterArgs...)
+	}
+
+	return conditions, args, nil
+}
+
+// GetByUserID obtiene las ideas de un usuario con filtros
+func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
+	conditions, args, err := ideaConditions(userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	baseQuery := `FROM ideas WHERE user_id = $1` + conditions
+	// Sin contenido se evita leer del TOAST los cuerpos largos de las ideas
+	content := "content"
+	if filters.WithoutContent {
+		content = "'' AS content"
+	}
+	selectQuery := `
+		SELECT id, title, ` + content + `, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at
+	` + baseQuery
+
 	// Obtener conteo total
-	var totalCount int
-	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&totalCount)
+	totalCount, err := countTotal(ctx, r.db, baseQuery, args, filters.Count)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count ideas: %w", err)
 	}
 
 	// Aplicar ordenamiento y paginación
-	orderBy := "created_at"
-	if filters.SortBy != "" {
-		orderBy = filters.SortBy
-	}
-	
-	direction := "DESC"
-	if !filters.SortDesc {
-		direction = "ASC"
+	order, err := orderBy(filters.Sort, ideaSortColumns, entities.SortField{Field: "created_at"})
+	if err != nil {
+		return nil, 0, err
 	}
-
-	selectQuery += fmt.Sprintf(" ORDER BY %s %s", orderBy, direction)
+	selectQuery += order
 
 	// Paginación
-	if filters.PageSize > 0 {
-		offset := (filters.Page - 1) * filters.PageSize
-		selectQuery += fmt.Sprintf(" LIMIT %d OFFSET %d", filters.PageSize, offset)
-	}
+	selectQuery += limitClause(filters.Page, filters.PageSize, filters.Count)
 
 	// Ejecutar query principal
 	rows, err := r.db.Query(ctx, selectQuery, args...)
@@ -184,6 +222,7 @@ func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filt
 		var idea entities.Idea
 		var tags pq.StringArray
 		var relatedIdeas pq.StringArray
+		var customFields []byte
 		var category, status int
 
 		err := rows.Scan(
@@ -198,6 +237,10 @@ func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filt
 			&idea.UserID,
 			&relatedIdeas,
 			&idea.Priority,
+			&idea.Position,
+			&customFields,
+			&idea.Version,
+			&idea.CompactedAt,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan idea: %w", err)
@@ -206,6 +249,9 @@ func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filt
 		idea.Tags = []string(tags)
 		idea.Category = entities.IdeaCategory(category)
 		idea.Status = entities.IdeaStatus(status)
+		if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+			return nil, 0, fmt.Errorf("invalid custom_fields: %w", err)
+		}
 
 		// Convertir related ideas
 		idea.RelatedIdeas = make([]uuid.UUID, len(relatedIdeas))
@@ -222,6 +268,7 @@ func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filt
 		return nil, 0, fmt.Errorf("error iterating ideas: %w", err)
 	}
 
+	ideas, totalCount = ports.PageTotal(ideas, totalCount, filters.Page, filters.PageSize, filters.Count)
 	return ideas, totalCount, nil
 }
 
@@ -229,9 +276,9 @@ func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filt
 func (r *ideaRepository) Update(ctx context.Context, idea *entities.Idea) error {
 	query := `
 		UPDATE ideas 
-		SET title = $2, content = $3, tags = $4, category = $5, status = $6, 
-		    updated_at = $7, related_ideas = $8, priority = $9
-		WHERE id = $1
+		SET title = $2, content = CASE WHEN compacted_at IS NULL THEN $3 ELSE content END, tags = $4, category = $5, status = $6, 
+		    updated_at = $7, related_ideas = $8, priority = $9, position = $10, custom_fields = $11, version = version + 1
+		WHERE id = $1 AND version = $12
 	`
 
 	relatedIdeaStrings := make([]string, len(idea.RelatedIdeas))
@@ -239,6 +286,11 @@ func (r *ideaRepository) Update(ctx context.Context, idea *entities.Idea) error
 		relatedIdeaStrings[i] = id.String()
 	}
 
+	customFields, err := encodeCustomFields(idea.CustomFields)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea: %w", err)
+	}
+
 	result, err := r.db.Exec(ctx, query,
 		idea.ID,
 		idea.Title,
@@ -249,6 +301,9 @@ func (r *ideaRepository) Update(ctx context.Context, idea *entities.Idea) error
 		idea.UpdatedAt,
 		pq.Array(relatedIdeaStrings),
 		idea.Priority,
+		idea.Position,
+		customFields,
+		idea.Version,
 	)
 
 	if err != nil {
@@ -257,9 +312,17 @@ func (r *ideaRepository) Update(ctx context.Context, idea *entities.Idea) error
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
+		var exists bool
+		if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM ideas WHERE id = $1)`, idea.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check idea existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
 		return entities.ErrIdeaNotFound
 	}
 
+	idea.Version++
 	return nil
 }
 
@@ -278,4 +341,148 @@ func (r *ideaRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	return nil
+}
+
+// ListByStatus recorre las ideas de todos los usuarios en esos estados ordenadas por ID
+func (r *ideaRepository) ListByStatus(ctx context.Context, statuses []entities.IdeaStatus, afterID uuid.UUID, limit int) ([]*entities.Idea, error) {
+	query := `
+		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at
+		FROM ideas
+		WHERE id > $1 AND status = ANY($2)
+		ORDER BY id
+		LIMIT $3
+	`
+
+	statusValues := make([]int32, len(statuses))
+	for i, status := range statuses {
+		statusValues[i] = int32(status)
+	}
+
+	rows, err := r.db.Query(ctx, query, afterID, statusValues, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ideas: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		var idea entities.Idea
+		var tags pq.StringArray
+		var relatedIdeas pq.StringArray
+		var customFields []byte
+		var category, status int
+
+		err := rows.Scan(
+			&idea.ID,
+			&idea.Title,
+			&idea.Content,
+			&tags,
+			&category,
+			&status,
+			&idea.CreatedAt,
+			&idea.UpdatedAt,
+			&idea.UserID,
+			&relatedIdeas,
+			&idea.Priority,
+			&idea.Position,
+			&customFields,
+			&idea.Version,
+			&idea.CompactedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+
+		idea.Tags = []string(tags)
+		idea.Category = entities.IdeaCategory(category)
+		idea.Status = entities.IdeaStatus(status)
+		if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+			return nil, fmt.Errorf("invalid custom_fields: %w", err)
+		}
+
+		idea.RelatedIdeas = make([]uuid.UUID, len(relatedIdeas))
+		for i, idStr := range relatedIdeas {
+			if relatedID, err := uuid.Parse(idStr); err == nil {
+				idea.RelatedIdeas[i] = relatedID
+			}
+		}
+
+		ideas = append(ideas, &idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ideas: %w", err)
+	}
+
+	return ideas, nil
+}
+
+// Search busca las ideas del usuario con el índice de texto completo, ordenadas por relevancia
+func (r *ideaRepository) Search(ctx context.Context, userID uuid.UUID, query string, limit int) ([]*entities.Idea, error) {
+	sqlQuery := `
+		SELECT id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at
+		FROM ideas, plainto_tsquery('simple', $2) query
+		WHERE user_id = $1 AND search_vector @@ query
+		ORDER BY ts_rank(search_vector, query) DESC, updated_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, sqlQuery, userID, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search ideas: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		var idea entities.Idea
+		var tags pq.StringArray
+		var relatedIdeas pq.StringArray
+		var customFields []byte
+		var category, status int
+
+		err := rows.Scan(
+			&idea.ID,
+			&idea.Title,
+			&idea.Content,
+			&tags,
+			&category,
+			&status,
+			&idea.CreatedAt,
+			&idea.UpdatedAt,
+			&idea.UserID,
+			&relatedIdeas,
+			&idea.Priority,
+			&idea.Position,
+			&customFields,
+			&idea.Version,
+			&idea.CompactedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+
+		idea.Tags = []string(tags)
+		idea.Category = entities.IdeaCategory(category)
+		idea.Status = entities.IdeaStatus(status)
+		if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+			return nil, fmt.Errorf("invalid custom_fields: %w", err)
+		}
+
+		// Convertir related ideas
+		idea.RelatedIdeas = make([]uuid.UUID, len(relatedIdeas))
+		for i, idStr := range relatedIdeas {
+			if relatedID, err := uuid.Parse(idStr); err == nil {
+				idea.RelatedIdeas[i] = relatedID
+			}
+		}
+
+		ideas = append(ideas, &idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ideas: %w", err)
+	}
+
+	return ideas, nil
 }
\ No newline at end of file
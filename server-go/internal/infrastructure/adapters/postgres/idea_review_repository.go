@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const ideaReviewColumns = `idea_id, user_id, ease_factor, interval_days, repetitions, due_at, last_reviewed_at, enrolled_at, version`
+
+type ideaReviewRepository struct {
+	db querier
+}
+
+var _ ports.IdeaReviewRepository = (*ideaReviewRepository)(nil)
+
+// NewIdeaReviewRepository crea un nuevo repositorio de ideas inscritas en el repaso
+func NewIdeaReviewRepository(db *pgxpool.Pool) ports.IdeaReviewRepository {
+	return &ideaReviewRepository{db: db}
+}
+
+// Create inscribe una idea en el repaso
+func (r *ideaReviewRepository) Create(ctx context.Context, review *entities.IdeaReview) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO idea_reviews (`+ideaReviewColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		review.IdeaID,
+		review.UserID,
+		review.EaseFactor,
+		review.IntervalDays,
+		review.Repetitions,
+		review.DueAt,
+		review.LastReviewedAt,
+		review.EnrolledAt,
+		review.Version,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return entities.ErrIdeaAlreadyEnrolled
+		}
+		return fmt.Errorf("failed to create idea review: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIdeaID obtiene la inscripción de una idea
+func (r *ideaReviewRepository) GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaReview, error) {
+	review, err := scanIdeaReview(r.db.QueryRow(ctx,
+		`SELECT `+ideaReviewColumns+` FROM idea_reviews WHERE idea_id = $1`, ideaID,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrIdeaReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea review: %w", err)
+	}
+
+	return review, nil
+}
+
+// Update guarda el resultado de un repaso con control de versión optimista
+func (r *ideaReviewRepository) Update(ctx context.Context, review *entities.IdeaReview) error {
+	query := `
+		UPDATE idea_reviews
+		SET ease_factor = $2, interval_days = $3, repetitions = $4, due_at = $5, last_reviewed_at = $6, version = version + 1
+		WHERE idea_id = $1 AND version = $7
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		review.IdeaID,
+		review.EaseFactor,
+		review.IntervalDays,
+		review.Repetitions,
+		review.DueAt,
+		review.LastReviewedAt,
+		review.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update idea review: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		var exists bool
+		if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM idea_reviews WHERE idea_id = $1)`, review.IdeaID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check idea review existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrIdeaReviewNotFound
+	}
+
+	review.Version++
+	return nil
+}
+
+// Delete retira una idea del repaso
+func (r *ideaReviewRepository) Delete(ctx context.Context, ideaID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM idea_reviews WHERE idea_id = $1`, ideaID)
+	if err != nil {
+		return fmt.Errorf("failed to delete idea review: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrIdeaReviewNotFound
+	}
+
+	return nil
+}
+
+// ListDue obtiene las inscripciones vencidas de un usuario, las más atrasadas primero
+func (r *ideaReviewRepository) ListDue(ctx context.Context, userID uuid.UUID, until time.Time, limit int) ([]*entities.IdeaReview, int, error) {
+	var totalCount int
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*) FROM idea_reviews WHERE user_id = $1 AND due_at < $2`,
+		userID, until,
+	).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count due idea reviews: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT `+ideaReviewColumns+` FROM idea_reviews WHERE user_id = $1 AND due_at < $2 ORDER BY due_at, idea_id LIMIT $3`,
+		userID, until, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list due idea reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*entities.IdeaReview
+	for rows.Next() {
+		review, err := scanIdeaReview(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan idea review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate idea reviews: %w", err)
+	}
+
+	return reviews, totalCount, nil
+}
+
+func scanIdeaReview(row pgx.Row) (*entities.IdeaReview, error) {
+	var review entities.IdeaReview
+	err := row.Scan(
+		&review.IdeaID,
+		&review.UserID,
+		&review.EaseFactor,
+		&review.IntervalDays,
+		&review.Repetitions,
+		&review.DueAt,
+		&review.LastReviewedAt,
+		&review.EnrolledAt,
+		&review.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const inboundAddressColumns = `id, user_id, token_hash, allowed_senders, created_at, last_used_at, revoked_at`
+
+type inboundAddressRepository struct {
+	db querier
+}
+
+var _ ports.InboundAddressRepository = (*inboundAddressRepository)(nil)
+
+// NewInboundAddressRepository crea un nuevo repositorio de direcciones de entrada
+func NewInboundAddressRepository(db *pgxpool.Pool) ports.InboundAddressRepository {
+	return &inboundAddressRepository{db: db}
+}
+
+// Create registra una dirección de entrada
+func (r *inboundAddressRepository) Create(ctx context.Context, address *entities.InboundAddress) error {
+	query := `
+		INSERT INTO inbound_addresses (` + inboundAddressColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	allowedSenders := address.AllowedSenders
+	if allowedSenders == nil {
+		allowedSenders = []string{}
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		address.ID,
+		address.UserID,
+		address.TokenHash,
+		allowedSenders,
+		address.CreatedAt,
+		address.LastUsedAt,
+		address.RevokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create inbound address: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una dirección de entrada por su ID
+func (r *inboundAddressRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.InboundAddress, error) {
+	return r.getOne(ctx, `SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE id = $1`, id)
+}
+
+// GetByTokenHash obtiene una dirección de entrada por el hash de su token
+func (r *inboundAddressRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.InboundAddress, error) {
+	return r.getOne(ctx, `SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE token_hash = $1`, tokenHash)
+}
+
+// ListByUserID obtiene las direcciones de un usuario, de la más reciente a la más antigua
+func (r *inboundAddressRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.InboundAddress, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE user_id = $1 ORDER BY created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbound addresses: %w", err)
+	}
+	defer rows.Close()
+
+	var addresses []*entities.InboundAddress
+	for rows.Next() {
+		address, err := scanInboundAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan inbound address: %w", err)
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate inbound addresses: %w", err)
+	}
+
+	return addresses, nil
+}
+
+// Revoke marca una dirección como revocada; revocar dos veces conserva la primera fecha
+func (r *inboundAddressRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE inbound_addresses SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1`,
+		id, revokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke inbound address: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrInboundAddressNotFound
+	}
+
+	return nil
+}
+
+// MarkUsed registra la fecha del último mensaje recibido
+func (r *inboundAddressRepository) MarkUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE inbound_addresses SET last_used_at = $2 WHERE id = $1`, id, usedAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark inbound address used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *inboundAddressRepository) getOne(ctx context.Context, query string, arg any) (*entities.InboundAddress, error) {
+	address, err := scanInboundAddress(r.db.QueryRow(ctx, query, arg))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrInboundAddressNotFound
+		}
+		return nil, fmt.Errorf("failed to get inbound address: %w", err)
+	}
+
+	return address, nil
+}
+
+func scanInboundAddress(row pgx.Row) (*entities.InboundAddress, error) {
+	var address entities.InboundAddress
+	err := row.Scan(
+		&address.ID,
+		&address.UserID,
+		&address.TokenHash,
+		&address.AllowedSenders,
+		&address.CreatedAt,
+		&address.LastUsedAt,
+		&address.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type localePreferenceRepository struct {
+	db querier
+}
+
+var _ ports.LocalePreferenceRepository = (*localePreferenceRepository)(nil)
+
+// NewLocalePreferenceRepository crea un nuevo repositorio de idiomas preferidos
+func NewLocalePreferenceRepository(db *pgxpool.Pool) ports.LocalePreferenceRepository {
+	return &localePreferenceRepository{db: db}
+}
+
+// GetLocale obtiene el idioma preferido de un usuario
+func (r *localePreferenceRepository) GetLocale(ctx context.Context, userID uuid.UUID) (string, error) {
+	var locale string
+	err := r.db.QueryRow(ctx, `SELECT locale FROM user_locales WHERE user_id = $1`, userID).Scan(&locale)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", entities.ErrLocalePreferenceNotFound
+		}
+		return "", fmt.Errorf("failed to get locale preference: %w", err)
+	}
+
+	return locale, nil
+}
+
+// SetLocale guarda el idioma preferido de un usuario
+func (r *localePreferenceRepository) SetLocale(ctx context.Context, userID uuid.UUID, locale string, updatedAt time.Time) error {
+	query := `
+		INSERT INTO user_locales (user_id, locale, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET locale = EXCLUDED.locale, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query, userID, locale, updatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set locale preference: %w", err)
+	}
+
+	return nil
+}
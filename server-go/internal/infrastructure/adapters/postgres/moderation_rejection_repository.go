@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+type moderationRejectionRepository struct {
+	db querier
+}
+
+var _ ports.ModerationRejectionRepository = (*moderationRejectionRepository)(nil)
+
+// NewModerationRejectionRepository crea un nuevo repositorio de auditoría de moderación
+func NewModerationRejectionRepository(db *pgxpool.Pool) ports.ModerationRejectionRepository {
+	return &moderationRejectionRepository{db: db}
+}
+
+// Create registra un contenido rechazado
+func (r *moderationRejectionRepository) Create(ctx context.Context, rejection *entities.ModerationRejection) error {
+	query := `
+		INSERT INTO moderation_rejections (id, user_id, organization, scope, entity_type, entity_id, reason, matches, moderator, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		rejection.ID,
+		rejection.UserID,
+		rejection.Organization,
+		string(rejection.Scope),
+		rejection.EntityType,
+		rejection.EntityID,
+		rejection.Reason,
+		pq.StringArray(rejection.Matches),
+		rejection.Moderator,
+		rejection.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create moderation rejection: %w", err)
+	}
+
+	return nil
+}
+
+// List obtiene los rechazos más recientes de una organización, o de todas si está vacía
+func (r *moderationRejectionRepository) List(ctx context.Context, organization string, limit int) ([]*entities.ModerationRejection, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, user_id, organization, scope, entity_type, entity_id, reason, matches, moderator, created_at
+		FROM moderation_rejections
+		WHERE $1 = '' OR organization = $1
+		ORDER BY created_at DESC
+		LIMIT $2`,
+		organization, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation rejections: %w", err)
+	}
+	defer rows.Close()
+
+	var rejections []*entities.ModerationRejection
+	for rows.Next() {
+		var rejection entities.ModerationRejection
+		var scope string
+		var matches pq.StringArray
+		err := rows.Scan(
+			&rejection.ID,
+			&rejection.UserID,
+			&rejection.Organization,
+			&scope,
+			&rejection.EntityType,
+			&rejection.EntityID,
+			&rejection.Reason,
+			&matches,
+			&rejection.Moderator,
+			&rejection.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation rejection: %w", err)
+		}
+		rejection.Scope = entities.ModerationScope(scope)
+		rejection.Matches = []string(matches)
+		rejections = append(rejections, &rejection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate moderation rejections: %w", err)
+	}
+
+	return rejections, nil
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type notificationInbox struct {
+	db querier
+}
+
+var _ ports.NotificationInbox = (*notificationInbox)(nil)
+
+// NewNotificationInbox crea un nuevo buzón persistente de notificaciones
+func NewNotificationInbox(db *pgxpool.Pool) ports.NotificationInbox {
+	return &notificationInbox{db: db}
+}
+
+// Append guarda una notificación enviada; seq conserva el orden de envío
+func (r *notificationInbox) Append(ctx context.Context, notification ports.Notification) error {
+	channels := notification.Channels
+	if channels == nil {
+		channels = []string{}
+	}
+	metadata := notification.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	query := `
+		INSERT INTO notification_inbox (id, user_id, title, message, type, channels, metadata, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		notification.ID,
+		notification.UserID,
+		notification.Title,
+		notification.Message,
+		notification.Type,
+		channels,
+		metadata,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append notification: %w", err)
+	}
+
+	return nil
+}
+
+// ListAfter devuelve las notificaciones del usuario enviadas después de afterID
+func (r *notificationInbox) ListAfter(ctx context.Context, userID, afterID uuid.UUID, limit int) ([]ports.Notification, error) {
+	var afterSeq int64
+	err := r.db.QueryRow(ctx,
+		`SELECT seq FROM notification_inbox WHERE id = $1 AND user_id = $2`,
+		afterID, userID,
+	).Scan(&afterSeq)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to find resume notification: %w", err)
+	}
+
+	query := `
+		SELECT id, user_id, title, message, type, channels, metadata, created_at
+		FROM notification_inbox
+		WHERE user_id = $1 AND seq > $2
+		ORDER BY seq
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, afterSeq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []ports.Notification
+	for rows.Next() {
+		var notification ports.Notification
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Title,
+			&notification.Message,
+			&notification.Type,
+			&notification.Channels,
+			&notification.Metadata,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// List devuelve una página de las notificaciones del usuario, de la más reciente a la más antigua
+func (r *notificationInbox) List(ctx context.Context, userID uuid.UUID, filters ports.NotificationFilters) ([]ports.Notification, int, error) {
+	where := `FROM notification_inbox WHERE user_id = $1`
+	args := []any{userID}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	query := `SELECT id, user_id, title, message, type, channels, metadata, created_at ` + where +
+		` ORDER BY seq DESC` + limitClause(filters.Page, filters.PageSize, filters.Count)
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []ports.Notification
+	for rows.Next() {
+		var notification ports.Notification
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Title,
+			&notification.Message,
+			&notification.Type,
+			&notification.Channels,
+			&notification.Metadata,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate notifications: %w", err)
+	}
+
+	notifications, totalCount = ports.PageTotal(notifications, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return notifications, totalCount, nil
+}
+
+// DeleteOlderThan depura las notificaciones enviadas antes de cutoff
+func (r *notificationInbox) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM notification_inbox WHERE created_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old notifications: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// countTotal cuenta las filas de where según mode; ports.CountEstimated usa las filas que el
+// planificador estima a partir de las estadísticas de la tabla, sin recorrerla
+func countTotal(ctx context.Context, db querier, where string, args []any, mode ports.CountMode) (int, error) {
+	switch mode {
+	case ports.CountNone:
+		return 0, nil
+	case ports.CountEstimated:
+		var plan []byte
+		if err := db.QueryRow(ctx, `EXPLAIN (FORMAT JSON) SELECT 1 `+where, args...).Scan(&plan); err != nil {
+			return 0, err
+		}
+		var explained []struct {
+			Plan struct {
+				Rows float64 `json:"Plan Rows"`
+			} `json:"Plan"`
+		}
+		if err := json.Unmarshal(plan, &explained); err != nil {
+			return 0, fmt.Errorf("failed to decode query plan: %w", err)
+		}
+		if len(explained) == 0 {
+			return 0, errors.New("failed to decode query plan: empty plan")
+		}
+		return int(explained[0].Plan.Rows), nil
+	}
+
+	var total int
+	if err := db.QueryRow(ctx, `SELECT COUNT(*) `+where, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// limitClause devuelve el LIMIT y OFFSET de la página; sin total pide una fila de más para saber
+// si hay otra página
+func limitClause(page, pageSize int, mode ports.CountMode) string {
+	if pageSize <= 0 {
+		return ""
+	}
+	limit := pageSize
+	if mode == ports.CountNone {
+		limit++
+	}
+	return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, (page-1)*pageSize)
+}
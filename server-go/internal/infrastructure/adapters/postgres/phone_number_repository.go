@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const phoneNumberColumns = `user_id, number, code_hash, code_expires_at, attempts, verified_at, created_at, updated_at`
+
+type phoneNumberRepository struct {
+	db querier
+}
+
+var _ ports.PhoneNumberRepository = (*phoneNumberRepository)(nil)
+
+// NewPhoneNumberRepository crea un nuevo repositorio de teléfonos y consumo de SMS
+func NewPhoneNumberRepository(db *pgxpool.Pool) ports.PhoneNumberRepository {
+	return &phoneNumberRepository{db: db}
+}
+
+// Save crea o reemplaza el teléfono de un usuario
+func (r *phoneNumberRepository) Save(ctx context.Context, phone *entities.PhoneNumber) error {
+	query := `
+		INSERT INTO user_phone_numbers (` + phoneNumberColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE SET
+			number = EXCLUDED.number, code_hash = EXCLUDED.code_hash, code_expires_at = EXCLUDED.code_expires_at,
+			attempts = EXCLUDED.attempts, verified_at = EXCLUDED.verified_at, created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		phone.UserID,
+		phone.Number,
+		nullIfEmpty(phone.CodeHash),
+		phone.CodeExpiresAt,
+		phone.Attempts,
+		phone.VerifiedAt,
+		phone.CreatedAt,
+		phone.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save phone number: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserID obtiene el teléfono de un usuario
+func (r *phoneNumberRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.PhoneNumber, error) {
+	var phone entities.PhoneNumber
+	var codeHash *string
+	err := r.db.QueryRow(ctx,
+		`SELECT `+phoneNumberColumns+` FROM user_phone_numbers WHERE user_id = $1`, userID,
+	).Scan(
+		&phone.UserID,
+		&phone.Number,
+		&codeHash,
+		&phone.CodeExpiresAt,
+		&phone.Attempts,
+		&phone.VerifiedAt,
+		&phone.CreatedAt,
+		&phone.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrPhoneNumberNotFound
+		}
+		return nil, fmt.Errorf("failed to get phone number: %w", err)
+	}
+
+	if codeHash != nil {
+		phone.CodeHash = *codeHash
+	}
+	return &phone, nil
+}
+
+// Delete elimina el teléfono de un usuario
+func (r *phoneNumberRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM user_phone_numbers WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete phone number: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entities.ErrPhoneNumberNotFound
+	}
+
+	return nil
+}
+
+// ReserveSMS suma un SMS al consumo del día en una sola sentencia, para que dos envíos simultáneos
+// no superen el límite
+func (r *phoneNumberRepository) ReserveSMS(ctx context.Context, userID uuid.UUID, day time.Time, limit int) (bool, error) {
+	if limit <= 0 {
+		return false, nil
+	}
+
+	result, err := r.db.Exec(ctx, `
+		INSERT INTO sms_usage (user_id, day, count) VALUES ($1, $2, 1)
+		ON CONFLICT (user_id, day) DO UPDATE SET count = sms_usage.count + 1 WHERE sms_usage.count < $3
+	`, userID, day.UTC().Format(time.DateOnly), limit)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve SMS: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
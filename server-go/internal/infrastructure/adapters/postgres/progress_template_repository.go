@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const progressTemplateColumns = `id, owner_id, name, description, milestones, organization, created_at, updated_at`
+
+// templateMilestoneRecord es la representación JSON de un hito dentro de la columna milestones
+type templateMilestoneRecord struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	DueOffsetMinutes int64   `json:"due_offset_minutes"`
+	Weight           float32 `json:"weight,omitempty"`
+}
+
+type progressTemplateRepository struct {
+	db querier
+}
+
+var _ ports.ProgressTemplateRepository = (*progressTemplateRepository)(nil)
+
+// NewProgressTemplateRepository crea un nuevo repositorio de plantillas de progreso
+func NewProgressTemplateRepository(db *pgxpool.Pool) ports.ProgressTemplateRepository {
+	return &progressTemplateRepository{db: db}
+}
+
+// Create guarda una plantilla de progreso
+func (r *progressTemplateRepository) Create(ctx context.Context, template *entities.ProgressTemplate) error {
+	milestones, err := encodeTemplateMilestones(template.Milestones)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress template: %w", err)
+	}
+
+	_, err = r.db.Exec(ctx,
+		`INSERT INTO progress_templates (`+progressTemplateColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		template.ID,
+		template.OwnerID,
+		template.Name,
+		template.Description,
+		milestones,
+		template.Organization,
+		template.CreatedAt,
+		template.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create progress template: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una plantilla de progreso
+func (r *progressTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ProgressTemplate, error) {
+	template, err := scanProgressTemplate(r.db.QueryRow(ctx,
+		`SELECT `+progressTemplateColumns+` FROM progress_templates WHERE id = $1`, id,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrProgressTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress template: %w", err)
+	}
+
+	return template, nil
+}
+
+// ListByOwner obtiene las plantillas de un usuario
+func (r *progressTemplateRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*entities.ProgressTemplate, error) {
+	return r.list(ctx, `owner_id = $1`, ownerID)
+}
+
+// ListByOrganization obtiene las plantillas compartidas con una organización
+func (r *progressTemplateRepository) ListByOrganization(ctx context.Context, organization string) ([]*entities.ProgressTemplate, error) {
+	return r.list(ctx, `organization = $1 AND organization <> ''`, organization)
+}
+
+func (r *progressTemplateRepository) list(ctx context.Context, condition string, arg any) ([]*entities.ProgressTemplate, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+progressTemplateColumns+` FROM progress_templates WHERE `+condition+` ORDER BY name, id`, arg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query progress templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*entities.ProgressTemplate
+	for rows.Next() {
+		template, err := scanProgressTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan progress template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating progress templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// Update guarda los cambios de una plantilla de progreso
+func (r *progressTemplateRepository) Update(ctx context.Context, template *entities.ProgressTemplate) error {
+	milestones, err := encodeTemplateMilestones(template.Milestones)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress template: %w", err)
+	}
+
+	result, err := r.db.Exec(ctx,
+		`UPDATE progress_templates SET name = $1, description = $2, milestones = $3, organization = $4, updated_at = $5 WHERE id = $6`,
+		template.Name,
+		template.Description,
+		milestones,
+		template.Organization,
+		template.UpdatedAt,
+		template.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update progress template: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrProgressTemplateNotFound
+	}
+
+	return nil
+}
+
+// Delete elimina una plantilla de progreso
+func (r *progressTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM progress_templates WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete progress template: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrProgressTemplateNotFound
+	}
+
+	return nil
+}
+
+func scanProgressTemplate(row pgx.Row) (*entities.ProgressTemplate, error) {
+	var template entities.ProgressTemplate
+	var milestones []byte
+
+	err := row.Scan(
+		&template.ID,
+		&template.OwnerID,
+		&template.Name,
+		&template.Description,
+		&milestones,
+		&template.Organization,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []templateMilestoneRecord
+	if err := json.Unmarshal(milestones, &records); err != nil {
+		return nil, fmt.Errorf("invalid progress template milestones: %w", err)
+	}
+	for _, m := range records {
+		template.Milestones = append(template.Milestones, entities.TemplateMilestone{
+			Name:        m.Name,
+			Description: m.Description,
+			DueOffset:   time.Duration(m.DueOffsetMinutes) * time.Minute,
+			Weight:      m.Weight,
+		})
+	}
+
+	return &template, nil
+}
+
+func encodeTemplateMilestones(milestones []entities.TemplateMilestone) ([]byte, error) {
+	records := make([]templateMilestoneRecord, len(milestones))
+	for i, m := range milestones {
+		records[i] = templateMilestoneRecord{
+			Name:             m.Name,
+			Description:      m.Description,
+			DueOffsetMinutes: int64(m.DueOffset / time.Minute),
+			Weight:           m.Weight,
+		}
+	}
+	return json.Marshal(records)
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	queryNameComment = regexp.MustCompile(`--\s*name:\s*(\w+)`)
+	queryTable       = regexp.MustCompile(`(?i)\b(?:FROM|INTO|UPDATE)\s+(\w+)`)
+	whitespace       = regexp.MustCompile(`\s+`)
+)
+
+// maxTrackedSlowQueries limita cuántas consultas lentas se conservan para diagnóstico
+const maxTrackedSlowQueries = 50
+
+// QueryTracer registra la duración de cada sentencia y conserva las consultas más lentas
+type QueryTracer struct {
+	threshold time.Duration
+	metrics   *metrics.MetricsCollector
+	logger    *logging.StructuredLogger
+	mu        sync.Mutex
+	slow      []ports.SlowQuery
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	start     time.Time
+	sql       string
+	argCount  int
+	requestID string
+}
+
+// NewQueryTracer crea un tracer; threshold <= 0 desactiva el registro de consultas lentas
+func NewQueryTracer(threshold time.Duration, metricsCollector *metrics.MetricsCollector, logger *logging.StructuredLogger) *QueryTracer {
+	return &QueryTracer{
+		threshold: threshold,
+		metrics:   metricsCollector,
+		logger:    logger,
+	}
+}
+
+// TraceQueryStart implementa pgx.QueryTracer
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	requestID, _ := logging.RequestIDFromContext(ctx)
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{
+		start:     time.Now(),
+		sql:       data.SQL,
+		argCount:  len(data.Args),
+		requestID: requestID,
+	})
+}
+
+// TraceQueryEnd implementa pgx.QueryTracer
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+
+	duration := time.Since(trace.start)
+	name := queryName(trace.sql)
+
+	if t.metrics != nil {
+		status := "ok"
+		if data.Err != nil {
+			status = "error"
+		}
+		t.metrics.ObserveHistogram("db_query_duration_seconds", duration.Seconds(), map[string]string{
+			"query":  name,
+			"status": status,
+		})
+	}
+
+	if t.threshold <= 0 || duration < t.threshold {
+		return
+	}
+
+	// Solo se registra el SQL con placeholders; los valores enlazados nunca salen del proceso
+	slowQuery := ports.SlowQuery{
+		Name:       name,
+		SQL:        strings.TrimSpace(whitespace.ReplaceAllString(trace.sql, " ")),
+		Duration:   duration,
+		ExecutedAt: trace.start,
+		ArgCount:   trace.argCount,
+		RequestID:  trace.requestID,
+	}
+	t.recordSlow(slowQuery)
+
+	if t.logger != nil {
+		t.logger.Warn("slow query", map[string]interface{}{
+			"query":       slowQuery.Name,
+			"sql":         slowQuery.SQL,
+			"duration_ms": duration.Milliseconds(),
+			"args":        fmt.Sprintf("[%d redacted]", trace.argCount),
+			"request_id":  trace.requestID,
+		})
+	}
+}
+
+// TopSlowQueries devuelve las consultas más lentas registradas, de mayor a menor duración
+func (t *QueryTracer) TopSlowQueries(limit int) []ports.SlowQuery {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if limit <= 0 || limit > len(t.slow) {
+		limit = len(t.slow)
+	}
+	result := make([]ports.SlowQuery, limit)
+	copy(result, t.slow[:limit])
+	return result
+}
+
+func (t *QueryTracer) recordSlow(query ports.SlowQuery) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.slow = append(t.slow, query)
+	sort.Slice(t.slow, func(i, j int) bool {
+		return t.slow[i].Duration > t.slow[j].Duration
+	})
+	if len(t.slow) > maxTrackedSlowQueries {
+		t.slow = t.slow[:maxTrackedSlowQueries]
+	}
+}
+
+// queryName obtiene un nombre estable para la consulta: el comentario "-- name: X" si existe,
+// o la operación y la tabla principal (p. ej. "select_ideas")
+func queryName(sql string) string {
+	if match := queryNameComment.FindStringSubmatch(sql); match != nil {
+		return match[1]
+	}
+
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+	name := strings.ToLower(fields[0])
+	if match := queryTable.FindStringSubmatch(sql); match != nil {
+		name += "_" + strings.ToLower(match[1])
+	}
+	return name
+}
@@ -0,0 +1,446 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+// reminderSortColumns contiene las columnas por las que se permite ordenar recordatorios
+var reminderSortColumns = map[string]string{
+	"scheduled_time": "scheduled_time",
+	"created_at":     "created_at",
+	"updated_at":     "updated_at",
+	"title":          "title",
+	"type":           "type",
+	"status":         "status",
+}
+
+const reminderColumns = `id, title, description, scheduled_time, type, status, recurring, recurrence_pattern, created_at, updated_at, user_id, notification_channels, idea_id, assignee_id, assignment_status, escalation_policy, escalation_level, acknowledged_at, progress_id, milestone_id, completes_reminder, version`
+
+// escalationPolicyRecord es la representación JSON de la columna escalation_policy
+type escalationPolicyRecord struct {
+	Steps           []escalationStepRecord `json:"steps"`
+	BackupContactID uuid.UUID              `json:"backup_contact_id"`
+}
+
+type escalationStepRecord struct {
+	AfterSeconds        int64    `json:"after_seconds"`
+	Channels            []string `json:"channels"`
+	NotifyBackupContact bool     `json:"notify_backup_contact,omitempty"`
+}
+
+type reminderRepository struct {
+	db querier
+}
+
+var _ ports.ReminderRepository = (*reminderRepository)(nil)
+
+// NewReminderRepository crea un nuevo repositorio de recordatorios
+func NewReminderRepository(db *pgxpool.Pool) ports.ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+// Create crea un nuevo recordatorio
+func (r *reminderRepository) Create(ctx context.Context, reminder *entities.Reminder) error {
+	policy, err := encodeEscalationPolicy(reminder.EscalationPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	progressID, milestoneID, completesReminder := encodeMilestoneLink(reminder.MilestoneLink)
+
+	_, err = r.db.Exec(ctx,
+		`INSERT INTO reminders (`+reminderColumns+`)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`,
+		reminder.ID,
+		reminder.Title,
+		reminder.Description,
+		reminder.ScheduledTime,
+		int(reminder.Type),
+		int(reminder.Status),
+		reminder.Recurring,
+		int(reminder.RecurrencePattern),
+		reminder.CreatedAt,
+		reminder.UpdatedAt,
+		reminder.UserID,
+		pq.Array(channelsOrEmpty(reminder.NotificationChannels)),
+		nullUUID(reminder.IdeaID),
+		nullUUID(reminder.AssigneeID),
+		int(reminder.AssignmentStatus),
+		policy,
+		reminder.EscalationLevel,
+		reminder.AcknowledgedAt,
+		progressID,
+		milestoneID,
+		completesReminder,
+		reminder.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reminder: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un recordatorio por su ID
+func (r *reminderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Reminder, error) {
+	reminder, err := scanReminder(r.db.QueryRow(ctx, `SELECT `+reminderColumns+` FROM reminders WHERE id = $1`, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrReminderNotFound
+		}
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	return reminder, nil
+}
+
+// reminderConditions construye el WHERE de filters para el usuario y sus argumentos; los
+// parámetros se numeran en el orden de args
+func reminderConditions(userID uuid.UUID, filters ports.ReminderFilters) (string, []any, error) {
+	active := []int32{int32(entities.ReminderAssignmentPending), int32(entities.ReminderAssignmentAccepted)}
+
+	var where string
+	var args []any
+	switch filters.Scope {
+	case entities.ReminderScopeCreatedByMe:
+		where = ` FROM reminders WHERE user_id = $1`
+		args = []any{userID}
+	case entities.ReminderScopeAssignedToMe:
+		where = ` FROM reminders WHERE assignee_id = $1 AND assignment_status = ANY($2)`
+		args = []any{userID, active}
+	default:
+		where = ` FROM reminders WHERE (user_id = $1 OR (assignee_id = $1 AND assignment_status = ANY($2)))`
+		args = []any{userID, active}
+	}
+
+	if filters.Type != entities.ReminderTypeUnspecified {
+		args = append(args, int(filters.Type))
+		where += fmt.Sprintf(` AND type = $%d`, len(args))
+	}
+
+	if filters.Status != entities.ReminderStatusUnspecified {
+		args = append(args, int(filters.Status))
+		where += fmt.Sprintf(` AND status = $%d`, len(args))
+	}
+
+	if filters.FromDate != nil {
+		from, err := time.Parse(time.RFC3339, *filters.FromDate)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		args = append(args, from)
+		where += fmt.Sprintf(` AND scheduled_time >= $%d`, len(args))
+	}
+
+	if filters.ToDate != nil {
+		to, err := time.Parse(time.RFC3339, *filters.ToDate)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		args = append(args, to)
+		where += fmt.Sprintf(` AND scheduled_time <= $%d`, len(args))
+	}
+
+	return where, args, nil
+}
+
+// GetByUserID obtiene los recordatorios de un usuario con filtros
+func (r *reminderRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.ReminderFilters) ([]*entities.Reminder, int, error) {
+	where, args, err := reminderConditions(userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count reminders: %w", err)
+	}
+
+	order, err := orderBy(filters.Sort, reminderSortColumns, entities.SortField{Field: "scheduled_time"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reminders, err := r.query(ctx, `SELECT `+reminderColumns+where+order+limitClause(filters.Page, filters.PageSize, filters.Count), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reminders, totalCount = ports.PageTotal(reminders, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return reminders, totalCount, nil
+}
+
+// Update actualiza un recordatorio existente si su versión no cambió desde que se leyó
+func (r *reminderRepository) Update(ctx context.Context, reminder *entities.Reminder) error {
+	policy, err := encodeEscalationPolicy(reminder.EscalationPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	progressID, milestoneID, completesReminder := encodeMilestoneLink(reminder.MilestoneLink)
+
+	result, err := r.db.Exec(ctx, `
+		UPDATE reminders
+		SET title = $2, description = $3, scheduled_time = $4, type = $5, status = $6, recurring = $7,
+		    recurrence_pattern = $8, updated_at = $9, notification_channels = $10, idea_id = $11, assignee_id = $12,
+		    assignment_status = $13, escalation_policy = $14, escalation_level = $15, acknowledged_at = $16,
+		    progress_id = $17, milestone_id = $18, completes_reminder = $19, version = version + 1
+		WHERE id = $1 AND version = $20
+	`,
+		reminder.ID,
+		reminder.Title,
+		reminder.Description,
+		reminder.ScheduledTime,
+		int(reminder.Type),
+		int(reminder.Status),
+		reminder.Recurring,
+		int(reminder.RecurrencePattern),
+		reminder.UpdatedAt,
+		pq.Array(channelsOrEmpty(reminder.NotificationChannels)),
+		nullUUID(reminder.IdeaID),
+		nullUUID(reminder.AssigneeID),
+		int(reminder.AssignmentStatus),
+		policy,
+		reminder.EscalationLevel,
+		reminder.AcknowledgedAt,
+		progressID,
+		milestoneID,
+		completesReminder,
+		reminder.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update reminder: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		var exists bool
+		if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM reminders WHERE id = $1)`, reminder.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check reminder existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrReminderNotFound
+	}
+
+	reminder.Version++
+	return nil
+}
+
+// Delete elimina un recordatorio
+func (r *reminderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM reminders WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrReminderNotFound
+	}
+
+	return nil
+}
+
+// GetOverdueReminders obtiene los recordatorios pendientes o activos cuya hora ya pasó
+func (r *reminderRepository) GetOverdueReminders(ctx context.Context) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status IN ($1, $2) AND scheduled_time < $3 ORDER BY scheduled_time ASC`,
+		int(entities.ReminderStatusPending),
+		int(entities.ReminderStatusActive),
+		time.Now(),
+	)
+}
+
+// GetEscalationCandidates obtiene los recordatorios Deadline vencidos, sin confirmar y con política de escalado
+func (r *reminderRepository) GetEscalationCandidates(ctx context.Context) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status = $1 AND type = $2 AND escalation_policy IS NOT NULL
+		 AND acknowledged_at IS NULL ORDER BY scheduled_time ASC`,
+		int(entities.ReminderStatusOverdue),
+		int(entities.ReminderTypeDeadline),
+	)
+}
+
+// GetUpcomingByIdeaIDs obtiene los recordatorios sin completar vinculados a esas ideas que vencen antes de before
+func (r *reminderRepository) GetUpcomingByIdeaIDs(ctx context.Context, ideaIDs []uuid.UUID, before time.Time) ([]*entities.Reminder, error) {
+	if len(ideaIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(ideaIDs))
+	for i, id := range ideaIDs {
+		ids[i] = id.String()
+	}
+
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status IN ($1, $2, $3) AND scheduled_time < $4
+		 AND idea_id = ANY($5::uuid[]) ORDER BY scheduled_time ASC`,
+		int(entities.ReminderStatusPending),
+		int(entities.ReminderStatusActive),
+		int(entities.ReminderStatusOverdue),
+		before,
+		pq.Array(ids),
+	)
+}
+
+// GetByMilestoneID obtiene los recordatorios vinculados al hito
+func (r *reminderRepository) GetByMilestoneID(ctx context.Context, milestoneID uuid.UUID) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE milestone_id = $1 ORDER BY scheduled_time ASC`,
+		milestoneID,
+	)
+}
+
+func (r *reminderRepository) query(ctx context.Context, query string, args ...any) ([]*entities.Reminder, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*entities.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+func channelsOrEmpty(channels []string) []string {
+	if channels == nil {
+		return []string{}
+	}
+	return channels
+}
+
+// nullUUID guarda como NULL las referencias opcionales vacías (idea_id, assignee_id, milestone_id)
+func nullUUID(id uuid.UUID) any {
+	if id == uuid.Nil {
+		return nil
+	}
+	return id
+}
+
+// encodeEscalationPolicy guarda como NULL los recordatorios sin política de escalado
+func encodeEscalationPolicy(policy *entities.ReminderEscalationPolicy) (any, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	record := escalationPolicyRecord{
+		Steps:           make([]escalationStepRecord, len(policy.Steps)),
+		BackupContactID: policy.BackupContactID,
+	}
+	for i, step := range policy.Steps {
+		record.Steps[i] = escalationStepRecord{
+			AfterSeconds:        int64(step.After / time.Second),
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	return json.Marshal(record)
+}
+
+func decodeEscalationPolicy(data []byte) (*entities.ReminderEscalationPolicy, error) {
+	var record escalationPolicyRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	policy := &entities.ReminderEscalationPolicy{
+		Steps:           make([]entities.ReminderEscalationStep, len(record.Steps)),
+		BackupContactID: record.BackupContactID,
+	}
+	for i, step := range record.Steps {
+		policy.Steps[i] = entities.ReminderEscalationStep{
+			After:               time.Duration(step.AfterSeconds) * time.Second,
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	return policy, nil
+}
+
+// encodeMilestoneLink guarda como NULL el hito de los recordatorios sin vincular
+func encodeMilestoneLink(link *entities.ReminderMilestoneLink) (progressID, milestoneID any, completesReminder bool) {
+	if link == nil {
+		return nil, nil, false
+	}
+	return nullUUID(link.ProgressID), nullUUID(link.MilestoneID), link.CompletesReminder
+}
+
+func scanReminder(row pgx.Row) (*entities.Reminder, error) {
+	var reminder entities.Reminder
+	var channels pq.StringArray
+	var reminderType, status, pattern, assignmentStatus int
+	var ideaID, assigneeID, progressID, milestoneID *uuid.UUID
+	var policy []byte
+	var completesReminder bool
+
+	err := row.Scan(
+		&reminder.ID,
+		&reminder.Title,
+		&reminder.Description,
+		&reminder.ScheduledTime,
+		&reminderType,
+		&status,
+		&reminder.Recurring,
+		&pattern,
+		&reminder.CreatedAt,
+		&reminder.UpdatedAt,
+		&reminder.UserID,
+		&channels,
+		&ideaID,
+		&assigneeID,
+		&assignmentStatus,
+		&policy,
+		&reminder.EscalationLevel,
+		&reminder.AcknowledgedAt,
+		&progressID,
+		&milestoneID,
+		&completesReminder,
+		&reminder.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder.Type = entities.ReminderType(reminderType)
+	reminder.Status = entities.ReminderStatus(status)
+	reminder.RecurrencePattern = entities.RecurrencePattern(pattern)
+	reminder.AssignmentStatus = entities.ReminderAssignmentStatus(assignmentStatus)
+	reminder.NotificationChannels = []string(channels)
+	if ideaID != nil {
+		reminder.IdeaID = *ideaID
+	}
+	if assigneeID != nil {
+		reminder.AssigneeID = *assigneeID
+	}
+	if policy != nil {
+		if reminder.EscalationPolicy, err = decodeEscalationPolicy(policy); err != nil {
+			return nil, fmt.Errorf("invalid escalation_policy: %w", err)
+		}
+	}
+	if milestoneID != nil && progressID != nil {
+		reminder.MilestoneLink = &entities.ReminderMilestoneLink{
+			ProgressID:        *progressID,
+			MilestoneID:       *milestoneID,
+			CompletesReminder: completesReminder,
+		}
+	}
+
+	return &reminder, nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/circuitbreaker"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryConfig configura los reintentos ante errores transitorios de PostgreSQL
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Retrier reintenta operaciones con backoff exponencial con jitter y falla rápido
+// mientras el circuit breaker "postgres" esté abierto
+type Retrier struct {
+	config  RetryConfig
+	breaker *circuitbreaker.CircuitBreaker
+}
+
+// NewRetrier crea un nuevo Retrier aplicando valores por defecto
+func NewRetrier(config RetryConfig, breakers *circuitbreaker.Registry) *Retrier {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 3
+	}
+	if config.BaseDelay <= 0 {
+		config.BaseDelay = 50 * time.Millisecond
+	}
+	if config.MaxDelay <= 0 {
+		config.MaxDelay = 2 * time.Second
+	}
+	return &Retrier{
+		config: config,
+		// Solo los errores transitorios cuentan como fallo; los errores de dominio
+		// (no encontrado, conflicto, etc.) no indican una base de datos caída
+		breaker: breakers.Get(circuitbreaker.BreakerConfig{
+			Name:      "postgres",
+			IsFailure: isTransient,
+		}),
+	}
+}
+
+// Do ejecuta op reintentando errores transitorios. Las operaciones no idempotentes
+// solo se reintentan cuando la sentencia no llegó a aplicarse.
+func (r *Retrier) Do(ctx context.Context, idempotent bool, op func() error) error {
+	err := r.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		for attempt := 0; attempt < r.config.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-time.After(r.backoff(attempt)):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			err = op()
+			if err == nil || !isRetryable(err, idempotent) {
+				break
+			}
+		}
+		return err
+	})
+
+	if errors.Is(err, circuitbreaker.ErrCircuitOpen) || errors.Is(err, circuitbreaker.ErrTooManyRequests) {
+		return entities.ErrServiceUnavailable
+	}
+	return err
+}
+
+func (r *Retrier) backoff(attempt int) time.Duration {
+	delay := r.config.BaseDelay << uint(attempt-1)
+	if delay <= 0 || delay > r.config.MaxDelay {
+		delay = r.config.MaxDelay
+	}
+	// Full jitter para evitar que todas las réplicas reintenten a la vez tras un failover
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+// isTransient indica si el error se debe a una condición pasajera del servidor o de la red
+func isTransient(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", "40P01": // serialization_failure, deadlock_detected
+			return true
+		case "57P01", "57P02", "57P03": // admin_shutdown, crash_shutdown, cannot_connect_now
+			return true
+		}
+		return strings.HasPrefix(pgErr.Code, "08") // connection_exception
+	}
+
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// isRetryable decide si reintentar: las operaciones no idempotentes solo se reintentan si la
+// transacción fue abortada por el servidor o la sentencia no llegó a enviarse
+func isRetryable(err error, idempotent bool) bool {
+	if !isTransient(err) {
+		return false
+	}
+	if idempotent {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "40001" || pgErr.Code == "40P01"
+	}
+	return pgconn.SafeToRetry(err)
+}
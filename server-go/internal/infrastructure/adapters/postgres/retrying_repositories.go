@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// Los decoradores de reintento envuelven repositorios ligados al pool; los repositorios
+// de una transacción (ports.Tx) no deben envolverse porque un reintento parcial rompería la atomicidad.
+
+type retryingIdeaRepository struct {
+	next    ports.IdeaRepository
+	retrier *Retrier
+}
+
+var _ ports.IdeaRepository = (*retryingIdeaRepository)(nil)
+
+// NewRetryingIdeaRepository envuelve un repositorio de ideas con reintentos ante errores transitorios
+func NewRetryingIdeaRepository(next ports.IdeaRepository, retrier *Retrier) ports.IdeaRepository {
+	return &retryingIdeaRepository{next: next, retrier: retrier}
+}
+
+func (r *retryingIdeaRepository) Create(ctx context.Context, idea *entities.Idea) error {
+	return r.retrier.Do(ctx, false, func() error {
+		return r.next.Create(ctx, idea)
+	})
+}
+
+func (r *retryingIdeaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Idea, error) {
+	var idea *entities.Idea
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		idea, err = r.next.GetByID(ctx, id)
+		return err
+	})
+	return idea, err
+}
+
+func (r *retryingIdeaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
+	var ideas []*entities.Idea
+	var total int
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		ideas, total, err = r.next.GetByUserID(ctx, userID, filters)
+		return err
+	})
+	return ideas, total, err
+}
+
+func (r *retryingIdeaRepository) Update(ctx context.Context, idea *entities.Idea) error {
+	// El control de versión hace que un reintento tras un commit incierto termine en conflicto, no en doble escritura
+	return r.retrier.Do(ctx, false, func() error {
+		return r.next.Update(ctx, idea)
+	})
+}
+
+func (r *retryingIdeaRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.retrier.Do(ctx, true, func() error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *retryingIdeaRepository) ListByStatus(ctx context.Context, statuses []entities.IdeaStatus, afterID uuid.UUID, limit int) ([]*entities.Idea, error) {
+	var ideas []*entities.Idea
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		ideas, err = r.next.ListByStatus(ctx, statuses, afterID, limit)
+		return err
+	})
+	return ideas, err
+}
+
+func (r *retryingIdeaRepository) Search(ctx context.Context, userID uuid.UUID, query string, limit int) ([]*entities.Idea, error) {
+	var ideas []*entities.Idea
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		ideas, err = r.next.Search(ctx, userID, query, limit)
+		return err
+	})
+	return ideas, err
+}
+
+type retryingFileRepository struct {
+	next    ports.FileRepository
+	retrier *Retrier
+}
+
+var _ ports.FileRepository = (*retryingFileRepository)(nil)
+
+// NewRetryingFileRepository envuelve un repositorio de archivos con reintentos ante errores transitorios
+func NewRetryingFileRepository(next ports.FileRepository, retrier *Retrier) ports.FileRepository {
+	return &retryingFileRepository{next: next, retrier: retrier}
+}
+
+func (r *retryingFileRepository) Create(ctx context.Context, fileInfo *entities.FileInfo) error {
+	return r.retrier.Do(ctx, false, func() error {
+		return r.next.Create(ctx, fileInfo)
+	})
+}
+
+func (r *retryingFileRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.FileInfo, error) {
+	var fileInfo *entities.FileInfo
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		fileInfo, err = r.next.GetByID(ctx, id)
+		return err
+	})
+	return fileInfo, err
+}
+
+func (r *retryingFileRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.FileFilters) ([]*entities.FileInfo, int, error) {
+	var files []*entities.FileInfo
+	var total int
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		files, total, err = r.next.GetByUserID(ctx, userID, filters)
+		return err
+	})
+	return files, total, err
+}
+
+func (r *retryingFileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.retrier.Do(ctx, true, func() error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *retryingFileRepository) GetLatestVersion(ctx context.Context, userID uuid.UUID, filename string) (*entities.FileInfo, error) {
+	var fileInfo *entities.FileInfo
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		fileInfo, err = r.next.GetLatestVersion(ctx, userID, filename)
+		return err
+	})
+	return fileInfo, err
+}
+
+func (r *retryingFileRepository) ListVersions(ctx context.Context, logicalID uuid.UUID) ([]*entities.FileInfo, error) {
+	var versions []*entities.FileInfo
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		versions, err = r.next.ListVersions(ctx, logicalID)
+		return err
+	})
+	return versions, err
+}
+
+func (r *retryingFileRepository) CountByPath(ctx context.Context, path string) (int, error) {
+	var count int
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		count, err = r.next.CountByPath(ctx, path)
+		return err
+	})
+	return count, err
+}
+
+func (r *retryingFileRepository) FindByChecksum(ctx context.Context, userID uuid.UUID, checksum string, size int64) (*entities.FileInfo, error) {
+	var fileInfo *entities.FileInfo
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		fileInfo, err = r.next.FindByChecksum(ctx, userID, checksum, size)
+		return err
+	})
+	return fileInfo, err
+}
+
+func (r *retryingFileRepository) GetStorageUsage(ctx context.Context, userID uuid.UUID) (*ports.StorageUsage, error) {
+	var usage *ports.StorageUsage
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		usage, err = r.next.GetStorageUsage(ctx, userID)
+		return err
+	})
+	return usage, err
+}
+
+func (r *retryingFileRepository) ListAll(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.FileInfo, error) {
+	var files []*entities.FileInfo
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		files, err = r.next.ListAll(ctx, afterID, limit)
+		return err
+	})
+	return files, err
+}
+
+func (r *retryingFileRepository) ListTierCandidates(ctx context.Context, tier entities.StorageTier, before time.Time, limit int) ([]string, error) {
+	var paths []string
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		paths, err = r.next.ListTierCandidates(ctx, tier, before, limit)
+		return err
+	})
+	return paths, err
+}
+
+func (r *retryingFileRepository) MoveStorage(ctx context.Context, oldPath, newPath string, tier entities.StorageTier, movedAt time.Time) (int, error) {
+	var moved int
+	err := r.retrier.Do(ctx, false, func() error {
+		var err error
+		moved, err = r.next.MoveStorage(ctx, oldPath, newPath, tier, movedAt)
+		return err
+	})
+	return moved, err
+}
+
+func (r *retryingFileRepository) GetTierUsage(ctx context.Context) ([]ports.TierUsage, error) {
+	var tiers []ports.TierUsage
+	err := r.retrier.Do(ctx, true, func() error {
+		var err error
+		tiers, err = r.next.GetTierUsage(ctx)
+		return err
+	})
+	return tiers, err
+}
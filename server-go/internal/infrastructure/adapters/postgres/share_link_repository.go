@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const shareLinkColumns = `id, file_id, user_id, token_hash, password_hash, expires_at, max_downloads, download_count, created_at, revoked_at`
+
+type shareLinkRepository struct {
+	db querier
+}
+
+var _ ports.ShareLinkRepository = (*shareLinkRepository)(nil)
+
+// NewShareLinkRepository crea un nuevo repositorio de enlaces de descarga compartida
+func NewShareLinkRepository(db *pgxpool.Pool) ports.ShareLinkRepository {
+	return &shareLinkRepository{db: db}
+}
+
+// Create registra un enlace compartido
+func (r *shareLinkRepository) Create(ctx context.Context, link *entities.ShareLink) error {
+	query := `
+		INSERT INTO file_share_links (` + shareLinkColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		link.ID,
+		link.FileID,
+		link.UserID,
+		link.TokenHash,
+		link.PasswordHash,
+		link.ExpiresAt,
+		link.MaxDownloads,
+		link.DownloadCount,
+		link.CreatedAt,
+		link.RevokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un enlace compartido por su ID
+func (r *shareLinkRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ShareLink, error) {
+	return r.getOne(ctx, `SELECT `+shareLinkColumns+` FROM file_share_links WHERE id = $1`, id)
+}
+
+// GetByTokenHash obtiene un enlace compartido por el hash de su token
+func (r *shareLinkRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.ShareLink, error) {
+	return r.getOne(ctx, `SELECT `+shareLinkColumns+` FROM file_share_links WHERE token_hash = $1`, tokenHash)
+}
+
+// ListByFileID obtiene los enlaces de un archivo, del más reciente al más antiguo
+func (r *shareLinkRepository) ListByFileID(ctx context.Context, fileID uuid.UUID) ([]*entities.ShareLink, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+shareLinkColumns+` FROM file_share_links WHERE file_id = $1 ORDER BY created_at DESC`,
+		fileID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*entities.ShareLink
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate share links: %w", err)
+	}
+
+	return links, nil
+}
+
+// Revoke marca un enlace compartido como revocado; revocar dos veces conserva la primera fecha
+func (r *shareLinkRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE file_share_links SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1`,
+		id, revokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrShareLinkNotFound
+	}
+
+	return nil
+}
+
+// IncrementDownloads suma una descarga si el enlace no alcanzó su límite
+func (r *shareLinkRepository) IncrementDownloads(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE file_share_links
+		SET download_count = download_count + 1
+		WHERE id = $1 AND (max_downloads = 0 OR download_count < max_downloads)
+	`
+
+	tag, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to increment share link downloads: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return entities.ErrShareLinkDownloadLimitReached
+	}
+
+	return nil
+}
+
+// RecordAccess guarda una entrada de auditoría de un intento de descarga
+func (r *shareLinkRepository) RecordAccess(ctx context.Context, access *entities.ShareLinkAccess) error {
+	query := `
+		INSERT INTO file_share_link_access (id, link_id, outcome, client_ip, user_agent, accessed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		access.ID,
+		access.LinkID,
+		string(access.Outcome),
+		access.ClientIP,
+		access.UserAgent,
+		access.AccessedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record share link access: %w", err)
+	}
+
+	return nil
+}
+
+func (r *shareLinkRepository) getOne(ctx context.Context, query string, arg any) (*entities.ShareLink, error) {
+	link, err := scanShareLink(r.db.QueryRow(ctx, query, arg))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+
+	return link, nil
+}
+
+func scanShareLink(row pgx.Row) (*entities.ShareLink, error) {
+	var link entities.ShareLink
+	err := row.Scan(
+		&link.ID,
+		&link.FileID,
+		&link.UserID,
+		&link.TokenHash,
+		&link.PasswordHash,
+		&link.ExpiresAt,
+		&link.MaxDownloads,
+		&link.DownloadCount,
+		&link.CreatedAt,
+		&link.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
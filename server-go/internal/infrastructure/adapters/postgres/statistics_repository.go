@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type statisticsRepository struct {
+	db querier
+}
+
+var _ ports.StatisticsRepository = (*statisticsRepository)(nil)
+
+// NewStatisticsRepository crea un nuevo repositorio de estadísticas materializadas
+func NewStatisticsRepository(db *pgxpool.Pool) ports.StatisticsRepository {
+	return &statisticsRepository{db: db}
+}
+
+// Get obtiene las estadísticas materializadas de un usuario
+func (r *statisticsRepository) Get(ctx context.Context, userID uuid.UUID) (*entities.UserStatistics, error) {
+	query := `
+		SELECT user_id, ideas_by_status, ideas_by_category, reminders_due_this_week, week_start,
+		       storage_files, storage_bytes, updated_at
+		FROM user_statistics
+		WHERE user_id = $1
+	`
+
+	var stats entities.UserStatistics
+	var byStatus, byCategory []byte
+	var weekStart *time.Time
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&stats.UserID,
+		&byStatus,
+		&byCategory,
+		&stats.RemindersDueThisWeek,
+		&weekStart,
+		&stats.StorageFiles,
+		&stats.StorageBytes,
+		&stats.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrStatisticsNotFound
+		}
+		return nil, fmt.Errorf("failed to get statistics: %w", err)
+	}
+
+	if err := json.Unmarshal(byStatus, &stats.IdeasByStatus); err != nil {
+		return nil, fmt.Errorf("invalid ideas_by_status: %w", err)
+	}
+	if err := json.Unmarshal(byCategory, &stats.IdeasByCategory); err != nil {
+		return nil, fmt.Errorf("invalid ideas_by_category: %w", err)
+	}
+	// Sin semana calculada queda en cero y se trata como desactualizada
+	if weekStart != nil {
+		stats.WeekStart = *weekStart
+	}
+
+	return &stats, nil
+}
+
+// RefreshIdeas recalcula los conteos de ideas por estado y por categoría de un usuario
+func (r *statisticsRepository) RefreshIdeas(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	rows, err := r.db.Query(ctx,
+		`SELECT status, category, COUNT(*) FROM ideas WHERE user_id = $1 GROUP BY status, category`,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to count ideas: %w", err)
+	}
+	defer rows.Close()
+
+	byStatus := make(map[entities.IdeaStatus]int)
+	byCategory := make(map[entities.IdeaCategory]int)
+	for rows.Next() {
+		var status, category, count int
+		if err := rows.Scan(&status, &category, &count); err != nil {
+			return fmt.Errorf("failed to scan idea counts: %w", err)
+		}
+		byStatus[entities.IdeaStatus(status)] += count
+		byCategory[entities.IdeaCategory(category)] += count
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating idea counts: %w", err)
+	}
+
+	statusJSON, err := json.Marshal(byStatus)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea counts: %w", err)
+	}
+	categoryJSON, err := json.Marshal(byCategory)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea counts: %w", err)
+	}
+
+	query := `
+		INSERT INTO user_statistics (user_id, ideas_by_status, ideas_by_category, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			ideas_by_status = EXCLUDED.ideas_by_status, ideas_by_category = EXCLUDED.ideas_by_category,
+			updated_at = EXCLUDED.updated_at
+	`
+	if _, err := r.db.Exec(ctx, query, userID, statusJSON, categoryJSON, now); err != nil {
+		return fmt.Errorf("failed to save idea statistics: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshReminders recalcula los recordatorios pendientes de un usuario que vencen en la semana
+func (r *statisticsRepository) RefreshReminders(ctx context.Context, userID uuid.UUID, weekStart, now time.Time) error {
+	query := `
+		INSERT INTO user_statistics (user_id, reminders_due_this_week, week_start, updated_at)
+		VALUES ($1, (
+			SELECT COUNT(*) FROM reminders
+			WHERE user_id = $1 AND scheduled_time >= $2 AND scheduled_time < $3 AND status NOT IN ($4, $5)
+		), $2, $6)
+		ON CONFLICT (user_id) DO UPDATE SET
+			reminders_due_this_week = EXCLUDED.reminders_due_this_week, week_start = EXCLUDED.week_start,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		userID,
+		weekStart,
+		weekStart.AddDate(0, 0, 7),
+		int(entities.ReminderStatusCompleted),
+		int(entities.ReminderStatusCancelled),
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save reminder statistics: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshStorage recalcula los archivos y el espacio usados por un usuario; los archivos físicos
+// compartidos por varias versiones se cuentan una sola vez
+func (r *statisticsRepository) RefreshStorage(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	query := `
+		INSERT INTO user_statistics (user_id, storage_files, storage_bytes, updated_at)
+		VALUES ($1,
+			(SELECT COUNT(DISTINCT logical_id) FROM files WHERE user_id = $1),
+			(SELECT COALESCE(SUM(size), 0) FROM (SELECT DISTINCT path, size FROM files WHERE user_id = $1) AS stored),
+			$2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			storage_files = EXCLUDED.storage_files, storage_bytes = EXCLUDED.storage_bytes,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.Exec(ctx, query, userID, now); err != nil {
+		return fmt.Errorf("failed to save storage statistics: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const timeEntryColumns = `id, user_id, progress_id, milestone_id, started_at, stopped_at`
+
+type timeEntryRepository struct {
+	db querier
+}
+
+var _ ports.TimeEntryRepository = (*timeEntryRepository)(nil)
+
+// NewTimeEntryRepository crea un nuevo repositorio de registros de tiempo
+func NewTimeEntryRepository(db *pgxpool.Pool) ports.TimeEntryRepository {
+	return &timeEntryRepository{db: db}
+}
+
+// Create pone en marcha un cronómetro; el índice único parcial impide que un usuario tenga dos
+func (r *timeEntryRepository) Create(ctx context.Context, entry *entities.TimeEntry) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO time_entries (`+timeEntryColumns+`) VALUES ($1, $2, $3, $4, $5, $6)`,
+		entry.ID,
+		entry.UserID,
+		entry.ProgressID,
+		entry.MilestoneID,
+		entry.StartedAt,
+		entry.StoppedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return entities.ErrTimerAlreadyRunning
+		}
+		return fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	return nil
+}
+
+// Stop guarda el fin de un cronómetro que sigue en marcha
+func (r *timeEntryRepository) Stop(ctx context.Context, entry *entities.TimeEntry) error {
+	result, err := r.db.Exec(ctx,
+		`UPDATE time_entries SET stopped_at = $1 WHERE id = $2 AND stopped_at IS NULL`,
+		entry.StoppedAt, entry.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stop time entry: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrNoRunningTimer
+	}
+
+	return nil
+}
+
+// GetRunning obtiene el cronómetro en marcha de un usuario
+func (r *timeEntryRepository) GetRunning(ctx context.Context, userID uuid.UUID) (*entities.TimeEntry, error) {
+	entry, err := scanTimeEntry(r.db.QueryRow(ctx,
+		`SELECT `+timeEntryColumns+` FROM time_entries WHERE user_id = $1 AND stopped_at IS NULL`, userID,
+	))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("failed to get running time entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// ListByProgress obtiene los registros de tiempo de un proyecto
+func (r *timeEntryRepository) ListByProgress(ctx context.Context, progressID uuid.UUID) ([]*entities.TimeEntry, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+timeEntryColumns+` FROM time_entries WHERE progress_id = $1 ORDER BY started_at, id`, progressID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan time entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func scanTimeEntry(row pgx.Row) (*entities.TimeEntry, error) {
+	var entry entities.TimeEntry
+	err := row.Scan(&entry.ID, &entry.UserID, &entry.ProgressID, &entry.MilestoneID, &entry.StartedAt, &entry.StoppedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// querier es el subconjunto común de *pgxpool.Pool y pgx.Tx usado por los repositorios
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type unitOfWork struct {
+	db *pgxpool.Pool
+}
+
+var _ ports.UnitOfWork = (*unitOfWork)(nil)
+
+// NewUnitOfWork crea una nueva unidad de trabajo sobre el pool de conexiones
+func NewUnitOfWork(db *pgxpool.Pool) ports.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Begin inicia una nueva transacción
+func (u *unitOfWork) Begin(ctx context.Context) (ports.Tx, error) {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &pgTx{tx: tx}, nil
+}
+
+type pgTx struct {
+	tx pgx.Tx
+}
+
+var _ ports.Tx = (*pgTx)(nil)
+
+// Commit confirma la transacción
+func (t *pgTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Rollback revierte la transacción; no falla si ya fue confirmada
+func (t *pgTx) Rollback(ctx context.Context) error {
+	if err := t.tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// Ideas devuelve el repositorio de ideas ligado a la transacción
+func (t *pgTx) Ideas() ports.IdeaRepository {
+	return &ideaRepository{db: t.tx}
+}
+
+// Files devuelve el repositorio de archivos ligado a la transacción
+func (t *pgTx) Files() ports.FileRepository {
+	return &fileRepository{db: t.tx}
+}
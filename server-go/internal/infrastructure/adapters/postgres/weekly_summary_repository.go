@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type weeklySummaryRepository struct {
+	db querier
+}
+
+var _ ports.WeeklySummaryRepository = (*weeklySummaryRepository)(nil)
+
+// NewWeeklySummaryRepository crea un nuevo repositorio de resúmenes semanales
+func NewWeeklySummaryRepository(db *pgxpool.Pool) ports.WeeklySummaryRepository {
+	return &weeklySummaryRepository{db: db}
+}
+
+// CountActivity cuenta las ideas creadas y los recordatorios completados entre from y to, y los
+// recordatorios vencidos a to. Los recordatorios no guardan cuándo se completaron, así que se
+// usa su última modificación.
+func (r *weeklySummaryRepository) CountActivity(ctx context.Context, userID uuid.UUID, from, to time.Time) (entities.WeeklyActivity, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM ideas WHERE user_id = $1 AND created_at >= $2 AND created_at < $3),
+			(SELECT COUNT(*) FROM reminders WHERE user_id = $1 AND status = $4 AND updated_at >= $2 AND updated_at < $3),
+			(SELECT COUNT(*) FROM reminders WHERE user_id = $1 AND scheduled_time < $3 AND status NOT IN ($4, $5))
+	`
+
+	var activity entities.WeeklyActivity
+	err := r.db.QueryRow(ctx, query,
+		userID,
+		from,
+		to,
+		int(entities.ReminderStatusCompleted),
+		int(entities.ReminderStatusCancelled),
+	).Scan(&activity.IdeasCreated, &activity.RemindersCompleted, &activity.OverdueReminders)
+	if err != nil {
+		return entities.WeeklyActivity{}, fmt.Errorf("failed to count weekly activity: %w", err)
+	}
+
+	return activity, nil
+}
+
+// GetLatest obtiene el último resumen semanal enviado a un usuario
+func (r *weeklySummaryRepository) GetLatest(ctx context.Context, userID uuid.UUID) (*entities.WeeklySummary, error) {
+	query := `
+		SELECT user_id, period_start, period_end, ideas_created, reminders_completed, overdue_reminders,
+		       overdue_milestones, projects, sent_at
+		FROM weekly_summaries
+		WHERE user_id = $1
+	`
+
+	var summary entities.WeeklySummary
+	var projects []byte
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&summary.UserID,
+		&summary.PeriodStart,
+		&summary.PeriodEnd,
+		&summary.IdeasCreated,
+		&summary.RemindersCompleted,
+		&summary.OverdueReminders,
+		&summary.OverdueMilestones,
+		&projects,
+		&summary.SentAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entities.ErrWeeklySummaryNotFound
+		}
+		return nil, fmt.Errorf("failed to get weekly summary: %w", err)
+	}
+
+	if err := json.Unmarshal(projects, &summary.Projects); err != nil {
+		return nil, fmt.Errorf("invalid weekly summary projects: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// SaveLatest reemplaza el último resumen semanal enviado a un usuario
+func (r *weeklySummaryRepository) SaveLatest(ctx context.Context, summary *entities.WeeklySummary) error {
+	projects, err := json.Marshal(summary.Projects)
+	if err != nil {
+		return fmt.Errorf("failed to encode weekly summary projects: %w", err)
+	}
+
+	query := `
+		INSERT INTO weekly_summaries (
+			user_id, period_start, period_end, ideas_created, reminders_completed, overdue_reminders,
+			overdue_milestones, projects, sent_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id) DO UPDATE SET
+			period_start = EXCLUDED.period_start, period_end = EXCLUDED.period_end,
+			ideas_created = EXCLUDED.ideas_created, reminders_completed = EXCLUDED.reminders_completed,
+			overdue_reminders = EXCLUDED.overdue_reminders, overdue_milestones = EXCLUDED.overdue_milestones,
+			projects = EXCLUDED.projects, sent_at = EXCLUDED.sent_at
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		summary.UserID,
+		summary.PeriodStart,
+		summary.PeriodEnd,
+		summary.IdeasCreated,
+		summary.RemindersCompleted,
+		summary.OverdueReminders,
+		summary.OverdueMilestones,
+		projects,
+		summary.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save weekly summary: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const chatBindingColumns = `id, user_id, provider, chat_id, code_hash, code_expires_at, created_at, linked_at`
+
+type chatBindingRepository struct {
+	db querier
+}
+
+// NewChatBindingRepository crea un nuevo repositorio de vínculos con chats
+func NewChatBindingRepository(db *sql.DB) ports.ChatBindingRepository {
+	return &chatBindingRepository{db: db}
+}
+
+// Create registra un vínculo pendiente
+func (r *chatBindingRepository) Create(ctx context.Context, binding *entities.ChatBinding) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO chat_bindings (`+chatBindingColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		binding.ID.String(),
+		binding.UserID.String(),
+		string(binding.Provider),
+		nullString(binding.ChatID),
+		nullString(binding.CodeHash),
+		formatTime(binding.CodeExpiresAt),
+		formatTime(binding.CreatedAt),
+		nullTime(binding.LinkedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create chat binding: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un vínculo por su ID
+func (r *chatBindingRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ChatBinding, error) {
+	return r.getOne(ctx, `SELECT `+chatBindingColumns+` FROM chat_bindings WHERE id = ?`, id.String())
+}
+
+// GetPendingByCodeHash obtiene el vínculo pendiente con el código indicado
+func (r *chatBindingRepository) GetPendingByCodeHash(ctx context.Context, provider entities.ChatProvider, codeHash string) (*entities.ChatBinding, error) {
+	return r.getOne(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE provider = ? AND code_hash = ? AND linked_at IS NULL`,
+		string(provider), codeHash,
+	)
+}
+
+// GetByChat obtiene el vínculo confirmado de un chat
+func (r *chatBindingRepository) GetByChat(ctx context.Context, provider entities.ChatProvider, chatID string) (*entities.ChatBinding, error) {
+	return r.getOne(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE provider = ? AND chat_id = ?`,
+		string(provider), chatID,
+	)
+}
+
+// ListByUserID obtiene los vínculos de un usuario, del más reciente al más antiguo
+func (r *chatBindingRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.ChatBinding, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+chatBindingColumns+` FROM chat_bindings WHERE user_id = ? ORDER BY created_at DESC`,
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chat bindings: %w", err)
+	}
+	defer rows.Close()
+
+	var bindings []*entities.ChatBinding
+	for rows.Next() {
+		binding, err := scanChatBinding(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan chat binding: %w", err)
+		}
+		bindings = append(bindings, binding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate chat bindings: %w", err)
+	}
+
+	return bindings, nil
+}
+
+// Link guarda la confirmación de un vínculo pendiente
+func (r *chatBindingRepository) Link(ctx context.Context, binding *entities.ChatBinding) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE chat_bindings SET chat_id = ?, code_hash = NULL, linked_at = ? WHERE id = ? AND linked_at IS NULL`,
+		binding.ChatID, nullTime(binding.LinkedAt), binding.ID.String(),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return entities.ErrChatAlreadyBound
+		}
+		return fmt.Errorf("failed to link chat binding: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to link chat binding: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrChatBindingNotFound
+	}
+
+	return nil
+}
+
+// Delete elimina un vínculo
+func (r *chatBindingRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM chat_bindings WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete chat binding: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete chat binding: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrChatBindingNotFound
+	}
+
+	return nil
+}
+
+func (r *chatBindingRepository) getOne(ctx context.Context, query string, args ...any) (*entities.ChatBinding, error) {
+	binding, err := scanChatBinding(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrChatBindingNotFound
+		}
+		return nil, fmt.Errorf("failed to get chat binding: %w", err)
+	}
+
+	return binding, nil
+}
+
+func scanChatBinding(row scanner) (*entities.ChatBinding, error) {
+	var binding entities.ChatBinding
+	var provider, codeExpiresAt, createdAt string
+	var chatID, codeHash, linkedAt sql.NullString
+	err := row.Scan(
+		&binding.ID,
+		&binding.UserID,
+		&provider,
+		&chatID,
+		&codeHash,
+		&codeExpiresAt,
+		&createdAt,
+		&linkedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.Provider = entities.ChatProvider(provider)
+	binding.ChatID = chatID.String
+	binding.CodeHash = codeHash.String
+	if binding.CodeExpiresAt, err = parseTime(codeExpiresAt); err != nil {
+		return nil, fmt.Errorf("invalid code_expires_at: %w", err)
+	}
+	if binding.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if binding.LinkedAt, err = parseNullTime(linkedAt); err != nil {
+		return nil, fmt.Errorf("invalid linked_at: %w", err)
+	}
+
+	return &binding, nil
+}
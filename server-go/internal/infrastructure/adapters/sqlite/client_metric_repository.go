@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+const clientMetricColumns = `id, user_id, device_id, app_version, kind, name, value, message, attributes, occurred_at, received_at`
+
+// clientMetricPlaceholders son los parámetros de la fila de un evento
+const clientMetricPlaceholders = `(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+type clientMetricRepository struct {
+	db querier
+}
+
+// NewClientMetricRepository crea un nuevo repositorio de telemetría de la app
+func NewClientMetricRepository(db *sql.DB) ports.ClientMetricRepository {
+	return &clientMetricRepository{db: db}
+}
+
+// CreateBatch guarda los eventos con un único INSERT; los IDs repetidos se ignoran
+func (r *clientMetricRepository) CreateBatch(ctx context.Context, metrics []*entities.ClientMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	rows := make([]string, len(metrics))
+	var args []any
+	for i, metric := range metrics {
+		rows[i] = clientMetricPlaceholders
+
+		attributes := metric.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		encodedAttributes, err := encodeJSON(attributes)
+		if err != nil {
+			return fmt.Errorf("failed to encode attributes: %w", err)
+		}
+
+		args = append(args,
+			metric.ID.String(),
+			metric.UserID.String(),
+			metric.DeviceID,
+			metric.AppVersion,
+			string(metric.Kind),
+			metric.Name,
+			metric.Value,
+			metric.Message,
+			encodedAttributes,
+			formatTime(metric.OccurredAt),
+			formatTime(metric.ReceivedAt),
+		)
+	}
+
+	query := `INSERT INTO client_metrics (` + clientMetricColumns + `) VALUES ` + strings.Join(rows, ", ") + ` ON CONFLICT (id) DO NOTHING`
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create client metrics: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteOlderThan depura los eventos recibidos antes de cutoff
+func (r *clientMetricRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM client_metrics WHERE received_at < ?`, formatTime(cutoff))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old client metrics: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,371 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// schema crea las tablas del modo standalone; equivale al esquema de PostgreSQL
+// con las migraciones aplicadas, usando TEXT para UUIDs, fechas y arreglos JSON
+const schema = `
+CREATE TABLE IF NOT EXISTS ideas (
+	id            TEXT PRIMARY KEY,
+	title         TEXT NOT NULL,
+	content       TEXT NOT NULL,
+	tags          TEXT NOT NULL DEFAULT '[]',
+	category      INTEGER NOT NULL,
+	status        INTEGER NOT NULL,
+	created_at    TEXT NOT NULL,
+	updated_at    TEXT NOT NULL,
+	user_id       TEXT NOT NULL,
+	related_ideas TEXT NOT NULL DEFAULT '[]',
+	priority      INTEGER NOT NULL DEFAULT 0,
+	position      TEXT NOT NULL DEFAULT '',
+	custom_fields TEXT NOT NULL DEFAULT '{}',
+	version       INTEGER NOT NULL DEFAULT 1,
+	compacted_at  TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_ideas_user_id ON ideas (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_ideas_status_id ON ideas (status, id);
+CREATE INDEX IF NOT EXISTS idx_ideas_board ON ideas (user_id, status, position);
+CREATE INDEX IF NOT EXISTS idx_ideas_compaction ON ideas (updated_at) WHERE status = 5 AND compacted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS reminders (
+	id                    TEXT PRIMARY KEY,
+	title                 TEXT NOT NULL,
+	description           TEXT NOT NULL,
+	scheduled_time        TEXT NOT NULL,
+	type                  INTEGER NOT NULL,
+	status                INTEGER NOT NULL,
+	recurring             INTEGER NOT NULL DEFAULT 0,
+	recurrence_pattern    INTEGER NOT NULL DEFAULT 0,
+	created_at            TEXT NOT NULL,
+	updated_at            TEXT NOT NULL,
+	user_id               TEXT NOT NULL,
+	notification_channels TEXT NOT NULL DEFAULT '[]',
+	idea_id               TEXT REFERENCES ideas (id) ON DELETE SET NULL,
+	assignee_id           TEXT,
+	assignment_status     INTEGER NOT NULL DEFAULT 0,
+	escalation_policy     TEXT,
+	escalation_level      INTEGER NOT NULL DEFAULT 0,
+	acknowledged_at       TEXT,
+	progress_id           TEXT,
+	milestone_id          TEXT,
+	completes_reminder    INTEGER NOT NULL DEFAULT 0,
+	version               INTEGER NOT NULL DEFAULT 1
+);
+CREATE INDEX IF NOT EXISTS idx_reminders_user_id ON reminders (user_id, scheduled_time);
+CREATE INDEX IF NOT EXISTS idx_reminders_status ON reminders (status, scheduled_time);
+CREATE INDEX IF NOT EXISTS idx_reminders_idea_id ON reminders (idea_id, scheduled_time) WHERE idea_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_reminders_assignee_id ON reminders (assignee_id, scheduled_time) WHERE assignee_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_reminders_escalation ON reminders (scheduled_time) WHERE escalation_policy IS NOT NULL AND acknowledged_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_reminders_milestone_id ON reminders (milestone_id) WHERE milestone_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS files (
+	id               TEXT PRIMARY KEY,
+	filename         TEXT NOT NULL,
+	content_type     TEXT NOT NULL,
+	size             INTEGER NOT NULL,
+	checksum         TEXT NOT NULL,
+	created_at       TEXT NOT NULL,
+	user_id          TEXT NOT NULL,
+	compressed       INTEGER NOT NULL DEFAULT 0,
+	compression_type TEXT NOT NULL DEFAULT '',
+	path             TEXT NOT NULL,
+	logical_id       TEXT NOT NULL,
+	version          INTEGER NOT NULL DEFAULT 1,
+	checksum_algorithm TEXT NOT NULL DEFAULT '',
+	preview          TEXT,
+	storage_tier     TEXT NOT NULL DEFAULT 'hot',
+	tier_changed_at  TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_files_user_id ON files (user_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_files_logical_version ON files (logical_id, version);
+CREATE INDEX IF NOT EXISTS idx_files_user_filename ON files (user_id, filename, version);
+CREATE INDEX IF NOT EXISTS idx_files_storage_tier_path ON files (storage_tier, path);
+CREATE INDEX IF NOT EXISTS idx_files_user_checksum ON files (user_id, checksum, size);
+
+CREATE TABLE IF NOT EXISTS progress (
+	id                    TEXT PRIMARY KEY,
+	user_id               TEXT NOT NULL,
+	project_name          TEXT NOT NULL,
+	description           TEXT NOT NULL,
+	completion_percentage REAL NOT NULL DEFAULT 0,
+	milestones            TEXT NOT NULL DEFAULT '[]',
+	custom_fields         TEXT NOT NULL DEFAULT '{}',
+	created_at            TEXT NOT NULL,
+	updated_at            TEXT NOT NULL,
+	version               INTEGER NOT NULL DEFAULT 1
+);
+CREATE INDEX IF NOT EXISTS idx_progress_user_id ON progress (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS notification_inbox (
+	seq        INTEGER PRIMARY KEY AUTOINCREMENT,
+	id         TEXT NOT NULL UNIQUE,
+	user_id    TEXT NOT NULL,
+	title      TEXT NOT NULL,
+	message    TEXT NOT NULL,
+	type       TEXT NOT NULL,
+	channels   TEXT NOT NULL DEFAULT '[]',
+	metadata   TEXT NOT NULL DEFAULT '{}',
+	created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_notification_inbox_user_seq ON notification_inbox (user_id, seq);
+CREATE INDEX IF NOT EXISTS idx_notification_inbox_created_at ON notification_inbox (created_at);
+
+CREATE TABLE IF NOT EXISTS file_share_links (
+	id             TEXT PRIMARY KEY,
+	file_id        TEXT NOT NULL REFERENCES files (id) ON DELETE CASCADE,
+	user_id        TEXT NOT NULL,
+	token_hash     TEXT NOT NULL UNIQUE,
+	password_hash  TEXT NOT NULL DEFAULT '',
+	expires_at     TEXT NOT NULL,
+	max_downloads  INTEGER NOT NULL DEFAULT 0,
+	download_count INTEGER NOT NULL DEFAULT 0,
+	created_at     TEXT NOT NULL,
+	revoked_at     TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_file_share_links_file_id ON file_share_links (file_id, created_at);
+
+CREATE TABLE IF NOT EXISTS file_share_link_access (
+	id          TEXT PRIMARY KEY,
+	link_id     TEXT NOT NULL,
+	outcome     TEXT NOT NULL,
+	client_ip   TEXT NOT NULL DEFAULT '',
+	user_agent  TEXT NOT NULL DEFAULT '',
+	accessed_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_file_share_link_access_link_id ON file_share_link_access (link_id, accessed_at);
+
+CREATE TABLE IF NOT EXISTS file_texts (
+	file_id      TEXT PRIMARY KEY REFERENCES files (id) ON DELETE CASCADE,
+	user_id      TEXT NOT NULL,
+	content      TEXT NOT NULL,
+	extractor    TEXT NOT NULL,
+	extracted_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS idea_embeddings (
+	idea_id      TEXT PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+	user_id      TEXT NOT NULL,
+	model        TEXT NOT NULL,
+	embedding    TEXT NOT NULL,
+	content_hash TEXT NOT NULL,
+	embedded_at  TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_embeddings_user_model ON idea_embeddings (user_id, model);
+
+CREATE TABLE IF NOT EXISTS idea_reviews (
+	idea_id          TEXT PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+	user_id          TEXT NOT NULL,
+	ease_factor      REAL NOT NULL,
+	interval_days    INTEGER NOT NULL DEFAULT 0,
+	repetitions      INTEGER NOT NULL DEFAULT 0,
+	due_at           TEXT NOT NULL,
+	last_reviewed_at TEXT,
+	enrolled_at      TEXT NOT NULL,
+	version          INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_reviews_user_due ON idea_reviews (user_id, due_at);
+
+CREATE TABLE IF NOT EXISTS idea_publications (
+	id             TEXT PRIMARY KEY,
+	idea_id        TEXT NOT NULL REFERENCES ideas (id) ON DELETE CASCADE,
+	user_id        TEXT NOT NULL,
+	slug           TEXT NOT NULL UNIQUE,
+	expires_at     TEXT,
+	view_count     INTEGER NOT NULL DEFAULT 0,
+	last_viewed_at TEXT,
+	created_at     TEXT NOT NULL,
+	unpublished_at TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_publications_user_id ON idea_publications (user_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_idea_publications_current ON idea_publications (idea_id) WHERE unpublished_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS inbound_addresses (
+	id              TEXT PRIMARY KEY,
+	user_id         TEXT NOT NULL,
+	token_hash      TEXT NOT NULL UNIQUE,
+	allowed_senders TEXT NOT NULL DEFAULT '[]',
+	created_at      TEXT NOT NULL,
+	last_used_at    TEXT,
+	revoked_at      TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_inbound_addresses_user_id ON inbound_addresses (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS chat_bindings (
+	id              TEXT PRIMARY KEY,
+	user_id         TEXT NOT NULL,
+	provider        TEXT NOT NULL,
+	chat_id         TEXT,
+	code_hash       TEXT,
+	code_expires_at TEXT NOT NULL,
+	created_at      TEXT NOT NULL,
+	linked_at       TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_chat_bindings_user_id ON chat_bindings (user_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_bindings_chat ON chat_bindings (provider, chat_id) WHERE chat_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_bindings_code ON chat_bindings (provider, code_hash) WHERE code_hash IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS user_locales (
+	user_id    TEXT PRIMARY KEY,
+	locale     TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS user_phone_numbers (
+	user_id         TEXT PRIMARY KEY,
+	number          TEXT NOT NULL,
+	code_hash       TEXT,
+	code_expires_at TEXT NOT NULL,
+	attempts        INTEGER NOT NULL DEFAULT 0,
+	verified_at     TEXT,
+	created_at      TEXT NOT NULL,
+	updated_at      TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sms_usage (
+	user_id TEXT NOT NULL,
+	day     TEXT NOT NULL,
+	count   INTEGER NOT NULL,
+	PRIMARY KEY (user_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS custom_fields (
+	id          TEXT PRIMARY KEY,
+	user_id     TEXT NOT NULL,
+	entity_type INTEGER NOT NULL,
+	key         TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	field_type  INTEGER NOT NULL,
+	options     TEXT NOT NULL DEFAULT '[]',
+	created_at  TEXT NOT NULL,
+	UNIQUE (user_id, entity_type, key)
+);
+
+CREATE TABLE IF NOT EXISTS idea_archives (
+	idea_id     TEXT PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+	bundle      BLOB NOT NULL,
+	archived_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS user_statistics (
+	user_id                 TEXT PRIMARY KEY,
+	ideas_by_status         TEXT NOT NULL DEFAULT '{}',
+	ideas_by_category       TEXT NOT NULL DEFAULT '{}',
+	reminders_due_this_week INTEGER NOT NULL DEFAULT 0,
+	week_start              TEXT,
+	storage_files           INTEGER NOT NULL DEFAULT 0,
+	storage_bytes           INTEGER NOT NULL DEFAULT 0,
+	updated_at              TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS client_metrics (
+	id          TEXT PRIMARY KEY,
+	user_id     TEXT NOT NULL,
+	device_id   TEXT NOT NULL,
+	app_version TEXT NOT NULL,
+	kind        TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	value       REAL NOT NULL,
+	message     TEXT NOT NULL,
+	attributes  TEXT NOT NULL DEFAULT '{}',
+	occurred_at TEXT NOT NULL,
+	received_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_client_metrics_kind_occurred ON client_metrics (kind, name, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_client_metrics_received_at ON client_metrics (received_at);
+
+CREATE TABLE IF NOT EXISTS moderation_rejections (
+	id           TEXT PRIMARY KEY,
+	user_id      TEXT NOT NULL,
+	organization TEXT NOT NULL DEFAULT '',
+	scope        TEXT NOT NULL,
+	entity_type  TEXT NOT NULL,
+	entity_id    TEXT NOT NULL,
+	reason       TEXT NOT NULL,
+	matches      TEXT NOT NULL DEFAULT '[]',
+	moderator    TEXT NOT NULL,
+	created_at   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_moderation_rejections_org_created ON moderation_rejections (organization, created_at);
+
+CREATE TABLE IF NOT EXISTS user_emails (
+	user_id        TEXT PRIMARY KEY,
+	address        TEXT NOT NULL,
+	weekly_summary INTEGER NOT NULL DEFAULT 0,
+	updated_at     TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS weekly_summaries (
+	user_id             TEXT PRIMARY KEY,
+	period_start        TEXT NOT NULL,
+	period_end          TEXT NOT NULL,
+	ideas_created       INTEGER NOT NULL,
+	reminders_completed INTEGER NOT NULL,
+	overdue_reminders   INTEGER NOT NULL,
+	overdue_milestones  INTEGER NOT NULL,
+	projects            TEXT NOT NULL DEFAULT '[]',
+	sent_at             TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS time_entries (
+	id           TEXT PRIMARY KEY,
+	user_id      TEXT NOT NULL,
+	progress_id  TEXT NOT NULL REFERENCES progress (id) ON DELETE CASCADE,
+	milestone_id TEXT NOT NULL,
+	started_at   TEXT NOT NULL,
+	stopped_at   TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_time_entries_progress ON time_entries (progress_id, started_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE stopped_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS progress_templates (
+	id           TEXT PRIMARY KEY,
+	owner_id     TEXT NOT NULL,
+	name         TEXT NOT NULL,
+	description  TEXT NOT NULL,
+	milestones   TEXT NOT NULL DEFAULT '[]',
+	organization TEXT NOT NULL DEFAULT '',
+	created_at   TEXT NOT NULL,
+	updated_at   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_progress_templates_owner ON progress_templates (owner_id, name);
+CREATE INDEX IF NOT EXISTS idx_progress_templates_organization ON progress_templates (organization, name) WHERE organization <> '';
+`
+
+// NewConnection abre (o crea) la base de datos SQLite en la ruta indicada y aplica el esquema
+func NewConnection(path string) (*sql.DB, error) {
+	params := url.Values{}
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	params.Add("_pragma", "foreign_keys(1)")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to apply schema: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,237 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const customFieldColumns = `id, user_id, entity_type, key, name, field_type, options, created_at`
+
+// customFieldTypeNames son los nombres con los que se guarda el tipo de cada valor en la columna
+// custom_fields, para poder filtrar sin consultar las definiciones
+var customFieldTypeNames = map[entities.CustomFieldType]string{
+	entities.CustomFieldTypeText:   "text",
+	entities.CustomFieldTypeNumber: "number",
+	entities.CustomFieldTypeDate:   "date",
+	entities.CustomFieldTypeEnum:   "enum",
+}
+
+var customFieldOperators = map[entities.CustomFieldOperator]string{
+	entities.CustomFieldOperatorEqual:          "=",
+	entities.CustomFieldOperatorLess:           "<",
+	entities.CustomFieldOperatorLessOrEqual:    "<=",
+	entities.CustomFieldOperatorGreater:        ">",
+	entities.CustomFieldOperatorGreaterOrEqual: ">=",
+}
+
+// customFieldRecord es la representación JSON de un valor dentro de la columna custom_fields; las
+// fechas se guardan como AAAA-MM-DD para que se comparen como texto
+type customFieldRecord struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type customFieldRepository struct {
+	db querier
+}
+
+// NewCustomFieldRepository crea un nuevo repositorio de definiciones de campos personalizados
+func NewCustomFieldRepository(db *sql.DB) ports.CustomFieldRepository {
+	return &customFieldRepository{db: db}
+}
+
+// Create guarda la definición de un campo personalizado
+func (r *customFieldRepository) Create(ctx context.Context, definition *entities.CustomFieldDefinition) error {
+	options, err := encodeJSON(definition.Options)
+	if err != nil {
+		return fmt.Errorf("failed to encode custom field: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO custom_fields (`+customFieldColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		definition.ID.String(),
+		definition.UserID.String(),
+		int(definition.EntityType),
+		definition.Key,
+		definition.Name,
+		int(definition.Type),
+		options,
+		formatTime(definition.CreatedAt),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return entities.ErrCustomFieldKeyExists
+		}
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene la definición de un campo personalizado
+func (r *customFieldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CustomFieldDefinition, error) {
+	definition, err := scanCustomField(r.db.QueryRowContext(ctx,
+		`SELECT `+customFieldColumns+` FROM custom_fields WHERE id = ?`, id.String(),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrCustomFieldNotFound
+		}
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+
+	return definition, nil
+}
+
+// ListByUserID obtiene los campos que un usuario definió para un tipo de registro
+func (r *customFieldRepository) ListByUserID(ctx context.Context, userID uuid.UUID, entityType entities.CustomFieldEntity) ([]*entities.CustomFieldDefinition, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+customFieldColumns+` FROM custom_fields WHERE user_id = ? AND entity_type = ? ORDER BY created_at`,
+		userID.String(), int(entityType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query custom fields: %w", err)
+	}
+	defer rows.Close()
+
+	var definitions []*entities.CustomFieldDefinition
+	for rows.Next() {
+		definition, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan custom field: %w", err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating custom fields: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// Delete elimina la definición de un campo personalizado
+func (r *customFieldRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM custom_fields WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+func scanCustomField(row scanner) (*entities.CustomFieldDefinition, error) {
+	var definition entities.CustomFieldDefinition
+	var entityType, fieldType int
+	var options, createdAt string
+
+	err := row.Scan(
+		&definition.ID,
+		&definition.UserID,
+		&entityType,
+		&definition.Key,
+		&definition.Name,
+		&fieldType,
+		&options,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	definition.EntityType = entities.CustomFieldEntity(entityType)
+	definition.Type = entities.CustomFieldType(fieldType)
+	if err := decodeJSON(options, &definition.Options); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	if definition.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+
+	return &definition, nil
+}
+
+func encodeCustomFields(fields entities.CustomFields) (string, error) {
+	records := make(map[string]customFieldRecord, len(fields))
+	for key, value := range fields {
+		records[key] = customFieldRecord{Type: customFieldTypeNames[value.Type], Value: customFieldJSONValue(value)}
+	}
+	return encodeJSON(records)
+}
+
+func decodeCustomFields(data string) (entities.CustomFields, error) {
+	var records map[string]customFieldRecord
+	if err := decodeJSON(data, &records); err != nil {
+		return nil, err
+	}
+
+	fields := make(entities.CustomFields, len(records))
+	for key, record := range records {
+		var value entities.CustomFieldValue
+		for fieldType, name := range customFieldTypeNames {
+			if name == record.Type {
+				value.Type = fieldType
+			}
+		}
+		switch raw := record.Value.(type) {
+		case float64:
+			value.Number = raw
+		case string:
+			if value.Type != entities.CustomFieldTypeDate {
+				value.Text = raw
+				break
+			}
+			date, err := time.Parse(time.DateOnly, raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date in custom field %q: %w", key, err)
+			}
+			value.Date = date
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+// customFieldJSONValue es el valor tal como se guarda en JSON y se compara en los filtros
+func customFieldJSONValue(value entities.CustomFieldValue) any {
+	switch value.Type {
+	case entities.CustomFieldTypeNumber:
+		return value.Number
+	case entities.CustomFieldTypeDate:
+		return value.Date.Format(time.DateOnly)
+	default:
+		return value.Text
+	}
+}
+
+// customFieldConditions traduce los filtros a condiciones sobre la columna custom_fields. Los
+// filtros se vuelven a validar porque las claves se usan como ruta JSON y un operador desconocido
+// dejaría la condición incompleta.
+func customFieldConditions(filters []entities.CustomFieldFilter) (string, []any, error) {
+	var conditions string
+	var args []any
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return "", nil, err
+		}
+		path := `$.` + filter.Key
+		conditions += ` AND json_extract(custom_fields, ?) = ? AND json_extract(custom_fields, ?) ` + customFieldOperators[filter.Operator] + ` ?`
+		args = append(args, path+`.type`, customFieldTypeNames[filter.Value.Type], path+`.value`, customFieldJSONValue(filter.Value))
+	}
+	return conditions, args, nil
+}
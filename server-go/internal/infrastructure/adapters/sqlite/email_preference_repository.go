@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type emailPreferenceRepository struct {
+	db querier
+}
+
+// NewEmailPreferenceRepository crea un nuevo repositorio de direcciones de email
+func NewEmailPreferenceRepository(db *sql.DB) ports.EmailPreferenceRepository {
+	return &emailPreferenceRepository{db: db}
+}
+
+// Get obtiene la dirección de email de un usuario
+func (r *emailPreferenceRepository) Get(ctx context.Context, userID uuid.UUID) (*entities.EmailPreference, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT user_id, address, weekly_summary, updated_at FROM user_emails WHERE user_id = ?`, userID.String(),
+	)
+	preference, err := scanEmailPreference(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrEmailPreferenceNotFound
+		}
+		return nil, fmt.Errorf("failed to get email preference: %w", err)
+	}
+
+	return preference, nil
+}
+
+// Upsert guarda la dirección de email de un usuario
+func (r *emailPreferenceRepository) Upsert(ctx context.Context, preference *entities.EmailPreference) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO user_emails (user_id, address, weekly_summary, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			address = excluded.address, weekly_summary = excluded.weekly_summary, updated_at = excluded.updated_at`,
+		preference.UserID.String(), preference.Address, preference.WeeklySummary, formatTime(preference.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save email preference: %w", err)
+	}
+
+	return nil
+}
+
+// Delete elimina la dirección de email de un usuario
+func (r *emailPreferenceRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM user_emails WHERE user_id = ?`, userID.String()); err != nil {
+		return fmt.Errorf("failed to delete email preference: %w", err)
+	}
+
+	return nil
+}
+
+// ListWeeklySummaryRecipients lista por páginas los usuarios que aceptaron el resumen semanal
+func (r *emailPreferenceRepository) ListWeeklySummaryRecipients(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.EmailPreference, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT user_id, address, weekly_summary, updated_at
+		FROM user_emails
+		WHERE weekly_summary = 1 AND user_id > ?
+		ORDER BY user_id
+		LIMIT ?`,
+		afterID.String(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weekly summary recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var preferences []*entities.EmailPreference
+	for rows.Next() {
+		preference, err := scanEmailPreference(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan email preference: %w", err)
+		}
+		preferences = append(preferences, preference)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate weekly summary recipients: %w", err)
+	}
+
+	return preferences, nil
+}
+
+func scanEmailPreference(row scanner) (*entities.EmailPreference, error) {
+	var preference entities.EmailPreference
+	var updatedAt string
+	if err := row.Scan(&preference.UserID, &preference.Address, &preference.WeeklySummary, &updatedAt); err != nil {
+		return nil, err
+	}
+	var err error
+	if preference.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, err
+	}
+	return &preference, nil
+}
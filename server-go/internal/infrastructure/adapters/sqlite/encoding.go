@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// timeLayout usa ancho fijo para que el orden lexicográfico coincida con el cronológico
+const timeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// scanner es el subconjunto común de *sql.Row y *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func parseTime(value string) (time.Time, error) {
+	return time.Parse(timeLayout, value)
+}
+
+// nullTime convierte una fecha opcional en un valor nulo de SQLite
+func nullTime(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: formatTime(*t), Valid: true}
+}
+
+// nullString guarda las cadenas vacías como NULL para que no choquen en los índices únicos parciales
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func parseNullTime(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	t, err := parseTime(value.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// encodeJSON serializa arreglos y estructuras anidadas que PostgreSQL guarda en columnas propias
+func encodeJSON(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeJSON(data string, value any) error {
+	if data == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(data), value)
+}
+
+// placeholders devuelve "?, ?, ..." con n marcadores
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,397 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// fileSortColumns contiene las columnas por las que se permite ordenar archivos
+var fileSortColumns = map[string]string{
+	"created_at":   "created_at",
+	"filename":     "filename",
+	"size":         "size",
+	"content_type": "content_type",
+}
+
+const fileColumns = `id, filename, content_type, size, checksum, created_at, user_id, compressed, compression_type, path, logical_id, version, checksum_algorithm, preview, storage_tier`
+
+type fileRepository struct {
+	db querier
+}
+
+// NewFileRepository crea un nuevo repositorio de archivos
+func NewFileRepository(db *sql.DB) ports.FileRepository {
+	return &fileRepository{db: db}
+}
+
+// Create registra la información de un archivo
+func (r *fileRepository) Create(ctx context.Context, fileInfo *entities.FileInfo) error {
+	var preview sql.NullString
+	if fileInfo.Preview != nil {
+		encoded, err := encodeJSON(fileInfo.Preview)
+		if err != nil {
+			return fmt.Errorf("failed to encode preview: %w", err)
+		}
+		preview = sql.NullString{String: encoded, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO files (`+fileColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		fileInfo.ID.String(),
+		fileInfo.Filename,
+		fileInfo.ContentType,
+		fileInfo.Size,
+		fileInfo.Checksum,
+		formatTime(fileInfo.CreatedAt),
+		fileInfo.UserID.String(),
+		fileInfo.Compressed,
+		fileInfo.CompressionType,
+		fileInfo.Path,
+		fileInfo.LogicalID.String(),
+		fileInfo.Version,
+		fileInfo.ChecksumAlgorithm,
+		preview,
+		fileInfo.StorageTier,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene la información de un archivo por su ID
+func (r *fileRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.FileInfo, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+fileColumns+` FROM files WHERE id = ?`, id.String())
+
+	fileInfo, err := scanFileInfo(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// GetByUserID obtiene los archivos de un usuario con filtros
+func (r *fileRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.FileFilters) ([]*entities.FileInfo, int, error) {
+	order, err := orderBy(filters.Sort, fileSortColumns, entities.SortField{Field: "created_at"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Solo la última versión de cada archivo
+	where := ` FROM files WHERE user_id = ? AND version = (SELECT MAX(v.version) FROM files v WHERE v.logical_id = files.logical_id)`
+	args := []any{userID.String()}
+	if filters.ContentTypeFilter != "" {
+		where += ` AND content_type LIKE ?`
+		args = append(args, filters.ContentTypeFilter+"%")
+	}
+	if filters.SearchQuery != "" {
+		// Sin índice de texto completo: en modo standalone el volumen es pequeño y basta LIKE
+		where += ` AND (filename LIKE ? OR id IN (SELECT file_id FROM file_texts WHERE content LIKE ?))`
+		pattern := "%" + filters.SearchQuery + "%"
+		args = append(args, pattern, pattern)
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count files: %w", err)
+	}
+
+	selectQuery := `SELECT ` + fileColumns + where + order + limitClause(filters.Page, filters.PageSize, filters.Count)
+
+	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan file: %w", err)
+		}
+		files = append(files, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating files: %w", err)
+	}
+
+	files, totalCount = ports.PageTotal(files, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return files, totalCount, nil
+}
+
+// Delete elimina el registro de un archivo
+func (r *fileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM files WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrFileNotFound
+	}
+
+	return nil
+}
+
+// GetLatestVersion obtiene la última versión del archivo del usuario con ese nombre
+func (r *fileRepository) GetLatestVersion(ctx context.Context, userID uuid.UUID, filename string) (*entities.FileInfo, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+fileColumns+` FROM files WHERE user_id = ? AND filename = ? ORDER BY version DESC, created_at DESC LIMIT 1`,
+		userID.String(), filename,
+	)
+
+	fileInfo, err := scanFileInfo(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest file version: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// ListVersions obtiene las versiones de un archivo, de la más reciente a la más antigua
+func (r *fileRepository) ListVersions(ctx context.Context, logicalID uuid.UUID) ([]*entities.FileInfo, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+fileColumns+` FROM files WHERE logical_id = ? ORDER BY version DESC`,
+		logicalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan file version: %w", err)
+		}
+		versions = append(versions, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating file versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// CountByPath cuenta las versiones que referencian un archivo físico
+func (r *fileRepository) CountByPath(ctx context.Context, path string) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM files WHERE path = ?`, path).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count file references: %w", err)
+	}
+	return count, nil
+}
+
+// FindByChecksum obtiene la versión más reciente del usuario con ese checksum SHA-256 y ese tamaño
+func (r *fileRepository) FindByChecksum(ctx context.Context, userID uuid.UUID, checksum string, size int64) (*entities.FileInfo, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+fileColumns+` FROM files WHERE user_id = ? AND checksum = ? AND size = ? AND checksum_algorithm = ? ORDER BY created_at DESC LIMIT 1`,
+		userID.String(), checksum, size, entities.ChecksumAlgorithmSHA256,
+	)
+
+	fileInfo, err := scanFileInfo(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to find file by checksum: %w", err)
+	}
+
+	return fileInfo, nil
+}
+
+// GetStorageUsage calcula el almacenamiento de un usuario sumando todas las versiones
+func (r *fileRepository) GetStorageUsage(ctx context.Context, userID uuid.UUID) (*ports.StorageUsage, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(DISTINCT logical_id) FROM files WHERE user_id = ?),
+			(SELECT COUNT(*) FROM files WHERE user_id = ?),
+			(SELECT COALESCE(SUM(size), 0) FROM (SELECT DISTINCT path, size FROM files WHERE user_id = ?))
+	`
+
+	var usage ports.StorageUsage
+	id := userID.String()
+	if err := r.db.QueryRowContext(ctx, query, id, id, id).Scan(&usage.Files, &usage.Versions, &usage.Bytes); err != nil {
+		return nil, fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	tiers, err := r.tierUsage(ctx, `WHERE user_id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	usage.Tiers = tiers
+
+	return &usage, nil
+}
+
+// GetTierUsage resume el almacenamiento de todos los usuarios por nivel
+func (r *fileRepository) GetTierUsage(ctx context.Context) ([]ports.TierUsage, error) {
+	return r.tierUsage(ctx, ``)
+}
+
+// tierUsage agrupa por nivel los archivos físicos de las versiones que cumplen where
+func (r *fileRepository) tierUsage(ctx context.Context, where string, args ...any) ([]ports.TierUsage, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT storage_tier, COUNT(*), COALESCE(SUM(size), 0)
+		FROM (SELECT DISTINCT path, size, storage_tier FROM files `+where+`)
+		GROUP BY storage_tier
+		ORDER BY storage_tier`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier usage: %w", err)
+	}
+	defer rows.Close()
+
+	var tiers []ports.TierUsage
+	for rows.Next() {
+		var usage ports.TierUsage
+		if err := rows.Scan(&usage.Tier, &usage.Blobs, &usage.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to scan tier usage: %w", err)
+		}
+		tiers = append(tiers, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tier usage: %w", err)
+	}
+
+	return tiers, nil
+}
+
+// ListTierCandidates devuelve los archivos físicos del nivel tier sin versiones ni cambios de nivel desde before
+func (r *fileRepository) ListTierCandidates(ctx context.Context, tier entities.StorageTier, before time.Time, limit int) ([]string, error) {
+	cutoff := formatTime(before)
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT path FROM files WHERE storage_tier = ?
+		GROUP BY path
+		HAVING MAX(created_at) < ? AND (MAX(tier_changed_at) IS NULL OR MAX(tier_changed_at) < ?)
+		ORDER BY MAX(created_at)
+		LIMIT ?`,
+		string(tier), cutoff, cutoff, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tier candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, fmt.Errorf("failed to scan tier candidate: %w", err)
+		}
+		paths = append(paths, path)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tier candidates: %w", err)
+	}
+
+	return paths, nil
+}
+
+// MoveStorage cambia la ruta y el nivel de todas las versiones que referencian oldPath
+func (r *fileRepository) MoveStorage(ctx context.Context, oldPath, newPath string, tier entities.StorageTier, movedAt time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE files SET path = ?, storage_tier = ?, tier_changed_at = ? WHERE path = ?`,
+		newPath, string(tier), formatTime(movedAt), oldPath,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move file storage: %w", err)
+	}
+
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to move file storage: %w", err)
+	}
+	return int(moved), nil
+}
+
+// ListAll recorre las versiones de todos los usuarios ordenadas por ID
+func (r *fileRepository) ListAll(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.FileInfo, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+fileColumns+` FROM files WHERE id > ? ORDER BY id LIMIT ?`,
+		afterID.String(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*entities.FileInfo
+	for rows.Next() {
+		fileInfo, err := scanFileInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan file: %w", err)
+		}
+		files = append(files, fileInfo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating files: %w", err)
+	}
+
+	return files, nil
+}
+
+func scanFileInfo(row scanner) (*entities.FileInfo, error) {
+	var fileInfo entities.FileInfo
+	var createdAt string
+	var preview sql.NullString
+	err := row.Scan(
+		&fileInfo.ID,
+		&fileInfo.Filename,
+		&fileInfo.ContentType,
+		&fileInfo.Size,
+		&fileInfo.Checksum,
+		&createdAt,
+		&fileInfo.UserID,
+		&fileInfo.Compressed,
+		&fileInfo.CompressionType,
+		&fileInfo.Path,
+		&fileInfo.LogicalID,
+		&fileInfo.Version,
+		&fileInfo.ChecksumAlgorithm,
+		&preview,
+		&fileInfo.StorageTier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileInfo.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+
+	if preview.Valid {
+		fileInfo.Preview = &entities.FilePreview{}
+		if err := decodeJSON(preview.String, fileInfo.Preview); err != nil {
+			return nil, fmt.Errorf("invalid preview: %w", err)
+		}
+	}
+
+	return &fileInfo, nil
+}
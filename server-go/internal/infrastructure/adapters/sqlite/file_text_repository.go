@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+type fileTextRepository struct {
+	db querier
+}
+
+// NewFileTextRepository crea un nuevo repositorio de texto extraído de archivos
+func NewFileTextRepository(db *sql.DB) ports.FileTextRepository {
+	return &fileTextRepository{db: db}
+}
+
+// Upsert guarda el texto de un archivo, reemplazando el de una extracción anterior
+func (r *fileTextRepository) Upsert(ctx context.Context, text *entities.FileText) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO file_texts (file_id, user_id, content, extractor, extracted_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (file_id) DO UPDATE
+		SET content = excluded.content, extractor = excluded.extractor, extracted_at = excluded.extracted_at`,
+		text.FileID.String(),
+		text.UserID.String(),
+		text.Content,
+		text.Extractor,
+		formatTime(text.ExtractedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save file text: %w", err)
+	}
+
+	return nil
+}
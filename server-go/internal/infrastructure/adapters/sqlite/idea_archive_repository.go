@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type ideaArchiveRepository struct {
+	db querier
+}
+
+// NewIdeaArchiveRepository crea un nuevo repositorio del archivo comprimido de ideas
+func NewIdeaArchiveRepository(db *sql.DB) ports.IdeaArchiveRepository {
+	return &ideaArchiveRepository{db: db}
+}
+
+// ListCompactionCandidates obtiene las ideas archivadas sin compactar modificadas antes de before,
+// las más antiguas primero
+func (r *ideaArchiveRepository) ListCompactionCandidates(ctx context.Context, before time.Time, limit int) ([]*entities.Idea, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+ideaColumns+` FROM ideas
+		WHERE status = ? AND compacted_at IS NULL AND updated_at < ?
+		ORDER BY updated_at, id LIMIT ?`,
+		int(entities.IdeaStatusArchived), formatTime(before), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query compaction candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		idea, err := scanIdea(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+		ideas = append(ideas, idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating compaction candidates: %w", err)
+	}
+
+	return ideas, nil
+}
+
+// Compact guarda el contenido comprimido antes de recortar la fila, así que si la segunda
+// sentencia falla la idea sigue completa y el archivo sobrante se reemplaza en el siguiente intento
+func (r *ideaArchiveRepository) Compact(ctx context.Context, idea *entities.Idea, compactedAt time.Time) error {
+	bundle, err := encodeIdeaBundle(idea.Content)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea bundle: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO idea_archives (idea_id, bundle, archived_at) VALUES (?, ?, ?)
+		ON CONFLICT (idea_id) DO UPDATE SET bundle = excluded.bundle, archived_at = excluded.archived_at
+	`, idea.ID.String(), bundle, formatTime(compactedAt))
+	if err != nil {
+		return fmt.Errorf("failed to archive idea: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE ideas SET content = ?, compacted_at = ? WHERE id = ? AND version = ? AND compacted_at IS NULL`,
+		entities.IdeaExcerpt(idea.Content), formatTime(compactedAt), idea.ID.String(), idea.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compact idea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to compact idea: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// restoreIdea devuelve a la fila de una idea compactada su contenido completo y elimina su copia
+// comprimida; devuelve el contenido restaurado
+func restoreIdea(ctx context.Context, db querier, id uuid.UUID) (string, error) {
+	var bundle []byte
+	if err := db.QueryRowContext(ctx, `SELECT bundle FROM idea_archives WHERE idea_id = ?`, id.String()).Scan(&bundle); err != nil {
+		return "", err
+	}
+	content, err := decodeIdeaBundle(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := db.ExecContext(ctx,
+		`UPDATE ideas SET content = ?, compacted_at = NULL WHERE id = ? AND compacted_at IS NOT NULL`,
+		content, id.String(),
+	); err != nil {
+		return "", err
+	}
+	if _, err := db.ExecContext(ctx, `DELETE FROM idea_archives WHERE idea_id = ?`, id.String()); err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// ideaBundle es el contenido de una idea compactada, guardado como JSON comprimido con gzip
+type ideaBundle struct {
+	Content string `json:"content"`
+}
+
+func encodeIdeaBundle(content string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(writer).Encode(ideaBundle{Content: content}); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeIdeaBundle(data []byte) (string, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	var bundle ideaBundle
+	if err := json.NewDecoder(reader).Decode(&bundle); err != nil {
+		return "", err
+	}
+	return bundle.Content, nil
+}
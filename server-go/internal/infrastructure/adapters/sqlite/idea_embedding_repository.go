@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type ideaEmbeddingRepository struct {
+	db querier
+}
+
+// NewIdeaEmbeddingRepository crea un nuevo repositorio de vectores de ideas
+func NewIdeaEmbeddingRepository(db *sql.DB) ports.IdeaEmbeddingRepository {
+	return &ideaEmbeddingRepository{db: db}
+}
+
+// Upsert guarda el vector de una idea como arreglo JSON, reemplazando el anterior
+func (r *ideaEmbeddingRepository) Upsert(ctx context.Context, embedding *entities.IdeaEmbedding) error {
+	vector, err := encodeJSON(embedding.Vector)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea embedding: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO idea_embeddings (idea_id, user_id, model, embedding, content_hash, embedded_at) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (idea_id) DO UPDATE
+		SET model = excluded.model, embedding = excluded.embedding,
+		    content_hash = excluded.content_hash, embedded_at = excluded.embedded_at`,
+		embedding.IdeaID.String(),
+		embedding.UserID.String(),
+		embedding.Model,
+		vector,
+		embedding.ContentHash,
+		formatTime(embedding.EmbeddedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save idea embedding: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIdeaID obtiene el vector de una idea
+func (r *ideaEmbeddingRepository) GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaEmbedding, error) {
+	var embedding entities.IdeaEmbedding
+	var id, userID, vector, embeddedAt string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT idea_id, user_id, model, embedding, content_hash, embedded_at FROM idea_embeddings WHERE idea_id = ?`,
+		ideaID.String(),
+	).Scan(&id, &userID, &embedding.Model, &vector, &embedding.ContentHash, &embeddedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrIdeaEmbeddingNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea embedding: %w", err)
+	}
+
+	if embedding.IdeaID, err = uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+	}
+	if embedding.UserID, err = uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+	}
+	if embedding.EmbeddedAt, err = parseTime(embeddedAt); err != nil {
+		return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+	}
+	if err := decodeJSON(vector, &embedding.Vector); err != nil {
+		return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+	}
+
+	return &embedding, nil
+}
+
+// Nearest calcula la similitud coseno en memoria: en modo standalone un usuario tiene pocas ideas
+// y SQLite no tiene tipo vectorial
+func (r *ideaEmbeddingRepository) Nearest(ctx context.Context, userID uuid.UUID, model string, vector []float32, limit int) ([]ports.IdeaMatch, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT idea_id, embedding FROM idea_embeddings WHERE user_id = ? AND model = ?`,
+		userID.String(), model,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query idea embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []ports.IdeaMatch
+	for rows.Next() {
+		var id, encoded string
+		if err := rows.Scan(&id, &encoded); err != nil {
+			return nil, fmt.Errorf("failed to scan idea embedding: %w", err)
+		}
+
+		var candidate []float32
+		if err := decodeJSON(encoded, &candidate); err != nil {
+			return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+		}
+		if len(candidate) != len(vector) {
+			continue
+		}
+
+		ideaID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse idea embedding: %w", err)
+		}
+		matches = append(matches, ports.IdeaMatch{IdeaID: ideaID, Similarity: entities.CosineSimilarity(vector, candidate)})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating idea embeddings: %w", err)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Similarity > matches[j].Similarity
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
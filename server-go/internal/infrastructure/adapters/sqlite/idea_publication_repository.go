@@ -0,0 +1,195 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const ideaPublicationColumns = `id, idea_id, user_id, slug, expires_at, view_count, last_viewed_at, created_at, unpublished_at`
+
+type ideaPublicationRepository struct {
+	db querier
+}
+
+// NewIdeaPublicationRepository crea un nuevo repositorio de publicaciones de ideas
+func NewIdeaPublicationRepository(db *sql.DB) ports.IdeaPublicationRepository {
+	return &ideaPublicationRepository{db: db}
+}
+
+// Create registra una publicación
+func (r *ideaPublicationRepository) Create(ctx context.Context, publication *entities.IdeaPublication) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO idea_publications (`+ideaPublicationColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		publication.ID.String(),
+		publication.IdeaID.String(),
+		publication.UserID.String(),
+		publication.Slug,
+		nullTime(publication.ExpiresAt),
+		publication.ViewCount,
+		nullTime(publication.LastViewedAt),
+		formatTime(publication.CreatedAt),
+		nullTime(publication.UnpublishedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create idea publication: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una publicación por su ID
+func (r *ideaPublicationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx, `SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE id = ?`, id.String())
+}
+
+// GetBySlug obtiene una publicación por su slug
+func (r *ideaPublicationRepository) GetBySlug(ctx context.Context, slug string) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx, `SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE slug = ?`, slug)
+}
+
+// GetCurrentByIdeaID obtiene la publicación no retirada de una idea
+func (r *ideaPublicationRepository) GetCurrentByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaPublication, error) {
+	return r.getOne(ctx,
+		`SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE idea_id = ? AND unpublished_at IS NULL`,
+		ideaID.String(),
+	)
+}
+
+// ListByUserID obtiene las publicaciones no retiradas de un usuario, de la más reciente a la más antigua
+func (r *ideaPublicationRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.IdeaPublication, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+ideaPublicationColumns+` FROM idea_publications WHERE user_id = ? AND unpublished_at IS NULL ORDER BY created_at DESC`,
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idea publications: %w", err)
+	}
+	defer rows.Close()
+
+	var publications []*entities.IdeaPublication
+	for rows.Next() {
+		publication, err := scanIdeaPublication(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea publication: %w", err)
+		}
+		publications = append(publications, publication)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate idea publications: %w", err)
+	}
+
+	return publications, nil
+}
+
+// SetExpiry cambia la caducidad de una publicación
+func (r *ideaPublicationRepository) SetExpiry(ctx context.Context, id uuid.UUID, expiresAt *time.Time) error {
+	return r.exec(ctx, "update idea publication expiry",
+		`UPDATE idea_publications SET expires_at = ? WHERE id = ?`,
+		nullTime(expiresAt), id.String(),
+	)
+}
+
+// Unpublish retira una publicación; retirarla dos veces conserva la primera fecha
+func (r *ideaPublicationRepository) Unpublish(ctx context.Context, id uuid.UUID, unpublishedAt time.Time) error {
+	return r.exec(ctx, "unpublish idea",
+		`UPDATE idea_publications SET unpublished_at = COALESCE(unpublished_at, ?) WHERE id = ?`,
+		formatTime(unpublishedAt), id.String(),
+	)
+}
+
+// RecordView suma una visita a una publicación
+func (r *ideaPublicationRepository) RecordView(ctx context.Context, id uuid.UUID, viewedAt time.Time) error {
+	return r.exec(ctx, "record idea publication view",
+		`UPDATE idea_publications SET view_count = view_count + 1, last_viewed_at = ? WHERE id = ?`,
+		formatTime(viewedAt), id.String(),
+	)
+}
+
+// RecordViews suma las visitas acumuladas de cada publicación; last_viewed_at nunca retrocede
+func (r *ideaPublicationRepository) RecordViews(ctx context.Context, views []ports.PublicationViews) error {
+	for _, view := range views {
+		viewedAt := formatTime(view.LastViewedAt)
+		_, err := r.db.ExecContext(ctx,
+			`UPDATE idea_publications
+			SET view_count = view_count + ?,
+				last_viewed_at = CASE WHEN last_viewed_at IS NULL OR last_viewed_at < ? THEN ? ELSE last_viewed_at END
+			WHERE id = ?`,
+			view.Count, viewedAt, viewedAt, view.PublicationID.String(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record idea publication views: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ideaPublicationRepository) exec(ctx context.Context, action, query string, args ...any) error {
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrIdeaPublicationNotFound
+	}
+
+	return nil
+}
+
+func (r *ideaPublicationRepository) getOne(ctx context.Context, query string, args ...any) (*entities.IdeaPublication, error) {
+	publication, err := scanIdeaPublication(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrIdeaPublicationNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea publication: %w", err)
+	}
+
+	return publication, nil
+}
+
+func scanIdeaPublication(row scanner) (*entities.IdeaPublication, error) {
+	var publication entities.IdeaPublication
+	var createdAt string
+	var expiresAt, lastViewedAt, unpublishedAt sql.NullString
+	err := row.Scan(
+		&publication.ID,
+		&publication.IdeaID,
+		&publication.UserID,
+		&publication.Slug,
+		&expiresAt,
+		&publication.ViewCount,
+		&lastViewedAt,
+		&createdAt,
+		&unpublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if publication.ExpiresAt, err = parseNullTime(expiresAt); err != nil {
+		return nil, fmt.Errorf("invalid expires_at: %w", err)
+	}
+	if publication.LastViewedAt, err = parseNullTime(lastViewedAt); err != nil {
+		return nil, fmt.Errorf("invalid last_viewed_at: %w", err)
+	}
+	if publication.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if publication.UnpublishedAt, err = parseNullTime(unpublishedAt); err != nil {
+		return nil, fmt.Errorf("invalid unpublished_at: %w", err)
+	}
+
+	return &publication, nil
+}
@@ -0,0 +1,413 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// ideaSortColumns contiene las columnas por las que se permite ordenar ideas
+var ideaSortColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"title":      "title",
+	"priority":   "priority",
+	"position":   "position",
+}
+
+const ideaColumns = `id, title, content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at`
+
+// ideaColumnsWithoutContent lee lo mismo que ideaColumns salvo el contenido, que queda vacío
+const ideaColumnsWithoutContent = `id, title, '' AS content, tags, category, status, created_at, updated_at, user_id, related_ideas, priority, position, custom_fields, version, compacted_at`
+
+type ideaRepository struct {
+	db querier
+}
+
+// NewIdeaRepository crea una nueva instancia del repositorio de ideas
+func NewIdeaRepository(db *sql.DB) ports.IdeaRepository {
+	return &ideaRepository{db: db}
+}
+
+// Create crea una nueva idea en la base de datos
+func (r *ideaRepository) Create(ctx context.Context, idea *entities.Idea) error {
+	tags, related, customFields, err := encodeIdeaJSON(idea)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO ideas (`+ideaColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		idea.ID.String(),
+		idea.Title,
+		idea.Content,
+		tags,
+		int(idea.Category),
+		int(idea.Status),
+		formatTime(idea.CreatedAt),
+		formatTime(idea.UpdatedAt),
+		idea.UserID.String(),
+		related,
+		idea.Priority,
+		idea.Position,
+		customFields,
+		idea.Version,
+		nullTime(idea.CompactedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create idea: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una idea por su ID
+func (r *ideaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Idea, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+ideaColumns+` FROM ideas WHERE id = ?`, id.String())
+
+	idea, err := scanIdea(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrIdeaNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea: %w", err)
+	}
+
+	if idea.IsCompacted() {
+		if idea.Content, err = restoreIdea(ctx, r.db, id); err != nil {
+			return nil, fmt.Errorf("failed to restore idea: %w", err)
+		}
+		idea.CompactedAt = nil
+	}
+
+	return idea, nil
+}
+
+// ideaConditions construye la cláusula FROM ... WHERE de un listado de ideas y sus argumentos,
+// en el orden de sus parámetros
+func ideaConditions(userID uuid.UUID, filters ports.IdeaFilters) (string, []any, error) {
+	where := ` FROM ideas WHERE user_id = ?`
+	args := []any{userID.String()}
+
+	if filters.Category != entities.IdeaCategoryUnspecified {
+		where += ` AND category = ?`
+		args = append(args, int(filters.Category))
+	}
+
+	if filters.Status != entities.IdeaStatusUnspecified {
+		where += ` AND status = ?`
+		args = append(args, int(filters.Status))
+	}
+
+	// Equivalente al operador && de PostgreSQL: al menos una etiqueta en común
+	if len(filters.Tags) > 0 {
+		where += ` AND EXISTS (SELECT 1 FROM json_each(ideas.tags) WHERE json_each.value IN (` + placeholders(len(filters.Tags)) + `))`
+		for _, tag := range filters.Tags {
+			args = append(args, tag)
+		}
+	}
+
+	if len(filters.CustomFields) > 0 {
+		conditions, conditionArgs, err := customFieldConditions(filters.CustomFields)
+		if err != nil {
+			return "", nil, err
+		}
+		where += conditions
+		args = append(args, conditionArgs...)
+	}
+
+	return where, args, nil
+}
+
+// GetByUserID obtiene las ideas de un usuario con filtros
+func (r *ideaRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.IdeaFilters) ([]*entities.Idea, int, error) {
+	order, err := orderBy(filters.Sort, ideaSortColumns, entities.SortField{Field: "created_at"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	where, args, err := ideaConditions(userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count ideas: %w", err)
+	}
+
+	columns := ideaColumns
+	if filters.WithoutContent {
+		columns = ideaColumnsWithoutContent
+	}
+	selectQuery := `SELECT ` + columns + where + order + limitClause(filters.Page, filters.PageSize, filters.Count)
+
+	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query ideas: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		idea, err := scanIdea(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan idea: %w", err)
+		}
+		ideas = append(ideas, idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating ideas: %w", err)
+	}
+
+	ideas, totalCount = ports.PageTotal(ideas, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return ideas, totalCount, nil
+}
+
+// Update actualiza una idea existente
+func (r *ideaRepository) Update(ctx context.Context, idea *entities.Idea) error {
+	tags, related, customFields, err := encodeIdeaJSON(idea)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE ideas
+		SET title = ?, content = CASE WHEN compacted_at IS NULL THEN ? ELSE content END, tags = ?, category = ?, status = ?,
+		    updated_at = ?, related_ideas = ?, priority = ?, position = ?, custom_fields = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`,
+		idea.Title,
+		idea.Content,
+		tags,
+		int(idea.Category),
+		int(idea.Status),
+		formatTime(idea.UpdatedAt),
+		related,
+		idea.Priority,
+		idea.Position,
+		customFields,
+		idea.ID.String(),
+		idea.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update idea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update idea: %w", err)
+	}
+	if rowsAffected == 0 {
+		var exists bool
+		if err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM ideas WHERE id = ?)`, idea.ID.String()).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check idea existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrIdeaNotFound
+	}
+
+	idea.Version++
+	return nil
+}
+
+// Delete elimina una idea
+func (r *ideaRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM ideas WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete idea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete idea: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrIdeaNotFound
+	}
+
+	return nil
+}
+
+// ListByStatus recorre las ideas de todos los usuarios en esos estados ordenadas por ID
+func (r *ideaRepository) ListByStatus(ctx context.Context, statuses []entities.IdeaStatus, afterID uuid.UUID, limit int) ([]*entities.Idea, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	args := []any{afterID.String()}
+	for _, status := range statuses {
+		args = append(args, int(status))
+	}
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+ideaColumns+` FROM ideas WHERE id > ? AND status IN (`+placeholders(len(statuses))+`) ORDER BY id LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ideas: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		idea, err := scanIdea(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+		ideas = append(ideas, idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ideas: %w", err)
+	}
+
+	return ideas, nil
+}
+
+// Search busca las palabras de query en el título y el contenido. Sin índice de texto completo
+// basta LIKE: las ideas que contienen todas las palabras en el título van primero.
+func (r *ideaRepository) Search(ctx context.Context, userID uuid.UUID, query string, limit int) ([]*entities.Idea, error) {
+	words := strings.Fields(query)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	where := ` FROM ideas WHERE user_id = ?`
+	args := []any{userID.String()}
+	titleMatch := make([]string, len(words))
+	var titleArgs []any
+	for i, word := range words {
+		pattern := "%" + escapeLike(word) + "%"
+		where += ` AND (title LIKE ? ESCAPE '\' OR content LIKE ? ESCAPE '\')`
+		args = append(args, pattern, pattern)
+		titleMatch[i] = `title LIKE ? ESCAPE '\'`
+		titleArgs = append(titleArgs, pattern)
+	}
+
+	selectQuery := `SELECT ` + ideaColumns + where + ` ORDER BY (` + strings.Join(titleMatch, " AND ") + `) DESC, updated_at DESC LIMIT ?`
+	args = append(args, titleArgs...)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search ideas: %w", err)
+	}
+	defer rows.Close()
+
+	var ideas []*entities.Idea
+	for rows.Next() {
+		idea, err := scanIdea(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan idea: %w", err)
+		}
+		ideas = append(ideas, idea)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ideas: %w", err)
+	}
+
+	return ideas, nil
+}
+
+// escapeLike evita que los comodines de LIKE en el texto buscado se interpreten
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+func encodeIdeaJSON(idea *entities.Idea) (string, string, string, error) {
+	tags := idea.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	encodedTags, err := encodeJSON(tags)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	related := make([]string, len(idea.RelatedIdeas))
+	for i, id := range idea.RelatedIdeas {
+		related[i] = id.String()
+	}
+	encodedRelated, err := encodeJSON(related)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	customFields, err := encodeCustomFields(idea.CustomFields)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return encodedTags, encodedRelated, customFields, nil
+}
+
+func scanIdea(row scanner) (*entities.Idea, error) {
+	var idea entities.Idea
+	var tags, relatedIdeas, customFields, createdAt, updatedAt string
+	var compactedAt sql.NullString
+	var category, status int
+
+	err := row.Scan(
+		&idea.ID,
+		&idea.Title,
+		&idea.Content,
+		&tags,
+		&category,
+		&status,
+		&createdAt,
+		&updatedAt,
+		&idea.UserID,
+		&relatedIdeas,
+		&idea.Priority,
+		&idea.Position,
+		&customFields,
+		&idea.Version,
+		&compactedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	idea.Category = entities.IdeaCategory(category)
+	idea.Status = entities.IdeaStatus(status)
+
+	if idea.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if idea.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+	if idea.CompactedAt, err = parseNullTime(compactedAt); err != nil {
+		return nil, fmt.Errorf("invalid compacted_at: %w", err)
+	}
+	if err := decodeJSON(tags, &idea.Tags); err != nil {
+		return nil, fmt.Errorf("invalid tags: %w", err)
+	}
+	if idea.CustomFields, err = decodeCustomFields(customFields); err != nil {
+		return nil, fmt.Errorf("invalid custom_fields: %w", err)
+	}
+
+	var relatedStrings []string
+	if err := decodeJSON(relatedIdeas, &relatedStrings); err != nil {
+		return nil, fmt.Errorf("invalid related_ideas: %w", err)
+	}
+	idea.RelatedIdeas = make([]uuid.UUID, 0, len(relatedStrings))
+	for _, idStr := range relatedStrings {
+		if relatedID, err := uuid.Parse(idStr); err == nil {
+			idea.RelatedIdeas = append(idea.RelatedIdeas, relatedID)
+		}
+	}
+
+	return &idea, nil
+}
@@ -0,0 +1,187 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const ideaReviewColumns = `idea_id, user_id, ease_factor, interval_days, repetitions, due_at, last_reviewed_at, enrolled_at, version`
+
+type ideaReviewRepository struct {
+	db querier
+}
+
+// NewIdeaReviewRepository crea un nuevo repositorio de ideas inscritas en el repaso
+func NewIdeaReviewRepository(db *sql.DB) ports.IdeaReviewRepository {
+	return &ideaReviewRepository{db: db}
+}
+
+// Create inscribe una idea en el repaso
+func (r *ideaReviewRepository) Create(ctx context.Context, review *entities.IdeaReview) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO idea_reviews (`+ideaReviewColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		review.IdeaID.String(),
+		review.UserID.String(),
+		review.EaseFactor,
+		review.IntervalDays,
+		review.Repetitions,
+		formatTime(review.DueAt),
+		nullTime(review.LastReviewedAt),
+		formatTime(review.EnrolledAt),
+		review.Version,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return entities.ErrIdeaAlreadyEnrolled
+		}
+		return fmt.Errorf("failed to create idea review: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIdeaID obtiene la inscripción de una idea
+func (r *ideaReviewRepository) GetByIdeaID(ctx context.Context, ideaID uuid.UUID) (*entities.IdeaReview, error) {
+	review, err := scanIdeaReview(r.db.QueryRowContext(ctx,
+		`SELECT `+ideaReviewColumns+` FROM idea_reviews WHERE idea_id = ?`, ideaID.String(),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrIdeaReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get idea review: %w", err)
+	}
+
+	return review, nil
+}
+
+// Update guarda el resultado de un repaso con control de versión optimista
+func (r *ideaReviewRepository) Update(ctx context.Context, review *entities.IdeaReview) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE idea_reviews
+		SET ease_factor = ?, interval_days = ?, repetitions = ?, due_at = ?, last_reviewed_at = ?, version = version + 1
+		WHERE idea_id = ? AND version = ?
+	`,
+		review.EaseFactor,
+		review.IntervalDays,
+		review.Repetitions,
+		formatTime(review.DueAt),
+		nullTime(review.LastReviewedAt),
+		review.IdeaID.String(),
+		review.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update idea review: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update idea review: %w", err)
+	}
+	if rowsAffected == 0 {
+		var exists bool
+		if err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM idea_reviews WHERE idea_id = ?)`, review.IdeaID.String()).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check idea review existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrIdeaReviewNotFound
+	}
+
+	review.Version++
+	return nil
+}
+
+// Delete retira una idea del repaso
+func (r *ideaReviewRepository) Delete(ctx context.Context, ideaID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM idea_reviews WHERE idea_id = ?`, ideaID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete idea review: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete idea review: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrIdeaReviewNotFound
+	}
+
+	return nil
+}
+
+// ListDue obtiene las inscripciones vencidas de un usuario, las más atrasadas primero
+func (r *ideaReviewRepository) ListDue(ctx context.Context, userID uuid.UUID, until time.Time, limit int) ([]*entities.IdeaReview, int, error) {
+	var totalCount int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM idea_reviews WHERE user_id = ? AND due_at < ?`,
+		userID.String(), formatTime(until),
+	).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count due idea reviews: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+ideaReviewColumns+` FROM idea_reviews WHERE user_id = ? AND due_at < ? ORDER BY due_at, idea_id LIMIT ?`,
+		userID.String(), formatTime(until), limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list due idea reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*entities.IdeaReview
+	for rows.Next() {
+		review, err := scanIdeaReview(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan idea review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate idea reviews: %w", err)
+	}
+
+	return reviews, totalCount, nil
+}
+
+func scanIdeaReview(row scanner) (*entities.IdeaReview, error) {
+	var review entities.IdeaReview
+	var dueAt, enrolledAt string
+	var lastReviewedAt sql.NullString
+	err := row.Scan(
+		&review.IdeaID,
+		&review.UserID,
+		&review.EaseFactor,
+		&review.IntervalDays,
+		&review.Repetitions,
+		&dueAt,
+		&lastReviewedAt,
+		&enrolledAt,
+		&review.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.DueAt, err = parseTime(dueAt); err != nil {
+		return nil, fmt.Errorf("invalid due_at: %w", err)
+	}
+	if review.LastReviewedAt, err = parseNullTime(lastReviewedAt); err != nil {
+		return nil, fmt.Errorf("invalid last_reviewed_at: %w", err)
+	}
+	if review.EnrolledAt, err = parseTime(enrolledAt); err != nil {
+		return nil, fmt.Errorf("invalid enrolled_at: %w", err)
+	}
+
+	return &review, nil
+}
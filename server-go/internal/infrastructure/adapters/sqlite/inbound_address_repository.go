@@ -0,0 +1,167 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const inboundAddressColumns = `id, user_id, token_hash, allowed_senders, created_at, last_used_at, revoked_at`
+
+type inboundAddressRepository struct {
+	db querier
+}
+
+// NewInboundAddressRepository crea un nuevo repositorio de direcciones de entrada
+func NewInboundAddressRepository(db *sql.DB) ports.InboundAddressRepository {
+	return &inboundAddressRepository{db: db}
+}
+
+// Create registra una dirección de entrada
+func (r *inboundAddressRepository) Create(ctx context.Context, address *entities.InboundAddress) error {
+	allowedSenders := address.AllowedSenders
+	if allowedSenders == nil {
+		allowedSenders = []string{}
+	}
+	senders, err := encodeJSON(allowedSenders)
+	if err != nil {
+		return fmt.Errorf("failed to encode inbound address: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO inbound_addresses (`+inboundAddressColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		address.ID.String(),
+		address.UserID.String(),
+		address.TokenHash,
+		senders,
+		formatTime(address.CreatedAt),
+		nullTime(address.LastUsedAt),
+		nullTime(address.RevokedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create inbound address: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una dirección de entrada por su ID
+func (r *inboundAddressRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.InboundAddress, error) {
+	return r.getOne(ctx, `SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE id = ?`, id.String())
+}
+
+// GetByTokenHash obtiene una dirección de entrada por el hash de su token
+func (r *inboundAddressRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.InboundAddress, error) {
+	return r.getOne(ctx, `SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE token_hash = ?`, tokenHash)
+}
+
+// ListByUserID obtiene las direcciones de un usuario, de la más reciente a la más antigua
+func (r *inboundAddressRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.InboundAddress, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+inboundAddressColumns+` FROM inbound_addresses WHERE user_id = ? ORDER BY created_at DESC`,
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbound addresses: %w", err)
+	}
+	defer rows.Close()
+
+	var addresses []*entities.InboundAddress
+	for rows.Next() {
+		address, err := scanInboundAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan inbound address: %w", err)
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate inbound addresses: %w", err)
+	}
+
+	return addresses, nil
+}
+
+// Revoke marca una dirección como revocada; revocar dos veces conserva la primera fecha
+func (r *inboundAddressRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE inbound_addresses SET revoked_at = COALESCE(revoked_at, ?) WHERE id = ?`,
+		formatTime(revokedAt), id.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke inbound address: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke inbound address: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrInboundAddressNotFound
+	}
+
+	return nil
+}
+
+// MarkUsed registra la fecha del último mensaje recibido
+func (r *inboundAddressRepository) MarkUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE inbound_addresses SET last_used_at = ? WHERE id = ?`,
+		formatTime(usedAt), id.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark inbound address used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *inboundAddressRepository) getOne(ctx context.Context, query string, arg any) (*entities.InboundAddress, error) {
+	address, err := scanInboundAddress(r.db.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrInboundAddressNotFound
+		}
+		return nil, fmt.Errorf("failed to get inbound address: %w", err)
+	}
+
+	return address, nil
+}
+
+func scanInboundAddress(row scanner) (*entities.InboundAddress, error) {
+	var address entities.InboundAddress
+	var senders, createdAt string
+	var lastUsedAt, revokedAt sql.NullString
+	err := row.Scan(
+		&address.ID,
+		&address.UserID,
+		&address.TokenHash,
+		&senders,
+		&createdAt,
+		&lastUsedAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeJSON(senders, &address.AllowedSenders); err != nil {
+		return nil, fmt.Errorf("invalid allowed_senders: %w", err)
+	}
+	if address.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if address.LastUsedAt, err = parseNullTime(lastUsedAt); err != nil {
+		return nil, fmt.Errorf("invalid last_used_at: %w", err)
+	}
+	if address.RevokedAt, err = parseNullTime(revokedAt); err != nil {
+		return nil, fmt.Errorf("invalid revoked_at: %w", err)
+	}
+
+	return &address, nil
+}
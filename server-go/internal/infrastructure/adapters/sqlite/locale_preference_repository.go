@@ -0,0 +1,49 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type localePreferenceRepository struct {
+	db querier
+}
+
+// NewLocalePreferenceRepository crea un nuevo repositorio de idiomas preferidos
+func NewLocalePreferenceRepository(db *sql.DB) ports.LocalePreferenceRepository {
+	return &localePreferenceRepository{db: db}
+}
+
+// GetLocale obtiene el idioma preferido de un usuario
+func (r *localePreferenceRepository) GetLocale(ctx context.Context, userID uuid.UUID) (string, error) {
+	var locale string
+	err := r.db.QueryRowContext(ctx, `SELECT locale FROM user_locales WHERE user_id = ?`, userID.String()).Scan(&locale)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", entities.ErrLocalePreferenceNotFound
+		}
+		return "", fmt.Errorf("failed to get locale preference: %w", err)
+	}
+
+	return locale, nil
+}
+
+// SetLocale guarda el idioma preferido de un usuario
+func (r *localePreferenceRepository) SetLocale(ctx context.Context, userID uuid.UUID, locale string, updatedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO user_locales (user_id, locale, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET locale = excluded.locale, updated_at = excluded.updated_at`,
+		userID.String(), locale, formatTime(updatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set locale preference: %w", err)
+	}
+
+	return nil
+}
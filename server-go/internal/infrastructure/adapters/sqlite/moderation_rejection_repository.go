@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+type moderationRejectionRepository struct {
+	db querier
+}
+
+// NewModerationRejectionRepository crea un nuevo repositorio de auditoría de moderación
+func NewModerationRejectionRepository(db *sql.DB) ports.ModerationRejectionRepository {
+	return &moderationRejectionRepository{db: db}
+}
+
+// Create registra un contenido rechazado
+func (r *moderationRejectionRepository) Create(ctx context.Context, rejection *entities.ModerationRejection) error {
+	matches, err := encodeJSON(rejection.Matches)
+	if err != nil {
+		return fmt.Errorf("failed to encode moderation matches: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO moderation_rejections (id, user_id, organization, scope, entity_type, entity_id, reason, matches, moderator, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rejection.ID.String(),
+		rejection.UserID.String(),
+		rejection.Organization,
+		string(rejection.Scope),
+		rejection.EntityType,
+		rejection.EntityID.String(),
+		rejection.Reason,
+		matches,
+		rejection.Moderator,
+		formatTime(rejection.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create moderation rejection: %w", err)
+	}
+
+	return nil
+}
+
+// List obtiene los rechazos más recientes de una organización, o de todas si está vacía
+func (r *moderationRejectionRepository) List(ctx context.Context, organization string, limit int) ([]*entities.ModerationRejection, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, user_id, organization, scope, entity_type, entity_id, reason, matches, moderator, created_at
+		FROM moderation_rejections
+		WHERE ? = '' OR organization = ?
+		ORDER BY created_at DESC
+		LIMIT ?`,
+		organization, organization, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation rejections: %w", err)
+	}
+	defer rows.Close()
+
+	var rejections []*entities.ModerationRejection
+	for rows.Next() {
+		var rejection entities.ModerationRejection
+		var scope, matches, createdAt string
+		err := rows.Scan(
+			&rejection.ID,
+			&rejection.UserID,
+			&rejection.Organization,
+			&scope,
+			&rejection.EntityType,
+			&rejection.EntityID,
+			&rejection.Reason,
+			&matches,
+			&rejection.Moderator,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation rejection: %w", err)
+		}
+		rejection.Scope = entities.ModerationScope(scope)
+		if err := decodeJSON(matches, &rejection.Matches); err != nil {
+			return nil, fmt.Errorf("failed to decode moderation matches: %w", err)
+		}
+		if rejection.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, fmt.Errorf("failed to parse moderation rejection time: %w", err)
+		}
+		rejections = append(rejections, &rejection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate moderation rejections: %w", err)
+	}
+
+	return rejections, nil
+}
@@ -0,0 +1,173 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const notificationColumns = `id, user_id, title, message, type, channels, metadata, created_at`
+
+type notificationInbox struct {
+	db querier
+}
+
+// NewNotificationInbox crea un nuevo buzón persistente de notificaciones
+func NewNotificationInbox(db *sql.DB) ports.NotificationInbox {
+	return &notificationInbox{db: db}
+}
+
+// Append guarda una notificación enviada; seq conserva el orden de envío
+func (r *notificationInbox) Append(ctx context.Context, notification ports.Notification) error {
+	channels := notification.Channels
+	if channels == nil {
+		channels = []string{}
+	}
+	encodedChannels, err := encodeJSON(channels)
+	if err != nil {
+		return fmt.Errorf("failed to encode channels: %w", err)
+	}
+
+	metadata := notification.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	encodedMetadata, err := encodeJSON(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO notification_inbox (`+notificationColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		notification.ID.String(),
+		notification.UserID.String(),
+		notification.Title,
+		notification.Message,
+		notification.Type,
+		encodedChannels,
+		encodedMetadata,
+		formatTime(notification.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append notification: %w", err)
+	}
+
+	return nil
+}
+
+// ListAfter devuelve las notificaciones del usuario enviadas después de afterID
+func (r *notificationInbox) ListAfter(ctx context.Context, userID, afterID uuid.UUID, limit int) ([]ports.Notification, error) {
+	var afterSeq int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT seq FROM notification_inbox WHERE id = ? AND user_id = ?`,
+		afterID.String(), userID.String(),
+	).Scan(&afterSeq)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to find resume notification: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+notificationColumns+` FROM notification_inbox WHERE user_id = ? AND seq > ? ORDER BY seq LIMIT ?`,
+		userID.String(), afterSeq, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []ports.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// List devuelve una página de las notificaciones del usuario, de la más reciente a la más antigua
+func (r *notificationInbox) List(ctx context.Context, userID uuid.UUID, filters ports.NotificationFilters) ([]ports.Notification, int, error) {
+	where := ` FROM notification_inbox WHERE user_id = ?`
+	args := []any{userID.String()}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	query := `SELECT ` + notificationColumns + where + ` ORDER BY seq DESC` + limitClause(filters.Page, filters.PageSize, filters.Count)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []ports.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate notifications: %w", err)
+	}
+
+	notifications, totalCount = ports.PageTotal(notifications, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return notifications, totalCount, nil
+}
+
+// DeleteOlderThan depura las notificaciones enviadas antes de cutoff
+func (r *notificationInbox) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM notification_inbox WHERE created_at < ?`, formatTime(cutoff))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old notifications: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func scanNotification(row scanner) (ports.Notification, error) {
+	var notification ports.Notification
+	var channels, metadata, createdAt string
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Title,
+		&notification.Message,
+		&notification.Type,
+		&channels,
+		&metadata,
+		&createdAt,
+	)
+	if err != nil {
+		return notification, err
+	}
+
+	if err := decodeJSON(channels, &notification.Channels); err != nil {
+		return notification, fmt.Errorf("invalid channels: %w", err)
+	}
+	if err := decodeJSON(metadata, &notification.Metadata); err != nil {
+		return notification, fmt.Errorf("invalid metadata: %w", err)
+	}
+	if notification.CreatedAt, err = parseTime(createdAt); err != nil {
+		return notification, fmt.Errorf("invalid created_at: %w", err)
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var inboxTestNow = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+func newTestNotificationInbox(t *testing.T) ports.NotificationInbox {
+	db, err := NewConnection(filepath.Join(t.TempDir(), "inbox.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return NewNotificationInbox(db)
+}
+
+// appendNotifications guarda count notificaciones del usuario, una por minuto a partir de inboxTestNow
+func appendNotifications(t *testing.T, inbox ports.NotificationInbox, userID uuid.UUID, count int) []ports.Notification {
+	notifications := make([]ports.Notification, count)
+	for i := range notifications {
+		notifications[i] = ports.Notification{
+			ID:        uuid.New(),
+			Title:     "Recordatorio",
+			Message:   "Llamar al proveedor",
+			Type:      "reminder",
+			UserID:    userID,
+			Channels:  []string{"push"},
+			Metadata:  map[string]string{"reminder_id": uuid.NewString()},
+			CreatedAt: inboxTestNow.Add(time.Duration(i) * time.Minute),
+		}
+		require.NoError(t, inbox.Append(context.Background(), notifications[i]))
+	}
+	return notifications
+}
+
+func notificationIDs(notifications []ports.Notification) []uuid.UUID {
+	ids := make([]uuid.UUID, len(notifications))
+	for i, notification := range notifications {
+		ids[i] = notification.ID
+	}
+	return ids
+}
+
+func TestNotificationInbox_ResumeAfterNotification(t *testing.T) {
+	inbox := newTestNotificationInbox(t)
+	userID := uuid.New()
+	sent := appendNotifications(t, inbox, userID, 3)
+	appendNotifications(t, inbox, uuid.New(), 2)
+
+	resumed, err := inbox.ListAfter(context.Background(), userID, sent[0].ID, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, sent[1:], resumed)
+
+	// Desde la última no queda nada pendiente
+	resumed, err = inbox.ListAfter(context.Background(), userID, sent[2].ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, resumed)
+}
+
+func TestNotificationInbox_InvalidResumeNotification(t *testing.T) {
+	inbox := newTestNotificationInbox(t)
+	userID := uuid.New()
+	appendNotifications(t, inbox, userID, 2)
+	others := appendNotifications(t, inbox, uuid.New(), 1)
+
+	tests := []struct {
+		name    string
+		afterID uuid.UUID
+	}{
+		{"unknown notification", uuid.New()},
+		{"notification of another user", others[0].ID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resumed, err := inbox.ListAfter(context.Background(), userID, tt.afterID, 10)
+
+			assert.Equal(t, entities.ErrNotificationNotFound, err)
+			assert.Nil(t, resumed)
+		})
+	}
+}
+
+func TestNotificationInbox_PurgedResumeNotification(t *testing.T) {
+	inbox := newTestNotificationInbox(t)
+	userID := uuid.New()
+	sent := appendNotifications(t, inbox, userID, 3)
+
+	deleted, err := inbox.DeleteOlderThan(context.Background(), sent[1].CreatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// El cliente que se quedó en la notificación depurada debe suscribirse de nuevo sin reanudar
+	_, err = inbox.ListAfter(context.Background(), userID, sent[0].ID, 10)
+	assert.Equal(t, entities.ErrNotificationNotFound, err)
+
+	resumed, err := inbox.ListAfter(context.Background(), userID, sent[1].ID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, notificationIDs(sent[2:]), notificationIDs(resumed))
+}
+
+func TestNotificationInbox_ReplayBacklogInBatches(t *testing.T) {
+	inbox := newTestNotificationInbox(t)
+	userID := uuid.New()
+	sent := appendNotifications(t, inbox, userID, 7)
+
+	// Igual que el reenvío del servidor: se pide por lotes a partir de la última entregada
+	var replayed []ports.Notification
+	afterID := sent[0].ID
+	for {
+		batch, err := inbox.ListAfter(context.Background(), userID, afterID, 2)
+		require.NoError(t, err)
+		replayed = append(replayed, batch...)
+		if len(batch) < 2 {
+			break
+		}
+		afterID = batch[len(batch)-1].ID
+	}
+
+	assert.Equal(t, notificationIDs(sent[1:]), notificationIDs(replayed))
+}
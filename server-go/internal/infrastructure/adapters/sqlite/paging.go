@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// countTotal cuenta las filas de where según mode; SQLite no tiene una estimación barata, así que
+// ports.CountEstimated cuenta exacto
+func countTotal(ctx context.Context, db querier, where string, args []any, mode ports.CountMode) (int, error) {
+	if mode == ports.CountNone {
+		return 0, nil
+	}
+	var total int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*)`+where, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// limitClause devuelve el LIMIT y OFFSET de la página; sin total pide una fila de más para saber
+// si hay otra página
+func limitClause(page, pageSize int, mode ports.CountMode) string {
+	if pageSize <= 0 {
+		return ""
+	}
+	limit := pageSize
+	if mode == ports.CountNone {
+		limit++
+	}
+	return fmt.Sprintf(" LIMIT %d OFFSET %d", limit, (page-1)*pageSize)
+}
@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const phoneNumberColumns = `user_id, number, code_hash, code_expires_at, attempts, verified_at, created_at, updated_at`
+
+type phoneNumberRepository struct {
+	db querier
+}
+
+// NewPhoneNumberRepository crea un nuevo repositorio de teléfonos y consumo de SMS
+func NewPhoneNumberRepository(db *sql.DB) ports.PhoneNumberRepository {
+	return &phoneNumberRepository{db: db}
+}
+
+// Save crea o reemplaza el teléfono de un usuario
+func (r *phoneNumberRepository) Save(ctx context.Context, phone *entities.PhoneNumber) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_phone_numbers (`+phoneNumberColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			number = excluded.number, code_hash = excluded.code_hash, code_expires_at = excluded.code_expires_at,
+			attempts = excluded.attempts, verified_at = excluded.verified_at, created_at = excluded.created_at,
+			updated_at = excluded.updated_at
+	`,
+		phone.UserID.String(),
+		phone.Number,
+		nullString(phone.CodeHash),
+		formatTime(phone.CodeExpiresAt),
+		phone.Attempts,
+		nullTime(phone.VerifiedAt),
+		formatTime(phone.CreatedAt),
+		formatTime(phone.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save phone number: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserID obtiene el teléfono de un usuario
+func (r *phoneNumberRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.PhoneNumber, error) {
+	var phone entities.PhoneNumber
+	var codeHash, verifiedAt sql.NullString
+	var codeExpiresAt, createdAt, updatedAt string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT `+phoneNumberColumns+` FROM user_phone_numbers WHERE user_id = ?`, userID.String(),
+	).Scan(
+		&phone.UserID,
+		&phone.Number,
+		&codeHash,
+		&codeExpiresAt,
+		&phone.Attempts,
+		&verifiedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrPhoneNumberNotFound
+		}
+		return nil, fmt.Errorf("failed to get phone number: %w", err)
+	}
+
+	phone.CodeHash = codeHash.String
+	if phone.CodeExpiresAt, err = parseTime(codeExpiresAt); err != nil {
+		return nil, fmt.Errorf("invalid code_expires_at: %w", err)
+	}
+	if phone.VerifiedAt, err = parseNullTime(verifiedAt); err != nil {
+		return nil, fmt.Errorf("invalid verified_at: %w", err)
+	}
+	if phone.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if phone.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+
+	return &phone, nil
+}
+
+// Delete elimina el teléfono de un usuario
+func (r *phoneNumberRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM user_phone_numbers WHERE user_id = ?`, userID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete phone number: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete phone number: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrPhoneNumberNotFound
+	}
+
+	return nil
+}
+
+// ReserveSMS suma un SMS al consumo del día en una sola sentencia, para que dos envíos simultáneos
+// no superen el límite
+func (r *phoneNumberRepository) ReserveSMS(ctx context.Context, userID uuid.UUID, day time.Time, limit int) (bool, error) {
+	if limit <= 0 {
+		return false, nil
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO sms_usage (user_id, day, count) VALUES (?, ?, 1)
+		ON CONFLICT (user_id, day) DO UPDATE SET count = count + 1 WHERE count < ?
+	`, userID.String(), day.UTC().Format(time.DateOnly), limit)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve SMS: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve SMS: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,311 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// progressSortColumns contiene las columnas por las que se permite ordenar el progreso
+var progressSortColumns = map[string]string{
+	"created_at":            "created_at",
+	"updated_at":            "updated_at",
+	"project_name":          "project_name",
+	"completion_percentage": "completion_percentage",
+}
+
+const progressColumns = `id, user_id, project_name, description, completion_percentage, milestones, custom_fields, created_at, updated_at, version`
+
+// milestoneRecord es la representación JSON de un hito dentro de la columna milestones
+type milestoneRecord struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	DueDate     time.Time  `json:"due_date"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// EstimatedEffortMinutes y EstimatedDurationMinutes se omiten en los hitos sin estimación
+	EstimatedEffortMinutes   int64 `json:"estimated_effort_minutes,omitempty"`
+	EstimatedDurationMinutes int64 `json:"estimated_duration_minutes,omitempty"`
+	// Weight se omite en los hitos con el peso por defecto
+	Weight float32 `json:"weight,omitempty"`
+}
+
+type progressRepository struct {
+	db querier
+}
+
+// NewProgressRepository crea un nuevo repositorio de progreso
+func NewProgressRepository(db *sql.DB) ports.ProgressRepository {
+	return &progressRepository{db: db}
+}
+
+// Create crea un nuevo registro de progreso
+func (r *progressRepository) Create(ctx context.Context, progress *entities.Progress) error {
+	milestones, customFields, err := encodeProgressJSON(progress)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO progress (`+progressColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		progress.ID.String(),
+		progress.UserID.String(),
+		progress.ProjectName,
+		progress.Description,
+		progress.CompletionPercentage,
+		milestones,
+		customFields,
+		formatTime(progress.CreatedAt),
+		formatTime(progress.UpdatedAt),
+		progress.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create progress: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un registro de progreso por su ID
+func (r *progressRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Progress, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+progressColumns+` FROM progress WHERE id = ?`, id.String())
+
+	progress, err := scanProgress(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrProgressNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress: %w", err)
+	}
+
+	return progress, nil
+}
+
+// GetByUserID obtiene los registros de progreso de un usuario
+func (r *progressRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.Progress, error) {
+	return r.query(ctx,
+		`SELECT `+progressColumns+` FROM progress WHERE user_id = ? ORDER BY created_at DESC`,
+		userID.String(),
+	)
+}
+
+// List obtiene los registros de progreso de un usuario con filtros
+func (r *progressRepository) List(ctx context.Context, userID uuid.UUID, filters ports.ProgressFilters) ([]*entities.Progress, int, error) {
+	where := ` FROM progress WHERE user_id = ?`
+	args := []any{userID.String()}
+
+	if filters.MinCompletion != nil {
+		where += ` AND completion_percentage >= ?`
+		args = append(args, *filters.MinCompletion)
+	}
+	if filters.MaxCompletion != nil {
+		where += ` AND completion_percentage <= ?`
+		args = append(args, *filters.MaxCompletion)
+	}
+	if filters.Completed != nil {
+		if *filters.Completed {
+			where += ` AND completion_percentage >= 100`
+		} else {
+			where += ` AND completion_percentage < 100`
+		}
+	}
+	if len(filters.CustomFields) > 0 {
+		conditions, conditionArgs, err := customFieldConditions(filters.CustomFields)
+		if err != nil {
+			return nil, 0, err
+		}
+		where += conditions
+		args = append(args, conditionArgs...)
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, ports.CountExact)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count progress: %w", err)
+	}
+
+	order, err := orderBy(filters.Sort, progressSortColumns, entities.SortField{Field: "created_at", Desc: true})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records, err := r.query(ctx, `SELECT `+progressColumns+where+order+limitClause(filters.Page, filters.PageSize, ports.CountExact), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, totalCount, nil
+}
+
+// Update actualiza un registro de progreso existente
+func (r *progressRepository) Update(ctx context.Context, progress *entities.Progress) error {
+	milestones, customFields, err := encodeProgressJSON(progress)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE progress
+		SET project_name = ?, description = ?, completion_percentage = ?, milestones = ?,
+		    custom_fields = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`,
+		progress.ProjectName,
+		progress.Description,
+		progress.CompletionPercentage,
+		milestones,
+		customFields,
+		formatTime(progress.UpdatedAt),
+		progress.ID.String(),
+		progress.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update progress: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update progress: %w", err)
+	}
+	if rowsAffected == 0 {
+		var exists bool
+		if err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM progress WHERE id = ?)`, progress.ID.String()).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check progress existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrProgressNotFound
+	}
+
+	progress.Version++
+	return nil
+}
+
+// Delete elimina un registro de progreso
+func (r *progressRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM progress WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete progress: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete progress: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrProgressNotFound
+	}
+
+	return nil
+}
+
+func (r *progressRepository) query(ctx context.Context, query string, args ...any) ([]*entities.Progress, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query progress: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*entities.Progress
+	for rows.Next() {
+		progress, err := scanProgress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan progress: %w", err)
+		}
+		records = append(records, progress)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating progress: %w", err)
+	}
+
+	return records, nil
+}
+
+func encodeProgressJSON(progress *entities.Progress) (string, string, error) {
+	milestones, err := encodeMilestones(progress.Milestones)
+	if err != nil {
+		return "", "", err
+	}
+	customFields, err := encodeCustomFields(progress.CustomFields)
+	if err != nil {
+		return "", "", err
+	}
+	return milestones, customFields, nil
+}
+
+func encodeMilestones(milestones []entities.ProgressMilestone) (string, error) {
+	records := make([]milestoneRecord, len(milestones))
+	for i, m := range milestones {
+		records[i] = milestoneRecord{
+			ID:                       m.ID,
+			Name:                     m.Name,
+			Description:              m.Description,
+			Completed:                m.Completed,
+			DueDate:                  m.DueDate,
+			CompletedAt:              m.CompletedAt,
+			EstimatedEffortMinutes:   int64(m.EstimatedEffort / time.Minute),
+			EstimatedDurationMinutes: int64(m.EstimatedDuration / time.Minute),
+			Weight:                   m.Weight,
+		}
+	}
+	return encodeJSON(records)
+}
+
+func scanProgress(row scanner) (*entities.Progress, error) {
+	var progress entities.Progress
+	var milestones, customFields, createdAt, updatedAt string
+
+	err := row.Scan(
+		&progress.ID,
+		&progress.UserID,
+		&progress.ProjectName,
+		&progress.Description,
+		&progress.CompletionPercentage,
+		&milestones,
+		&customFields,
+		&createdAt,
+		&updatedAt,
+		&progress.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if progress.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if progress.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+
+	var records []milestoneRecord
+	if err := decodeJSON(milestones, &records); err != nil {
+		return nil, fmt.Errorf("invalid milestones: %w", err)
+	}
+	if progress.CustomFields, err = decodeCustomFields(customFields); err != nil {
+		return nil, fmt.Errorf("invalid custom_fields: %w", err)
+	}
+	progress.Milestones = make([]entities.ProgressMilestone, len(records))
+	for i, m := range records {
+		progress.Milestones[i] = entities.ProgressMilestone{
+			ID:                m.ID,
+			Name:              m.Name,
+			Description:       m.Description,
+			Completed:         m.Completed,
+			DueDate:           m.DueDate,
+			CompletedAt:       m.CompletedAt,
+			EstimatedEffort:   time.Duration(m.EstimatedEffortMinutes) * time.Minute,
+			EstimatedDuration: time.Duration(m.EstimatedDurationMinutes) * time.Minute,
+			Weight:            m.Weight,
+		}
+	}
+
+	return &progress, nil
+}
@@ -0,0 +1,208 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const progressTemplateColumns = `id, owner_id, name, description, milestones, organization, created_at, updated_at`
+
+// templateMilestoneRecord es la representación JSON de un hito dentro de la columna milestones
+type templateMilestoneRecord struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	DueOffsetMinutes int64   `json:"due_offset_minutes"`
+	Weight           float32 `json:"weight,omitempty"`
+}
+
+type progressTemplateRepository struct {
+	db querier
+}
+
+// NewProgressTemplateRepository crea un nuevo repositorio de plantillas de progreso
+func NewProgressTemplateRepository(db *sql.DB) ports.ProgressTemplateRepository {
+	return &progressTemplateRepository{db: db}
+}
+
+// Create guarda una plantilla de progreso
+func (r *progressTemplateRepository) Create(ctx context.Context, template *entities.ProgressTemplate) error {
+	milestones, err := encodeTemplateMilestones(template.Milestones)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress template: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO progress_templates (`+progressTemplateColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		template.ID.String(),
+		template.OwnerID.String(),
+		template.Name,
+		template.Description,
+		milestones,
+		template.Organization,
+		formatTime(template.CreatedAt),
+		formatTime(template.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create progress template: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene una plantilla de progreso
+func (r *progressTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ProgressTemplate, error) {
+	template, err := scanProgressTemplate(r.db.QueryRowContext(ctx,
+		`SELECT `+progressTemplateColumns+` FROM progress_templates WHERE id = ?`, id.String(),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrProgressTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress template: %w", err)
+	}
+
+	return template, nil
+}
+
+// ListByOwner obtiene las plantillas de un usuario
+func (r *progressTemplateRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*entities.ProgressTemplate, error) {
+	return r.list(ctx, `owner_id = ?`, ownerID.String())
+}
+
+// ListByOrganization obtiene las plantillas compartidas con una organización
+func (r *progressTemplateRepository) ListByOrganization(ctx context.Context, organization string) ([]*entities.ProgressTemplate, error) {
+	return r.list(ctx, `organization = ? AND organization <> ''`, organization)
+}
+
+func (r *progressTemplateRepository) list(ctx context.Context, condition string, arg any) ([]*entities.ProgressTemplate, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+progressTemplateColumns+` FROM progress_templates WHERE `+condition+` ORDER BY name, id`, arg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query progress templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*entities.ProgressTemplate
+	for rows.Next() {
+		template, err := scanProgressTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan progress template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating progress templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// Update guarda los cambios de una plantilla de progreso
+func (r *progressTemplateRepository) Update(ctx context.Context, template *entities.ProgressTemplate) error {
+	milestones, err := encodeTemplateMilestones(template.Milestones)
+	if err != nil {
+		return fmt.Errorf("failed to encode progress template: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE progress_templates SET name = ?, description = ?, milestones = ?, organization = ?, updated_at = ? WHERE id = ?`,
+		template.Name,
+		template.Description,
+		milestones,
+		template.Organization,
+		formatTime(template.UpdatedAt),
+		template.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update progress template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrProgressTemplateNotFound
+	}
+
+	return nil
+}
+
+// Delete elimina una plantilla de progreso
+func (r *progressTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM progress_templates WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete progress template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete progress template: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrProgressTemplateNotFound
+	}
+
+	return nil
+}
+
+func scanProgressTemplate(row scanner) (*entities.ProgressTemplate, error) {
+	var template entities.ProgressTemplate
+	var milestones, createdAt, updatedAt string
+
+	err := row.Scan(
+		&template.ID,
+		&template.OwnerID,
+		&template.Name,
+		&template.Description,
+		&milestones,
+		&template.Organization,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []templateMilestoneRecord
+	if err := decodeJSON(milestones, &records); err != nil {
+		return nil, fmt.Errorf("invalid milestones: %w", err)
+	}
+	for _, m := range records {
+		template.Milestones = append(template.Milestones, entities.TemplateMilestone{
+			Name:        m.Name,
+			Description: m.Description,
+			DueOffset:   time.Duration(m.DueOffsetMinutes) * time.Minute,
+			Weight:      m.Weight,
+		})
+	}
+	if template.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if template.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+
+	return &template, nil
+}
+
+func encodeTemplateMilestones(milestones []entities.TemplateMilestone) (string, error) {
+	records := make([]templateMilestoneRecord, len(milestones))
+	for i, m := range milestones {
+		records[i] = templateMilestoneRecord{
+			Name:             m.Name,
+			Description:      m.Description,
+			DueOffsetMinutes: int64(m.DueOffset / time.Minute),
+			Weight:           m.Weight,
+		}
+	}
+	return encodeJSON(records)
+}
@@ -0,0 +1,474 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// reminderSortColumns contiene las columnas por las que se permite ordenar recordatorios
+var reminderSortColumns = map[string]string{
+	"scheduled_time": "scheduled_time",
+	"created_at":     "created_at",
+	"updated_at":     "updated_at",
+	"title":          "title",
+	"type":           "type",
+	"status":         "status",
+}
+
+const reminderColumns = `id, title, description, scheduled_time, type, status, recurring, recurrence_pattern, created_at, updated_at, user_id, notification_channels, idea_id, assignee_id, assignment_status, escalation_policy, escalation_level, acknowledged_at, progress_id, milestone_id, completes_reminder, version`
+
+// escalationPolicyRecord es la representación JSON de la columna escalation_policy
+type escalationPolicyRecord struct {
+	Steps           []escalationStepRecord `json:"steps"`
+	BackupContactID uuid.UUID              `json:"backup_contact_id"`
+}
+
+type escalationStepRecord struct {
+	AfterSeconds        int64    `json:"after_seconds"`
+	Channels            []string `json:"channels"`
+	NotifyBackupContact bool     `json:"notify_backup_contact,omitempty"`
+}
+
+type reminderRepository struct {
+	db querier
+}
+
+// NewReminderRepository crea un nuevo repositorio de recordatorios
+func NewReminderRepository(db *sql.DB) ports.ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+// Create crea un nuevo recordatorio
+func (r *reminderRepository) Create(ctx context.Context, reminder *entities.Reminder) error {
+	channels, err := encodeChannels(reminder.NotificationChannels)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	policy, err := encodeEscalationPolicy(reminder.EscalationPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	progressID, milestoneID, completesReminder := encodeMilestoneLink(reminder.MilestoneLink)
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO reminders (`+reminderColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		reminder.ID.String(),
+		reminder.Title,
+		reminder.Description,
+		formatTime(reminder.ScheduledTime),
+		int(reminder.Type),
+		int(reminder.Status),
+		reminder.Recurring,
+		int(reminder.RecurrencePattern),
+		formatTime(reminder.CreatedAt),
+		formatTime(reminder.UpdatedAt),
+		reminder.UserID.String(),
+		channels,
+		nullIdeaID(reminder.IdeaID),
+		nullIdeaID(reminder.AssigneeID),
+		int(reminder.AssignmentStatus),
+		policy,
+		reminder.EscalationLevel,
+		nullTime(reminder.AcknowledgedAt),
+		progressID,
+		milestoneID,
+		completesReminder,
+		reminder.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reminder: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un recordatorio por su ID
+func (r *reminderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Reminder, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+reminderColumns+` FROM reminders WHERE id = ?`, id.String())
+
+	reminder, err := scanReminder(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrReminderNotFound
+		}
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	return reminder, nil
+}
+
+// GetByUserID obtiene los recordatorios de un usuario con filtros
+func (r *reminderRepository) GetByUserID(ctx context.Context, userID uuid.UUID, filters ports.ReminderFilters) ([]*entities.Reminder, int, error) {
+	var where string
+	var args []any
+	switch filters.Scope {
+	case entities.ReminderScopeCreatedByMe:
+		where = ` FROM reminders WHERE user_id = ?`
+		args = []any{userID.String()}
+	case entities.ReminderScopeAssignedToMe:
+		where = ` FROM reminders WHERE assignee_id = ? AND assignment_status IN (?, ?)`
+		args = []any{userID.String(), int(entities.ReminderAssignmentPending), int(entities.ReminderAssignmentAccepted)}
+	default:
+		where = ` FROM reminders WHERE (user_id = ? OR (assignee_id = ? AND assignment_status IN (?, ?)))`
+		args = []any{userID.String(), userID.String(), int(entities.ReminderAssignmentPending), int(entities.ReminderAssignmentAccepted)}
+	}
+
+	if filters.Type != entities.ReminderTypeUnspecified {
+		where += ` AND type = ?`
+		args = append(args, int(filters.Type))
+	}
+
+	if filters.Status != entities.ReminderStatusUnspecified {
+		where += ` AND status = ?`
+		args = append(args, int(filters.Status))
+	}
+
+	if filters.FromDate != nil {
+		from, err := time.Parse(time.RFC3339, *filters.FromDate)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid from date: %w", err)
+		}
+		where += ` AND scheduled_time >= ?`
+		args = append(args, formatTime(from))
+	}
+
+	if filters.ToDate != nil {
+		to, err := time.Parse(time.RFC3339, *filters.ToDate)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid to date: %w", err)
+		}
+		where += ` AND scheduled_time <= ?`
+		args = append(args, formatTime(to))
+	}
+
+	totalCount, err := countTotal(ctx, r.db, where, args, filters.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count reminders: %w", err)
+	}
+
+	order, err := orderBy(filters.Sort, reminderSortColumns, entities.SortField{Field: "scheduled_time"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	selectQuery := `SELECT ` + reminderColumns + where + order + limitClause(filters.Page, filters.PageSize, filters.Count)
+
+	reminders, err := r.query(ctx, selectQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reminders, totalCount = ports.PageTotal(reminders, totalCount, filters.Page, filters.PageSize, filters.Count)
+	return reminders, totalCount, nil
+}
+
+// Update actualiza un recordatorio existente
+func (r *reminderRepository) Update(ctx context.Context, reminder *entities.Reminder) error {
+	channels, err := encodeChannels(reminder.NotificationChannels)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	policy, err := encodeEscalationPolicy(reminder.EscalationPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+	progressID, milestoneID, completesReminder := encodeMilestoneLink(reminder.MilestoneLink)
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE reminders
+		SET title = ?, description = ?, scheduled_time = ?, type = ?, status = ?, recurring = ?,
+		    recurrence_pattern = ?, updated_at = ?, notification_channels = ?, idea_id = ?, assignee_id = ?,
+		    assignment_status = ?, escalation_policy = ?, escalation_level = ?, acknowledged_at = ?,
+		    progress_id = ?, milestone_id = ?, completes_reminder = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`,
+		reminder.Title,
+		reminder.Description,
+		formatTime(reminder.ScheduledTime),
+		int(reminder.Type),
+		int(reminder.Status),
+		reminder.Recurring,
+		int(reminder.RecurrencePattern),
+		formatTime(reminder.UpdatedAt),
+		channels,
+		nullIdeaID(reminder.IdeaID),
+		nullIdeaID(reminder.AssigneeID),
+		int(reminder.AssignmentStatus),
+		policy,
+		reminder.EscalationLevel,
+		nullTime(reminder.AcknowledgedAt),
+		progressID,
+		milestoneID,
+		completesReminder,
+		reminder.ID.String(),
+		reminder.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update reminder: %w", err)
+	}
+	if rowsAffected == 0 {
+		var exists bool
+		if err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM reminders WHERE id = ?)`, reminder.ID.String()).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check reminder existence: %w", err)
+		}
+		if exists {
+			return entities.ErrVersionConflict
+		}
+		return entities.ErrReminderNotFound
+	}
+
+	reminder.Version++
+	return nil
+}
+
+// Delete elimina un recordatorio
+func (r *reminderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM reminders WHERE id = ?`, id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrReminderNotFound
+	}
+
+	return nil
+}
+
+// GetOverdueReminders obtiene los recordatorios pendientes o activos cuya hora ya pasó
+func (r *reminderRepository) GetOverdueReminders(ctx context.Context) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status IN (?, ?) AND scheduled_time < ? ORDER BY scheduled_time ASC`,
+		int(entities.ReminderStatusPending),
+		int(entities.ReminderStatusActive),
+		formatTime(time.Now()),
+	)
+}
+
+// GetEscalationCandidates obtiene los recordatorios Deadline vencidos, sin confirmar y con política de escalado
+func (r *reminderRepository) GetEscalationCandidates(ctx context.Context) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status = ? AND type = ? AND escalation_policy IS NOT NULL
+		 AND acknowledged_at IS NULL ORDER BY scheduled_time ASC`,
+		int(entities.ReminderStatusOverdue),
+		int(entities.ReminderTypeDeadline),
+	)
+}
+
+// GetUpcomingByIdeaIDs obtiene los recordatorios sin completar vinculados a esas ideas que vencen antes de before
+func (r *reminderRepository) GetUpcomingByIdeaIDs(ctx context.Context, ideaIDs []uuid.UUID, before time.Time) ([]*entities.Reminder, error) {
+	if len(ideaIDs) == 0 {
+		return nil, nil
+	}
+
+	args := []any{
+		int(entities.ReminderStatusPending),
+		int(entities.ReminderStatusActive),
+		int(entities.ReminderStatusOverdue),
+		formatTime(before),
+	}
+	for _, id := range ideaIDs {
+		args = append(args, id.String())
+	}
+
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE status IN (?, ?, ?) AND scheduled_time < ?
+		 AND idea_id IN (`+placeholders(len(ideaIDs))+`) ORDER BY scheduled_time ASC`,
+		args...,
+	)
+}
+
+// GetByMilestoneID obtiene los recordatorios vinculados al hito
+func (r *reminderRepository) GetByMilestoneID(ctx context.Context, milestoneID uuid.UUID) ([]*entities.Reminder, error) {
+	return r.query(ctx,
+		`SELECT `+reminderColumns+` FROM reminders WHERE milestone_id = ? ORDER BY scheduled_time ASC`,
+		milestoneID.String(),
+	)
+}
+
+func (r *reminderRepository) query(ctx context.Context, query string, args ...any) ([]*entities.Reminder, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*entities.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+func encodeChannels(channels []string) (string, error) {
+	if channels == nil {
+		channels = []string{}
+	}
+	return encodeJSON(channels)
+}
+
+// encodeEscalationPolicy guarda como NULL los recordatorios sin política de escalado
+func encodeEscalationPolicy(policy *entities.ReminderEscalationPolicy) (sql.NullString, error) {
+	if policy == nil {
+		return sql.NullString{}, nil
+	}
+	record := escalationPolicyRecord{
+		Steps:           make([]escalationStepRecord, len(policy.Steps)),
+		BackupContactID: policy.BackupContactID,
+	}
+	for i, step := range policy.Steps {
+		record.Steps[i] = escalationStepRecord{
+			AfterSeconds:        int64(step.After / time.Second),
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	encoded, err := encodeJSON(record)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: encoded, Valid: true}, nil
+}
+
+func decodeEscalationPolicy(encoded string) (*entities.ReminderEscalationPolicy, error) {
+	var record escalationPolicyRecord
+	if err := decodeJSON(encoded, &record); err != nil {
+		return nil, err
+	}
+	policy := &entities.ReminderEscalationPolicy{
+		Steps:           make([]entities.ReminderEscalationStep, len(record.Steps)),
+		BackupContactID: record.BackupContactID,
+	}
+	for i, step := range record.Steps {
+		policy.Steps[i] = entities.ReminderEscalationStep{
+			After:               time.Duration(step.AfterSeconds) * time.Second,
+			Channels:            step.Channels,
+			NotifyBackupContact: step.NotifyBackupContact,
+		}
+	}
+	return policy, nil
+}
+
+// nullIdeaID guarda como NULL las referencias opcionales vacías (idea_id, assignee_id, milestone_id)
+func nullIdeaID(id uuid.UUID) sql.NullString {
+	if id == uuid.Nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
+
+// encodeMilestoneLink guarda como NULL el hito de los recordatorios sin vincular
+func encodeMilestoneLink(link *entities.ReminderMilestoneLink) (progressID, milestoneID sql.NullString, completesReminder bool) {
+	if link == nil {
+		return sql.NullString{}, sql.NullString{}, false
+	}
+	return nullIdeaID(link.ProgressID), nullIdeaID(link.MilestoneID), link.CompletesReminder
+}
+
+func scanReminder(row scanner) (*entities.Reminder, error) {
+	var reminder entities.Reminder
+	var scheduledTime, createdAt, updatedAt, channels string
+	var reminderType, status, pattern, assignmentStatus int
+	var ideaID, assigneeID, policy, acknowledgedAt, progressID, milestoneID sql.NullString
+	var completesReminder bool
+
+	err := row.Scan(
+		&reminder.ID,
+		&reminder.Title,
+		&reminder.Description,
+		&scheduledTime,
+		&reminderType,
+		&status,
+		&reminder.Recurring,
+		&pattern,
+		&createdAt,
+		&updatedAt,
+		&reminder.UserID,
+		&channels,
+		&ideaID,
+		&assigneeID,
+		&assignmentStatus,
+		&policy,
+		&reminder.EscalationLevel,
+		&acknowledgedAt,
+		&progressID,
+		&milestoneID,
+		&completesReminder,
+		&reminder.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder.Type = entities.ReminderType(reminderType)
+	reminder.Status = entities.ReminderStatus(status)
+	reminder.RecurrencePattern = entities.RecurrencePattern(pattern)
+	reminder.AssignmentStatus = entities.ReminderAssignmentStatus(assignmentStatus)
+
+	if reminder.ScheduledTime, err = parseTime(scheduledTime); err != nil {
+		return nil, fmt.Errorf("invalid scheduled_time: %w", err)
+	}
+	if reminder.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if reminder.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+	if err := decodeJSON(channels, &reminder.NotificationChannels); err != nil {
+		return nil, fmt.Errorf("invalid notification_channels: %w", err)
+	}
+	if ideaID.Valid {
+		if reminder.IdeaID, err = uuid.Parse(ideaID.String); err != nil {
+			return nil, fmt.Errorf("invalid idea_id: %w", err)
+		}
+	}
+	if assigneeID.Valid {
+		if reminder.AssigneeID, err = uuid.Parse(assigneeID.String); err != nil {
+			return nil, fmt.Errorf("invalid assignee_id: %w", err)
+		}
+	}
+	if policy.Valid {
+		if reminder.EscalationPolicy, err = decodeEscalationPolicy(policy.String); err != nil {
+			return nil, fmt.Errorf("invalid escalation_policy: %w", err)
+		}
+	}
+	if reminder.AcknowledgedAt, err = parseNullTime(acknowledgedAt); err != nil {
+		return nil, fmt.Errorf("invalid acknowledged_at: %w", err)
+	}
+	if milestoneID.Valid {
+		link := &entities.ReminderMilestoneLink{CompletesReminder: completesReminder}
+		if link.ProgressID, err = uuid.Parse(progressID.String); err != nil {
+			return nil, fmt.Errorf("invalid progress_id: %w", err)
+		}
+		if link.MilestoneID, err = uuid.Parse(milestoneID.String); err != nil {
+			return nil, fmt.Errorf("invalid milestone_id: %w", err)
+		}
+		reminder.MilestoneLink = link
+	}
+
+	return &reminder, nil
+}
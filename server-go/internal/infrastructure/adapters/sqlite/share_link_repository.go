@@ -0,0 +1,197 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const shareLinkColumns = `id, file_id, user_id, token_hash, password_hash, expires_at, max_downloads, download_count, created_at, revoked_at`
+
+type shareLinkRepository struct {
+	db querier
+}
+
+// NewShareLinkRepository crea un nuevo repositorio de enlaces de descarga compartida
+func NewShareLinkRepository(db *sql.DB) ports.ShareLinkRepository {
+	return &shareLinkRepository{db: db}
+}
+
+// Create registra un enlace compartido
+func (r *shareLinkRepository) Create(ctx context.Context, link *entities.ShareLink) error {
+	var revokedAt sql.NullString
+	if link.RevokedAt != nil {
+		revokedAt = sql.NullString{String: formatTime(*link.RevokedAt), Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO file_share_links (`+shareLinkColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		link.ID.String(),
+		link.FileID.String(),
+		link.UserID.String(),
+		link.TokenHash,
+		link.PasswordHash,
+		formatTime(link.ExpiresAt),
+		link.MaxDownloads,
+		link.DownloadCount,
+		formatTime(link.CreatedAt),
+		revokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID obtiene un enlace compartido por su ID
+func (r *shareLinkRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ShareLink, error) {
+	return r.getOne(ctx, `SELECT `+shareLinkColumns+` FROM file_share_links WHERE id = ?`, id.String())
+}
+
+// GetByTokenHash obtiene un enlace compartido por el hash de su token
+func (r *shareLinkRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.ShareLink, error) {
+	return r.getOne(ctx, `SELECT `+shareLinkColumns+` FROM file_share_links WHERE token_hash = ?`, tokenHash)
+}
+
+// ListByFileID obtiene los enlaces de un archivo, del más reciente al más antiguo
+func (r *shareLinkRepository) ListByFileID(ctx context.Context, fileID uuid.UUID) ([]*entities.ShareLink, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+shareLinkColumns+` FROM file_share_links WHERE file_id = ? ORDER BY created_at DESC`,
+		fileID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*entities.ShareLink
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate share links: %w", err)
+	}
+
+	return links, nil
+}
+
+// Revoke marca un enlace compartido como revocado; revocar dos veces conserva la primera fecha
+func (r *shareLinkRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE file_share_links SET revoked_at = COALESCE(revoked_at, ?) WHERE id = ?`,
+		formatTime(revokedAt), id.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrShareLinkNotFound
+	}
+
+	return nil
+}
+
+// IncrementDownloads suma una descarga si el enlace no alcanzó su límite
+func (r *shareLinkRepository) IncrementDownloads(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE file_share_links SET download_count = download_count + 1
+		WHERE id = ? AND (max_downloads = 0 OR download_count < max_downloads)`,
+		id.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to increment share link downloads: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to increment share link downloads: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrShareLinkDownloadLimitReached
+	}
+
+	return nil
+}
+
+// RecordAccess guarda una entrada de auditoría de un intento de descarga
+func (r *shareLinkRepository) RecordAccess(ctx context.Context, access *entities.ShareLinkAccess) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO file_share_link_access (id, link_id, outcome, client_ip, user_agent, accessed_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		access.ID.String(),
+		access.LinkID.String(),
+		string(access.Outcome),
+		access.ClientIP,
+		access.UserAgent,
+		formatTime(access.AccessedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record share link access: %w", err)
+	}
+
+	return nil
+}
+
+func (r *shareLinkRepository) getOne(ctx context.Context, query string, arg any) (*entities.ShareLink, error) {
+	link, err := scanShareLink(r.db.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+
+	return link, nil
+}
+
+func scanShareLink(row scanner) (*entities.ShareLink, error) {
+	var link entities.ShareLink
+	var expiresAt, createdAt string
+	var revokedAt sql.NullString
+	err := row.Scan(
+		&link.ID,
+		&link.FileID,
+		&link.UserID,
+		&link.TokenHash,
+		&link.PasswordHash,
+		&expiresAt,
+		&link.MaxDownloads,
+		&link.DownloadCount,
+		&createdAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.ExpiresAt, err = parseTime(expiresAt); err != nil {
+		return nil, fmt.Errorf("invalid expires_at: %w", err)
+	}
+	if link.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if revokedAt.Valid {
+		revoked, err := parseTime(revokedAt.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revoked_at: %w", err)
+		}
+		link.RevokedAt = &revoked
+	}
+
+	return &link, nil
+}
@@ -0,0 +1,32 @@
+package sqlite
+
+import (
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// orderBy construye la cláusula ORDER BY de sortFields, sin criterios usa defaultSort. Solo
+// admite los campos de columns, así que nunca se interpola texto del cliente, y desempata por id
+// para que las páginas no repitan ni salten filas con valores iguales.
+func orderBy(sortFields []entities.SortField, columns map[string]string, defaultSort entities.SortField) (string, error) {
+	if len(sortFields) == 0 {
+		sortFields = []entities.SortField{defaultSort}
+	}
+
+	terms := make([]string, 0, len(sortFields)+1)
+	for _, field := range sortFields {
+		column, ok := columns[field.Field]
+		if !ok {
+			return "", entities.ErrInvalidSortField
+		}
+		direction := "ASC"
+		if field.Desc {
+			direction = "DESC"
+		}
+		terms = append(terms, column+" "+direction)
+	}
+	terms = append(terms, "id ASC")
+
+	return " ORDER BY " + strings.Join(terms, ", "), nil
+}
@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type statisticsRepository struct {
+	db querier
+}
+
+// NewStatisticsRepository crea un nuevo repositorio de estadísticas materializadas
+func NewStatisticsRepository(db *sql.DB) ports.StatisticsRepository {
+	return &statisticsRepository{db: db}
+}
+
+// Get obtiene las estadísticas materializadas de un usuario
+func (r *statisticsRepository) Get(ctx context.Context, userID uuid.UUID) (*entities.UserStatistics, error) {
+	var stats entities.UserStatistics
+	var byStatus, byCategory, updatedAt string
+	var weekStart sql.NullString
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, ideas_by_status, ideas_by_category, reminders_due_this_week, week_start,
+		       storage_files, storage_bytes, updated_at
+		FROM user_statistics WHERE user_id = ?
+	`, userID.String()).Scan(
+		&stats.UserID,
+		&byStatus,
+		&byCategory,
+		&stats.RemindersDueThisWeek,
+		&weekStart,
+		&stats.StorageFiles,
+		&stats.StorageBytes,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrStatisticsNotFound
+		}
+		return nil, fmt.Errorf("failed to get statistics: %w", err)
+	}
+
+	if err := decodeJSON(byStatus, &stats.IdeasByStatus); err != nil {
+		return nil, fmt.Errorf("invalid ideas_by_status: %w", err)
+	}
+	if err := decodeJSON(byCategory, &stats.IdeasByCategory); err != nil {
+		return nil, fmt.Errorf("invalid ideas_by_category: %w", err)
+	}
+	// Sin semana calculada queda en cero y se trata como desactualizada
+	if start, err := parseNullTime(weekStart); err != nil {
+		return nil, fmt.Errorf("invalid week_start: %w", err)
+	} else if start != nil {
+		stats.WeekStart = *start
+	}
+	if stats.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+
+	return &stats, nil
+}
+
+// RefreshIdeas recalcula los conteos de ideas por estado y por categoría de un usuario
+func (r *statisticsRepository) RefreshIdeas(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT status, category, COUNT(*) FROM ideas WHERE user_id = ? GROUP BY status, category`,
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to count ideas: %w", err)
+	}
+	defer rows.Close()
+
+	byStatus := make(map[entities.IdeaStatus]int)
+	byCategory := make(map[entities.IdeaCategory]int)
+	for rows.Next() {
+		var status, category, count int
+		if err := rows.Scan(&status, &category, &count); err != nil {
+			return fmt.Errorf("failed to scan idea counts: %w", err)
+		}
+		byStatus[entities.IdeaStatus(status)] += count
+		byCategory[entities.IdeaCategory(category)] += count
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating idea counts: %w", err)
+	}
+
+	statusJSON, err := encodeJSON(byStatus)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea counts: %w", err)
+	}
+	categoryJSON, err := encodeJSON(byCategory)
+	if err != nil {
+		return fmt.Errorf("failed to encode idea counts: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO user_statistics (user_id, ideas_by_status, ideas_by_category, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			ideas_by_status = excluded.ideas_by_status, ideas_by_category = excluded.ideas_by_category,
+			updated_at = excluded.updated_at
+	`, userID.String(), statusJSON, categoryJSON, formatTime(now))
+	if err != nil {
+		return fmt.Errorf("failed to save idea statistics: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshReminders recalcula los recordatorios pendientes de un usuario que vencen en la semana
+func (r *statisticsRepository) RefreshReminders(ctx context.Context, userID uuid.UUID, weekStart, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_statistics (user_id, reminders_due_this_week, week_start, updated_at)
+		VALUES (?, (
+			SELECT COUNT(*) FROM reminders
+			WHERE user_id = ? AND scheduled_time >= ? AND scheduled_time < ? AND status NOT IN (?, ?)
+		), ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			reminders_due_this_week = excluded.reminders_due_this_week, week_start = excluded.week_start,
+			updated_at = excluded.updated_at
+	`,
+		userID.String(),
+		userID.String(),
+		formatTime(weekStart),
+		formatTime(weekStart.AddDate(0, 0, 7)),
+		int(entities.ReminderStatusCompleted),
+		int(entities.ReminderStatusCancelled),
+		formatTime(weekStart),
+		formatTime(now),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save reminder statistics: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshStorage recalcula los archivos y el espacio usados por un usuario; los archivos físicos
+// compartidos por varias versiones se cuentan una sola vez
+func (r *statisticsRepository) RefreshStorage(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	id := userID.String()
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_statistics (user_id, storage_files, storage_bytes, updated_at)
+		VALUES (?,
+			(SELECT COUNT(DISTINCT logical_id) FROM files WHERE user_id = ?),
+			(SELECT COALESCE(SUM(size), 0) FROM (SELECT DISTINCT path, size FROM files WHERE user_id = ?)),
+			?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			storage_files = excluded.storage_files, storage_bytes = excluded.storage_bytes,
+			updated_at = excluded.updated_at
+	`, id, id, id, formatTime(now))
+	if err != nil {
+		return fmt.Errorf("failed to save storage statistics: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const timeEntryColumns = `id, user_id, progress_id, milestone_id, started_at, stopped_at`
+
+type timeEntryRepository struct {
+	db querier
+}
+
+// NewTimeEntryRepository crea un nuevo repositorio de registros de tiempo
+func NewTimeEntryRepository(db *sql.DB) ports.TimeEntryRepository {
+	return &timeEntryRepository{db: db}
+}
+
+// Create pone en marcha un cronómetro; el índice único parcial impide que un usuario tenga dos
+func (r *timeEntryRepository) Create(ctx context.Context, entry *entities.TimeEntry) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO time_entries (`+timeEntryColumns+`) VALUES (?, ?, ?, ?, ?, ?)`,
+		entry.ID.String(),
+		entry.UserID.String(),
+		entry.ProgressID.String(),
+		entry.MilestoneID.String(),
+		formatTime(entry.StartedAt),
+		nullTime(entry.StoppedAt),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return entities.ErrTimerAlreadyRunning
+		}
+		return fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	return nil
+}
+
+// Stop guarda el fin de un cronómetro que sigue en marcha
+func (r *timeEntryRepository) Stop(ctx context.Context, entry *entities.TimeEntry) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE time_entries SET stopped_at = ? WHERE id = ? AND stopped_at IS NULL`,
+		nullTime(entry.StoppedAt), entry.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stop time entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entities.ErrNoRunningTimer
+	}
+
+	return nil
+}
+
+// GetRunning obtiene el cronómetro en marcha de un usuario
+func (r *timeEntryRepository) GetRunning(ctx context.Context, userID uuid.UUID) (*entities.TimeEntry, error) {
+	entry, err := scanTimeEntry(r.db.QueryRowContext(ctx,
+		`SELECT `+timeEntryColumns+` FROM time_entries WHERE user_id = ? AND stopped_at IS NULL`, userID.String(),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("failed to get running time entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// ListByProgress obtiene los registros de tiempo de un proyecto
+func (r *timeEntryRepository) ListByProgress(ctx context.Context, progressID uuid.UUID) ([]*entities.TimeEntry, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+timeEntryColumns+` FROM time_entries WHERE progress_id = ? ORDER BY started_at, id`, progressID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan time entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate time entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func scanTimeEntry(row scanner) (*entities.TimeEntry, error) {
+	var entry entities.TimeEntry
+	var startedAt string
+	var stoppedAt sql.NullString
+	if err := row.Scan(&entry.ID, &entry.UserID, &entry.ProgressID, &entry.MilestoneID, &startedAt, &stoppedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if entry.StartedAt, err = parseTime(startedAt); err != nil {
+		return nil, fmt.Errorf("invalid started_at: %w", err)
+	}
+	if entry.StoppedAt, err = parseNullTime(stoppedAt); err != nil {
+		return nil, fmt.Errorf("invalid stopped_at: %w", err)
+	}
+	return &entry, nil
+}
@@ -0,0 +1,64 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// querier es el subconjunto común de *sql.DB y *sql.Tx usado por los repositorios
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type unitOfWork struct {
+	db *sql.DB
+}
+
+// NewUnitOfWork crea una nueva unidad de trabajo sobre la base de datos
+func NewUnitOfWork(db *sql.DB) ports.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Begin inicia una nueva transacción
+func (u *unitOfWork) Begin(ctx context.Context) (ports.Tx, error) {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &sqliteTx{tx: tx}, nil
+}
+
+type sqliteTx struct {
+	tx *sql.Tx
+}
+
+// Commit confirma la transacción
+func (t *sqliteTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Rollback revierte la transacción; no falla si ya fue confirmada
+func (t *sqliteTx) Rollback(ctx context.Context) error {
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// Ideas devuelve el repositorio de ideas ligado a la transacción
+func (t *sqliteTx) Ideas() ports.IdeaRepository {
+	return &ideaRepository{db: t.tx}
+}
+
+// Files devuelve el repositorio de archivos ligado a la transacción
+func (t *sqliteTx) Files() ports.FileRepository {
+	return &fileRepository{db: t.tx}
+}
@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type weeklySummaryRepository struct {
+	db querier
+}
+
+// NewWeeklySummaryRepository crea un nuevo repositorio de resúmenes semanales
+func NewWeeklySummaryRepository(db *sql.DB) ports.WeeklySummaryRepository {
+	return &weeklySummaryRepository{db: db}
+}
+
+// CountActivity cuenta las ideas creadas y los recordatorios completados entre from y to, y los
+// recordatorios vencidos a to. Los recordatorios no guardan cuándo se completaron, así que se
+// usa su última modificación.
+func (r *weeklySummaryRepository) CountActivity(ctx context.Context, userID uuid.UUID, from, to time.Time) (entities.WeeklyActivity, error) {
+	var activity entities.WeeklyActivity
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM ideas WHERE user_id = ? AND created_at >= ? AND created_at < ?),
+			(SELECT COUNT(*) FROM reminders WHERE user_id = ? AND status = ? AND updated_at >= ? AND updated_at < ?),
+			(SELECT COUNT(*) FROM reminders WHERE user_id = ? AND scheduled_time < ? AND status NOT IN (?, ?))
+	`,
+		userID.String(), formatTime(from), formatTime(to),
+		userID.String(), int(entities.ReminderStatusCompleted), formatTime(from), formatTime(to),
+		userID.String(), formatTime(to), int(entities.ReminderStatusCompleted), int(entities.ReminderStatusCancelled),
+	).Scan(&activity.IdeasCreated, &activity.RemindersCompleted, &activity.OverdueReminders)
+	if err != nil {
+		return entities.WeeklyActivity{}, fmt.Errorf("failed to count weekly activity: %w", err)
+	}
+
+	return activity, nil
+}
+
+// GetLatest obtiene el último resumen semanal enviado a un usuario
+func (r *weeklySummaryRepository) GetLatest(ctx context.Context, userID uuid.UUID) (*entities.WeeklySummary, error) {
+	var summary entities.WeeklySummary
+	var periodStart, periodEnd, projects, sentAt string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT user_id, period_start, period_end, ideas_created, reminders_completed, overdue_reminders,
+			overdue_milestones, projects, sent_at
+		FROM weekly_summaries
+		WHERE user_id = ?`,
+		userID.String(),
+	).Scan(
+		&summary.UserID,
+		&periodStart,
+		&periodEnd,
+		&summary.IdeasCreated,
+		&summary.RemindersCompleted,
+		&summary.OverdueReminders,
+		&summary.OverdueMilestones,
+		&projects,
+		&sentAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entities.ErrWeeklySummaryNotFound
+		}
+		return nil, fmt.Errorf("failed to get weekly summary: %w", err)
+	}
+
+	if summary.PeriodStart, err = parseTime(periodStart); err != nil {
+		return nil, fmt.Errorf("failed to parse weekly summary period: %w", err)
+	}
+	if summary.PeriodEnd, err = parseTime(periodEnd); err != nil {
+		return nil, fmt.Errorf("failed to parse weekly summary period: %w", err)
+	}
+	if summary.SentAt, err = parseTime(sentAt); err != nil {
+		return nil, fmt.Errorf("failed to parse weekly summary time: %w", err)
+	}
+	if err := decodeJSON(projects, &summary.Projects); err != nil {
+		return nil, fmt.Errorf("failed to decode weekly summary projects: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// SaveLatest reemplaza el último resumen semanal enviado a un usuario
+func (r *weeklySummaryRepository) SaveLatest(ctx context.Context, summary *entities.WeeklySummary) error {
+	projects, err := encodeJSON(summary.Projects)
+	if err != nil {
+		return fmt.Errorf("failed to encode weekly summary projects: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO weekly_summaries (
+			user_id, period_start, period_end, ideas_created, reminders_completed, overdue_reminders,
+			overdue_milestones, projects, sent_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			period_start = excluded.period_start, period_end = excluded.period_end,
+			ideas_created = excluded.ideas_created, reminders_completed = excluded.reminders_completed,
+			overdue_reminders = excluded.overdue_reminders, overdue_milestones = excluded.overdue_milestones,
+			projects = excluded.projects, sent_at = excluded.sent_at`,
+		summary.UserID.String(),
+		formatTime(summary.PeriodStart),
+		formatTime(summary.PeriodEnd),
+		summary.IdeasCreated,
+		summary.RemindersCompleted,
+		summary.OverdueReminders,
+		summary.OverdueMilestones,
+		projects,
+		formatTime(summary.SentAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save weekly summary: %w", err)
+	}
+
+	return nil
+}
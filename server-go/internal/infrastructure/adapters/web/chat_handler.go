@@ -0,0 +1,340 @@
+package web
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/notifications"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ChatPathPrefix es la ruta de los webhooks de los bots: /chat/telegram recibe las actualizaciones
+// de Telegram y /chat/slack/commands y /chat/slack/actions los comandos y botones de Slack
+const ChatPathPrefix = "/chat/"
+
+const (
+	telegramChatPath      = "telegram"
+	slackCommandsChatPath = "slack/commands"
+	slackActionsChatPath  = "slack/actions"
+)
+
+// chatMaxBodySize limita el tamaño de las peticiones de los bots, que solo traen texto
+const chatMaxBodySize = 1 << 20
+
+// ChatConfig configura el handler de los bots de chat
+type ChatConfig struct {
+	// TelegramSecret es el secret_token registrado con setWebhook; vacío desactiva el webhook de Telegram
+	TelegramSecret string
+	// SlackSigningSecret firma las peticiones de Slack; vacío desactiva los webhooks de Slack
+	SlackSigningSecret string
+	// Translator, si no es nil, traduce las respuestas al idioma del dueño del chat o, en Telegram,
+	// al de la aplicación de quien escribe
+	Translator *i18n.Translator
+	// Preferences obtiene el idioma preferido del dueño de un chat vinculado
+	Preferences i18n.Preferences
+}
+
+// ChatHandler recibe los mensajes y las acciones de los bots de Slack y de Telegram: confirma los
+// vínculos con el código que el usuario envía desde el chat y ejecuta los botones de las notificaciones
+type ChatHandler struct {
+	chat     *usecases.ChatUseCases
+	telegram *notifications.TelegramSender
+	slack    *notifications.SlackSender
+	config   ChatConfig
+	logger   *zap.Logger
+}
+
+// NewChatHandler crea el handler de los bots; telegram o slack pueden ser nil si no se configuraron
+func NewChatHandler(chat *usecases.ChatUseCases, telegram *notifications.TelegramSender, slack *notifications.SlackSender, config ChatConfig, logger *zap.Logger) *ChatHandler {
+	return &ChatHandler{
+		chat:     chat,
+		telegram: telegram,
+		slack:    slack,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *ChatHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, chatMaxBodySize))
+	if err != nil {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	switch path := strings.TrimPrefix(r.URL.Path, ChatPathPrefix); path {
+	case telegramChatPath:
+		if h.telegram == nil || !h.validTelegramSecret(r) {
+			http.NotFound(w, r)
+			return
+		}
+		h.serveTelegram(w, r, body)
+	case slackCommandsChatPath, slackActionsChatPath:
+		if h.slack == nil || h.config.SlackSigningSecret == "" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := notifications.VerifySlackSignature(h.config.SlackSigningSecret, r.Header, body, time.Now()); err != nil {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			http.Error(w, "invalid form", http.StatusBadRequest)
+			return
+		}
+		if path == slackCommandsChatPath {
+			h.serveSlackCommand(w, r, form)
+		} else {
+			h.serveSlackAction(w, r, form)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// telegramUpdate es la parte de una actualización de Telegram que usa el bot
+type telegramUpdate struct {
+	Message *struct {
+		Chat telegramChat `json:"chat"`
+		From telegramUser `json:"from"`
+		Text string       `json:"text"`
+	} `json:"message"`
+	CallbackQuery *struct {
+		ID      string       `json:"id"`
+		From    telegramUser `json:"from"`
+		Data    string       `json:"data"`
+		Message *struct {
+			MessageID int64        `json:"message_id"`
+			Chat      telegramChat `json:"chat"`
+		} `json:"message"`
+	} `json:"callback_query"`
+}
+
+type telegramChat struct {
+	ID int64 `json:"id"`
+}
+
+type telegramUser struct {
+	LanguageCode string `json:"language_code"`
+}
+
+// serveTelegram atiende los comandos /start <código> y /stop y los botones de las notificaciones.
+// Siempre responde 200 para que Telegram no reintente la actualización.
+func (h *ChatHandler) serveTelegram(w http.ResponseWriter, r *http.Request, body []byte) {
+	w.WriteHeader(http.StatusOK)
+
+	var update telegramUpdate
+	if err := json.Unmarshal(body, &update); err != nil {
+		h.logger.Info("Dropped invalid Telegram update", zap.Error(err))
+		return
+	}
+	ctx := r.Context()
+
+	if query := update.CallbackQuery; query != nil && query.Message != nil {
+		chatID := strconv.FormatInt(query.Message.Chat.ID, 10)
+		reply, done := h.handleAction(ctx, entities.ChatProviderTelegram, chatID, query.Data)
+		reply = h.translate(ctx, entities.ChatProviderTelegram, chatID, query.From.LanguageCode, reply)
+		if err := h.telegram.AnswerCallback(ctx, query.ID, reply); err != nil {
+			h.logger.Warn("Failed to answer Telegram callback", zap.Error(err))
+		}
+		if done {
+			h.telegram.ClearButtons(ctx, chatID, query.Message.MessageID)
+		}
+		return
+	}
+
+	if update.Message == nil {
+		return
+	}
+	chatID := strconv.FormatInt(update.Message.Chat.ID, 10)
+	command, argument, _ := strings.Cut(strings.TrimSpace(update.Message.Text), " ")
+	// En los grupos los comandos llegan como /start@nombre_del_bot
+	command, _, _ = strings.Cut(command, "@")
+
+	var reply string
+	switch command {
+	case "/start":
+		reply = h.link(ctx, entities.ChatProviderTelegram, strings.TrimSpace(argument), chatID)
+	case "/stop":
+		reply = h.unlink(ctx, entities.ChatProviderTelegram, chatID)
+	default:
+		return
+	}
+	reply = h.translate(ctx, entities.ChatProviderTelegram, chatID, update.Message.From.LanguageCode, reply)
+	if err := h.telegram.Send(ctx, chatID, notifications.ChatMessage{Text: h.telegram.Escape(reply)}); err != nil {
+		h.logger.Warn("Failed to reply on Telegram", zap.Error(err))
+	}
+}
+
+// serveSlackCommand atiende el comando de barra del bot: "link <código>" (o solo el código) y "unlink"
+func (h *ChatHandler) serveSlackCommand(w http.ResponseWriter, r *http.Request, form url.Values) {
+	chatID := form.Get("channel_id")
+	subcommand, argument, _ := strings.Cut(strings.TrimSpace(form.Get("text")), " ")
+
+	var reply string
+	switch strings.ToLower(subcommand) {
+	case "unlink", "stop":
+		reply = h.unlink(r.Context(), entities.ChatProviderSlack, chatID)
+	case "link":
+		reply = h.link(r.Context(), entities.ChatProviderSlack, strings.TrimSpace(argument), chatID)
+	default:
+		reply = h.link(r.Context(), entities.ChatProviderSlack, subcommand, chatID)
+	}
+	reply = h.translate(r.Context(), entities.ChatProviderSlack, chatID, "", reply)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"response_type": "ephemeral",
+		"text":          h.slack.Escape(reply),
+	})
+}
+
+// slackInteraction es la parte de una interacción de Slack que usa el bot
+type slackInteraction struct {
+	Type    string `json:"type"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+// serveSlackAction ejecuta el botón pulsado y reemplaza el mensaje con el resultado
+func (h *ChatHandler) serveSlackAction(w http.ResponseWriter, r *http.Request, form url.Values) {
+	var interaction slackInteraction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &interaction); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if interaction.Type != "block_actions" || len(interaction.Actions) == 0 {
+		return
+	}
+
+	action := interaction.Actions[0]
+	reply, _ := h.handleAction(r.Context(), entities.ChatProviderSlack, interaction.Channel.ID,
+		notifications.EncodeChatAction(action.ActionID, action.Value))
+	reply = h.translate(r.Context(), entities.ChatProviderSlack, interaction.Channel.ID, "", reply)
+	if err := h.slack.Respond(r.Context(), interaction.ResponseURL, h.slack.Escape(reply)); err != nil {
+		h.logger.Warn("Failed to respond to Slack interaction", zap.Error(err))
+	}
+}
+
+// link confirma un vínculo y devuelve la respuesta para el chat
+func (h *ChatHandler) link(ctx context.Context, provider entities.ChatProvider, code, chatID string) string {
+	if code == "" {
+		return "Send the code shown in the app to link this chat."
+	}
+
+	_, err := h.chat.CompleteBinding(ctx, provider, code, chatID)
+	switch {
+	case err == nil:
+		return "This chat will now receive your notifications."
+	case errors.Is(err, entities.ErrChatBindingNotFound):
+		return "That code is not valid. Request a new one from the app."
+	case errors.Is(err, entities.ErrChatBindingCodeExpired):
+		return "That code has expired. Request a new one from the app."
+	case errors.Is(err, entities.ErrChatAlreadyBound):
+		return "This chat is already linked to another account."
+	default:
+		h.logger.Error("Failed to link chat", zap.String("provider", string(provider)), zap.Error(err))
+		return "Something went wrong. Please try again later."
+	}
+}
+
+// unlink elimina el vínculo del chat y devuelve la respuesta para el chat
+func (h *ChatHandler) unlink(ctx context.Context, provider entities.ChatProvider, chatID string) string {
+	err := h.chat.Unbind(ctx, provider, chatID)
+	switch {
+	case err == nil:
+		return "This chat will no longer receive notifications."
+	case errors.Is(err, entities.ErrChatBindingNotFound):
+		return "This chat is not linked to any account."
+	default:
+		h.logger.Error("Failed to unlink chat", zap.String("provider", string(provider)), zap.Error(err))
+		return "Something went wrong. Please try again later."
+	}
+}
+
+// handleAction ejecuta la acción de un botón; done indica si el botón ya no tiene efecto y puede quitarse
+func (h *ChatHandler) handleAction(ctx context.Context, provider entities.ChatProvider, chatID, data string) (reply string, done bool) {
+	actionType, value, ok := notifications.ParseChatAction(data)
+	targetID, err := uuid.Parse(value)
+	if !ok || err != nil {
+		return "This button is no longer valid.", true
+	}
+
+	reminder, err := h.chat.HandleAction(ctx, provider, chatID, usecases.ChatAction{
+		Type:     usecases.ChatActionType(actionType),
+		TargetID: targetID,
+	})
+	switch {
+	case err == nil:
+		return "Reminder completed: " + reminder.Title, true
+	case errors.Is(err, entities.ErrChatBindingNotFound):
+		return "This chat is not linked to any account.", false
+	case errors.Is(err, entities.ErrReminderNotFound), errors.Is(err, entities.ErrReminderUnauthorized):
+		return "This reminder no longer exists.", true
+	case errors.Is(err, entities.ErrInvalidReminderTransition):
+		return "This reminder was already completed or cancelled.", true
+	case errors.Is(err, entities.ErrUnsupportedChatAction):
+		return "This button is no longer valid.", true
+	default:
+		h.logger.Error("Failed to run chat action", zap.String("provider", string(provider)), zap.Error(err))
+		return "Something went wrong. Please try again later.", false
+	}
+}
+
+// translate traduce una respuesta al idioma preferido del dueño del chat o, si no lo hay, al
+// idioma de la aplicación de quien escribe. Se llama después de la acción para que un chat
+// recién vinculado ya responda en el idioma de su dueño.
+func (h *ChatHandler) translate(ctx context.Context, provider entities.ChatProvider, chatID, languageCode, reply string) string {
+	translator := h.config.Translator
+	if translator == nil {
+		return reply
+	}
+
+	locale := translator.Negotiate(languageCode)
+	if h.config.Preferences != nil {
+		if binding, err := h.chat.GetBindingByChat(ctx, provider, chatID); err == nil {
+			if preferred, err := h.config.Preferences.GetLocale(ctx, binding.UserID); err == nil && translator.Supports(preferred) {
+				locale = preferred
+			}
+		}
+	}
+	if locale == "" {
+		locale = translator.DefaultLocale()
+	}
+	return translator.Translate(locale, reply)
+}
+
+func (h *ChatHandler) validTelegramSecret(r *http.Request) bool {
+	if h.config.TelegramSecret == "" {
+		return false
+	}
+	secret := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(h.config.TelegramSecret)) == 1
+}
@@ -0,0 +1,317 @@
+package web
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/notifications"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	chatTestSlackSecret    = "secreto-de-slack"
+	chatTestTelegramSecret = "secreto-de-telegram"
+	chatTestChannel        = "C0123456"
+	chatTestTelegramChat   = int64(424242)
+)
+
+var chatTestNow = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// telegramCall es una llamada recibida por el servidor falso de la Bot API
+type telegramCall struct {
+	Method  string
+	Payload map[string]any
+}
+
+type chatTestEnv struct {
+	handler   *ChatHandler
+	bindings  *mocks.ChatBindingRepository
+	reminders *mocks.ReminderRepository
+
+	mu    sync.Mutex
+	calls []telegramCall
+}
+
+func newTestChatHandler(t *testing.T) *chatTestEnv {
+	env := &chatTestEnv{
+		bindings:  mocks.NewChatBindingRepository(t),
+		reminders: mocks.NewReminderRepository(t),
+	}
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		env.mu.Lock()
+		env.calls = append(env.calls, telegramCall{Method: r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], Payload: payload})
+		env.mu.Unlock()
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(api.Close)
+
+	clock := entities.NewFakeClock(chatTestNow)
+	ids := &entities.SequentialIDGenerator{}
+	reminders := usecases.NewReminderUseCases(env.reminders, nil, nil, nil, clock, ids)
+	chat := usecases.NewChatUseCases(env.bindings, reminders, nil, clock, ids)
+	env.handler = NewChatHandler(chat,
+		notifications.NewTelegramSender(notifications.TelegramConfig{BotToken: "token", APIURL: api.URL}),
+		notifications.NewSlackSender(notifications.SlackConfig{BotToken: "xoxb-token"}),
+		ChatConfig{TelegramSecret: chatTestTelegramSecret, SlackSigningSecret: chatTestSlackSecret},
+		zap.NewNop(),
+	)
+	return env
+}
+
+func (env *chatTestEnv) telegramCalls() []telegramCall {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	return append([]telegramCall(nil), env.calls...)
+}
+
+// slackCommand envía el comando de barra text firmado como lo firma Slack y devuelve la respuesta efímera
+func (env *chatTestEnv) slackCommand(t *testing.T, text string) string {
+	body := url.Values{"channel_id": {chatTestChannel}, "text": {text}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, ChatPathPrefix+slackCommandsChatPath, strings.NewReader(body))
+	signSlackRequest(req, body)
+	rec := httptest.NewRecorder()
+	env.handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var reply map[string]string
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&reply))
+	return reply["text"]
+}
+
+func (env *chatTestEnv) telegramUpdate(t *testing.T, update string) {
+	req := httptest.NewRequest(http.MethodPost, ChatPathPrefix+telegramChatPath, strings.NewReader(update))
+	req.Header.Set("X-Telegram-Bot-Api-Secret-Token", chatTestTelegramSecret)
+	rec := httptest.NewRecorder()
+	env.handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func signSlackRequest(req *http.Request, body string) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(chatTestSlackSecret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+}
+
+func telegramText(text string) string {
+	update, _ := json.Marshal(map[string]any{
+		"message": map[string]any{"chat": map[string]any{"id": chatTestTelegramChat}, "text": text},
+	})
+	return string(update)
+}
+
+func TestChatHandler_SlackLinkCommand(t *testing.T) {
+	for _, text := range []string{"link ABCD2345", "ABCD2345", "LINK  abcd2345"} {
+		t.Run(text, func(t *testing.T) {
+			env := newTestChatHandler(t)
+			pending := &entities.ChatBinding{ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderSlack, CodeExpiresAt: chatTestNow.Add(time.Minute)}
+			env.bindings.On("GetPendingByCodeHash", mock.Anything, entities.ChatProviderSlack, entities.HashChatBindingCode("ABCD2345")).Return(pending, nil)
+			env.bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, chatTestChannel).Return(nil, entities.ErrChatBindingNotFound)
+			env.bindings.On("Link", mock.Anything, mock.MatchedBy(func(binding *entities.ChatBinding) bool {
+				return binding.ID == pending.ID && binding.ChatID == chatTestChannel
+			})).Return(nil)
+
+			assert.Equal(t, "This chat will now receive your notifications.", env.slackCommand(t, text))
+		})
+	}
+}
+
+func TestChatHandler_SlackCommandErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		setup func(bindings *mocks.ChatBindingRepository)
+		want  string
+	}{
+		{
+			// Sin código no se consulta ningún vínculo
+			name:  "link without code",
+			text:  "link",
+			setup: func(bindings *mocks.ChatBindingRepository) {},
+			want:  "Send the code shown in the app to link this chat.",
+		},
+		{
+			name: "unknown code",
+			text: "ZZZZ",
+			setup: func(bindings *mocks.ChatBindingRepository) {
+				bindings.On("GetPendingByCodeHash", mock.Anything, entities.ChatProviderSlack, mock.Anything).Return(nil, entities.ErrChatBindingNotFound)
+			},
+			want: "That code is not valid. Request a new one from the app.",
+		},
+		{
+			name: "unlink unbound chat",
+			text: "unlink",
+			setup: func(bindings *mocks.ChatBindingRepository) {
+				bindings.On("GetByChat", mock.Anything, entities.ChatProviderSlack, chatTestChannel).Return(nil, entities.ErrChatBindingNotFound)
+			},
+			want: "This chat is not linked to any account.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestChatHandler(t)
+			tt.setup(env.bindings)
+
+			assert.Equal(t, tt.want, env.slackCommand(t, tt.text))
+		})
+	}
+}
+
+func TestChatHandler_SlackRejectsInvalidSignature(t *testing.T) {
+	env := newTestChatHandler(t)
+	body := url.Values{"channel_id": {chatTestChannel}, "text": {"unlink"}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, ChatPathPrefix+slackCommandsChatPath, strings.NewReader(body))
+	signSlackRequest(req, body+"&text=link")
+
+	rec := httptest.NewRecorder()
+	env.handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestChatHandler_TelegramCommands(t *testing.T) {
+	t.Run("unknown command is ignored", func(t *testing.T) {
+		env := newTestChatHandler(t)
+
+		env.telegramUpdate(t, telegramText("/help"))
+		env.telegramUpdate(t, telegramText("hola"))
+
+		assert.Empty(t, env.telegramCalls())
+	})
+
+	t.Run("start without code", func(t *testing.T) {
+		env := newTestChatHandler(t)
+
+		env.telegramUpdate(t, telegramText("/start"))
+
+		calls := env.telegramCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, "sendMessage", calls[0].Method)
+		assert.Equal(t, "Send the code shown in the app to link this chat.", calls[0].Payload["text"])
+	})
+
+	t.Run("stop addressed to the bot in a group", func(t *testing.T) {
+		env := newTestChatHandler(t)
+		chatID := strconv.FormatInt(chatTestTelegramChat, 10)
+		linkedAt := chatTestNow
+		binding := &entities.ChatBinding{ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderTelegram, ChatID: chatID, LinkedAt: &linkedAt}
+		env.bindings.On("GetByChat", mock.Anything, entities.ChatProviderTelegram, chatID).Return(binding, nil)
+		env.bindings.On("Delete", mock.Anything, binding.ID).Return(nil)
+
+		env.telegramUpdate(t, telegramText("/stop@notebook_bot"))
+
+		calls := env.telegramCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, "This chat will no longer receive notifications.", calls[0].Payload["text"])
+	})
+}
+
+func TestChatHandler_TelegramButtonPermissions(t *testing.T) {
+	chatID := strconv.FormatInt(chatTestTelegramChat, 10)
+	callback := func(data string) string {
+		update, _ := json.Marshal(map[string]any{
+			"callback_query": map[string]any{
+				"id":      "callback-1",
+				"data":    data,
+				"message": map[string]any{"message_id": 7, "chat": map[string]any{"id": chatTestTelegramChat}},
+			},
+		})
+		return string(update)
+	}
+	reminderID := uuid.New()
+
+	tests := []struct {
+		name          string
+		data          string
+		setup         func(env *chatTestEnv)
+		want          string
+		clearsButtons bool
+	}{
+		{
+			name:          "malformed button",
+			data:          "complete_reminder:no-es-un-uuid",
+			setup:         func(env *chatTestEnv) {},
+			want:          "This button is no longer valid.",
+			clearsButtons: true,
+		},
+		{
+			name: "unbound chat",
+			data: notifications.EncodeChatAction(string(usecases.ChatActionCompleteReminder), reminderID.String()),
+			setup: func(env *chatTestEnv) {
+				env.bindings.On("GetByChat", mock.Anything, entities.ChatProviderTelegram, chatID).Return(nil, entities.ErrChatBindingNotFound)
+			},
+			want: "This chat is not linked to any account.",
+		},
+		{
+			// Un recordatorio de otro usuario se responde como inexistente
+			name: "reminder of another user",
+			data: notifications.EncodeChatAction(string(usecases.ChatActionCompleteReminder), reminderID.String()),
+			setup: func(env *chatTestEnv) {
+				linkedAt := chatTestNow
+				env.bindings.On("GetByChat", mock.Anything, entities.ChatProviderTelegram, chatID).Return(&entities.ChatBinding{
+					ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderTelegram, ChatID: chatID, LinkedAt: &linkedAt,
+				}, nil)
+				env.reminders.On("GetByID", mock.Anything, reminderID).Return(&entities.Reminder{
+					ID: reminderID, UserID: uuid.New(), Status: entities.ReminderStatusPending,
+				}, nil)
+			},
+			want:          "This reminder no longer exists.",
+			clearsButtons: true,
+		},
+		{
+			name: "unknown action",
+			data: notifications.EncodeChatAction("delete_reminder", reminderID.String()),
+			setup: func(env *chatTestEnv) {
+				linkedAt := chatTestNow
+				env.bindings.On("GetByChat", mock.Anything, entities.ChatProviderTelegram, chatID).Return(&entities.ChatBinding{
+					ID: uuid.New(), UserID: uuid.New(), Provider: entities.ChatProviderTelegram, ChatID: chatID, LinkedAt: &linkedAt,
+				}, nil)
+			},
+			want:          "This button is no longer valid.",
+			clearsButtons: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestChatHandler(t)
+			tt.setup(env)
+
+			env.telegramUpdate(t, callback(tt.data))
+
+			calls := env.telegramCalls()
+			require.NotEmpty(t, calls)
+			assert.Equal(t, "answerCallbackQuery", calls[0].Method)
+			assert.Equal(t, tt.want, calls[0].Payload["text"])
+			if tt.clearsButtons {
+				require.Len(t, calls, 2)
+				assert.Equal(t, "editMessageReplyMarkup", calls[1].Method)
+			} else {
+				assert.Len(t, calls, 1)
+			}
+			env.reminders.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
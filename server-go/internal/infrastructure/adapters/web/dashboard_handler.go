@@ -0,0 +1,253 @@
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/buildinfo"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/notifications"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/queue"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/startup"
+	"go.uber.org/zap"
+)
+
+// DashboardPath es la ruta del panel de operación en el servidor HTTP de administración
+const DashboardPath = "/"
+
+// dashboardCSP no permite scripts: el panel se actualiza recargando la página entera
+const dashboardCSP = "default-src 'none'; style-src 'unsafe-inline'; base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+
+var dashboardTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+{{if .Refresh}}<meta http-equiv="refresh" content="{{.Refresh}}">
+{{end}}<title>notebook-server</title>
+<style>
+body { margin: 1.5rem; font-family: system-ui, sans-serif; font-size: .9rem; color: #222; }
+h1 { font-size: 1.3rem; } h2 { font-size: 1.05rem; margin-top: 2rem; }
+table { border-collapse: collapse; } th, td { padding: .2rem .8rem .2rem 0; text-align: left; vertical-align: top; }
+th { border-bottom: 1px solid #ccc; } td.num { text-align: right; font-variant-numeric: tabular-nums; }
+.ready { color: #186a3b; } .degraded, .WARN { color: #9a6700; } .failed, .ERROR, .FATAL { color: #b42318; }
+.muted { color: #777; } code { overflow-wrap: anywhere; }
+</style>
+</head>
+<body>
+<h1>notebook-server {{.Build.Version}}</h1>
+<p class="muted">Commit {{.Build.Commit}} · schema {{.Build.SchemaVersion}} · {{.Build.GoVersion}} · generated {{.GeneratedAt}}</p>
+{{if .Health}}
+<h2>Health checks</h2>
+<table>
+<tr><th>Dependency</th><th>State</th><th>Optional</th><th>Failed probes</th><th>Last error</th></tr>
+{{range .Health}}<tr><td>{{.Name}}</td><td class="{{.State}}">{{.State}}</td><td>{{.Optional}}</td><td class="num">{{.Attempts}}</td><td><code>{{.LastError}}</code></td></tr>
+{{end}}</table>
+{{end}}{{with .Queue}}
+<h2>Message queue</h2>
+<table>
+<tr><th>Queued</th><th>In flight</th><th>Delayed</th><th>Paused</th><th>Quarantined</th><th>Dead letters</th><th>Workers</th></tr>
+<tr><td class="num">{{.CurrentSize}}</td><td class="num">{{.InFlightMessages}}</td><td class="num">{{.DelayedMessages}}</td><td class="num">{{.PausedMessages}}</td><td class="num">{{.QuarantinedMessages}}</td><td class="num">{{$.DeadLetters}}</td><td class="num">{{.ActiveWorkers}}/{{.Workers}}</td></tr>
+</table>
+<p class="muted">{{.ProcessedMessages}} processed · {{.FailedMessages}} failed · {{.RetryMessages}} retried · {{.DeadMessages}} dead of {{.TotalMessages}} published</p>
+{{end}}{{if .StreamsEnabled}}
+<h2>Notification streams ({{len .Streams}})</h2>
+{{if .Streams}}<table>
+<tr><th>User</th><th>Channels</th><th>Open since</th><th>Pending</th></tr>
+{{range .Streams}}<tr><td><code>{{.UserID}}</code></td><td>{{if .Channels}}{{range $i, $c := .Channels}}{{if $i}}, {{end}}{{$c}}{{end}}{{else}}<span class="muted">all</span>{{end}}</td><td>{{.Since.Format "2006-01-02 15:04:05"}}</td><td class="num">{{.Pending}}</td></tr>
+{{end}}</table>
+{{else}}<p class="muted">No open streams</p>
+{{end}}{{end}}{{if .Metrics}}
+<h2>Metrics</h2>
+<table>
+<tr><th>Name</th><th>Labels</th><th>Value</th></tr>
+{{range .Metrics}}<tr><td>{{.Name}}</td><td class="muted">{{.Labels}}</td><td class="num">{{.Value}}</td></tr>
+{{end}}</table>
+{{end}}{{if .LogsEnabled}}
+<h2>Recent logs</h2>
+{{if .Logs}}<table>
+<tr><th>Time</th><th>Level</th><th>Message</th><th>Fields</th></tr>
+{{range .Logs}}<tr><td>{{.Time}}</td><td class="{{.Level}}">{{.Level}}</td><td>{{.Message}}</td><td class="muted"><code>{{.Fields}}</code></td></tr>
+{{end}}</table>
+{{else}}<p class="muted">Nothing logged yet</p>
+{{end}}{{end}}</body>
+</html>
+`))
+
+type dashboardPage struct {
+	Refresh        int
+	GeneratedAt    string
+	Build          buildinfo.Info
+	Health         []startup.DependencyStatus
+	Queue          *queue.QueueMetrics
+	DeadLetters    int
+	StreamsEnabled bool
+	Streams        []notifications.StreamInfo
+	Metrics        []dashboardMetric
+	LogsEnabled    bool
+	Logs           []dashboardLog
+}
+
+type dashboardMetric struct {
+	Name   string
+	Labels string
+	Value  string
+}
+
+type dashboardLog struct {
+	Time    string
+	Level   string
+	Message string
+	Fields  string
+}
+
+// DashboardConfig reúne lo que muestra el panel; las secciones cuya fuente es nil no se muestran
+type DashboardConfig struct {
+	Metrics *metrics.MetricsCollector
+	Queue   *queue.MessageQueue
+	Streams *notifications.Hub
+	Health  *startup.Orchestrator
+	Logs    *logging.RecentLogs
+	// Refresh es cada cuánto recarga la página el navegador; 0 no la recarga
+	Refresh time.Duration
+}
+
+// DashboardHandler sirve una página HTML, sin JavaScript, con el estado del servidor: chequeos de
+// salud, cola de mensajes, streams de notificaciones abiertos, métricas y los últimos logs. No
+// autentica: se sirve solo en el puerto de administración, que filtra a los clientes por IP.
+type DashboardHandler struct {
+	config DashboardConfig
+	logger *zap.Logger
+}
+
+// NewDashboardHandler crea el handler del panel de operación
+func NewDashboardHandler(config DashboardConfig, logger *zap.Logger) *DashboardHandler {
+	return &DashboardHandler{
+		config: config,
+		logger: logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *DashboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != DashboardPath {
+		http.NotFound(w, r)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := dashboardTemplate.Execute(&body, h.page()); err != nil {
+		h.logger.Error("Failed to render dashboard", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", dashboardCSP)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	w.Write(body.Bytes())
+}
+
+func (h *DashboardHandler) page() dashboardPage {
+	page := dashboardPage{
+		Refresh:     int(h.config.Refresh / time.Second),
+		GeneratedAt: time.Now().Format(time.RFC3339),
+		Build:       buildinfo.Get(),
+	}
+
+	if h.config.Health != nil {
+		page.Health = h.config.Health.Statuses()
+	}
+	if h.config.Queue != nil {
+		queueMetrics := h.config.Queue.GetMetrics()
+		page.Queue = &queueMetrics
+		page.DeadLetters = h.config.Queue.GetDLQSize()
+	}
+	if h.config.Streams != nil {
+		page.StreamsEnabled = true
+		page.Streams = h.config.Streams.Streams()
+	}
+	if h.config.Metrics != nil {
+		page.Metrics = dashboardMetrics(h.config.Metrics.GetAllMetrics())
+	}
+	if h.config.Logs != nil {
+		page.LogsEnabled = true
+		for _, entry := range h.config.Logs.Entries() {
+			page.Logs = append(page.Logs, newDashboardLog(entry))
+		}
+	}
+	return page
+}
+
+// dashboardMetrics ordena las métricas por nombre y etiquetas para que no cambien de lugar entre recargas
+func dashboardMetrics(collected []metrics.Metric) []dashboardMetric {
+	result := make([]dashboardMetric, len(collected))
+	for i, metric := range collected {
+		result[i] = dashboardMetric{
+			Name:   metric.Name,
+			Labels: formatPairs(metric.Labels),
+			Value:  strconv.FormatFloat(metric.Value, 'f', -1, 64),
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Labels < result[j].Labels
+	})
+	return result
+}
+
+func newDashboardLog(entry logging.LogEntry) dashboardLog {
+	fields := make(map[string]string, len(entry.Fields)+2)
+	for key, value := range entry.Fields {
+		fields[key] = fmt.Sprint(value)
+	}
+	if entry.Component != "" {
+		fields["component"] = entry.Component
+	}
+	if entry.RequestID != "" {
+		fields["request_id"] = entry.RequestID
+	}
+	if entry.Error != nil {
+		fields["error"] = entry.Error.Message
+	}
+	return dashboardLog{
+		Time:    entry.Timestamp.Format("15:04:05.000"),
+		Level:   entry.Level,
+		Message: entry.Message,
+		Fields:  formatPairs(fields),
+	}
+}
+
+// formatPairs escribe un mapa como clave=valor ordenado por clave
+func formatPairs(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + values[key]
+	}
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,132 @@
+package web
+
+import (
+	"crypto/subtle"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"sync/atomic"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"go.uber.org/zap"
+)
+
+// DebugPathPrefix es la ruta de los perfiles de net/http/pprof (/debug/pprof/) y de las variables
+// de expvar (/debug/vars)
+const DebugPathPrefix = "/debug/"
+
+// DebugConfig configura quién puede usar los endpoints de depuración
+type DebugConfig struct {
+	// Tokens valida los tokens Bearer; solo se aceptan los del rol admin. nil los rechaza todos
+	Tokens *security.TokenManager
+	// APIKey es la clave estática que se acepta en X-API-Key; vacía la desactiva
+	APIKey string
+	// Enabled indica si los endpoints responden al arrancar; SetEnabled lo cambia después
+	Enabled bool
+}
+
+// DebugHandler sirve pprof y expvar a administradores autenticados, para sacar perfiles de
+// memoria o de CPU en producción. Apagado responde 404, como si los endpoints no existieran.
+type DebugHandler struct {
+	config  DebugConfig
+	enabled atomic.Bool
+	mux     *http.ServeMux
+	logger  *zap.Logger
+}
+
+// NewDebugHandler crea el handler de depuración. Registra sus rutas en un mux propio y no en
+// http.DefaultServeMux, que no se sirve
+func NewDebugHandler(config DebugConfig, logger *zap.Logger) *DebugHandler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(DebugPathPrefix+"pprof/", pprof.Index)
+	mux.HandleFunc(DebugPathPrefix+"pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc(DebugPathPrefix+"pprof/profile", pprof.Profile)
+	mux.HandleFunc(DebugPathPrefix+"pprof/symbol", pprof.Symbol)
+	mux.HandleFunc(DebugPathPrefix+"pprof/trace", pprof.Trace)
+	mux.Handle(DebugPathPrefix+"vars", expvar.Handler())
+
+	h := &DebugHandler{
+		config: config,
+		mux:    mux,
+		logger: logger,
+	}
+	h.enabled.Store(config.Enabled)
+	return h
+}
+
+// SetEnabled enciende o apaga los endpoints sin reiniciar el servidor
+func (h *DebugHandler) SetEnabled(enabled bool) {
+	h.enabled.Store(enabled)
+}
+
+// ServeHTTP implementa http.Handler
+func (h *DebugHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.enabled.Load() {
+		http.NotFound(w, r)
+		return
+	}
+
+	subject, err := h.authenticate(r)
+	if err != nil {
+		h.logger.Warn("Rejected debug endpoint request",
+			zap.String("path", r.URL.Path),
+			zap.String("ip", clientIP(r)),
+			zap.Error(err))
+		switch {
+		case errors.Is(err, security.ErrInsufficientRole):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		default:
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		}
+		return
+	}
+
+	// Un perfil puede exponer datos de memoria: queda registrado quién lo pidió
+	h.logger.Info("Debug endpoint requested",
+		zap.String("path", r.URL.Path),
+		zap.String("subject", subject),
+		zap.String("ip", clientIP(r)))
+	w.Header().Set("Cache-Control", "no-store")
+	h.mux.ServeHTTP(w, r)
+}
+
+// authenticate devuelve quién hace la petición: "api-key" o el usuario del token
+func (h *DebugHandler) authenticate(r *http.Request) (string, error) {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		if h.config.APIKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(h.config.APIKey)) != 1 {
+			return "", security.ErrInvalidToken
+		}
+		return "api-key", nil
+	}
+
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") || h.config.Tokens == nil {
+		return "", errMissingCredentials
+	}
+	claims, err := h.config.Tokens.ValidateToken(strings.TrimPrefix(header, "Bearer "))
+	if err != nil {
+		return "", err
+	}
+	if !claims.HasRole(security.RoleAdmin) {
+		return "", security.ErrInsufficientRole
+	}
+	return claims.UserID, nil
+}
+
+// AllowListHandler responde 403 a los clientes cuya IP no está en list antes de pasar la petición
+// a next. Usa la dirección de la conexión y no X-Forwarded-For, que el cliente puede falsificar
+func AllowListHandler(list *security.IPAllowList, next http.Handler, logger *zap.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ip := clientIP(r); !list.Allows(ip) {
+			logger.Warn("Rejected admin HTTP request from address outside the allow-list",
+				zap.String("path", r.URL.Path),
+				zap.String("ip", ip))
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,236 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/cdn"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FilePathPrefix es la ruta bajo la que se sirven los archivos de los usuarios: /files/<id>
+const FilePathPrefix = "/files/"
+
+// errMissingCredentials indica que la petición no trae token ni firma
+var errMissingCredentials = errors.New("missing credentials")
+
+// FileHandler sirve por HTTP los archivos de sus dueños con soporte de Range, ETag y caché,
+// para que los reproductores multimedia y las CDN puedan pedir fragmentos y reutilizar respuestas
+type FileHandler struct {
+	files  *usecases.FileUseCases
+	tokens *security.TokenManager
+	signer *cdn.OriginSigner
+	maxAge time.Duration
+	logger *zap.Logger
+}
+
+// NewFileHandler crea el handler de archivos. Las peticiones se autentican con un token
+// Bearer de tokens o con una URL firmada por signer; cualquiera de los dos puede ser nil.
+// maxAge es la vigencia en caché de las respuestas: cada versión tiene su propio ID y no cambia.
+func NewFileHandler(files *usecases.FileUseCases, tokens *security.TokenManager, signer *cdn.OriginSigner, maxAge time.Duration, logger *zap.Logger) *FileHandler {
+	return &FileHandler{
+		files:  files,
+		tokens: tokens,
+		signer: signer,
+		maxAge: maxAge,
+		logger: logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *FileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	fileID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, FilePathPrefix))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	userID, signed, err := h.authenticate(r, fileID)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	ctx := entities.ContextWithEventActor(r.Context(), userID)
+	fileInfo, err := h.files.GetFileInfo(ctx, fileID, userID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.writeFileHeaders(w, fileInfo, signed)
+
+	// HEAD, las peticiones condicionales y los rangos se responden con los metadatos o leyendo
+	// el archivo donde esté: solo una descarga completa lo restaura del nivel frío y se cuenta
+	if notModified(r, fileInfo) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if r.Method == http.MethodHead {
+		if !fileInfo.Compressed {
+			w.Header().Set("Content-Length", strconv.FormatInt(fileInfo.Size, 10))
+		}
+		return
+	}
+	if rangeRequested(r, fileInfo) && h.serveRange(ctx, w, r, fileInfo, userID) {
+		return
+	}
+
+	fileInfo, reader, err := h.files.DownloadFile(ctx, fileID, userID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	defer reader.Close()
+
+	// Los archivos comprimidos se guardan con otro contenido y tamaño, así que sus rangos no
+	// corresponderían al original
+	if seeker, ok := reader.(io.ReadSeeker); ok && !fileInfo.Compressed {
+		http.ServeContent(w, r, fileInfo.Filename, fileInfo.CreatedAt, seeker)
+		return
+	}
+	if _, err := io.Copy(w, reader); err != nil {
+		h.logger.Warn("Failed to stream file", zap.String("file_id", fileInfo.ID.String()), zap.Error(err))
+	}
+}
+
+// serveRange responde a una petición con Range leyendo el archivo sin restaurarlo ni contar la
+// descarga. Devuelve false si el archivo no admite Seek y hay que enviarlo completo.
+func (h *FileHandler) serveRange(ctx context.Context, w http.ResponseWriter, r *http.Request, fileInfo *entities.FileInfo, userID uuid.UUID) bool {
+	_, reader, err := h.files.ReadFile(ctx, fileInfo.ID, userID)
+	if err != nil {
+		h.writeError(w, err)
+		return true
+	}
+	defer reader.Close()
+
+	seeker, ok := reader.(io.ReadSeeker)
+	if !ok {
+		w.Header().Set("Accept-Ranges", "none")
+		return false
+	}
+	http.ServeContent(w, r, fileInfo.Filename, fileInfo.CreatedAt, seeker)
+	return true
+}
+
+// writeFileHeaders escribe las cabeceras de la respuesta que dependen solo de los metadatos
+func (h *FileHandler) writeFileHeaders(w http.ResponseWriter, fileInfo *entities.FileInfo, signed bool) {
+	contentType := fileInfo.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": fileInfo.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", h.cacheControl(signed))
+	w.Header().Set("Last-Modified", fileInfo.CreatedAt.UTC().Format(http.TimeFormat))
+	if fileInfo.Checksum != "" {
+		w.Header().Set("ETag", `"`+fileInfo.Checksum+`"`)
+	}
+	if fileInfo.Compressed {
+		w.Header().Set("Accept-Ranges", "none")
+	} else {
+		w.Header().Set("Accept-Ranges", "bytes")
+	}
+}
+
+// authenticate devuelve el usuario de la petición e indica si llegó con una URL firmada
+func (h *FileHandler) authenticate(r *http.Request, fileID uuid.UUID) (uuid.UUID, bool, error) {
+	if header := r.Header.Get("Authorization"); header != "" && h.tokens != nil {
+		claims, err := h.tokens.ValidateToken(strings.TrimPrefix(header, "Bearer "))
+		if err != nil {
+			return uuid.Nil, false, err
+		}
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			return uuid.Nil, false, security.ErrInvalidToken
+		}
+		return userID, false, nil
+	}
+
+	query := r.URL.Query()
+	if query.Get(cdn.SignatureParam) != "" && h.signer != nil {
+		userID, err := h.signer.Verify(fileID, query, time.Now())
+		return userID, true, err
+	}
+
+	return uuid.Nil, false, errMissingCredentials
+}
+
+// cacheControl permite que la CDN guarde las respuestas a URLs firmadas, que solo se
+// entregan a los dueños; las autenticadas con token solo se guardan en el dispositivo
+func (h *FileHandler) cacheControl(signed bool) string {
+	if h.maxAge <= 0 {
+		return "private, no-cache"
+	}
+	visibility := "private"
+	if signed {
+		visibility = "public"
+	}
+	return fmt.Sprintf("%s, max-age=%d, immutable", visibility, int64(h.maxAge/time.Second))
+}
+
+func (h *FileHandler) writeError(w http.ResponseWriter, err error) {
+	switch err {
+	// No se distingue un archivo ajeno de uno inexistente
+	case entities.ErrFileNotFound, entities.ErrFileUnauthorized:
+		http.Error(w, "file not found", http.StatusNotFound)
+	default:
+		h.logger.Error("Failed to serve file", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// notModified evalúa If-None-Match y, si no viene, If-Modified-Since, como http.ServeContent
+func notModified(r *http.Request, fileInfo *entities.FileInfo) bool {
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		return fileInfo.Checksum != "" && etagMatches(header, `"`+fileInfo.Checksum+`"`)
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	return err == nil && !fileInfo.CreatedAt.Truncate(time.Second).After(since)
+}
+
+// rangeRequested indica si la petición pide un rango que se puede servir: el archivo no está
+// comprimido e If-Range, si viene, coincide con el ETag o la fecha del archivo
+func rangeRequested(r *http.Request, fileInfo *entities.FileInfo) bool {
+	if r.Header.Get("Range") == "" || fileInfo.Compressed {
+		return false
+	}
+	ifRange := r.Header.Get("If-Range")
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) {
+		return fileInfo.Checksum != "" && ifRange == `"`+fileInfo.Checksum+`"`
+	}
+	modified, err := http.ParseTime(ifRange)
+	return err == nil && fileInfo.CreatedAt.Truncate(time.Second).Equal(modified)
+}
+
+// etagMatches evalúa If-None-Match con comparación débil, como pide RFC 9110
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
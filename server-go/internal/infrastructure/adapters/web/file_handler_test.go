@@ -0,0 +1,236 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const fileTestContent = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// seekableFile es un archivo almacenado que admite Seek, como los del almacenamiento local
+type seekableFile struct {
+	*strings.Reader
+}
+
+func (seekableFile) Close() error { return nil }
+
+type fileTestEnv struct {
+	handler  *FileHandler
+	fileInfo *entities.FileInfo
+	token    string
+	events   *mocks.EventBus
+	tiering  *mocks.StorageTiering
+}
+
+// newTestFileHandler sirve un archivo de fileTestContent, del usuario dueño del token. Los
+// mocks de eventos y de niveles fallan si la petición cuenta una descarga o restaura el archivo
+// sin que el test lo espere.
+func newTestFileHandler(t *testing.T, compressed bool) *fileTestEnv {
+	userID := uuid.New()
+	fileInfo := &entities.FileInfo{
+		ID:          uuid.New(),
+		Filename:    "notas.txt",
+		ContentType: "text/plain",
+		Path:        "files/notas.txt",
+		Checksum:    "9f86d081884c7d659a2feaa0c55ad015",
+		Compressed:  compressed,
+		UserID:      userID,
+		CreatedAt:   time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+	}
+
+	files := mocks.NewFileRepository(t)
+	storage := mocks.NewFileStorageService(t)
+	files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil).Maybe()
+	storage.On("RetrieveFile", mock.Anything, fileInfo.Path).Return(seekableFile{strings.NewReader(fileTestContent)}, nil).Maybe()
+
+	tokens := security.NewTokenManager("clave-de-prueba", "test", time.Hour)
+	token, err := tokens.GenerateToken(&security.AuthClaims{UserID: userID.String()})
+	require.NoError(t, err)
+
+	events := mocks.NewEventBus(t)
+	tiering := mocks.NewStorageTiering(t)
+	clock := entities.NewFakeClock(fileInfo.CreatedAt)
+	lifecycle := usecases.NewStorageLifecycleUseCases(files, storage, tiering, nil, clock, 0)
+	fileUseCases := usecases.NewFileUseCases(files, storage, events, nil, clock, &entities.SequentialIDGenerator{}, usecases.WithStorageLifecycle(lifecycle))
+	return &fileTestEnv{
+		handler:  NewFileHandler(fileUseCases, tokens, nil, time.Hour, zap.NewNop()),
+		fileInfo: fileInfo,
+		token:    token,
+		events:   events,
+		tiering:  tiering,
+	}
+}
+
+// expectDownload espera que la petición cuente como una descarga del archivo
+func (env *fileTestEnv) expectDownload() {
+	env.events.On("Publish", mock.Anything, mock.MatchedBy(func(event *usecases.FileDownloadedEvent) bool {
+		return event.FileID == env.fileInfo.ID
+	})).Return(nil).Once()
+}
+
+func (env *fileTestEnv) get(headers map[string]string) *httptest.ResponseRecorder {
+	return env.request(http.MethodGet, headers)
+}
+
+func (env *fileTestEnv) request(method string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, FilePathPrefix+env.fileInfo.ID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+env.token)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	env.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestFileHandler_Range(t *testing.T) {
+	env := newTestFileHandler(t, false)
+
+	rec := env.get(map[string]string{"Range": "bytes=0-9"})
+
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, "0123456789", rec.Body.String())
+	assert.Equal(t, "bytes 0-9/36", rec.Header().Get("Content-Range"))
+	assert.Equal(t, `"`+env.fileInfo.Checksum+`"`, rec.Header().Get("ETag"))
+}
+
+func TestFileHandler_UnsatisfiableRange(t *testing.T) {
+	env := newTestFileHandler(t, false)
+
+	rec := env.get(map[string]string{"Range": "bytes=100-200"})
+
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, rec.Code)
+	assert.Equal(t, "bytes */36", rec.Header().Get("Content-Range"))
+}
+
+func TestFileHandler_IfNoneMatch(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		env := newTestFileHandler(t, compressed)
+
+		// La comparación es débil: W/ y las listas de ETags también coinciden
+		rec := env.get(map[string]string{"If-None-Match": `"otro", W/"` + env.fileInfo.Checksum + `"`})
+
+		assert.Equal(t, http.StatusNotModified, rec.Code, "compressed=%v", compressed)
+		assert.Empty(t, rec.Body.String())
+	}
+}
+
+func TestFileHandler_IfNoneMatchMismatch(t *testing.T) {
+	env := newTestFileHandler(t, false)
+	env.expectDownload()
+
+	rec := env.get(map[string]string{"If-None-Match": `"otro"`})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, fileTestContent, rec.Body.String())
+}
+
+func TestFileHandler_CompressedFileIgnoresRange(t *testing.T) {
+	env := newTestFileHandler(t, true)
+	env.expectDownload()
+
+	rec := env.get(map[string]string{"Range": "bytes=0-9"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, fileTestContent, rec.Body.String())
+	assert.Equal(t, "none", rec.Header().Get("Accept-Ranges"))
+	assert.Empty(t, rec.Header().Get("Content-Range"))
+}
+
+func TestFileHandler_Head(t *testing.T) {
+	env := newTestFileHandler(t, false)
+	env.fileInfo.Size = int64(len(fileTestContent))
+
+	rec := env.request(http.MethodHead, nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Equal(t, "36", rec.Header().Get("Content-Length"))
+	assert.Equal(t, "bytes", rec.Header().Get("Accept-Ranges"))
+	assert.Equal(t, `"`+env.fileInfo.Checksum+`"`, rec.Header().Get("ETag"))
+}
+
+func TestFileHandler_IfRangeMismatch(t *testing.T) {
+	env := newTestFileHandler(t, false)
+	env.expectDownload()
+
+	// El archivo cambió desde que el cliente pidió el primer fragmento: se envía completo
+	rec := env.get(map[string]string{"Range": "bytes=0-9", "If-Range": `"otro"`})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, fileTestContent, rec.Body.String())
+}
+
+func TestFileHandler_ColdFileIsRestoredOnlyOnFullDownload(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		status  int
+	}{
+		{"head", http.MethodHead, nil, http.StatusOK},
+		{"not modified", http.MethodGet, map[string]string{"If-None-Match": `"9f86d081884c7d659a2feaa0c55ad015"`}, http.StatusNotModified},
+		{"range", http.MethodGet, map[string]string{"Range": "bytes=0-9"}, http.StatusPartialContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestFileHandler(t, false)
+			env.fileInfo.StorageTier = entities.StorageTierCold
+
+			rec := env.request(tt.method, tt.headers)
+
+			// Los mocks fallan si se restaura el archivo o se publica la descarga
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+
+	t.Run("full download", func(t *testing.T) {
+		env := newTestFileHandler(t, false)
+		env.fileInfo.StorageTier = entities.StorageTierCold
+		env.tiering.On("CopyToTier", mock.Anything, env.fileInfo.Path, entities.StorageTierHot).Return("", assert.AnError).Once()
+		env.expectDownload()
+
+		// Si la restauración falla se lee desde el nivel frío
+		rec := env.get(nil)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, fileTestContent, rec.Body.String())
+	})
+}
+
+func TestFileHandler_Authentication(t *testing.T) {
+	t.Run("missing credentials", func(t *testing.T) {
+		env := newTestFileHandler(t, false)
+		req := httptest.NewRequest(http.MethodGet, FilePathPrefix+env.fileInfo.ID.String(), nil)
+		rec := httptest.NewRecorder()
+
+		env.handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("file of another user", func(t *testing.T) {
+		env := newTestFileHandler(t, false)
+		env.fileInfo.UserID = uuid.New()
+
+		// No se distingue de un archivo inexistente
+		rec := env.get(nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,359 @@
+package web
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	"go.uber.org/zap"
+)
+
+// sendGridMemory es la parte de un formulario de SendGrid que se guarda en memoria; el resto va a disco
+const sendGridMemory = 8 << 20
+
+// snsHostPattern restringe las URLs de confirmación de SNS a los endpoints de AWS
+var snsHostPattern = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+var htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+// inboundEmail es un correo recibido de un proveedor, ya normalizado
+type inboundEmail struct {
+	From        string
+	Recipients  []string
+	Subject     string
+	Text        string
+	Spam        bool
+	Attachments []usecases.InboundAttachment
+}
+
+// token devuelve el token de la primera dirección del dominio de entrada entre los destinatarios.
+// Se ignora el sufijo "+etiqueta" de la parte local.
+func (e *inboundEmail) token(domain string) (string, bool) {
+	for _, recipient := range e.Recipients {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			continue
+		}
+		at := strings.LastIndex(address.Address, "@")
+		if at < 0 || !strings.EqualFold(address.Address[at+1:], domain) {
+			continue
+		}
+		local := address.Address[:at]
+		if plus := strings.Index(local, "+"); plus >= 0 {
+			local = local[:plus]
+		}
+		if local != "" {
+			return strings.ToLower(local), true
+		}
+	}
+	return "", false
+}
+
+// sendGridEnvelope es el campo envelope del webhook Inbound Parse de SendGrid
+type sendGridEnvelope struct {
+	From string   `json:"from"`
+	To   []string `json:"to"`
+}
+
+// serveSendGrid procesa el webhook Inbound Parse de SendGrid, con o sin la opción de enviar el MIME original
+func (h *InboundHandler) serveSendGrid(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(sendGridMemory); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	var email *inboundEmail
+	if raw := r.FormValue("email"); raw != "" {
+		parsed, err := parseMIMEEmail([]byte(raw))
+		if err != nil {
+			h.logger.Info("Dropped unparseable inbound email", zap.Error(err))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		email = parsed
+	} else {
+		email = &inboundEmail{
+			From:    r.FormValue("from"),
+			Subject: r.FormValue("subject"),
+			Text:    r.FormValue("text"),
+		}
+		if email.Text == "" {
+			email.Text = htmlToText(r.FormValue("html"))
+		}
+		if to, err := mail.ParseAddressList(r.FormValue("to")); err == nil {
+			for _, address := range to {
+				email.Recipients = append(email.Recipients, address.Address)
+			}
+		}
+
+		attachments, _ := strconv.Atoi(r.FormValue("attachments"))
+		for i := 1; i <= attachments; i++ {
+			file, header, err := r.FormFile(fmt.Sprintf("attachment%d", i))
+			if err != nil {
+				continue
+			}
+			defer file.Close()
+			email.Attachments = append(email.Attachments, usecases.InboundAttachment{
+				Filename:    header.Filename,
+				ContentType: header.Header.Get("Content-Type"),
+				Content:     file,
+			})
+		}
+	}
+
+	// El sobre SMTP es más fiable que las cabeceras, que el remitente controla
+	var envelope sendGridEnvelope
+	if err := json.Unmarshal([]byte(r.FormValue("envelope")), &envelope); err == nil {
+		if envelope.From != "" {
+			email.From = envelope.From
+		}
+		if len(envelope.To) > 0 {
+			email.Recipients = envelope.To
+		}
+	}
+	if score, err := strconv.ParseFloat(r.FormValue("spam_score"), 64); err == nil && score >= h.config.SpamScore {
+		email.Spam = true
+	}
+
+	h.receiveEmail(r.Context(), w, email)
+}
+
+// snsMessage es la notificación HTTP de Amazon SNS
+type snsMessage struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// sesNotification es la notificación de SES para la acción SNS de una regla de recepción
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	Mail             struct {
+		Source string `json:"source"`
+	} `json:"mail"`
+	Receipt struct {
+		Recipients   []string   `json:"recipients"`
+		SpamVerdict  sesVerdict `json:"spamVerdict"`
+		VirusVerdict sesVerdict `json:"virusVerdict"`
+		Action       struct {
+			Encoding string `json:"encoding"`
+		} `json:"action"`
+	} `json:"receipt"`
+	Content string `json:"content"`
+}
+
+type sesVerdict struct {
+	Status string `json:"status"`
+}
+
+// serveSES procesa las notificaciones de SNS con los correos recibidos por SES. La autenticidad se
+// apoya en la clave del webhook, que solo conoce la suscripción; no se verifica la firma de SNS.
+func (h *InboundHandler) serveSES(w http.ResponseWriter, r *http.Request) {
+	var message snsMessage
+	if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+		http.Error(w, "invalid SNS message", http.StatusBadRequest)
+		return
+	}
+
+	switch message.Type {
+	case "SubscriptionConfirmation":
+		if err := h.confirmSNSSubscription(r, message.SubscribeURL); err != nil {
+			h.logger.Warn("Failed to confirm SNS subscription", zap.Error(err))
+			http.Error(w, "subscription not confirmed", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	case "Notification":
+	default:
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var notification sesNotification
+	if err := json.Unmarshal([]byte(message.Message), &notification); err != nil || notification.NotificationType != "Received" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	raw := []byte(notification.Content)
+	if strings.EqualFold(notification.Receipt.Action.Encoding, "BASE64") {
+		decoded, err := base64.StdEncoding.DecodeString(notification.Content)
+		if err != nil {
+			h.logger.Info("Dropped inbound email with invalid encoding", zap.Error(err))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		raw = decoded
+	}
+
+	email, err := parseMIMEEmail(raw)
+	if err != nil {
+		h.logger.Info("Dropped unparseable inbound email", zap.Error(err))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	email.From = notification.Mail.Source
+	email.Recipients = notification.Receipt.Recipients
+	email.Spam = notification.Receipt.SpamVerdict.Status == "FAIL" || notification.Receipt.VirusVerdict.Status == "FAIL"
+
+	h.receiveEmail(r.Context(), w, email)
+}
+
+// confirmSNSSubscription visita la URL de confirmación si apunta a SNS
+func (h *InboundHandler) confirmSNSSubscription(r *http.Request, subscribeURL string) error {
+	target, err := url.Parse(subscribeURL)
+	if err != nil || target.Scheme != "https" || !snsHostPattern.MatchString(target.Hostname()) {
+		return fmt.Errorf("unexpected subscribe URL %q", subscribeURL)
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, target.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("subscribe URL returned %s", resp.Status)
+	}
+	return nil
+}
+
+// parseMIMEEmail extrae asunto, remitente, texto y adjuntos de un correo en formato MIME
+func parseMIMEEmail(raw []byte) (*inboundEmail, error) {
+	message, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := new(mime.WordDecoder)
+	subject, err := decoder.DecodeHeader(message.Header.Get("Subject"))
+	if err != nil {
+		subject = message.Header.Get("Subject")
+	}
+	email := &inboundEmail{
+		From:    message.Header.Get("From"),
+		Subject: subject,
+	}
+	if from, err := mail.ParseAddress(email.From); err == nil {
+		email.From = from.Address
+	}
+	if to, err := message.Header.AddressList("To"); err == nil {
+		for _, address := range to {
+			email.Recipients = append(email.Recipients, address.Address)
+		}
+	}
+
+	var html string
+	err = walkMIMEPart(message.Header.Get("Content-Type"), message.Header.Get("Content-Transfer-Encoding"), "", message.Body,
+		func(contentType, disposition string, params map[string]string, body []byte) {
+			filename := params["filename"]
+			switch {
+			case disposition == "attachment" || filename != "":
+				email.Attachments = append(email.Attachments, usecases.InboundAttachment{
+					Filename:    filename,
+					ContentType: contentType,
+					Content:     bytes.NewReader(body),
+				})
+			case contentType == "text/plain" && email.Text == "":
+				email.Text = string(body)
+			case contentType == "text/html" && html == "":
+				html = string(body)
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+	if email.Text == "" {
+		email.Text = htmlToText(html)
+	}
+
+	return email, nil
+}
+
+// walkMIMEPart recorre una parte MIME y sus partes anidadas, llamando a visit con el contenido ya decodificado
+// de cada parte que no es multipart
+func walkMIMEPart(contentType, encoding, disposition string, body io.Reader, visit func(contentType, disposition string, params map[string]string, body []byte)) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// NextPart ya decodifica quoted-printable y elimina la cabecera
+			if err := walkMIMEPart(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part.Header.Get("Content-Disposition"), part, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, &lineJoiner{r: body})
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	dispositionType, dispositionParams, _ := mime.ParseMediaType(disposition)
+	if dispositionParams == nil {
+		dispositionParams = map[string]string{}
+	}
+	if dispositionParams["filename"] == "" && params["name"] != "" {
+		dispositionParams["filename"] = params["name"]
+	}
+	visit(mediaType, dispositionType, dispositionParams, content)
+	return nil
+}
+
+// lineJoiner elimina los saltos de línea del contenido en base64, que el decodificador no acepta
+type lineJoiner struct {
+	r io.Reader
+}
+
+func (l *lineJoiner) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	kept := 0
+	for _, b := range p[:n] {
+		if b != '\r' && b != '\n' {
+			p[kept] = b
+			kept++
+		}
+	}
+	return kept, err
+}
+
+// htmlToText obtiene un texto aproximado de un cuerpo HTML, para correos sin parte de texto plano
+func htmlToText(html string) string {
+	html = strings.NewReplacer("<br>", "\n", "<br/>", "\n", "<br />", "\n", "</p>", "\n", "</div>", "\n").Replace(html)
+	return strings.TrimSpace(htmlTagPattern.ReplaceAllString(html, ""))
+}
@@ -0,0 +1,317 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"go.uber.org/zap"
+)
+
+// InboundPathPrefix es la ruta de la integración de entrada: /inbound/<token> recibe JSON de cada
+// usuario y /inbound/email/sendgrid y /inbound/email/ses reciben los correos de todo el dominio
+const InboundPathPrefix = "/inbound/"
+
+const (
+	sendGridInboundPath = "email/sendgrid"
+	sesInboundPath      = "email/ses"
+)
+
+// DefaultInboundMaxBodySize es el tamaño máximo de una petición de entrada, adjuntos incluidos
+const DefaultInboundMaxBodySize = 25 << 20
+
+// DefaultInboundSpamScore es la puntuación de SpamAssassin de SendGrid a partir de la cual se descarta un correo
+const DefaultInboundSpamScore = 5.0
+
+// InboundConfig configura el handler de entrada
+type InboundConfig struct {
+	// EmailDomain es el dominio de las direcciones <token>@EmailDomain
+	EmailDomain string
+	// WebhookKey es la clave que los proveedores de correo envían en ?key=; vacía desactiva sus webhooks
+	WebhookKey string
+	// MaxBodySize limita el tamaño de cada petición; 0 usa DefaultInboundMaxBodySize
+	MaxBodySize int64
+	// SpamScore es el umbral de spam_score de SendGrid; 0 usa DefaultInboundSpamScore
+	SpamScore float64
+	// Limiter, si no es nil, limita los mensajes por dirección de entrada y por IP
+	Limiter *security.RateLimiter
+}
+
+// InboundHandler recibe correos (webhooks de SendGrid y de SES a través de SNS) y peticiones JSON
+// enviados a las direcciones de entrada de los usuarios y crea con ellos ideas y recordatorios
+type InboundHandler struct {
+	inbound *usecases.InboundUseCases
+	config  InboundConfig
+	client  *http.Client
+	logger  *zap.Logger
+}
+
+// NewInboundHandler crea el handler de entrada
+func NewInboundHandler(inbound *usecases.InboundUseCases, config InboundConfig, logger *zap.Logger) *InboundHandler {
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = DefaultInboundMaxBodySize
+	}
+	if config.SpamScore <= 0 {
+		config.SpamScore = DefaultInboundSpamScore
+	}
+	return &InboundHandler{
+		inbound: inbound,
+		config:  config,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		logger:  logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *InboundHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.config.Limiter != nil && !h.config.Limiter.Allow("ip:"+clientIP(r)) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, h.config.MaxBodySize)
+
+	switch path := strings.TrimPrefix(r.URL.Path, InboundPathPrefix); path {
+	case sendGridInboundPath, sesInboundPath:
+		if !h.validWebhookKey(r) {
+			http.NotFound(w, r)
+			return
+		}
+		if path == sendGridInboundPath {
+			h.serveSendGrid(w, r)
+		} else {
+			h.serveSES(w, r)
+		}
+	default:
+		if path == "" || strings.Contains(path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		h.serveJSON(w, r, path)
+	}
+}
+
+// inboundJSONRequest es el cuerpo de las peticiones JSON a /inbound/<token>
+type inboundJSONRequest struct {
+	Type          string                  `json:"type"`
+	Title         string                  `json:"title"`
+	Content       string                  `json:"content"`
+	Category      string                  `json:"category"`
+	Tags          []string                `json:"tags"`
+	Priority      int32                   `json:"priority"`
+	ScheduledTime *time.Time              `json:"scheduled_time"`
+	ReminderType  string                  `json:"reminder_type"`
+	Channels      []string                `json:"channels"`
+	Attachments   []inboundJSONAttachment `json:"attachments"`
+}
+
+type inboundJSONAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     string `json:"content_base64"`
+}
+
+type inboundResponse struct {
+	Kind    string   `json:"kind"`
+	ID      string   `json:"id"`
+	FileIDs []string `json:"file_ids,omitempty"`
+}
+
+var (
+	inboundCategories = map[string]entities.IdeaCategory{
+		"":          entities.IdeaCategoryUnspecified,
+		"business":  entities.IdeaCategoryBusiness,
+		"personal":  entities.IdeaCategoryPersonal,
+		"technical": entities.IdeaCategoryTechnical,
+		"creative":  entities.IdeaCategoryCreative,
+		"research":  entities.IdeaCategoryResearch,
+	}
+	inboundReminderTypes = map[string]entities.ReminderType{
+		"":         entities.ReminderTypeUnspecified,
+		"task":     entities.ReminderTypeTask,
+		"meeting":  entities.ReminderTypeMeeting,
+		"deadline": entities.ReminderTypeDeadline,
+		"event":    entities.ReminderTypeEvent,
+		"call":     entities.ReminderTypeCall,
+	}
+)
+
+// serveJSON crea una idea o un recordatorio a partir de una petición JSON
+func (h *InboundHandler) serveJSON(w http.ResponseWriter, r *http.Request, token string) {
+	if !h.allowToken(token) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+
+	var req inboundJSONRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	category, ok := inboundCategories[strings.ToLower(req.Category)]
+	if !ok {
+		http.Error(w, "invalid category", http.StatusBadRequest)
+		return
+	}
+	reminderType, ok := inboundReminderTypes[strings.ToLower(req.ReminderType)]
+	if !ok {
+		http.Error(w, "invalid reminder type", http.StatusBadRequest)
+		return
+	}
+
+	msg := usecases.InboundMessage{
+		Kind:         usecases.InboundKind(strings.ToLower(req.Type)),
+		Title:        req.Title,
+		Content:      req.Content,
+		Category:     category,
+		Tags:         req.Tags,
+		Priority:     req.Priority,
+		ReminderType: reminderType,
+		Channels:     req.Channels,
+	}
+	if msg.Kind == "" {
+		msg.Kind = usecases.InboundKindIdea
+	}
+	if req.ScheduledTime != nil {
+		msg.ScheduledTime = *req.ScheduledTime
+	}
+	for _, attachment := range req.Attachments {
+		content, err := base64.StdEncoding.DecodeString(attachment.Content)
+		if err != nil {
+			http.Error(w, "invalid attachment encoding", http.StatusBadRequest)
+			return
+		}
+		msg.Attachments = append(msg.Attachments, usecases.InboundAttachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     bytes.NewReader(content),
+		})
+	}
+
+	result, err := h.inbound.Receive(r.Context(), token, msg)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newInboundResponse(result))
+}
+
+// receiveEmail crea una idea a partir de un correo. Los correos rechazados se confirman igualmente
+// para que el proveedor no los reintente; solo los fallos internos piden un reintento.
+func (h *InboundHandler) receiveEmail(ctx context.Context, w http.ResponseWriter, email *inboundEmail) {
+	token, ok := email.token(h.config.EmailDomain)
+	if !ok {
+		h.logger.Info("Dropped inbound email without a known recipient", zap.Strings("recipients", email.Recipients))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !h.allowToken(token) {
+		h.logger.Warn("Dropped inbound email over the rate limit")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	_, err := h.inbound.Receive(ctx, token, usecases.InboundMessage{
+		Kind:        usecases.InboundKindIdea,
+		Sender:      email.From,
+		Title:       email.Subject,
+		Content:     email.Text,
+		Spam:        email.Spam,
+		Attachments: email.Attachments,
+	})
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case isInboundRejection(err):
+		h.logger.Info("Rejected inbound email", zap.String("sender", email.From), zap.Error(err))
+		w.WriteHeader(http.StatusOK)
+	default:
+		h.logger.Error("Failed to process inbound email", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// allowToken limita los mensajes por dirección de entrada
+func (h *InboundHandler) allowToken(token string) bool {
+	return h.config.Limiter == nil || h.config.Limiter.Allow("token:"+entities.HashInboundToken(token))
+}
+
+func (h *InboundHandler) validWebhookKey(r *http.Request) bool {
+	if h.config.WebhookKey == "" {
+		return false
+	}
+	key := r.URL.Query().Get("key")
+	return subtle.ConstantTimeCompare([]byte(key), []byte(h.config.WebhookKey)) == 1
+}
+
+func (h *InboundHandler) writeError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, entities.ErrInboundAddressNotFound):
+		http.Error(w, "inbound address not found", http.StatusNotFound)
+	case errors.Is(err, entities.ErrInboundSpam), errors.Is(err, entities.ErrInboundSenderNotAllowed):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.As(err, &maxBytesErr), errors.Is(err, entities.ErrFileSizeExceeded):
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+	case isInboundRejection(err):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		h.logger.Error("Failed to process inbound message", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// isInboundRejection indica si err se debe al mensaje y reintentarlo no cambiaría el resultado
+func isInboundRejection(err error) bool {
+	switch err {
+	case entities.ErrInboundAddressNotFound,
+		entities.ErrInboundSpam,
+		entities.ErrInboundSenderNotAllowed,
+		entities.ErrInboundUnsupportedKind,
+		entities.ErrInboundTooManyAttachments,
+		entities.ErrIdeaTitleRequired,
+		entities.ErrIdeaContentRequired,
+		entities.ErrReminderTitleRequired,
+		entities.ErrReminderScheduledTimeRequired,
+		entities.ErrInvalidReminderType,
+		entities.ErrFileNameRequired,
+		entities.ErrFileSizeExceeded,
+		entities.ErrInvalidFileType:
+		return true
+	}
+	return false
+}
+
+func newInboundResponse(result *usecases.InboundResult) inboundResponse {
+	var response inboundResponse
+	if result.Idea != nil {
+		response.Kind = string(usecases.InboundKindIdea)
+		response.ID = result.Idea.ID.String()
+	}
+	if result.Reminder != nil {
+		response.Kind = string(usecases.InboundKindReminder)
+		response.ID = result.Reminder.ID.String()
+	}
+	for _, fileInfo := range result.Files {
+		response.FileIDs = append(response.FileIDs, fileInfo.ID.String())
+	}
+	return response
+}
@@ -0,0 +1,144 @@
+package web
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"go.uber.org/zap"
+)
+
+// PublicIdeaPathPrefix es la ruta bajo la que se sirven las ideas publicadas: /p/<slug>
+const PublicIdeaPathPrefix = "/p/"
+
+// publicIdeaCSP no permite scripts, recursos externos ni que la página se incruste en otra
+const publicIdeaCSP = "default-src 'none'; style-src 'unsafe-inline'; base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+
+// publicIdeaTemplate escapa todo el texto de la idea: el contenido se muestra tal cual, nunca
+// como HTML, así que una idea publicada no puede inyectar marcado en la página
+var publicIdeaTemplate = template.Must(template.New("idea").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { max-width: 42rem; margin: 2rem auto; padding: 0 1rem; font-family: system-ui, sans-serif; line-height: 1.6; color: #222; }
+p { white-space: pre-wrap; overflow-wrap: anywhere; }
+.tags span { display: inline-block; margin-right: .5rem; padding: 0 .5rem; border-radius: .75rem; background: #eee; font-size: .85rem; }
+footer { margin-top: 2rem; color: #777; font-size: .85rem; }
+</style>
+</head>
+<body>
+<article>
+<h1>{{.Title}}</h1>
+{{range .Paragraphs}}<p>{{.}}</p>
+{{end}}{{if .Tags}}<div class="tags">{{range .Tags}}<span>#{{.}}</span>{{end}}</div>
+{{end}}</article>
+<footer>Updated {{.UpdatedAt}}</footer>
+</body>
+</html>
+`))
+
+type publicIdeaPage struct {
+	Title      string
+	Paragraphs []string
+	Tags       []string
+	UpdatedAt  string
+}
+
+// PublicIdeaHandler sirve en modo solo lectura y sin autenticación las ideas publicadas
+type PublicIdeaHandler struct {
+	publications *usecases.PublicationUseCases
+	limiter      *security.RateLimiter
+	logger       *zap.Logger
+}
+
+// NewPublicIdeaHandler crea el handler de ideas publicadas; limiter, si no es nil, limita las
+// visitas por IP para que no se puedan recorrer los slugs por fuerza bruta
+func NewPublicIdeaHandler(publications *usecases.PublicationUseCases, limiter *security.RateLimiter, logger *zap.Logger) *PublicIdeaHandler {
+	return &PublicIdeaHandler{
+		publications: publications,
+		limiter:      limiter,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *PublicIdeaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	slug := strings.TrimPrefix(r.URL.Path, PublicIdeaPathPrefix)
+	if slug == "" || strings.Contains(slug, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	if h.limiter != nil && !h.limiter.Allow(clientIP(r)) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+
+	// Las peticiones HEAD de los previsualizadores de enlaces no cuentan como visitas
+	idea, publication, err := h.publications.ViewPublishedIdea(r.Context(), slug, r.Method == http.MethodGet)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := publicIdeaTemplate.Execute(&body, newPublicIdeaPage(idea)); err != nil {
+		h.logger.Error("Failed to render published idea", zap.String("publication_id", publication.ID.String()), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", publicIdeaCSP)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	// Sin caché compartida: al despublicar o caducar la idea debe dejar de verse enseguida
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	w.Write(body.Bytes())
+}
+
+func (h *PublicIdeaHandler) writeError(w http.ResponseWriter, err error) {
+	switch err {
+	case entities.ErrIdeaPublicationNotFound:
+		http.Error(w, "idea not found", http.StatusNotFound)
+	case entities.ErrIdeaPublicationExpired:
+		http.Error(w, err.Error(), http.StatusGone)
+	default:
+		h.logger.Error("Failed to serve published idea", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+func newPublicIdeaPage(idea *entities.Idea) publicIdeaPage {
+	var paragraphs []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(idea.Content, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	return publicIdeaPage{
+		Title:      idea.Title,
+		Paragraphs: paragraphs,
+		Tags:       idea.Tags,
+		UpdatedAt:  idea.UpdatedAt.UTC().Format(time.DateOnly),
+	}
+}
@@ -0,0 +1,121 @@
+package web
+
+import (
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	"go.uber.org/zap"
+)
+
+// SharePathPrefix es la ruta bajo la que se sirven las descargas por enlace: /share/<token>
+const SharePathPrefix = "/share/"
+
+// SharePasswordHeader es la cabecera con la contraseña de los enlaces protegidos
+const SharePasswordHeader = "X-Share-Password"
+
+// ShareHandler sirve por HTTP los archivos compartidos mediante enlace, para personas sin cliente gRPC
+type ShareHandler struct {
+	shareLinks *usecases.ShareLinkUseCases
+	limiter    *security.RateLimiter
+	logger     *zap.Logger
+}
+
+// NewShareHandler crea el handler de descargas; limiter, si no es nil, limita los intentos por IP
+func NewShareHandler(shareLinks *usecases.ShareLinkUseCases, limiter *security.RateLimiter, logger *zap.Logger) *ShareHandler {
+	return &ShareHandler{
+		shareLinks: shareLinks,
+		limiter:    limiter,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP implementa http.Handler
+func (h *ShareHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.URL.Path, SharePathPrefix)
+	if token == "" || strings.Contains(token, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	client := usecases.ShareLinkClient{
+		IP:        clientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	// Limita la adivinación de tokens y contraseñas desde una misma dirección
+	if h.limiter != nil && !h.limiter.Allow(client.IP) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+
+	password := r.Header.Get(SharePasswordHeader)
+
+	// HEAD solo comprueba el enlace: no consume una descarga ni queda en la auditoría
+	if r.Method == http.MethodHead {
+		fileInfo, err := h.shareLinks.StatSharedFile(r.Context(), token, password)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeShareHeaders(w, fileInfo)
+		return
+	}
+
+	fileInfo, reader, err := h.shareLinks.OpenSharedFile(r.Context(), token, password, client)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	defer reader.Close()
+
+	writeShareHeaders(w, fileInfo)
+	if _, err := io.Copy(w, reader); err != nil {
+		h.logger.Warn("Failed to stream shared file", zap.String("file_id", fileInfo.ID.String()), zap.Error(err))
+	}
+}
+
+func writeShareHeaders(w http.ResponseWriter, fileInfo *entities.FileInfo) {
+	contentType := fileInfo.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileInfo.Filename}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+}
+
+func (h *ShareHandler) writeError(w http.ResponseWriter, err error) {
+	switch err {
+	case entities.ErrShareLinkNotFound, entities.ErrShareLinkRevoked, entities.ErrFileNotFound:
+		http.Error(w, "share link not found", http.StatusNotFound)
+	case entities.ErrShareLinkExpired, entities.ErrShareLinkDownloadLimitReached:
+		http.Error(w, err.Error(), http.StatusGone)
+	case entities.ErrShareLinkPasswordRequired, entities.ErrShareLinkInvalidPassword:
+		w.Header().Set("WWW-Authenticate", SharePasswordHeader)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	default:
+		h.logger.Error("Failed to serve shared file", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,124 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+var shareTestNow = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+const shareTestToken = "token-de-prueba"
+
+func newTestShareHandler(t *testing.T, link *entities.ShareLink) (*ShareHandler, *mocks.ShareLinkRepository, *mocks.FileRepository, *mocks.FileStorageService) {
+	links := mocks.NewShareLinkRepository(t)
+	files := mocks.NewFileRepository(t)
+	storage := mocks.NewFileStorageService(t)
+	links.On("GetByTokenHash", mock.Anything, entities.HashShareToken(shareTestToken)).Return(link, nil).Maybe()
+
+	shareLinks := usecases.NewShareLinkUseCases(links, files, storage, nil, entities.NewFakeClock(shareTestNow), &entities.SequentialIDGenerator{})
+	return NewShareHandler(shareLinks, nil, zap.NewNop()), links, files, storage
+}
+
+func shareTestLink() (*entities.ShareLink, *entities.FileInfo) {
+	fileInfo := &entities.FileInfo{ID: uuid.New(), Filename: "informe.pdf", ContentType: "application/pdf", Path: "files/informe.pdf"}
+	return &entities.ShareLink{
+		ID:        uuid.New(),
+		FileID:    fileInfo.ID,
+		UserID:    uuid.New(),
+		TokenHash: entities.HashShareToken(shareTestToken),
+		ExpiresAt: shareTestNow.Add(time.Hour),
+		CreatedAt: shareTestNow.Add(-time.Hour),
+	}, fileInfo
+}
+
+func TestShareHandler_GetCountsAndAudits(t *testing.T) {
+	link, fileInfo := shareTestLink()
+	handler, links, files, storage := newTestShareHandler(t, link)
+	files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil)
+	storage.On("RetrieveFile", mock.Anything, fileInfo.Path).Return(io.NopCloser(strings.NewReader("contenido")), nil)
+	links.On("IncrementDownloads", mock.Anything, link.ID).Return(nil).Once()
+	links.On("RecordAccess", mock.Anything, mock.MatchedBy(func(access *entities.ShareLinkAccess) bool {
+		return access.Outcome == entities.ShareLinkAccessGranted
+	})).Return(nil).Once()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SharePathPrefix+shareTestToken, nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "contenido", rec.Body.String())
+}
+
+func TestShareHandler_HeadDoesNotCountOrAudit(t *testing.T) {
+	link, fileInfo := shareTestLink()
+	handler, links, files, storage := newTestShareHandler(t, link)
+	files.On("GetByID", mock.Anything, fileInfo.ID).Return(fileInfo, nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, SharePathPrefix+shareTestToken, nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "informe.pdf")
+	assert.Empty(t, rec.Body.String())
+	links.AssertNotCalled(t, "IncrementDownloads", mock.Anything, mock.Anything)
+	links.AssertNotCalled(t, "RecordAccess", mock.Anything, mock.Anything)
+	storage.AssertNotCalled(t, "RetrieveFile", mock.Anything, mock.Anything)
+}
+
+func TestShareHandler_HeadRejectsUnusableLinks(t *testing.T) {
+	revokedAt := shareTestNow.Add(-time.Minute)
+	tests := []struct {
+		name   string
+		modify func(link *entities.ShareLink)
+		want   int
+	}{
+		{"expired", func(link *entities.ShareLink) { link.ExpiresAt = shareTestNow }, http.StatusGone},
+		{"revoked", func(link *entities.ShareLink) { link.RevokedAt = &revokedAt }, http.StatusNotFound},
+		{"limit reached", func(link *entities.ShareLink) { link.MaxDownloads, link.DownloadCount = 1, 1 }, http.StatusGone},
+		// Un hash que no corresponde a ninguna contraseña rechaza cualquiera
+		{"wrong password", func(link *entities.ShareLink) { link.PasswordHash = "$2a$04$invalid" }, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, _ := shareTestLink()
+			tt.modify(link)
+			handler, links, _, _ := newTestShareHandler(t, link)
+
+			req := httptest.NewRequest(http.MethodHead, SharePathPrefix+shareTestToken, nil)
+			req.Header.Set(SharePasswordHeader, "otra")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+			links.AssertNotCalled(t, "RecordAccess", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestShareHandler_GetAuditsRejections(t *testing.T) {
+	link, _ := shareTestLink()
+	link.ExpiresAt = shareTestNow
+	handler, links, _, _ := newTestShareHandler(t, link)
+	links.On("RecordAccess", mock.Anything, mock.MatchedBy(func(access *entities.ShareLinkAccess) bool {
+		return access.Outcome == entities.ShareLinkAccessExpired && access.ClientIP == "192.0.2.1"
+	})).Return(nil).Once()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SharePathPrefix+shareTestToken, nil))
+
+	assert.Equal(t, http.StatusGone, rec.Code)
+}
@@ -0,0 +1,301 @@
+package appversion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorReason is the ErrorInfo reason of the calls rejected for an outdated app.
+const ErrorReason = "UPGRADE_REQUIRED"
+
+type Config struct {
+	// Header is the metadata key carrying the app version. Defaults to "x-client-version".
+	Header string `json:"header"`
+	// MinVersion is the oldest version accepted; empty accepts every version.
+	MinVersion string `json:"min_version"`
+	// RejectMissing rejects calls without a version instead of letting them through.
+	RejectMissing bool `json:"reject_missing"`
+	// ExemptMethods are full method names that outdated apps can still call, e.g. health checks.
+	ExemptMethods []string `json:"exempt_methods"`
+	// UpgradeURL is returned to rejected clients so they can send the user to the store.
+	UpgradeURL string `json:"upgrade_url"`
+	// MaxVersions caps the distinct major.minor versions reported in metrics.
+	MaxVersions int `json:"max_versions"`
+	// ErrorDomain is the ErrorInfo domain of rejected calls.
+	ErrorDomain string `json:"error_domain"`
+}
+
+// Version is a parsed major.minor.patch version; pre-release and build suffixes are ignored.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion accepts "1", "1.2" and "1.2.3", optionally prefixed with "v"
+// and followed by a "-" or "+" suffix.
+func ParseVersion(value string) (Version, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	if i := strings.IndexAny(trimmed, "-+ "); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	parts := strings.Split(trimmed, ".")
+	if trimmed == "" || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", value)
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", value)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Gate rejects calls from apps older than the minimum version with
+// FailedPrecondition and an UPGRADE_REQUIRED ErrorInfo, and counts the
+// versions seen so the minimum can be raised once few users are left behind.
+type Gate struct {
+	config Config
+	exempt map[string]bool
+
+	mu       sync.RWMutex
+	min      *Version
+	versions map[string]int64
+	rejected int64
+}
+
+func NewGate(config Config) (*Gate, error) {
+	if config.Header == "" {
+		config.Header = "x-client-version"
+	}
+	if config.MaxVersions <= 0 {
+		config.MaxVersions = 50
+	}
+	if config.ErrorDomain == "" {
+		config.ErrorDomain = "notebook"
+	}
+
+	g := &Gate{
+		config:   config,
+		exempt:   make(map[string]bool, len(config.ExemptMethods)),
+		versions: make(map[string]int64),
+	}
+	for _, method := range config.ExemptMethods {
+		g.exempt[method] = true
+	}
+	if err := g.SetMinVersion(config.MinVersion); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// SetMinVersion changes the oldest accepted version; empty disables the check.
+func (g *Gate) SetMinVersion(value string) error {
+	var min *Version
+	if value != "" {
+		parsed, err := ParseVersion(value)
+		if err != nil {
+			return fmt.Errorf("min version: %w", err)
+		}
+		min = &parsed
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.min = min
+	return nil
+}
+
+// MinVersion returns the oldest accepted version, or "" when every version is accepted.
+func (g *Gate) MinVersion() string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.min == nil {
+		return ""
+	}
+	return g.min.String()
+}
+
+func (g *Gate) UpgradeURL() string {
+	return g.config.UpgradeURL
+}
+
+func (g *Gate) check(ctx context.Context, fullMethod string) error {
+	value := g.clientVersion(ctx)
+	g.count(value)
+
+	if g.exempt[fullMethod] {
+		return nil
+	}
+
+	g.mu.RLock()
+	min := g.min
+	g.mu.RUnlock()
+	if min == nil {
+		return nil
+	}
+
+	if value == "" {
+		if !g.config.RejectMissing {
+			return nil
+		}
+		return g.reject(value, *min, "client version is required")
+	}
+	version, err := ParseVersion(value)
+	if err != nil {
+		return g.reject(value, *min, fmt.Sprintf("client version %q is not valid", value))
+	}
+	if version.Less(*min) {
+		return g.reject(value, *min, fmt.Sprintf("client version %s is no longer supported, upgrade to %s or later", value, min))
+	}
+	return nil
+}
+
+func (g *Gate) reject(value string, min Version, message string) error {
+	g.mu.Lock()
+	g.rejected++
+	g.mu.Unlock()
+
+	info := &errdetails.ErrorInfo{
+		Reason: ErrorReason,
+		Domain: g.config.ErrorDomain,
+		Metadata: map[string]string{
+			"min_version": min.String(),
+		},
+	}
+	if value != "" {
+		info.Metadata["client_version"] = value
+	}
+	if g.config.UpgradeURL != "" {
+		info.Metadata["upgrade_url"] = g.config.UpgradeURL
+	}
+
+	st := status.New(codes.FailedPrecondition, message)
+	if detailed, err := st.WithDetails(info); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (g *Gate) clientVersion(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(g.config.Header); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// count records the call under its major.minor version, keeping the first
+// MaxVersions values so that made-up versions cannot grow the map unbounded.
+func (g *Gate) count(value string) {
+	label := metrics.UnknownLabelValue
+	if value != "" {
+		label = metrics.MajorMinorVersion(value)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.versions[label]; !ok && len(g.versions) >= g.config.MaxVersions {
+		label = metrics.OverflowLabelValue
+	}
+	g.versions[label]++
+}
+
+// Distribution returns the number of calls seen per major.minor version.
+func (g *Gate) Distribution() map[string]int64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	result := make(map[string]int64, len(g.versions))
+	for version, count := range g.versions {
+		result[version] = count
+	}
+	return result
+}
+
+func (g *Gate) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := g.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (g *Gate) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := g.check(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// Metrics is a metrics.MetricsCollector collector for the version distribution and the rejected calls.
+func (g *Gate) Metrics() []metrics.Metric {
+	distribution := g.Distribution()
+	versions := make([]string, 0, len(distribution))
+	for version := range distribution {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	g.mu.RLock()
+	rejected := g.rejected
+	g.mu.RUnlock()
+
+	now := time.Now()
+	result := make([]metrics.Metric, 0, len(versions)+1)
+	for _, version := range versions {
+		result = append(result, metrics.Metric{
+			Name:      "client_version_requests_total",
+			Type:      metrics.Counter,
+			Value:     float64(distribution[version]),
+			Labels:    map[string]string{"version": version},
+			Timestamp: now,
+		})
+	}
+	result = append(result, metrics.Metric{Name: "client_version_rejected_total", Type: metrics.Counter, Value: float64(rejected), Timestamp: now})
+	return result
+}
@@ -0,0 +1,89 @@
+package appversion
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGateRejectsOutdatedVersions(t *testing.T) {
+	gate, err := NewGate(Config{
+		MinVersion:    "2.3.0",
+		ExemptMethods: []string{"/grpc.health.v1.Health/Check"},
+		UpgradeURL:    "https://example.com/app",
+	})
+	if err != nil {
+		t.Fatalf("NewGate: %v", err)
+	}
+	interceptor := gate.UnaryInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	call := func(method, version string) error {
+		ctx := context.Background()
+		if version != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-client-version", version))
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	for _, version := range []string{"2.3.0", "2.10.1", "v3.0.0-beta", ""} {
+		if err := call("/notebook.NotebookService/ListIdeas", version); err != nil {
+			t.Errorf("version %q rejected: %v", version, err)
+		}
+	}
+	if err := call("/grpc.health.v1.Health/Check", "1.0.0"); err != nil {
+		t.Errorf("exempt method rejected: %v", err)
+	}
+
+	err = call("/notebook.NotebookService/ListIdeas", "2.2.9")
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want FailedPrecondition", status.Code(err))
+	}
+	var info *errdetails.ErrorInfo
+	for _, detail := range status.Convert(err).Details() {
+		if detail, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = detail
+		}
+	}
+	if info == nil || info.Reason != ErrorReason {
+		t.Fatalf("ErrorInfo = %v, want reason %s", info, ErrorReason)
+	}
+	if info.Metadata["min_version"] != "2.3.0" || info.Metadata["upgrade_url"] != "https://example.com/app" {
+		t.Errorf("metadata = %v", info.Metadata)
+	}
+
+	if err := gate.SetMinVersion(""); err != nil {
+		t.Fatalf("SetMinVersion: %v", err)
+	}
+	if err := call("/notebook.NotebookService/ListIdeas", "1.0.0"); err != nil {
+		t.Errorf("version accepted after disabling the minimum: %v", err)
+	}
+
+	distribution := gate.Distribution()
+	if distribution["2.3"] != 1 || distribution["1.0"] != 2 || distribution["unknown"] != 1 {
+		t.Errorf("distribution = %v", distribution)
+	}
+}
+
+func TestGateRejectMissing(t *testing.T) {
+	gate, err := NewGate(Config{MinVersion: "1.0", RejectMissing: true})
+	if err != nil {
+		t.Fatalf("NewGate: %v", err)
+	}
+	err = gate.check(context.Background(), "/notebook.NotebookService/ListIdeas")
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestNewGateRejectsInvalidMinVersion(t *testing.T) {
+	if _, err := NewGate(Config{MinVersion: "latest"}); err == nil {
+		t.Error("NewGate accepted an invalid minimum version")
+	}
+}
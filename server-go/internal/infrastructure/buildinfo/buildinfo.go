@@ -0,0 +1,65 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// Set at build time with
+//
+//	-ldflags "-X <module>/internal/infrastructure/buildinfo.Version=1.2.0 -X ...buildinfo.Commit=abc123"
+//
+// see the build target of the Makefile.
+var (
+	Version = "dev"
+	Commit  = ""
+	// Date is the build time in RFC 3339.
+	Date = ""
+	// SchemaVersion identifies the proto files the server was generated from.
+	SchemaVersion = ""
+)
+
+type Info struct {
+	Version       string    `json:"version"`
+	Commit        string    `json:"commit"`
+	Date          time.Time `json:"date"`
+	SchemaVersion string    `json:"schema_version"`
+	GoVersion     string    `json:"go_version"`
+	// Modified is true when the binary was built from a tree with uncommitted changes.
+	Modified bool `json:"modified"`
+}
+
+// Get returns the build information, falling back to the VCS stamp the Go
+// toolchain embeds when the ldflags were not set, e.g. in go run.
+func Get() Info {
+	info := Info{
+		Version:       Version,
+		Commit:        Commit,
+		SchemaVersion: SchemaVersion,
+		GoVersion:     runtime.Version(),
+	}
+	if date, err := time.Parse(time.RFC3339, Date); err == nil {
+		info.Date = date
+	}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.Date.IsZero() {
+				info.Date, _ = time.Parse(time.RFC3339, setting.Value)
+			}
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+	return info
+}
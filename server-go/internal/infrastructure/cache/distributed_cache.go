@@ -4,11 +4,14 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
 )
 
 var (
@@ -17,6 +20,8 @@ var (
 	ErrCacheFull   = errors.New("cache is full")
 )
 
+// CacheEntry is owned by the cache, which recycles entries through a pool once they are
+// deleted, evicted or expire. Callers only ever see copies, such as GetWithInfo's.
 type CacheEntry struct {
 	Key        string      `json:"key"`
 	Value      interface{} `json:"value"`
@@ -24,16 +29,32 @@ type CacheEntry struct {
 	CreatedAt  time.Time   `json:"created_at"`
 	AccessedAt time.Time   `json:"accessed_at"`
 	AccessCount int64      `json:"access_count"`
+	// SlidingTTL, when set, is how far each read pushes ExpiresAt, never past MaxExpiresAt
+	SlidingTTL   time.Duration `json:"sliding_ttl,omitempty"`
+	MaxExpiresAt time.Time     `json:"max_expires_at,omitempty"`
+	// Size is the memory accounted to the entry in bytes, see CacheConfig.SizeMode
 	Size       int         `json:"size"`
 }
 
-func (e *CacheEntry) IsExpired() bool {
-	return !e.ExpiresAt.IsZero() && time.Now().After(e.ExpiresAt)
+func (e *CacheEntry) IsExpired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && now.After(e.ExpiresAt)
+}
+
+// Reset clears the entry for reuse and drops its reference to the value.
+func (e *CacheEntry) Reset() {
+	*e = CacheEntry{}
 }
 
-func (e *CacheEntry) Touch() {
-	e.AccessedAt = time.Now()
+func (e *CacheEntry) Touch(now time.Time) {
+	e.AccessedAt = now
 	e.AccessCount++
+	
+	if e.SlidingTTL > 0 {
+		e.ExpiresAt = now.Add(e.SlidingTTL)
+		if e.ExpiresAt.After(e.MaxExpiresAt) {
+			e.ExpiresAt = e.MaxExpiresAt
+		}
+	}
 }
 
 type CacheStats struct {
@@ -41,7 +62,9 @@ type CacheStats struct {
 	HitCount      int64         `json:"hit_count"`
 	MissCount     int64         `json:"miss_count"`
 	EvictionCount int64         `json:"eviction_count"`
+	// TotalSize is the memory accounted to all entries in bytes, kept within MaxMemory
 	TotalSize     int           `json:"total_size"`
+	MaxMemory     int           `json:"max_memory"`
 	HitRatio      float64       `json:"hit_ratio"`
 	AvgAccessTime time.Duration `json:"avg_access_time"`
 }
@@ -58,10 +81,16 @@ const (
 type CacheConfig struct {
 	MaxSize        int            `json:"max_size"`
 	MaxMemory      int            `json:"max_memory"` // bytes
+	// SizeMode and Sizer set how entry sizes are estimated for MaxMemory; SizeSerialized by default
+	SizeMode       SizeMode       `json:"size_mode"`
+	Sizer          Sizer          `json:"-"`
 	DefaultTTL     time.Duration  `json:"default_ttl"`
 	EvictionPolicy EvictionPolicy `json:"eviction_policy"`
 	CleanupInterval time.Duration `json:"cleanup_interval"`
 	EnableMetrics  bool           `json:"enable_metrics"`
+	Clock          entities.Clock `json:"-"`
+	// ExternalCleanup disables the internal cleanup goroutine; the caller schedules CleanupExpired.
+	ExternalCleanup bool          `json:"external_cleanup"`
 }
 
 type DistributedCache struct {
@@ -70,6 +99,8 @@ type DistributedCache struct {
 	config   CacheConfig
 	stats    CacheStats
 	stopCh   chan struct{}
+	// memoryUsed is the sum of the entry sizes, kept up to date by Set and removeEntry
+	memoryUsed int
 	
 	// Event handlers
 	onSet    func(key string, value interface{})
@@ -91,6 +122,12 @@ func NewDistributedCache(config CacheConfig) *DistributedCache {
 	if config.EvictionPolicy == "" {
 		config.EvictionPolicy = LRU
 	}
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+	if config.SizeMode == "" {
+		config.SizeMode = SizeSerialized
+	}
 	
 	cache := &DistributedCache{
 		entries: make(map[string]*CacheEntry),
@@ -98,55 +135,148 @@ func NewDistributedCache(config CacheConfig) *DistributedCache {
 		stopCh:  make(chan struct{}),
 	}
 	
-	cache.startCleanupRoutine()
+	if !config.ExternalCleanup {
+		cache.startCleanupRoutine()
+	}
 	return cache
 }
 
 func (dc *DistributedCache) Set(ctx context.Context, key string, value interface{}, ttl ...time.Duration) error {
+	// Sizing may serialize the value, so it happens before taking the lock
+	size, err := dc.entrySize(key, value)
+	if err != nil {
+		return fmt.Errorf("failed to size value: %w", err)
+	}
+	if dc.config.MaxMemory > 0 && size > dc.config.MaxMemory {
+		return ErrCacheFull
+	}
+	
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
 	
-	if dc.needsEviction() {
-		if err := dc.evictEntries(1); err != nil {
-			return err
-		}
+	dc.setLocked(key, value, size, firstTTL(ttl), 0)
+	return nil
+}
+
+// SetSliding stores value with sliding expiration: it expires after idleTTL without reads, and
+// every Get or MGet hit extends it by idleTTL again, up to maxLifetime after this call. Suited
+// to session-like data that stays valid while in use.
+func (dc *DistributedCache) SetSliding(ctx context.Context, key string, value interface{}, idleTTL, maxLifetime time.Duration) error {
+	if idleTTL <= 0 || maxLifetime < idleTTL {
+		return fmt.Errorf("invalid sliding expiration: idle %s, max lifetime %s", idleTTL, maxLifetime)
 	}
 	
-	expiration := time.Time{}
-	if len(ttl) > 0 && ttl[0] > 0 {
-		expiration = time.Now().Add(ttl[0])
-	} else if dc.config.DefaultTTL > 0 {
-		expiration = time.Now().Add(dc.config.DefaultTTL)
+	size, err := dc.entrySize(key, value)
+	if err != nil {
+		return fmt.Errorf("failed to size value: %w", err)
+	}
+	if dc.config.MaxMemory > 0 && size > dc.config.MaxMemory {
+		return ErrCacheFull
 	}
 	
-	serialized, err := json.Marshal(value)
-	if err != nil {
-		return fmt.Errorf("failed to serialize value: %w", err)
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	
+	dc.setLocked(key, value, size, idleTTL, maxLifetime)
+	return nil
+}
+
+// MSet stores all items with the same TTL under a single lock. Every value is sized first, so
+// nothing is stored if one of them cannot be.
+func (dc *DistributedCache) MSet(ctx context.Context, items map[string]interface{}, ttl ...time.Duration) error {
+	sizes := make(map[string]int, len(items))
+	for key, value := range items {
+		size, err := dc.entrySize(key, value)
+		if err != nil {
+			return fmt.Errorf("failed to size value of %s: %w", key, err)
+		}
+		if dc.config.MaxMemory > 0 && size > dc.config.MaxMemory {
+			return ErrCacheFull
+		}
+		sizes[key] = size
+	}
+	
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	
+	for key, value := range items {
+		dc.setLocked(key, value, sizes[key], firstTTL(ttl), 0)
+	}
+	return nil
+}
+
+// firstTTL returns the optional TTL argument of Set and MSet, or 0 for the default.
+func firstTTL(ttl []time.Duration) time.Duration {
+	if len(ttl) > 0 {
+		return ttl[0]
+	}
+	return 0
+}
+
+// setLocked stores value expiring after ttl, or DefaultTTL when it is not positive. A positive
+// maxLifetime makes ttl a sliding expiration, see SetSliding.
+func (dc *DistributedCache) setLocked(key string, value interface{}, size int, ttl, maxLifetime time.Duration) {
+	if dc.needsEviction(key, size) {
+		dc.evictEntries(key, size)
 	}
 	
-	entry := &CacheEntry{
-		Key:         key,
-		Value:       value,
-		ExpiresAt:   expiration,
-		CreatedAt:   time.Now(),
-		AccessedAt:  time.Now(),
-		AccessCount: 1,
-		Size:        len(serialized),
+	now := dc.config.Clock.Now()
+	expiration := time.Time{}
+	if ttl > 0 {
+		expiration = now.Add(ttl)
+	} else if dc.config.DefaultTTL > 0 {
+		expiration = now.Add(dc.config.DefaultTTL)
 	}
 	
-	dc.entries[key] = entry
+	// Overwriting a key reuses its entry
+	entry, exists := dc.entries[key]
+	if !exists {
+		entry = acquireEntry()
+		dc.entries[key] = entry
+	}
+	dc.memoryUsed += size - entry.Size
+	entry.Key = key
+	entry.Value = value
+	entry.ExpiresAt = expiration
+	entry.CreatedAt = now
+	entry.AccessedAt = now
+	entry.AccessCount = 1
+	entry.Size = size
+	entry.SlidingTTL = 0
+	entry.MaxExpiresAt = time.Time{}
+	if maxLifetime > 0 {
+		entry.SlidingTTL = ttl
+		entry.MaxExpiresAt = now.Add(maxLifetime)
+	}
 	
 	if dc.onSet != nil {
 		dc.onSet(key, value)
 	}
-	
-	return nil
 }
 
 func (dc *DistributedCache) Get(ctx context.Context, key string) (interface{}, error) {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
 	
+	return dc.getLocked(key)
+}
+
+// MGet looks up keys under a single lock and returns the values found; missing and expired
+// keys are left out and counted as misses.
+func (dc *DistributedCache) MGet(ctx context.Context, keys []string) map[string]interface{} {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	
+	values := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, err := dc.getLocked(key); err == nil {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+func (dc *DistributedCache) getLocked(key string) (interface{}, error) {
 	entry, exists := dc.entries[key]
 	if !exists {
 		dc.stats.MissCount++
@@ -156,8 +286,8 @@ func (dc *DistributedCache) Get(ctx context.Context, key string) (interface{}, e
 		return nil, ErrKeyNotFound
 	}
 	
-	if entry.IsExpired() {
-		delete(dc.entries, key)
+	if entry.IsExpired(dc.config.Clock.Now()) {
+		dc.removeEntry(key)
 		dc.stats.MissCount++
 		if dc.onGet != nil {
 			dc.onGet(key, false)
@@ -165,7 +295,7 @@ func (dc *DistributedCache) Get(ctx context.Context, key string) (interface{}, e
 		return nil, ErrKeyExpired
 	}
 	
-	entry.Touch()
+	entry.Touch(dc.config.Clock.Now())
 	dc.stats.HitCount++
 	
 	if dc.onGet != nil {
@@ -184,7 +314,7 @@ func (dc *DistributedCache) GetWithInfo(ctx context.Context, key string) (*Cache
 		return nil, ErrKeyNotFound
 	}
 	
-	if entry.IsExpired() {
+	if entry.IsExpired(dc.config.Clock.Now()) {
 		return nil, ErrKeyExpired
 	}
 	
@@ -196,12 +326,10 @@ func (dc *DistributedCache) Delete(ctx context.Context, key string) error {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
 	
-	if _, exists := dc.entries[key]; !exists {
+	if !dc.removeEntry(key) {
 		return ErrKeyNotFound
 	}
 	
-	delete(dc.entries, key)
-	
 	if dc.onDelete != nil {
 		dc.onDelete(key)
 	}
@@ -221,7 +349,7 @@ func (dc *DistributedCache) SetWithCallback(
 	}
 	
 	if callback != nil && ttl > 0 {
-		go func() {
+		supervisor.Go("cache.expiry_callback", func() {
 			timer := time.NewTimer(ttl)
 			defer timer.Stop()
 			
@@ -231,7 +359,7 @@ func (dc *DistributedCache) SetWithCallback(
 			case <-ctx.Done():
 				callback(key, false)
 			}
-		}()
+		})
 	}
 	
 	return nil
@@ -281,7 +409,11 @@ func (dc *DistributedCache) Clear() {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
 	
+	for _, entry := range dc.entries {
+		releaseEntry(entry)
+	}
 	dc.entries = make(map[string]*CacheEntry)
+	dc.memoryUsed = 0
 	dc.stats = CacheStats{}
 }
 
@@ -302,36 +434,33 @@ func (dc *DistributedCache) Stats() CacheStats {
 		stats.HitRatio = float64(stats.HitCount) / float64(stats.HitCount+stats.MissCount)
 	}
 	
-	totalSize := 0
-	for _, entry := range dc.entries {
-		totalSize += entry.Size
-	}
-	stats.TotalSize = totalSize
+	stats.TotalSize = dc.memoryUsed
+	stats.MaxMemory = dc.config.MaxMemory
 	
 	return stats
 }
 
-func (dc *DistributedCache) needsEviction() bool {
-	if dc.config.MaxSize > 0 && len(dc.entries) >= dc.config.MaxSize {
+// needsEviction reports whether storing size bytes under key would exceed MaxSize or MaxMemory.
+func (dc *DistributedCache) needsEviction(key string, size int) bool {
+	existing, overwrite := dc.entries[key]
+	if !overwrite && dc.config.MaxSize > 0 && len(dc.entries) >= dc.config.MaxSize {
 		return true
 	}
 	
 	if dc.config.MaxMemory > 0 {
-		totalSize := 0
-		for _, entry := range dc.entries {
-			totalSize += entry.Size
+		used := dc.memoryUsed + size
+		if overwrite {
+			used -= existing.Size
 		}
-		return totalSize >= dc.config.MaxMemory
+		return used > dc.config.MaxMemory
 	}
 	
 	return false
 }
 
-func (dc *DistributedCache) evictEntries(count int) error {
-	if len(dc.entries) == 0 {
-		return nil
-	}
-	
+// evictEntries evicts in policy order until size bytes fit under key.
+func (dc *DistributedCache) evictEntries(key string, size int) {
+	count := len(dc.entries)
 	var keysToEvict []string
 	
 	switch dc.config.EvictionPolicy {
@@ -342,23 +471,36 @@ func (dc *DistributedCache) evictEntries(count int) error {
 	case FIFO:
 		keysToEvict = dc.getFIFOKeys(count)
 	case TTL:
-		keysToEvict = dc.getExpiredKeys()
-		if len(keysToEvict) < count {
-			keysToEvict = append(keysToEvict, dc.getLRUKeys(count-len(keysToEvict))...)
-		}
+		keysToEvict = append(dc.getExpiredKeys(), dc.getLRUKeys(count)...)
 	default:
 		keysToEvict = dc.getLRUKeys(count)
 	}
 	
-	for _, key := range keysToEvict {
-		delete(dc.entries, key)
+	for _, victim := range keysToEvict {
+		if !dc.needsEviction(key, size) {
+			return
+		}
+		// The TTL policy lists expired keys twice
+		if !dc.removeEntry(victim) {
+			continue
+		}
 		dc.stats.EvictionCount++
 		if dc.onEvict != nil {
-			dc.onEvict(key, string(dc.config.EvictionPolicy))
+			dc.onEvict(victim, string(dc.config.EvictionPolicy))
 		}
 	}
-	
-	return nil
+}
+
+// removeEntry deletes key, releases its memory and recycles its entry.
+func (dc *DistributedCache) removeEntry(key string) bool {
+	entry, exists := dc.entries[key]
+	if !exists {
+		return false
+	}
+	delete(dc.entries, key)
+	dc.memoryUsed -= entry.Size
+	releaseEntry(entry)
+	return true
 }
 
 func (dc *DistributedCache) getLRUKeys(count int) []string {
@@ -372,13 +514,9 @@ func (dc *DistributedCache) getLRUKeys(count int) []string {
 		items = append(items, keyTime{key: key, time: entry.AccessedAt})
 	}
 	
-	for i := 0; i < len(items)-1; i++ {
-		for j := i + 1; j < len(items); j++ {
-			if items[i].time.After(items[j].time) {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].time.Before(items[j].time)
+	})
 	
 	var keys []string
 	for i := 0; i < count && i < len(items); i++ {
@@ -399,13 +537,9 @@ func (dc *DistributedCache) getLFUKeys(count int) []string {
 		items = append(items, keyCount{key: key, count: entry.AccessCount})
 	}
 	
-	for i := 0; i < len(items)-1; i++ {
-		for j := i + 1; j < len(items); j++ {
-			if items[i].count > items[j].count {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].count < items[j].count
+	})
 	
 	var keys []string
 	for i := 0; i < count && i < len(items); i++ {
@@ -426,13 +560,9 @@ func (dc *DistributedCache) getFIFOKeys(count int) []string {
 		items = append(items, keyTime{key: key, time: entry.CreatedAt})
 	}
 	
-	for i := 0; i < len(items)-1; i++ {
-		for j := i + 1; j < len(items); j++ {
-			if items[i].time.After(items[j].time) {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].time.Before(items[j].time)
+	})
 	
 	var keys []string
 	for i := 0; i < count && i < len(items); i++ {
@@ -444,7 +574,7 @@ func (dc *DistributedCache) getFIFOKeys(count int) []string {
 
 func (dc *DistributedCache) getExpiredKeys() []string {
 	var keys []string
-	now := time.Now()
+	now := dc.config.Clock.Now()
 	
 	for key, entry := range dc.entries {
 		if !entry.ExpiresAt.IsZero() && now.After(entry.ExpiresAt) {
@@ -456,7 +586,7 @@ func (dc *DistributedCache) getExpiredKeys() []string {
 }
 
 func (dc *DistributedCache) startCleanupRoutine() {
-	go func() {
+	supervisor.Go("cache.cleanup", func() {
 		ticker := time.NewTicker(dc.config.CleanupInterval)
 		defer ticker.Stop()
 		
@@ -468,20 +598,26 @@ func (dc *DistributedCache) startCleanupRoutine() {
 				return
 			}
 		}
-	}()
+	})
+}
+
+// CleanupExpired removes expired entries and returns how many were removed.
+func (dc *DistributedCache) CleanupExpired() int {
+	return dc.cleanup()
 }
 
-func (dc *DistributedCache) cleanup() {
+func (dc *DistributedCache) cleanup() int {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
 	
 	expiredKeys := dc.getExpiredKeys()
 	for _, key := range expiredKeys {
-		delete(dc.entries, key)
+		dc.removeEntry(key)
 		if dc.onEvict != nil {
 			dc.onEvict(key, "expired")
 		}
 	}
+	return len(expiredKeys)
 }
 
 func (dc *DistributedCache) matchPattern(key, pattern string) bool {
@@ -0,0 +1,323 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+	"unsafe"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type benchValue struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+}
+
+func TestDistributedCache_RecyclesEntries(t *testing.T) {
+	cache := NewDistributedCache(CacheConfig{MaxSize: 2, ExternalCleanup: true})
+	defer cache.Stop()
+	ctx := context.Background()
+
+	require.NoError(t, cache.Set(ctx, "a", "first", time.Hour))
+	info, err := cache.GetWithInfo(ctx, "a")
+	require.NoError(t, err)
+
+	// Overwriting, deleting and evicting recycle entries; copies handed out are unaffected
+	require.NoError(t, cache.Set(ctx, "a", "second", time.Hour))
+	require.NoError(t, cache.Set(ctx, "b", "value", time.Hour))
+	require.NoError(t, cache.Delete(ctx, "b"))
+	require.NoError(t, cache.Set(ctx, "c", "value", time.Hour))
+	require.NoError(t, cache.Set(ctx, "d", "value", time.Hour))
+
+	assert.Equal(t, "first", info.Value)
+	assert.Equal(t, 2, cache.Size())
+	for _, key := range cache.Keys("") {
+		entry, err := cache.GetWithInfo(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, key, entry.Key)
+	}
+}
+
+func TestDistributedCache_EnforcesMaxMemory(t *testing.T) {
+	value := make([]byte, 100)
+	entry := entryOverhead + len("key-0") + len(value)
+	cache := NewDistributedCache(CacheConfig{MaxMemory: 3 * entry, ExternalCleanup: true})
+	defer cache.Stop()
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, cache.Set(ctx, "key-"+strconv.Itoa(i), value, time.Hour))
+		assert.LessOrEqual(t, cache.Stats().TotalSize, 3*entry)
+	}
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, 3*entry, cache.Stats().TotalSize)
+	assert.Equal(t, int64(2), cache.Stats().EvictionCount)
+
+	// Overwriting with a larger value evicts until it fits, never the key itself
+	require.NoError(t, cache.Set(ctx, "key-4", make([]byte, 200), time.Hour))
+	assert.Equal(t, 2, cache.Size())
+	_, err := cache.Get(ctx, "key-4")
+	assert.NoError(t, err)
+
+	require.NoError(t, cache.Delete(ctx, "key-4"))
+	assert.Equal(t, entry, cache.Stats().TotalSize)
+
+	assert.ErrorIs(t, cache.Set(ctx, "big", make([]byte, 4*entry), time.Hour), ErrCacheFull)
+}
+
+func TestDistributedCache_SizeModes(t *testing.T) {
+	value := benchValue{ID: "1", Title: "Idea", Tags: []string{"a", "b"}}
+	overhead := entryOverhead + len("key")
+
+	serialized := NewDistributedCache(CacheConfig{ExternalCleanup: true})
+	defer serialized.Stop()
+	shallow := NewDistributedCache(CacheConfig{SizeMode: SizeShallow, ExternalCleanup: true})
+	defer shallow.Stop()
+	custom := NewDistributedCache(CacheConfig{Sizer: func(interface{}) (int, error) { return 7, nil }, ExternalCleanup: true})
+	defer custom.Stop()
+
+	for _, cache := range []*DistributedCache{serialized, shallow, custom} {
+		require.NoError(t, cache.Set(context.Background(), "key", value, time.Hour))
+	}
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	assert.Equal(t, overhead+len(data), serialized.Stats().TotalSize)
+	// The struct, its two strings, the tags' backing array and the tags' strings
+	assert.Equal(t, overhead+int(unsafe.Sizeof(value))+len("1")+len("Idea")+2*int(unsafe.Sizeof(""))+2, shallow.Stats().TotalSize)
+	assert.Equal(t, overhead+7, custom.Stats().TotalSize)
+}
+
+func TestDistributedCache_MGetMSet(t *testing.T) {
+	cache := NewDistributedCache(CacheConfig{ExternalCleanup: true})
+	defer cache.Stop()
+	ctx := context.Background()
+
+	require.NoError(t, cache.MSet(ctx, map[string]interface{}{"a": 1, "b": 2, "c": 3}, time.Hour))
+	assert.Equal(t, 3, cache.Size())
+
+	values := cache.MGet(ctx, []string{"a", "c", "missing"})
+	assert.Equal(t, map[string]interface{}{"a": 1, "c": 3}, values)
+	assert.Equal(t, int64(2), cache.Stats().HitCount)
+	assert.Equal(t, int64(1), cache.Stats().MissCount)
+
+	// A value that cannot be sized stores nothing
+	err := cache.MSet(ctx, map[string]interface{}{"d": 4, "e": make(chan int)}, time.Hour)
+	assert.Error(t, err)
+	assert.Equal(t, 3, cache.Size())
+}
+
+func TestDistributedCache_SlidingExpiration(t *testing.T) {
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	cache := NewDistributedCache(CacheConfig{Clock: clock, ExternalCleanup: true})
+	defer cache.Stop()
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetSliding(ctx, "session", "token", time.Minute, 3*time.Minute))
+
+	// Each read within the idle TTL extends it, up to the max lifetime
+	for i := 0; i < 3; i++ {
+		clock.Advance(50 * time.Second)
+		_, err := cache.Get(ctx, "session")
+		require.NoError(t, err, "read %d", i)
+	}
+	clock.Advance(50 * time.Second)
+	_, err := cache.Get(ctx, "session")
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	// Without reads it expires after the idle TTL
+	require.NoError(t, cache.SetSliding(ctx, "idle", "token", time.Minute, time.Hour))
+	clock.Advance(61 * time.Second)
+	_, err = cache.Get(ctx, "idle")
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	// Overwriting with Set turns sliding expiration off
+	require.NoError(t, cache.SetSliding(ctx, "fixed", "token", time.Minute, time.Hour))
+	require.NoError(t, cache.Set(ctx, "fixed", "token", 2*time.Minute))
+	clock.Advance(90 * time.Second)
+	_, err = cache.Get(ctx, "fixed")
+	require.NoError(t, err)
+	clock.Advance(31 * time.Second)
+	_, err = cache.Get(ctx, "fixed")
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	assert.Error(t, cache.SetSliding(ctx, "invalid", "token", time.Hour, time.Minute))
+}
+
+func newBenchCache(b *testing.B, entries int) *DistributedCache {
+	b.Helper()
+	cache := NewDistributedCache(CacheConfig{MaxSize: entries * 2, ExternalCleanup: true})
+	ctx := context.Background()
+	for i := 0; i < entries; i++ {
+		value := benchValue{ID: strconv.Itoa(i), Title: "Idea", Tags: []string{"a", "b"}}
+		if err := cache.Set(ctx, "key-"+strconv.Itoa(i), value, time.Hour); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return cache
+}
+
+func BenchmarkDistributedCache_Set(b *testing.B) {
+	cache := NewDistributedCache(CacheConfig{MaxSize: 1000, ExternalCleanup: true})
+	ctx := context.Background()
+	value := benchValue{ID: "1", Title: "Idea", Tags: []string{"a", "b"}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Past MaxSize every Set also evicts, which is the steady state of a warm cache
+		if err := cache.Set(ctx, "key-"+strconv.Itoa(i%2000), value, time.Hour); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDistributedCache_Get(b *testing.B) {
+	cache := newBenchCache(b, 1000)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cache.Get(ctx, "key-"+strconv.Itoa(i%1000)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDistributedCache_GetParallel(b *testing.B) {
+	cache := newBenchCache(b, 1000)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if _, err := cache.Get(ctx, "key-"+strconv.Itoa(i%1000)); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
+
+// benchRequest is a proto message with a user_id, like the cached read requests.
+type benchRequest struct {
+	*wrapperspb.StringValue
+}
+
+func (r benchRequest) GetUserId() string {
+	return r.GetValue()
+}
+
+func BenchmarkResponseCache_Hit(b *testing.B) {
+	const method = "/notebook.NotebookService/GetIdea"
+	responseCache := NewResponseCache(ResponseCacheConfig{Methods: map[string]time.Duration{method: time.Minute}})
+	interceptor := responseCache.UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	req := benchRequest{wrapperspb.String(uuid.NewString())}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return wrapperspb.String("response"), nil
+	}
+	ctx := context.Background()
+
+	if _, err := interceptor(ctx, req, info, handler); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := interceptor(ctx, req, info, handler); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDistributedCache_Overwrite(b *testing.B) {
+	cache := newBenchCache(b, 1000)
+	ctx := context.Background()
+	value := benchValue{ID: "1", Title: "Idea", Tags: []string{"a", "b"}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := cache.Set(ctx, "key-"+strconv.Itoa(i%1000), value, time.Hour); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestResponseCache_NegativeEntries(t *testing.T) {
+	const method = "/notebook.NotebookService/GetIdea"
+	ctx := context.Background()
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	userID := uuid.New()
+	req := benchRequest{wrapperspb.String(userID.String())}
+
+	calls := 0
+	found := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if !found {
+			return nil, status.Error(codes.NotFound, "idea not found")
+		}
+		return wrapperspb.String("idea"), nil
+	}
+
+	for _, allow := range []bool{false, true} {
+		calls, found = 0, false
+		responseCache := NewResponseCache(ResponseCacheConfig{
+			Methods:       map[string]time.Duration{method: time.Minute},
+			AllowNegative: allow,
+		})
+		interceptor := responseCache.UnaryInterceptor()
+
+		for i := 0; i < 2; i++ {
+			_, err := interceptor(ctx, req, info, handler)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		}
+		if !allow {
+			assert.Equal(t, 2, calls)
+			continue
+		}
+		assert.Equal(t, 1, calls)
+
+		// Creating the idea invalidates the user's entries, including the negative one
+		found = true
+		responseCache.InvalidateUser(ctx, userID)
+		resp, err := interceptor(ctx, req, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "idea", resp.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, 2, calls)
+	}
+}
+
+func BenchmarkDistributedCache_MGet(b *testing.B) {
+	cache := newBenchCache(b, 1000)
+	ctx := context.Background()
+	keys := make([]string, 50)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i*20)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if values := cache.MGet(ctx, keys); len(values) != len(keys) {
+			b.Fatalf("got %d values, want %d", len(values), len(keys))
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package cache
+
+import "sync"
+
+var entryPool = sync.Pool{
+	New: func() interface{} {
+		return &CacheEntry{}
+	},
+}
+
+func acquireEntry() *CacheEntry {
+	return entryPool.Get().(*CacheEntry)
+}
+
+// releaseEntry recycles an entry the cache no longer references. Entries never leave the
+// cache, so nothing else can hold one.
+func releaseEntry(entry *CacheEntry) {
+	if entry == nil {
+		return
+	}
+	entry.Reset()
+	entryPool.Put(entry)
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// PublicationViewCounter implements ports.PublicationViewRecorder on top of WriteBehindCounters:
+// views accumulate in memory and the caller schedules Flush to add them to the repository.
+type PublicationViewCounter struct {
+	*WriteBehindCounters
+}
+
+// NewPublicationViewCounter replays the views journaled at journalPath that were not flushed
+// before the last shutdown; an empty journalPath keeps them in memory only.
+func NewPublicationViewCounter(repo ports.IdeaPublicationRepository, journalPath string) (*PublicationViewCounter, error) {
+	counters, err := NewWriteBehindCounters(WriteBehindConfig{
+		Name:        "publication_views",
+		JournalPath: journalPath,
+		Flush: func(ctx context.Context, deltas []CounterDelta) error {
+			views := make([]ports.PublicationViews, 0, len(deltas))
+			for _, delta := range deltas {
+				id, err := uuid.Parse(delta.Key)
+				if err != nil {
+					continue
+				}
+				views = append(views, ports.PublicationViews{PublicationID: id, Count: delta.Delta, LastViewedAt: delta.LastAt})
+			}
+			return repo.RecordViews(ctx, views)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PublicationViewCounter{WriteBehindCounters: counters}, nil
+}
+
+// RecordView implements ports.PublicationViewRecorder.
+func (c *PublicationViewCounter) RecordView(ctx context.Context, id uuid.UUID, viewedAt time.Time) error {
+	return c.Increment(id.String(), 1, viewedAt)
+}
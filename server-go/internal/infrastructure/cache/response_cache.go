@@ -0,0 +1,199 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type ResponseCacheConfig struct {
+	// Methods maps the full method names of idempotent reads to the TTL of their responses.
+	Methods    map[string]time.Duration `json:"methods"`
+	MaxEntries int                      `json:"max_entries"`
+	// MaxMemory bounds the cached responses by their wire size in bytes; 0 means no bound.
+	MaxMemory int `json:"max_memory"`
+	// AllowNegative also caches the NotFound errors of the configured methods, so repeated
+	// lookups of missing entities do not reach the database. They expire after NegativeTTL
+	// and, like responses, are dropped as soon as the user's data changes.
+	AllowNegative bool           `json:"allow_negative"`
+	NegativeTTL   time.Duration  `json:"negative_ttl"`
+	Clock         entities.Clock `json:"-"`
+}
+
+// ResponseCache caches the responses of idempotent read RPCs per user, keyed by method, user
+// and a hash of the request. Every key also carries the user's generation, so InvalidateUser
+// drops all of a user's entries at once by bumping it; stale entries simply expire.
+type ResponseCache struct {
+	config      ResponseCacheConfig
+	store       *DistributedCache
+	mu          sync.Mutex
+	generations map[uuid.UUID]uint64
+
+	invalidations int64
+	negativeHits  int64
+}
+
+// notFound is the sentinel stored for a cached NotFound error.
+type notFound struct {
+	status *status.Status
+}
+
+// userRequest is implemented by the generated requests that carry a user_id field.
+type userRequest interface {
+	GetUserId() string
+}
+
+func NewResponseCache(config ResponseCacheConfig) *ResponseCache {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = 10000
+	}
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+	if config.NegativeTTL <= 0 {
+		config.NegativeTTL = time.Second
+	}
+
+	return &ResponseCache{
+		config: config,
+		store: NewDistributedCache(CacheConfig{
+			MaxSize:         config.MaxEntries,
+			MaxMemory:       config.MaxMemory,
+			Sizer:           protoSize,
+			EvictionPolicy:  LRU,
+			Clock:           config.Clock,
+			ExternalCleanup: true,
+		}),
+		generations: make(map[uuid.UUID]uint64),
+	}
+}
+
+// UnaryInterceptor serves cached responses for the configured methods. Only successful responses
+// are stored, and they are cloned both ways so later interceptors can modify what they return;
+// with AllowNegative, NotFound errors are stored too.
+func (c *ResponseCache) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ttl, ok := c.config.Methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		key, ok := c.key(info.FullMethod, req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if cached, err := c.store.Get(ctx, key); err == nil {
+			if miss, ok := cached.(notFound); ok {
+				c.mu.Lock()
+				c.negativeHits++
+				c.mu.Unlock()
+				return nil, miss.status.Err()
+			}
+			return proto.Clone(cached.(proto.Message)), nil
+		}
+
+		// The key was computed with the generation read before the handler ran, so a response
+		// that raced with an invalidation is stored under a key no one will look up again
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound && c.config.AllowNegative {
+				c.store.Set(ctx, key, notFound{status: st}, c.config.NegativeTTL)
+			}
+			return resp, err
+		}
+		if msg, ok := resp.(proto.Message); ok {
+			c.store.Set(ctx, key, proto.Clone(msg), ttl)
+		}
+		return resp, nil
+	}
+}
+
+// InvalidateUser implements ports.ReadCache.
+func (c *ResponseCache) InvalidateUser(ctx context.Context, userID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generations[userID]++
+	c.invalidations++
+}
+
+// CleanupExpired drops expired responses; the caller schedules it.
+func (c *ResponseCache) CleanupExpired() int {
+	return c.store.CleanupExpired()
+}
+
+// Metrics is a metrics.MetricsCollector collector for cache hits, misses and invalidations.
+func (c *ResponseCache) Metrics() []metrics.Metric {
+	stats := c.store.Stats()
+	c.mu.Lock()
+	invalidations := c.invalidations
+	negativeHits := c.negativeHits
+	c.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "grpc_response_cache_entries", Type: metrics.Gauge, Value: float64(stats.TotalKeys), Timestamp: now},
+		{Name: "grpc_response_cache_requests_total", Type: metrics.Counter, Value: float64(stats.HitCount), Labels: map[string]string{"result": "hit"}, Timestamp: now},
+		{Name: "grpc_response_cache_requests_total", Type: metrics.Counter, Value: float64(stats.MissCount), Labels: map[string]string{"result": "miss"}, Timestamp: now},
+		{Name: "grpc_response_cache_negative_hits_total", Type: metrics.Counter, Value: float64(negativeHits), Timestamp: now},
+		{Name: "grpc_response_cache_invalidations_total", Type: metrics.Counter, Value: float64(invalidations), Timestamp: now},
+		{Name: "grpc_response_cache_memory_bytes", Type: metrics.Gauge, Value: float64(stats.TotalSize), Timestamp: now},
+	}
+}
+
+// protoSize sizes cached responses by their wire size, a tighter estimate of their memory than
+// the JSON length, which encoding/json does not compute faithfully for generated messages.
+func protoSize(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case proto.Message:
+		return proto.Size(v), nil
+	case notFound:
+		return proto.Size(v.status.Proto()), nil
+	}
+	return serializedSize(value)
+}
+
+// key returns false for requests that cannot be cached: without a valid user_id there is
+// nothing to invalidate them by.
+func (c *ResponseCache) key(method string, req interface{}) (string, bool) {
+	userReq, ok := req.(userRequest)
+	if !ok {
+		return "", false
+	}
+	userID, err := uuid.Parse(userReq.GetUserId())
+	if err != nil {
+		return "", false
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", false
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", false
+	}
+	hash := sha256.Sum256(data)
+
+	c.mu.Lock()
+	generation := c.generations[userID]
+	c.mu.Unlock()
+
+	return fmt.Sprintf("%s|%s|%d|%s", method, userID, generation, hex.EncodeToString(hash[:])), true
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"encoding/json"
+	"reflect"
+	"unsafe"
+)
+
+// SizeMode selects how the cache estimates the memory a value takes.
+type SizeMode string
+
+const (
+	// SizeSerialized counts the length of []byte and string values and the JSON length of
+	// anything else. It is the default, and accurate when the cache stores encoded bytes.
+	SizeSerialized SizeMode = "serialized"
+	// SizeShallow estimates the in-memory size of the value: its type, the data its strings,
+	// slices and maps point to, and the struct behind a top-level pointer. Deeper pointers are
+	// not followed, so values that are mostly references are undercounted.
+	SizeShallow SizeMode = "shallow"
+)
+
+// Sizer returns the memory a value takes, in bytes. It takes precedence over SizeMode.
+type Sizer func(value interface{}) (int, error)
+
+// entryOverhead is what every entry costs besides its key and value: the CacheEntry itself
+// and its slot in the entries map (key string header and pointer).
+const entryOverhead = int(unsafe.Sizeof(CacheEntry{})) + int(unsafe.Sizeof("")) + int(unsafe.Sizeof(uintptr(0)))
+
+// entrySize is the memory accounted to an entry: the overhead, the key and the value.
+func (dc *DistributedCache) entrySize(key string, value interface{}) (int, error) {
+	var size int
+	var err error
+
+	switch {
+	case dc.config.Sizer != nil:
+		size, err = dc.config.Sizer(value)
+	case dc.config.SizeMode == SizeShallow:
+		size = shallowSize(value)
+	default:
+		size, err = serializedSize(value)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return entryOverhead + len(key) + size, nil
+}
+
+func serializedSize(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case []byte:
+		return len(v), nil
+	case string:
+		return len(v), nil
+	}
+
+	serialized, err := json.Marshal(value)
+	if err != nil {
+		return 0, err
+	}
+	return len(serialized), nil
+}
+
+func shallowSize(value interface{}) int {
+	if value == nil {
+		return 0
+	}
+	v := reflect.ValueOf(value)
+	size := int(v.Type().Size())
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+		size += int(v.Type().Size())
+	}
+	return size + referencedSize(v, true)
+}
+
+// referencedSize counts the data v points to outside of itself. When deep, the elements of
+// slices and maps are inspected one level down as well.
+func referencedSize(v reflect.Value, deep bool) int {
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len()
+	case reflect.Slice:
+		if v.IsNil() {
+			return 0
+		}
+		size := v.Cap() * int(v.Type().Elem().Size())
+		if deep {
+			for i := 0; i < v.Len(); i++ {
+				size += referencedSize(v.Index(i), false)
+			}
+		}
+		return size
+	case reflect.Map:
+		if v.IsNil() {
+			return 0
+		}
+		size := v.Len() * int(v.Type().Key().Size()+v.Type().Elem().Size())
+		if deep {
+			iter := v.MapRange()
+			for iter.Next() {
+				size += referencedSize(iter.Key(), false) + referencedSize(iter.Value(), false)
+			}
+		}
+		return size
+	case reflect.Struct:
+		size := 0
+		for i := 0; i < v.NumField(); i++ {
+			size += referencedSize(v.Field(i), deep)
+		}
+		return size
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		elem := v.Elem()
+		// The dynamic value is boxed unless it is a pointer
+		size := 0
+		if elem.Kind() != reflect.Pointer {
+			size = int(elem.Type().Size())
+		}
+		return size + referencedSize(elem, false)
+	}
+	return 0
+}
@@ -0,0 +1,338 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+var ErrCountersClosed = errors.New("write-behind counters are closed")
+
+// CounterDelta is the increment accumulated for a key since the last flush.
+type CounterDelta struct {
+	Key    string
+	Delta  int64
+	LastAt time.Time
+}
+
+// CounterFlushFunc persists a batch of deltas. It must apply all of them or none: a failed
+// batch is kept and retried with the next flush.
+type CounterFlushFunc func(ctx context.Context, deltas []CounterDelta) error
+
+type WriteBehindConfig struct {
+	// Name labels the metrics of these counters.
+	Name  string           `json:"name"`
+	Flush CounterFlushFunc `json:"-"`
+	// JournalPath is the append-only file increments are written to before Increment returns,
+	// so a crash loses none of them; empty keeps increments in memory only.
+	JournalPath string `json:"journal_path"`
+}
+
+// WriteBehindCounters accumulates high-frequency counter increments in memory and persists
+// them in batches when Flush is called, usually from a background job.
+//
+// The journal records every increment, a begin marker when a batch is taken and a commit
+// marker once it is persisted. On start, only the increments after the last committed batch
+// are replayed, so a crash neither loses nor double-counts increments.
+type WriteBehindCounters struct {
+	config WriteBehindConfig
+
+	mu      sync.Mutex
+	pending map[string]*CounterDelta
+	journal *os.File
+	batch   int64
+	closed  bool
+
+	// flushMu serializes flushes, so batches commit in order
+	flushMu sync.Mutex
+
+	flushed       int64
+	failedFlushes int64
+}
+
+// NewWriteBehindCounters replays the journal, if any, into the pending increments.
+func NewWriteBehindCounters(config WriteBehindConfig) (*WriteBehindCounters, error) {
+	if config.Flush == nil {
+		return nil, errors.New("write-behind counters require a flush function")
+	}
+
+	w := &WriteBehindCounters{
+		config:  config,
+		pending: make(map[string]*CounterDelta),
+	}
+	if config.JournalPath == "" {
+		return w, nil
+	}
+
+	if err := w.replay(); err != nil {
+		return nil, err
+	}
+	// Compacting leaves the journal with just the replayed increments
+	if err := w.compactLocked(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Increment adds delta to key. With a journal, the increment is on disk when it returns.
+func (w *WriteBehindCounters) Increment(key string, delta int64, at time.Time) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return ErrCountersClosed
+	}
+	if w.journal != nil {
+		if _, err := w.journal.WriteString(incrementRecord(key, delta, at)); err != nil {
+			return fmt.Errorf("failed to journal counter increment: %w", err)
+		}
+	}
+	w.addLocked(key, delta, at)
+	return nil
+}
+
+// Flush persists the pending increments and returns how many keys were flushed. On failure
+// they stay pending for the next flush.
+func (w *WriteBehindCounters) Flush(ctx context.Context) (int, error) {
+	w.flushMu.Lock()
+	defer w.flushMu.Unlock()
+
+	w.mu.Lock()
+	if len(w.pending) == 0 {
+		w.mu.Unlock()
+		return 0, nil
+	}
+	w.batch++
+	batch := w.batch
+	if err := w.writeMarkerLocked('B', batch); err != nil {
+		w.mu.Unlock()
+		return 0, err
+	}
+	deltas := make([]CounterDelta, 0, len(w.pending))
+	for _, delta := range w.pending {
+		deltas = append(deltas, *delta)
+	}
+	w.pending = make(map[string]*CounterDelta)
+	w.mu.Unlock()
+
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].Key < deltas[j].Key })
+
+	if err := w.config.Flush(ctx, deltas); err != nil {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		// The increments before the begin marker belong to the next batch too
+		for _, delta := range deltas {
+			w.addLocked(delta.Key, delta.Delta, delta.LastAt)
+		}
+		w.failedFlushes++
+		return 0, fmt.Errorf("failed to flush counters: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.flushed += int64(len(deltas))
+	if err := w.writeMarkerLocked('C', batch); err != nil {
+		return len(deltas), err
+	}
+	if w.journal != nil {
+		if err := w.compactLocked(); err != nil {
+			return len(deltas), err
+		}
+	}
+	return len(deltas), nil
+}
+
+// Close flushes the pending increments and closes the journal. Increments that could not be
+// flushed stay in the journal for the next start.
+func (w *WriteBehindCounters) Close(ctx context.Context) error {
+	_, flushErr := w.Flush(ctx)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	if w.journal == nil {
+		return flushErr
+	}
+	if err := w.journal.Close(); err != nil && flushErr == nil {
+		return fmt.Errorf("failed to close counter journal: %w", err)
+	}
+	w.journal = nil
+	return flushErr
+}
+
+// Pending returns the number of keys waiting to be flushed.
+func (w *WriteBehindCounters) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pending)
+}
+
+// Metrics is a metrics.MetricsCollector collector for pending keys and flushes.
+func (w *WriteBehindCounters) Metrics() []metrics.Metric {
+	w.mu.Lock()
+	pending, flushed, failed := len(w.pending), w.flushed, w.failedFlushes
+	w.mu.Unlock()
+
+	labels := map[string]string{"counter": w.config.Name}
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "write_behind_pending_keys", Type: metrics.Gauge, Value: float64(pending), Labels: labels, Timestamp: now},
+		{Name: "write_behind_flushed_keys_total", Type: metrics.Counter, Value: float64(flushed), Labels: labels, Timestamp: now},
+		{Name: "write_behind_failed_flushes_total", Type: metrics.Counter, Value: float64(failed), Labels: labels, Timestamp: now},
+	}
+}
+
+func (w *WriteBehindCounters) addLocked(key string, delta int64, at time.Time) {
+	pending, ok := w.pending[key]
+	if !ok {
+		pending = &CounterDelta{Key: key}
+		w.pending[key] = pending
+	}
+	pending.Delta += delta
+	if at.After(pending.LastAt) {
+		pending.LastAt = at
+	}
+}
+
+func (w *WriteBehindCounters) writeMarkerLocked(kind byte, batch int64) error {
+	if w.journal == nil {
+		return nil
+	}
+	if _, err := w.journal.WriteString(string(kind) + " " + strconv.FormatInt(batch, 10) + "\n"); err != nil {
+		return fmt.Errorf("failed to journal counter batch: %w", err)
+	}
+	return nil
+}
+
+// compactLocked replaces the journal with one increment per pending key. The new journal is
+// synced before it replaces the old one.
+func (w *WriteBehindCounters) compactLocked() error {
+	tmpPath := w.config.JournalPath + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to compact counter journal: %w", err)
+	}
+
+	writer := bufio.NewWriter(tmp)
+	for _, delta := range w.pending {
+		writer.WriteString(incrementRecord(delta.Key, delta.Delta, delta.LastAt))
+	}
+	err = writer.Flush()
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, w.config.JournalPath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to compact counter journal: %w", err)
+	}
+
+	if w.journal != nil {
+		w.journal.Close()
+	}
+	w.journal, err = os.OpenFile(w.config.JournalPath, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open counter journal: %w", err)
+	}
+	w.batch = 0
+	return nil
+}
+
+// replay loads the increments journaled after the begin marker of the last committed batch.
+func (w *WriteBehindCounters) replay() error {
+	file, err := os.Open(w.config.JournalPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open counter journal: %w", err)
+	}
+	defer file.Close()
+
+	type increment struct {
+		key   string
+		delta int64
+		at    time.Time
+	}
+	var increments []increment
+	begins := make(map[int64]int)
+	replayFrom := 0
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) < 2 {
+			continue
+		}
+		switch line[0] {
+		case '+':
+			key, delta, at, ok := parseIncrementRecord(line)
+			// A crash can leave the last line half written
+			if ok {
+				increments = append(increments, increment{key: key, delta: delta, at: at})
+			}
+		case 'B', 'C':
+			batch, err := strconv.ParseInt(strings.TrimSpace(line[2:]), 10, 64)
+			if err != nil {
+				continue
+			}
+			if line[0] == 'B' {
+				begins[batch] = len(increments)
+			} else if start, ok := begins[batch]; ok && start > replayFrom {
+				replayFrom = start
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read counter journal: %w", err)
+	}
+
+	for _, inc := range increments[replayFrom:] {
+		w.addLocked(inc.key, inc.delta, inc.at)
+	}
+	return nil
+}
+
+func incrementRecord(key string, delta int64, at time.Time) string {
+	return "+ " + strconv.Quote(key) + " " + strconv.FormatInt(delta, 10) + " " + strconv.FormatInt(at.UnixNano(), 10) + "\n"
+}
+
+func parseIncrementRecord(line string) (string, int64, time.Time, bool) {
+	// The quoted key may contain spaces, so the delta and timestamp are split off the end
+	rest := line[2:]
+	i := strings.LastIndexByte(rest, ' ')
+	if i < 0 {
+		return "", 0, time.Time{}, false
+	}
+	at, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, time.Time{}, false
+	}
+	rest = rest[:i]
+	if i = strings.LastIndexByte(rest, ' '); i < 0 {
+		return "", 0, time.Time{}, false
+	}
+	delta, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, time.Time{}, false
+	}
+	key, err := strconv.Unquote(rest[:i])
+	if err != nil {
+		return "", 0, time.Time{}, false
+	}
+	return key, delta, time.Unix(0, at), true
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingFlush struct {
+	totals  map[string]int64
+	failing bool
+}
+
+func (r *recordingFlush) flush(ctx context.Context, deltas []CounterDelta) error {
+	if r.failing {
+		return errors.New("database unavailable")
+	}
+	for _, delta := range deltas {
+		r.totals[delta.Key] += delta.Delta
+	}
+	return nil
+}
+
+func TestWriteBehindCounters_RetriesFailedFlushes(t *testing.T) {
+	store := &recordingFlush{totals: map[string]int64{}, failing: true}
+	counters, err := NewWriteBehindCounters(WriteBehindConfig{Flush: store.flush})
+	require.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, counters.Increment("a", 2, now))
+	_, err = counters.Flush(ctx)
+	assert.Error(t, err)
+
+	require.NoError(t, counters.Increment("a", 1, now))
+	require.NoError(t, counters.Increment("b", 1, now))
+	store.failing = false
+	flushed, err := counters.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, flushed)
+	assert.Equal(t, map[string]int64{"a": 3, "b": 1}, store.totals)
+	assert.Zero(t, counters.Pending())
+}
+
+func TestWriteBehindCounters_ReplaysJournalAfterCrash(t *testing.T) {
+	journal := filepath.Join(t.TempDir(), "views.journal")
+	store := &recordingFlush{totals: map[string]int64{}}
+	ctx := context.Background()
+	now := time.Now()
+
+	counters, err := NewWriteBehindCounters(WriteBehindConfig{Flush: store.flush, JournalPath: journal})
+	require.NoError(t, err)
+	require.NoError(t, counters.Increment("a", 1, now))
+	_, err = counters.Flush(ctx)
+	require.NoError(t, err)
+	require.NoError(t, counters.Increment("a", 1, now))
+	require.NoError(t, counters.Increment("key with  spaces", 5, now))
+
+	// A crash while flushing: the batch was taken but never committed
+	var crashed []CounterDelta
+	counters.config.Flush = func(ctx context.Context, deltas []CounterDelta) error {
+		crashed = deltas
+		require.NoError(t, counters.Increment("a", 1, now))
+		return errors.New("process killed")
+	}
+	_, err = counters.Flush(ctx)
+	require.Error(t, err)
+	require.Len(t, crashed, 2)
+
+	restarted, err := NewWriteBehindCounters(WriteBehindConfig{Flush: store.flush, JournalPath: journal})
+	require.NoError(t, err)
+	_, err = restarted.Flush(ctx)
+	require.NoError(t, err)
+	require.NoError(t, restarted.Close(ctx))
+
+	assert.Equal(t, map[string]int64{"a": 3, "key with  spaces": 5}, store.totals)
+
+	// Everything was flushed, so the journal is empty
+	data, err := os.ReadFile(journal)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+}
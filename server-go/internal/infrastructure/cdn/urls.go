@@ -0,0 +1,72 @@
+package cdn
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockery --name URLSigner --with-expecter --case underscore
+
+// URLSigner signs a URL for a CDN edge so it is only served until expiresAt.
+type URLSigner interface {
+	SignURL(rawURL string, expiresAt time.Time) (string, error)
+}
+
+// FileURLConfig configures FileURLs.
+type FileURLConfig struct {
+	// BaseURL is the public address of the file endpoint (the CDN domain when one is used);
+	// the file ID is appended to it.
+	BaseURL string
+	Origin  *OriginSigner
+	// Edge is optional. The CDN must forward the query string to the origin, which checks
+	// the origin signature, but leave it out of the cache key.
+	Edge URLSigner
+	TTL  time.Duration
+}
+
+// FileURLs builds time-limited download URLs for the HTTP file endpoint.
+type FileURLs struct {
+	baseURL string
+	origin  *OriginSigner
+	edge    URLSigner
+	ttl     time.Duration
+}
+
+// DefaultFileURLTTL is used when FileURLConfig.TTL is not set.
+const DefaultFileURLTTL = time.Hour
+
+func NewFileURLs(cfg FileURLConfig) *FileURLs {
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultFileURLTTL
+	}
+	return &FileURLs{
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		origin:  cfg.Origin,
+		edge:    cfg.Edge,
+		ttl:     cfg.TTL,
+	}
+}
+
+// FileURL returns a URL that lets its holder download fileID on behalf of userID until the returned time.
+func (u *FileURLs) FileURL(fileID, userID uuid.UUID, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(u.ttl).Truncate(time.Second)
+	rawURL := u.baseURL + "/" + fileID.String() + "?" + u.origin.Sign(fileID, userID, expiresAt).Encode()
+	if u.edge == nil {
+		return rawURL, expiresAt, nil
+	}
+
+	signed, err := u.edge.SignURL(rawURL, expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+func appendQuery(rawURL, query string) string {
+	if strings.Contains(rawURL, "?") {
+		return rawURL + "&" + query
+	}
+	return rawURL + "?" + query
+}
@@ -0,0 +1,277 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+var (
+	ErrCircuitOpen     = errors.New("circuit breaker is open")
+	ErrTooManyRequests = errors.New("circuit breaker half-open probe limit reached")
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half_open"
+)
+
+type BreakerConfig struct {
+	Name             string           `json:"name"`
+	WindowSize       time.Duration    `json:"window_size"`
+	BucketCount      int              `json:"bucket_count"`
+	MinRequests      int64            `json:"min_requests"`
+	FailureRatio     float64          `json:"failure_ratio"`
+	OpenTimeout      time.Duration    `json:"open_timeout"`
+	HalfOpenMaxCalls int64            `json:"half_open_max_calls"`
+	IsFailure        func(error) bool `json:"-"`
+	Clock            entities.Clock   `json:"-"`
+}
+
+type BreakerStats struct {
+	Name            string    `json:"name"`
+	State           State     `json:"state"`
+	Requests        int64     `json:"requests"`
+	Failures        int64     `json:"failures"`
+	FailureRate     float64   `json:"failure_rate"`
+	Rejected        int64     `json:"rejected"`
+	LastStateChange time.Time `json:"last_state_change"`
+}
+
+type bucket struct {
+	start    time.Time
+	requests int64
+	failures int64
+}
+
+type CircuitBreaker struct {
+	config BreakerConfig
+	mu     sync.Mutex
+
+	state             State
+	buckets           []bucket
+	bucketWidth       time.Duration
+	openedAt          time.Time
+	lastStateChange   time.Time
+	halfOpenInFlight  int64
+	halfOpenSuccesses int64
+	rejected          int64
+	// generation changes on every state transition; results of calls admitted
+	// in an earlier generation are ignored
+	generation uint64
+
+	onStateChange func(name string, from, to State)
+}
+
+func NewCircuitBreaker(config BreakerConfig) *CircuitBreaker {
+	if config.WindowSize <= 0 {
+		config.WindowSize = 30 * time.Second
+	}
+	if config.BucketCount <= 0 {
+		config.BucketCount = 10
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = 10
+	}
+	if config.FailureRatio <= 0 || config.FailureRatio > 1 {
+		config.FailureRatio = 0.5
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = 15 * time.Second
+	}
+	if config.HalfOpenMaxCalls <= 0 {
+		config.HalfOpenMaxCalls = 1
+	}
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = func(err error) bool {
+			return err != nil && !errors.Is(err, context.Canceled)
+		}
+	}
+
+	return &CircuitBreaker{
+		config:          config,
+		state:           StateClosed,
+		buckets:         make([]bucket, config.BucketCount),
+		bucketWidth:     config.WindowSize / time.Duration(config.BucketCount),
+		lastStateChange: config.Clock.Now(),
+	}
+}
+
+func (cb *CircuitBreaker) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	done, err := cb.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn(ctx)
+	done(err)
+	return err
+}
+
+// Allow reserves a call slot; the returned done func must be called with the call result.
+// Useful for streaming calls where the work does not fit in a single closure.
+func (cb *CircuitBreaker) Allow() (func(err error), error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.config.Clock.Now()
+
+	switch cb.state {
+	case StateOpen:
+		if now.Sub(cb.openedAt) < cb.config.OpenTimeout {
+			cb.rejected++
+			return nil, ErrCircuitOpen
+		}
+		cb.transition(StateHalfOpen, now)
+		fallthrough
+	case StateHalfOpen:
+		if cb.halfOpenInFlight >= cb.config.HalfOpenMaxCalls {
+			cb.rejected++
+			return nil, ErrTooManyRequests
+		}
+		cb.halfOpenInFlight++
+	}
+
+	generation := cb.generation
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { cb.record(generation, err) })
+	}, nil
+}
+
+func (cb *CircuitBreaker) record(generation uint64, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	// A call admitted before the last transition says nothing about the current
+	// state: a slow call from a closed breaker must not count as a half-open probe
+	if generation != cb.generation {
+		return
+	}
+
+	now := cb.config.Clock.Now()
+	failed := cb.config.IsFailure(err)
+
+	if cb.state == StateHalfOpen {
+		cb.halfOpenInFlight--
+		if failed {
+			cb.trip(now)
+			return
+		}
+		cb.halfOpenSuccesses++
+		if cb.halfOpenSuccesses >= cb.config.HalfOpenMaxCalls {
+			cb.resetWindow()
+			cb.transition(StateClosed, now)
+		}
+		return
+	}
+
+	if cb.state != StateClosed {
+		return
+	}
+
+	b := cb.currentBucket(now)
+	b.requests++
+	if failed {
+		b.failures++
+	}
+
+	requests, failures := cb.windowTotals(now)
+	if requests >= cb.config.MinRequests && float64(failures)/float64(requests) >= cb.config.FailureRatio {
+		cb.trip(now)
+	}
+}
+
+func (cb *CircuitBreaker) trip(now time.Time) {
+	cb.openedAt = now
+	cb.halfOpenInFlight = 0
+	cb.halfOpenSuccesses = 0
+	cb.transition(StateOpen, now)
+}
+
+func (cb *CircuitBreaker) transition(to State, now time.Time) {
+	if cb.state == to {
+		return
+	}
+	from := cb.state
+	cb.state = to
+	cb.generation++
+	cb.lastStateChange = now
+	if to != StateHalfOpen {
+		cb.halfOpenInFlight = 0
+		cb.halfOpenSuccesses = 0
+	}
+
+	if cb.onStateChange != nil {
+		supervisor.Go("circuit_breaker.state_change", func() { cb.onStateChange(cb.config.Name, from, to) })
+	}
+}
+
+func (cb *CircuitBreaker) currentBucket(now time.Time) *bucket {
+	start := now.Truncate(cb.bucketWidth)
+	idx := int(start.UnixNano()/int64(cb.bucketWidth)) % len(cb.buckets)
+	b := &cb.buckets[idx]
+	if !b.start.Equal(start) {
+		*b = bucket{start: start}
+	}
+	return b
+}
+
+func (cb *CircuitBreaker) windowTotals(now time.Time) (int64, int64) {
+	cutoff := now.Add(-cb.config.WindowSize)
+	var requests, failures int64
+	for _, b := range cb.buckets {
+		if b.start.After(cutoff) {
+			requests += b.requests
+			failures += b.failures
+		}
+	}
+	return requests, failures
+}
+
+func (cb *CircuitBreaker) resetWindow() {
+	for i := range cb.buckets {
+		cb.buckets[i] = bucket{}
+	}
+}
+
+func (cb *CircuitBreaker) State() State {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+func (cb *CircuitBreaker) Stats() BreakerStats {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	requests, failures := cb.windowTotals(cb.config.Clock.Now())
+	stats := BreakerStats{
+		Name:            cb.config.Name,
+		State:           cb.state,
+		Requests:        requests,
+		Failures:        failures,
+		Rejected:        cb.rejected,
+		LastStateChange: cb.lastStateChange,
+	}
+	if requests > 0 {
+		stats.FailureRate = float64(failures) / float64(requests)
+	}
+	return stats
+}
+
+func (cb *CircuitBreaker) OnStateChange(handler func(name string, from, to State)) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.onStateChange = handler
+}
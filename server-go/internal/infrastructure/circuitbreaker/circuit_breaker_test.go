@@ -0,0 +1,165 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBackend = errors.New("backend unavailable")
+
+func newTestBreaker(clock *entities.FakeClock) *CircuitBreaker {
+	return NewCircuitBreaker(BreakerConfig{
+		Name:             "test",
+		WindowSize:       10 * time.Second,
+		BucketCount:      10,
+		MinRequests:      4,
+		FailureRatio:     0.5,
+		OpenTimeout:      5 * time.Second,
+		HalfOpenMaxCalls: 2,
+		Clock:            clock,
+	})
+}
+
+// call runs one call through the breaker with the given result.
+func call(t *testing.T, cb *CircuitBreaker, result error) {
+	t.Helper()
+	done, err := cb.Allow()
+	require.NoError(t, err)
+	done(result)
+}
+
+// trip opens the breaker with MinRequests failures.
+func trip(t *testing.T, cb *CircuitBreaker) {
+	t.Helper()
+	for i := 0; i < 4; i++ {
+		call(t, cb, errBackend)
+	}
+	require.Equal(t, StateOpen, cb.State())
+}
+
+func TestCircuitBreaker_TripsOnFailureRatio(t *testing.T) {
+	cb := newTestBreaker(entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)))
+
+	call(t, cb, nil)
+	call(t, cb, errBackend)
+	call(t, cb, errBackend)
+	// Three calls are below MinRequests, whatever their failure ratio
+	assert.Equal(t, StateClosed, cb.State())
+
+	call(t, cb, nil)
+	assert.Equal(t, StateOpen, cb.State())
+
+	_, err := cb.Allow()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int64(1), cb.Stats().Rejected)
+}
+
+func TestCircuitBreaker_IgnoresRepeatedDone(t *testing.T) {
+	cb := newTestBreaker(entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)))
+
+	done, err := cb.Allow()
+	require.NoError(t, err)
+	done(errBackend)
+	done(errBackend)
+
+	assert.Equal(t, int64(1), cb.Stats().Requests)
+}
+
+func TestCircuitBreaker_HalfOpenProbing(t *testing.T) {
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	cb := newTestBreaker(clock)
+	trip(t, cb)
+
+	clock.Advance(5 * time.Second)
+
+	// Only HalfOpenMaxCalls probes are admitted at a time
+	first, err := cb.Allow()
+	require.NoError(t, err)
+	assert.Equal(t, StateHalfOpen, cb.State())
+	second, err := cb.Allow()
+	require.NoError(t, err)
+	_, err = cb.Allow()
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+
+	// The breaker closes once every probe succeeded
+	first(nil)
+	assert.Equal(t, StateHalfOpen, cb.State())
+	second(nil)
+	assert.Equal(t, StateClosed, cb.State())
+	assert.Zero(t, cb.Stats().Requests)
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	cb := newTestBreaker(clock)
+	trip(t, cb)
+
+	clock.Advance(5 * time.Second)
+	call(t, cb, errBackend)
+	assert.Equal(t, StateOpen, cb.State())
+
+	// The open timeout starts again from the failed probe
+	clock.Advance(4 * time.Second)
+	_, err := cb.Allow()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestCircuitBreaker_IgnoresResultsFromEarlierStates(t *testing.T) {
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	cb := newTestBreaker(clock)
+
+	// Two calls admitted while closed are still running when the breaker trips
+	slowSuccess, err := cb.Allow()
+	require.NoError(t, err)
+	slowFailure, err := cb.Allow()
+	require.NoError(t, err)
+	trip(t, cb)
+
+	clock.Advance(5 * time.Second)
+	probe, err := cb.Allow()
+	require.NoError(t, err)
+
+	// Their results neither close nor reopen the breaker, nor free a probe slot
+	slowSuccess(nil)
+	slowFailure(errBackend)
+	assert.Equal(t, StateHalfOpen, cb.State())
+
+	_, err = cb.Allow()
+	require.NoError(t, err)
+	_, err = cb.Allow()
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+
+	probe(nil)
+	assert.Equal(t, StateHalfOpen, cb.State())
+}
+
+func TestCircuitBreaker_WindowRollover(t *testing.T) {
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	cb := newTestBreaker(clock)
+
+	for i := 0; i < 3; i++ {
+		call(t, cb, errBackend)
+	}
+	assert.Equal(t, int64(3), cb.Stats().Requests)
+
+	// Once the failures leave the window they no longer count towards MinRequests
+	clock.Advance(11 * time.Second)
+	call(t, cb, errBackend)
+
+	assert.Equal(t, StateClosed, cb.State())
+	stats := cb.Stats()
+	assert.Equal(t, int64(1), stats.Requests)
+	assert.Equal(t, int64(1), stats.Failures)
+
+	// Calls in older buckets that are still inside the window do count
+	clock.Advance(3 * time.Second)
+	for i := 0; i < 3; i++ {
+		call(t, cb, errBackend)
+	}
+	assert.Equal(t, StateOpen, cb.State())
+}
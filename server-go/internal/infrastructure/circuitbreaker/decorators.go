@@ -0,0 +1,122 @@
+package circuitbreaker
+
+import (
+	"context"
+	"io"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+type fileStorageService struct {
+	ports.FileStorageService
+	breaker *CircuitBreaker
+}
+
+func NewFileStorageService(next ports.FileStorageService, breaker *CircuitBreaker) ports.FileStorageService {
+	return &fileStorageService{FileStorageService: next, breaker: breaker}
+}
+
+func (s *fileStorageService) StoreFile(ctx context.Context, filename string, reader io.Reader, compress bool, compressionType string) (string, string, int64, error) {
+	var path, checksum string
+	var size int64
+	err := s.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		path, checksum, size, err = s.FileStorageService.StoreFile(ctx, filename, reader, compress, compressionType)
+		return err
+	})
+	return path, checksum, size, err
+}
+
+func (s *fileStorageService) RetrieveFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	var reader io.ReadCloser
+	err := s.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		reader, err = s.FileStorageService.RetrieveFile(ctx, path)
+		return err
+	})
+	return reader, err
+}
+
+func (s *fileStorageService) DeleteFile(ctx context.Context, path string) error {
+	return s.breaker.Execute(ctx, func(ctx context.Context) error {
+		return s.FileStorageService.DeleteFile(ctx, path)
+	})
+}
+
+// notificationService guards outbound delivery (FCM, SMTP, webhooks); subscriptions are local and pass through.
+type notificationService struct {
+	ports.NotificationService
+	breaker *CircuitBreaker
+}
+
+func NewNotificationService(next ports.NotificationService, breaker *CircuitBreaker) ports.NotificationService {
+	return &notificationService{NotificationService: next, breaker: breaker}
+}
+
+func (s *notificationService) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	return s.breaker.Execute(ctx, func(ctx context.Context) error {
+		return s.NotificationService.SendNotification(ctx, userID, title, message, notificationType, channels, metadata)
+	})
+}
+
+// embeddingService fails fast while the embeddings API is down, so searches fall back to keywords quickly.
+type embeddingService struct {
+	ports.EmbeddingService
+	breaker *CircuitBreaker
+}
+
+func NewEmbeddingService(next ports.EmbeddingService, breaker *CircuitBreaker) ports.EmbeddingService {
+	return &embeddingService{EmbeddingService: next, breaker: breaker}
+}
+
+func (s *embeddingService) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	var vectors [][]float32
+	err := s.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		vectors, err = s.EmbeddingService.Embed(ctx, texts)
+		return err
+	})
+	return vectors, err
+}
+
+// ideaClassifier fails fast while the classification backend is down, so suggestions fall back without waiting.
+type ideaClassifier struct {
+	ports.IdeaClassifier
+	breaker *CircuitBreaker
+}
+
+func NewIdeaClassifier(next ports.IdeaClassifier, breaker *CircuitBreaker) ports.IdeaClassifier {
+	return &ideaClassifier{IdeaClassifier: next, breaker: breaker}
+}
+
+func (c *ideaClassifier) Classify(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error) {
+	var suggestion *entities.IdeaSuggestion
+	err := c.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		suggestion, err = c.IdeaClassifier.Classify(ctx, idea)
+		return err
+	})
+	return suggestion, err
+}
+
+// contentModerator fails fast while the moderation API is down, so content is checked with the wordlist without waiting.
+type contentModerator struct {
+	ports.ContentModerator
+	breaker *CircuitBreaker
+}
+
+func NewContentModerator(next ports.ContentModerator, breaker *CircuitBreaker) ports.ContentModerator {
+	return &contentModerator{ContentModerator: next, breaker: breaker}
+}
+
+func (m *contentModerator) Moderate(ctx context.Context, text string, policy entities.ModerationPolicy) (*entities.ModerationVerdict, error) {
+	var verdict *entities.ModerationVerdict
+	err := m.breaker.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		verdict, err = m.ContentModerator.Moderate(ctx, text, policy)
+		return err
+	})
+	return verdict, err
+}
@@ -0,0 +1,69 @@
+package circuitbreaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+type Registry struct {
+	breakers map[string]*CircuitBreaker
+	mu       sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		breakers: make(map[string]*CircuitBreaker),
+	}
+}
+
+// Get returns the breaker registered under config.Name, creating it on first use.
+func (r *Registry) Get(config BreakerConfig) *CircuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cb, exists := r.breakers[config.Name]; exists {
+		return cb
+	}
+	cb := NewCircuitBreaker(config)
+	r.breakers[config.Name] = cb
+	return cb
+}
+
+func (r *Registry) Stats() []BreakerStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := make([]BreakerStats, 0, len(r.breakers))
+	for _, cb := range r.breakers {
+		stats = append(stats, cb.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+	return stats
+}
+
+// Metrics is a metrics.MetricsCollector collector exposing state and failure rate per breaker.
+func (r *Registry) Metrics() []metrics.Metric {
+	var result []metrics.Metric
+	now := time.Now()
+
+	for _, stats := range r.Stats() {
+		labels := map[string]string{"breaker": stats.Name}
+		open := 0.0
+		if stats.State != StateClosed {
+			open = 1.0
+		}
+
+		result = append(result,
+			metrics.Metric{Name: "circuit_breaker_open", Type: metrics.Gauge, Value: open, Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "circuit_breaker_failure_rate", Type: metrics.Gauge, Value: stats.FailureRate, Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "circuit_breaker_rejected_total", Type: metrics.Counter, Value: float64(stats.Rejected), Labels: labels, Timestamp: now},
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package classification
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// Fallback uses Primary and, when it fails, Secondary; an ML backend that is
+// down still leaves the keyword suggestions.
+type Fallback struct {
+	Primary   ports.IdeaClassifier
+	Secondary ports.IdeaClassifier
+}
+
+func (f Fallback) Name() string {
+	return f.Primary.Name()
+}
+
+func (f Fallback) Classify(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error) {
+	suggestion, err := f.Primary.Classify(ctx, idea)
+	if err == nil {
+		return suggestion, nil
+	}
+	return f.Secondary.Classify(ctx, idea)
+}
@@ -0,0 +1,110 @@
+package classification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// categoryNames maps the category names of HTTPClassifier responses.
+var categoryNames = map[string]entities.IdeaCategory{
+	"business":  entities.IdeaCategoryBusiness,
+	"personal":  entities.IdeaCategoryPersonal,
+	"technical": entities.IdeaCategoryTechnical,
+	"creative":  entities.IdeaCategoryCreative,
+	"research":  entities.IdeaCategoryResearch,
+}
+
+// HTTPConfig configures an external classification model.
+type HTTPConfig struct {
+	// Endpoint receives {"title", "content", "tags"} as a JSON POST and must answer
+	// {"tags": [{"tag", "confidence"}], "category": "technical", "category_confidence"}.
+	Endpoint string
+	// APIKey, when set, is sent as a bearer token.
+	APIKey  string
+	Timeout time.Duration
+}
+
+// HTTPClassifier delegates classification to an ML backend.
+type HTTPClassifier struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewHTTPClassifier(config HTTPConfig) *HTTPClassifier {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &HTTPClassifier{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (c *HTTPClassifier) Name() string {
+	return "http"
+}
+
+type classifyRequest struct {
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+}
+
+type classifyResponse struct {
+	Tags []struct {
+		Tag        string  `json:"tag"`
+		Confidence float64 `json:"confidence"`
+	} `json:"tags"`
+	Category           string  `json:"category"`
+	CategoryConfidence float64 `json:"category_confidence"`
+}
+
+func (c *HTTPClassifier) Classify(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error) {
+	body, err := json.Marshal(classifyRequest{Title: idea.Title, Content: idea.Content, Tags: idea.Tags})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("classification API returned %s", resp.Status)
+	}
+
+	var decoded classifyResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid classification response: %w", err)
+	}
+
+	suggestion := &entities.IdeaSuggestion{Classifier: c.Name()}
+	for _, tag := range decoded.Tags {
+		suggestion.Tags = append(suggestion.Tags, entities.TagSuggestion{Tag: tag.Tag, Confidence: tag.Confidence})
+	}
+	// Unknown categories are ignored rather than failing the whole suggestion
+	if category, ok := categoryNames[decoded.Category]; ok {
+		suggestion.Category = category
+		suggestion.CategoryConfidence = decoded.CategoryConfidence
+	}
+	return suggestion, nil
+}
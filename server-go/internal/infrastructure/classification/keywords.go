@@ -0,0 +1,179 @@
+package classification
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// DefaultCategoryKeywords are the words, in English and Spanish, that point to each category.
+var DefaultCategoryKeywords = map[entities.IdeaCategory][]string{
+	entities.IdeaCategoryBusiness: {
+		"business", "client", "clients", "customer", "customers", "market", "revenue", "sales", "startup", "pricing", "invoice",
+		"negocio", "cliente", "clientes", "mercado", "ventas", "ingresos", "empresa", "precio", "factura",
+	},
+	entities.IdeaCategoryPersonal: {
+		"family", "friend", "friends", "home", "health", "vacation", "birthday", "gym", "personal",
+		"familia", "amigo", "amigos", "casa", "salud", "vacaciones", "cumpleaños", "gimnasio",
+	},
+	entities.IdeaCategoryTechnical: {
+		"api", "bug", "code", "database", "deploy", "server", "refactor", "backend", "frontend", "android", "golang", "grpc",
+		"código", "servidor", "despliegue", "programar", "aplicación",
+	},
+	entities.IdeaCategoryCreative: {
+		"design", "draw", "story", "music", "song", "paint", "poem", "novel", "photo", "video",
+		"diseño", "dibujo", "historia", "música", "canción", "pintura", "poema", "novela", "foto",
+	},
+	entities.IdeaCategoryResearch: {
+		"research", "study", "paper", "experiment", "hypothesis", "analysis", "survey", "data",
+		"investigación", "investigar", "estudio", "experimento", "hipótesis", "análisis", "encuesta", "datos",
+	},
+}
+
+// DefaultTagKeywords are the tags suggested when one of their words appears in the idea.
+var DefaultTagKeywords = map[string][]string{
+	"urgent":   {"urgent", "asap", "urgente"},
+	"work":     {"work", "office", "meeting", "boss", "trabajo", "oficina", "reunión", "jefe"},
+	"health":   {"health", "doctor", "gym", "diet", "salud", "médico", "gimnasio", "dieta"},
+	"finance":  {"money", "budget", "invest", "tax", "taxes", "dinero", "presupuesto", "invertir", "impuestos"},
+	"learning": {"learn", "course", "book", "tutorial", "aprender", "curso", "libro"},
+	"travel":   {"travel", "trip", "flight", "hotel", "viaje", "vuelo"},
+	"someday":  {"someday", "maybe", "algún", "quizás"},
+}
+
+// KeywordConfig configures KeywordClassifier; nil maps use the defaults.
+type KeywordConfig struct {
+	CategoryKeywords map[entities.IdeaCategory][]string
+	TagKeywords      map[string][]string
+}
+
+// KeywordClassifier suggests tags and a category by counting known words and
+// hashtags in the idea. It needs no external services.
+type KeywordClassifier struct {
+	categories map[string][]entities.IdeaCategory
+	tags       map[string][]string
+}
+
+func NewKeywordClassifier(config KeywordConfig) *KeywordClassifier {
+	if config.CategoryKeywords == nil {
+		config.CategoryKeywords = DefaultCategoryKeywords
+	}
+	if config.TagKeywords == nil {
+		config.TagKeywords = DefaultTagKeywords
+	}
+
+	c := &KeywordClassifier{
+		categories: make(map[string][]entities.IdeaCategory),
+		tags:       make(map[string][]string),
+	}
+	for category, words := range config.CategoryKeywords {
+		for _, word := range words {
+			word = strings.ToLower(word)
+			c.categories[word] = append(c.categories[word], category)
+		}
+	}
+	for tag, words := range config.TagKeywords {
+		for _, word := range words {
+			word = strings.ToLower(word)
+			c.tags[word] = append(c.tags[word], tag)
+		}
+	}
+	return c
+}
+
+func (c *KeywordClassifier) Name() string {
+	return "keywords"
+}
+
+func (c *KeywordClassifier) Classify(ctx context.Context, idea *entities.Idea) (*entities.IdeaSuggestion, error) {
+	text := idea.Title + "\n" + idea.Content
+	suggestion := &entities.IdeaSuggestion{Classifier: c.Name()}
+
+	// Hashtags are explicit, so they are suggested with full confidence
+	tagHits := make(map[string]int)
+	seen := make(map[string]bool)
+	for _, hashtag := range hashtags(text) {
+		if !seen[hashtag] {
+			seen[hashtag] = true
+			suggestion.Tags = append(suggestion.Tags, entities.TagSuggestion{Tag: hashtag, Confidence: 1})
+		}
+	}
+
+	categoryHits := make(map[entities.IdeaCategory]int)
+	total := 0
+	for _, word := range words(text) {
+		for _, category := range c.categories[word] {
+			categoryHits[category]++
+			total++
+		}
+		for _, tag := range c.tags[word] {
+			tagHits[tag]++
+		}
+	}
+
+	for tag, hits := range tagHits {
+		if !seen[tag] {
+			suggestion.Tags = append(suggestion.Tags, entities.TagSuggestion{Tag: tag, Confidence: hitConfidence(hits)})
+		}
+	}
+	sort.SliceStable(suggestion.Tags, func(i, j int) bool {
+		if suggestion.Tags[i].Confidence != suggestion.Tags[j].Confidence {
+			return suggestion.Tags[i].Confidence > suggestion.Tags[j].Confidence
+		}
+		return suggestion.Tags[i].Tag < suggestion.Tags[j].Tag
+	})
+
+	// The category is the one with the most hits, unless another one ties with it
+	best, bestHits, tied := entities.IdeaCategoryUnspecified, 0, false
+	for category, hits := range categoryHits {
+		switch {
+		case hits > bestHits:
+			best, bestHits, tied = category, hits, false
+		case hits == bestHits:
+			tied = true
+		}
+	}
+	if bestHits > 0 && !tied {
+		suggestion.Category = best
+		suggestion.CategoryConfidence = min(hitConfidence(bestHits), float64(bestHits)/float64(total))
+	}
+
+	return suggestion, nil
+}
+
+// hitConfidence grows with the number of matching words: 0.6 for one, 0.8 for two, 0.9 from three on.
+func hitConfidence(hits int) float64 {
+	switch {
+	case hits >= 3:
+		return 0.9
+	case hits == 2:
+		return 0.8
+	default:
+		return 0.6
+	}
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func hashtags(text string) []string {
+	var tags []string
+	for _, field := range strings.Fields(text) {
+		if !strings.HasPrefix(field, "#") {
+			continue
+		}
+		tag := strings.TrimRightFunc(field[1:], func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if tag = entities.NormalizeTag(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,90 @@
+package compression
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+type Config struct {
+	// Enabled compresses responses of every method not listed in Methods.
+	Enabled bool `json:"enabled"`
+	// Methods overrides Enabled per full method name, e.g. to skip payloads that are already compressed.
+	Methods map[string]bool `json:"methods"`
+}
+
+// Interceptor picks the response compressor per method. Responses are only
+// gzipped when the client advertises gzip support in grpc-accept-encoding.
+type Interceptor struct {
+	config  Config
+	enabled atomic.Bool
+}
+
+func NewInterceptor(config Config) *Interceptor {
+	if config.Methods == nil {
+		config.Methods = make(map[string]bool)
+	}
+
+	i := &Interceptor{config: config}
+	i.enabled.Store(config.Enabled)
+	return i
+}
+
+// SetEnabled changes the default for methods not listed in Config.Methods.
+func (i *Interceptor) SetEnabled(enabled bool) {
+	i.enabled.Store(enabled)
+}
+
+func (i *Interceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		i.apply(ctx, info.FullMethod)
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		i.apply(stream.Context(), info.FullMethod)
+		return handler(srv, stream)
+	}
+}
+
+func (i *Interceptor) methodEnabled(method string) bool {
+	if enabled, ok := i.config.Methods[method]; ok {
+		return enabled
+	}
+	return i.enabled.Load()
+}
+
+func (i *Interceptor) apply(ctx context.Context, method string) {
+	if !i.methodEnabled(method) {
+		// By default grpc answers with the request's compressor; force identity
+		// so disabled methods stay uncompressed even for gzipped requests
+		grpc.SetSendCompressor(ctx, encoding.Identity)
+		return
+	}
+
+	supported, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return
+	}
+	for _, name := range supported {
+		if name == gzip.Name {
+			grpc.SetSendCompressor(ctx, gzip.Name)
+			return
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultOpenAIEndpoint is the embeddings endpoint of the OpenAI API.
+const DefaultOpenAIEndpoint = "https://api.openai.com/v1/embeddings"
+
+// HTTPConfig configures an embeddings API with the OpenAI request format, which
+// OpenAI and local model servers (Ollama, llama.cpp, vLLM) all accept.
+type HTTPConfig struct {
+	Endpoint string
+	// APIKey, when set, is sent as a bearer token.
+	APIKey string
+	Model  string
+	// Dimensions asks models that support it for shorter vectors; 0 keeps the model's size.
+	Dimensions int
+	// BatchSize is the maximum number of texts per request.
+	BatchSize int
+	Timeout   time.Duration
+}
+
+// HTTPEmbedder computes embeddings with an external API.
+type HTTPEmbedder struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewHTTPEmbedder(config HTTPConfig) *HTTPEmbedder {
+	if config.Endpoint == "" {
+		config.Endpoint = DefaultOpenAIEndpoint
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 64
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+	return &HTTPEmbedder{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Model includes the requested dimensions, since vectors of different sizes are not comparable.
+func (e *HTTPEmbedder) Model() string {
+	if e.config.Dimensions > 0 {
+		return fmt.Sprintf("%s@%d", e.config.Model, e.config.Dimensions)
+	}
+	return e.config.Model
+}
+
+func (e *HTTPEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += e.config.BatchSize {
+		batch, err := e.embedBatch(ctx, texts[start:min(start+e.config.BatchSize, len(texts))])
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, batch...)
+	}
+	return vectors, nil
+}
+
+type embeddingRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions,omitempty"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (e *HTTPEmbedder) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(embeddingRequest{Model: e.config.Model, Input: texts, Dimensions: e.config.Dimensions})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.APIKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("embeddings API returned %s", resp.Status)
+	}
+
+	var decoded embeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid embeddings response: %w", err)
+	}
+	if len(decoded.Data) != len(texts) {
+		return nil, fmt.Errorf("embeddings API returned %d vectors for %d texts", len(decoded.Data), len(texts))
+	}
+
+	// The API may answer out of order; index refers to the position in the input
+	vectors := make([][]float32, len(texts))
+	for _, item := range decoded.Data {
+		if item.Index < 0 || item.Index >= len(texts) || vectors[item.Index] != nil {
+			return nil, fmt.Errorf("embeddings API returned an invalid index %d", item.Index)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+	return vectors, nil
+}
@@ -0,0 +1,85 @@
+package extraction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// HTTPConfig configures an external OCR API.
+type HTTPConfig struct {
+	// Endpoint receives the raw file as the POST body with its Content-Type and
+	// must answer 200 with the extracted text as a text/plain body.
+	Endpoint string
+	// APIKey, when set, is sent as a bearer token.
+	APIKey       string
+	ContentTypes []string
+	Timeout      time.Duration
+}
+
+// HTTPExtractor delegates extraction to an external OCR service.
+type HTTPExtractor struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewHTTPExtractor(config HTTPConfig) *HTTPExtractor {
+	if len(config.ContentTypes) == 0 {
+		config.ContentTypes = []string{"image/", "application/pdf"}
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 2 * time.Minute
+	}
+	return &HTTPExtractor{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (e *HTTPExtractor) Name() string {
+	return "http"
+}
+
+// Supports matches ContentTypes exactly, or by prefix for entries ending in "/".
+func (e *HTTPExtractor) Supports(contentType string) bool {
+	for _, supported := range e.config.ContentTypes {
+		if contentType == supported || (strings.HasSuffix(supported, "/") && strings.HasPrefix(contentType, supported)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *HTTPExtractor) Extract(ctx context.Context, contentType string, reader io.Reader) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.config.Endpoint, reader)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "text/plain")
+	if e.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.APIKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("text extraction API returned %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, entities.MaxExtractedTextLength))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,28 @@
+package extraction
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// PlainTextExtractor indexes text/* files as they are; it needs no external tools.
+type PlainTextExtractor struct{}
+
+func (PlainTextExtractor) Name() string {
+	return "plain"
+}
+
+func (PlainTextExtractor) Supports(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/")
+}
+
+func (PlainTextExtractor) Extract(ctx context.Context, contentType string, reader io.Reader) (string, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, entities.MaxExtractedTextLength))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,96 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// TesseractConfig configures the local OCR toolchain.
+type TesseractConfig struct {
+	// TesseractPath is the tesseract binary used for images; defaults to "tesseract" on $PATH.
+	TesseractPath string
+	// PdfToTextPath is the poppler pdftotext binary used for PDFs; defaults to "pdftotext".
+	PdfToTextPath string
+	// Languages is passed to tesseract -l, e.g. "eng+spa"; defaults to "eng".
+	Languages string
+	Timeout   time.Duration
+}
+
+// TesseractExtractor runs OCR on images with tesseract and reads the text layer of PDFs
+// with pdftotext, streaming the file through stdin.
+type TesseractExtractor struct {
+	config TesseractConfig
+}
+
+func NewTesseractExtractor(config TesseractConfig) *TesseractExtractor {
+	if config.TesseractPath == "" {
+		config.TesseractPath = "tesseract"
+	}
+	if config.PdfToTextPath == "" {
+		config.PdfToTextPath = "pdftotext"
+	}
+	if config.Languages == "" {
+		config.Languages = "eng"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 2 * time.Minute
+	}
+	return &TesseractExtractor{config: config}
+}
+
+func (e *TesseractExtractor) Name() string {
+	return "tesseract"
+}
+
+func (e *TesseractExtractor) Supports(contentType string) bool {
+	return strings.HasPrefix(contentType, "image/") || contentType == "application/pdf"
+}
+
+func (e *TesseractExtractor) Extract(ctx context.Context, contentType string, reader io.Reader) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.config.Timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if contentType == "application/pdf" {
+		cmd = exec.CommandContext(ctx, e.config.PdfToTextPath, "-q", "-enc", "UTF-8", "-", "-")
+	} else {
+		cmd = exec.CommandContext(ctx, e.config.TesseractPath, "stdin", "stdout", "-l", e.config.Languages)
+	}
+
+	var stdout limitedBuffer
+	var stderr bytes.Buffer
+	stdout.limit = entities.MaxExtractedTextLength
+	cmd.Stdin = reader
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s failed: %w: %s", cmd.Path, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// limitedBuffer keeps the first limit bytes and discards the rest, so a huge
+// document cannot exhaust memory while the tool keeps writing.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.Len(); remaining > 0 {
+		if len(p) > remaining {
+			b.Buffer.Write(p[:remaining])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
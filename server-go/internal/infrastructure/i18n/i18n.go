@@ -0,0 +1,206 @@
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// DefaultLocale is the source language of the catalogs: messages are written
+// in English in the code and used as keys (gettext style), so a missing
+// translation falls back to the original text.
+const DefaultLocale = "en"
+
+// MetadataLocale is the notification metadata key that carries the locale
+// the notification was rendered in, for outbound channels with their own texts.
+const MetadataLocale = "locale"
+
+//go:embed locales/*.json
+var catalogFiles embed.FS
+
+// Translator looks up messages in the embedded catalogs. Keys of the form
+// "notification.<type>.title" are text/template templates; every other key
+// is the English message itself.
+type Translator struct {
+	defaultLocale string
+	catalogs      map[string]map[string]string
+
+	mu        sync.Mutex
+	templates map[string]*template.Template
+}
+
+func NewTranslator(defaultLocale string) (*Translator, error) {
+	files, err := catalogFiles.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Translator{
+		catalogs:  make(map[string]map[string]string),
+		templates: make(map[string]*template.Template),
+	}
+	for _, file := range files {
+		data, err := catalogFiles.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("invalid catalog %s: %w", file.Name(), err)
+		}
+		t.catalogs[strings.TrimSuffix(file.Name(), ".json")] = catalog
+	}
+
+	t.defaultLocale = Normalize(defaultLocale)
+	if !t.Supports(t.defaultLocale) {
+		return nil, fmt.Errorf("no catalog for default locale %q", defaultLocale)
+	}
+	return t, nil
+}
+
+func (t *Translator) DefaultLocale() string {
+	return t.defaultLocale
+}
+
+// Locales returns the locales with a catalog, sorted.
+func (t *Translator) Locales() []string {
+	locales := make([]string, 0, len(t.catalogs))
+	for locale := range t.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func (t *Translator) Supports(locale string) bool {
+	_, ok := t.catalogs[locale]
+	return ok
+}
+
+// Translate returns message in locale. Messages with a detail appended after
+// ": " (such as "Failed to list files: timeout") have their prefix translated.
+func (t *Translator) Translate(locale, message string) string {
+	if translated, ok := t.lookup(locale, message); ok {
+		return translated
+	}
+	if prefix, detail, found := strings.Cut(message, ": "); found {
+		if translated, ok := t.lookup(locale, prefix); ok {
+			return translated + ": " + detail
+		}
+	}
+	return message
+}
+
+// Render executes the template stored under key for locale, falling back to
+// the default locale. It reports false when neither catalog has the key.
+func (t *Translator) Render(locale, key string, data any) (string, bool, error) {
+	if _, ok := t.catalogs[locale][key]; !ok {
+		locale = t.defaultLocale
+	}
+	text, ok := t.catalogs[locale][key]
+	if !ok {
+		return "", false, nil
+	}
+
+	tmpl, err := t.template(locale, key, text)
+	if err != nil {
+		return "", true, err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", true, fmt.Errorf("failed to render %s/%s: %w", locale, key, err)
+	}
+	return out.String(), true, nil
+}
+
+// Negotiate picks the supported locale that best matches an Accept-Language
+// value, or "" when none does.
+func (t *Translator) Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		locale  string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if tag == "" || quality <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: Normalize(tag), quality: quality})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, c := range candidates {
+		if c.locale == "*" {
+			return t.defaultLocale
+		}
+		if t.Supports(c.locale) {
+			return c.locale
+		}
+	}
+	return ""
+}
+
+func (t *Translator) lookup(locale, key string) (string, bool) {
+	if translated, ok := t.catalogs[locale][key]; ok {
+		return translated, true
+	}
+	translated, ok := t.catalogs[t.defaultLocale][key]
+	return translated, ok
+}
+
+func (t *Translator) template(locale, key, text string) (*template.Template, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cacheKey := locale + "\x00" + key
+	if tmpl, ok := t.templates[cacheKey]; ok {
+		return tmpl, nil
+	}
+	tmpl, err := template.New(key).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s/%s: %w", locale, key, err)
+	}
+	t.templates[cacheKey] = tmpl
+	return tmpl, nil
+}
+
+// Normalize reduces a language tag such as "es-AR" or "pt_BR" to its primary language.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+type localeKey struct{}
+
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the locale of the request in ctx, or "" if none was resolved.
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
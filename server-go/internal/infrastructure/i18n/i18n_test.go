@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTranslator(t *testing.T) *Translator {
+	translator, err := NewTranslator(DefaultLocale)
+	require.NoError(t, err)
+	return translator
+}
+
+// The default catalog only holds the templated keys, so every other locale
+// must define all of them or its users get English notifications.
+func TestCatalogs_EveryDefaultKeyIsTranslated(t *testing.T) {
+	translator := newTestTranslator(t)
+	defaults := translator.catalogs[DefaultLocale]
+	require.NotEmpty(t, defaults)
+
+	for _, locale := range translator.Locales() {
+		catalog := translator.catalogs[locale]
+		for key := range defaults {
+			assert.Contains(t, catalog, key, "locale %q is missing %q", locale, key)
+		}
+	}
+}
+
+func TestCatalogs_TranslationsAreUsable(t *testing.T) {
+	translator := newTestTranslator(t)
+
+	for _, locale := range translator.Locales() {
+		for key, text := range translator.catalogs[locale] {
+			assert.NotEmpty(t, strings.TrimSpace(text), "locale %q has an empty translation for %q", locale, key)
+			if strings.HasPrefix(key, "notification.") {
+				_, err := template.New(key).Parse(text)
+				assert.NoError(t, err, "locale %q has an invalid template for %q", locale, key)
+			}
+		}
+	}
+}
+
+func TestNewTranslator_UnknownDefaultLocale(t *testing.T) {
+	_, err := NewTranslator("xx")
+	assert.Error(t, err)
+}
+
+func TestTranslate_Fallbacks(t *testing.T) {
+	translator := newTestTranslator(t)
+
+	tests := []struct {
+		name    string
+		locale  string
+		message string
+		want    string
+	}{
+		{"translated", "es", "Overdue reminder", "Recordatorio vencido"},
+		{"prefix with detail", "es", "Overdue reminder: Llamar al proveedor", "Recordatorio vencido: Llamar al proveedor"},
+		{"missing translation keeps the message", "es", "A message nobody translated", "A message nobody translated"},
+		{"unsupported locale keeps the message", "xx", "Overdue reminder", "Overdue reminder"},
+		{"unsupported locale uses the default catalog", "xx", "notification.file_missing.title", "File unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, translator.Translate(tt.locale, tt.message))
+		})
+	}
+}
+
+func TestRender_FallsBackToDefaultLocale(t *testing.T) {
+	translator := newTestTranslator(t)
+	translator.catalogs["es"] = map[string]string{}
+	data := map[string]any{"Metadata": map[string]string{"version": "3", "filename": "informe.pdf"}}
+
+	text, ok, err := translator.Render("es", "notification.file_missing.message", data)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Version 3 of informe.pdf is no longer available in storage and was removed", text)
+
+	_, ok, err = translator.Render("es", "notification.unknown.title", data)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestNegotiate(t *testing.T) {
+	translator := newTestTranslator(t)
+
+	assert.Equal(t, "es", translator.Negotiate("es-AR,es;q=0.9,en;q=0.8"))
+	assert.Equal(t, "en", translator.Negotiate("fr;q=1,en;q=0.5,es;q=0.1"))
+	assert.Equal(t, DefaultLocale, translator.Negotiate("fr, *;q=0.5"))
+	assert.Equal(t, "", translator.Negotiate("fr, de;q=0.5, es;q=0"))
+	assert.Equal(t, "", translator.Negotiate(""))
+}
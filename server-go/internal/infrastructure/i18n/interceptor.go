@@ -0,0 +1,144 @@
+package i18n
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MetadataKey is the metadata key clients send their preferred languages in,
+// with the syntax of the HTTP Accept-Language header.
+const MetadataKey = "accept-language"
+
+// ContentLanguageKey is the header key the server answers with the locale it used.
+const ContentLanguageKey = "content-language"
+
+//go:generate mockery --name Preferences --with-expecter --case underscore
+
+// Preferences returns the locale a user chose, or "" if none.
+type Preferences interface {
+	GetLocale(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// Interceptor resolves the locale of each call and translates the "message"
+// field of unary responses. Streamed messages are left alone: notifications
+// are already rendered in the recipient's locale. The locale comes from Accept-Language metadata,
+// then from the preference of the user in the request's "user_id" field (or
+// the authenticated actor), then the default.
+type Interceptor struct {
+	translator  *Translator
+	preferences Preferences
+}
+
+func NewInterceptor(translator *Translator, preferences Preferences) *Interceptor {
+	return &Interceptor{translator: translator, preferences: preferences}
+}
+
+func (i *Interceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		locale := i.resolve(ctx, userIDField(req))
+		ctx = WithLocale(ctx, locale)
+		grpc.SetHeader(ctx, metadata.Pairs(ContentLanguageKey, locale))
+
+		resp, err := handler(ctx, req)
+		i.localize(locale, resp)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// The request has not been read yet, so only the metadata and the actor are available
+		locale := i.resolve(stream.Context(), uuid.Nil)
+		stream.SetHeader(metadata.Pairs(ContentLanguageKey, locale))
+
+		return handler(srv, &localeStream{
+			ServerStream: stream,
+			ctx:          WithLocale(stream.Context(), locale),
+		})
+	}
+}
+
+func (i *Interceptor) resolve(ctx context.Context, userID uuid.UUID) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(MetadataKey) {
+			if locale := i.translator.Negotiate(value); locale != "" {
+				return locale
+			}
+		}
+	}
+
+	if userID == uuid.Nil {
+		userID = entities.EventContextFromContext(ctx).ActorID
+	}
+	if userID != uuid.Nil && i.preferences != nil {
+		if locale, err := i.preferences.GetLocale(ctx, userID); err == nil && i.translator.Supports(locale) {
+			return locale
+		}
+	}
+	return i.translator.DefaultLocale()
+}
+
+func (i *Interceptor) localize(locale string, msg interface{}) {
+	if locale == i.translator.DefaultLocale() {
+		return
+	}
+	m, ok := msg.(proto.Message)
+	if !ok || m == nil {
+		return
+	}
+
+	reflected := m.ProtoReflect()
+	if !reflected.IsValid() {
+		return
+	}
+	field := reflected.Descriptor().Fields().ByName("message")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+		return
+	}
+	if message := reflected.Get(field).String(); message != "" {
+		reflected.Set(field, protoreflect.ValueOfString(i.translator.Translate(locale, message)))
+	}
+}
+
+// userIDField reads the "user_id" field most requests carry.
+func userIDField(req interface{}) uuid.UUID {
+	m, ok := req.(proto.Message)
+	if !ok || m == nil {
+		return uuid.Nil
+	}
+	reflected := m.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName("user_id")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+		return uuid.Nil
+	}
+	userID, err := uuid.Parse(reflected.Get(field).String())
+	if err != nil {
+		return uuid.Nil
+	}
+	return userID
+}
+
+type localeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *localeStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// NotificationTemplateData is what "notification.<type>.title" and
+// "notification.<type>.message" templates are executed with; Title and
+// Message are the texts the notification was sent with.
+type NotificationTemplateData struct {
+	Title    string
+	Message  string
+	Metadata map[string]string
+}
+
+// Notifications is a ports.NotificationService decorator that renders
+// notifications in the recipient's locale: the user's preference, else the
+// locale of the request when the recipient made it, else the default.
+// Notification types without templates keep their texts.
+type Notifications struct {
+	ports.NotificationService
+	translator  *Translator
+	preferences Preferences
+}
+
+func NewNotifications(next ports.NotificationService, translator *Translator, preferences Preferences) *Notifications {
+	return &Notifications{NotificationService: next, translator: translator, preferences: preferences}
+}
+
+func (n *Notifications) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	locale := n.locale(ctx, userID)
+	data := NotificationTemplateData{Title: title, Message: message, Metadata: metadata}
+
+	if rendered, ok, err := n.translator.Render(locale, "notification."+notificationType+".title", data); err != nil {
+		return err
+	} else if ok {
+		title = rendered
+	}
+	if rendered, ok, err := n.translator.Render(locale, "notification."+notificationType+".message", data); err != nil {
+		return err
+	} else if ok {
+		message = rendered
+	}
+
+	localized := make(map[string]string, len(metadata)+1)
+	for key, value := range metadata {
+		localized[key] = value
+	}
+	localized[MetadataLocale] = locale
+
+	return n.NotificationService.SendNotification(ctx, userID, title, message, notificationType, channels, localized)
+}
+
+func (n *Notifications) locale(ctx context.Context, userID uuid.UUID) string {
+	if n.preferences != nil {
+		if locale, err := n.preferences.GetLocale(ctx, userID); err == nil && n.translator.Supports(locale) {
+			return locale
+		}
+	}
+	if locale := FromContext(ctx); locale != "" && entities.EventContextFromContext(ctx).ActorID == userID {
+		return locale
+	}
+	return n.translator.DefaultLocale()
+}
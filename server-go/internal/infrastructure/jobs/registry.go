@@ -0,0 +1,341 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/lock"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+var (
+	ErrJobNotFound    = errors.New("job not found")
+	ErrJobRunning     = errors.New("job is already running")
+	ErrJobExists      = errors.New("job already registered")
+	ErrInvalidJob     = errors.New("job requires a name and a task")
+	ErrLockerRequired = errors.New("singleton jobs require a locker")
+)
+
+type JobFunc func(ctx context.Context) error
+
+type RunResult string
+
+const (
+	ResultNeverRun  RunResult = "never_run"
+	ResultSucceeded RunResult = "succeeded"
+	ResultFailed    RunResult = "failed"
+	ResultSkipped   RunResult = "skipped"
+)
+
+type JobConfig struct {
+	Name string `json:"name"`
+	// Interval between scheduled runs; zero registers a job that only runs when triggered.
+	Interval   time.Duration `json:"interval"`
+	Timeout    time.Duration `json:"timeout"`
+	RunOnStart bool          `json:"run_on_start"`
+	// Singleton jobs run under a distributed lock so only one replica executes them.
+	Singleton bool    `json:"singleton"`
+	Task      JobFunc `json:"-"`
+}
+
+type JobStatus struct {
+	Name           string        `json:"name"`
+	Interval       time.Duration `json:"interval"`
+	Singleton      bool          `json:"singleton"`
+	Running        bool          `json:"running"`
+	LastResult     RunResult     `json:"last_result"`
+	LastError      string        `json:"last_error,omitempty"`
+	LastStartedAt  time.Time     `json:"last_started_at"`
+	LastFinishedAt time.Time     `json:"last_finished_at"`
+	LastDuration   time.Duration `json:"last_duration"`
+	NextRunAt      time.Time     `json:"next_run_at"`
+	RunCount       int64         `json:"run_count"`
+	FailureCount   int64         `json:"failure_count"`
+}
+
+type RegistryConfig struct {
+	Locker ports.DistributedLocker `json:"-"`
+	Clock  entities.Clock          `json:"-"`
+}
+
+type job struct {
+	config JobConfig
+	task   JobFunc
+	status JobStatus
+}
+
+type Registry struct {
+	config RegistryConfig
+	mu     sync.Mutex
+	jobs   map[string]*job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	paused bool
+
+	onRunComplete func(status JobStatus, err error)
+}
+
+func NewRegistry(config RegistryConfig) *Registry {
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+
+	return &Registry{
+		config: config,
+		jobs:   make(map[string]*job),
+	}
+}
+
+// Register adds a job. Jobs registered after Start are scheduled immediately.
+func (r *Registry) Register(config JobConfig) error {
+	if config.Name == "" || config.Task == nil {
+		return ErrInvalidJob
+	}
+	if config.Singleton && r.config.Locker == nil {
+		return ErrLockerRequired
+	}
+
+	task := config.Task
+	if config.Singleton {
+		task = lock.Exclusive(r.config.Locker, "job:"+config.Name, task)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.jobs[config.Name]; exists {
+		return fmt.Errorf("%w: %s", ErrJobExists, config.Name)
+	}
+
+	j := &job{
+		config: config,
+		task:   task,
+		status: JobStatus{
+			Name:       config.Name,
+			Interval:   config.Interval,
+			Singleton:  config.Singleton,
+			LastResult: ResultNeverRun,
+		},
+	}
+	r.jobs[config.Name] = j
+
+	if r.ctx != nil {
+		r.schedule(j)
+	}
+	return nil
+}
+
+// Start launches the scheduler of every periodic job until ctx is cancelled or Stop is called.
+func (r *Registry) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ctx != nil {
+		return
+	}
+	r.ctx, r.cancel = context.WithCancel(ctx)
+
+	for _, j := range r.jobs {
+		r.schedule(j)
+	}
+}
+
+func (r *Registry) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	r.wg.Wait()
+}
+
+// Trigger runs a job immediately and waits for it to finish.
+func (r *Registry) Trigger(ctx context.Context, name string) (JobStatus, error) {
+	r.mu.Lock()
+	j, exists := r.jobs[name]
+	r.mu.Unlock()
+
+	if !exists {
+		return JobStatus{}, fmt.Errorf("%w: %s", ErrJobNotFound, name)
+	}
+
+	err := r.run(ctx, j)
+	status, _ := r.Status(name)
+	return status, err
+}
+
+func (r *Registry) Status(name string) (JobStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, exists := r.jobs[name]
+	if !exists {
+		return JobStatus{}, false
+	}
+	return j.status, true
+}
+
+func (r *Registry) List() []JobStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(r.jobs))
+	for _, j := range r.jobs {
+		statuses = append(statuses, j.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// Pause skips scheduled runs until Resume; running jobs finish and manual triggers still run.
+func (r *Registry) Pause() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paused = true
+}
+
+func (r *Registry) Resume() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paused = false
+}
+
+func (r *Registry) Paused() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.paused
+}
+
+func (r *Registry) OnRunComplete(callback func(status JobStatus, err error)) {
+	r.onRunComplete = callback
+}
+
+// Metrics is a metrics.MetricsCollector collector exposing run counts and last result per job.
+func (r *Registry) Metrics() []metrics.Metric {
+	var result []metrics.Metric
+	now := r.config.Clock.Now()
+
+	for _, status := range r.List() {
+		labels := map[string]string{"job": status.Name}
+		failed := 0.0
+		if status.LastResult == ResultFailed {
+			failed = 1.0
+		}
+
+		result = append(result,
+			metrics.Metric{Name: "job_runs_total", Type: metrics.Counter, Value: float64(status.RunCount), Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "job_failures_total", Type: metrics.Counter, Value: float64(status.FailureCount), Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "job_last_run_failed", Type: metrics.Gauge, Value: failed, Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "job_last_duration_seconds", Type: metrics.Gauge, Value: status.LastDuration.Seconds(), Labels: labels, Timestamp: now},
+		)
+	}
+
+	return result
+}
+
+// schedule must be called with r.mu held.
+func (r *Registry) schedule(j *job) {
+	if j.config.Interval <= 0 {
+		return
+	}
+
+	ctx := r.ctx
+	j.status.NextRunAt = r.config.Clock.Now().Add(j.config.Interval)
+
+	r.wg.Add(1)
+	supervisor.Go("jobs."+j.config.Name, func() {
+		defer r.wg.Done()
+
+		if j.config.RunOnStart && !r.Paused() {
+			r.run(ctx, j)
+		}
+
+		ticker := time.NewTicker(j.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if r.Paused() {
+					continue
+				}
+				// Overlaps with a manual trigger are skipped with ErrJobRunning
+				r.run(ctx, j)
+			}
+		}
+	})
+}
+
+func (r *Registry) run(ctx context.Context, j *job) (err error) {
+	r.mu.Lock()
+	if j.status.Running {
+		r.mu.Unlock()
+		return ErrJobRunning
+	}
+	j.status.Running = true
+	startedAt := r.config.Clock.Now()
+	j.status.LastStartedAt = startedAt
+	r.mu.Unlock()
+
+	if j.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, j.config.Timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job %s panicked: %v", j.config.Name, recovered)
+		}
+		r.finish(j, startedAt, err)
+	}()
+
+	return j.task(ctx)
+}
+
+func (r *Registry) finish(j *job, startedAt time.Time, err error) {
+	r.mu.Lock()
+	now := r.config.Clock.Now()
+	j.status.Running = false
+	j.status.LastFinishedAt = now
+	j.status.LastDuration = now.Sub(startedAt)
+	if j.config.Interval > 0 {
+		j.status.NextRunAt = now.Add(j.config.Interval)
+	}
+
+	switch {
+	case errors.Is(err, entities.ErrLockNotAcquired):
+		// Another replica holds the singleton lock; not a failure
+		j.status.LastResult = ResultSkipped
+		j.status.LastError = ""
+	case err != nil:
+		j.status.RunCount++
+		j.status.FailureCount++
+		j.status.LastResult = ResultFailed
+		j.status.LastError = err.Error()
+	default:
+		j.status.RunCount++
+		j.status.LastResult = ResultSucceeded
+		j.status.LastError = ""
+	}
+	status := j.status
+	r.mu.Unlock()
+
+	if r.onRunComplete != nil {
+		r.onRunComplete(status, err)
+	}
+}
@@ -0,0 +1,296 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// PayloadTooLargeReason is the ErrorInfo reason of messages over Config.MaxMessageBytes.
+	PayloadTooLargeReason = "PAYLOAD_TOO_LARGE"
+	// FieldTooLargeReason is the ErrorInfo reason of fields over their limit.
+	FieldTooLargeReason = "FIELD_TOO_LARGE"
+)
+
+// DefaultStringFields are the maximum lengths, in characters, of the free-text
+// fields of the Notebook protos. A repeated string field limits each entry.
+var DefaultStringFields = map[string]int{
+	"title":        500,
+	"content":      100000,
+	"description":  10000,
+	"notes":        10000,
+	"message":      10000,
+	"body":         100000,
+	"name":         255,
+	"filename":     255,
+	"content_type": 255,
+	"category":     100,
+	"tags":         64,
+	"query":        1000,
+}
+
+// DefaultListFields are the maximum number of entries of repeated and map fields.
+var DefaultListFields = map[string]int{
+	"tags":          50,
+	"ids":           500,
+	"idea_ids":      500,
+	"custom_fields": 100,
+	"exif":          200,
+	"attributes":    100,
+	"metadata":      100,
+	"headers":       100,
+}
+
+type Config struct {
+	// MaxMessageBytes is the largest encoded request message, checked per message on streams.
+	MaxMessageBytes int `json:"max_message_bytes"`
+	// StringFields limit string fields by proto field name, at any depth. Defaults to DefaultStringFields.
+	StringFields map[string]int `json:"string_fields"`
+	// ListFields limit repeated and map fields by proto field name, at any depth. Defaults to DefaultListFields.
+	ListFields map[string]int `json:"list_fields"`
+	// ErrorDomain is the ErrorInfo domain of rejected calls.
+	ErrorDomain string `json:"error_domain"`
+}
+
+// violation describes the first limit a request exceeded.
+type violation struct {
+	// field is the path of the value, such as "idea.tags[3]"; name is the limited field name
+	field  string
+	name   string
+	limit  int
+	actual int
+	unit   string
+}
+
+// Enforcer rejects oversized requests before they reach the handlers: the
+// whole message with ResourceExhausted and individual fields with
+// InvalidArgument, both with an ErrorInfo naming the limit.
+type Enforcer struct {
+	config Config
+
+	mu       sync.Mutex
+	rejected map[string]int64
+}
+
+func NewEnforcer(config Config) *Enforcer {
+	if config.MaxMessageBytes <= 0 {
+		config.MaxMessageBytes = 4 << 20
+	}
+	if config.StringFields == nil {
+		config.StringFields = DefaultStringFields
+	}
+	if config.ListFields == nil {
+		config.ListFields = DefaultListFields
+	}
+	if config.ErrorDomain == "" {
+		config.ErrorDomain = "notebook"
+	}
+
+	return &Enforcer{
+		config:   config,
+		rejected: make(map[string]int64),
+	}
+}
+
+// Check validates one request message.
+func (e *Enforcer) Check(req interface{}) error {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	if size := proto.Size(message); size > e.config.MaxMessageBytes {
+		e.count("message_bytes")
+		st := status.New(codes.ResourceExhausted, fmt.Sprintf("request is %d bytes, the maximum is %d", size, e.config.MaxMessageBytes))
+		if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+			Reason:   PayloadTooLargeReason,
+			Domain:   e.config.ErrorDomain,
+			Metadata: map[string]string{"limit_bytes": strconv.Itoa(e.config.MaxMessageBytes)},
+		}); err == nil {
+			st = detailed
+		}
+		return st.Err()
+	}
+
+	v := e.checkMessage(message.ProtoReflect(), "")
+	if v == nil {
+		return nil
+	}
+	e.count(v.name)
+	description := fmt.Sprintf("must have at most %d %s, got %d", v.limit, v.unit, v.actual)
+	st := status.New(codes.InvalidArgument, v.field+" "+description)
+	if detailed, err := st.WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: v.field, Description: description}},
+		},
+		&errdetails.ErrorInfo{
+			Reason:   FieldTooLargeReason,
+			Domain:   e.config.ErrorDomain,
+			Metadata: map[string]string{"field": v.field, "limit": strconv.Itoa(v.limit)},
+		},
+	); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (e *Enforcer) checkMessage(m protoreflect.Message, path string) *violation {
+	var found *violation
+	m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		found = e.checkField(fd, value, joinPath(path, string(fd.Name())))
+		return found == nil
+	})
+	return found
+}
+
+func (e *Enforcer) checkField(fd protoreflect.FieldDescriptor, value protoreflect.Value, path string) *violation {
+	name := string(fd.Name())
+
+	switch {
+	case fd.IsMap():
+		entries := value.Map()
+		if limit, ok := e.config.ListFields[name]; ok && entries.Len() > limit {
+			return &violation{field: path, name: name, limit: limit, actual: entries.Len(), unit: "entries"}
+		}
+		if !isMessage(fd.MapValue()) {
+			return nil
+		}
+		var found *violation
+		entries.Range(func(key protoreflect.MapKey, entry protoreflect.Value) bool {
+			found = e.checkMessage(entry.Message(), path+"["+key.String()+"]")
+			return found == nil
+		})
+		return found
+	case fd.IsList():
+		list := value.List()
+		if limit, ok := e.config.ListFields[name]; ok && list.Len() > limit {
+			return &violation{field: path, name: name, limit: limit, actual: list.Len(), unit: "entries"}
+		}
+		for i := 0; i < list.Len(); i++ {
+			item := fmt.Sprintf("%s[%d]", path, i)
+			if fd.Kind() == protoreflect.StringKind {
+				if v := e.checkString(name, list.Get(i).String(), item); v != nil {
+					return v
+				}
+			} else if isMessage(fd) {
+				if v := e.checkMessage(list.Get(i).Message(), item); v != nil {
+					return v
+				}
+			}
+		}
+		return nil
+	case fd.Kind() == protoreflect.StringKind:
+		return e.checkString(name, value.String(), path)
+	case isMessage(fd):
+		return e.checkMessage(value.Message(), path)
+	}
+	return nil
+}
+
+func (e *Enforcer) checkString(name, value, path string) *violation {
+	limit, ok := e.config.StringFields[name]
+	// The byte length bounds the character count, so most values skip counting runes
+	if !ok || len(value) <= limit {
+		return nil
+	}
+	if length := utf8.RuneCountInString(value); length > limit {
+		return &violation{field: path, name: name, limit: limit, actual: length, unit: "characters"}
+	}
+	return nil
+}
+
+func (e *Enforcer) count(limit string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rejected[limit]++
+}
+
+func (e *Enforcer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := e.Check(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (e *Enforcer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &checkedStream{ServerStream: stream, enforcer: e})
+	}
+}
+
+// checkedStream validates every message the client sends.
+type checkedStream struct {
+	grpc.ServerStream
+	enforcer *Enforcer
+}
+
+func (s *checkedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.enforcer.Check(m)
+}
+
+// Metrics is a metrics.MetricsCollector collector for the rejected requests, by limit.
+func (e *Enforcer) Metrics() []metrics.Metric {
+	e.mu.Lock()
+	limits := make([]string, 0, len(e.rejected))
+	for limit := range e.rejected {
+		limits = append(limits, limit)
+	}
+	sort.Strings(limits)
+	counts := make([]int64, len(limits))
+	for i, limit := range limits {
+		counts[i] = e.rejected[limit]
+	}
+	e.mu.Unlock()
+
+	now := time.Now()
+	result := make([]metrics.Metric, 0, len(limits))
+	for i, limit := range limits {
+		result = append(result, metrics.Metric{
+			Name:      "request_limit_rejections_total",
+			Type:      metrics.Counter,
+			Value:     float64(counts[i]),
+			Labels:    map[string]string{"limit": limit},
+			Timestamp: now,
+		})
+	}
+	return result
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
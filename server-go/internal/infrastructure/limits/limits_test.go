@@ -0,0 +1,77 @@
+package limits
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	t.Helper()
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("error %v has no ErrorInfo", err)
+	return nil
+}
+
+func TestEnforcerLimitsFields(t *testing.T) {
+	enforcer := NewEnforcer(Config{
+		MaxMessageBytes: 1 << 10,
+		StringFields:    map[string]int{"string_value": 5},
+		ListFields:      map[string]int{"fields": 3, "values": 2},
+	})
+
+	accepted, _ := structpb.NewStruct(map[string]interface{}{"title": "héllo", "tags": []interface{}{"a", "b"}})
+	if err := enforcer.Check(accepted); err != nil {
+		t.Errorf("request within limits rejected: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		request map[string]interface{}
+		field   string
+	}{
+		{"long string", map[string]interface{}{"title": "too long"}, "fields[title].string_value"},
+		{"long list entry", map[string]interface{}{"tags": []interface{}{"ok", "too long"}}, "fields[tags].list_value.values[1].string_value"},
+		{"too many list entries", map[string]interface{}{"tags": []interface{}{"a", "b", "c"}}, "fields[tags].list_value.values"},
+		{"too many map entries", map[string]interface{}{"a": 1, "b": 2, "c": 3, "d": 4}, "fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, _ := structpb.NewStruct(tt.request)
+			err := enforcer.Check(request)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+			}
+			info := errorInfo(t, err)
+			if info.Reason != FieldTooLargeReason || info.Metadata["field"] != tt.field {
+				t.Errorf("ErrorInfo = %v, want %s on %s", info, FieldTooLargeReason, tt.field)
+			}
+		})
+	}
+}
+
+func TestEnforcerLimitsMessageSize(t *testing.T) {
+	enforcer := NewEnforcer(Config{MaxMessageBytes: 100, StringFields: map[string]int{}, ListFields: map[string]int{}})
+
+	request, _ := structpb.NewStruct(map[string]interface{}{"content": strings.Repeat("x", 200)})
+	err := enforcer.Check(request)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("code = %v, want ResourceExhausted", status.Code(err))
+	}
+	if info := errorInfo(t, err); info.Reason != PayloadTooLargeReason || info.Metadata["limit_bytes"] != "100" {
+		t.Errorf("ErrorInfo = %v", info)
+	}
+
+	metrics := enforcer.Metrics()
+	if len(metrics) != 1 || metrics[0].Labels["limit"] != "message_bytes" || metrics[0].Value != 1 {
+		t.Errorf("metrics = %v, want one message_bytes rejection", metrics)
+	}
+}
@@ -0,0 +1,89 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+const releaseTimeout = 5 * time.Second
+
+type LeaderConfig struct {
+	Name          string        `json:"name"`
+	RetryInterval time.Duration `json:"retry_interval"`
+	OnElected     func(name string)
+	OnDemoted     func(name string, err error)
+}
+
+// RunAsLeader blocks until ctx is cancelled, running task only while this
+// instance holds the named lock. Replicas that lose the election retry every
+// RetryInterval; if the lease is lost, the task context is cancelled and the
+// instance goes back to campaigning.
+func RunAsLeader(ctx context.Context, locker ports.DistributedLocker, config LeaderConfig, task func(ctx context.Context) error) error {
+	if config.RetryInterval == 0 {
+		config.RetryInterval = 15 * time.Second
+	}
+
+	for {
+		lease, err := locker.TryLock(ctx, config.Name)
+		if err == nil {
+			if config.OnElected != nil {
+				config.OnElected(config.Name)
+			}
+
+			taskErr := runWithLease(ctx, lease, task)
+
+			if config.OnDemoted != nil {
+				config.OnDemoted(config.Name, taskErr)
+			}
+		} else if !errors.Is(err, entities.ErrLockNotAcquired) && config.OnDemoted != nil {
+			config.OnDemoted(config.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(config.RetryInterval):
+		}
+	}
+}
+
+// Exclusive wraps a one-shot job so that at most one replica runs it at a
+// time. When another replica holds the lock the job is skipped and
+// entities.ErrLockNotAcquired is returned.
+func Exclusive(locker ports.DistributedLocker, name string, job func(ctx context.Context) error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		lease, err := locker.TryLock(ctx, name)
+		if err != nil {
+			return err
+		}
+		return runWithLease(ctx, lease, job)
+	}
+}
+
+func runWithLease(ctx context.Context, lease ports.LockLease, task func(ctx context.Context) error) error {
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	supervisor.Go("lock.lease_watch", func() {
+		select {
+		case <-lease.Lost():
+			cancel()
+		case <-taskCtx.Done():
+		}
+	})
+
+	err := task(taskCtx)
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer releaseCancel()
+	if releaseErr := lease.Release(releaseCtx); releaseErr != nil && err == nil {
+		err = releaseErr
+	}
+
+	return err
+}
@@ -0,0 +1,59 @@
+package lock
+
+import (
+	"context"
+	"sync"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// LocalLocker is an in-process ports.DistributedLocker for single-instance
+// deployments such as standalone mode, where there are no other replicas.
+type LocalLocker struct {
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{
+		held: make(map[string]bool),
+	}
+}
+
+func (l *LocalLocker) TryLock(ctx context.Context, name string) (ports.LockLease, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.held[name] {
+		return nil, entities.ErrLockNotAcquired
+	}
+	l.held[name] = true
+
+	return &localLease{locker: l, name: name, lost: make(chan struct{})}, nil
+}
+
+func (l *LocalLocker) release(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.held, name)
+}
+
+type localLease struct {
+	locker *LocalLocker
+	name   string
+	lost   chan struct{}
+	once   sync.Once
+}
+
+// Lost is never closed: an in-process lock cannot be lost.
+func (l *localLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *localLease) Release(ctx context.Context) error {
+	l.once.Do(func() {
+		l.locker.release(l.name)
+	})
+	return nil
+}
@@ -0,0 +1,258 @@
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// maxPooledEntryBuffer keeps the occasional huge entry (a long stack trace) from pinning its
+// buffer in the pool.
+const maxPooledEntryBuffer = 64 << 10
+
+var entryBuffers = sync.Pool{
+	New: func() interface{} {
+		return &entryBuffer{bytes: make([]byte, 0, 1024), keys: make([]string, 0, 16)}
+	},
+}
+
+// entryBuffer encodes log entries without allocating. The output is byte for byte what
+// json.Marshal produces for a LogEntry, so log consumers cannot tell the difference; values
+// without a fast path are encoded with json.Marshal.
+type entryBuffer struct {
+	bytes []byte
+	keys  []string
+}
+
+func getEntryBuffer() *entryBuffer {
+	return entryBuffers.Get().(*entryBuffer)
+}
+
+// free returns the buffer to the pool; bytes must not be used afterwards.
+func (e *entryBuffer) free() {
+	if cap(e.bytes) > maxPooledEntryBuffer {
+		return
+	}
+	e.bytes = e.bytes[:0]
+	for i := range e.keys {
+		e.keys[i] = ""
+	}
+	e.keys = e.keys[:0]
+	entryBuffers.Put(e)
+}
+
+// encode replaces the buffer contents with the JSON line of entry, newline included.
+func (e *entryBuffer) encode(entry *LogEntry) error {
+	e.bytes = e.bytes[:0]
+
+	b := append(e.bytes, `{"timestamp":`...)
+	b, err := appendTime(b, entry.Timestamp)
+	if err != nil {
+		return err
+	}
+	b = append(b, `,"level":`...)
+	b = appendString(b, entry.Level)
+	b = append(b, `,"message":`...)
+	b = appendString(b, entry.Message)
+
+	if len(entry.Fields) > 0 {
+		b = append(b, `,"fields":`...)
+		if b, err = e.appendFields(b, entry.Fields); err != nil {
+			return err
+		}
+	}
+	if entry.CallerInfo != nil {
+		b = append(b, `,"caller":{"file":`...)
+		b = appendString(b, entry.CallerInfo.File)
+		b = append(b, `,"line":`...)
+		b = strconv.AppendInt(b, int64(entry.CallerInfo.Line), 10)
+		b = append(b, `,"function":`...)
+		b = appendString(b, entry.CallerInfo.Function)
+		b = append(b, '}')
+	}
+	b = appendOptionalString(b, `,"trace_id":`, entry.TraceID)
+	b = appendOptionalString(b, `,"span_id":`, entry.SpanID)
+	if entry.Error != nil {
+		b = append(b, `,"error":{"type":`...)
+		b = appendString(b, entry.Error.Type)
+		b = append(b, `,"message":`...)
+		b = appendString(b, entry.Error.Message)
+		b = appendOptionalString(b, `,"stack_trace":`, entry.Error.StackTrace)
+		b = appendOptionalString(b, `,"code":`, entry.Error.Code)
+		b = append(b, '}')
+	}
+	if entry.Duration != nil {
+		b = append(b, `,"duration":`...)
+		b = strconv.AppendInt(b, int64(*entry.Duration), 10)
+	}
+	b = appendOptionalString(b, `,"request_id":`, entry.RequestID)
+	b = appendOptionalString(b, `,"user_id":`, entry.UserID)
+	b = appendOptionalString(b, `,"session_id":`, entry.SessionID)
+	b = appendOptionalString(b, `,"component":`, entry.Component)
+	b = appendOptionalString(b, `,"operation":`, entry.Operation)
+	b = appendOptionalString(b, `,"environment":`, entry.Environment)
+
+	e.bytes = append(b, '}', '\n')
+	return nil
+}
+
+// appendFields writes the map with its keys sorted, as encoding/json does.
+func (e *entryBuffer) appendFields(b []byte, fields map[string]interface{}) ([]byte, error) {
+	keys := e.keys[:0]
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	// Insertion sort: entries have few fields, and sort.Strings would allocate
+	for i := 1; i < len(keys); i++ {
+		for j := i; j > 0 && keys[j] < keys[j-1]; j-- {
+			keys[j], keys[j-1] = keys[j-1], keys[j]
+		}
+	}
+	e.keys = keys
+
+	b = append(b, '{')
+	for i, key := range keys {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendString(b, key)
+		b = append(b, ':')
+
+		var err error
+		if b, err = appendValue(b, fields[key]); err != nil {
+			return b, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+func appendValue(b []byte, value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case string:
+		return appendString(b, v), nil
+	case bool:
+		return strconv.AppendBool(b, v), nil
+	case int:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(b, v, 10), nil
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(b, v, 10), nil
+	case float64:
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return appendFloat(b, v), nil
+		}
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return b, err
+	}
+	return append(b, data...), nil
+}
+
+// appendFloat follows encoding/json: exponent notation only for very small or large values,
+// with the exponent's leading zero removed.
+func appendFloat(b []byte, f float64) []byte {
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
+
+func appendTime(b []byte, t time.Time) ([]byte, error) {
+	if year := t.Year(); year < 0 || year >= 10000 {
+		return b, errors.New("Time.MarshalJSON: year outside of range [0,9999]")
+	}
+	b = append(b, '"')
+	b = t.AppendFormat(b, time.RFC3339Nano)
+	return append(b, '"'), nil
+}
+
+func appendOptionalString(b []byte, key, value string) []byte {
+	if value == "" {
+		return b
+	}
+	b = append(b, key...)
+	return appendString(b, value)
+}
+
+// appendString escapes like json.Marshal, including its HTML escaping. The escapes of the
+// uncommon control characters differ between Go releases, so strings containing them are
+// left to encoding/json.
+func appendString(b []byte, s string) []byte {
+	begin := len(b)
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				b = append(b, s[start:i]...)
+				b = append(b, '\\', c)
+			case c == '\n':
+				b = append(b, s[start:i]...)
+				b = append(b, '\\', 'n')
+			case c == '\r':
+				b = append(b, s[start:i]...)
+				b = append(b, '\\', 'r')
+			case c == '\t':
+				b = append(b, s[start:i]...)
+				b = append(b, '\\', 't')
+			case c < 0x20:
+				data, _ := json.Marshal(s)
+				return append(b[:begin], data...)
+			case c == '<' || c == '>' || c == '&':
+				b = append(b, s[start:i]...)
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			default:
+				i++
+				continue
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			b = append(b, s[start:i]...)
+			b = append(b, `\ufffd`...)
+		case r == '\u2028' || r == '\u2029':
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+const hexDigits = "0123456789abcdef"
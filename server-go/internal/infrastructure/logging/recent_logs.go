@@ -0,0 +1,138 @@
+package logging
+
+import (
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// RecentLogs keeps the last entries logged in memory so the admin dashboard can show them
+// without a log pipeline. It is a LogHook for the StructuredLogger and, through Core, a sink
+// for zap loggers, so both end up in the same list.
+type RecentLogs struct {
+	level LogLevel
+
+	mu      sync.Mutex
+	entries []LogEntry
+	next    int
+	full    bool
+}
+
+// NewRecentLogs keeps up to capacity entries at level or above.
+func NewRecentLogs(capacity int, level LogLevel) *RecentLogs {
+	if capacity <= 0 {
+		capacity = 200
+	}
+	return &RecentLogs{
+		level:   level,
+		entries: make([]LogEntry, capacity),
+	}
+}
+
+func (r *RecentLogs) Fire(entry *LogEntry) error {
+	r.add(*entry)
+	return nil
+}
+
+func (r *RecentLogs) Levels() []LogLevel {
+	var levels []LogLevel
+	for level := r.level; level <= FATAL; level++ {
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// Entries returns the kept entries, newest first.
+func (r *RecentLogs) Entries() []LogEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := r.next
+	if r.full {
+		count = len(r.entries)
+	}
+	result := make([]LogEntry, 0, count)
+	for i := 1; i <= count; i++ {
+		result = append(result, r.entries[(r.next-i+len(r.entries))%len(r.entries)])
+	}
+	return result
+}
+
+// Core returns a zapcore.Core that records zap entries at the same level or above; tee it with
+// the logger's own core to keep writing to the usual output.
+func (r *RecentLogs) Core() zapcore.Core {
+	return &recentCore{logs: r}
+}
+
+func (r *RecentLogs) add(entry LogEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// zapLevels maps zap levels to the closest LogLevel; DPanic and Panic are logged as errors.
+var zapLevels = map[zapcore.Level]LogLevel{
+	zapcore.DebugLevel:  DEBUG,
+	zapcore.InfoLevel:   INFO,
+	zapcore.WarnLevel:   WARN,
+	zapcore.ErrorLevel:  ERROR,
+	zapcore.DPanicLevel: ERROR,
+	zapcore.PanicLevel:  ERROR,
+	zapcore.FatalLevel:  FATAL,
+}
+
+type recentCore struct {
+	logs   *RecentLogs
+	fields []zapcore.Field
+}
+
+func (c *recentCore) Enabled(level zapcore.Level) bool {
+	return zapLevels[level] >= c.logs.level
+}
+
+func (c *recentCore) With(fields []zapcore.Field) zapcore.Core {
+	return &recentCore{
+		logs:   c.logs,
+		fields: append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+func (c *recentCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *recentCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+
+	logEntry := LogEntry{
+		Timestamp: entry.Time,
+		Level:     levelNames[zapLevels[entry.Level]],
+		Message:   entry.Message,
+		Fields:    encoder.Fields,
+		Component: entry.LoggerName,
+	}
+	if message, ok := encoder.Fields["error"].(string); ok {
+		logEntry.Error = &ErrorInfo{Type: "error", Message: message}
+		delete(encoder.Fields, "error")
+	}
+	c.logs.add(logEntry)
+	return nil
+}
+
+func (c *recentCore) Sync() error {
+	return nil
+}
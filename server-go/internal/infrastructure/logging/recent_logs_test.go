@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRecentLogs_KeepsNewestEntriesFirst(t *testing.T) {
+	logs := NewRecentLogs(2, INFO)
+
+	for _, message := range []string{"first", "second", "third"} {
+		require.NoError(t, logs.Fire(&LogEntry{Level: "INFO", Message: message}))
+	}
+
+	entries := logs.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "third", entries[0].Message)
+	assert.Equal(t, "second", entries[1].Message)
+	assert.Equal(t, []LogLevel{INFO, WARN, ERROR, FATAL}, logs.Levels())
+}
+
+func TestRecentLogs_CoreRecordsZapEntries(t *testing.T) {
+	logs := NewRecentLogs(10, WARN)
+	logger := zap.New(logs.Core()).Named("queue").With(zap.String("queue", "default"))
+
+	logger.Info("ignored")
+	logger.Warn("slow consumer", zap.Int("pending", 3), zap.Error(errors.New("timeout")))
+
+	entries := logs.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "WARN", entries[0].Level)
+	assert.Equal(t, "slow consumer", entries[0].Message)
+	assert.Equal(t, "queue", entries[0].Component)
+	assert.Equal(t, map[string]interface{}{"queue": "default", "pending": int64(3)}, entries[0].Fields)
+	require.NotNil(t, entries[0].Error)
+	assert.Equal(t, "timeout", entries[0].Error.Message)
+}
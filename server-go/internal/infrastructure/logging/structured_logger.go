@@ -2,7 +2,6 @@ package logging
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,6 +10,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
 )
 
 type LogLevel int
@@ -33,6 +34,19 @@ var levelNames = map[LogLevel]string{
 	FATAL: "FATAL",
 }
 
+func (l LogLevel) String() string {
+	return levelNames[l]
+}
+
+func ParseLogLevel(name string) (LogLevel, error) {
+	for level, levelName := range levelNames {
+		if strings.EqualFold(levelName, name) {
+			return level, nil
+		}
+	}
+	return INFO, fmt.Errorf("unknown log level: %s", name)
+}
+
 type LogEntry struct {
 	Timestamp   time.Time              `json:"timestamp"`
 	Level       string                 `json:"level"`
@@ -85,6 +99,8 @@ type LoggerConfig struct {
 	ServiceVersion   string            `json:"service_version"`
 }
 
+//go:generate mockery --name LogHook --with-expecter --case underscore
+
 type LogHook interface {
 	Fire(entry *LogEntry) error
 	Levels() []LogLevel
@@ -166,13 +182,13 @@ func (f *FileRotationHook) Fire(entry *LogEntry) error {
 		f.currentFile = file
 	}
 	
-	data, err := json.Marshal(entry)
-	if err != nil {
+	buf := getEntryBuffer()
+	defer buf.free()
+	if err := buf.encode(entry); err != nil {
 		return err
 	}
 	
-	_, err = f.currentFile.Write(append(data, '\n'))
-	if err != nil {
+	if _, err := f.currentFile.Write(buf.bytes); err != nil {
 		return err
 	}
 	
@@ -199,7 +215,7 @@ func (f *FileRotationHook) rotateIfNeeded() error {
 		
 		f.currentFile = nil
 		
-		go f.cleanupOldBackups()
+		supervisor.Go("logging.backup_cleanup", f.cleanupOldBackups)
 	}
 	
 	return nil
@@ -300,6 +316,17 @@ func (sl *StructuredLogger) WithFields(fields map[string]interface{}) *Structure
 	return newLogger
 }
 
+type requestIDKey struct{}
+
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
 func (sl *StructuredLogger) WithContext(ctx context.Context) *StructuredLogger {
 	fields := make(map[string]interface{})
 	
@@ -309,7 +336,9 @@ func (sl *StructuredLogger) WithContext(ctx context.Context) *StructuredLogger {
 	if spanID := ctx.Value("span_id"); spanID != nil {
 		fields["span_id"] = spanID
 	}
-	if requestID := ctx.Value("request_id"); requestID != nil {
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		fields["request_id"] = requestID
+	} else if requestID := ctx.Value("request_id"); requestID != nil {
 		fields["request_id"] = requestID
 	}
 	if userID := ctx.Value("user_id"); userID != nil {
@@ -395,6 +424,11 @@ func (sl *StructuredLogger) logWithError(level LogLevel, message string, err err
 		Fields:    sl.buildFields(fields),
 	}
 	
+	if requestID, ok := entry.Fields["request_id"].(string); ok {
+		entry.RequestID = requestID
+		delete(entry.Fields, "request_id")
+	}
+	
 	if sl.config.EnableCaller {
 		entry.CallerInfo = sl.getCallerInfo(3)
 	}
@@ -512,21 +546,18 @@ func (sl *StructuredLogger) processEntry(entry *LogEntry) {
 		}
 	}
 	
-	var output []byte
-	var err error
-	
 	if sl.config.Format == "json" {
-		output, err = json.Marshal(entry)
-		if err != nil {
+		buf := getEntryBuffer()
+		defer buf.free()
+		if err := buf.encode(entry); err != nil {
 			fmt.Fprintf(os.Stderr, "JSON marshal error: %v\n", err)
 			return
 		}
-		output = append(output, '\n')
-	} else {
-		output = []byte(sl.formatText(entry))
+		sl.output.Write(buf.bytes)
+		return
 	}
 	
-	sl.output.Write(output)
+	sl.output.Write([]byte(sl.formatText(entry)))
 }
 
 func (sl *StructuredLogger) shouldFireHook(hook LogHook, entry *LogEntry) bool {
@@ -572,7 +603,7 @@ func (sl *StructuredLogger) formatText(entry *LogEntry) string {
 func (sl *StructuredLogger) startAsyncProcessor() {
 	sl.wg.Add(1)
 	
-	go func() {
+	supervisor.Go("logging.async_processor", func() {
 		defer sl.wg.Done()
 		
 		ticker := time.NewTicker(sl.config.FlushInterval)
@@ -602,7 +633,7 @@ func (sl *StructuredLogger) startAsyncProcessor() {
 				return
 			}
 		}
-	}()
+	})
 }
 
 func (sl *StructuredLogger) processBatch(batch []*LogEntry) {
@@ -0,0 +1,166 @@
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryBuffer_MatchesJSONMarshal(t *testing.T) {
+	duration := 1500 * time.Microsecond
+	entries := []*LogEntry{
+		{Timestamp: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), Level: "INFO", Message: "plain"},
+		{
+			Timestamp: time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.FixedZone("CET", 3600)),
+			Level:     "ERROR",
+			Message:   "quotes \" and \\ <html> & \n\r\t \x01 ñ \u2028 \xff",
+			Fields: map[string]interface{}{
+				"string": "value", "int": 42, "int64": int64(-7), "uint": uint(7), "float": 3.25,
+				"small": 1e-9, "large": 1e22, "nan": "NaN", "bool": true, "nil": nil,
+				"duration": time.Second, "slice": []string{"a", "b"}, "nested": map[string]int{"b": 2, "a": 1},
+			},
+			CallerInfo:  &CallerInfo{File: "main.go", Line: 10, Function: "main.main"},
+			TraceID:     "trace",
+			SpanID:      "span",
+			Error:       &ErrorInfo{Type: "*errors.errorString", Message: "boom", StackTrace: "goroutine 1"},
+			Duration:    &duration,
+			RequestID:   "request",
+			UserID:      "user",
+			SessionID:   "session",
+			Component:   "grpc",
+			Operation:   "ListIdeas",
+			Environment: "test",
+		},
+		{Timestamp: time.Unix(0, 0).UTC(), Level: "WARN", Message: "", Fields: map[string]interface{}{}},
+	}
+
+	for _, entry := range entries {
+		expected, err := json.Marshal(entry)
+		require.NoError(t, err)
+
+		buf := getEntryBuffer()
+		require.NoError(t, buf.encode(entry))
+		assert.Equal(t, string(expected)+"\n", string(buf.bytes))
+		buf.free()
+	}
+}
+
+func TestEntryBuffer_ReportsMarshalErrors(t *testing.T) {
+	buf := getEntryBuffer()
+	defer buf.free()
+
+	err := buf.encode(&LogEntry{Timestamp: time.Now(), Fields: map[string]interface{}{"value": math.NaN()}})
+	assert.Error(t, err)
+}
+
+func newBenchLogger(format string) *StructuredLogger {
+	return NewStructuredLogger(LoggerConfig{
+		Level:       INFO,
+		Format:      format,
+		Output:      io.Discard,
+		ServiceName: "notebook-server",
+		Environment: "bench",
+	})
+}
+
+func benchFields() map[string]interface{} {
+	return map[string]interface{}{
+		"request_id":  "0b6c8f0e-3c1a-4f0e-9a51-6f1f2d7b9c10",
+		"method":      "/notebook.NotebookService/ListIdeas",
+		"duration_ms": int64(12),
+		"code":        "OK",
+	}
+}
+
+func BenchmarkStructuredLogger_InfoJSON(b *testing.B) {
+	logger := newBenchLogger("json")
+	fields := benchFields()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("gRPC call completed", fields)
+	}
+}
+
+func BenchmarkStructuredLogger_InfoText(b *testing.B) {
+	logger := newBenchLogger("text")
+	fields := benchFields()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("gRPC call completed", fields)
+	}
+}
+
+func BenchmarkStructuredLogger_Error(b *testing.B) {
+	logger := newBenchLogger("json")
+	fields := benchFields()
+	err := errors.New("idea not found")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Error("gRPC call failed", err, fields)
+	}
+}
+
+func BenchmarkStructuredLogger_Filtered(b *testing.B) {
+	logger := newBenchLogger("json")
+	fields := benchFields()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Debug("below the configured level", fields)
+	}
+}
+
+func BenchmarkLogEntry_Marshal(b *testing.B) {
+	duration := 12 * time.Millisecond
+	entry := &LogEntry{
+		Timestamp: time.Now(),
+		Level:     "INFO",
+		Message:   "gRPC call completed",
+		Fields:    benchFields(),
+		RequestID: "0b6c8f0e-3c1a-4f0e-9a51-6f1f2d7b9c10",
+		Duration:  &duration,
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(entry); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLogEntry_Encode(b *testing.B) {
+	duration := 12 * time.Millisecond
+	entry := &LogEntry{
+		Timestamp: time.Now(),
+		Level:     "INFO",
+		Message:   "gRPC call completed",
+		Fields:    benchFields(),
+		RequestID: "0b6c8f0e-3c1a-4f0e-9a51-6f1f2d7b9c10",
+		Duration:  &duration,
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf := getEntryBuffer()
+		if err := buf.encode(entry); err != nil {
+			b.Fatal(err)
+		}
+		buf.free()
+	}
+}
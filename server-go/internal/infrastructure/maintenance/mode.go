@@ -0,0 +1,223 @@
+package maintenance
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrorReason is the ErrorInfo reason of the calls rejected during maintenance.
+const ErrorReason = "MAINTENANCE"
+
+// DefaultReadPrefixes are the method name prefixes treated as reads, which
+// keep working during maintenance.
+var DefaultReadPrefixes = []string{"Get", "List", "Watch", "Subscribe", "SemanticSearch", "Download"}
+
+type Config struct {
+	// ReadPrefixes classify methods by their name, without the service. Defaults to DefaultReadPrefixes.
+	ReadPrefixes []string `json:"read_prefixes"`
+	// Methods overrides the classification per full method name: true for reads, false for writes.
+	Methods map[string]bool `json:"methods"`
+	// DefaultRetryAfter is the retry hint when Enable is given none.
+	DefaultRetryAfter time.Duration `json:"default_retry_after"`
+	// ErrorDomain is the ErrorInfo domain of rejected calls.
+	ErrorDomain string `json:"error_domain"`
+}
+
+type State struct {
+	Enabled    bool          `json:"enabled"`
+	Message    string        `json:"message"`
+	RetryAfter time.Duration `json:"retry_after"`
+	ChangedAt  time.Time     `json:"changed_at"`
+}
+
+// Mode is the server-wide read-only switch. While enabled, writes fail with
+// Unavailable and a retry hint, reads keep working and every change is
+// broadcast to subscribers such as notification streams and the job registry.
+type Mode struct {
+	config Config
+
+	mu          sync.RWMutex
+	state       State
+	subscribers map[chan State]struct{}
+	onChange    []func(State)
+	rejected    int64
+}
+
+func NewMode(config Config) *Mode {
+	if config.ReadPrefixes == nil {
+		config.ReadPrefixes = DefaultReadPrefixes
+	}
+	if config.Methods == nil {
+		config.Methods = make(map[string]bool)
+	}
+	if config.DefaultRetryAfter <= 0 {
+		config.DefaultRetryAfter = 5 * time.Minute
+	}
+	if config.ErrorDomain == "" {
+		config.ErrorDomain = "notebook"
+	}
+
+	return &Mode{
+		config:      config,
+		subscribers: make(map[chan State]struct{}),
+	}
+}
+
+// Enable switches to read-only. message is shown to users; retryAfter is the
+// hint returned to rejected writes and zero uses Config.DefaultRetryAfter.
+func (m *Mode) Enable(message string, retryAfter time.Duration) State {
+	if retryAfter <= 0 {
+		retryAfter = m.config.DefaultRetryAfter
+	}
+	return m.set(State{Enabled: true, Message: message, RetryAfter: retryAfter, ChangedAt: time.Now()})
+}
+
+func (m *Mode) Disable() State {
+	return m.set(State{ChangedAt: time.Now()})
+}
+
+func (m *Mode) set(state State) State {
+	m.mu.Lock()
+	m.state = state
+	callbacks := append([]func(State){}, m.onChange...)
+	for ch := range m.subscribers {
+		// Only the latest state matters, so a subscriber that has not read the previous one gets it replaced
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- state
+	}
+	m.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(state)
+	}
+	return state
+}
+
+func (m *Mode) State() State {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state
+}
+
+func (m *Mode) Enabled() bool {
+	return m.State().Enabled
+}
+
+// OnChange registers a callback run after every change, outside the lock.
+func (m *Mode) OnChange(callback func(State)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onChange = append(m.onChange, callback)
+}
+
+// Subscribe returns a channel that receives every later change until ctx is done.
+func (m *Mode) Subscribe(ctx context.Context) <-chan State {
+	ch := make(chan State, 1)
+
+	m.mu.Lock()
+	m.subscribers[ch] = struct{}{}
+	m.mu.Unlock()
+
+	supervisor.Go("maintenance.subscriber", func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		delete(m.subscribers, ch)
+		m.mu.Unlock()
+	})
+	return ch
+}
+
+// IsRead reports whether the full method name is a read that keeps working during maintenance.
+func (m *Mode) IsRead(fullMethod string) bool {
+	if read, ok := m.config.Methods[fullMethod]; ok {
+		return read
+	}
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range m.config.ReadPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mode) check(fullMethod string) error {
+	state := m.State()
+	if !state.Enabled || m.IsRead(fullMethod) {
+		return nil
+	}
+
+	m.mu.Lock()
+	m.rejected++
+	m.mu.Unlock()
+
+	message := "server is in maintenance, writes are temporarily disabled"
+	if state.Message != "" {
+		message = state.Message
+	}
+	st := status.New(codes.Unavailable, message)
+	if detailed, err := st.WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(state.RetryAfter)},
+		&errdetails.ErrorInfo{Reason: ErrorReason, Domain: m.config.ErrorDomain},
+	); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (m *Mode) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := m.check(info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (m *Mode) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := m.check(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// Metrics is a metrics.MetricsCollector collector for the mode and the writes it rejected.
+func (m *Mode) Metrics() []metrics.Metric {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	enabled := 0.0
+	if m.state.Enabled {
+		enabled = 1
+	}
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "maintenance_mode_enabled", Type: metrics.Gauge, Value: enabled, Timestamp: now},
+		{Name: "maintenance_rejected_calls_total", Type: metrics.Counter, Value: float64(m.rejected), Timestamp: now},
+	}
+}
@@ -0,0 +1,80 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptorRejectsWritesOnly(t *testing.T) {
+	mode := NewMode(Config{})
+	interceptor := mode.UnaryInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	if err := call("/notebook.NotebookService/CreateIdea"); err != nil {
+		t.Fatalf("write rejected while maintenance is disabled: %v", err)
+	}
+
+	mode.Enable("upgrading the database", 2*time.Minute)
+	if err := call("/notebook.NotebookService/ListIdeas"); err != nil {
+		t.Fatalf("read rejected during maintenance: %v", err)
+	}
+
+	st, _ := status.FromError(call("/notebook.NotebookService/CreateIdea"))
+	if st.Code() != codes.Unavailable || st.Message() != "upgrading the database" {
+		t.Fatalf("write error = %v, want Unavailable with the maintenance message", st.Err())
+	}
+	var retry *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() != 2*time.Minute {
+		t.Fatalf("RetryInfo = %v, want 2m", retry)
+	}
+
+	mode.Disable()
+	if err := call("/notebook.NotebookService/CreateIdea"); err != nil {
+		t.Fatalf("write rejected after maintenance ended: %v", err)
+	}
+}
+
+func TestSubscribersAndCallbacksReceiveChanges(t *testing.T) {
+	mode := NewMode(Config{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var paused bool
+	mode.OnChange(func(state State) { paused = state.Enabled })
+	changes := mode.Subscribe(ctx)
+
+	mode.Enable("", 0)
+	if !paused {
+		t.Error("OnChange callback not run on Enable")
+	}
+	select {
+	case state := <-changes:
+		if !state.Enabled || state.RetryAfter != 5*time.Minute {
+			t.Fatalf("state = %+v, want enabled with the default retry hint", state)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscriber did not receive the change")
+	}
+
+	// An unread change is replaced by the latest one instead of blocking
+	mode.Disable()
+	mode.Enable("again", time.Minute)
+	if state := <-changes; state.Message != "again" {
+		t.Fatalf("state = %+v, want the latest change", state)
+	}
+}
@@ -36,6 +36,26 @@ type MetricsCollector struct {
 	enabled     int32
 	flushTicker *time.Ticker
 	stopCh      chan struct{}
+
+	externalFlush bool
+	spawn         func(name string, fn func())
+}
+
+type CollectorOption func(*MetricsCollector)
+
+// WithExternalFlush disables the internal flush goroutine; the caller schedules FlushOldMetrics.
+func WithExternalFlush() CollectorOption {
+	return func(mc *MetricsCollector) {
+		mc.externalFlush = true
+	}
+}
+
+// WithSpawn starts the flush goroutine through spawn, normally supervisor.Go;
+// the supervisor imports this package, so it cannot be called directly.
+func WithSpawn(spawn func(name string, fn func())) CollectorOption {
+	return func(mc *MetricsCollector) {
+		mc.spawn = spawn
+	}
 }
 
 type CounterMetric struct {
@@ -57,14 +77,21 @@ type HistogramMetric struct {
 	mu      sync.RWMutex
 }
 
-func NewMetricsCollector() *MetricsCollector {
+func NewMetricsCollector(options ...CollectorOption) *MetricsCollector {
 	mc := &MetricsCollector{
 		enabled: 1,
 		stopCh:  make(chan struct{}),
+		spawn:   func(_ string, fn func()) { go fn() },
+	}
+	
+	for _, option := range options {
+		option(mc)
 	}
 	
 	mc.registerDefaultCollectors()
-	mc.startPeriodicFlush()
+	if !mc.externalFlush {
+		mc.startPeriodicFlush()
+	}
 	
 	return mc
 }
@@ -305,7 +332,7 @@ func (mc *MetricsCollector) RegisterCollector(collector func() []Metric) {
 func (mc *MetricsCollector) startPeriodicFlush() {
 	mc.flushTicker = time.NewTicker(30 * time.Second)
 	
-	go func() {
+	mc.spawn("metrics.flush", func() {
 		for {
 			select {
 			case <-mc.flushTicker.C:
@@ -314,7 +341,11 @@ func (mc *MetricsCollector) startPeriodicFlush() {
 				return
 			}
 		}
-	}()
+	})
+}
+
+func (mc *MetricsCollector) FlushOldMetrics() {
+	mc.flushOldMetrics()
 }
 
 func (mc *MetricsCollector) flushOldMetrics() {
@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// OverflowLabelValue replaces the values of a label once it reached RPCConfig.MaxLabelValues.
+const OverflowLabelValue = "other"
+
+// UnknownLabelValue is the value of a label the request does not carry.
+const UnknownLabelValue = "unknown"
+
+// LabelFunc derives labels from the request context. The values must come
+// from a small set, such as roles or app versions, never user or request IDs.
+type LabelFunc func(ctx context.Context) map[string]string
+
+type RPCConfig struct {
+	// Labels are added to every request metric besides method and code.
+	Labels []LabelFunc `json:"-"`
+	// MaxLabelValues caps the distinct values kept per label; later values are reported as OverflowLabelValue.
+	MaxLabelValues int `json:"max_label_values"`
+}
+
+// RPCMetrics records the count and latency of every call, sliced by method,
+// status code and the configured request labels.
+type RPCMetrics struct {
+	collector *MetricsCollector
+	config    RPCConfig
+
+	mu     sync.Mutex
+	values map[string]map[string]struct{}
+}
+
+func NewRPCMetrics(collector *MetricsCollector, config RPCConfig) *RPCMetrics {
+	if config.MaxLabelValues <= 0 {
+		config.MaxLabelValues = 50
+	}
+
+	return &RPCMetrics{
+		collector: collector,
+		config:    config,
+		values:    make(map[string]map[string]struct{}),
+	}
+}
+
+func (r *RPCMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		r.record(ctx, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+func (r *RPCMetrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		r.record(stream.Context(), info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+func (r *RPCMetrics) record(ctx context.Context, fullMethod string, err error, elapsed time.Duration) {
+	labels := r.labels(ctx)
+	labels["method"] = fullMethod
+	r.collector.ObserveHistogram("grpc_server_request_duration_seconds", elapsed.Seconds(), labels)
+
+	counted := make(map[string]string, len(labels)+1)
+	for key, value := range labels {
+		counted[key] = value
+	}
+	counted["code"] = status.Code(err).String()
+	r.collector.IncrementCounter("grpc_server_requests_total", counted)
+}
+
+func (r *RPCMetrics) labels(ctx context.Context) map[string]string {
+	labels := make(map[string]string)
+	for _, labelFunc := range r.config.Labels {
+		for key, value := range labelFunc(ctx) {
+			labels[key] = r.bound(key, value)
+		}
+	}
+	return labels
+}
+
+// bound keeps the first MaxLabelValues values of each label so that a
+// misbehaving client cannot create unlimited series.
+func (r *RPCMetrics) bound(key, value string) string {
+	if value == "" {
+		return UnknownLabelValue
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen, ok := r.values[key]
+	if !ok {
+		seen = make(map[string]struct{})
+		r.values[key] = seen
+	}
+	if _, ok := seen[value]; ok {
+		return value
+	}
+	if len(seen) >= r.config.MaxLabelValues {
+		return OverflowLabelValue
+	}
+	seen[value] = struct{}{}
+	return value
+}
+
+// MetadataLabel reads label from the incoming metadata key, passed through
+// normalize when it is not nil.
+func MetadataLabel(key, label string, normalize func(string) string) LabelFunc {
+	return func(ctx context.Context) map[string]string {
+		var value string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(key); len(values) > 0 {
+				value = strings.TrimSpace(values[0])
+			}
+		}
+		if normalize != nil && value != "" {
+			value = normalize(value)
+		}
+		return map[string]string{label: value}
+	}
+}
+
+// MajorMinorVersion reduces a version such as "2.14.3-beta+45" to "2.14", so
+// patch releases and builds share a series. Values that are not a version are
+// reported as UnknownLabelValue.
+func MajorMinorVersion(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+ "); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	for _, part := range parts {
+		if part == "" || len(part) > 4 || strings.Trim(part, "0123456789") != "" {
+			return UnknownLabelValue
+		}
+	}
+	return strings.Join(parts, ".")
+}
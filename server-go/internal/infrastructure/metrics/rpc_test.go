@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMajorMinorVersion(t *testing.T) {
+	tests := map[string]string{
+		"2.14.3":         "2.14",
+		"v3.0.1-beta+45": "3.0",
+		"7":              "7",
+		"":               UnknownLabelValue,
+		"latest":         UnknownLabelValue,
+		"1.2.x":          "1.2",
+		"12345.1":        UnknownLabelValue,
+	}
+	for version, want := range tests {
+		if got := MajorMinorVersion(version); got != want {
+			t.Errorf("MajorMinorVersion(%q) = %q, want %q", version, got, want)
+		}
+	}
+}
+
+func TestRPCMetricsBoundsLabelValues(t *testing.T) {
+	collector := NewMetricsCollector(WithExternalFlush())
+	defer collector.Stop()
+	rpc := NewRPCMetrics(collector, RPCConfig{
+		Labels:         []LabelFunc{MetadataLabel("x-client-version", "client_version", MajorMinorVersion)},
+		MaxLabelValues: 2,
+	})
+	interceptor := rpc.UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/notebook.NotebookService/ListIdeas"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	for _, version := range []string{"1.0.0", "1.0.5", "1.1.0", "2.0.0", ""} {
+		ctx := context.Background()
+		if version != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-client-version", version))
+		}
+		interceptor(ctx, nil, info, handler)
+	}
+
+	counts := make(map[string]float64)
+	for _, metric := range collector.GetAllMetrics() {
+		// Metric names carry their labels as a suffix of the key
+		if strings.HasPrefix(metric.Name, "grpc_server_requests_total") {
+			counts[metric.Labels["client_version"]] += metric.Value
+		}
+	}
+	want := map[string]float64{"1.0": 2, "1.1": 1, OverflowLabelValue: 1, UnknownLabelValue: 1}
+	for version, count := range want {
+		if counts[version] != count {
+			t.Errorf("requests with client_version %q = %v, want %v (all: %v)", version, counts[version], count, counts)
+		}
+	}
+}
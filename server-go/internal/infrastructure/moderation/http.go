@@ -0,0 +1,95 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// HTTPConfig configures an external moderation API.
+type HTTPConfig struct {
+	// Endpoint receives {"text", "blocked_terms"} as a JSON POST and must answer
+	// {"flagged": true, "reason": "harassment", "matches": ["..."]}.
+	Endpoint string
+	// APIKey, when set, is sent as a bearer token.
+	APIKey  string
+	Timeout time.Duration
+}
+
+// HTTPModerator delegates moderation to an external service.
+type HTTPModerator struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewHTTPModerator(config HTTPConfig) *HTTPModerator {
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+	return &HTTPModerator{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (m *HTTPModerator) Name() string {
+	return "http"
+}
+
+type moderateRequest struct {
+	Text         string   `json:"text"`
+	BlockedTerms []string `json:"blocked_terms,omitempty"`
+}
+
+type moderateResponse struct {
+	Flagged bool     `json:"flagged"`
+	Reason  string   `json:"reason"`
+	Matches []string `json:"matches"`
+}
+
+func (m *HTTPModerator) Moderate(ctx context.Context, text string, policy entities.ModerationPolicy) (*entities.ModerationVerdict, error) {
+	body, err := json.Marshal(moderateRequest{Text: text, BlockedTerms: policy.BlockedTerms})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.config.APIKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("moderation API returned %s", resp.Status)
+	}
+
+	var decoded moderateResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid moderation response: %w", err)
+	}
+
+	verdict := &entities.ModerationVerdict{Flagged: decoded.Flagged, Matches: decoded.Matches, Moderator: m.Name()}
+	if decoded.Flagged {
+		verdict.Reason = decoded.Reason
+		if verdict.Reason == "" {
+			verdict.Reason = "flagged"
+		}
+	}
+	return verdict, nil
+}
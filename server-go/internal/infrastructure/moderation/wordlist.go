@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// ProfanityReason is the verdict reason of WordlistModerator.
+const ProfanityReason = "profanity"
+
+// DefaultBlockedTerms are a small list of English and Spanish slurs and
+// insults; deployments usually replace it with WordlistConfig.Terms.
+var DefaultBlockedTerms = []string{
+	"fuck", "fucking", "motherfucker", "shit", "bullshit", "asshole", "bitch", "bastard", "cunt", "dickhead", "retard",
+	"mierda", "puta", "puto", "cabrón", "gilipollas", "pendejo", "hijo de puta", "coño", "maricón", "imbécil",
+}
+
+// folded maps accented letters to their base letter, and the digits and
+// symbols commonly used to dodge word filters to the letter they stand for.
+var folded = map[rune]rune{
+	'á': 'a', 'à': 'a', 'ä': 'a', 'â': 'a', 'é': 'e', 'è': 'e', 'ë': 'e', 'ê': 'e',
+	'í': 'i', 'ì': 'i', 'ï': 'i', 'î': 'i', 'ó': 'o', 'ò': 'o', 'ö': 'o', 'ô': 'o',
+	'ú': 'u', 'ù': 'u', 'ü': 'u', 'û': 'u', 'ñ': 'n', 'ç': 'c',
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '@': 'a', '$': 's', '!': 'i',
+}
+
+type WordlistConfig struct {
+	// Terms are the blocked words and phrases; nil uses DefaultBlockedTerms.
+	Terms []string
+}
+
+// WordlistModerator flags texts containing blocked words or phrases. Matching is
+// case, accent and leetspeak insensitive and on whole words, so "class" does
+// not match "ass". It needs no external services.
+type WordlistModerator struct {
+	terms []string
+}
+
+func NewWordlistModerator(config WordlistConfig) *WordlistModerator {
+	if config.Terms == nil {
+		config.Terms = DefaultBlockedTerms
+	}
+	return &WordlistModerator{terms: normalizeTerms(config.Terms)}
+}
+
+func (m *WordlistModerator) Name() string {
+	return "wordlist"
+}
+
+// Moderate checks text against the configured terms and the policy's BlockedTerms.
+func (m *WordlistModerator) Moderate(ctx context.Context, text string, policy entities.ModerationPolicy) (*entities.ModerationVerdict, error) {
+	verdict := &entities.ModerationVerdict{Moderator: m.Name()}
+
+	// Surrounding spaces make every term match on word boundaries
+	normalized := " " + strings.Join(normalize(text), " ") + " "
+	seen := make(map[string]bool)
+	for _, terms := range [][]string{m.terms, normalizeTerms(policy.BlockedTerms)} {
+		for _, term := range terms {
+			if !seen[term] && strings.Contains(normalized, " "+term+" ") {
+				seen[term] = true
+				verdict.Matches = append(verdict.Matches, term)
+			}
+		}
+	}
+	if len(verdict.Matches) > 0 {
+		sort.Strings(verdict.Matches)
+		verdict.Flagged = true
+		verdict.Reason = ProfanityReason
+	}
+	return verdict, nil
+}
+
+func normalizeTerms(terms []string) []string {
+	result := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if words := normalize(term); len(words) > 0 {
+			result = append(result, strings.Join(words, " "))
+		}
+	}
+	return result
+}
+
+// normalize lowercases text, strips accents, undoes leetspeak and splits it
+// into words.
+func normalize(text string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if replacement, ok := folded[r]; ok {
+			r = replacement
+		}
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
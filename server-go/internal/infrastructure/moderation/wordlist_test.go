@@ -0,0 +1,37 @@
+package moderation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+func TestWordlistModerator(t *testing.T) {
+	moderator := NewWordlistModerator(WordlistConfig{Terms: []string{"ass", "hijo de puta", "Cabrón"}})
+	policy := entities.ModerationPolicy{Enabled: true, BlockedTerms: []string{"competitor"}}
+
+	tests := []struct {
+		name    string
+		text    string
+		matches []string
+	}{
+		{"clean", "Plan the class trip to Madrid", nil},
+		{"whole word", "What an ASS.", []string{"ass"}},
+		{"accents and leetspeak", "eres un c4bron", []string{"cabron"}},
+		{"phrase", "hijo  de\nputa", []string{"hijo de puta"}},
+		{"policy terms", "Switch to Competitor, ass", []string{"ass", "competitor"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := moderator.Moderate(context.Background(), tt.text, policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verdict.Flagged != (tt.matches != nil) || !reflect.DeepEqual(verdict.Matches, tt.matches) {
+				t.Errorf("Moderate(%q) = %+v, want matches %v", tt.text, verdict, tt.matches)
+			}
+		})
+	}
+}
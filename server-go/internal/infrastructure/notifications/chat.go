@@ -0,0 +1,197 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+	"github.com/google/uuid"
+)
+
+// ChatActionCompleteReminder is the action behind the "Complete" button of reminder notifications.
+const ChatActionCompleteReminder = "complete_reminder"
+
+// DefaultChatTemplates are the message templates keyed by notification type;
+// the "" entry is used for types without their own template. Title, Message
+// and Metadata values are already escaped for the provider, and {{.T "text"}}
+// translates text to the locale the notification was rendered in.
+var DefaultChatTemplates = map[string]string{
+	"":                   "{{bold .Title}}{{if .Message}}\n{{.Message}}{{end}}",
+	"reminder_overdue":   "⏰ {{.T \"Overdue reminder\"}}: {{bold .Title}}{{if .Message}}\n{{.Message}}{{end}}",
+	"reminder_escalated": "🚨 {{.T \"Unacknowledged reminder\"}}: {{bold .Title}}{{if .Message}}\n{{.Message}}{{end}}",
+	"file_missing":       "⚠️ {{bold .Title}}\n{{.Message}}",
+}
+
+var errChatSubscriptions = errors.New("chat notifier does not support subscriptions")
+
+// ChatMessage is a notification rendered for a chat provider.
+type ChatMessage struct {
+	Text    string
+	Buttons []ChatButton
+}
+
+// ChatButton is an interactive button; Action and Value come back in the callback.
+type ChatButton struct {
+	Label  string
+	Action string
+	Value  string
+}
+
+//go:generate mockery --name ChatSender --with-expecter --case underscore
+
+// ChatSender delivers messages to the chats of one provider.
+type ChatSender interface {
+	Provider() entities.ChatProvider
+	// Escape makes text safe for the provider's markup.
+	Escape(text string) string
+	Bold(text string) string
+	Send(ctx context.Context, chatID string, message ChatMessage) error
+}
+
+type chatTemplateData struct {
+	Title    string
+	Message  string
+	Type     string
+	Metadata map[string]string
+
+	translator *i18n.Translator
+	locale     string
+	escape     func(string) string
+}
+
+func (d chatTemplateData) T(text string) string {
+	if d.translator != nil {
+		text = d.translator.Translate(d.locale, text)
+	}
+	return d.escape(text)
+}
+
+// ChatNotifier is an outbound ports.NotificationService that delivers each
+// notification to the Slack and Telegram chats bound to the user. A
+// notification addressed to specific channels only reaches a chat when the
+// provider name ("slack", "telegram") is one of them.
+type ChatNotifier struct {
+	bindings   ports.ChatBindingRepository
+	senders    map[entities.ChatProvider]ChatSender
+	templates  map[entities.ChatProvider]*template.Template
+	translator *i18n.Translator
+}
+
+// NewChatNotifier uses DefaultChatTemplates when templates is nil; translator
+// may be nil, in which case texts are sent in English.
+func NewChatNotifier(bindings ports.ChatBindingRepository, templates map[string]string, translator *i18n.Translator, senders ...ChatSender) (*ChatNotifier, error) {
+	if templates == nil {
+		templates = DefaultChatTemplates
+	}
+
+	n := &ChatNotifier{
+		bindings:   bindings,
+		senders:    make(map[entities.ChatProvider]ChatSender),
+		templates:  make(map[entities.ChatProvider]*template.Template),
+		translator: translator,
+	}
+	for _, sender := range senders {
+		root := template.New("").Funcs(template.FuncMap{"bold": sender.Bold})
+		for notificationType, text := range templates {
+			if _, err := root.New(notificationType).Parse(text); err != nil {
+				return nil, fmt.Errorf("invalid chat template %q: %w", notificationType, err)
+			}
+		}
+		n.senders[sender.Provider()] = sender
+		n.templates[sender.Provider()] = root
+	}
+	return n, nil
+}
+
+func (n *ChatNotifier) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	bindings, err := n.bindings.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	notification := ports.Notification{Title: title, Message: message, Type: notificationType, Channels: channels, Metadata: metadata}
+	var errs []error
+	for _, binding := range bindings {
+		sender, ok := n.senders[binding.Provider]
+		if !ok || !binding.IsLinked() || !notification.MatchesChannels([]string{string(binding.Provider)}) {
+			continue
+		}
+
+		rendered, err := n.render(sender, notification)
+		if err != nil {
+			return err
+		}
+		if err := sender.Send(ctx, binding.ChatID, rendered); err != nil {
+			errs = append(errs, fmt.Errorf("%s chat %s: %w", binding.Provider, binding.ChatID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *ChatNotifier) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	return nil, errChatSubscriptions
+}
+
+func (n *ChatNotifier) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func (n *ChatNotifier) render(sender ChatSender, notification ports.Notification) (ChatMessage, error) {
+	root := n.templates[sender.Provider()]
+	tmpl := root.Lookup(notification.Type)
+	if tmpl == nil {
+		tmpl = root.Lookup("")
+	}
+	if tmpl == nil {
+		return ChatMessage{}, fmt.Errorf("no chat template for notification type %q", notification.Type)
+	}
+
+	data := chatTemplateData{
+		Title:      sender.Escape(notification.Title),
+		Message:    sender.Escape(notification.Message),
+		Type:       notification.Type,
+		Metadata:   make(map[string]string, len(notification.Metadata)),
+		translator: n.translator,
+		locale:     notification.Metadata[i18n.MetadataLocale],
+		escape:     sender.Escape,
+	}
+	for key, value := range notification.Metadata {
+		data.Metadata[key] = sender.Escape(value)
+	}
+
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, data); err != nil {
+		return ChatMessage{}, fmt.Errorf("failed to render chat template %q: %w", tmpl.Name(), err)
+	}
+	return ChatMessage{Text: text.String(), Buttons: n.buttons(notification, data.locale)}, nil
+}
+
+// buttons offers to complete the reminder of reminder notifications.
+func (n *ChatNotifier) buttons(notification ports.Notification, locale string) []ChatButton {
+	reminderID := notification.Metadata["reminder_id"]
+	if reminderID == "" || !strings.HasPrefix(notification.Type, "reminder_") {
+		return nil
+	}
+
+	label := "Complete"
+	if n.translator != nil {
+		label = n.translator.Translate(locale, label)
+	}
+	return []ChatButton{{Label: label, Action: ChatActionCompleteReminder, Value: reminderID}}
+}
+
+// EncodeChatAction packs a button into the callback data of providers that
+// only carry a single string (Telegram allows 64 bytes).
+func EncodeChatAction(action, value string) string {
+	return action + ":" + value
+}
+
+func ParseChatAction(data string) (action, value string, ok bool) {
+	return strings.Cut(data, ":")
+}
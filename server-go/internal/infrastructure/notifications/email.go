@@ -0,0 +1,72 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// EmailChannel is the notification channel name that selects email delivery.
+const EmailChannel = "email"
+
+// Metadata keys with a prepared email body. Notifications without them are
+// sent with the title as subject and the message as body.
+const (
+	MetadataEmailHTML = "email_html"
+	MetadataEmailText = "email_text"
+)
+
+var errEmailSubscriptions = errors.New("email notifier does not support subscriptions")
+
+// EmailNotifier is an outbound ports.NotificationService that emails
+// notifications that explicitly list the "email" channel to the address the
+// user registered.
+type EmailNotifier struct {
+	preferences ports.EmailPreferenceRepository
+	sender      ports.EmailSender
+}
+
+func NewEmailNotifier(preferences ports.EmailPreferenceRepository, sender ports.EmailSender) *EmailNotifier {
+	return &EmailNotifier{preferences: preferences, sender: sender}
+}
+
+func (n *EmailNotifier) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	if !containsChannel(channels, EmailChannel) {
+		return nil
+	}
+
+	preference, err := n.preferences.Get(ctx, userID)
+	if errors.Is(err, entities.ErrEmailPreferenceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	email := ports.EmailMessage{
+		To:      preference.Address,
+		Subject: title,
+		HTML:    metadata[MetadataEmailHTML],
+		Text:    metadata[MetadataEmailText],
+	}
+	if email.Text == "" {
+		email.Text = message
+	}
+	if email.HTML == "" {
+		email.HTML = "<p>" + strings.ReplaceAll(html.EscapeString(email.Text), "\n", "<br>") + "</p>"
+	}
+	return n.sender.SendEmail(ctx, email)
+}
+
+func (n *EmailNotifier) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	return nil, errEmailSubscriptions
+}
+
+func (n *EmailNotifier) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
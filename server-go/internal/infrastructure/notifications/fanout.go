@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+// Fanout is a ports.NotificationService that delivers every notification
+// through each of its services, so the hub can have several outbound
+// channels. Subscriptions are handled by the first service.
+type Fanout []ports.NotificationService
+
+func (f Fanout) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	var errs []error
+	for _, service := range f {
+		errs = append(errs, service.SendNotification(ctx, userID, title, message, notificationType, channels, metadata))
+	}
+	return errors.Join(errs...)
+}
+
+func (f Fanout) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	if len(f) == 0 {
+		return nil, errors.New("no notification services")
+	}
+	return f[0].SubscribeToNotifications(ctx, userID, channels)
+}
+
+func (f Fanout) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	if len(f) == 0 {
+		return nil
+	}
+	return f[0].UnsubscribeFromNotifications(ctx, userID)
+}
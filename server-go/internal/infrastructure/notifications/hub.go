@@ -0,0 +1,243 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"github.com/google/uuid"
+)
+
+type HubConfig struct {
+	// BufferSize is the number of undelivered notifications a subscriber may
+	// accumulate before it is treated as a slow consumer and evicted.
+	BufferSize int `json:"buffer_size"`
+	// Outbound, when set, receives every notification after local fan-out
+	// (push, email, webhooks). Its own subscriptions are not used.
+	Outbound ports.NotificationService `json:"-"`
+	// Inbox, when set, persists every notification so reconnecting clients can resume.
+	Inbox ports.NotificationInbox `json:"-"`
+	Clock entities.Clock          `json:"-"`
+	IDs   entities.IDGenerator    `json:"-"`
+	// OnEvict is called after a slow subscriber has been disconnected.
+	OnEvict func(userID uuid.UUID) `json:"-"`
+}
+
+// Hub is an in-process ports.NotificationService that fans notifications out
+// to the streams subscribed by each user. Subscribers have bounded buffers:
+// one that falls behind is evicted (its channel is closed) instead of
+// blocking delivery to everybody else.
+type Hub struct {
+	config      HubConfig
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[*subscriber]struct{}
+
+	delivered atomic.Int64
+	dropped   atomic.Int64
+	evicted   atomic.Int64
+}
+
+type subscriber struct {
+	userID   uuid.UUID
+	channels []string
+	since    time.Time
+	ch       chan ports.Notification
+	stop     func() bool
+	closed   bool
+}
+
+func NewHub(config HubConfig) *Hub {
+	if config.BufferSize == 0 {
+		config.BufferSize = 64
+	}
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+	if config.IDs == nil {
+		config.IDs = entities.UUIDGenerator{}
+	}
+
+	return &Hub{
+		config:      config,
+		subscribers: make(map[uuid.UUID]map[*subscriber]struct{}),
+	}
+}
+
+func (h *Hub) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	notification := ports.Notification{
+		ID:        h.config.IDs.NewID(),
+		Title:     title,
+		Message:   message,
+		Type:      notificationType,
+		UserID:    userID,
+		Channels:  channels,
+		Metadata:  metadata,
+		CreatedAt: h.config.Clock.Now(),
+	}
+
+	// Persisted before fan-out so a client that resumes from this notification finds it
+	var inboxErr, outboundErr error
+	if h.config.Inbox != nil {
+		inboxErr = h.config.Inbox.Append(ctx, notification)
+	}
+
+	h.publish(notification)
+
+	if h.config.Outbound != nil {
+		outboundErr = h.config.Outbound.SendNotification(ctx, userID, title, message, notificationType, channels, metadata)
+	}
+	return errors.Join(inboxErr, outboundErr)
+}
+
+// SubscribeToNotifications registers a stream for userID. An empty channels
+// list receives every notification; otherwise only notifications sent to one
+// of those channels (or to no channel in particular) are delivered. The
+// subscription ends when ctx is done or the subscriber is evicted, and in
+// both cases the returned channel is closed.
+func (h *Hub) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	sub := &subscriber{
+		userID:   userID,
+		channels: channels,
+		since:    h.config.Clock.Now(),
+		ch:       make(chan ports.Notification, h.config.BufferSize),
+	}
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[*subscriber]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+	sub.stop = context.AfterFunc(ctx, func() {
+		h.remove(sub)
+	})
+	h.mu.Unlock()
+
+	return sub.ch, nil
+}
+
+func (h *Hub) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	h.mu.RLock()
+	subs := make([]*subscriber, 0, len(h.subscribers[userID]))
+	for sub := range h.subscribers[userID] {
+		subs = append(subs, sub)
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range subs {
+		h.remove(sub)
+	}
+	return nil
+}
+
+func (h *Hub) ActiveSubscribers() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	count := 0
+	for _, subs := range h.subscribers {
+		count += len(subs)
+	}
+	return count
+}
+
+// StreamInfo describes an open notification stream.
+type StreamInfo struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Channels []string  `json:"channels"`
+	Since    time.Time `json:"since"`
+	// Pending is the number of notifications buffered and not yet read by the stream.
+	Pending int `json:"pending"`
+}
+
+// Streams returns the open streams, oldest first.
+func (h *Hub) Streams() []StreamInfo {
+	h.mu.RLock()
+	var streams []StreamInfo
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			streams = append(streams, StreamInfo{
+				UserID:   sub.userID,
+				Channels: sub.channels,
+				Since:    sub.since,
+				Pending:  len(sub.ch),
+			})
+		}
+	}
+	h.mu.RUnlock()
+
+	sort.Slice(streams, func(i, j int) bool { return streams[i].Since.Before(streams[j].Since) })
+	return streams
+}
+
+// Metrics is a metrics.MetricsCollector collector for streams and delivery outcomes.
+func (h *Hub) Metrics() []metrics.Metric {
+	h.mu.RLock()
+	users := len(h.subscribers)
+	h.mu.RUnlock()
+
+	now := h.config.Clock.Now()
+	return []metrics.Metric{
+		{Name: "notification_subscribers", Type: metrics.Gauge, Value: float64(h.ActiveSubscribers()), Timestamp: now},
+		{Name: "notification_subscribed_users", Type: metrics.Gauge, Value: float64(users), Timestamp: now},
+		{Name: "notifications_delivered_total", Type: metrics.Counter, Value: float64(h.delivered.Load()), Timestamp: now},
+		{Name: "notifications_dropped_total", Type: metrics.Counter, Value: float64(h.dropped.Load()), Timestamp: now},
+		{Name: "notification_subscribers_evicted_total", Type: metrics.Counter, Value: float64(h.evicted.Load()), Timestamp: now},
+	}
+}
+
+func (h *Hub) publish(notification ports.Notification) {
+	var slow []*subscriber
+
+	// Sends happen under the read lock so remove, which closes channels under
+	// the write lock, can never close a channel that is being written to
+	h.mu.RLock()
+	for sub := range h.subscribers[notification.UserID] {
+		if !notification.MatchesChannels(sub.channels) {
+			continue
+		}
+		select {
+		case sub.ch <- notification:
+			h.delivered.Add(1)
+		default:
+			h.dropped.Add(1)
+			slow = append(slow, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range slow {
+		if h.remove(sub) {
+			h.evicted.Add(1)
+			if h.config.OnEvict != nil {
+				h.config.OnEvict(sub.userID)
+			}
+		}
+	}
+}
+
+// remove unregisters sub and closes its channel; it reports whether sub was still registered.
+func (h *Hub) remove(sub *subscriber) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if sub.closed {
+		return false
+	}
+	sub.closed = true
+	if sub.stop != nil {
+		sub.stop()
+	}
+
+	delete(h.subscribers[sub.userID], sub)
+	if len(h.subscribers[sub.userID]) == 0 {
+		delete(h.subscribers, sub.userID)
+	}
+	close(sub.ch)
+	return true
+}
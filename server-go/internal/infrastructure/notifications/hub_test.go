@@ -0,0 +1,190 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var hubTestNow = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+func newTestHub(config HubConfig) *Hub {
+	config.Clock = entities.NewFakeClock(hubTestNow)
+	config.IDs = &entities.SequentialIDGenerator{}
+	return NewHub(config)
+}
+
+func subscribe(t *testing.T, hub *Hub, userID uuid.UUID, channels ...string) <-chan ports.Notification {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ch, err := hub.SubscribeToNotifications(ctx, userID, channels)
+	require.NoError(t, err)
+	return ch
+}
+
+func send(t *testing.T, hub *Hub, userID uuid.UUID, title string, channels ...string) {
+	t.Helper()
+	require.NoError(t, hub.SendNotification(context.Background(), userID, title, "message", "reminder", channels, nil))
+}
+
+// received drains the notifications already buffered in ch and returns their titles.
+func received(ch <-chan ports.Notification) []string {
+	var titles []string
+	for {
+		select {
+		case notification, ok := <-ch:
+			if !ok {
+				return titles
+			}
+			titles = append(titles, notification.Title)
+		default:
+			return titles
+		}
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan ports.Notification) {
+	t.Helper()
+	received(ch)
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok, "channel should be closed")
+	default:
+		t.Fatal("channel should be closed")
+	}
+}
+
+func TestHub_BroadcastsToEverySubscriberOfTheUser(t *testing.T) {
+	hub := newTestHub(HubConfig{})
+	userID, otherUserID := uuid.New(), uuid.New()
+
+	first := subscribe(t, hub, userID)
+	second := subscribe(t, hub, userID)
+	other := subscribe(t, hub, otherUserID)
+	assert.Equal(t, 3, hub.ActiveSubscribers())
+
+	send(t, hub, userID, "one")
+	send(t, hub, userID, "two")
+
+	assert.Equal(t, []string{"one", "two"}, received(first))
+	assert.Equal(t, []string{"one", "two"}, received(second))
+	assert.Empty(t, received(other))
+}
+
+func TestHub_FiltersByChannel(t *testing.T) {
+	hub := newTestHub(HubConfig{})
+	userID := uuid.New()
+
+	all := subscribe(t, hub, userID)
+	push := subscribe(t, hub, userID, "push")
+
+	send(t, hub, userID, "email only", "email")
+	send(t, hub, userID, "push and email", "push", "email")
+	send(t, hub, userID, "any channel")
+
+	assert.Equal(t, []string{"email only", "push and email", "any channel"}, received(all))
+	assert.Equal(t, []string{"push and email", "any channel"}, received(push))
+}
+
+func TestHub_UnsubscribeClosesEveryStreamOfTheUser(t *testing.T) {
+	hub := newTestHub(HubConfig{})
+	userID, otherUserID := uuid.New(), uuid.New()
+
+	first := subscribe(t, hub, userID)
+	second := subscribe(t, hub, userID)
+	other := subscribe(t, hub, otherUserID)
+
+	require.NoError(t, hub.UnsubscribeFromNotifications(context.Background(), userID))
+
+	assertClosed(t, first)
+	assertClosed(t, second)
+	assert.Equal(t, 1, hub.ActiveSubscribers())
+	assert.NotContains(t, hub.subscribers, userID)
+
+	// Sending to a user without subscribers is not an error, and others keep receiving
+	send(t, hub, userID, "nobody listening")
+	send(t, hub, otherUserID, "still here")
+	assert.Equal(t, []string{"still here"}, received(other))
+
+	// Unsubscribing twice is harmless
+	require.NoError(t, hub.UnsubscribeFromNotifications(context.Background(), userID))
+}
+
+func TestHub_ContextCancellationRemovesSubscriber(t *testing.T) {
+	hub := newTestHub(HubConfig{})
+	userID := uuid.New()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch, err := hub.SubscribeToNotifications(ctx, userID, nil)
+	require.NoError(t, err)
+	cancel()
+
+	// context.AfterFunc runs the removal in its own goroutine
+	assert.Eventually(t, func() bool { return hub.ActiveSubscribers() == 0 }, time.Second, time.Millisecond)
+	assertClosed(t, ch)
+	assert.Empty(t, hub.subscribers)
+	assert.Empty(t, hub.Streams())
+}
+
+func TestHub_EvictsSlowSubscriber(t *testing.T) {
+	var evicted []uuid.UUID
+	hub := newTestHub(HubConfig{
+		BufferSize: 2,
+		OnEvict:    func(userID uuid.UUID) { evicted = append(evicted, userID) },
+	})
+	userID := uuid.New()
+
+	slow := subscribe(t, hub, userID)
+	fast := subscribe(t, hub, userID)
+
+	send(t, hub, userID, "one")
+	send(t, hub, userID, "two")
+	assert.Equal(t, []string{"one", "two"}, received(fast))
+
+	// The slow subscriber never reads, so the third notification does not fit in its buffer
+	send(t, hub, userID, "three")
+
+	assert.Equal(t, []string{"three"}, received(fast))
+	assert.Equal(t, []string{"one", "two"}, received(slow))
+	assertClosed(t, slow)
+	assert.Equal(t, []uuid.UUID{userID}, evicted)
+	assert.Equal(t, 1, hub.ActiveSubscribers())
+
+	counters := map[string]float64{}
+	for _, metric := range hub.Metrics() {
+		counters[metric.Name] = metric.Value
+	}
+	assert.Equal(t, float64(5), counters["notifications_delivered_total"])
+	assert.Equal(t, float64(1), counters["notifications_dropped_total"])
+	assert.Equal(t, float64(1), counters["notification_subscribers_evicted_total"])
+}
+
+func TestHub_PersistsAndForwardsNotifications(t *testing.T) {
+	inbox := mocks.NewNotificationInbox(t)
+	outbound := mocks.NewNotificationService(t)
+	hub := newTestHub(HubConfig{Inbox: inbox, Outbound: outbound})
+	userID := uuid.New()
+	ch := subscribe(t, hub, userID)
+
+	inbox.On("Append", mock.Anything, mock.MatchedBy(func(n ports.Notification) bool {
+		return n.UserID == userID && n.Title == "title" && n.CreatedAt.Equal(hubTestNow)
+	})).Return(nil)
+	outbound.On("SendNotification", mock.Anything, userID, "title", "message", "reminder", []string{"push"}, map[string]string(nil)).
+		Return(errors.New("push provider down"))
+
+	err := hub.SendNotification(context.Background(), userID, "title", "message", "reminder", []string{"push"}, nil)
+
+	// Local subscribers still get the notification when the outbound service fails
+	assert.EqualError(t, err, "push provider down")
+	assert.Equal(t, []string{"title"}, received(ch))
+}
@@ -0,0 +1,291 @@
+package notifications
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// MQTT 3.1.1 control packet types used by the client.
+const (
+	mqttConnect    = 1
+	mqttConnack    = 2
+	mqttPublish    = 3
+	mqttPuback     = 4
+	mqttDisconnect = 14
+)
+
+const defaultMQTTTimeout = 10 * time.Second
+
+var errMQTTClosed = errors.New("mqtt: client is closed")
+
+type MQTTConfig struct {
+	// BrokerURL is tcp://host:1883, or tls://host:8883 (also ssl:// and mqtts://) for TLS.
+	BrokerURL string
+	ClientID  string
+	Username  string
+	Password  string
+	// QoS is 0 (fire and forget) or 1 (wait for the broker's PUBACK).
+	QoS byte
+	// Timeout bounds connecting and waiting for acknowledgments. Defaults to 10s.
+	Timeout   time.Duration
+	TLSConfig *tls.Config
+}
+
+// MQTTClient is a minimal MQTT 3.1.1 client that only publishes. It connects
+// on the first Publish and reconnects when the connection breaks; there is no
+// keep-alive, so a connection the broker or a NAT dropped while idle is noticed
+// on the next publish, which is retried once on a new connection.
+type MQTTClient struct {
+	config MQTTConfig
+
+	mu       sync.Mutex
+	conn     net.Conn
+	reader   *bufio.Reader
+	packetID uint16
+	closed   bool
+}
+
+func NewMQTTClient(config MQTTConfig) (*MQTTClient, error) {
+	if _, _, err := mqttAddress(config.BrokerURL); err != nil {
+		return nil, err
+	}
+	if config.ClientID == "" {
+		return nil, errors.New("mqtt: client ID is required")
+	}
+	if config.QoS > 1 {
+		return nil, fmt.Errorf("mqtt: unsupported QoS %d", config.QoS)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultMQTTTimeout
+	}
+	return &MQTTClient{config: config}, nil
+}
+
+// Publish sends payload to topic and, with QoS 1, waits until the broker has it.
+func (c *MQTTClient) Publish(ctx context.Context, topic string, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return errMQTTClosed
+	}
+	reused := c.conn != nil
+	err := c.publishLocked(ctx, topic, payload)
+	if err != nil && reused && ctx.Err() == nil {
+		// The idle connection may have been dropped; retry once on a new one
+		err = c.publishLocked(ctx, topic, payload)
+	}
+	return err
+}
+
+// Close disconnects from the broker.
+func (c *MQTTClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	if c.conn == nil {
+		return nil
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(c.config.Timeout))
+	c.conn.Write([]byte{mqttDisconnect << 4, 0})
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+func (c *MQTTClient) publishLocked(ctx context.Context, topic string, payload []byte) error {
+	if c.conn == nil {
+		if err := c.connectLocked(ctx); err != nil {
+			return err
+		}
+	}
+
+	err := c.sendPublishLocked(ctx, topic, payload)
+	if err != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	return err
+}
+
+func (c *MQTTClient) sendPublishLocked(ctx context.Context, topic string, payload []byte) error {
+	c.conn.SetDeadline(c.deadline(ctx))
+
+	header := byte(mqttPublish<<4) | c.config.QoS<<1
+	body := appendMQTTString(nil, topic)
+	var packetID uint16
+	if c.config.QoS > 0 {
+		c.packetID++
+		if c.packetID == 0 {
+			c.packetID = 1
+		}
+		packetID = c.packetID
+		body = binary.BigEndian.AppendUint16(body, packetID)
+	}
+	body = append(body, payload...)
+	if err := writeMQTTPacket(c.conn, header, body); err != nil {
+		return fmt.Errorf("mqtt: publish failed: %w", err)
+	}
+	if c.config.QoS == 0 {
+		return nil
+	}
+
+	for {
+		packetType, body, err := readMQTTPacket(c.reader)
+		if err != nil {
+			return fmt.Errorf("mqtt: waiting for puback failed: %w", err)
+		}
+		// Packets of earlier publishes that timed out can still arrive
+		if packetType == mqttPuback && len(body) == 2 && binary.BigEndian.Uint16(body) == packetID {
+			return nil
+		}
+	}
+}
+
+func (c *MQTTClient) connectLocked(ctx context.Context) error {
+	scheme, address, _ := mqttAddress(c.config.BrokerURL)
+	dialer := &net.Dialer{Timeout: c.config.Timeout}
+	var conn net.Conn
+	var err error
+	if scheme == "tcp" {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: c.config.TLSConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return fmt.Errorf("mqtt: connect failed: %w", err)
+	}
+	conn.SetDeadline(c.deadline(ctx))
+
+	var flags byte = 0x02 // clean session
+	if c.config.Username != "" {
+		flags |= 0x80
+	}
+	if c.config.Password != "" {
+		flags |= 0x40
+	}
+	body := appendMQTTString(nil, "MQTT")
+	body = append(body, 4, flags, 0, 0) // protocol level 4, keep-alive disabled
+	body = appendMQTTString(body, c.config.ClientID)
+	if c.config.Username != "" {
+		body = appendMQTTString(body, c.config.Username)
+	}
+	if c.config.Password != "" {
+		body = appendMQTTString(body, c.config.Password)
+	}
+
+	reader := bufio.NewReader(conn)
+	if err := writeMQTTPacket(conn, mqttConnect<<4, body); err != nil {
+		conn.Close()
+		return fmt.Errorf("mqtt: connect failed: %w", err)
+	}
+	packetType, ack, err := readMQTTPacket(reader)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("mqtt: connect failed: %w", err)
+	}
+	if packetType != mqttConnack || len(ack) != 2 {
+		conn.Close()
+		return fmt.Errorf("mqtt: unexpected packet %d while connecting", packetType)
+	}
+	if ack[1] != 0 {
+		conn.Close()
+		return fmt.Errorf("mqtt: broker refused the connection (code %d)", ack[1])
+	}
+
+	c.conn, c.reader = conn, reader
+	return nil
+}
+
+func (c *MQTTClient) deadline(ctx context.Context) time.Time {
+	deadline := time.Now().Add(c.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+	return deadline
+}
+
+// mqttAddress returns "tcp" or "tls" and the host:port of a broker URL.
+func mqttAddress(brokerURL string) (string, string, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil || u.Host == "" {
+		return "", "", fmt.Errorf("mqtt: invalid broker URL %q", brokerURL)
+	}
+	switch u.Scheme {
+	case "tcp", "mqtt":
+		if u.Port() == "" {
+			return "tcp", net.JoinHostPort(u.Hostname(), "1883"), nil
+		}
+		return "tcp", u.Host, nil
+	case "tls", "ssl", "mqtts":
+		if u.Port() == "" {
+			return "tls", net.JoinHostPort(u.Hostname(), "8883"), nil
+		}
+		return "tls", u.Host, nil
+	}
+	return "", "", fmt.Errorf("mqtt: unsupported broker URL scheme %q", u.Scheme)
+}
+
+func appendMQTTString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func writeMQTTPacket(w io.Writer, header byte, body []byte) error {
+	packet := make([]byte, 0, len(body)+5)
+	packet = append(packet, header)
+	// Remaining length: 7 bits per byte, high bit set while more bytes follow
+	length := len(body)
+	for {
+		encoded := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			encoded |= 0x80
+		}
+		packet = append(packet, encoded)
+		if length == 0 {
+			break
+		}
+	}
+	packet = append(packet, body...)
+	_, err := w.Write(packet)
+	return err
+}
+
+func readMQTTPacket(r *bufio.Reader) (byte, []byte, error) {
+	header, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length, multiplier := 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, nil, errors.New("malformed remaining length")
+		}
+		encoded, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length += int(encoded&0x7f) * multiplier
+		if encoded&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header >> 4, body, nil
+}
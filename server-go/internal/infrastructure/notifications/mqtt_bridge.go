@@ -0,0 +1,106 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+)
+
+const defaultMQTTTopicPrefix = "notebook"
+
+var errMQTTSubscriptions = errors.New("mqtt bridge does not support subscriptions")
+
+//go:generate mockery --name MQTTPublisher --with-expecter --case underscore
+
+// MQTTPublisher publishes a payload to an MQTT topic; MQTTClient implements it.
+type MQTTPublisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+type MQTTBridgeConfig struct {
+	// TopicPrefix starts every topic. Defaults to "notebook".
+	TopicPrefix string
+	// Types are the notification types mirrored to the broker, such as
+	// "reminder_overdue"; empty mirrors every notification.
+	Types []string
+}
+
+// mqttNotification is the JSON payload devices receive.
+type mqttNotification struct {
+	Type     string            `json:"type"`
+	Title    string            `json:"title"`
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	SentAt   time.Time         `json:"sent_at"`
+}
+
+// MQTTBridge is an outbound ports.NotificationService that mirrors
+// notifications to an MQTT broker, so Android devices receive them in the
+// background through a lightweight MQTT connection instead of keeping a gRPC
+// stream open. Every user has their own topic namespace,
+// "<prefix>/users/<user ID>/notifications/<type>", which broker ACLs can
+// restrict to that user's credentials.
+type MQTTBridge struct {
+	publisher MQTTPublisher
+	config    MQTTBridgeConfig
+	types     map[string]bool
+	clock     entities.Clock
+}
+
+func NewMQTTBridge(publisher MQTTPublisher, config MQTTBridgeConfig, clock entities.Clock) *MQTTBridge {
+	if config.TopicPrefix == "" {
+		config.TopicPrefix = defaultMQTTTopicPrefix
+	}
+	config.TopicPrefix = strings.TrimSuffix(config.TopicPrefix, "/")
+	var types map[string]bool
+	if len(config.Types) > 0 {
+		types = make(map[string]bool, len(config.Types))
+		for _, notificationType := range config.Types {
+			types[notificationType] = true
+		}
+	}
+	return &MQTTBridge{publisher: publisher, config: config, types: types, clock: clock}
+}
+
+// UserTopic is the topic a user's notifications of notificationType are
+// published to. Devices subscribe to "<prefix>/users/<user ID>/#".
+func (b *MQTTBridge) UserTopic(userID uuid.UUID, notificationType string) string {
+	if notificationType == "" {
+		notificationType = "general"
+	}
+	// Wildcards and separators are not allowed in a topic level
+	notificationType = strings.NewReplacer("/", "_", "+", "_", "#", "_").Replace(notificationType)
+	return b.config.TopicPrefix + "/users/" + userID.String() + "/notifications/" + notificationType
+}
+
+func (b *MQTTBridge) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	if b.types != nil && !b.types[notificationType] {
+		return nil
+	}
+
+	payload, err := json.Marshal(mqttNotification{
+		Type:     notificationType,
+		Title:    title,
+		Message:  message,
+		Metadata: metadata,
+		SentAt:   b.clock.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return b.publisher.Publish(ctx, b.UserTopic(userID, notificationType), payload)
+}
+
+func (b *MQTTBridge) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	return nil, errMQTTSubscriptions
+}
+
+func (b *MQTTBridge) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
@@ -0,0 +1,177 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+const defaultSlackAPIURL = "https://slack.com/api"
+
+// slackMaxSkew bounds the age of signed Slack requests to prevent replays.
+const slackMaxSkew = 5 * time.Minute
+
+var ErrInvalidSlackSignature = errors.New("invalid slack request signature")
+
+type SlackConfig struct {
+	// BotToken is the xoxb- token of the Slack app, with the chat:write scope.
+	BotToken string
+	APIURL   string
+	Client   *http.Client
+}
+
+// SlackSender posts notifications to Slack channels with chat.postMessage.
+type SlackSender struct {
+	config SlackConfig
+}
+
+func NewSlackSender(config SlackConfig) *SlackSender {
+	if config.APIURL == "" {
+		config.APIURL = defaultSlackAPIURL
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &SlackSender{config: config}
+}
+
+func (s *SlackSender) Provider() entities.ChatProvider {
+	return entities.ChatProviderSlack
+}
+
+// Escape escapes the characters that Slack mrkdwn treats as control sequences.
+func (s *SlackSender) Escape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+func (s *SlackSender) Bold(text string) string {
+	if text == "" {
+		return ""
+	}
+	return "*" + text + "*"
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackElement struct {
+	Type     string    `json:"type"`
+	Text     slackText `json:"text"`
+	ActionID string    `json:"action_id"`
+	Value    string    `json:"value"`
+}
+
+type slackBlock struct {
+	Type     string         `json:"type"`
+	Text     *slackText     `json:"text,omitempty"`
+	Elements []slackElement `json:"elements,omitempty"`
+}
+
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+func (s *SlackSender) Send(ctx context.Context, chatID string, message ChatMessage) error {
+	blocks := []slackBlock{{Type: "section", Text: &slackText{Type: "mrkdwn", Text: message.Text}}}
+	if len(message.Buttons) > 0 {
+		actions := slackBlock{Type: "actions"}
+		for _, button := range message.Buttons {
+			actions.Elements = append(actions.Elements, slackElement{
+				Type:     "button",
+				Text:     slackText{Type: "plain_text", Text: button.Label},
+				ActionID: button.Action,
+				Value:    button.Value,
+			})
+		}
+		blocks = append(blocks, actions)
+	}
+
+	var response slackResponse
+	err := s.post(ctx, s.config.APIURL+"/chat.postMessage", map[string]any{
+		"channel": chatID,
+		"text":    message.Text, // fallback for notifications and clients without blocks
+		"blocks":  blocks,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if !response.OK {
+		return fmt.Errorf("slack chat.postMessage: %s", response.Error)
+	}
+	return nil
+}
+
+// Respond replaces the message an interaction came from through its
+// response_url, which Slack only issues on hooks.slack.com.
+func (s *SlackSender) Respond(ctx context.Context, responseURL, text string) error {
+	target, err := url.Parse(responseURL)
+	if err != nil || target.Scheme != "https" || target.Hostname() != "hooks.slack.com" {
+		return fmt.Errorf("unexpected slack response URL %q", responseURL)
+	}
+	return s.post(ctx, target.String(), map[string]any{"replace_original": true, "text": text}, nil)
+}
+
+func (s *SlackSender) post(ctx context.Context, endpoint string, payload any, response any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+s.config.BotToken)
+
+	resp, err := s.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack returned %s", resp.Status)
+	}
+	if response != nil {
+		return json.NewDecoder(resp.Body).Decode(response)
+	}
+	return nil
+}
+
+// VerifySlackSignature checks the v0 signature Slack sends with slash
+// commands and interactions, computed over the raw request body.
+func VerifySlackSignature(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get("X-Slack-Request-Timestamp"), 10, 64)
+	if err != nil {
+		return ErrInvalidSlackSignature
+	}
+	if skew := now.Sub(time.Unix(timestamp, 0)); skew > slackMaxSkew || skew < -slackMaxSkew {
+		return ErrInvalidSlackSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	fmt.Fprintf(mac, "v0:%d:", timestamp)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return ErrInvalidSlackSignature
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+	"github.com/google/uuid"
+)
+
+// SMSChannel is the notification channel name that selects SMS delivery.
+const SMSChannel = "sms"
+
+// maxSMSLength keeps every notification in a single GSM segment.
+const maxSMSLength = 160
+
+var errSMSSubscriptions = errors.New("sms notifier does not support subscriptions")
+
+// SMSConfig configures an SMSNotifier.
+type SMSConfig struct {
+	// DailyLimit is the maximum number of SMS a user receives per UTC day.
+	DailyLimit int
+	// DeepLinkBaseURL prefixes the app links, e.g. "notebook://".
+	DeepLinkBaseURL string
+}
+
+// SMSNotifier is an outbound ports.NotificationService that sends a short
+// text with a deep link into the app to the user's verified phone. Since
+// every SMS costs money it only delivers notifications that explicitly list
+// the "sms" channel, and never more than DailyLimit per user and day.
+type SMSNotifier struct {
+	phones     ports.PhoneNumberRepository
+	sender     ports.SMSSender
+	config     SMSConfig
+	translator *i18n.Translator
+	clock      entities.Clock
+}
+
+// NewSMSNotifier accepts a nil translator, in which case texts are sent in English.
+func NewSMSNotifier(phones ports.PhoneNumberRepository, sender ports.SMSSender, config SMSConfig, translator *i18n.Translator, clock entities.Clock) *SMSNotifier {
+	return &SMSNotifier{phones: phones, sender: sender, config: config, translator: translator, clock: clock}
+}
+
+func (n *SMSNotifier) SendNotification(ctx context.Context, userID uuid.UUID, title, message, notificationType string, channels []string, metadata map[string]string) error {
+	if !containsChannel(channels, SMSChannel) {
+		return nil
+	}
+
+	phone, err := n.phones.GetByUserID(ctx, userID)
+	if errors.Is(err, entities.ErrPhoneNumberNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !phone.IsVerified() {
+		return nil
+	}
+
+	reserved, err := n.phones.ReserveSMS(ctx, userID, n.clock.Now(), n.config.DailyLimit)
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		// Over the limit the notification still reaches the other channels; not
+		// an error, so it does not open the circuit breaker
+		return nil
+	}
+
+	return n.sender.SendSMS(ctx, phone.Number, n.render(notificationType, title, metadata))
+}
+
+func (n *SMSNotifier) SubscribeToNotifications(ctx context.Context, userID uuid.UUID, channels []string) (<-chan ports.Notification, error) {
+	return nil, errSMSSubscriptions
+}
+
+func (n *SMSNotifier) UnsubscribeFromNotifications(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+// render builds "<prefix>: <title> <link>", shortening the title so the
+// link always fits.
+func (n *SMSNotifier) render(notificationType, title string, metadata map[string]string) string {
+	prefix := ""
+	switch notificationType {
+	case "reminder_overdue":
+		prefix = "Overdue reminder"
+	case "reminder_escalated":
+		prefix = "Unacknowledged reminder"
+	}
+	if prefix != "" && n.translator != nil {
+		prefix = n.translator.Translate(metadata[i18n.MetadataLocale], prefix)
+	}
+	if prefix != "" {
+		title = prefix + ": " + title
+	}
+
+	link := n.deepLink(metadata)
+	room := maxSMSLength
+	if link != "" {
+		room -= utf8.RuneCountInString(link) + 1
+	}
+	if utf8.RuneCountInString(title) > room {
+		title = string([]rune(title)[:max(room-1, 0)]) + "…"
+	}
+	if link == "" {
+		return title
+	}
+	return title + " " + link
+}
+
+// deepLink points at the reminder or idea the notification is about.
+func (n *SMSNotifier) deepLink(metadata map[string]string) string {
+	if n.config.DeepLinkBaseURL == "" {
+		return ""
+	}
+	base := strings.TrimSuffix(n.config.DeepLinkBaseURL, "/") + "/"
+	if strings.HasSuffix(n.config.DeepLinkBaseURL, "://") {
+		base = n.config.DeepLinkBaseURL
+	}
+	switch {
+	case metadata["reminder_id"] != "":
+		return base + "reminders/" + metadata["reminder_id"]
+	case metadata["idea_id"] != "":
+		return base + "ideas/" + metadata["idea_id"]
+	}
+	return ""
+}
+
+func containsChannel(channels []string, channel string) bool {
+	for _, c := range channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
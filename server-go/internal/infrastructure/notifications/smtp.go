@@ -0,0 +1,164 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+const defaultSMTPTimeout = 30 * time.Second
+
+type SMTPConfig struct {
+	// Addr is the relay's host:port. STARTTLS is used whenever the relay offers it.
+	Addr     string
+	Username string
+	Password string
+	// From is the sender address, optionally with a display name.
+	From    string
+	Timeout time.Duration
+}
+
+// SMTPSender is a ports.EmailSender that delivers multipart/alternative
+// emails through an SMTP relay.
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultSMTPTimeout
+	}
+	return &SMTPSender{config: config}
+}
+
+func (s *SMTPSender) SendEmail(ctx context.Context, message ports.EmailMessage) error {
+	body, err := s.compose(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.config.Addr)
+	if err != nil {
+		return fmt.Errorf("smtp: dial failed: %w", err)
+	}
+	// net/smtp has no context support; the deadline bounds the whole conversation
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(s.config.Addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("smtp: starttls failed: %w", err)
+		}
+	}
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, host)); err != nil {
+			return fmt.Errorf("smtp: authentication failed: %w", err)
+		}
+	}
+
+	from, err := envelopeAddress(s.config.From)
+	if err != nil {
+		return err
+	}
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return client.Quit()
+}
+
+// compose writes the headers and a multipart/alternative body with the text
+// part first, so clients that render HTML pick the last one.
+func (s *SMTPSender) compose(message ports.EmailMessage) ([]byte, error) {
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", s.config.From},
+		{"To", message.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `multipart/alternative; boundary="` + boundary + `"`},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header[0], header[1])
+	}
+	buf.WriteString("\r\n")
+
+	parts := []struct{ contentType, content string }{
+		{"text/plain", message.Text},
+		{"text/html", message.HTML},
+	}
+	for _, part := range parts {
+		if part.content == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "--%s\r\nContent-Type: %s; charset=utf-8\r\nContent-Transfer-Encoding: quoted-printable\r\n\r\n", boundary, part.contentType)
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+func randomBoundary() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// envelopeAddress strips the display name from a From header value.
+func envelopeAddress(from string) (string, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", fmt.Errorf("smtp: invalid sender %q: %w", from, err)
+	}
+	return address.Address, nil
+}
@@ -0,0 +1,129 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+const defaultTelegramAPIURL = "https://api.telegram.org"
+
+type TelegramConfig struct {
+	BotToken string
+	APIURL   string
+	Client   *http.Client
+}
+
+// TelegramSender sends notifications through the Telegram Bot API using HTML
+// formatting and inline keyboards for the buttons.
+type TelegramSender struct {
+	config TelegramConfig
+}
+
+func NewTelegramSender(config TelegramConfig) *TelegramSender {
+	if config.APIURL == "" {
+		config.APIURL = defaultTelegramAPIURL
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &TelegramSender{config: config}
+}
+
+func (t *TelegramSender) Provider() entities.ChatProvider {
+	return entities.ChatProviderTelegram
+}
+
+func (t *TelegramSender) Escape(text string) string {
+	return html.EscapeString(text)
+}
+
+func (t *TelegramSender) Bold(text string) string {
+	if text == "" {
+		return ""
+	}
+	return "<b>" + text + "</b>"
+}
+
+type telegramButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data"`
+}
+
+type telegramKeyboard struct {
+	InlineKeyboard [][]telegramButton `json:"inline_keyboard"`
+}
+
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+func (t *TelegramSender) Send(ctx context.Context, chatID string, message ChatMessage) error {
+	payload := map[string]any{
+		"chat_id":    chatID,
+		"text":       message.Text,
+		"parse_mode": "HTML",
+	}
+	if len(message.Buttons) > 0 {
+		row := make([]telegramButton, len(message.Buttons))
+		for i, button := range message.Buttons {
+			row[i] = telegramButton{Text: button.Label, CallbackData: EncodeChatAction(button.Action, button.Value)}
+		}
+		payload["reply_markup"] = telegramKeyboard{InlineKeyboard: [][]telegramButton{row}}
+	}
+	return t.call(ctx, "sendMessage", payload)
+}
+
+// AnswerCallback acknowledges a button press; text is shown briefly to the user.
+func (t *TelegramSender) AnswerCallback(ctx context.Context, callbackID, text string) error {
+	return t.call(ctx, "answerCallbackQuery", map[string]any{
+		"callback_query_id": callbackID,
+		"text":              text,
+	})
+}
+
+// ClearButtons removes the inline keyboard of a message once its action is done.
+func (t *TelegramSender) ClearButtons(ctx context.Context, chatID string, messageID int64) error {
+	return t.call(ctx, "editMessageReplyMarkup", map[string]any{
+		"chat_id":      chatID,
+		"message_id":   messageID,
+		"reply_markup": telegramKeyboard{InlineKeyboard: [][]telegramButton{}},
+	})
+}
+
+func (t *TelegramSender) call(ctx context.Context, method string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/%s", t.config.APIURL, t.config.BotToken, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.config.Client.Do(req)
+	if err != nil {
+		// The URL contains the bot token; keep it out of logs
+		return fmt.Errorf("telegram %s: request failed", method)
+	}
+	defer resp.Body.Close()
+
+	var response telegramResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("telegram %s returned %s", method, resp.Status)
+	}
+	if !response.OK {
+		return fmt.Errorf("telegram %s: %s", method, response.Description)
+	}
+	return nil
+}
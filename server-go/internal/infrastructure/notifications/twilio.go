@@ -0,0 +1,75 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTwilioAPIURL = "https://api.twilio.com"
+
+type TwilioConfig struct {
+	AccountSID string
+	AuthToken  string
+	// From is the Twilio number (E.164) or messaging service SID that sends the SMS.
+	From   string
+	APIURL string
+	Client *http.Client
+}
+
+// TwilioSender is a ports.SMSSender that sends text messages through the
+// Twilio Messages API.
+type TwilioSender struct {
+	config TwilioConfig
+}
+
+func NewTwilioSender(config TwilioConfig) *TwilioSender {
+	if config.APIURL == "" {
+		config.APIURL = defaultTwilioAPIURL
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &TwilioSender{config: config}
+}
+
+type twilioError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (t *TwilioSender) SendSMS(ctx context.Context, to, body string) error {
+	form := url.Values{"To": {to}, "Body": {body}}
+	if strings.HasPrefix(t.config.From, "MG") {
+		form.Set("MessagingServiceSid", t.config.From)
+	} else {
+		form.Set("From", t.config.From)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", t.config.APIURL, url.PathEscape(t.config.AccountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(t.config.AccountSID, t.config.AuthToken)
+
+	resp, err := t.config.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var response twilioError
+		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil || response.Message == "" {
+			return fmt.Errorf("twilio returned %s", resp.Status)
+		}
+		return fmt.Errorf("twilio error %d: %s", response.Code, response.Message)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package preview
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+const (
+	tagMake             = 0x010f
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagSoftware         = 0x0131
+	tagDateTime         = 0x0132
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagDateTimeOriginal = 0x9003
+)
+
+// exifTags are the descriptive tags exposed to clients; GPS data never is.
+var exifTags = map[uint16]string{
+	tagMake:             "Make",
+	tagModel:            "Model",
+	tagOrientation:      "Orientation",
+	tagSoftware:         "Software",
+	tagDateTime:         "DateTime",
+	tagDateTimeOriginal: "DateTimeOriginal",
+}
+
+// typeSizes is the size in bytes of each TIFF field type, indexed by type.
+var typeSizes = [...]int{0, 1, 1, 2, 4, 8, 1, 1, 2, 4, 8, 4, 8}
+
+// readJPEGExif walks the JPEG segments in prefix up to the start of scan and reads
+// the EXIF APP1 segment. With stripGPS the GPS IFD is blanked in place, which keeps
+// every offset in the file valid.
+func readJPEGExif(prefix []byte, stripGPS bool, preview *entities.FilePreview) {
+	if len(prefix) < 4 || prefix[0] != 0xff || prefix[1] != 0xd8 {
+		return
+	}
+
+	pos := 2
+	for pos+4 <= len(prefix) {
+		if prefix[pos] != 0xff {
+			return
+		}
+		marker := prefix[pos+1]
+		if marker == 0xd8 || marker == 0x01 || (marker >= 0xd0 && marker <= 0xd7) {
+			pos += 2
+			continue
+		}
+		if marker == 0xda || marker == 0xd9 {
+			return
+		}
+
+		length := int(binary.BigEndian.Uint16(prefix[pos+2 : pos+4]))
+		end := pos + 2 + length
+		if length < 2 || end > len(prefix) {
+			return
+		}
+		segment := prefix[pos+4 : end]
+		if marker == 0xe1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			readTIFF(segment[6:], stripGPS, preview)
+			return
+		}
+		pos = end
+	}
+}
+
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func readTIFF(data []byte, stripGPS bool, preview *entities.FilePreview) {
+	if len(data) < 8 {
+		return
+	}
+	t := tiff{data: data}
+	switch string(data[0:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return
+	}
+	if t.order.Uint16(data[2:4]) != 42 {
+		return
+	}
+
+	tags := make(map[string]string)
+	ifd0 := int(t.order.Uint32(data[4:8]))
+	pointers := t.readIFD(ifd0, tags)
+	if offset, ok := pointers[tagExifIFD]; ok {
+		t.readIFD(offset, tags)
+	}
+	if offset, ok := pointers[tagGPSIFD]; ok && stripGPS {
+		preview.GPSStripped = t.blankIFD(offset)
+	}
+
+	if len(tags) > 0 {
+		preview.EXIF = tags
+	}
+}
+
+// readIFD collects the exposed tags of the IFD at offset into tags and returns the
+// offsets of the sub-IFDs it points to.
+func (t tiff) readIFD(offset int, tags map[string]string) map[uint16]int {
+	pointers := make(map[uint16]int)
+	count, ok := t.entryCount(offset)
+	if !ok {
+		return pointers
+	}
+
+	for i := 0; i < count; i++ {
+		entry := t.data[offset+2+i*12 : offset+14+i*12]
+		tag := t.order.Uint16(entry[0:2])
+		fieldType := t.order.Uint16(entry[2:4])
+
+		switch {
+		case tag == tagExifIFD || tag == tagGPSIFD:
+			pointers[tag] = int(t.order.Uint32(entry[8:12]))
+		case exifTags[tag] != "":
+			if value, ok := t.value(entry, fieldType); ok && value != "" {
+				tags[exifTags[tag]] = value
+			}
+		}
+	}
+	return pointers
+}
+
+func (t tiff) value(entry []byte, fieldType uint16) (string, bool) {
+	switch fieldType {
+	case 2: // ASCII
+		data, ok := t.fieldData(entry, fieldType)
+		if !ok {
+			return "", false
+		}
+		return strings.TrimSpace(strings.TrimRight(string(data), "\x00")), true
+	case 3: // SHORT
+		return strconv.Itoa(int(t.order.Uint16(entry[8:10]))), true
+	case 4: // LONG
+		return strconv.FormatUint(uint64(t.order.Uint32(entry[8:12])), 10), true
+	}
+	return "", false
+}
+
+// fieldData returns the bytes of an entry's value, stored inline when it fits in four bytes.
+func (t tiff) fieldData(entry []byte, fieldType uint16) ([]byte, bool) {
+	if int(fieldType) >= len(typeSizes) || typeSizes[fieldType] == 0 {
+		return nil, false
+	}
+	size := int(t.order.Uint32(entry[4:8])) * typeSizes[fieldType]
+	if size < 0 || size > len(t.data) {
+		return nil, false
+	}
+	if size <= 4 {
+		return entry[8 : 8+size], true
+	}
+	offset := int(t.order.Uint32(entry[8:12]))
+	if offset < 0 || offset+size > len(t.data) {
+		return nil, false
+	}
+	return t.data[offset : offset+size], true
+}
+
+// blankIFD zeroes every entry of the IFD at offset along with the values they point to,
+// then sets its entry count to zero so readers see an empty GPS block.
+func (t tiff) blankIFD(offset int) bool {
+	count, ok := t.entryCount(offset)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < count; i++ {
+		entry := t.data[offset+2+i*12 : offset+14+i*12]
+		if data, ok := t.fieldData(entry, t.order.Uint16(entry[2:4])); ok {
+			clear(data)
+		}
+		clear(entry)
+	}
+	t.order.PutUint16(t.data[offset:offset+2], 0)
+	return true
+}
+
+func (t tiff) entryCount(offset int) (int, bool) {
+	if offset < 8 || offset+2 > len(t.data) {
+		return 0, false
+	}
+	count := int(t.order.Uint16(t.data[offset : offset+2]))
+	if offset+2+count*12 > len(t.data) {
+		return 0, false
+	}
+	return count, true
+}
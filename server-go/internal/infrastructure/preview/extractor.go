@@ -0,0 +1,127 @@
+package preview
+
+import (
+	"bytes"
+	"io"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// prefixSize bounds how much of a file is buffered to read its headers; JPEG EXIF
+// segments are at most 64 KiB and image dimensions sit right after them.
+const prefixSize = 256 << 10
+
+type Config struct {
+	// StripGPS blanks the GPS block of JPEG EXIF data before the file is stored.
+	StripGPS bool
+}
+
+// Extractor implements ports.PreviewExtractor. It never buffers the whole file:
+// headers are read from a bounded prefix and the rest is inspected as it streams.
+type Extractor struct {
+	config Config
+}
+
+func NewExtractor(config Config) *Extractor {
+	return &Extractor{config: config}
+}
+
+func (e *Extractor) Wrap(contentType string, reader io.Reader) (io.Reader, func() *entities.FilePreview) {
+	var preview entities.FilePreview
+	result := func() *entities.FilePreview {
+		if preview.IsEmpty() {
+			return nil
+		}
+		return &preview
+	}
+
+	switch contentType {
+	case "image/jpeg":
+		return inspectPrefix(reader, func(prefix []byte) {
+			readImageConfig(prefix, &preview)
+			readJPEGExif(prefix, e.config.StripGPS, &preview)
+		}), result
+	case "image/png", "image/gif":
+		return inspectPrefix(reader, func(prefix []byte) {
+			readImageConfig(prefix, &preview)
+		}), result
+	case "image/webp":
+		return inspectPrefix(reader, func(prefix []byte) {
+			readWebPSize(prefix, &preview)
+		}), result
+	case "application/pdf":
+		counter := &pdfPageCounter{}
+		return io.TeeReader(reader, counter), func() *entities.FilePreview {
+			preview.PageCount = counter.pages()
+			return result()
+		}
+	case "audio/wav", "audio/x-wav", "audio/wave":
+		return inspectPrefix(reader, func(prefix []byte) {
+			readWAVDuration(prefix, &preview)
+		}), result
+	case "audio/mpeg", "audio/mp3":
+		var header mp3Header
+		counter := &byteCounter{}
+		tee := io.TeeReader(reader, counter)
+		return inspectPrefix(tee, func(prefix []byte) {
+				header = readMP3Header(prefix)
+			}), func() *entities.FilePreview {
+				preview.Duration = header.duration(counter.n)
+				return result()
+			}
+	case "video/mp4", "video/quicktime", "audio/mp4", "audio/m4a", "audio/x-m4a":
+		scanner := &mp4Scanner{}
+		return io.TeeReader(reader, scanner), func() *entities.FilePreview {
+			preview.Duration = scanner.duration
+			return result()
+		}
+	}
+
+	return reader, func() *entities.FilePreview { return nil }
+}
+
+// inspectPrefix returns a reader over src that hands the first prefixSize bytes to
+// process before passing them on. process may rewrite the prefix in place as long as
+// its length is unchanged.
+func inspectPrefix(src io.Reader, process func(prefix []byte)) io.Reader {
+	return &prefixReader{src: src, process: process}
+}
+
+type prefixReader struct {
+	src     io.Reader
+	process func(prefix []byte)
+	r       io.Reader
+}
+
+func (p *prefixReader) Read(b []byte) (int, error) {
+	if p.r == nil {
+		prefix := make([]byte, prefixSize)
+		n, err := io.ReadFull(p.src, prefix)
+		prefix = prefix[:n]
+		switch err {
+		case nil, io.EOF, io.ErrUnexpectedEOF:
+			p.process(prefix)
+			p.r = io.MultiReader(bytes.NewReader(prefix), p.src)
+		default:
+			p.r = io.MultiReader(bytes.NewReader(prefix), errReader{err})
+		}
+	}
+	return p.r.Read(b)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,42 @@
+package preview
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+func readImageConfig(prefix []byte, preview *entities.FilePreview) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(prefix))
+	if err != nil {
+		return
+	}
+	preview.Width = int32(config.Width)
+	preview.Height = int32(config.Height)
+}
+
+// readWebPSize reads the canvas size from the first chunk of a WebP file; the
+// standard library has no WebP decoder.
+func readWebPSize(prefix []byte, preview *entities.FilePreview) {
+	if len(prefix) < 30 || string(prefix[0:4]) != "RIFF" || string(prefix[8:12]) != "WEBP" {
+		return
+	}
+
+	switch string(prefix[12:16]) {
+	case "VP8 ":
+		preview.Width = int32(binary.LittleEndian.Uint16(prefix[26:28]) & 0x3fff)
+		preview.Height = int32(binary.LittleEndian.Uint16(prefix[28:30]) & 0x3fff)
+	case "VP8L":
+		bits := binary.LittleEndian.Uint32(prefix[21:25])
+		preview.Width = int32(bits&0x3fff) + 1
+		preview.Height = int32((bits>>14)&0x3fff) + 1
+	case "VP8X":
+		preview.Width = int32(uint32(prefix[24])|uint32(prefix[25])<<8|uint32(prefix[26])<<16) + 1
+		preview.Height = int32(uint32(prefix[27])|uint32(prefix[28])<<8|uint32(prefix[29])<<16) + 1
+	}
+}
@@ -0,0 +1,212 @@
+package preview
+
+import (
+	"encoding/binary"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// readWAVDuration reads the byte rate from the fmt chunk and the length of the data chunk.
+func readWAVDuration(prefix []byte, preview *entities.FilePreview) {
+	if len(prefix) < 12 || string(prefix[0:4]) != "RIFF" || string(prefix[8:12]) != "WAVE" {
+		return
+	}
+
+	var byteRate uint32
+	pos := 12
+	for pos+8 <= len(prefix) {
+		id := string(prefix[pos : pos+4])
+		size := int64(binary.LittleEndian.Uint32(prefix[pos+4 : pos+8]))
+		switch id {
+		case "fmt ":
+			if pos+20 > len(prefix) {
+				return
+			}
+			byteRate = binary.LittleEndian.Uint32(prefix[pos+16 : pos+20])
+		case "data":
+			// Streaming encoders write 0xFFFFFFFF when the length is unknown
+			if byteRate > 0 && size != 0xffffffff {
+				preview.Duration = seconds(float64(size) / float64(byteRate))
+			}
+			return
+		}
+		pos += 8 + int(size+size%2)
+	}
+}
+
+// mp3Header holds what is needed from the first MPEG audio frame.
+type mp3Header struct {
+	offset     int64
+	bitrate    int64 // bits per second
+	sampleRate int64
+	samples    int64 // samples per frame
+	frames     int64 // from the Xing/Info header of VBR files, zero otherwise
+}
+
+var (
+	mp3BitratesV1 = [...]int64{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mp3BitratesV2 = [...]int64{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+	mp3Rates      = [...][3]int64{{11025, 12000, 8000}, {}, {22050, 24000, 16000}, {44100, 48000, 32000}}
+)
+
+// readMP3Header skips an ID3v2 tag and parses the first Layer III frame header.
+func readMP3Header(prefix []byte) mp3Header {
+	pos := 0
+	if len(prefix) >= 10 && string(prefix[0:3]) == "ID3" {
+		size := int(prefix[6])<<21 | int(prefix[7])<<14 | int(prefix[8])<<7 | int(prefix[9])
+		pos = 10 + size
+	}
+	if pos+4 > len(prefix) || prefix[pos] != 0xff || prefix[pos+1]&0xe0 != 0xe0 {
+		return mp3Header{}
+	}
+
+	version := (prefix[pos+1] >> 3) & 0x03 // 0: MPEG 2.5, 2: MPEG 2, 3: MPEG 1
+	layer := (prefix[pos+1] >> 1) & 0x03
+	bitrateIndex := prefix[pos+2] >> 4
+	rateIndex := (prefix[pos+2] >> 2) & 0x03
+	mono := prefix[pos+3]>>6 == 0x03
+	if version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return mp3Header{}
+	}
+
+	header := mp3Header{offset: int64(pos), sampleRate: mp3Rates[version][rateIndex]}
+	sideInfo := 17
+	if version == 3 {
+		header.bitrate = mp3BitratesV1[bitrateIndex] * 1000
+		header.samples = 1152
+		if !mono {
+			sideInfo = 32
+		}
+	} else {
+		header.bitrate = mp3BitratesV2[bitrateIndex] * 1000
+		header.samples = 576
+		if mono {
+			sideInfo = 9
+		}
+	}
+
+	xing := pos + 4 + sideInfo
+	if xing+12 <= len(prefix) {
+		tag := string(prefix[xing : xing+4])
+		flags := binary.BigEndian.Uint32(prefix[xing+4 : xing+8])
+		if (tag == "Xing" || tag == "Info") && flags&0x01 != 0 {
+			header.frames = int64(binary.BigEndian.Uint32(prefix[xing+8 : xing+12]))
+		}
+	}
+	return header
+}
+
+// duration uses the frame count of VBR files and otherwise assumes a constant bitrate over size bytes.
+func (h mp3Header) duration(size int64) time.Duration {
+	switch {
+	case h.frames > 0 && h.sampleRate > 0:
+		return seconds(float64(h.frames*h.samples) / float64(h.sampleRate))
+	case h.bitrate > 0 && size > h.offset:
+		return seconds(float64(size-h.offset) * 8 / float64(h.bitrate))
+	}
+	return 0
+}
+
+// mp4Scanner walks ISO base media boxes as the file streams past, descending into
+// moov to read the movie duration from mvhd. Everything else is skipped unbuffered,
+// so a moov placed at the end of the file is still found.
+type mp4Scanner struct {
+	header   []byte
+	skip     int64
+	mvhd     []byte
+	mvhdLeft int
+	done     bool
+	duration time.Duration
+}
+
+func (s *mp4Scanner) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 && !s.done {
+		switch {
+		case s.skip > 0:
+			step := int64(len(p))
+			if step > s.skip {
+				step = s.skip
+			}
+			s.skip -= step
+			p = p[step:]
+		case s.mvhdLeft > 0:
+			step := len(p)
+			if step > s.mvhdLeft {
+				step = s.mvhdLeft
+			}
+			s.mvhd = append(s.mvhd, p[:step]...)
+			s.mvhdLeft -= step
+			p = p[step:]
+			if s.mvhdLeft == 0 {
+				s.readMovieHeader()
+				s.done = true
+			}
+		default:
+			p = s.readBoxHeader(p)
+		}
+	}
+	return n, nil
+}
+
+// readBoxHeader accumulates a box header from p and returns the bytes left after it.
+func (s *mp4Scanner) readBoxHeader(p []byte) []byte {
+	need := 8
+	if len(s.header) >= 4 && binary.BigEndian.Uint32(s.header[0:4]) == 1 {
+		need = 16
+	}
+	step := need - len(s.header)
+	if step > len(p) {
+		step = len(p)
+	}
+	s.header = append(s.header, p[:step]...)
+	p = p[step:]
+	if len(s.header) < need {
+		return p
+	}
+	if need == 8 && binary.BigEndian.Uint32(s.header[0:4]) == 1 {
+		// 64-bit size: the next call reads the remaining eight bytes
+		return p
+	}
+
+	size := int64(binary.BigEndian.Uint32(s.header[0:4]))
+	if need == 16 {
+		size = int64(binary.BigEndian.Uint64(s.header[8:16]))
+	}
+	boxType := string(s.header[4:8])
+	s.header = s.header[:0]
+
+	switch {
+	case boxType == "moov":
+		// Children follow immediately
+	case size == 0:
+		// The box runs to the end of the file
+		s.done = true
+	case boxType == "mvhd":
+		s.mvhdLeft = 32
+	case size < int64(need):
+		s.done = true
+	default:
+		s.skip = size - int64(need)
+	}
+	return p
+}
+
+func (s *mp4Scanner) readMovieHeader() {
+	var timescale, duration uint64
+	if s.mvhd[0] == 1 {
+		timescale = uint64(binary.BigEndian.Uint32(s.mvhd[20:24]))
+		duration = binary.BigEndian.Uint64(s.mvhd[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(s.mvhd[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(s.mvhd[16:20]))
+	}
+	if timescale > 0 {
+		s.duration = seconds(float64(duration) / float64(timescale))
+	}
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
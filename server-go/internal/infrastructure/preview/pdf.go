@@ -0,0 +1,55 @@
+package preview
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var (
+	// pdfPageObject matches page dictionaries but not the /Pages tree nodes.
+	pdfPageObject = regexp.MustCompile(`/Type\s*/Page[^s]`)
+	pdfPageCount  = regexp.MustCompile(`/Count\s+(\d+)`)
+)
+
+// pdfCarry is how many trailing bytes are rescanned with the next chunk so that
+// tokens split across writes are still found.
+const pdfCarry = 64
+
+// pdfPageCounter counts pages as the PDF streams past. Page objects inside compressed
+// object streams are invisible to it, so it falls back to the largest /Count of the
+// page tree.
+type pdfPageCounter struct {
+	carry    []byte
+	objects  int32
+	maxCount int32
+}
+
+func (c *pdfPageCounter) Write(p []byte) (int, error) {
+	buf := append(c.carry, p...)
+	carried := len(c.carry)
+
+	// Matches that end inside the carried bytes were already counted
+	for _, match := range pdfPageObject.FindAllIndex(buf, -1) {
+		if match[1] > carried {
+			c.objects++
+		}
+	}
+	for _, match := range pdfPageCount.FindAllSubmatch(buf, -1) {
+		if count, err := strconv.ParseInt(string(match[1]), 10, 32); err == nil && int32(count) > c.maxCount {
+			c.maxCount = int32(count)
+		}
+	}
+
+	if len(buf) > pdfCarry {
+		buf = buf[len(buf)-pdfCarry:]
+	}
+	c.carry = append(c.carry[:0], buf...)
+	return len(p), nil
+}
+
+func (c *pdfPageCounter) pages() int32 {
+	if c.objects > 0 {
+		return c.objects
+	}
+	return c.maxCount
+}
@@ -0,0 +1,183 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+var (
+	// ErrAckExpired is returned when a delivery is settled after its visibility timeout, once
+	// the message has been handed back to the queue.
+	ErrAckExpired = errors.New("delivery expired before it was acknowledged")
+	// ErrVisibilityTimeout is the processing error of a message that was not acknowledged in time.
+	ErrVisibilityTimeout = errors.New("visibility timeout exceeded")
+)
+
+// AckHandler processes a message and settles it through delivery, from this goroutine or any
+// other. A message that is neither acked nor nacked within the visibility timeout is retried
+// like a failed one. msg belongs to the handler until it settles the delivery or the timeout
+// passes, whichever comes first.
+type AckHandler func(ctx context.Context, msg *Message, delivery *Delivery)
+
+// Delivery is one delivery of a message to an AckHandler.
+type Delivery struct {
+	mq    *MessageQueue
+	id    string
+	token uint64
+}
+
+// Ack marks the message as processed.
+func (d *Delivery) Ack() error {
+	msg, err := d.mq.settle(d)
+	if err != nil {
+		return err
+	}
+	d.mq.completeMessage(msg)
+	return nil
+}
+
+// Nack fails the message with err, so it is retried or dead-lettered.
+func (d *Delivery) Nack(err error) error {
+	msg, settleErr := d.mq.settle(d)
+	if settleErr != nil {
+		return settleErr
+	}
+	d.mq.handleProcessingError(msg, err)
+	return nil
+}
+
+// ExtendVisibility gives the handler timeout more time, counted from now, to settle the message.
+func (d *Delivery) ExtendVisibility(timeout time.Duration) error {
+	d.mq.inFlight.mu.Lock()
+	defer d.mq.inFlight.mu.Unlock()
+
+	inFlight, ok := d.mq.inFlight.deliveries[d.id]
+	if !ok || inFlight.token != d.token {
+		return ErrAckExpired
+	}
+	inFlight.deadline = d.mq.config.Clock.Now().Add(timeout)
+	return nil
+}
+
+type ackSubscription struct {
+	handler           AckHandler
+	visibilityTimeout time.Duration
+}
+
+type inFlightDelivery struct {
+	msg      *Message
+	token    uint64
+	deadline time.Time
+}
+
+// inFlightDeliveries tracks the messages handed to AckHandlers that are not settled yet.
+type inFlightDeliveries struct {
+	mu         sync.Mutex
+	deliveries map[string]*inFlightDelivery
+	nextToken  uint64
+}
+
+// SubscribeWithAck registers a handler that acknowledges messages explicitly. A zero
+// visibilityTimeout uses the queue's VisibilityTimeout. It replaces any Subscribe handler for
+// the topic.
+func (mq *MessageQueue) SubscribeWithAck(topic string, visibilityTimeout time.Duration, handler AckHandler) {
+	if visibilityTimeout <= 0 {
+		visibilityTimeout = mq.config.VisibilityTimeout
+	}
+
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+	delete(mq.handlers, topic)
+	mq.ackHandlers[topic] = ackSubscription{handler: handler, visibilityTimeout: visibilityTimeout}
+}
+
+// deliver hands msg to an AckHandler and leaves it in flight until it is settled or expires.
+func (mq *MessageQueue) deliver(msg *Message, sub ackSubscription) {
+	msg.Status = StatusProcessing
+	now := mq.config.Clock.Now()
+	msg.ProcessedAt = &now
+
+	mq.inFlight.mu.Lock()
+	mq.inFlight.nextToken++
+	delivery := &Delivery{mq: mq, id: msg.ID, token: mq.inFlight.nextToken}
+	mq.inFlight.deliveries[msg.ID] = &inFlightDelivery{msg: msg, token: delivery.token, deadline: now.Add(sub.visibilityTimeout)}
+	mq.inFlight.mu.Unlock()
+
+	// The handler may settle the delivery after it returns, so it gets the queue's context
+	ctx, log := withHandlerLog(mq.ctx)
+	err := callHandler(ctx, msg, func(ctx context.Context, msg *Message) error {
+		sub.handler(ctx, msg, delivery)
+		return nil
+	})
+	// A panic nacks the delivery, unless the handler settled it before panicking
+	if err != nil {
+		if _, settleErr := mq.settle(delivery); settleErr == nil {
+			mq.failMessage(msg, err, log)
+		}
+	}
+}
+
+// settle removes the delivery from the in-flight set, if it is still the current one.
+func (mq *MessageQueue) settle(d *Delivery) (*Message, error) {
+	if mq.ctx.Err() != nil {
+		return nil, ErrConsumerStopped
+	}
+
+	mq.inFlight.mu.Lock()
+	defer mq.inFlight.mu.Unlock()
+
+	inFlight, ok := mq.inFlight.deliveries[d.id]
+	if !ok || inFlight.token != d.token {
+		return nil, ErrAckExpired
+	}
+	delete(mq.inFlight.deliveries, d.id)
+	return inFlight.msg, nil
+}
+
+func (mq *MessageQueue) inFlightCount() int {
+	mq.inFlight.mu.Lock()
+	defer mq.inFlight.mu.Unlock()
+	return len(mq.inFlight.deliveries)
+}
+
+// startVisibilitySweeper fails the in-flight messages whose visibility timeout has passed,
+// checking every PollInterval.
+func (mq *MessageQueue) startVisibilitySweeper() {
+	mq.wg.Add(1)
+
+	supervisor.Go("queue.visibility_sweeper", func() {
+		defer mq.wg.Done()
+
+		ticker := time.NewTicker(mq.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				for _, msg := range mq.expiredDeliveries(mq.config.Clock.Now()) {
+					mq.handleProcessingError(msg, ErrVisibilityTimeout)
+				}
+			case <-mq.ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+func (mq *MessageQueue) expiredDeliveries(now time.Time) []*Message {
+	mq.inFlight.mu.Lock()
+	defer mq.inFlight.mu.Unlock()
+
+	var expired []*Message
+	for id, inFlight := range mq.inFlight.deliveries {
+		if now.After(inFlight.deadline) {
+			expired = append(expired, inFlight.msg)
+			delete(mq.inFlight.deliveries, id)
+		}
+	}
+	return expired
+}
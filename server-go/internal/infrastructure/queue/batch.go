@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"context"
+)
+
+// BatchMessage is one message of a PublishBatch.
+type BatchMessage struct {
+	Topic   string
+	Payload interface{}
+	Options []PublishOption
+}
+
+// PublishResult reports what happened to one message of a batch.
+type PublishResult struct {
+	ID  string
+	Err error
+}
+
+// PublishBatch enqueues messages in order and reports the result of each one. If the queue
+// does not have room for the whole batch, nothing is enqueued and it returns ErrQueueFull.
+//
+// The queue is in memory, so the batch is not a transaction: the room is checked up front,
+// but a concurrent publisher or retry can still take it, and then the messages that no
+// longer fit report ErrQueueFull while the ones before them stay enqueued. Callers that must
+// not publish partial batches check every result.
+func (mq *MessageQueue) PublishBatch(ctx context.Context, messages []BatchMessage) ([]PublishResult, error) {
+	mq.batchMu.Lock()
+	defer mq.batchMu.Unlock()
+
+	if cap(mq.messages)-len(mq.messages) < len(messages) {
+		return nil, ErrQueueFull
+	}
+
+	results := make([]PublishResult, len(messages))
+	for i, message := range messages {
+		msg := mq.newPublishedMessage(message.Topic, message.Payload, message.Options)
+		// The ID is read before enqueue, since a worker may recycle msg right after
+		results[i].ID = msg.ID
+		results[i].Err = mq.enqueue(ctx, msg)
+	}
+	return results, nil
+}
@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+)
+
+// ClientMetricsTopic carries batches of client telemetry events waiting to be stored.
+const ClientMetricsTopic = "telemetry.client_metrics"
+
+// ClientMetricQueue implements ports.ClientMetricQueue on top of a MessageQueue.
+type ClientMetricQueue struct {
+	mq *MessageQueue
+}
+
+// NewClientMetricQueue subscribes store to ClientMetricsTopic. Failed batches are retried with
+// the queue's strategy, so store must tolerate events it already saved.
+func NewClientMetricQueue(mq *MessageQueue, store func(ctx context.Context, metrics []*entities.ClientMetric) error) *ClientMetricQueue {
+	Subscribe(mq, ClientMetricsTopic, func(ctx context.Context, msg *Message, metrics []*entities.ClientMetric) error {
+		return store(contextFromHeaders(ctx, msg.Headers), metrics)
+	})
+	return &ClientMetricQueue{mq: mq}
+}
+
+// EnqueueClientMetrics publishes a batch with low priority so telemetry never delays other work.
+func (q *ClientMetricQueue) EnqueueClientMetrics(ctx context.Context, metrics []*entities.ClientMetric) error {
+	return q.mq.Publish(ctx, ClientMetricsTopic, metrics, WithPriority(PriorityLow), WithHeaders(eventHeaders(ctx)))
+}
@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+// delayScheduler holds the messages that are not due yet, ordered by DelayUntil, and a single
+// goroutine hands them back to the queue when they are. It replaces a goroutine and a timer
+// per delayed message.
+type delayScheduler struct {
+	mu      sync.Mutex
+	pending delayHeap
+	seq     uint64
+	// wake interrupts the wait when a message due earlier than the current head arrives
+	wake chan struct{}
+}
+
+func newDelayScheduler() *delayScheduler {
+	return &delayScheduler{wake: make(chan struct{}, 1)}
+}
+
+func (s *delayScheduler) add(msg *Message) {
+	s.mu.Lock()
+	s.seq++
+	heap.Push(&s.pending, delayedMessage{msg: msg, at: *msg.DelayUntil, seq: s.seq})
+	first := s.pending[0].msg == msg
+	s.mu.Unlock()
+
+	if first {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *delayScheduler) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+// popDue removes and returns the messages due at now, and when the next one is due.
+func (s *delayScheduler) popDue(now time.Time) ([]*Message, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*Message
+	for len(s.pending) > 0 && !s.pending[0].at.After(now) {
+		due = append(due, heap.Pop(&s.pending).(delayedMessage).msg)
+	}
+	if len(s.pending) == 0 {
+		return due, time.Time{}
+	}
+	return due, s.pending[0].at
+}
+
+// startDelayScheduler runs the goroutine that requeues delayed messages. The wait is capped
+// at PollInterval so a Clock that jumps ahead is noticed.
+func (mq *MessageQueue) startDelayScheduler() {
+	mq.wg.Add(1)
+
+	supervisor.Go("queue.delay_scheduler", func() {
+		defer mq.wg.Done()
+
+		timer := time.NewTimer(mq.config.PollInterval)
+		defer timer.Stop()
+
+		for {
+			due, next := mq.delayed.popDue(mq.config.Clock.Now())
+			for _, msg := range due {
+				select {
+				case mq.messages <- msg:
+					atomic.AddInt64(&mq.metrics.CurrentSize, 1)
+				case <-mq.ctx.Done():
+					return
+				}
+			}
+
+			wait := mq.config.PollInterval
+			if !next.IsZero() {
+				if untilNext := next.Sub(mq.config.Clock.Now()); untilNext < wait {
+					wait = untilNext
+				}
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(wait)
+
+			select {
+			case <-mq.ctx.Done():
+				return
+			case <-mq.delayed.wake:
+			case <-timer.C:
+			}
+		}
+	})
+}
+
+type delayedMessage struct {
+	msg *Message
+	at  time.Time
+	// seq keeps messages due at the same time in scheduling order
+	seq uint64
+}
+
+type delayHeap []delayedMessage
+
+func (h delayHeap) Len() int { return len(h) }
+
+func (h delayHeap) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].at.Before(h[j].at)
+}
+
+func (h delayHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *delayHeap) Push(x interface{}) { *h = append(*h, x.(delayedMessage)) }
+
+func (h *delayHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = delayedMessage{}
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// IdeaEmbeddingTopic carries the IDs of ideas whose content embedding must be computed.
+const IdeaEmbeddingTopic = "ideas.embedding"
+
+// IdeaEmbeddingQueue implements ports.IdeaEmbeddingQueue on top of a MessageQueue.
+type IdeaEmbeddingQueue struct {
+	mq *MessageQueue
+}
+
+// NewIdeaEmbeddingQueue subscribes embed to IdeaEmbeddingTopic. Failed embeddings are
+// retried with the queue's strategy and end up in the DLQ, where they can be requeued.
+func NewIdeaEmbeddingQueue(mq *MessageQueue, embed func(ctx context.Context, ideaID uuid.UUID) error) *IdeaEmbeddingQueue {
+	Subscribe(mq, IdeaEmbeddingTopic, func(ctx context.Context, msg *Message, ideaID uuid.UUID) error {
+		return embed(contextFromHeaders(ctx, msg.Headers), ideaID)
+	})
+	return &IdeaEmbeddingQueue{mq: mq}
+}
+
+// EnqueueIdeaEmbedding publishes ideaID with low priority so embeddings never delay other work.
+func (q *IdeaEmbeddingQueue) EnqueueIdeaEmbedding(ctx context.Context, ideaID uuid.UUID) error {
+	return q.mq.Publish(ctx, IdeaEmbeddingTopic, ideaID, WithPriority(PriorityLow), WithHeaders(eventHeaders(ctx)))
+}
@@ -0,0 +1,41 @@
+package queue
+
+import "sync"
+
+// maxPooledMapSize keeps messages that carried unusually many headers or metadata keys from
+// pinning their grown maps in the pool.
+const maxPooledMapSize = 32
+
+var messagePool = sync.Pool{
+	New: func() interface{} {
+		return newMessage()
+	},
+}
+
+func newMessage() *Message {
+	return &Message{
+		Headers:  make(map[string]string),
+		Metadata: make(map[string]interface{}),
+	}
+}
+
+// acquireMessage returns an empty message with its Headers and Metadata maps ready to fill.
+func (mq *MessageQueue) acquireMessage() *Message {
+	if mq.config.DisableMessagePool {
+		return newMessage()
+	}
+	return messagePool.Get().(*Message)
+}
+
+// releaseMessage recycles msg. The queue calls it only once no handler, callback or
+// channel references msg any more; see the ownership rules on Message.
+func (mq *MessageQueue) releaseMessage(msg *Message) {
+	if mq.config.DisableMessagePool {
+		return
+	}
+	if len(msg.Headers) > maxPooledMapSize || len(msg.Metadata) > maxPooledMapSize {
+		return
+	}
+	msg.Reset()
+	messagePool.Put(msg)
+}
@@ -8,6 +8,9 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
 )
 
 var (
@@ -35,8 +38,14 @@ const (
 	StatusFailed     MessageStatus = "failed"
 	StatusRetrying   MessageStatus = "retrying"
 	StatusDead       MessageStatus = "dead"
+	// StatusQuarantined marks a poison message: its handler panicked PoisonThreshold times
+	StatusQuarantined MessageStatus = "quarantined"
 )
 
+// Message is owned by the queue. Messages are recycled through a pool once they complete or
+// are dropped, so handlers and callbacks must not keep msg, its Headers or its Metadata after
+// they return: copy what they need or keep msg.Clone(). An AckHandler keeps msg until it settles
+// the delivery. Messages in the DLQ are never recycled.
 type Message struct {
 	ID          string                 `json:"id"`
 	Topic       string                 `json:"topic"`
@@ -52,16 +61,46 @@ type Message struct {
 	Metadata    map[string]interface{} `json:"metadata"`
 }
 
-func (m *Message) IsExpired(ttl time.Duration) bool {
-	return time.Since(m.CreatedAt) > ttl
+// Reset clears the message for reuse, keeping its Headers and Metadata maps allocated.
+func (m *Message) Reset() {
+	headers, metadata := m.Headers, m.Metadata
+	clear(headers)
+	clear(metadata)
+	*m = Message{Headers: headers, Metadata: metadata}
+}
+
+// Clone returns a copy that shares no maps with m, so it outlives m being recycled.
+func (m *Message) Clone() *Message {
+	clone := *m
+	clone.Headers = make(map[string]string, len(m.Headers))
+	for k, v := range m.Headers {
+		clone.Headers[k] = v
+	}
+	clone.Metadata = make(map[string]interface{}, len(m.Metadata))
+	for k, v := range m.Metadata {
+		clone.Metadata[k] = v
+	}
+	if m.ProcessedAt != nil {
+		processedAt := *m.ProcessedAt
+		clone.ProcessedAt = &processedAt
+	}
+	if m.DelayUntil != nil {
+		delayUntil := *m.DelayUntil
+		clone.DelayUntil = &delayUntil
+	}
+	return &clone
+}
+
+func (m *Message) IsExpired(ttl time.Duration, now time.Time) bool {
+	return now.Sub(m.CreatedAt) > ttl
 }
 
 func (m *Message) CanRetry() bool {
 	return m.RetryCount < m.MaxRetries
 }
 
-func (m *Message) ShouldProcess() bool {
-	if m.DelayUntil != nil && time.Now().Before(*m.DelayUntil) {
+func (m *Message) ShouldProcess(now time.Time) bool {
+	if m.DelayUntil != nil && now.Before(*m.DelayUntil) {
 		return false
 	}
 	return m.Status == StatusPending || m.Status == StatusRetrying
@@ -69,6 +108,8 @@ func (m *Message) ShouldProcess() bool {
 
 type MessageHandler func(ctx context.Context, msg *Message) error
 
+//go:generate mockery --name RetryStrategy --with-expecter --case underscore
+
 type RetryStrategy interface {
 	NextDelay(attempt int) time.Duration
 	ShouldRetry(err error, attempt int) bool
@@ -118,6 +159,19 @@ type QueueConfig struct {
 	RetryStrategy  RetryStrategy          `json:"-"`
 	DeadLetterTTL  time.Duration          `json:"dead_letter_ttl"`
 	EnableMetrics  bool                   `json:"enable_metrics"`
+	Clock          entities.Clock         `json:"-"`
+	IDGenerator    entities.IDGenerator   `json:"-"`
+	// ExternalDLQCleanup disables the internal DLQ processor; the caller schedules CleanupExpiredDLQ.
+	ExternalDLQCleanup bool               `json:"external_dlq_cleanup"`
+	// VisibilityTimeout is how long an AckHandler has to settle a message before it is
+	// redelivered, unless the subscription sets its own.
+	VisibilityTimeout time.Duration       `json:"visibility_timeout"`
+	// PoisonThreshold is how many times a message's handler may panic before the message is
+	// quarantined instead of retried; 0 uses 3 and a negative value never quarantines.
+	PoisonThreshold int                   `json:"poison_threshold"`
+	// DisableMessagePool allocates every message instead of recycling them, for handlers that
+	// keep messages after returning.
+	DisableMessagePool bool               `json:"disable_message_pool"`
 }
 
 type QueueMetrics struct {
@@ -127,6 +181,11 @@ type QueueMetrics struct {
 	RetryMessages     int64 `json:"retry_messages"`
 	DeadMessages      int64 `json:"dead_messages"`
 	CurrentSize       int64 `json:"current_size"`
+	DelayedMessages   int64 `json:"delayed_messages"`
+	InFlightMessages  int64 `json:"in_flight_messages"`
+	InvalidMessages   int64 `json:"invalid_messages"`
+	PausedMessages    int64 `json:"paused_messages"`
+	QuarantinedMessages int64 `json:"quarantined_messages"`
 	Workers           int   `json:"workers"`
 	ActiveWorkers     int32 `json:"active_workers"`
 }
@@ -135,13 +194,23 @@ type MessageQueue struct {
 	config       QueueConfig
 	messages     chan *Message
 	dlq          chan *Message
+	delayed      *delayScheduler
 	handlers     map[string]MessageHandler
+	ackHandlers  map[string]ackSubscription
+	inFlight     inFlightDeliveries
+	quarantine   quarantineStore
+	paused       map[string]bool
+	parked       map[string][]*Message
 	mu           sync.RWMutex
+	// batchMu serializes PublishBatch, so two batches do not both pass the room check
+	batchMu      sync.Mutex
 	ctx          context.Context
 	cancel       context.CancelFunc
 	wg           sync.WaitGroup
 	metrics      QueueMetrics
 	activeWorkers int32
+	draining     int32
+	drainedWorkers int32
 	
 	// Event callbacks
 	onMessage    func(*Message)
@@ -174,55 +243,84 @@ func NewMessageQueue(config QueueConfig) *MessageQueue {
 	if config.DeadLetterTTL <= 0 {
 		config.DeadLetterTTL = time.Hour * 24
 	}
+	if config.PoisonThreshold == 0 {
+		config.PoisonThreshold = 3
+	}
+	if config.VisibilityTimeout <= 0 {
+		config.VisibilityTimeout = 30 * time.Second
+	}
+	if config.Clock == nil {
+		config.Clock = entities.SystemClock{}
+	}
+	if config.IDGenerator == nil {
+		config.IDGenerator = entities.UUIDGenerator{}
+	}
 	
 	ctx, cancel := context.WithCancel(context.Background())
 	
 	mq := &MessageQueue{
-		config:   config,
-		messages: make(chan *Message, config.MaxSize),
-		dlq:      make(chan *Message, config.MaxSize/10),
-		handlers: make(map[string]MessageHandler),
-		ctx:      ctx,
-		cancel:   cancel,
-		metrics:  QueueMetrics{Workers: config.Workers},
+		config:       config,
+		messages:     make(chan *Message, config.MaxSize),
+		dlq:          make(chan *Message, config.MaxSize/10),
+		delayed:      newDelayScheduler(),
+		handlers:     make(map[string]MessageHandler),
+		ackHandlers:  make(map[string]ackSubscription),
+		paused:       make(map[string]bool),
+		parked:       make(map[string][]*Message),
+		inFlight:     inFlightDeliveries{deliveries: make(map[string]*inFlightDelivery)},
+		quarantine:   quarantineStore{messages: make(map[string]*Message)},
+		ctx:          ctx,
+		cancel:       cancel,
+		metrics:      QueueMetrics{Workers: config.Workers},
 	}
 	
 	mq.startWorkers()
-	mq.startDLQProcessor()
+	mq.startDelayScheduler()
+	mq.startVisibilitySweeper()
+	if !config.ExternalDLQCleanup {
+		mq.startDLQProcessor()
+	}
 	
 	return mq
 }
 
 func (mq *MessageQueue) Publish(ctx context.Context, topic string, payload interface{}, options ...PublishOption) error {
-	msg := &Message{
-		ID:         generateID(),
-		Topic:      topic,
-		Payload:    payload,
-		Headers:    make(map[string]string),
-		Priority:   PriorityNormal,
-		Status:     StatusPending,
-		CreatedAt:  time.Now(),
-		MaxRetries: 3,
-		Metadata:   make(map[string]interface{}),
-	}
+	return mq.enqueue(ctx, mq.newPublishedMessage(topic, payload, options))
+}
+
+func (mq *MessageQueue) newPublishedMessage(topic string, payload interface{}, options []PublishOption) *Message {
+	msg := mq.acquireMessage()
+	msg.ID = mq.config.IDGenerator.NewID().String()
+	msg.Topic = topic
+	msg.Payload = payload
+	msg.Priority = PriorityNormal
+	msg.Status = StatusPending
+	msg.CreatedAt = mq.config.Clock.Now()
+	msg.MaxRetries = 3
 	
 	for _, option := range options {
 		option(msg)
 	}
+	return msg
+}
+
+func (mq *MessageQueue) enqueue(ctx context.Context, msg *Message) error {
+	// Once enqueued a worker may complete and recycle the message at any time, so the
+	// callback sees it before
+	if mq.onMessage != nil {
+		mq.onMessage(msg)
+	}
 	
 	select {
 	case mq.messages <- msg:
 		atomic.AddInt64(&mq.metrics.TotalMessages, 1)
 		atomic.AddInt64(&mq.metrics.CurrentSize, 1)
-		
-		if mq.onMessage != nil {
-			mq.onMessage(msg)
-		}
-		
 		return nil
 	case <-ctx.Done():
+		mq.releaseMessage(msg)
 		return ctx.Err()
 	default:
+		mq.releaseMessage(msg)
 		return ErrQueueFull
 	}
 }
@@ -251,7 +349,8 @@ func WithMaxRetries(retries int) PublishOption {
 
 func WithDelay(delay time.Duration) PublishOption {
 	return func(m *Message) {
-		delayUntil := time.Now().Add(delay)
+		// Options run after CreatedAt is set, so the delay is relative to the queue clock
+		delayUntil := m.CreatedAt.Add(delay)
 		m.DelayUntil = &delayUntil
 	}
 }
@@ -267,6 +366,7 @@ func WithMetadata(metadata map[string]interface{}) PublishOption {
 func (mq *MessageQueue) Subscribe(topic string, handler MessageHandler) {
 	mq.mu.Lock()
 	defer mq.mu.Unlock()
+	delete(mq.ackHandlers, topic)
 	mq.handlers[topic] = handler
 }
 
@@ -274,12 +374,14 @@ func (mq *MessageQueue) Unsubscribe(topic string) {
 	mq.mu.Lock()
 	defer mq.mu.Unlock()
 	delete(mq.handlers, topic)
+	delete(mq.ackHandlers, topic)
 }
 
 func (mq *MessageQueue) startWorkers() {
 	for i := 0; i < mq.config.Workers; i++ {
 		mq.wg.Add(1)
-		go mq.worker(i)
+		id := i
+		supervisor.Go("queue.worker", func() { mq.worker(id) })
 	}
 }
 
@@ -290,17 +392,32 @@ func (mq *MessageQueue) worker(id int) {
 	ticker := time.NewTicker(mq.config.PollInterval)
 	defer ticker.Stop()
 	
+	drained := false
 	for {
+		messages := mq.messages
+		if mq.isDraining() {
+			// Stop taking messages, finish the batch and report this worker idle
+			messages = nil
+			if !drained {
+				if len(batch) > 0 {
+					mq.processBatch(batch)
+					batch = batch[:0]
+				}
+				drained = true
+				atomic.AddInt32(&mq.drainedWorkers, 1)
+			}
+		}
+		
 		select {
 		case <-mq.ctx.Done():
 			return
 			
-		case msg := <-mq.messages:
+		case msg := <-messages:
 			atomic.AddInt32(&mq.activeWorkers, 1)
 			
-			if msg.ShouldProcess() {
+			atomic.AddInt64(&mq.metrics.CurrentSize, -1)
+			if msg.ShouldProcess(mq.config.Clock.Now()) {
 				batch = append(batch, msg)
-				atomic.AddInt64(&mq.metrics.CurrentSize, -1)
 			} else {
 				mq.scheduleRetry(msg)
 				atomic.AddInt32(&mq.activeWorkers, -1)
@@ -332,10 +449,20 @@ func (mq *MessageQueue) processBatch(batch []*Message) {
 }
 
 func (mq *MessageQueue) processMessage(msg *Message) {
+	if mq.park(msg) {
+		return
+	}
+	
 	mq.mu.RLock()
 	handler, exists := mq.handlers[msg.Topic]
+	ackSub, acked := mq.ackHandlers[msg.Topic]
 	mq.mu.RUnlock()
 	
+	if acked {
+		mq.deliver(msg, ackSub)
+		return
+	}
+	
 	if !exists {
 		msg.Status = StatusFailed
 		atomic.AddInt64(&mq.metrics.FailedMessages, 1)
@@ -343,37 +470,49 @@ func (mq *MessageQueue) processMessage(msg *Message) {
 		if mq.onProcessed != nil {
 			mq.onProcessed(msg, fmt.Errorf("no handler for topic: %s", msg.Topic))
 		}
+		mq.releaseMessage(msg)
 		return
 	}
 	
 	msg.Status = StatusProcessing
-	now := time.Now()
+	now := mq.config.Clock.Now()
 	msg.ProcessedAt = &now
 	
 	ctx, cancel := context.WithCancel(mq.ctx)
 	defer cancel()
+	ctx, log := withHandlerLog(ctx)
 	
-	err := handler(ctx, msg)
+	err := callHandler(ctx, msg, handler)
 	
 	if err != nil {
-		mq.handleProcessingError(msg, err)
+		mq.failMessage(msg, err, log)
 	} else {
-		msg.Status = StatusCompleted
-		atomic.AddInt64(&mq.metrics.ProcessedMessages, 1)
-		
-		if mq.onProcessed != nil {
-			mq.onProcessed(msg, nil)
-		}
+		mq.completeMessage(msg)
+	}
+}
+
+func (mq *MessageQueue) completeMessage(msg *Message) {
+	msg.Status = StatusCompleted
+	atomic.AddInt64(&mq.metrics.ProcessedMessages, 1)
+	
+	if mq.onProcessed != nil {
+		mq.onProcessed(msg, nil)
 	}
+	mq.releaseMessage(msg)
 }
 
 func (mq *MessageQueue) handleProcessingError(msg *Message, err error) {
-	if mq.config.RetryStrategy.ShouldRetry(err, msg.RetryCount) && msg.CanRetry() {
+	invalid := errors.Is(err, ErrInvalidPayload)
+	if invalid {
+		atomic.AddInt64(&mq.metrics.InvalidMessages, 1)
+	}
+	
+	if !invalid && mq.config.RetryStrategy.ShouldRetry(err, msg.RetryCount) && msg.CanRetry() {
 		msg.RetryCount++
 		msg.Status = StatusRetrying
 		
 		delay := mq.config.RetryStrategy.NextDelay(msg.RetryCount)
-		retryTime := time.Now().Add(delay)
+		retryTime := mq.config.Clock.Now().Add(delay)
 		msg.DelayUntil = &retryTime
 		
 		atomic.AddInt64(&mq.metrics.RetryMessages, 1)
@@ -387,62 +526,50 @@ func (mq *MessageQueue) handleProcessingError(msg *Message, err error) {
 		msg.Status = StatusDead
 		atomic.AddInt64(&mq.metrics.DeadMessages, 1)
 		
+		dropped := false
 		select {
 		case mq.dlq <- msg:
 			if mq.onDead != nil {
 				mq.onDead(msg)
 			}
 		default:
+			dropped = true
 		}
 		
 		atomic.AddInt64(&mq.metrics.FailedMessages, 1)
 		
 		if mq.onProcessed != nil {
-			mq.onProcessed(msg, ErrRetryExceeded)
+			if invalid {
+				mq.onProcessed(msg, err)
+			} else {
+				mq.onProcessed(msg, ErrRetryExceeded)
+			}
+		}
+		if dropped {
+			mq.releaseMessage(msg)
 		}
 	}
 }
 
+// scheduleRetry requeues msg, or hands it to the delay scheduler until DelayUntil.
 func (mq *MessageQueue) scheduleRetry(msg *Message) {
-	if msg.DelayUntil == nil {
-		select {
-		case mq.messages <- msg:
-			atomic.AddInt64(&mq.metrics.CurrentSize, 1)
-		default:
-		}
+	if msg.DelayUntil != nil && mq.config.Clock.Now().Before(*msg.DelayUntil) {
+		mq.delayed.add(msg)
 		return
 	}
 	
-	delay := time.Until(*msg.DelayUntil)
-	if delay <= 0 {
-		select {
-		case mq.messages <- msg:
-			atomic.AddInt64(&mq.metrics.CurrentSize, 1)
-		default:
-		}
-		return
+	select {
+	case mq.messages <- msg:
+		atomic.AddInt64(&mq.metrics.CurrentSize, 1)
+	default:
+		mq.releaseMessage(msg)
 	}
-	
-	go func() {
-		timer := time.NewTimer(delay)
-		defer timer.Stop()
-		
-		select {
-		case <-timer.C:
-			select {
-			case mq.messages <- msg:
-				atomic.AddInt64(&mq.metrics.CurrentSize, 1)
-			case <-mq.ctx.Done():
-			}
-		case <-mq.ctx.Done():
-		}
-	}()
 }
 
 func (mq *MessageQueue) startDLQProcessor() {
 	mq.wg.Add(1)
 	
-	go func() {
+	supervisor.Go("queue.dead_letters", func() {
 		defer mq.wg.Done()
 		
 		ticker := time.NewTicker(time.Hour)
@@ -454,7 +581,8 @@ func (mq *MessageQueue) startDLQProcessor() {
 				return
 				
 			case msg := <-mq.dlq:
-				if msg.IsExpired(mq.config.DeadLetterTTL) {
+				if msg.IsExpired(mq.config.DeadLetterTTL, mq.config.Clock.Now()) {
+					mq.releaseMessage(msg)
 					continue
 				}
 				
@@ -462,26 +590,41 @@ func (mq *MessageQueue) startDLQProcessor() {
 				mq.cleanupExpiredDLQMessages()
 			}
 		}
-	}()
+	})
 }
 
 func (mq *MessageQueue) cleanupExpiredDLQMessages() {
 	for {
 		select {
 		case msg := <-mq.dlq:
-			if !msg.IsExpired(mq.config.DeadLetterTTL) {
+			if !msg.IsExpired(mq.config.DeadLetterTTL, mq.config.Clock.Now()) {
 				mq.dlq <- msg
 				return
 			}
+			mq.releaseMessage(msg)
 		default:
 			return
 		}
 	}
 }
 
+// GetMetrics returns a snapshot; the counters are updated atomically by the workers.
 func (mq *MessageQueue) GetMetrics() QueueMetrics {
-	mq.metrics.ActiveWorkers = atomic.LoadInt32(&mq.activeWorkers)
-	return mq.metrics
+	return QueueMetrics{
+		TotalMessages:     atomic.LoadInt64(&mq.metrics.TotalMessages),
+		ProcessedMessages: atomic.LoadInt64(&mq.metrics.ProcessedMessages),
+		FailedMessages:    atomic.LoadInt64(&mq.metrics.FailedMessages),
+		RetryMessages:     atomic.LoadInt64(&mq.metrics.RetryMessages),
+		DeadMessages:      atomic.LoadInt64(&mq.metrics.DeadMessages),
+		CurrentSize:       atomic.LoadInt64(&mq.metrics.CurrentSize),
+		DelayedMessages:   int64(mq.delayed.len()),
+		InFlightMessages:  int64(mq.inFlightCount()),
+		InvalidMessages:   atomic.LoadInt64(&mq.metrics.InvalidMessages),
+		PausedMessages:    atomic.LoadInt64(&mq.metrics.PausedMessages),
+		QuarantinedMessages: int64(mq.quarantinedCount()),
+		Workers:           mq.metrics.Workers,
+		ActiveWorkers:     atomic.LoadInt32(&mq.activeWorkers),
+	}
 }
 
 func (mq *MessageQueue) GetSize() int {
@@ -523,13 +666,12 @@ func (mq *MessageQueue) GetHandlers() []string {
 	for topic := range mq.handlers {
 		topics = append(topics, topic)
 	}
+	for topic := range mq.ackHandlers {
+		topics = append(topics, topic)
+	}
 	return topics
 }
 
-func generateID() string {
-	return fmt.Sprintf("%d_%d", time.Now().UnixNano(), rand.Int31())
-}
-
 func (mq *MessageQueue) DrainDLQ() []*Message {
 	var messages []*Message
 	
@@ -543,6 +685,25 @@ func (mq *MessageQueue) DrainDLQ() []*Message {
 	}
 }
 
+// ListDLQ returns a snapshot of the dead letter queue, optionally filtered by topic.
+// Messages are drained and put back, so the DLQ contents are unchanged.
+func (mq *MessageQueue) ListDLQ(topic string) []*Message {
+	messages := mq.DrainDLQ()
+	var result []*Message
+	
+	for _, msg := range messages {
+		if topic == "" || msg.Topic == topic {
+			result = append(result, msg)
+		}
+		mq.dlq <- msg
+	}
+	return result
+}
+
+func (mq *MessageQueue) CleanupExpiredDLQ() {
+	mq.cleanupExpiredDLQMessages()
+}
+
 func (mq *MessageQueue) RequeueFromDLQ(messageID string) error {
 	messages := mq.DrainDLQ()
 	var targetMessage *Message
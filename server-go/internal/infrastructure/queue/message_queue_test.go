@@ -0,0 +1,405 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_ResetKeepsMaps(t *testing.T) {
+	delay := time.Now()
+	msg := &Message{
+		ID:         "id",
+		Topic:      "topic",
+		Payload:    "payload",
+		Headers:    map[string]string{"request-id": "1"},
+		Metadata:   map[string]interface{}{"attempt": 1},
+		RetryCount: 2,
+		DelayUntil: &delay,
+	}
+	clone := msg.Clone()
+
+	msg.Reset()
+
+	assert.Empty(t, msg.ID)
+	assert.Nil(t, msg.Payload)
+	assert.Nil(t, msg.DelayUntil)
+	assert.Zero(t, msg.RetryCount)
+	assert.NotNil(t, msg.Headers)
+	assert.Empty(t, msg.Headers)
+	assert.NotNil(t, msg.Metadata)
+	assert.Empty(t, msg.Metadata)
+
+	assert.Equal(t, "1", clone.Headers["request-id"])
+	assert.Equal(t, 1, clone.Metadata["attempt"])
+	assert.Equal(t, delay, *clone.DelayUntil)
+}
+
+func TestMessageQueue_RecycledMessagesStartClean(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{Workers: 1, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true})
+	defer mq.Stop()
+
+	received := make(chan map[string]string, 2)
+	mq.Subscribe("topic", func(ctx context.Context, msg *Message) error {
+		headers := make(map[string]string, len(msg.Headers))
+		for k, v := range msg.Headers {
+			headers[k] = v
+		}
+		received <- headers
+		return nil
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mq.Publish(ctx, "topic", 1, WithHeaders(map[string]string{"request-id": "1"})))
+	assert.Equal(t, map[string]string{"request-id": "1"}, <-received)
+
+	require.NoError(t, mq.Publish(ctx, "topic", 2))
+	assert.Empty(t, <-received)
+}
+
+func TestMessageQueue_ManyDelayedMessages(t *testing.T) {
+	const messages = 20000
+	// The fake clock keeps every message pending until the test advances it, however long publishing takes
+	clock := entities.NewFakeClock(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	mq := NewMessageQueue(QueueConfig{MaxSize: messages, Workers: 4, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true, Clock: clock})
+	defer mq.Stop()
+
+	var released, early atomic.Int64
+	mq.Subscribe("delayed", func(ctx context.Context, msg *Message) error {
+		if clock.Now().Before(*msg.DelayUntil) {
+			early.Add(1)
+		}
+		released.Add(1)
+		return nil
+	})
+	ctx := context.Background()
+	goroutines := runtime.NumGoroutine()
+
+	delay := func(i int) time.Duration { return time.Duration(100+i%200) * time.Millisecond }
+	for i := 0; i < messages; i++ {
+		require.NoError(t, mq.Publish(ctx, "delayed", i, WithDelay(delay(i))))
+	}
+
+	// Every delayed message waits in the scheduler, not in a goroutine of its own
+	require.Eventually(t, func() bool { return mq.GetMetrics().DelayedMessages == messages }, 5*time.Second, time.Millisecond)
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines+2)
+	assert.Zero(t, released.Load())
+
+	// Each step releases exactly the messages that came due, and the rest stay pending
+	for _, elapsed := range []time.Duration{200 * time.Millisecond, 300 * time.Millisecond} {
+		clock.Set(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC).Add(elapsed))
+		due := int64(0)
+		for i := 0; i < messages; i++ {
+			if delay(i) <= elapsed {
+				due++
+			}
+		}
+		assert.Eventually(t, func() bool {
+			return released.Load() == due && mq.GetMetrics().DelayedMessages == messages-due
+		}, 10*time.Second, time.Millisecond)
+		require.Equal(t, due, released.Load())
+		require.Equal(t, messages-due, mq.GetMetrics().DelayedMessages)
+	}
+	assert.Zero(t, early.Load())
+	assert.Zero(t, mq.GetSize())
+}
+
+func TestMessageQueue_AckDeliveries(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{
+		Workers: 1, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true,
+		RetryStrategy: &ExponentialBackoffStrategy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1, MaxRetries: 3},
+	})
+	defer mq.Stop()
+
+	deliveries := make(chan *Delivery, 10)
+	mq.SubscribeWithAck("topic", 20*time.Millisecond, func(ctx context.Context, msg *Message, delivery *Delivery) {
+		deliveries <- delivery
+	})
+	ctx := context.Background()
+	next := func() *Delivery {
+		select {
+		case delivery := <-deliveries:
+			return delivery
+		case <-time.After(time.Second):
+			t.Fatal("message was not delivered")
+			return nil
+		}
+	}
+
+	// Ack completes the message, and settling twice fails
+	require.NoError(t, mq.Publish(ctx, "topic", 1))
+	delivery := next()
+	assert.Equal(t, int64(1), mq.GetMetrics().InFlightMessages)
+	require.NoError(t, delivery.Ack())
+	assert.ErrorIs(t, delivery.Ack(), ErrAckExpired)
+	assert.Equal(t, int64(1), mq.GetMetrics().ProcessedMessages)
+
+	// Nack retries the message
+	require.NoError(t, mq.Publish(ctx, "topic", 2))
+	require.NoError(t, next().Nack(assert.AnError))
+	require.NoError(t, next().Ack())
+
+	// An unsettled message is redelivered after the visibility timeout, and the late ack fails
+	require.NoError(t, mq.Publish(ctx, "topic", 3))
+	expired := next()
+	redelivered := next()
+	assert.ErrorIs(t, expired.Ack(), ErrAckExpired)
+	require.NoError(t, redelivered.ExtendVisibility(time.Minute))
+	require.NoError(t, redelivered.Ack())
+
+	metrics := mq.GetMetrics()
+	assert.Equal(t, int64(3), metrics.ProcessedMessages)
+	assert.Equal(t, int64(2), metrics.RetryMessages)
+	assert.Zero(t, metrics.InFlightMessages)
+}
+
+type viewPayload struct {
+	IdeaID string `json:"idea_id"`
+	Count  int    `json:"count"`
+}
+
+func (p viewPayload) Validate() error {
+	if p.IdeaID == "" {
+		return errors.New("idea_id is required")
+	}
+	return nil
+}
+
+func TestSubscribe_DecodesAndDeadLettersInvalidPayloads(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{Workers: 1, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true})
+	defer mq.Stop()
+
+	received := make(chan viewPayload, 3)
+	Subscribe(mq, "views", func(ctx context.Context, msg *Message, payload viewPayload) error {
+		received <- payload
+		return nil
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mq.Publish(ctx, "views", viewPayload{IdeaID: "a", Count: 1}))
+	require.NoError(t, mq.Publish(ctx, "views", []byte(`{"idea_id":"b","count":2}`)))
+	require.NoError(t, mq.Publish(ctx, "views", map[string]interface{}{"idea_id": "c", "count": 3}))
+	for _, want := range []string{"a", "b", "c"} {
+		assert.Equal(t, want, (<-received).IdeaID)
+	}
+
+	// Undecodable and invalid payloads go straight to the DLQ with the error attached
+	require.NoError(t, mq.Publish(ctx, "views", []byte("not json")))
+	require.NoError(t, mq.Publish(ctx, "views", viewPayload{Count: 4}))
+	require.Eventually(t, func() bool { return mq.GetDLQSize() == 2 }, time.Second, time.Millisecond)
+
+	dead := mq.ListDLQ("views")
+	require.Len(t, dead, 2)
+	for _, msg := range dead {
+		assert.Zero(t, msg.RetryCount)
+		assert.Contains(t, msg.Metadata[PayloadErrorKey], ErrInvalidPayload.Error())
+	}
+	assert.Contains(t, dead[1].Metadata[PayloadErrorKey], "idea_id is required")
+	assert.Equal(t, int64(2), mq.GetMetrics().InvalidMessages)
+	assert.Empty(t, received)
+}
+
+func TestMessageQueue_PauseResumeDrain(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{Workers: 2, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true})
+	defer mq.Stop()
+
+	received := make(chan int, 10)
+	release := make(chan struct{})
+	mq.Subscribe("topic", func(ctx context.Context, msg *Message) error {
+		if msg.Payload == 3 {
+			<-release
+		}
+		received <- msg.Payload.(int)
+		return nil
+	})
+	ctx := context.Background()
+
+	// Paused messages are accepted and held until Resume
+	mq.Pause("topic")
+	assert.Equal(t, []string{"topic"}, mq.PausedTopics())
+	require.NoError(t, mq.Publish(ctx, "topic", 1))
+	require.NoError(t, mq.Publish(ctx, "topic", 2))
+	require.Eventually(t, func() bool { return mq.GetMetrics().PausedMessages == 2 }, time.Second, time.Millisecond)
+	assert.Empty(t, received)
+
+	mq.Resume("topic")
+	assert.ElementsMatch(t, []int{1, 2}, []int{<-received, <-received})
+	assert.Empty(t, mq.PausedTopics())
+	assert.Zero(t, mq.GetMetrics().PausedMessages)
+
+	// Drain waits for the running handler, and fails if it does not finish in time
+	require.NoError(t, mq.Publish(ctx, "topic", 3))
+	require.Eventually(t, func() bool { return mq.GetSize() == 0 }, time.Second, time.Millisecond)
+	shortCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, mq.Drain(shortCtx), context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, mq.Drain(ctx))
+	assert.Equal(t, 3, <-received)
+
+	// Once drained, published messages are not delivered
+	require.NoError(t, mq.Publish(ctx, "topic", 4))
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, received)
+}
+
+func TestMessageQueue_PublishBatch(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{MaxSize: 3, Workers: 1, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true})
+	defer mq.Stop()
+	mq.Pause("topic")
+	ctx := context.Background()
+
+	results, err := mq.PublishBatch(ctx, []BatchMessage{
+		{Topic: "topic", Payload: 1},
+		{Topic: "topic", Payload: 2, Options: []PublishOption{WithPriority(PriorityHigh)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.NotEmpty(t, result.ID)
+		assert.NoError(t, result.Err)
+	}
+	assert.NotEqual(t, results[0].ID, results[1].ID)
+
+	// A batch that does not fit is rejected as a whole
+	_, err = mq.PublishBatch(ctx, make([]BatchMessage, 4))
+	assert.ErrorIs(t, err, ErrQueueFull)
+	assert.Equal(t, int64(2), mq.GetMetrics().TotalMessages)
+}
+
+func TestMessageQueue_QuarantinesPoisonMessages(t *testing.T) {
+	mq := NewMessageQueue(QueueConfig{
+		Workers: 1, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true, PoisonThreshold: 2,
+		RetryStrategy: &ExponentialBackoffStrategy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1, MaxRetries: 5},
+	})
+	defer mq.Stop()
+
+	var fixed atomic.Bool
+	handled := make(chan int, 1)
+	mq.Subscribe("topic", func(ctx context.Context, msg *Message) error {
+		Logf(ctx, "handling %v", msg.Payload)
+		if !fixed.Load() {
+			var payload map[string]int
+			payload["count"]++
+		}
+		handled <- msg.Payload.(int)
+		return nil
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mq.Publish(ctx, "topic", 1, WithMaxRetries(5)))
+	require.Eventually(t, func() bool { return mq.GetMetrics().QuarantinedMessages == 1 }, time.Second, time.Millisecond)
+
+	quarantined := mq.ListQuarantined("topic")
+	require.Len(t, quarantined, 1)
+	msg := quarantined[0]
+	assert.Equal(t, StatusQuarantined, msg.Status)
+	assert.Equal(t, 1, msg.RetryCount)
+	assert.Equal(t, 2, msg.Metadata[PanicCountKey])
+	assert.Contains(t, msg.Metadata[PanicValueKey], "nil map")
+	assert.Contains(t, msg.Metadata[PanicStackKey], "TestMessageQueue_QuarantinesPoisonMessages")
+	assert.Equal(t, []string{"handling 1"}, msg.Metadata[HandlerLogKey])
+	assert.Zero(t, mq.GetDLQSize())
+
+	fixed.Store(true)
+	require.NoError(t, mq.ReplayQuarantined(msg.ID))
+	assert.Equal(t, 1, <-handled)
+	assert.ErrorIs(t, mq.ReplayQuarantined(msg.ID), ErrNotQuarantined)
+	assert.Zero(t, mq.GetMetrics().QuarantinedMessages)
+}
+
+func BenchmarkMessageQueue_Publish(b *testing.B) {
+	mq := NewMessageQueue(QueueConfig{MaxSize: 10000, Workers: 4, BatchSize: 1, ExternalDLQCleanup: true})
+	defer mq.Stop()
+	mq.Subscribe("bench", func(ctx context.Context, msg *Message) error { return nil })
+	ctx := context.Background()
+	payload := map[string]string{"idea_id": "1"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for mq.Publish(ctx, "bench", payload) == ErrQueueFull {
+			runtime.Gosched()
+		}
+	}
+}
+
+func BenchmarkMessageQueue_PublishConsume(b *testing.B) {
+	mq := NewMessageQueue(QueueConfig{MaxSize: 10000, Workers: 4, BatchSize: 1, PollInterval: time.Millisecond, ExternalDLQCleanup: true})
+	defer mq.Stop()
+
+	var wg sync.WaitGroup
+	mq.Subscribe("bench", func(ctx context.Context, msg *Message) error {
+		wg.Done()
+		return nil
+	})
+	ctx := context.Background()
+	payload := map[string]string{"idea_id": "1"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	wg.Add(b.N)
+	for i := 0; i < b.N; i++ {
+		for mq.Publish(ctx, "bench", payload) == ErrQueueFull {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+}
+
+// BenchmarkMessageQueue_GCPause publishes and consumes the 100k messages a busy minute brings,
+// with and without recycling messages, and reports the GC pause time that costs.
+func BenchmarkMessageQueue_GCPause(b *testing.B) {
+	const messagesPerMinute = 100000
+
+	for _, tc := range []struct {
+		name    string
+		disable bool
+	}{{"pooled", false}, {"unpooled", true}} {
+		b.Run(tc.name, func(b *testing.B) {
+			mq := NewMessageQueue(QueueConfig{
+				MaxSize: 10000, Workers: 4, BatchSize: 1, PollInterval: time.Millisecond,
+				ExternalDLQCleanup: true, DisableMessagePool: tc.disable,
+			})
+			defer mq.Stop()
+
+			var wg sync.WaitGroup
+			mq.Subscribe("bench", func(ctx context.Context, msg *Message) error {
+				wg.Done()
+				return nil
+			})
+			ctx := context.Background()
+			headers := map[string]string{"request-id": "0b6c8f0e-3c1a-4f0e-9a51-6f1f2d7b9c10"}
+
+			runtime.GC()
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				wg.Add(messagesPerMinute)
+				for j := 0; j < messagesPerMinute; j++ {
+					for mq.Publish(ctx, "bench", j, WithHeaders(headers)) == ErrQueueFull {
+						runtime.Gosched()
+					}
+				}
+				wg.Wait()
+			}
+			b.StopTimer()
+
+			runtime.ReadMemStats(&after)
+			b.ReportMetric(float64(after.PauseTotalNs-before.PauseTotalNs)/float64(b.N), "gc-pause-ns/op")
+			b.ReportMetric(float64(after.NumGC-before.NumGC)/float64(b.N), "gcs/op")
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// Pause stops delivering the messages of topic. Publishing to it keeps working: the messages
+// the workers pick up are set aside until Resume. Handlers already running are not affected.
+func (mq *MessageQueue) Pause(topic string) {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+	mq.paused[topic] = true
+}
+
+// Resume delivers the messages of topic again, starting with the ones set aside while it
+// was paused. It blocks while the queue is full.
+func (mq *MessageQueue) Resume(topic string) {
+	mq.mu.Lock()
+	delete(mq.paused, topic)
+	parked := mq.parked[topic]
+	delete(mq.parked, topic)
+	mq.mu.Unlock()
+
+	for _, msg := range parked {
+		atomic.AddInt64(&mq.metrics.PausedMessages, -1)
+		select {
+		case mq.messages <- msg:
+			atomic.AddInt64(&mq.metrics.CurrentSize, 1)
+		case <-mq.ctx.Done():
+			return
+		}
+	}
+}
+
+// PausedTopics returns the topics whose delivery is paused, sorted.
+func (mq *MessageQueue) PausedTopics() []string {
+	mq.mu.RLock()
+	defer mq.mu.RUnlock()
+
+	topics := make([]string, 0, len(mq.paused))
+	for topic := range mq.paused {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// park sets msg aside if its topic is paused.
+func (mq *MessageQueue) park(msg *Message) bool {
+	mq.mu.RLock()
+	paused := mq.paused[msg.Topic]
+	mq.mu.RUnlock()
+	if !paused {
+		return false
+	}
+
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+	// Resume may have run in between
+	if !mq.paused[msg.Topic] {
+		return false
+	}
+	mq.parked[msg.Topic] = append(mq.parked[msg.Topic], msg)
+	atomic.AddInt64(&mq.metrics.PausedMessages, 1)
+	return true
+}
+
+// Drain stops delivering messages and waits until no handler is running and every
+// AckHandler delivery is settled, or until ctx is done. It is meant to run before Stop on
+// shutdown: delivery is not resumed, and messages still queued are not delivered.
+func (mq *MessageQueue) Drain(ctx context.Context) error {
+	atomic.StoreInt32(&mq.draining, 1)
+
+	ticker := time.NewTicker(mq.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if int(atomic.LoadInt32(&mq.drainedWorkers)) == mq.config.Workers && mq.inFlightCount() == 0 {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (mq *MessageQueue) isDraining() bool {
+	return atomic.LoadInt32(&mq.draining) == 1
+}
@@ -0,0 +1,178 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// ErrHandlerPanic is wrapped by the processing error of a message whose handler panicked.
+	ErrHandlerPanic   = errors.New("handler panicked")
+	ErrNotQuarantined = errors.New("message is not quarantined")
+)
+
+// Metadata a message carries about the last time its handler panicked.
+const (
+	PanicCountKey = "panic_count"
+	PanicValueKey = "panic_value"
+	PanicStackKey = "panic_stack"
+	HandlerLogKey = "handler_log"
+)
+
+// maxHandlerLogLines bounds the lines Logf keeps per delivery; later lines are dropped.
+const maxHandlerLogLines = 50
+
+type handlerPanic struct {
+	value interface{}
+	stack []byte
+}
+
+func (p *handlerPanic) Error() string {
+	return fmt.Sprintf("%v: %v", ErrHandlerPanic, p.value)
+}
+
+func (p *handlerPanic) Unwrap() error {
+	return ErrHandlerPanic
+}
+
+type handlerLogKey struct{}
+
+// handlerLog collects the lines a handler writes with Logf during one delivery.
+type handlerLog struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+// Logf adds a line to the log of the message being handled. The log is attached to the
+// message when its handler panics, so it can be inspected once the message is quarantined.
+// Outside of a handler it does nothing.
+func Logf(ctx context.Context, format string, args ...interface{}) {
+	log, ok := ctx.Value(handlerLogKey{}).(*handlerLog)
+	if !ok {
+		return
+	}
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	if len(log.lines) < maxHandlerLogLines {
+		log.lines = append(log.lines, fmt.Sprintf(format, args...))
+	}
+}
+
+func withHandlerLog(ctx context.Context) (context.Context, *handlerLog) {
+	log := &handlerLog{}
+	return context.WithValue(ctx, handlerLogKey{}, log), log
+}
+
+func (l *handlerLog) snapshot() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+// callHandler runs handler, turning a panic into a *handlerPanic error.
+func callHandler(ctx context.Context, msg *Message, handler MessageHandler) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = &handlerPanic{value: recovered, stack: debug.Stack()}
+		}
+	}()
+	return handler(ctx, msg)
+}
+
+// failMessage quarantines msg if its handler has now panicked PoisonThreshold times, and
+// otherwise retries or dead-letters it.
+func (mq *MessageQueue) failMessage(msg *Message, err error, log *handlerLog) {
+	var panicked *handlerPanic
+	if !errors.As(err, &panicked) {
+		mq.handleProcessingError(msg, err)
+		return
+	}
+
+	if msg.Metadata == nil {
+		msg.Metadata = make(map[string]interface{})
+	}
+	count, _ := msg.Metadata[PanicCountKey].(int)
+	count++
+	msg.Metadata[PanicCountKey] = count
+	msg.Metadata[PanicValueKey] = fmt.Sprint(panicked.value)
+	msg.Metadata[PanicStackKey] = string(panicked.stack)
+	msg.Metadata[HandlerLogKey] = log.snapshot()
+
+	if mq.config.PoisonThreshold < 0 || count < mq.config.PoisonThreshold {
+		mq.handleProcessingError(msg, err)
+		return
+	}
+
+	msg.Status = StatusQuarantined
+	mq.quarantine.mu.Lock()
+	mq.quarantine.messages[msg.ID] = msg
+	mq.quarantine.mu.Unlock()
+	atomic.AddInt64(&mq.metrics.FailedMessages, 1)
+
+	if mq.onProcessed != nil {
+		mq.onProcessed(msg, err)
+	}
+}
+
+// quarantineStore holds the poison messages taken out of the queue. Like the DLQ, its
+// messages are never recycled.
+type quarantineStore struct {
+	mu       sync.Mutex
+	messages map[string]*Message
+}
+
+// ListQuarantined returns copies of the quarantined messages of topic, or of every topic if
+// it is empty, oldest first.
+func (mq *MessageQueue) ListQuarantined(topic string) []*Message {
+	mq.quarantine.mu.Lock()
+	defer mq.quarantine.mu.Unlock()
+
+	var messages []*Message
+	for _, msg := range mq.quarantine.messages {
+		if topic == "" || msg.Topic == topic {
+			messages = append(messages, msg.Clone())
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].CreatedAt.Before(messages[j].CreatedAt) })
+	return messages
+}
+
+// ReplayQuarantined puts a quarantined message back in the queue, usually once the handler is
+// fixed. Its panic count starts over; the last panic stays in its metadata until it panics again.
+func (mq *MessageQueue) ReplayQuarantined(messageID string) error {
+	mq.quarantine.mu.Lock()
+	defer mq.quarantine.mu.Unlock()
+
+	msg, ok := mq.quarantine.messages[messageID]
+	if !ok {
+		return ErrNotQuarantined
+	}
+
+	msg.Status = StatusPending
+	msg.RetryCount = 0
+	msg.DelayUntil = nil
+	count := msg.Metadata[PanicCountKey]
+	delete(msg.Metadata, PanicCountKey)
+
+	select {
+	case mq.messages <- msg:
+		delete(mq.quarantine.messages, messageID)
+		atomic.AddInt64(&mq.metrics.CurrentSize, 1)
+		return nil
+	default:
+		msg.Status = StatusQuarantined
+		msg.Metadata[PanicCountKey] = count
+		return ErrQueueFull
+	}
+}
+
+func (mq *MessageQueue) quarantinedCount() int {
+	mq.quarantine.mu.Lock()
+	defer mq.quarantine.mu.Unlock()
+	return len(mq.quarantine.messages)
+}
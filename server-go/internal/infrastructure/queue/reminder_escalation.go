@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ReminderEscalationTopic carries the IDs of reminders whose next escalation step is due.
+const ReminderEscalationTopic = "reminders.escalation"
+
+// ReminderEscalationQueue implements ports.ReminderEscalationQueue on top of a MessageQueue.
+type ReminderEscalationQueue struct {
+	mq *MessageQueue
+}
+
+// NewReminderEscalationQueue subscribes escalate to ReminderEscalationTopic. escalate must
+// re-check the reminder, since the scheduler may enqueue it again before it is processed.
+func NewReminderEscalationQueue(mq *MessageQueue, escalate func(ctx context.Context, reminderID uuid.UUID) error) *ReminderEscalationQueue {
+	Subscribe(mq, ReminderEscalationTopic, func(ctx context.Context, msg *Message, reminderID uuid.UUID) error {
+		return escalate(contextFromHeaders(ctx, msg.Headers), reminderID)
+	})
+	return &ReminderEscalationQueue{mq: mq}
+}
+
+// EnqueueReminderEscalation publishes reminderID with high priority: escalations are time-sensitive.
+func (q *ReminderEscalationQueue) EnqueueReminderEscalation(ctx context.Context, reminderID uuid.UUID) error {
+	return q.mq.Publish(ctx, ReminderEscalationTopic, reminderID, WithPriority(PriorityHigh), WithHeaders(eventHeaders(ctx)))
+}
@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// TextExtractionTopic carries the IDs of files whose text must be extracted and indexed.
+const TextExtractionTopic = "files.text_extraction"
+
+// TextExtractionQueue implements ports.TextExtractionQueue on top of a MessageQueue.
+type TextExtractionQueue struct {
+	mq *MessageQueue
+}
+
+// NewTextExtractionQueue subscribes extract to TextExtractionTopic. Failed extractions are
+// retried with the queue's strategy and end up in the DLQ, where they can be requeued.
+func NewTextExtractionQueue(mq *MessageQueue, extract func(ctx context.Context, fileID uuid.UUID) error) *TextExtractionQueue {
+	Subscribe(mq, TextExtractionTopic, func(ctx context.Context, msg *Message, fileID uuid.UUID) error {
+		return extract(contextFromHeaders(ctx, msg.Headers), fileID)
+	})
+	return &TextExtractionQueue{mq: mq}
+}
+
+// EnqueueTextExtraction publishes fileID with low priority so extraction never delays other work.
+func (q *TextExtractionQueue) EnqueueTextExtraction(ctx context.Context, fileID uuid.UUID) error {
+	return q.mq.Publish(ctx, TextExtractionTopic, fileID, WithPriority(PriorityLow), WithHeaders(eventHeaders(ctx)))
+}
+
+// Event context headers let events published by a consumer be traced back to the request
+// and event that enqueued the message.
+const (
+	actorHeader       = "actor-id"
+	requestIDHeader   = "request-id"
+	correlationHeader = "correlation-id"
+	causationHeader   = "causation-id"
+)
+
+func eventHeaders(ctx context.Context) map[string]string {
+	eventCtx := entities.EventContextFromContext(ctx)
+	headers := make(map[string]string)
+	if eventCtx.ActorID != uuid.Nil {
+		headers[actorHeader] = eventCtx.ActorID.String()
+	}
+	for key, value := range map[string]string{
+		requestIDHeader:   eventCtx.RequestID,
+		correlationHeader: eventCtx.CorrelationID,
+		causationHeader:   eventCtx.CausationID,
+	} {
+		if value != "" {
+			headers[key] = value
+		}
+	}
+	return headers
+}
+
+func contextFromHeaders(ctx context.Context, headers map[string]string) context.Context {
+	eventCtx := entities.EventContext{
+		RequestID:     headers[requestIDHeader],
+		CorrelationID: headers[correlationHeader],
+		CausationID:   headers[causationHeader],
+	}
+	if actorID, err := uuid.Parse(headers[actorHeader]); err == nil {
+		eventCtx.ActorID = actorID
+	}
+	return entities.ContextWithEventContext(ctx, eventCtx)
+}
@@ -0,0 +1,111 @@
+package queue
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrInvalidPayload wraps the decode or validation error of a payload a typed handler cannot
+// accept. Such messages are dead-lettered without retries.
+var ErrInvalidPayload = errors.New("invalid message payload")
+
+// PayloadErrorKey is the metadata key an invalid message carries its decode error in.
+const PayloadErrorKey = "payload_error"
+
+// TypedHandler processes a message whose payload has been decoded into T.
+type TypedHandler[T any] func(ctx context.Context, msg *Message, payload T) error
+
+//go:generate mockery --name Validator --with-expecter --case underscore
+
+// Validator is implemented by payloads that check their own schema once decoded.
+type Validator interface {
+	Validate() error
+}
+
+// Subscribe registers handler for topic, decoding every payload into T. Payloads published
+// in-process as T are passed through; []byte and json.RawMessage are unmarshaled as protobuf
+// when T is a proto.Message and as JSON otherwise; strings are read with UnmarshalText when T
+// supports it; anything else, like the maps a serialized message comes back with, is
+// converted through JSON. If T implements Validator, the decoded payload must pass Validate.
+func Subscribe[T any](mq *MessageQueue, topic string, handler TypedHandler[T]) {
+	mq.Subscribe(topic, func(ctx context.Context, msg *Message) error {
+		payload, err := DecodePayload[T](msg.Payload)
+		if err != nil {
+			if msg.Metadata == nil {
+				msg.Metadata = make(map[string]interface{})
+			}
+			msg.Metadata[PayloadErrorKey] = err.Error()
+			return err
+		}
+		return handler(ctx, msg, payload)
+	})
+}
+
+// DecodePayload converts payload into T the way Subscribe does. Errors wrap ErrInvalidPayload.
+func DecodePayload[T any](payload interface{}) (T, error) {
+	value, err := decodePayload[T](payload)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if validator, ok := any(value).(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return value, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+	} else if validator, ok := any(&value).(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return value, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+	}
+	return value, nil
+}
+
+func decodePayload[T any](payload interface{}) (T, error) {
+	var value T
+
+	switch v := payload.(type) {
+	case T:
+		return v, nil
+	case *T:
+		if v == nil {
+			return value, errors.New("nil payload")
+		}
+		return *v, nil
+	case nil:
+		return value, errors.New("nil payload")
+	case []byte:
+		return value, unmarshalPayload(v, &value)
+	case json.RawMessage:
+		return value, unmarshalPayload(v, &value)
+	case string:
+		if unmarshaler, ok := any(&value).(encoding.TextUnmarshaler); ok {
+			return value, unmarshaler.UnmarshalText([]byte(v))
+		}
+		return value, json.Unmarshal([]byte(v), &value)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return value, err
+	}
+	return value, json.Unmarshal(data, &value)
+}
+
+// unmarshalPayload decodes data into target, a *T. Proto messages are pointers, so when T
+// is one its message is allocated first.
+func unmarshalPayload(data []byte, target interface{}) error {
+	elem := reflect.ValueOf(target).Elem()
+	if elem.Kind() == reflect.Pointer {
+		if _, ok := elem.Interface().(proto.Message); ok {
+			elem.Set(reflect.New(elem.Type().Elem()))
+			return proto.Unmarshal(data, elem.Interface().(proto.Message))
+		}
+	}
+	return json.Unmarshal(data, target)
+}
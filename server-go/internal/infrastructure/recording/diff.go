@@ -0,0 +1,100 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Diff compares two responses rendered with MarshalOptions and returns the
+// paths of the values that differ, such as "ideas[2].title: "a" != "b"".
+// Fields named in ignore are skipped at any depth.
+func Diff(before, after []byte, ignore map[string]bool) ([]string, error) {
+	if bytes.Equal(before, after) {
+		return nil, nil
+	}
+
+	var beforeValue, afterValue interface{}
+	if err := json.Unmarshal(before, &beforeValue); err != nil {
+		return nil, fmt.Errorf("failed to decode the expected response: %w", err)
+	}
+	if err := json.Unmarshal(after, &afterValue); err != nil {
+		return nil, fmt.Errorf("failed to decode the compared response: %w", err)
+	}
+
+	var differences []string
+	diffValues("", beforeValue, afterValue, ignore, &differences)
+	return differences, nil
+}
+
+func diffValues(path string, before, after interface{}, ignore map[string]bool, differences *[]string) {
+	switch b := before.(type) {
+	case map[string]interface{}:
+		a, ok := after.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make(map[string]bool, len(b)+len(a))
+		for key := range b {
+			keys[key] = true
+		}
+		for key := range a {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			if !ignore[key] {
+				sorted = append(sorted, key)
+			}
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			diffValues(joinPath(path, key), b[key], a[key], ignore, differences)
+		}
+		return
+	case []interface{}:
+		a, ok := after.([]interface{})
+		if !ok {
+			break
+		}
+		if len(a) != len(b) {
+			*differences = append(*differences, fmt.Sprintf("%s: %d items != %d items", displayPath(path), len(b), len(a)))
+			return
+		}
+		for i := range b {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), b[i], a[i], ignore, differences)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*differences = append(*differences, fmt.Sprintf("%s: %s != %s", displayPath(path), formatValue(before), formatValue(after)))
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "response"
+	}
+	return path
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
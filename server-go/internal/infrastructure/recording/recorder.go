@@ -0,0 +1,278 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// FilePattern matches the files written by a Recorder inside its directory.
+const FilePattern = "rpc-*.jsonl"
+
+// MarshalOptions is shared by the recorder and the replay tool so recorded and
+// replayed responses are rendered the same way before being compared.
+var MarshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+type Config struct {
+	// Dir receives the recordings, one JSON line per call.
+	Dir string `json:"dir"`
+	// Methods restricts recording to these full method names; empty records every unary method.
+	Methods []string `json:"methods"`
+	// SampleRate is the fraction of matching calls that are recorded, between 0 and 1.
+	SampleRate float64 `json:"sample_rate"`
+	// MaxFileSize starts a new file once the current one reaches this many bytes.
+	MaxFileSize int64 `json:"max_file_size"`
+	// RedactFields lists proto field names whose values never reach the disk.
+	// Defaults to DefaultRedactFields.
+	RedactFields []string `json:"redact_fields"`
+}
+
+// Record is a single recorded call.
+type Record struct {
+	Method       string          `json:"method"`
+	RequestID    string          `json:"request_id,omitempty"`
+	RecordedAt   time.Time       `json:"recorded_at"`
+	DurationMs   float64         `json:"duration_ms"`
+	RequestType  string          `json:"request_type"`
+	Request      json.RawMessage `json:"request"`
+	ResponseType string          `json:"response_type,omitempty"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	Code         string          `json:"code"`
+	Message      string          `json:"message,omitempty"`
+}
+
+// Recorder writes sanitized request/response pairs of unary calls to disk so
+// they can be replayed later against another server build. Streams are not
+// recorded.
+type Recorder struct {
+	config  Config
+	methods map[string]bool
+	redact  map[string]bool
+
+	mu          sync.Mutex
+	file        *os.File
+	writer      *bufio.Writer
+	written     int64
+	recorded    int64
+	writeErrors int64
+}
+
+func NewRecorder(config Config) (*Recorder, error) {
+	if config.Dir == "" {
+		return nil, fmt.Errorf("recording directory is required")
+	}
+	if config.SampleRate <= 0 || config.SampleRate > 1 {
+		config.SampleRate = 1
+	}
+	if config.MaxFileSize <= 0 {
+		config.MaxFileSize = 64 << 20
+	}
+	if config.RedactFields == nil {
+		config.RedactFields = DefaultRedactFields
+	}
+	if err := os.MkdirAll(config.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create recording directory: %w", err)
+	}
+
+	r := &Recorder{
+		config:  config,
+		methods: make(map[string]bool, len(config.Methods)),
+		redact:  make(map[string]bool, len(config.RedactFields)),
+	}
+	for _, method := range config.Methods {
+		r.methods[method] = true
+	}
+	for _, field := range config.RedactFields {
+		r.redact[field] = true
+	}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Recorder) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !r.shouldRecord(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		r.record(ctx, info.FullMethod, start, req, resp, err)
+		return resp, err
+	}
+}
+
+func (r *Recorder) shouldRecord(method string) bool {
+	if len(r.methods) > 0 && !r.methods[method] {
+		return false
+	}
+	return r.config.SampleRate >= 1 || rand.Float64() < r.config.SampleRate
+}
+
+func (r *Recorder) record(ctx context.Context, method string, start time.Time, req, resp interface{}, err error) {
+	record := Record{
+		Method:     method,
+		RecordedAt: start.UTC(),
+		DurationMs: float64(time.Since(start)) / float64(time.Millisecond),
+	}
+	record.RequestID, _ = logging.RequestIDFromContext(ctx)
+
+	st := status.Convert(err)
+	record.Code = st.Code().String()
+	record.Message = st.Message()
+
+	var marshalErr error
+	if message, ok := req.(proto.Message); ok {
+		record.RequestType = string(message.ProtoReflect().Descriptor().FullName())
+		record.Request, marshalErr = r.marshal(message)
+	}
+	if message, ok := resp.(proto.Message); ok && err == nil && marshalErr == nil {
+		record.ResponseType = string(message.ProtoReflect().Descriptor().FullName())
+		record.Response, marshalErr = r.marshal(message)
+	}
+	if marshalErr != nil || record.RequestType == "" {
+		r.mu.Lock()
+		r.writeErrors++
+		r.mu.Unlock()
+		return
+	}
+
+	line, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		r.mu.Lock()
+		r.writeErrors++
+		r.mu.Unlock()
+		return
+	}
+	r.write(append(line, '\n'))
+}
+
+// marshal renders a sanitized copy so the handler's message is never modified.
+func (r *Recorder) marshal(message proto.Message) (json.RawMessage, error) {
+	clone := proto.Clone(message)
+	Sanitize(clone.ProtoReflect(), r.redact)
+	return MarshalOptions.Marshal(clone)
+}
+
+func (r *Recorder) write(line []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.writer == nil {
+		r.writeErrors++
+		return
+	}
+	if r.written > 0 && r.written+int64(len(line)) > r.config.MaxFileSize {
+		if err := r.rotate(); err != nil {
+			r.writeErrors++
+			return
+		}
+	}
+	// Flushed per record so a crash loses at most the call in flight
+	n, err := r.writer.Write(line)
+	if err == nil {
+		err = r.writer.Flush()
+	}
+	r.written += int64(n)
+	if err != nil {
+		r.writeErrors++
+		return
+	}
+	r.recorded++
+}
+
+// rotate closes the current file and opens a new one. Callers hold mu, except
+// NewRecorder which runs before the recorder is shared.
+func (r *Recorder) rotate() error {
+	if r.file != nil {
+		r.writer.Flush()
+		r.file.Close()
+		r.file, r.writer = nil, nil
+	}
+
+	name := fmt.Sprintf("rpc-%s.jsonl", time.Now().UTC().Format("20060102T150405.000000000"))
+	file, err := os.OpenFile(filepath.Join(r.config.Dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("open recording file: %w", err)
+	}
+	r.file = file
+	r.writer = bufio.NewWriter(file)
+	r.written = 0
+	return nil
+}
+
+// Close flushes and closes the current recording file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	flushErr := r.writer.Flush()
+	closeErr := r.file.Close()
+	r.file, r.writer = nil, nil
+	if flushErr != nil {
+		return flushErr
+	}
+	return closeErr
+}
+
+// Metrics is a metrics.MetricsCollector collector for recorded calls and write failures.
+func (r *Recorder) Metrics() []metrics.Metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "grpc_recorded_calls_total", Type: metrics.Counter, Value: float64(r.recorded), Timestamp: now},
+		{Name: "grpc_recording_errors_total", Type: metrics.Counter, Value: float64(r.writeErrors), Timestamp: now},
+	}
+}
+
+// ReadFile loads every record of a recording file, in the order they were written.
+func ReadFile(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,141 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testDescriptor describes
+//
+//	message Login { string email = 1; string password = 2; bytes data = 3; Login nested = 4; repeated Login items = 5; string title = 6; }
+func testDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	field := func(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Type: kind.Enum(), Label: label.Enum()}
+		if kind == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			f.TypeName = proto.String(".recording.test.Login")
+		}
+		return f
+	}
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("recording_test.proto"),
+		Package: proto.String("recording.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Login"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("email", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+				field("password", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+				field("data", 3, descriptorpb.FieldDescriptorProto_TYPE_BYTES, optional),
+				field("nested", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional),
+				field("items", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, repeated),
+				field("title", 6, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+			},
+		}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file.Messages().Get(0)
+}
+
+func newLogin(desc protoreflect.MessageDescriptor, title string) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(desc)
+	m.Set(desc.Fields().ByName("email"), protoreflect.ValueOfString("ana@example.com"))
+	m.Set(desc.Fields().ByName("password"), protoreflect.ValueOfString("hunter2"))
+	m.Set(desc.Fields().ByName("data"), protoreflect.ValueOfBytes([]byte{1, 2, 3}))
+	m.Set(desc.Fields().ByName("title"), protoreflect.ValueOfString(title))
+	return m
+}
+
+func TestSanitizeRedactsNestedFields(t *testing.T) {
+	desc := testDescriptor(t)
+	root := newLogin(desc, "root")
+	root.Set(desc.Fields().ByName("nested"), protoreflect.ValueOfMessage(newLogin(desc, "nested")))
+	items := root.Mutable(desc.Fields().ByName("items")).List()
+	items.Append(protoreflect.ValueOfMessage(newLogin(desc, "item")))
+
+	Sanitize(root, map[string]bool{"email": true, "password": true})
+
+	for _, m := range []protoreflect.Message{root, root.Get(desc.Fields().ByName("nested")).Message(), items.Get(0).Message()} {
+		if got := m.Get(desc.Fields().ByName("password")).String(); got != Redacted {
+			t.Errorf("password = %q, want %q", got, Redacted)
+		}
+		if got := m.Get(desc.Fields().ByName("email")).String(); got != Redacted {
+			t.Errorf("email = %q, want %q", got, Redacted)
+		}
+		if m.Has(desc.Fields().ByName("data")) {
+			t.Error("bytes field was not dropped")
+		}
+		if m.Get(desc.Fields().ByName("title")).String() == "" {
+			t.Error("unredacted field was cleared")
+		}
+	}
+}
+
+func TestUnaryInterceptorRecordsSanitizedCalls(t *testing.T) {
+	desc := testDescriptor(t)
+	dir := t.TempDir()
+	recorder, err := NewRecorder(Config{Dir: dir, Methods: []string{"/test.Service/Login", "/test.Service/Fail"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := recorder.UnaryInterceptor()
+
+	req := newLogin(desc, "request")
+	resp := newLogin(desc, "response")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return resp, nil }
+	if _, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Login"}, handler); err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Get(desc.Fields().ByName("password")).String(); got != "hunter2" {
+		t.Fatalf("the handler's request was modified: password = %q", got)
+	}
+
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "idea not found")
+	}
+	interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Fail"}, failing)
+	interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Other"}, handler)
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, FilePattern))
+	if len(files) != 1 {
+		t.Fatalf("expected one recording file, got %v", files)
+	}
+	records, err := ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+
+	var request map[string]interface{}
+	if err := json.Unmarshal(records[0].Request, &request); err != nil {
+		t.Fatal(err)
+	}
+	if request["password"] != Redacted || request["title"] != "request" {
+		t.Errorf("unexpected recorded request %s", records[0].Request)
+	}
+	if records[0].RequestType != "recording.test.Login" || records[0].Code != codes.OK.String() || len(records[0].Response) == 0 {
+		t.Errorf("unexpected record %+v", records[0])
+	}
+	if records[1].Code != codes.NotFound.String() || records[1].Message != "idea not found" || records[1].Response != nil {
+		t.Errorf("unexpected error record %+v", records[1])
+	}
+}
@@ -0,0 +1,82 @@
+package recording
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Redacted replaces the value of redacted string fields, so a replayed request
+// still carries the field and fails validation the same way in both builds.
+const Redacted = "REDACTED"
+
+// DefaultRedactFields are the credential, contact and secret fields of the
+// Notebook protos. Bytes fields are always dropped, whatever their name, since
+// they hold file contents and encrypted payloads.
+var DefaultRedactFields = []string{
+	"password",
+	"current_password",
+	"new_password",
+	"token",
+	"access_token",
+	"refresh_token",
+	"session_token",
+	"reset_token",
+	"confirmation_token",
+	"device_token",
+	"api_key",
+	"secret",
+	"private_key",
+	"authorization_code",
+	"verification_code",
+	"code",
+	"join_code",
+	"email",
+	"email_address",
+	"email_or_username",
+	"phone_number",
+	"decrypted_data",
+	"encrypted_data",
+}
+
+// Sanitize redacts the named fields and drops bytes fields in m and every
+// message nested in it, in place.
+func Sanitize(m protoreflect.Message, redact map[string]bool) {
+	// Fields are collected first because a message must not be mutated while ranging over it
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		switch {
+		case redact[string(fd.Name())]:
+			if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+				m.Set(fd, protoreflect.ValueOfString(Redacted))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.BytesKind {
+				m.Clear(fd)
+			} else if isMessage(fd.MapValue()) {
+				m.Mutable(fd).Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					Sanitize(value.Message(), redact)
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Clear(fd)
+		case fd.IsList() && isMessage(fd):
+			list := m.Mutable(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				Sanitize(list.Get(i).Message(), redact)
+			}
+		case isMessage(fd):
+			Sanitize(m.Mutable(fd).Message(), redact)
+		}
+	}
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
@@ -0,0 +1,126 @@
+package reload
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+)
+
+// LogLevel applies Settings.LogLevel to logger, or fallback when it is unset.
+func LogLevel(logger *logging.StructuredLogger, fallback logging.LogLevel) Component {
+	return Component{
+		Name: "log_level",
+		Prepare: func(settings Settings) (func(), error) {
+			level := fallback
+			if settings.LogLevel != "" {
+				parsed, err := logging.ParseLogLevel(settings.LogLevel)
+				if err != nil {
+					return nil, err
+				}
+				level = parsed
+			}
+			return func() { logger.SetLevel(level) }, nil
+		},
+	}
+}
+
+// RateLimit applies Settings.RateLimits[name] to limiter, or fallback when it is unset.
+func RateLimit(name string, limiter *security.RateLimiter, fallback int) Component {
+	return Component{
+		Name: "rate_limits." + name,
+		Prepare: func(settings Settings) (func(), error) {
+			limit, ok := settings.RateLimits[name]
+			if !ok {
+				limit = fallback
+			}
+			if limit <= 0 {
+				return nil, fmt.Errorf("limit must be positive, got %d", limit)
+			}
+			return func() { limiter.SetLimit(limit) }, nil
+		},
+	}
+}
+
+// Feature passes Settings.Features[name], or fallback when it is unset, to set.
+func Feature(name string, fallback bool, set func(enabled bool)) Component {
+	return Component{
+		Name: "features." + name,
+		Prepare: func(settings Settings) (func(), error) {
+			enabled, ok := settings.Features[name]
+			if !ok {
+				enabled = fallback
+			}
+			return func() { set(enabled) }, nil
+		},
+	}
+}
+
+// AllowList applies Settings.AllowedIPs[name] to list, or fallback when it is unset.
+func AllowList(name string, list *security.IPAllowList, fallback []netip.Prefix) Component {
+	return Component{
+		Name: "allowed_ips." + name,
+		Prepare: func(settings Settings) (func(), error) {
+			entries, ok := settings.AllowedIPs[name]
+			if !ok {
+				return func() { list.Set(fallback) }, nil
+			}
+			prefixes, err := security.ParseIPAllowList(entries)
+			if err != nil {
+				return nil, err
+			}
+			return func() { list.Set(prefixes) }, nil
+		},
+	}
+}
+
+// Certificate serves a TLS certificate that can be swapped while the server
+// runs; connections already established keep the certificate they negotiated.
+type Certificate struct {
+	current atomic.Pointer[tls.Certificate]
+}
+
+func LoadCertificate(certFile, keyFile string) (*Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	c := &Certificate{}
+	c.current.Store(&cert)
+	return c, nil
+}
+
+func (c *Certificate) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return c.current.Load(), nil
+}
+
+// TLSConfig returns a server configuration that always presents the current certificate.
+func (c *Certificate) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: c.GetCertificate,
+	}
+}
+
+// Component reloads the certificate from Settings.TLS, or from fallback when
+// the file does not set both paths. The files are read on every reload, so
+// renewed certificates are picked up even when the paths do not change.
+func (c *Certificate) Component(fallback TLSSettings) Component {
+	return Component{
+		Name: "tls",
+		Prepare: func(settings Settings) (func(), error) {
+			files := settings.TLS
+			if files.CertFile == "" || files.KeyFile == "" {
+				files = fallback
+			}
+			cert, err := tls.LoadX509KeyPair(files.CertFile, files.KeyFile)
+			if err != nil {
+				return nil, err
+			}
+			return func() { c.current.Store(&cert) }, nil
+		},
+	}
+}
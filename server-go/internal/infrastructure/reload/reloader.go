@@ -0,0 +1,205 @@
+package reload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+var ErrInvalidComponent = errors.New("component requires a name and a prepare function")
+
+// Settings are the values that can change without restarting the server. Unset
+// fields fall back to what each component was configured with at startup, so
+// removing a line from the file reverts that setting.
+type Settings struct {
+	LogLevel string `json:"log_level"`
+	// RateLimits are requests per minute by limiter name, e.g. "share" or "inbound".
+	RateLimits map[string]int `json:"rate_limits"`
+	// Features toggles behavior by flag name, e.g. "grpc_compression".
+	Features map[string]bool `json:"features"`
+	// AllowedIPs are the networks, or single addresses, admitted by each allow-list, e.g. "admin_http".
+	AllowedIPs map[string][]string `json:"allowed_ips"`
+	TLS        TLSSettings         `json:"tls"`
+}
+
+type TLSSettings struct {
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+}
+
+// PrepareFunc validates the settings a component uses and returns the function
+// that applies them. Commits run only after every component prepared
+// successfully, so an invalid file changes nothing.
+type PrepareFunc func(settings Settings) (commit func(), err error)
+
+type Component struct {
+	Name    string
+	Prepare PrepareFunc
+}
+
+type Config struct {
+	// Path of the JSON settings file. SIGHUP and changes to the file trigger a reload.
+	Path string `json:"path"`
+	// PollInterval is how often the file's modification time is checked; zero only reloads on SIGHUP.
+	PollInterval time.Duration `json:"poll_interval"`
+	// OnReload is called after every reload attempt, with the error that rejected it if any.
+	OnReload func(settings Settings, err error) `json:"-"`
+}
+
+// Reloader reads the settings file and swaps the settings of every registered
+// component at once.
+type Reloader struct {
+	config Config
+
+	mu         sync.Mutex
+	components []Component
+	current    Settings
+	modTime    time.Time
+	reloads    int64
+	failures   int64
+}
+
+func NewReloader(config Config) *Reloader {
+	return &Reloader{config: config}
+}
+
+func (r *Reloader) Register(component Component) error {
+	if component.Name == "" || component.Prepare == nil {
+		return ErrInvalidComponent
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.components = append(r.components, component)
+	return nil
+}
+
+// Reload reads the file and applies it to every component, or to none of them
+// if the file or any component rejects it.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	settings, modTime, err := r.read()
+	if err == nil {
+		err = r.apply(settings)
+	}
+	// A rejected file is not retried by the poller until it changes again
+	if !modTime.IsZero() {
+		r.modTime = modTime
+	}
+	if err != nil {
+		r.failures++
+	} else {
+		r.current = settings
+		r.reloads++
+	}
+	if r.config.OnReload != nil {
+		r.config.OnReload(settings, err)
+	}
+	return err
+}
+
+func (r *Reloader) read() (Settings, time.Time, error) {
+	var settings Settings
+	info, err := os.Stat(r.config.Path)
+	if err != nil {
+		return settings, time.Time{}, fmt.Errorf("read settings: %w", err)
+	}
+	data, err := os.ReadFile(r.config.Path)
+	if err != nil {
+		return settings, info.ModTime(), fmt.Errorf("read settings: %w", err)
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, info.ModTime(), fmt.Errorf("parse %s: %w", r.config.Path, err)
+	}
+	return settings, info.ModTime(), nil
+}
+
+func (r *Reloader) apply(settings Settings) error {
+	commits := make([]func(), 0, len(r.components))
+	var errs []error
+	for _, component := range r.components {
+		commit, err := component.Prepare(settings)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", component.Name, err))
+			continue
+		}
+		if commit != nil {
+			commits = append(commits, commit)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, commit := range commits {
+		commit()
+	}
+	return nil
+}
+
+// Current returns the settings applied by the last successful reload.
+func (r *Reloader) Current() Settings {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// Watch reloads on SIGHUP and, with a poll interval, whenever the file's
+// modification time changes, until ctx is cancelled.
+func (r *Reloader) Watch(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	var tick <-chan time.Time
+	if r.config.PollInterval > 0 {
+		ticker := time.NewTicker(r.config.PollInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			r.Reload()
+		case <-tick:
+			if r.changed() {
+				r.Reload()
+			}
+		}
+	}
+}
+
+func (r *Reloader) changed() bool {
+	info, err := os.Stat(r.config.Path)
+	if err != nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !info.ModTime().Equal(r.modTime)
+}
+
+// Metrics is a metrics.MetricsCollector collector for applied and rejected reloads.
+func (r *Reloader) Metrics() []metrics.Metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "config_reloads_total", Type: metrics.Counter, Value: float64(r.reloads), Timestamp: now},
+		{Name: "config_reload_failures_total", Type: metrics.Counter, Value: float64(r.failures), Timestamp: now},
+	}
+}
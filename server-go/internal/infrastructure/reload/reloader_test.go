@@ -0,0 +1,114 @@
+package reload
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+)
+
+func writeSettings(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadAppliesAllComponentsOrNone(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	logger := logging.NewStructuredLogger(logging.LoggerConfig{Level: logging.INFO, Format: "json"})
+	limiter := security.NewRateLimiter(30, time.Minute)
+	compression := true
+
+	reloader := NewReloader(Config{Path: path})
+	for _, component := range []Component{
+		LogLevel(logger, logging.INFO),
+		RateLimit("share", limiter, 30),
+		Feature("grpc_compression", true, func(enabled bool) { compression = enabled }),
+	} {
+		if err := reloader.Register(component); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeSettings(t, path, `{"log_level": "debug", "rate_limits": {"share": 5}, "features": {"grpc_compression": false}}`)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if logger.GetLevel() != logging.DEBUG || limiter.Limit() != 5 || compression {
+		t.Fatalf("settings not applied: level=%s limit=%d compression=%v", logger.GetLevel(), limiter.Limit(), compression)
+	}
+
+	// The invalid limit rejects the whole file, including the valid log level
+	writeSettings(t, path, `{"log_level": "error", "rate_limits": {"share": 0}}`)
+	if err := reloader.Reload(); err == nil {
+		t.Fatal("Reload() accepted an invalid rate limit")
+	}
+	if logger.GetLevel() != logging.DEBUG || limiter.Limit() != 5 || compression {
+		t.Fatalf("rejected settings were partially applied: level=%s limit=%d compression=%v", logger.GetLevel(), limiter.Limit(), compression)
+	}
+
+	// Settings removed from the file fall back to the startup values
+	writeSettings(t, path, `{}`)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if logger.GetLevel() != logging.INFO || limiter.Limit() != 30 || !compression {
+		t.Fatalf("settings not reverted: level=%s limit=%d compression=%v", logger.GetLevel(), limiter.Limit(), compression)
+	}
+}
+
+func TestReloadRejectsMalformedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	var reloadErr error
+	reloader := NewReloader(Config{Path: path, OnReload: func(_ Settings, err error) { reloadErr = err }})
+
+	writeSettings(t, path, `{"log_level": `)
+	if err := reloader.Reload(); err == nil || reloadErr == nil {
+		t.Fatalf("Reload() error = %v, OnReload error = %v, want both set", err, reloadErr)
+	}
+	if reloader.changed() {
+		t.Error("a rejected file should not be reloaded again until it changes")
+	}
+}
+
+func TestReloadAllowList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	fallback, err := security.ParseIPAllowList([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := security.NewIPAllowList(fallback)
+
+	reloader := NewReloader(Config{Path: path})
+	if err := reloader.Register(AllowList("admin_http", list, fallback)); err != nil {
+		t.Fatal(err)
+	}
+
+	writeSettings(t, path, `{"allowed_ips": {"admin_http": ["10.0.0.0/8"]}}`)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if !list.Allows("10.4.5.6") || list.Allows("127.0.0.1") {
+		t.Fatalf("allow-list not applied: %v", list.Prefixes())
+	}
+
+	writeSettings(t, path, `{"allowed_ips": {"admin_http": ["10.0.0.0/40"]}}`)
+	if err := reloader.Reload(); err == nil {
+		t.Fatal("Reload() accepted an invalid network")
+	}
+	if !list.Allows("10.4.5.6") {
+		t.Fatalf("rejected allow-list was applied: %v", list.Prefixes())
+	}
+
+	writeSettings(t, path, `{}`)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if list.Allows("10.4.5.6") || !list.Allows("127.0.0.1") {
+		t.Fatalf("allow-list not reverted: %v", list.Prefixes())
+	}
+}
@@ -0,0 +1,205 @@
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+type FollowerConfig struct {
+	// ReconnectDelay is the pause before reconnecting to the primary after the stream ends.
+	ReconnectDelay time.Duration `json:"reconnect_delay"`
+	// OnDisconnect is called every time the stream to the primary ends with an error.
+	OnDisconnect func(err error) `json:"-"`
+}
+
+type FollowerStatus struct {
+	Connected    bool
+	LastSequence uint64
+	LastEventAt  time.Time
+	// Lag is how old the last event was when it arrived, heartbeats included.
+	Lag     time.Duration
+	Applied int64
+	// Gaps counts the jumps in the sequence, i.e. changes that were never received.
+	Gaps int64
+}
+
+// Follower consumes the primary's stream and rebroadcasts it as a
+// ports.ChangeFeed, so notifications and cache invalidation keep working on an
+// instance whose replica database cannot LISTEN. After promotion, Relay feeds
+// it from the local database instead and subscribers are kept.
+type Follower struct {
+	source Source
+	config FollowerConfig
+
+	mu          sync.Mutex
+	status      FollowerStatus
+	subscribers map[chan ports.EntityChange]struct{}
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewFollower(source Source, config FollowerConfig) *Follower {
+	if config.ReconnectDelay <= 0 {
+		config.ReconnectDelay = 2 * time.Second
+	}
+
+	return &Follower{
+		source:      source,
+		config:      config,
+		subscribers: make(map[chan ports.EntityChange]struct{}),
+	}
+}
+
+// Start follows the primary in the background until ctx is cancelled or Stop is called.
+func (f *Follower) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	f.mu.Lock()
+	f.cancel = cancel
+	f.done = done
+	f.mu.Unlock()
+
+	supervisor.Go("replication.follower", func() {
+		defer close(done)
+		f.run(ctx)
+	})
+}
+
+func (f *Follower) run(ctx context.Context) {
+	for {
+		f.mu.Lock()
+		after := f.status.LastSequence
+		f.mu.Unlock()
+
+		err := f.source.Stream(ctx, after, f.receive)
+
+		f.mu.Lock()
+		f.status.Connected = false
+		f.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && f.config.OnDisconnect != nil {
+			f.config.OnDisconnect(err)
+		}
+
+		select {
+		case <-time.After(f.config.ReconnectDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (f *Follower) receive(event Event) error {
+	f.mu.Lock()
+	f.status.Connected = true
+	f.status.LastEventAt = time.Now()
+	f.status.Lag = f.status.LastEventAt.Sub(event.SentAt)
+	if event.Heartbeat {
+		// A heartbeat behind the last sequence means the primary restarted its numbering
+		if event.Sequence < f.status.LastSequence {
+			f.status.LastSequence = event.Sequence
+			f.status.Gaps++
+		}
+		f.mu.Unlock()
+		return nil
+	}
+	if event.Sequence != f.status.LastSequence+1 && f.status.LastSequence != 0 {
+		f.status.Gaps++
+	}
+	f.status.LastSequence = event.Sequence
+	f.status.Applied++
+	f.mu.Unlock()
+
+	f.broadcast(event.Change)
+	return nil
+}
+
+// Stop stops following the primary and waits for the stream to end. Subscribers stay registered.
+func (f *Follower) Stop() {
+	f.mu.Lock()
+	cancel, done := f.cancel, f.done
+	f.cancel, f.done = nil, nil
+	f.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Relay rebroadcasts another feed until ctx is cancelled, typically the local
+// database's once this instance is promoted.
+func (f *Follower) Relay(ctx context.Context, feed ports.ChangeFeed) error {
+	changes, err := feed.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	for change := range changes {
+		f.broadcast(change)
+	}
+	return ctx.Err()
+}
+
+// Subscribe implements ports.ChangeFeed; the channel is closed when ctx is cancelled.
+func (f *Follower) Subscribe(ctx context.Context) (<-chan ports.EntityChange, error) {
+	ch := make(chan ports.EntityChange, 64)
+
+	f.mu.Lock()
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	supervisor.Go("replication.subscriber", func() {
+		<-ctx.Done()
+		f.mu.Lock()
+		delete(f.subscribers, ch)
+		close(ch)
+		f.mu.Unlock()
+	})
+
+	return ch, nil
+}
+
+func (f *Follower) broadcast(change ports.EntityChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- change:
+		default:
+			// Slow subscriber: the change is dropped instead of blocking the stream
+		}
+	}
+}
+
+func (f *Follower) Status() FollowerStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status
+}
+
+// Metrics is a metrics.MetricsCollector collector for the stream from the primary.
+func (f *Follower) Metrics() []metrics.Metric {
+	status := f.Status()
+
+	connected := 0.0
+	if status.Connected {
+		connected = 1
+	}
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "replication_connected", Type: metrics.Gauge, Value: connected, Timestamp: now},
+		{Name: "replication_lag_seconds", Type: metrics.Gauge, Value: status.Lag.Seconds(), Timestamp: now},
+		{Name: "replication_applied_changes_total", Type: metrics.Counter, Value: float64(status.Applied), Timestamp: now},
+		{Name: "replication_gaps_total", Type: metrics.Counter, Value: float64(status.Gaps), Timestamp: now},
+	}
+}
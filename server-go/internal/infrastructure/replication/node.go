@@ -0,0 +1,200 @@
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorReason is the ErrorInfo reason of the writes rejected by a follower.
+const ErrorReason = "FOLLOWER"
+
+type Role string
+
+const (
+	RolePrimary  Role = "primary"
+	RoleFollower Role = "follower"
+)
+
+var (
+	ErrInvalidRole    = errors.New("role must be primary or follower")
+	ErrAlreadyPrimary = errors.New("instance is already the primary")
+)
+
+// PromoteHook runs when a follower is promoted, e.g. to stop following the old
+// primary or to resume background jobs. Hooks should be safe to run again,
+// because a failed promotion can be retried.
+type PromoteHook func(ctx context.Context) error
+
+type Config struct {
+	Role Role `json:"role"`
+	// PrimaryAddr is returned to clients whose writes a follower rejects, so they can switch to the primary.
+	PrimaryAddr string `json:"primary_addr"`
+	// IsRead reports whether a full method name is a read that a follower serves. Without it every call is treated as a write.
+	IsRead func(fullMethod string) bool `json:"-"`
+	// ErrorDomain is the ErrorInfo domain of rejected writes.
+	ErrorDomain string `json:"error_domain"`
+}
+
+// Node holds the replication role of this instance. A follower serves reads
+// from its replica database and rejects writes until it is promoted.
+type Node struct {
+	config Config
+
+	// promoteMu serializes promotions without blocking calls while hooks run
+	promoteMu  sync.Mutex
+	mu         sync.RWMutex
+	role       Role
+	promotedAt time.Time
+	hooks      []PromoteHook
+	rejected   int64
+	promotions int64
+}
+
+func NewNode(config Config) (*Node, error) {
+	if config.Role == "" {
+		config.Role = RolePrimary
+	}
+	if config.Role != RolePrimary && config.Role != RoleFollower {
+		return nil, fmt.Errorf("%w, got %q", ErrInvalidRole, config.Role)
+	}
+	if config.IsRead == nil {
+		config.IsRead = func(string) bool { return false }
+	}
+	if config.ErrorDomain == "" {
+		config.ErrorDomain = "notebook"
+	}
+
+	return &Node{config: config, role: config.Role}, nil
+}
+
+func (n *Node) Role() Role {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.role
+}
+
+func (n *Node) IsFollower() bool {
+	return n.Role() == RoleFollower
+}
+
+func (n *Node) PrimaryAddr() string {
+	return n.config.PrimaryAddr
+}
+
+// PromotedAt is when this instance was promoted, or zero if it never was.
+func (n *Node) PromotedAt() time.Time {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.promotedAt
+}
+
+// OnPromote registers a hook run, in registration order, by Promote.
+func (n *Node) OnPromote(hook PromoteHook) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.hooks = append(n.hooks, hook)
+}
+
+// Promote turns a follower into the primary. Writes are accepted only after
+// every hook succeeded; if one fails the instance stays a follower and the
+// promotion can be retried. Promote does not fence the old primary, which must
+// already be down or isolated.
+func (n *Node) Promote(ctx context.Context) error {
+	n.promoteMu.Lock()
+	defer n.promoteMu.Unlock()
+
+	n.mu.RLock()
+	role := n.role
+	hooks := append([]PromoteHook{}, n.hooks...)
+	n.mu.RUnlock()
+
+	if role == RolePrimary {
+		return ErrAlreadyPrimary
+	}
+	for i, hook := range hooks {
+		if err := hook(ctx); err != nil {
+			return fmt.Errorf("promote hook %d: %w", i, err)
+		}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.role = RolePrimary
+	n.promotedAt = time.Now()
+	n.promotions++
+	return nil
+}
+
+func (n *Node) check(fullMethod string) error {
+	if !n.IsFollower() || n.config.IsRead(fullMethod) {
+		return nil
+	}
+
+	n.mu.Lock()
+	n.rejected++
+	n.mu.Unlock()
+
+	st := status.New(codes.Unavailable, "this instance is a read-only follower, send writes to the primary")
+	info := &errdetails.ErrorInfo{Reason: ErrorReason, Domain: n.config.ErrorDomain}
+	if n.config.PrimaryAddr != "" {
+		info.Metadata = map[string]string{"primary": n.config.PrimaryAddr}
+	}
+	if detailed, err := st.WithDetails(info); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (n *Node) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := n.check(info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (n *Node) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := n.check(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// Metrics is a metrics.MetricsCollector collector for the role and the writes a follower rejected.
+func (n *Node) Metrics() []metrics.Metric {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	follower := 0.0
+	if n.role == RoleFollower {
+		follower = 1
+	}
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "replication_follower", Type: metrics.Gauge, Value: follower, Timestamp: now},
+		{Name: "replication_rejected_writes_total", Type: metrics.Counter, Value: float64(n.rejected), Timestamp: now},
+		{Name: "replication_promotions_total", Type: metrics.Counter, Value: float64(n.promotions), Timestamp: now},
+	}
+}
@@ -0,0 +1,184 @@
+package replication
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+// ErrFollowerTooSlow ends the stream of a follower that fell further behind
+// than its send buffer; it reconnects and catches up from the backlog.
+var ErrFollowerTooSlow = errors.New("follower fell behind the change stream")
+
+// Event is one entry of the replication stream. Sequences are assigned by the
+// publisher and restart when it does, so a follower that sees a jump knows it
+// missed changes.
+type Event struct {
+	Sequence uint64
+	Change   ports.EntityChange
+	SentAt   time.Time
+	// Heartbeat events carry no change; they keep lag measurable while the primary is idle.
+	Heartbeat bool
+}
+
+// Source is the stream a follower consumes. Stream calls send for every event
+// after the given sequence until ctx is done, the stream fails or send returns an error.
+type Source interface {
+	Stream(ctx context.Context, afterSequence uint64, send func(Event) error) error
+}
+
+type PublisherConfig struct {
+	// BacklogSize is how many recent changes are kept for followers that reconnect.
+	BacklogSize int `json:"backlog_size"`
+	// HeartbeatInterval is how often idle followers receive a heartbeat.
+	HeartbeatInterval time.Duration `json:"heartbeat_interval"`
+	// SendBuffer is how many events may be queued for one follower before it is disconnected.
+	SendBuffer int `json:"send_buffer"`
+}
+
+// Publisher republishes a change feed as a sequenced stream for followers,
+// keeping a bounded in-memory backlog so short disconnections lose nothing.
+// The rows themselves reach the follower through database replication; the
+// stream carries what LISTEN/NOTIFY cannot deliver to a standby database.
+type Publisher struct {
+	feed   ports.ChangeFeed
+	config PublisherConfig
+
+	mu          sync.Mutex
+	backlog     []Event
+	sequence    uint64
+	subscribers map[chan Event]struct{}
+	published   int64
+	dropped     int64
+}
+
+func NewPublisher(feed ports.ChangeFeed, config PublisherConfig) *Publisher {
+	if config.BacklogSize <= 0 {
+		config.BacklogSize = 10000
+	}
+	if config.HeartbeatInterval <= 0 {
+		config.HeartbeatInterval = 5 * time.Second
+	}
+	if config.SendBuffer <= 0 {
+		config.SendBuffer = 256
+	}
+
+	return &Publisher{
+		feed:        feed,
+		config:      config,
+		subscribers: make(map[chan Event]struct{}),
+	}
+}
+
+// Start consumes the change feed until ctx is cancelled.
+func (p *Publisher) Start(ctx context.Context) error {
+	changes, err := p.feed.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	for change := range changes {
+		p.publish(change)
+	}
+	return ctx.Err()
+}
+
+func (p *Publisher) publish(change ports.EntityChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sequence++
+	event := Event{Sequence: p.sequence, Change: change, SentAt: time.Now()}
+	if len(p.backlog) == p.config.BacklogSize {
+		p.backlog = append(p.backlog[:0], p.backlog[1:]...)
+	}
+	p.backlog = append(p.backlog, event)
+	p.published++
+
+	for ch := range p.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// The follower resumes from the backlog after reconnecting
+			delete(p.subscribers, ch)
+			close(ch)
+			p.dropped++
+		}
+	}
+}
+
+// Stream implements Source. Events still in the backlog after afterSequence are
+// sent first, then live changes and heartbeats.
+func (p *Publisher) Stream(ctx context.Context, afterSequence uint64, send func(Event) error) error {
+	ch := make(chan Event, p.config.SendBuffer)
+
+	p.mu.Lock()
+	// A follower ahead of the publisher saw a previous run; it starts over and records the gap
+	if afterSequence > p.sequence {
+		afterSequence = 0
+	}
+	var pending []Event
+	for _, event := range p.backlog {
+		if event.Sequence > afterSequence {
+			pending = append(pending, event)
+		}
+	}
+	// Registering under the same lock as the backlog snapshot means no change is missed or sent twice
+	p.subscribers[ch] = struct{}{}
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		if _, ok := p.subscribers[ch]; ok {
+			delete(p.subscribers, ch)
+			close(ch)
+		}
+		p.mu.Unlock()
+	}()
+
+	last := afterSequence
+	for _, event := range pending {
+		if err := send(event); err != nil {
+			return err
+		}
+		last = event.Sequence
+	}
+
+	heartbeat := time.NewTicker(p.config.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-ch:
+			if !ok {
+				return ErrFollowerTooSlow
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+			last = event.Sequence
+		case <-heartbeat.C:
+			if err := send(Event{Sequence: last, SentAt: time.Now(), Heartbeat: true}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Metrics is a metrics.MetricsCollector collector for the published stream.
+func (p *Publisher) Metrics() []metrics.Metric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "replication_published_changes_total", Type: metrics.Counter, Value: float64(p.published), Timestamp: now},
+		{Name: "replication_followers", Type: metrics.Gauge, Value: float64(len(p.subscribers)), Timestamp: now},
+		{Name: "replication_dropped_followers_total", Type: metrics.Counter, Value: float64(p.dropped), Timestamp: now},
+	}
+}
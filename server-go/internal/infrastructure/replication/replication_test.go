@@ -0,0 +1,109 @@
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type channelFeed chan ports.EntityChange
+
+func (f channelFeed) Subscribe(ctx context.Context) (<-chan ports.EntityChange, error) {
+	return f, nil
+}
+
+func receiveChange(t *testing.T, changes <-chan ports.EntityChange) ports.EntityChange {
+	t.Helper()
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(2 * time.Second):
+		t.Fatal("change not relayed to the follower")
+		return ports.EntityChange{}
+	}
+}
+
+func TestFollowerRelaysChangesAndResumesFromBacklog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	feed := make(channelFeed, 8)
+	publisher := NewPublisher(feed, PublisherConfig{HeartbeatInterval: time.Hour})
+	go publisher.Start(ctx)
+
+	follower := NewFollower(publisher, FollowerConfig{ReconnectDelay: 10 * time.Millisecond})
+	changes, _ := follower.Subscribe(ctx)
+	follower.Start(ctx)
+
+	first := ports.EntityChange{Table: "ideas", Operation: "INSERT", EntityID: uuid.New()}
+	feed <- first
+	if got := receiveChange(t, changes); got.EntityID != first.EntityID {
+		t.Fatalf("relayed %v, want %v", got.EntityID, first.EntityID)
+	}
+
+	// Changes published while the follower is away are sent from the backlog when it comes back
+	follower.Stop()
+	second := ports.EntityChange{Table: "ideas", Operation: "UPDATE", EntityID: uuid.New()}
+	feed <- second
+	deadline := time.Now().Add(2 * time.Second)
+	for publisher.Metrics()[0].Value < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	follower.Start(ctx)
+	if got := receiveChange(t, changes); got.EntityID != second.EntityID {
+		t.Fatalf("relayed %v, want %v", got.EntityID, second.EntityID)
+	}
+
+	status := follower.Status()
+	if status.LastSequence != 2 || status.Applied != 2 || status.Gaps != 0 {
+		t.Fatalf("status = %+v, want sequence 2, 2 applied and no gaps", status)
+	}
+}
+
+func TestFollowerRejectsWritesUntilPromoted(t *testing.T) {
+	node, err := NewNode(Config{
+		Role:        RoleFollower,
+		PrimaryAddr: "primary:50051",
+		IsRead:      func(method string) bool { return method == "/notebook.NotebookService/ListIdeas" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := node.UnaryInterceptor()
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err
+	}
+
+	if err := call("/notebook.NotebookService/ListIdeas"); err != nil {
+		t.Fatalf("follower rejected a read: %v", err)
+	}
+	if code := status.Code(call("/notebook.NotebookService/CreateIdea")); code != codes.Unavailable {
+		t.Fatalf("follower write code = %v, want Unavailable", code)
+	}
+
+	var promoted bool
+	node.OnPromote(func(ctx context.Context) error {
+		promoted = true
+		return nil
+	})
+	if err := node.Promote(context.Background()); err != nil {
+		t.Fatalf("Promote() error = %v", err)
+	}
+	if !promoted || node.IsFollower() {
+		t.Fatal("promotion did not run its hooks or change the role")
+	}
+	if err := call("/notebook.NotebookService/CreateIdea"); err != nil {
+		t.Fatalf("primary rejected a write: %v", err)
+	}
+	if err := node.Promote(context.Background()); err != ErrAlreadyPrimary {
+		t.Fatalf("second Promote() error = %v, want ErrAlreadyPrimary", err)
+	}
+}
@@ -0,0 +1,97 @@
+package reports
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+)
+
+//go:embed templates
+var templateFiles embed.FS
+
+// weeklySummaryData is what the weekly summary templates are executed with.
+type weeklySummaryData struct {
+	Locale  string
+	Subject string
+	Period  string
+	Summary *entities.WeeklySummary
+}
+
+// Renderer is a ports.ReportRenderer that writes the reports with the embedded
+// templates, translating their texts with the i18n catalogs.
+type Renderer struct {
+	translator *i18n.Translator
+	html       *htmltemplate.Template
+	text       *texttemplate.Template
+}
+
+// NewRenderer accepts a nil translator, in which case reports are written in English.
+func NewRenderer(translator *i18n.Translator) (*Renderer, error) {
+	// The templates are parsed with a placeholder T; every render clones them with the recipient's locale
+	funcs := map[string]any{
+		"T":       func(message string) string { return message },
+		"percent": func(value float32) string { return fmt.Sprintf("%.0f%%", value) },
+		"delta":   func(value float32) string { return fmt.Sprintf("%+.0f", value) },
+	}
+	html, err := htmltemplate.New("").Funcs(funcs).ParseFS(templateFiles, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+	text, err := texttemplate.New("").Funcs(funcs).ParseFS(templateFiles, "templates/*.txt")
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{translator: translator, html: html, text: text}, nil
+}
+
+func (r *Renderer) RenderWeeklySummary(summary *entities.WeeklySummary, locale string) (*ports.RenderedReport, error) {
+	translate := func(message string) string { return message }
+	if r.translator != nil {
+		if !r.translator.Supports(locale) {
+			locale = r.translator.DefaultLocale()
+		}
+		translate = func(message string) string { return r.translator.Translate(locale, message) }
+	}
+	if locale == "" {
+		locale = i18n.DefaultLocale
+	}
+
+	data := weeklySummaryData{
+		Locale:  locale,
+		Subject: translate("Your week in Notebook"),
+		// The period ends at midnight, so the last day shown is the one before
+		Period:  summary.PeriodStart.Format("2006-01-02") + " – " + summary.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02"),
+		Summary: summary,
+	}
+
+	html, err := r.html.Clone()
+	if err != nil {
+		return nil, err
+	}
+	var htmlBody bytes.Buffer
+	if err := html.Funcs(map[string]any{"T": translate}).ExecuteTemplate(&htmlBody, "weekly_summary.html", data); err != nil {
+		return nil, fmt.Errorf("failed to render weekly summary: %w", err)
+	}
+
+	text, err := r.text.Clone()
+	if err != nil {
+		return nil, err
+	}
+	var textBody bytes.Buffer
+	if err := text.Funcs(map[string]any{"T": translate}).ExecuteTemplate(&textBody, "weekly_summary.txt", data); err != nil {
+		return nil, fmt.Errorf("failed to render weekly summary: %w", err)
+	}
+
+	return &ports.RenderedReport{
+		Subject: data.Subject,
+		HTML:    htmlBody.String(),
+		Text:    strings.TrimSpace(textBody.String()),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/i18n"
+	"github.com/google/uuid"
+)
+
+func TestRenderWeeklySummary(t *testing.T) {
+	translator, err := i18n.NewTranslator(i18n.DefaultLocale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := NewRenderer(translator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary := &entities.WeeklySummary{
+		PeriodStart:    time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		WeeklyActivity: entities.WeeklyActivity{IdeasCreated: 3, RemindersCompleted: 2, OverdueReminders: 1},
+		Projects: []entities.ProjectProgressDelta{
+			{ProgressID: uuid.New(), ProjectName: "<Launch>", Previous: 40, Current: 55},
+		},
+	}
+
+	report, err := renderer.RenderWeeklySummary(summary, "es")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Subject != "Tu semana en Notebook" {
+		t.Errorf("Subject = %q", report.Subject)
+	}
+	for _, want := range []string{"Ideas creadas", "2024-01-08 – 2024-01-14", "&lt;Launch&gt;", "55%"} {
+		if !strings.Contains(report.HTML, want) {
+			t.Errorf("HTML does not contain %q", want)
+		}
+	}
+	if !strings.Contains(report.Text, "- <Launch>: 55% (+15)") {
+		t.Errorf("Text = %q", report.Text)
+	}
+
+	// Locales without a catalog fall back to the default one
+	report, err = renderer.RenderWeeklySummary(summary, "xx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(report.HTML, `lang="en"`) || !strings.Contains(report.Text, "Ideas created: 3") {
+		t.Errorf("fallback report = %+v", report)
+	}
+}
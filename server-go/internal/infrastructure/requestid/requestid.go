@@ -0,0 +1,142 @@
+package requestid
+
+import (
+	"context"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Header is the metadata key clients use to send a request ID and the
+// trailer key the server echoes it back in.
+const Header = "x-request-id"
+
+// CorrelationHeader is the metadata key clients use to tie a request to an
+// earlier one; when absent the request ID starts a new correlation chain.
+const CorrelationHeader = "x-correlation-id"
+
+// maxLength bounds client-supplied IDs so they cannot bloat logs.
+const maxLength = 128
+
+type Interceptor struct {
+	ids    entities.IDGenerator
+	logger *logging.StructuredLogger
+}
+
+type InterceptorOption func(*Interceptor)
+
+// WithRequestLogging logs one entry per completed call, tagged with its request ID.
+func WithRequestLogging(logger *logging.StructuredLogger) InterceptorOption {
+	return func(i *Interceptor) {
+		i.logger = logger
+	}
+}
+
+func NewInterceptor(ids entities.IDGenerator, options ...InterceptorOption) *Interceptor {
+	if ids == nil {
+		ids = entities.UUIDGenerator{}
+	}
+
+	interceptor := &Interceptor{ids: ids}
+	for _, option := range options {
+		option(interceptor)
+	}
+	return interceptor
+}
+
+func (i *Interceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		requestID := i.resolve(ctx)
+		ctx = logging.ContextWithRequestID(ctx, requestID)
+		ctx = entities.ContextWithEventRequest(ctx, requestID, correlationID(ctx))
+
+		// Set before calling the handler so the ID is echoed even when the call fails
+		grpc.SetTrailer(ctx, metadata.Pairs(Header, requestID))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		i.logCall(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		requestID := i.resolve(stream.Context())
+		ctx := logging.ContextWithRequestID(stream.Context(), requestID)
+		ctx = entities.ContextWithEventRequest(ctx, requestID, correlationID(ctx))
+		stream.SetTrailer(metadata.Pairs(Header, requestID))
+
+		start := time.Now()
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		i.logCall(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// resolve reuses the client's request ID when it is usable, otherwise generates one.
+func (i *Interceptor) resolve(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(Header); len(values) > 0 && valid(values[0]) {
+			return values[0]
+		}
+	}
+	return i.ids.NewID().String()
+}
+
+// correlationID returns the client's correlation ID when it is usable.
+func correlationID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(CorrelationHeader); len(values) > 0 && valid(values[0]) {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func (i *Interceptor) logCall(ctx context.Context, method string, start time.Time, err error) {
+	if i.logger == nil {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"method": method,
+		"code":   status.Code(err).String(),
+	}
+	i.logger.WithContext(ctx).LogWithDuration(logging.INFO, "grpc call", time.Since(start), fields)
+}
+
+func valid(requestID string) bool {
+	if requestID == "" || len(requestID) > maxLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
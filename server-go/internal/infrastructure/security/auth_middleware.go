@@ -8,10 +8,15 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -23,6 +28,7 @@ var (
 	ErrTokenExpired     = errors.New("token expired")
 	ErrInsufficientRole = errors.New("insufficient role")
 	ErrMissingMetadata  = errors.New("missing metadata")
+	ErrInvalidClaims    = errors.New("invalid claims")
 )
 
 type Role string
@@ -81,6 +87,16 @@ type TokenManager struct {
 	mu            sync.RWMutex
 	blacklist     map[string]time.Time
 	rateLimiter   *RateLimiter
+
+	// validated caches the claims of recently validated tokens by token hash; see EnableValidationCache
+	validated     map[string]validatedToken
+	cacheTTL      time.Duration
+	cacheMaxItems int
+}
+
+type validatedToken struct {
+	claims    AuthClaims
+	expiresAt time.Time
 }
 
 type RateLimiter struct {
@@ -97,10 +113,24 @@ func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
 		window:   window,
 	}
 	
-	go rl.cleanup()
+	supervisor.Go("rate_limiter.cleanup", rl.cleanup)
 	return rl
 }
 
+// SetLimit changes the number of requests allowed per window; requests already
+// counted keep counting against the new limit.
+func (rl *RateLimiter) SetLimit(limit int) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.limit = limit
+}
+
+func (rl *RateLimiter) Limit() int {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.limit
+}
+
 func (rl *RateLimiter) Allow(identifier string) bool {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
@@ -164,6 +194,18 @@ func NewTokenManager(secretKey string, issuer string, defaultExpiry time.Duratio
 	}
 }
 
+// EnableValidationCache skips the decoding and signature check of tokens validated in the last
+// ttl. Entries never outlive the token, and RevokeToken drops them, so a revoked token is
+// rejected immediately. Once maxEntries tokens are cached, new ones are validated uncached until
+// the expired entries are pruned.
+func (tm *TokenManager) EnableValidationCache(ttl time.Duration, maxEntries int) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.cacheTTL = ttl
+	tm.cacheMaxItems = maxEntries
+	tm.validated = make(map[string]validatedToken)
+}
+
 func (tm *TokenManager) GenerateToken(claims *AuthClaims) (string, error) {
 	if claims.IssuedAt.IsZero() {
 		claims.IssuedAt = time.Now()
@@ -174,6 +216,11 @@ func (tm *TokenManager) GenerateToken(claims *AuthClaims) (string, error) {
 	if claims.Issuer == "" {
 		claims.Issuer = tm.issuer
 	}
+	// The fields are joined with ':', so a separator inside one of them would shift the rest when
+	// the token is parsed. Only the subject, which goes last, may contain it.
+	if strings.Contains(claims.UserID, ":") || strings.Contains(string(claims.Role), ":") || strings.Contains(claims.Issuer, ":") {
+		return "", ErrInvalidClaims
+	}
 	
 	tokenData := fmt.Sprintf("%s:%s:%s:%d:%d:%s",
 		claims.UserID,
@@ -191,6 +238,18 @@ func (tm *TokenManager) GenerateToken(claims *AuthClaims) (string, error) {
 }
 
 func (tm *TokenManager) ValidateToken(token string) (*AuthClaims, error) {
+	tm.mu.RLock()
+	caching := tm.cacheTTL > 0
+	tm.mu.RUnlock()
+	
+	var key string
+	if caching {
+		key = hashAPIKey(token)
+		if claims, ok := tm.cachedClaims(key); ok {
+			return claims, nil
+		}
+	}
+	
 	parts := strings.Split(token, ".")
 	if len(parts) != 2 {
 		return nil, ErrInvalidToken
@@ -222,45 +281,78 @@ func (tm *TokenManager) ValidateToken(token string) (*AuthClaims, error) {
 		return nil, ErrTokenExpired
 	}
 	
+	if caching {
+		tm.cacheClaims(key, token, claims)
+	}
+	
 	return claims, nil
 }
 
-func (tm *TokenManager) parseTokenData(data string) (*AuthClaims, error) {
-	parts := strings.Split(data, ":")
-	if len(parts) < 6 {
-		return nil, ErrInvalidToken
+// cachedClaims returns a copy of the cached claims, since callers such as RefreshToken modify them.
+func (tm *TokenManager) cachedClaims(key string) (*AuthClaims, bool) {
+	tm.mu.RLock()
+	entry, ok := tm.validated[key]
+	tm.mu.RUnlock()
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return nil, false
 	}
 	
-	userID := parts[0]
-	role := Role(parts[1])
-	issuer := parts[2]
-	
-	issuedAt := time.Unix(0, 0)
-	if len(parts) > 3 {
-		if timestamp, err := time.Parse("1136239445", parts[3]); err == nil {
-			issuedAt = timestamp
-		}
+	claims := entry.claims
+	claims.Metadata = make(map[string]string)
+	return &claims, true
+}
+
+func (tm *TokenManager) cacheClaims(key, token string, claims *AuthClaims) {
+	now := time.Now()
+	expiresAt := now.Add(tm.cacheTTL)
+	if claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt
 	}
 	
-	expiresAt := time.Unix(0, 0)
-	if len(parts) > 4 {
-		if timestamp, err := time.Parse("1136239445", parts[4]); err == nil {
-			expiresAt = timestamp
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	
+	// The token may have been revoked after the blacklist check above
+	if _, revoked := tm.blacklist[token]; revoked {
+		return
+	}
+	if len(tm.validated) >= tm.cacheMaxItems {
+		for cachedKey, entry := range tm.validated {
+			if !now.Before(entry.expiresAt) {
+				delete(tm.validated, cachedKey)
+			}
+		}
+		if len(tm.validated) >= tm.cacheMaxItems {
+			return
 		}
 	}
+	tm.validated[key] = validatedToken{claims: *claims, expiresAt: expiresAt}
+}
+
+// parseTokenData reverses the encoding of GenerateToken. Malformed timestamps reject the token
+// instead of defaulting to the epoch, and the subject keeps any ':' it contains.
+func (tm *TokenManager) parseTokenData(data string) (*AuthClaims, error) {
+	parts := strings.SplitN(data, ":", 6)
+	if len(parts) < 6 {
+		return nil, ErrInvalidToken
+	}
 	
-	subject := ""
-	if len(parts) > 5 {
-		subject = parts[5]
+	issuedAt, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidToken
 	}
 	
 	return &AuthClaims{
-		UserID:    userID,
-		Role:      role,
-		IssuedAt:  issuedAt,
-		ExpiresAt: expiresAt,
-		Issuer:    issuer,
-		Subject:   subject,
+		UserID:    parts[0],
+		Role:      Role(parts[1]),
+		IssuedAt:  time.Unix(issuedAt, 0),
+		ExpiresAt: time.Unix(expiresAt, 0),
+		Issuer:    parts[2],
+		Subject:   parts[5],
 		Metadata:  make(map[string]string),
 	}, nil
 }
@@ -275,6 +367,9 @@ func (tm *TokenManager) RevokeToken(token string, expiry time.Time) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 	tm.blacklist[token] = expiry
+	if tm.validated != nil {
+		delete(tm.validated, hashAPIKey(token))
+	}
 }
 
 func (tm *TokenManager) RefreshToken(oldToken string) (string, error) {
@@ -293,6 +388,7 @@ func (tm *TokenManager) RefreshToken(oldToken string) (string, error) {
 
 type AuthInterceptor struct {
 	tokenManager   *TokenManager
+	apiKeys        map[string]*AuthClaims
 	publicMethods  map[string]bool
 	requiredRoles  map[string]Role
 	enableLogging  bool
@@ -303,6 +399,7 @@ type AuthInterceptor struct {
 func NewAuthInterceptor(tokenManager *TokenManager) *AuthInterceptor {
 	return &AuthInterceptor{
 		tokenManager:   tokenManager,
+		apiKeys:        make(map[string]*AuthClaims),
 		publicMethods:  make(map[string]bool),
 		requiredRoles:  make(map[string]Role),
 		requestTracker: make(map[string]int),
@@ -315,12 +412,32 @@ func (ai *AuthInterceptor) AddPublicMethod(method string) {
 	ai.publicMethods[method] = true
 }
 
+// AddAPIKey registers a static API key accepted through the x-api-key metadata header.
+// Keys are stored hashed so the lookup does not leak timing information about the key.
+func (ai *AuthInterceptor) AddAPIKey(key string, claims *AuthClaims) {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	ai.apiKeys[hashAPIKey(key)] = claims
+}
+
 func (ai *AuthInterceptor) SetMethodRole(method string, role Role) {
 	ai.mu.Lock()
 	defer ai.mu.Unlock()
 	ai.requiredRoles[method] = role
 }
 
+// SetServiceRole requires role for every method of the service, unary and streaming.
+func (ai *AuthInterceptor) SetServiceRole(desc *grpc.ServiceDesc, role Role) {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	for _, method := range desc.Methods {
+		ai.requiredRoles["/"+desc.ServiceName+"/"+method.MethodName] = role
+	}
+	for _, stream := range desc.Streams {
+		ai.requiredRoles["/"+desc.ServiceName+"/"+stream.StreamName] = role
+	}
+}
+
 func (ai *AuthInterceptor) EnableLogging(enable bool) {
 	ai.enableLogging = enable
 }
@@ -335,7 +452,8 @@ func (ai *AuthInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		ai.trackRequest(info.FullMethod)
 		
 		if ai.enableLogging {
-			fmt.Printf("Auth interceptor: %s\n", info.FullMethod)
+			requestID, _ := logging.RequestIDFromContext(ctx)
+			fmt.Printf("Auth interceptor: %s request_id=%s\n", info.FullMethod, requestID)
 		}
 		
 		ai.mu.RLock()
@@ -361,8 +479,7 @@ func (ai *AuthInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
 		}
 		
-		ctxWithClaims := context.WithValue(ctx, "auth_claims", claims)
-		return handler(ctxWithClaims, req)
+		return handler(contextWithClaims(ctx, claims), req)
 	}
 }
 
@@ -376,7 +493,8 @@ func (ai *AuthInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
 		ai.trackRequest(info.FullMethod)
 		
 		if ai.enableLogging {
-			fmt.Printf("Auth stream interceptor: %s\n", info.FullMethod)
+			requestID, _ := logging.RequestIDFromContext(stream.Context())
+			fmt.Printf("Auth stream interceptor: %s request_id=%s\n", info.FullMethod, requestID)
 		}
 		
 		ai.mu.RLock()
@@ -404,13 +522,23 @@ func (ai *AuthInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
 		
 		wrappedStream := &wrappedStream{
 			ServerStream: stream,
-			ctx:          context.WithValue(stream.Context(), "auth_claims", claims),
+			ctx:          contextWithClaims(stream.Context(), claims),
 		}
 		
 		return handler(srv, wrappedStream)
 	}
 }
 
+// contextWithClaims stores the claims for handlers and records the user as
+// the actor of the domain events published while serving the call.
+func contextWithClaims(ctx context.Context, claims *AuthClaims) context.Context {
+	ctx = context.WithValue(ctx, "auth_claims", claims)
+	if userID, err := uuid.Parse(claims.UserID); err == nil {
+		ctx = entities.ContextWithEventActor(ctx, userID)
+	}
+	return ctx
+}
+
 type wrappedStream struct {
 	grpc.ServerStream
 	ctx context.Context
@@ -426,6 +554,16 @@ func (ai *AuthInterceptor) extractAndValidateToken(ctx context.Context) (*AuthCl
 		return nil, ErrMissingMetadata
 	}
 	
+	if keys := md.Get("x-api-key"); len(keys) > 0 {
+		ai.mu.RLock()
+		claims, exists := ai.apiKeys[hashAPIKey(keys[0])]
+		ai.mu.RUnlock()
+		if !exists {
+			return nil, ErrInvalidToken
+		}
+		return claims, nil
+	}
+	
 	tokens := md.Get("authorization")
 	if len(tokens) == 0 {
 		return nil, ErrInvalidToken
@@ -473,6 +611,11 @@ func (ai *AuthInterceptor) GetRequestStats() map[string]int {
 	return stats
 }
 
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
 func GenerateSecretKey() (string, error) {
 	key := make([]byte, 32)
 	_, err := rand.Read(key)
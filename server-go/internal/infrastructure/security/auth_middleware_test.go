@@ -0,0 +1,145 @@
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenManager_ValidationCache(t *testing.T) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	tm.EnableValidationCache(time.Minute, 10)
+
+	token, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: RoleUser})
+	require.NoError(t, err)
+
+	claims, err := tm.ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+
+	// Modifying the returned claims must not change the cached ones
+	claims.UserID = "changed"
+	cached, err := tm.ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", cached.UserID)
+
+	tm.RevokeToken(token, time.Now().Add(time.Hour))
+	_, err = tm.ValidateToken(token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTokenManager_ValidationCacheRespectsExpiry(t *testing.T) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	tm.EnableValidationCache(time.Hour, 10)
+
+	token, err := tm.GenerateToken(&AuthClaims{
+		UserID:    "user-1",
+		Role:      RoleUser,
+		ExpiresAt: time.Now().Add(time.Second),
+	})
+	require.NoError(t, err)
+
+	_, err = tm.ValidateToken(token)
+	require.NoError(t, err)
+
+	tm.mu.RLock()
+	entry := tm.validated[hashAPIKey(token)]
+	tm.mu.RUnlock()
+	assert.False(t, entry.expiresAt.After(time.Now().Add(time.Second)))
+}
+
+// adminServiceDesc mirrors the shape of the generated AdminService descriptor:
+// unary methods and a server-streaming method.
+var adminServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notebook.AdminService",
+	Methods:     []grpc.MethodDesc{{MethodName: "GetQueueStats"}},
+	Streams:     []grpc.StreamDesc{{StreamName: "StreamChanges", ServerStreams: true}},
+}
+
+func TestAuthInterceptor_ServiceRoleCoversStreams(t *testing.T) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	interceptor := NewAuthInterceptor(tm)
+	interceptor.SetServiceRole(&adminServiceDesc, RoleAdmin)
+
+	streamWithRole := func(role Role) *fakeServerStream {
+		token, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: role})
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		return &fakeServerStream{ctx: ctx}
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/notebook.AdminService/StreamChanges", IsServerStream: true}
+
+	tests := []struct {
+		name string
+		role Role
+		want codes.Code
+	}{
+		{"user token", RoleUser, codes.PermissionDenied},
+		{"admin token", RoleAdmin, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			err := interceptor.StreamInterceptor()(nil, streamWithRole(tt.role), info, func(srv interface{}, stream grpc.ServerStream) error {
+				called = true
+				return nil
+			})
+
+			assert.Equal(t, tt.want, status.Code(err))
+			assert.Equal(t, tt.want == codes.OK, called)
+		})
+	}
+}
+
+func TestAuthInterceptor_ServiceRoleCoversUnaryMethods(t *testing.T) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	interceptor := NewAuthInterceptor(tm)
+	interceptor.SetServiceRole(&adminServiceDesc, RoleAdmin)
+
+	token, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: RoleUser})
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	_, err = interceptor.UnaryInterceptor()(ctx, struct{}{}, &grpc.UnaryServerInfo{FullMethod: "/notebook.AdminService/GetQueueStats"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func BenchmarkValidateToken(b *testing.B) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	token, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: RoleUser})
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tm.ValidateToken(token); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidateToken_Cached(b *testing.B) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	tm.EnableValidationCache(time.Minute, 1000)
+	token, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: RoleUser})
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tm.ValidateToken(token); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package security
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+)
+
+// IPAllowList admits clients whose address falls in one of its networks. The
+// networks can be replaced while the server runs; an empty list admits nobody.
+type IPAllowList struct {
+	prefixes atomic.Pointer[[]netip.Prefix]
+}
+
+func NewIPAllowList(prefixes []netip.Prefix) *IPAllowList {
+	l := &IPAllowList{}
+	l.Set(prefixes)
+	return l
+}
+
+// ParseIPAllowList parses CIDR networks and single addresses, e.g. "10.0.0.0/8" or "::1".
+func ParseIPAllowList(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network %q: %w", entry, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", entry, err)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+func (l *IPAllowList) Set(prefixes []netip.Prefix) {
+	l.prefixes.Store(&prefixes)
+}
+
+func (l *IPAllowList) Prefixes() []netip.Prefix {
+	return append([]netip.Prefix(nil), *l.prefixes.Load()...)
+}
+
+// Allows reports whether ip, as written in a request's remote address, is in the list.
+func (l *IPAllowList) Allows(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range *l.prefixes.Load() {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
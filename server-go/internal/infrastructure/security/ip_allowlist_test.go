@@ -0,0 +1,33 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPAllowList_Allows(t *testing.T) {
+	prefixes, err := ParseIPAllowList([]string{"10.1.0.0/16", "::1", " 192.168.1.7 "})
+	require.NoError(t, err)
+	list := NewIPAllowList(prefixes)
+
+	assert.True(t, list.Allows("10.1.200.3"))
+	assert.True(t, list.Allows("::ffff:10.1.0.1"))
+	assert.True(t, list.Allows("::1"))
+	assert.True(t, list.Allows("192.168.1.7"))
+	assert.False(t, list.Allows("192.168.1.8"))
+	assert.False(t, list.Allows("10.2.0.1"))
+	assert.False(t, list.Allows("not-an-ip"))
+
+	list.Set(nil)
+	assert.False(t, list.Allows("10.1.200.3"))
+}
+
+func TestParseIPAllowList_RejectsInvalidEntries(t *testing.T) {
+	_, err := ParseIPAllowList([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	_, err = ParseIPAllowList([]string{"localhost"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package security
+
+import (
+	"context"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+// AnonymousRole is the role label of calls without authentication claims.
+const AnonymousRole = "anonymous"
+
+// ClaimLabels labels request metrics with the caller's role and, when orgKey is
+// set, the organization stored under that key in the claims metadata.
+func ClaimLabels(orgKey string) metrics.LabelFunc {
+	return func(ctx context.Context) map[string]string {
+		labels := map[string]string{"role": AnonymousRole}
+		if orgKey != "" {
+			labels["org"] = ""
+		}
+
+		claims, ok := ExtractClaimsFromContext(ctx)
+		if !ok {
+			return labels
+		}
+		if claims.Role != "" {
+			labels["role"] = string(claims.Role)
+		}
+		if orgKey != "" {
+			labels["org"] = claims.Metadata[orgKey]
+		}
+		return labels
+	}
+}
@@ -0,0 +1,164 @@
+package security
+
+import (
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type StreamLimiterConfig struct {
+	MaxStreamsPerUser int `json:"max_streams_per_user"`
+	MaxTotalStreams   int `json:"max_total_streams"`
+}
+
+// StreamLimiter caps concurrent streaming RPCs per user and in total, rejecting
+// extra streams with codes.ResourceExhausted instead of letting them pile up goroutines.
+type StreamLimiter struct {
+	config  StreamLimiterConfig
+	mu      sync.Mutex
+	total   int
+	perUser map[string]int
+
+	rejectedTotal   int64
+	rejectedPerUser int64
+}
+
+func NewStreamLimiter(config StreamLimiterConfig) *StreamLimiter {
+	if config.MaxStreamsPerUser == 0 {
+		config.MaxStreamsPerUser = 10
+	}
+	if config.MaxTotalStreams == 0 {
+		config.MaxTotalStreams = 1000
+	}
+
+	return &StreamLimiter{
+		config:  config,
+		perUser: make(map[string]int),
+	}
+}
+
+// StreamInterceptor limits streams per user. The user is taken from the auth
+// claims when present, otherwise from the user_id of the first request message.
+func (sl *StreamLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !sl.acquireTotal() {
+			return status.Error(codes.ResourceExhausted, "too many concurrent streams on the server")
+		}
+		defer sl.releaseTotal()
+
+		limited := &limitedStream{ServerStream: stream, limiter: sl}
+		defer limited.release()
+
+		if claims, ok := ExtractClaimsFromContext(stream.Context()); ok && claims.UserID != "" {
+			if err := limited.acquire(claims.UserID); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, limited)
+	}
+}
+
+func (sl *StreamLimiter) ActiveStreams() int {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	return sl.total
+}
+
+// Metrics is a metrics.MetricsCollector collector for active and rejected streams.
+func (sl *StreamLimiter) Metrics() []metrics.Metric {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "grpc_active_streams", Type: metrics.Gauge, Value: float64(sl.total), Timestamp: now},
+		{Name: "grpc_streaming_users", Type: metrics.Gauge, Value: float64(len(sl.perUser)), Timestamp: now},
+		{Name: "grpc_stream_rejections_total", Type: metrics.Counter, Value: float64(sl.rejectedTotal), Labels: map[string]string{"limit": "total"}, Timestamp: now},
+		{Name: "grpc_stream_rejections_total", Type: metrics.Counter, Value: float64(sl.rejectedPerUser), Labels: map[string]string{"limit": "per_user"}, Timestamp: now},
+	}
+}
+
+func (sl *StreamLimiter) acquireTotal() bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.total >= sl.config.MaxTotalStreams {
+		sl.rejectedTotal++
+		return false
+	}
+	sl.total++
+	return true
+}
+
+func (sl *StreamLimiter) releaseTotal() {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	sl.total--
+}
+
+func (sl *StreamLimiter) acquireUser(userID string) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.perUser[userID] >= sl.config.MaxStreamsPerUser {
+		sl.rejectedPerUser++
+		return false
+	}
+	sl.perUser[userID]++
+	return true
+}
+
+func (sl *StreamLimiter) releaseUser(userID string) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.perUser[userID]--
+	if sl.perUser[userID] <= 0 {
+		delete(sl.perUser, userID)
+	}
+}
+
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *StreamLimiter
+	userID  string
+}
+
+// RecvMsg attributes unauthenticated streams to the user_id of their first message.
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.userID != "" {
+		return nil
+	}
+
+	if request, ok := m.(interface{ GetUserId() string }); ok && request.GetUserId() != "" {
+		return s.acquire(request.GetUserId())
+	}
+	return nil
+}
+
+func (s *limitedStream) acquire(userID string) error {
+	if !s.limiter.acquireUser(userID) {
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent streams for user, limit is %d", s.limiter.config.MaxStreamsPerUser)
+	}
+	s.userID = userID
+	return nil
+}
+
+func (s *limitedStream) release() {
+	if s.userID != "" {
+		s.limiter.releaseUser(s.userID)
+	}
+}
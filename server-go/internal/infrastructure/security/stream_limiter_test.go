@@ -0,0 +1,140 @@
+package security
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userRequest stands in for the generated request messages that carry a user_id.
+type userRequest struct {
+	UserId string
+}
+
+func (r *userRequest) GetUserId() string { return r.UserId }
+
+// fakeServerStream delivers a single userRequest to RecvMsg.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	userID string
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	m.(*userRequest).UserId = s.userID
+	return nil
+}
+
+// openStream runs a stream through the limiter and keeps it open until the
+// returned close function is called, or returns the error the stream was
+// rejected with.
+func openStream(t *testing.T, limiter *StreamLimiter, stream *fakeServerStream, recv bool) (func(), error) {
+	t.Helper()
+	interceptor := limiter.StreamInterceptor()
+	admitted := make(chan struct{}, 1)
+	hold := make(chan struct{})
+	done := make(chan error, 1)
+
+	go func() {
+		done <- interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/notebook.NotificationService/StreamNotifications"}, func(srv interface{}, s grpc.ServerStream) error {
+			if recv {
+				if err := s.RecvMsg(&userRequest{}); err != nil {
+					return err
+				}
+			}
+			admitted <- struct{}{}
+			<-hold
+			return nil
+		})
+	}()
+
+	select {
+	case <-admitted:
+		return func() {
+			close(hold)
+			require.NoError(t, <-done)
+		}, nil
+	case err := <-done:
+		return func() {}, err
+	}
+}
+
+func authenticatedStream(userID string) *fakeServerStream {
+	return &fakeServerStream{ctx: contextWithClaims(context.Background(), &AuthClaims{UserID: userID})}
+}
+
+func TestStreamLimiter_PerUserLimit(t *testing.T) {
+	limiter := NewStreamLimiter(StreamLimiterConfig{MaxStreamsPerUser: 2, MaxTotalStreams: 10})
+
+	closeFirst, err := openStream(t, limiter, authenticatedStream("user-1"), false)
+	require.NoError(t, err)
+	closeSecond, err := openStream(t, limiter, authenticatedStream("user-1"), false)
+	require.NoError(t, err)
+
+	// The user is over its limit, other users are not
+	_, err = openStream(t, limiter, authenticatedStream("user-1"), false)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	closeOther, err := openStream(t, limiter, authenticatedStream("user-2"), false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, limiter.ActiveStreams())
+
+	// Closing a stream frees its slot
+	closeFirst()
+	closeThird, err := openStream(t, limiter, authenticatedStream("user-1"), false)
+	require.NoError(t, err)
+
+	closeSecond()
+	closeThird()
+	closeOther()
+	assert.Zero(t, limiter.ActiveStreams())
+	assert.Empty(t, limiter.perUser)
+}
+
+func TestStreamLimiter_UnauthenticatedStreamsUseFirstMessage(t *testing.T) {
+	limiter := NewStreamLimiter(StreamLimiterConfig{MaxStreamsPerUser: 1, MaxTotalStreams: 10})
+	stream := func() *fakeServerStream {
+		return &fakeServerStream{ctx: context.Background(), userID: "user-1"}
+	}
+
+	closeFirst, err := openStream(t, limiter, stream(), true)
+	require.NoError(t, err)
+
+	// The second stream is admitted, then closed when its first message names the same user
+	_, err = openStream(t, limiter, stream(), true)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, limiter.ActiveStreams())
+
+	closeFirst()
+	assert.Zero(t, limiter.ActiveStreams())
+	assert.Empty(t, limiter.perUser)
+}
+
+func TestStreamLimiter_TotalLimit(t *testing.T) {
+	limiter := NewStreamLimiter(StreamLimiterConfig{MaxStreamsPerUser: 5, MaxTotalStreams: 2})
+
+	closeFirst, err := openStream(t, limiter, authenticatedStream("user-1"), false)
+	require.NoError(t, err)
+	closeSecond, err := openStream(t, limiter, authenticatedStream("user-2"), false)
+	require.NoError(t, err)
+
+	_, err = openStream(t, limiter, authenticatedStream("user-3"), false)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	closeFirst()
+	closeSecond()
+
+	rejections := map[string]float64{}
+	for _, metric := range limiter.Metrics() {
+		if metric.Name == "grpc_stream_rejections_total" {
+			rejections[metric.Labels["limit"]] = metric.Value
+		}
+	}
+	assert.Equal(t, map[string]float64{"total": 1, "per_user": 0}, rejections)
+}
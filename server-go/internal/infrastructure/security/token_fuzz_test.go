@@ -0,0 +1,96 @@
+package security
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+)
+
+// go test ./internal/infrastructure/security -run '^$' -fuzz FuzzValidateToken
+
+// FuzzValidateToken checks that arbitrary input never panics and that only correctly signed
+// tokens are accepted
+func FuzzValidateToken(f *testing.F) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	valid, err := tm.GenerateToken(&AuthClaims{UserID: "user-1", Role: RoleUser, Subject: "android"})
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(valid)
+	f.Add("")
+	f.Add(".")
+	f.Add("zz.zz")
+	f.Add(hex.EncodeToString([]byte("user-1:user:test:1:2:x")) + ".")
+	f.Add(valid + "." + valid)
+
+	f.Fuzz(func(t *testing.T, token string) {
+		claims, err := tm.ValidateToken(token)
+		if err != nil {
+			if claims != nil {
+				t.Fatalf("claims returned along with error %v", err)
+			}
+			return
+		}
+		data, _, _ := strings.Cut(token, ".")
+		if _, decodeErr := hex.DecodeString(data); decodeErr != nil {
+			t.Fatalf("accepted token with undecodable data %q", token)
+		}
+		if claims.IsExpired() {
+			t.Fatalf("accepted expired token %q", token)
+		}
+	})
+}
+
+// FuzzParseTokenData signs arbitrary payloads, which the signature check would otherwise reject
+// before they reach the parser
+func FuzzParseTokenData(f *testing.F) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	f.Add("user-1:user:test:1700000000:4102444800:android")
+	f.Add("user-1:user:test:1700000000:4102444800:")
+	f.Add("user-1:user:test:x:y:z")
+	f.Add("user-1:user:test:2006-01-02:4102444800:")
+	f.Add(":::::")
+	f.Add("user-1:user:test:-9223372036854775808:9223372036854775807:a:b:c")
+
+	f.Fuzz(func(t *testing.T, data string) {
+		token := hex.EncodeToString([]byte(data)) + "." + tm.sign(data)
+		claims, err := tm.ValidateToken(token)
+		if err != nil {
+			return
+		}
+		parts := strings.SplitN(data, ":", 6)
+		if claims.UserID != parts[0] || string(claims.Role) != parts[1] || claims.Issuer != parts[2] || claims.Subject != parts[5] {
+			t.Fatalf("claims %+v do not match the token data %q", claims, data)
+		}
+	})
+}
+
+// FuzzTokenRoundTrip checks that every token GenerateToken issues validates back to its claims
+func FuzzTokenRoundTrip(f *testing.F) {
+	tm := NewTokenManager("secret", "test", time.Hour)
+	f.Add("user-1", "user", "test", "android")
+	f.Add("user-1", "admin", "", "")
+	f.Add("user-1:admin", "user", "test", "")
+	f.Add("user-1", "user", "test", "device:1234:android")
+
+	f.Fuzz(func(t *testing.T, userID, role, issuer, subject string) {
+		token, err := tm.GenerateToken(&AuthClaims{UserID: userID, Role: Role(role), Issuer: issuer, Subject: subject})
+		if err != nil {
+			if !strings.Contains(userID+role+issuer, ":") && !strings.Contains(tm.issuer, ":") {
+				t.Fatalf("GenerateToken: %v", err)
+			}
+			return
+		}
+		claims, err := tm.ValidateToken(token)
+		if err != nil {
+			t.Fatalf("ValidateToken on a generated token: %v", err)
+		}
+		if issuer == "" {
+			issuer = tm.issuer
+		}
+		if claims.UserID != userID || claims.Role != Role(role) || claims.Issuer != issuer || claims.Subject != subject {
+			t.Fatalf("round trip changed the claims: got %+v", claims)
+		}
+	})
+}
@@ -0,0 +1,270 @@
+package shadow
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/recording"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Header marks mirrored calls, so the canary can tell them apart from real
+// traffic and skip side effects such as analytics.
+const Header = "x-shadow"
+
+// DefaultForwardHeaders are the incoming metadata keys copied to the canary so
+// it sees the same user, locale and app version as the primary.
+var DefaultForwardHeaders = []string{"authorization", "x-api-key", "accept-language", "x-client-version", "x-request-id"}
+
+type Config struct {
+	// Percent of eligible calls mirrored, between 0 and 100.
+	Percent float64 `json:"percent"`
+	// IsRead decides which methods are mirrored; writes never are, since the canary shares the database.
+	IsRead func(fullMethod string) bool `json:"-"`
+	// Methods restricts mirroring to these full method names; empty mirrors every read.
+	Methods []string `json:"methods"`
+	// IgnoreFields are response field names left out of the comparison, such as generated IDs and timestamps.
+	IgnoreFields []string `json:"ignore_fields"`
+	// ForwardHeaders are copied from the original call. Defaults to DefaultForwardHeaders.
+	ForwardHeaders []string `json:"forward_headers"`
+	// Timeout bounds each mirrored call.
+	Timeout time.Duration `json:"timeout"`
+	// MaxInFlight caps concurrent mirrored calls; calls beyond it are skipped, never queued.
+	MaxInFlight int `json:"max_in_flight"`
+	// MaxLoggedDiffs is how many differing fields are logged per call.
+	MaxLoggedDiffs int `json:"max_logged_diffs"`
+}
+
+// Shadower mirrors a sample of read calls to a canary after the primary
+// answered, and logs where the two responses differ. The client only ever
+// sees the primary's response, and mirrored calls run in the background so
+// they add no latency. Streams are not mirrored.
+type Shadower struct {
+	canary grpc.ClientConnInterface
+	config Config
+	logger *logging.StructuredLogger
+
+	methods map[string]bool
+	ignore  map[string]bool
+	slots   chan struct{}
+
+	mu       sync.Mutex
+	mirrored int64
+	matched  int64
+	differed int64
+	failed   int64
+	skipped  int64
+}
+
+func NewShadower(canary grpc.ClientConnInterface, logger *logging.StructuredLogger, config Config) *Shadower {
+	if config.Percent < 0 {
+		config.Percent = 0
+	}
+	if config.Percent > 100 {
+		config.Percent = 100
+	}
+	if config.IsRead == nil {
+		config.IsRead = func(string) bool { return false }
+	}
+	if config.ForwardHeaders == nil {
+		config.ForwardHeaders = DefaultForwardHeaders
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+	if config.MaxInFlight <= 0 {
+		config.MaxInFlight = 32
+	}
+	if config.MaxLoggedDiffs <= 0 {
+		config.MaxLoggedDiffs = 20
+	}
+
+	s := &Shadower{
+		canary:  canary,
+		config:  config,
+		logger:  logger,
+		methods: make(map[string]bool, len(config.Methods)),
+		ignore:  make(map[string]bool, len(config.IgnoreFields)),
+		slots:   make(chan struct{}, config.MaxInFlight),
+	}
+	for _, method := range config.Methods {
+		s.methods[method] = true
+	}
+	for _, field := range config.IgnoreFields {
+		s.ignore[field] = true
+	}
+	return s
+}
+
+func (s *Shadower) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if s.shouldMirror(info.FullMethod) {
+			s.mirror(ctx, info.FullMethod, req, resp, err)
+		}
+		return resp, err
+	}
+}
+
+func (s *Shadower) shouldMirror(method string) bool {
+	if s.config.Percent == 0 || !s.config.IsRead(method) {
+		return false
+	}
+	if len(s.methods) > 0 && !s.methods[method] {
+		return false
+	}
+	return s.config.Percent >= 100 || rand.Float64()*100 < s.config.Percent
+}
+
+// mirror copies what the background call needs, since req and resp belong to
+// the handler and ctx is cancelled as soon as the primary call returns.
+func (s *Shadower) mirror(ctx context.Context, method string, req, resp interface{}, err error) {
+	request, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		s.count(&s.skipped)
+		return
+	}
+
+	primary := result{code: status.Code(err).String()}
+	if message, ok := resp.(proto.Message); ok && err == nil {
+		primary.response = proto.Clone(message)
+	}
+	request = proto.Clone(request)
+	outgoing := s.forwardedMetadata(ctx)
+	requestID, _ := logging.RequestIDFromContext(ctx)
+
+	supervisor.Go("shadow.compare", func() {
+		defer func() { <-s.slots }()
+		s.compare(method, requestID, request, outgoing, primary)
+	})
+}
+
+type result struct {
+	code     string
+	response proto.Message
+}
+
+func (s *Shadower) compare(method, requestID string, req proto.Message, md metadata.MD, primary result) {
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), s.config.Timeout)
+	defer cancel()
+
+	// The canary answers with its own message type, which has to match the primary's to be comparable
+	var canaryResponse proto.Message
+	if primary.response != nil {
+		canaryResponse = primary.response.ProtoReflect().New().Interface()
+	} else {
+		canaryResponse = &emptypb.Empty{}
+	}
+	err := s.canary.Invoke(ctx, method, req, canaryResponse)
+	canary := result{code: status.Code(err).String()}
+	if err == nil {
+		canary.response = canaryResponse
+	}
+	s.count(&s.mirrored)
+
+	fields := map[string]interface{}{"method": method}
+	if requestID != "" {
+		fields["request_id"] = requestID
+	}
+
+	if ctx.Err() != nil {
+		s.count(&s.failed)
+		fields["error"] = ctx.Err().Error()
+		s.logger.Warn("Shadow call to canary timed out", fields)
+		return
+	}
+
+	var differences []string
+	if primary.code != canary.code {
+		differences = []string{"code: " + primary.code + " != " + canary.code}
+		if err != nil {
+			fields["canary_message"] = status.Convert(err).Message()
+		}
+	} else if primary.response != nil && canary.response != nil {
+		differences, err = s.diff(primary.response, canary.response)
+		if err != nil {
+			s.count(&s.failed)
+			s.logger.Error("Failed to compare shadow response", err, fields)
+			return
+		}
+	}
+
+	if len(differences) == 0 {
+		s.count(&s.matched)
+		return
+	}
+	s.count(&s.differed)
+	fields["differences"] = len(differences)
+	if len(differences) > s.config.MaxLoggedDiffs {
+		differences = differences[:s.config.MaxLoggedDiffs]
+	}
+	fields["diff"] = strings.Join(differences, "; ")
+	s.logger.Warn("Canary response differs from primary", fields)
+}
+
+func (s *Shadower) diff(primary, canary proto.Message) ([]string, error) {
+	before, err := recording.MarshalOptions.Marshal(primary)
+	if err != nil {
+		return nil, err
+	}
+	after, err := recording.MarshalOptions.Marshal(canary)
+	if err != nil {
+		return nil, err
+	}
+	return recording.Diff(before, after, s.ignore)
+}
+
+func (s *Shadower) forwardedMetadata(ctx context.Context) metadata.MD {
+	md := metadata.Pairs(Header, "true")
+	incoming, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return md
+	}
+	for _, key := range s.config.ForwardHeaders {
+		if values := incoming.Get(key); len(values) > 0 {
+			md.Set(key, values...)
+		}
+	}
+	return md
+}
+
+func (s *Shadower) count(counter *int64) {
+	s.mu.Lock()
+	*counter++
+	s.mu.Unlock()
+}
+
+// Metrics is a metrics.MetricsCollector collector for mirrored calls and their outcome.
+func (s *Shadower) Metrics() []metrics.Metric {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	return []metrics.Metric{
+		{Name: "shadow_requests_total", Type: metrics.Counter, Value: float64(s.mirrored), Timestamp: now},
+		{Name: "shadow_matches_total", Type: metrics.Counter, Value: float64(s.matched), Timestamp: now},
+		{Name: "shadow_diffs_total", Type: metrics.Counter, Value: float64(s.differed), Timestamp: now},
+		{Name: "shadow_errors_total", Type: metrics.Counter, Value: float64(s.failed), Timestamp: now},
+		{Name: "shadow_skipped_total", Type: metrics.Counter, Value: float64(s.skipped), Timestamp: now},
+	}
+}
@@ -0,0 +1,106 @@
+package shadow
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeCanary answers every call with the configured fields and records the metadata it received.
+type fakeCanary struct {
+	mu       sync.Mutex
+	fields   map[string]interface{}
+	metadata []metadata.MD
+}
+
+func (c *fakeCanary) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.mu.Lock()
+	c.metadata = append(c.metadata, md)
+	fields := c.fields
+	c.mu.Unlock()
+
+	response, err := structpb.NewStruct(fields)
+	if err != nil {
+		return err
+	}
+	proto.Merge(reply.(proto.Message), response)
+	return nil
+}
+
+func (c *fakeCanary) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, nil
+}
+
+// syncBuffer lets the test read the log while the mirrored calls write it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestShadowerComparesReadResponses(t *testing.T) {
+	canary := &fakeCanary{fields: map[string]interface{}{"title": "new", "id": "canary-id"}}
+	var output syncBuffer
+	logger := logging.NewStructuredLogger(logging.LoggerConfig{Level: logging.DEBUG, Output: &output})
+	shadower := NewShadower(canary, logger, Config{
+		Percent:      100,
+		IsRead:       func(method string) bool { return strings.Contains(method, "/Get") },
+		IgnoreFields: []string{"id"},
+	})
+	interceptor := shadower.UnaryInterceptor()
+
+	primary, _ := structpb.NewStruct(map[string]interface{}{"title": "old", "id": "primary-id"})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return primary, nil }
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token", "cookie", "secret"))
+
+	for _, method := range []string{"/notebook.NotebookService/GetIdea", "/notebook.NotebookService/CreateIdea"} {
+		resp, err := interceptor(ctx, &structpb.Struct{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		if err != nil || resp != primary {
+			t.Fatalf("%s returned %v, %v; want the primary response", method, resp, err)
+		}
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !strings.Contains(output.String(), "Canary response differs") && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	counts := make(map[string]float64)
+	for _, metric := range shadower.Metrics() {
+		counts[metric.Name] = metric.Value
+	}
+	if counts["shadow_requests_total"] != 1 || counts["shadow_diffs_total"] != 1 || counts["shadow_matches_total"] != 0 {
+		t.Errorf("metrics = %v, want one mirrored read that differed", counts)
+	}
+	if log := output.String(); !strings.Contains(log, `title: "old" != "new"`) || strings.Contains(log, "primary-id") {
+		t.Errorf("log does not report only the title difference: %s", log)
+	}
+
+	canary.mu.Lock()
+	defer canary.mu.Unlock()
+	md := canary.metadata[0]
+	if md.Get(Header)[0] != "true" || md.Get("authorization")[0] != "Bearer token" || len(md.Get("cookie")) != 0 {
+		t.Errorf("canary metadata = %v", md)
+	}
+}
@@ -0,0 +1,356 @@
+package startup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/supervisor"
+)
+
+var (
+	ErrInvalidDependency = errors.New("dependency requires a name and a probe")
+	ErrDependencyExists  = errors.New("dependency already registered")
+	ErrUnknownDependency = errors.New("unknown dependency")
+	ErrDependencyCycle   = errors.New("dependency cycle")
+	ErrBudgetExhausted   = errors.New("startup wait budget exhausted")
+)
+
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateReady    State = "ready"
+	StateDegraded State = "degraded"
+	StateFailed   State = "failed"
+)
+
+// ProbeFunc checks a dependency once. It may also open the connection the
+// server keeps using, so it only needs to succeed once.
+type ProbeFunc func(ctx context.Context) error
+
+type Dependency struct {
+	Name string `json:"name"`
+	// DependsOn lists dependencies that must be ready before this one is probed.
+	DependsOn []string `json:"depends_on"`
+	// Optional dependencies still down when the wait budget runs out leave the
+	// server degraded instead of failing startup, and keep being probed in the background.
+	Optional bool      `json:"optional"`
+	Probe    ProbeFunc `json:"-"`
+	// OnRecover runs when an optional dependency that was degraded at startup becomes ready.
+	OnRecover func() `json:"-"`
+}
+
+type Config struct {
+	InitialBackoff time.Duration `json:"initial_backoff"`
+	MaxBackoff     time.Duration `json:"max_backoff"`
+	// MaxWait is the budget shared by every dependency, measured from the start of Run.
+	MaxWait      time.Duration `json:"max_wait"`
+	ProbeTimeout time.Duration `json:"probe_timeout"`
+	// OnAttempt is called after every failed probe with the delay before the next one.
+	OnAttempt func(name string, attempt int, err error, retryIn time.Duration) `json:"-"`
+}
+
+type DependencyStatus struct {
+	Name      string    `json:"name"`
+	State     State     `json:"state"`
+	Optional  bool      `json:"optional"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error,omitempty"`
+	ReadyAt   time.Time `json:"ready_at"`
+}
+
+// Orchestrator probes the server's dependencies in dependency order, retrying
+// each one with exponential backoff until it is ready or the wait budget runs out.
+type Orchestrator struct {
+	config       Config
+	dependencies map[string]Dependency
+	order        []string
+
+	mu       sync.Mutex
+	statuses map[string]*DependencyStatus
+	wg       sync.WaitGroup
+}
+
+func NewOrchestrator(config Config) *Orchestrator {
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = 500 * time.Millisecond
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 10 * time.Second
+	}
+	if config.MaxWait <= 0 {
+		config.MaxWait = 2 * time.Minute
+	}
+	if config.ProbeTimeout <= 0 {
+		config.ProbeTimeout = 5 * time.Second
+	}
+
+	return &Orchestrator{
+		config:       config,
+		dependencies: make(map[string]Dependency),
+		statuses:     make(map[string]*DependencyStatus),
+	}
+}
+
+func (o *Orchestrator) Add(dependency Dependency) error {
+	if dependency.Name == "" || dependency.Probe == nil {
+		return ErrInvalidDependency
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, exists := o.dependencies[dependency.Name]; exists {
+		return fmt.Errorf("%w: %s", ErrDependencyExists, dependency.Name)
+	}
+	o.dependencies[dependency.Name] = dependency
+	o.order = append(o.order, dependency.Name)
+	o.statuses[dependency.Name] = &DependencyStatus{
+		Name:     dependency.Name,
+		State:    StatePending,
+		Optional: dependency.Optional,
+	}
+	return nil
+}
+
+// Run blocks until every required dependency is ready. Independent dependencies
+// are probed concurrently. It fails as soon as a required dependency cannot be
+// reached within the budget; optional ones are marked degraded and retried in
+// the background until ctx is cancelled.
+func (o *Orchestrator) Run(ctx context.Context) error {
+	levels, err := o.levels()
+	if err != nil {
+		return err
+	}
+
+	budgetCtx, cancel := context.WithTimeout(ctx, o.config.MaxWait)
+	defer cancel()
+
+	for _, level := range levels {
+		errs := make([]error, len(level))
+		var wg sync.WaitGroup
+		for i, name := range level {
+			wg.Add(1)
+			go func(i int, dependency Dependency) {
+				defer wg.Done()
+				errs[i] = o.start(ctx, budgetCtx, dependency)
+			}(i, o.dependencies[name])
+		}
+		wg.Wait()
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// start waits for a single dependency once its own dependencies have been handled
+func (o *Orchestrator) start(ctx, budgetCtx context.Context, dependency Dependency) error {
+	for _, name := range dependency.DependsOn {
+		if o.state(name) == StateReady {
+			continue
+		}
+		// Nothing is probed on top of a dependency that is down
+		err := fmt.Errorf("%s depends on %s, which is not ready", dependency.Name, name)
+		if dependency.Optional {
+			o.setState(dependency.Name, StateDegraded, err)
+			return nil
+		}
+		o.setState(dependency.Name, StateFailed, err)
+		return err
+	}
+
+	err := o.probeUntil(budgetCtx, dependency, o.config.InitialBackoff)
+	if err == nil {
+		return nil
+	}
+	if !dependency.Optional || ctx.Err() != nil {
+		o.setState(dependency.Name, StateFailed, err)
+		return fmt.Errorf("%s: %w", dependency.Name, err)
+	}
+
+	o.setState(dependency.Name, StateDegraded, err)
+	o.wg.Add(1)
+	supervisor.Go("startup.reprobe", func() {
+		defer o.wg.Done()
+		if o.probeUntil(ctx, dependency, o.config.MaxBackoff) == nil && dependency.OnRecover != nil {
+			dependency.OnRecover()
+		}
+	})
+	return nil
+}
+
+// probeUntil probes the dependency until it succeeds or ctx is done
+func (o *Orchestrator) probeUntil(ctx context.Context, dependency Dependency, backoff time.Duration) error {
+	for attempt := 1; ; attempt++ {
+		probeCtx, cancel := context.WithTimeout(ctx, o.config.ProbeTimeout)
+		err := dependency.Probe(probeCtx)
+		cancel()
+		if err == nil {
+			o.setState(dependency.Name, StateReady, nil)
+			return nil
+		}
+		o.recordAttempt(dependency.Name, err)
+
+		delay := o.jitter(backoff)
+		if o.config.OnAttempt != nil {
+			o.config.OnAttempt(dependency.Name, attempt, err, delay)
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w after %d attempts: %v", ErrBudgetExhausted, attempt, err)
+			}
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > o.config.MaxBackoff {
+			backoff = o.config.MaxBackoff
+		}
+	}
+}
+
+func (o *Orchestrator) jitter(delay time.Duration) time.Duration {
+	// Between half and the full delay so replicas restarted together do not probe in lockstep
+	half := int64(delay / 2)
+	if half <= 0 {
+		return delay
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// levels groups the dependencies so every one comes after those it depends on,
+// in registration order within a level
+func (o *Orchestrator) levels() ([][]string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	depth := make(map[string]int, len(o.order))
+	visiting := make(map[string]bool)
+	var visit func(name string) (int, error)
+	visit = func(name string) (int, error) {
+		if d, ok := depth[name]; ok {
+			return d, nil
+		}
+		dependency, ok := o.dependencies[name]
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrUnknownDependency, name)
+		}
+		if visiting[name] {
+			return 0, fmt.Errorf("%w: %s", ErrDependencyCycle, name)
+		}
+		visiting[name] = true
+		d := 0
+		for _, parent := range dependency.DependsOn {
+			parentDepth, err := visit(parent)
+			if err != nil {
+				return 0, err
+			}
+			if parentDepth+1 > d {
+				d = parentDepth + 1
+			}
+		}
+		visiting[name] = false
+		depth[name] = d
+		return d, nil
+	}
+
+	var levels [][]string
+	for _, name := range o.order {
+		d, err := visit(name)
+		if err != nil {
+			return nil, err
+		}
+		for len(levels) <= d {
+			levels = append(levels, nil)
+		}
+		levels[d] = append(levels[d], name)
+	}
+	return levels, nil
+}
+
+func (o *Orchestrator) state(name string) State {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.statuses[name].State
+}
+
+func (o *Orchestrator) setState(name string, state State, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	status := o.statuses[name]
+	status.State = state
+	if err != nil {
+		status.LastError = err.Error()
+	}
+	if state == StateReady {
+		status.LastError = ""
+		status.ReadyAt = time.Now()
+	}
+}
+
+func (o *Orchestrator) recordAttempt(name string, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	status := o.statuses[name]
+	status.Attempts++
+	status.LastError = err.Error()
+}
+
+// Statuses returns a snapshot of every dependency, sorted by name.
+func (o *Orchestrator) Statuses() []DependencyStatus {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	statuses := make([]DependencyStatus, 0, len(o.statuses))
+	for _, status := range o.statuses {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Degraded returns the names of the optional dependencies that are still down.
+func (o *Orchestrator) Degraded() []string {
+	var names []string
+	for _, status := range o.Statuses() {
+		if status.State == StateDegraded {
+			names = append(names, status.Name)
+		}
+	}
+	return names
+}
+
+// Wait blocks until the background probes of degraded dependencies have stopped.
+func (o *Orchestrator) Wait() {
+	o.wg.Wait()
+}
+
+// Metrics is a metrics.MetricsCollector collector reporting whether each dependency is ready.
+func (o *Orchestrator) Metrics() []metrics.Metric {
+	now := time.Now()
+	var result []metrics.Metric
+	for _, status := range o.Statuses() {
+		ready := 0.0
+		if status.State == StateReady {
+			ready = 1
+		}
+		labels := map[string]string{"dependency": status.Name}
+		result = append(result,
+			metrics.Metric{Name: "startup_dependency_ready", Type: metrics.Gauge, Value: ready, Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "startup_dependency_probe_failures_total", Type: metrics.Counter, Value: float64(status.Attempts), Labels: labels, Timestamp: now},
+		)
+	}
+	return result
+}
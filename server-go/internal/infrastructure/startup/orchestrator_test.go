@@ -0,0 +1,133 @@
+package startup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testConfig() Config {
+	return Config{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		MaxWait:        200 * time.Millisecond,
+		ProbeTimeout:   50 * time.Millisecond,
+	}
+}
+
+// failTimes returns a probe that fails n times before succeeding
+func failTimes(n int32) (ProbeFunc, *int32) {
+	var calls int32
+	return func(ctx context.Context) error {
+		if atomic.AddInt32(&calls, 1) <= n {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, &calls
+}
+
+func TestRunRetriesUntilReadyInDependencyOrder(t *testing.T) {
+	orchestrator := NewOrchestrator(testConfig())
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string, probe ProbeFunc) ProbeFunc {
+		return func(ctx context.Context) error {
+			err := probe(ctx)
+			if err == nil {
+				mu.Lock()
+				order = append(order, name)
+				mu.Unlock()
+			}
+			return err
+		}
+	}
+
+	database, calls := failTimes(3)
+	if err := orchestrator.Add(Dependency{Name: "migrations", DependsOn: []string{"postgres"}, Probe: record("migrations", func(context.Context) error { return nil })}); err != nil {
+		t.Fatal(err)
+	}
+	if err := orchestrator.Add(Dependency{Name: "postgres", Probe: record("postgres", database)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := orchestrator.Run(context.Background()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 4 {
+		t.Errorf("postgres probed %d times, want 4", got)
+	}
+	if len(order) != 2 || order[0] != "postgres" || order[1] != "migrations" {
+		t.Errorf("ready order = %v, want [postgres migrations]", order)
+	}
+	for _, status := range orchestrator.Statuses() {
+		if status.State != StateReady {
+			t.Errorf("%s state = %s, want ready", status.Name, status.State)
+		}
+	}
+}
+
+func TestRunFailsWhenRequiredDependencyExhaustsBudget(t *testing.T) {
+	orchestrator := NewOrchestrator(testConfig())
+	down, _ := failTimes(1 << 30)
+	orchestrator.Add(Dependency{Name: "postgres", Probe: down})
+
+	err := orchestrator.Run(context.Background())
+	if !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("Run() error = %v, want ErrBudgetExhausted", err)
+	}
+}
+
+func TestRunDegradesOptionalDependencyAndRecovers(t *testing.T) {
+	config := testConfig()
+	config.MaxWait = 20 * time.Millisecond
+	orchestrator := NewOrchestrator(config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var available atomic.Bool
+	recovered := make(chan struct{})
+	orchestrator.Add(Dependency{
+		Name:     "storage",
+		Optional: true,
+		Probe: func(context.Context) error {
+			if !available.Load() {
+				return errors.New("mount not ready")
+			}
+			return nil
+		},
+		OnRecover: func() { close(recovered) },
+	})
+	orchestrator.Add(Dependency{Name: "thumbnails", Optional: true, DependsOn: []string{"storage"}, Probe: func(context.Context) error { return nil }})
+
+	if err := orchestrator.Run(ctx); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if degraded := orchestrator.Degraded(); len(degraded) != 2 {
+		t.Fatalf("Degraded() = %v, want storage and thumbnails", degraded)
+	}
+
+	available.Store(true)
+	select {
+	case <-recovered:
+	case <-time.After(time.Second):
+		t.Fatal("storage never recovered")
+	}
+	cancel()
+	orchestrator.Wait()
+}
+
+func TestRunRejectsCycles(t *testing.T) {
+	orchestrator := NewOrchestrator(testConfig())
+	ok := func(context.Context) error { return nil }
+	orchestrator.Add(Dependency{Name: "a", DependsOn: []string{"b"}, Probe: ok})
+	orchestrator.Add(Dependency{Name: "b", DependsOn: []string{"a"}, Probe: ok})
+
+	if err := orchestrator.Run(context.Background()); !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("Run() error = %v, want ErrDependencyCycle", err)
+	}
+}
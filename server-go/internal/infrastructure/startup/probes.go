@@ -0,0 +1,38 @@
+package startup
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+)
+
+// TCPProbe succeeds once addr accepts TCP connections. It is enough for
+// dependencies the server has no client for yet, such as Redis.
+func TCPProbe(addr string) ProbeFunc {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
+
+// DirProbe succeeds once dir exists, or can be created, and a file can be
+// written in it. Network mounts for uploads often show up after the server starts.
+func DirProbe(dir string) ProbeFunc {
+	return func(ctx context.Context) error {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		file, err := os.CreateTemp(dir, ".startup-probe-*")
+		if err != nil {
+			return fmt.Errorf("%s is not writable: %w", dir, err)
+		}
+		name := file.Name()
+		file.Close()
+		return os.Remove(name)
+	}
+}
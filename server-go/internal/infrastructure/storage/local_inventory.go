@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// LocalInventory implements ports.StorageInventory for the local uploads directory.
+type LocalInventory struct {
+	root string
+}
+
+// NewLocalInventory takes the same root directory given to the local storage service,
+// so walked paths match the ones recorded in the files table.
+func NewLocalInventory(root string) *LocalInventory {
+	return &LocalInventory{root: root}
+}
+
+func (i *LocalInventory) Walk(ctx context.Context, fn func(blob ports.StoredBlob) error) error {
+	err := filepath.WalkDir(i.root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// Removed while walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		rel, err := filepath.Rel(i.root, path)
+		if err != nil {
+			return err
+		}
+		return fn(ports.StoredBlob{
+			Path:       i.blobPath(rel),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		})
+	})
+	// Nothing has been stored yet
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (i *LocalInventory) Exists(ctx context.Context, path string) (bool, error) {
+	_, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// blobPath joins without cleaning, since filepath.Join would turn "./uploads/x" into "uploads/x".
+func (i *LocalInventory) blobPath(rel string) string {
+	return strings.TrimRight(i.root, string(filepath.Separator)) + string(filepath.Separator) + rel
+}
\ No newline at end of file
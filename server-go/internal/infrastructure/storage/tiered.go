@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+)
+
+// ColdPathPrefix marks the paths of blobs stored in the cold tier.
+const ColdPathPrefix = "cold:"
+
+// TieredFileStorage stores uploads in the hot tier and routes reads and deletes to the tier
+// encoded in the path, so blobs keep working for every reader after a lifecycle transition.
+type TieredFileStorage struct {
+	hot  ports.FileStorageService
+	cold ports.FileStorageService
+}
+
+// NewTieredFileStorage takes the main storage and a cheaper one (another bucket, storage class or disk).
+func NewTieredFileStorage(hot, cold ports.FileStorageService) *TieredFileStorage {
+	return &TieredFileStorage{hot: hot, cold: cold}
+}
+
+func (s *TieredFileStorage) StoreFile(ctx context.Context, filename string, reader io.Reader, compress bool, compressionType string) (string, string, int64, error) {
+	return s.hot.StoreFile(ctx, filename, reader, compress, compressionType)
+}
+
+func (s *TieredFileStorage) RetrieveFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	backend, path := s.backend(path)
+	return backend.RetrieveFile(ctx, path)
+}
+
+func (s *TieredFileStorage) DeleteFile(ctx context.Context, path string) error {
+	backend, path := s.backend(path)
+	return backend.DeleteFile(ctx, path)
+}
+
+func (s *TieredFileStorage) CompressFile(data []byte, compressionType string) ([]byte, error) {
+	return s.hot.CompressFile(data, compressionType)
+}
+
+func (s *TieredFileStorage) DecompressFile(data []byte, compressionType string) ([]byte, error) {
+	return s.hot.DecompressFile(data, compressionType)
+}
+
+// CopyToTier implements ports.StorageTiering. Bytes are copied as stored, so compressed
+// blobs stay compressed and their checksum still matches.
+func (s *TieredFileStorage) CopyToTier(ctx context.Context, path string, tier entities.StorageTier) (string, error) {
+	if TierOf(path) == tier {
+		return path, nil
+	}
+
+	reader, err := s.RetrieveFile(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	target := s.hot
+	if tier == entities.StorageTierCold {
+		target = s.cold
+	}
+
+	newPath, _, _, err := target.StoreFile(ctx, filepath.Base(strings.TrimPrefix(path, ColdPathPrefix)), reader, false, "")
+	if err != nil {
+		return "", err
+	}
+	if tier == entities.StorageTierCold {
+		newPath = ColdPathPrefix + newPath
+	}
+	return newPath, nil
+}
+
+func (s *TieredFileStorage) backend(path string) (ports.FileStorageService, string) {
+	if strings.HasPrefix(path, ColdPathPrefix) {
+		return s.cold, strings.TrimPrefix(path, ColdPathPrefix)
+	}
+	return s.hot, path
+}
+
+// TierOf returns the tier a path belongs to.
+func TierOf(path string) entities.StorageTier {
+	if strings.HasPrefix(path, ColdPathPrefix) {
+		return entities.StorageTierCold
+	}
+	return entities.StorageTierHot
+}
+
+// TieredInventory walks both tiers, reporting cold blobs with ColdPathPrefix.
+type TieredInventory struct {
+	hot  ports.StorageInventory
+	cold ports.StorageInventory
+}
+
+func NewTieredInventory(hot, cold ports.StorageInventory) *TieredInventory {
+	return &TieredInventory{hot: hot, cold: cold}
+}
+
+func (i *TieredInventory) Walk(ctx context.Context, fn func(blob ports.StoredBlob) error) error {
+	if err := i.hot.Walk(ctx, fn); err != nil {
+		return err
+	}
+	return i.cold.Walk(ctx, func(blob ports.StoredBlob) error {
+		blob.Path = ColdPathPrefix + blob.Path
+		return fn(blob)
+	})
+}
+
+func (i *TieredInventory) Exists(ctx context.Context, path string) (bool, error) {
+	if strings.HasPrefix(path, ColdPathPrefix) {
+		return i.cold.Exists(ctx, strings.TrimPrefix(path, ColdPathPrefix))
+	}
+	return i.hot.Exists(ctx, path)
+}
\ No newline at end of file
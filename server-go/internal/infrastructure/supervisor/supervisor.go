@@ -0,0 +1,173 @@
+package supervisor
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/metrics"
+)
+
+// DefaultLeakSamples is how many consecutive samples a task's count must grow
+// before Watch reports it when WatchConfig.Samples is unset.
+const DefaultLeakSamples = 5
+
+// Default supervises the background goroutines of every package; Go starts
+// tasks on it.
+var Default = New()
+
+// Go starts fn as a named task of Default.
+func Go(name string, fn func()) {
+	Default.Go(name, fn)
+}
+
+// Leak describes a task whose running count grew in every one of the last
+// Samples samples.
+type Leak struct {
+	Name    string `json:"name"`
+	Running int    `json:"running"`
+	Samples int    `json:"samples"`
+}
+
+type WatchConfig struct {
+	// Interval between samples of the running counts.
+	Interval time.Duration `json:"interval"`
+	// Samples is how many consecutive growing samples make a leak; zero uses DefaultLeakSamples.
+	Samples int `json:"samples"`
+	// OnLeak is called once per growth streak, when it reaches Samples.
+	OnLeak func(leak Leak) `json:"-"`
+}
+
+type task struct {
+	running int
+	started int64
+
+	// Leak detection state, updated by sample
+	lastSample int
+	streak     int
+	reported   bool
+}
+
+// Supervisor tracks the goroutines started through it by task name, so a loop
+// that is started more often than it stops shows up in the metrics and in the
+// logs instead of only in a heap profile.
+type Supervisor struct {
+	mu    sync.Mutex
+	tasks map[string]*task
+	leaks int64
+}
+
+func New() *Supervisor {
+	return &Supervisor{tasks: make(map[string]*task)}
+}
+
+// Go runs fn in a new goroutine counted under name until it returns.
+func (s *Supervisor) Go(name string, fn func()) {
+	s.mu.Lock()
+	t := s.task(name)
+	t.running++
+	t.started++
+	s.mu.Unlock()
+
+	go func() {
+		defer s.done(name)
+		fn()
+	}()
+}
+
+func (s *Supervisor) done(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[name].running--
+}
+
+func (s *Supervisor) task(name string) *task {
+	t, ok := s.tasks[name]
+	if !ok {
+		t = &task{}
+		s.tasks[name] = t
+	}
+	return t
+}
+
+// Running returns how many goroutines of each task are running; tasks that
+// ran before and have none running are reported with zero.
+func (s *Supervisor) Running() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	running := make(map[string]int, len(s.tasks))
+	for name, t := range s.tasks {
+		running[name] = t.running
+	}
+	return running
+}
+
+// Watch samples the running counts every Interval until ctx is done and
+// reports the tasks that keep growing. It is meant to run in its own goroutine.
+func (s *Supervisor) Watch(ctx context.Context, config WatchConfig) {
+	if config.Samples <= 0 {
+		config.Samples = DefaultLeakSamples
+	}
+
+	ticker := time.NewTicker(config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, leak := range s.sample(config.Samples) {
+				if config.OnLeak != nil {
+					config.OnLeak(leak)
+				}
+			}
+		}
+	}
+}
+
+// sample compares the running counts with the previous sample and returns the
+// tasks whose growth streak just reached samples. A streak ends as soon as a
+// count stops growing, so a pool that fills up and stays full is not a leak.
+func (s *Supervisor) sample(samples int) []Leak {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var leaks []Leak
+	for name, t := range s.tasks {
+		if t.running > t.lastSample {
+			t.streak++
+		} else {
+			t.streak = 0
+			t.reported = false
+		}
+		t.lastSample = t.running
+
+		if t.streak >= samples && !t.reported {
+			t.reported = true
+			s.leaks++
+			leaks = append(leaks, Leak{Name: name, Running: t.running, Samples: t.streak})
+		}
+	}
+	sort.Slice(leaks, func(i, j int) bool { return leaks[i].Name < leaks[j].Name })
+	return leaks
+}
+
+func (s *Supervisor) Metrics() []metrics.Metric {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	result := make([]metrics.Metric, 0, 2*len(s.tasks)+1)
+	for name, t := range s.tasks {
+		labels := map[string]string{"task": name}
+		result = append(result,
+			metrics.Metric{Name: "goroutines_running", Type: metrics.Gauge, Value: float64(t.running), Labels: labels, Timestamp: now},
+			metrics.Metric{Name: "goroutines_started_total", Type: metrics.Counter, Value: float64(t.started), Labels: labels, Timestamp: now},
+		)
+	}
+	result = append(result, metrics.Metric{Name: "goroutine_leak_warnings_total", Type: metrics.Counter, Value: float64(s.leaks), Timestamp: now})
+	return result
+}
@@ -0,0 +1,66 @@
+package supervisor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGoCountsRunningTasks(t *testing.T) {
+	s := New()
+	release := make(chan struct{})
+	finished := make(chan struct{})
+
+	s.Go("worker", func() { <-release })
+	s.Go("worker", func() { <-release })
+	s.Go("flush", func() { close(finished) })
+	<-finished
+
+	// The flush task may still be unwinding; only the blocked workers are certain
+	if got := s.Running()["worker"]; got != 2 {
+		t.Fatalf("Running()[worker] = %d, want 2", got)
+	}
+
+	close(release)
+	for {
+		if running := s.Running(); running["worker"] == 0 && running["flush"] == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSampleReportsTasksThatKeepGrowing(t *testing.T) {
+	s := New()
+	block := make(chan struct{})
+	defer close(block)
+
+	// "pool" grows once and then stays flat; "leaky" grows on every sample
+	s.Go("pool", func() { <-block })
+	s.Go("pool", func() { <-block })
+	var leaks []Leak
+	for i := 0; i < 4; i++ {
+		s.Go("leaky", func() { <-block })
+		leaks = append(leaks, s.sample(3)...)
+	}
+
+	want := []Leak{{Name: "leaky", Running: 3, Samples: 3}}
+	if !reflect.DeepEqual(leaks, want) {
+		t.Fatalf("leaks = %+v, want %+v", leaks, want)
+	}
+
+	// A streak is reported once, and again only after it is broken
+	if leaks := s.sample(3); len(leaks) != 0 {
+		t.Fatalf("flat sample reported %+v", leaks)
+	}
+
+	var leakWarnings float64
+	for _, metric := range s.Metrics() {
+		if metric.Name == "goroutine_leak_warnings_total" {
+			leakWarnings = metric.Value
+		}
+	}
+	if leakWarnings != 1 {
+		t.Fatalf("goroutine_leak_warnings_total = %v, want 1", leakWarnings)
+	}
+}
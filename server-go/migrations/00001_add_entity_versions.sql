@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE ideas ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE progress ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE progress DROP COLUMN IF EXISTS version;
+ALTER TABLE reminders DROP COLUMN IF EXISTS version;
+ALTER TABLE ideas DROP COLUMN IF EXISTS version;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_entity_change() RETURNS trigger AS $$
+DECLARE
+    row_data JSONB;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        row_data := to_jsonb(OLD);
+    ELSE
+        row_data := to_jsonb(NEW);
+    END IF;
+
+    PERFORM pg_notify('entity_changes', json_build_object(
+        'table', TG_TABLE_NAME,
+        'operation', TG_OP,
+        'id', row_data->>'id',
+        'user_id', row_data->>'user_id',
+        'version', COALESCE((row_data->>'version')::BIGINT, 0)
+    )::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ideas_change_notify AFTER INSERT OR UPDATE OR DELETE ON ideas
+    FOR EACH ROW EXECUTE FUNCTION notify_entity_change();
+CREATE TRIGGER reminders_change_notify AFTER INSERT OR UPDATE OR DELETE ON reminders
+    FOR EACH ROW EXECUTE FUNCTION notify_entity_change();
+CREATE TRIGGER progress_change_notify AFTER INSERT OR UPDATE OR DELETE ON progress
+    FOR EACH ROW EXECUTE FUNCTION notify_entity_change();
+CREATE TRIGGER files_change_notify AFTER INSERT OR UPDATE OR DELETE ON files
+    FOR EACH ROW EXECUTE FUNCTION notify_entity_change();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS files_change_notify ON files;
+DROP TRIGGER IF EXISTS progress_change_notify ON progress;
+DROP TRIGGER IF EXISTS reminders_change_notify ON reminders;
+DROP TRIGGER IF EXISTS ideas_change_notify ON ideas;
+DROP FUNCTION IF EXISTS notify_entity_change();
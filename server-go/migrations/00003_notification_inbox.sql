@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notification_inbox (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    user_id UUID NOT NULL,
+    title TEXT NOT NULL,
+    message TEXT NOT NULL,
+    type TEXT NOT NULL,
+    channels TEXT[] NOT NULL DEFAULT '{}',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_inbox_user_seq ON notification_inbox (user_id, seq);
+CREATE INDEX IF NOT EXISTS idx_notification_inbox_created_at ON notification_inbox (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_inbox;
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS file_share_links (
+    id UUID PRIMARY KEY,
+    file_id UUID NOT NULL REFERENCES files (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    max_downloads BIGINT NOT NULL DEFAULT 0,
+    download_count BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_share_links_file_id ON file_share_links (file_id, created_at);
+
+-- Sin clave foránea: la auditoría se conserva aunque se borre el enlace o el archivo
+CREATE TABLE IF NOT EXISTS file_share_link_access (
+    id UUID PRIMARY KEY,
+    link_id UUID NOT NULL,
+    outcome TEXT NOT NULL,
+    client_ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    accessed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_share_link_access_link_id ON file_share_link_access (link_id, accessed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS file_share_link_access;
+DROP TABLE IF EXISTS file_share_links;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Cada fila de files es una versión; las versiones de un mismo archivo comparten logical_id
+ALTER TABLE files ADD COLUMN IF NOT EXISTS logical_id UUID;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+UPDATE files SET logical_id = id WHERE logical_id IS NULL;
+ALTER TABLE files ALTER COLUMN logical_id SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_files_logical_version ON files (logical_id, version);
+CREATE INDEX IF NOT EXISTS idx_files_user_filename ON files (user_id, filename, version);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_files_user_filename;
+DROP INDEX IF EXISTS idx_files_logical_version;
+ALTER TABLE files DROP COLUMN IF EXISTS version;
+ALTER TABLE files DROP COLUMN IF EXISTS logical_id;
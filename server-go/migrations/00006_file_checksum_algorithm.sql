@@ -0,0 +1,6 @@
+-- +goose Up
+-- Vacío en archivos anteriores: su checksum es el que devolvía el almacenamiento
+ALTER TABLE files ADD COLUMN IF NOT EXISTS checksum_algorithm TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE files DROP COLUMN IF EXISTS checksum_algorithm;
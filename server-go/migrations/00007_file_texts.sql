@@ -0,0 +1,15 @@
+-- +goose Up
+-- Texto extraído del contenido de los archivos; search_vector alimenta la búsqueda de archivos
+CREATE TABLE IF NOT EXISTS file_texts (
+    file_id UUID PRIMARY KEY REFERENCES files (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    extractor TEXT NOT NULL,
+    extracted_at TIMESTAMPTZ NOT NULL,
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_texts_search_vector ON file_texts USING GIN (search_vector);
+
+-- +goose Down
+DROP TABLE IF EXISTS file_texts;
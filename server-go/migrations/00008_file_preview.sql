@@ -0,0 +1,6 @@
+-- +goose Up
+-- Metadatos de vista previa (dimensiones, EXIF, páginas, duración); NULL si no se pudieron extraer
+ALTER TABLE files ADD COLUMN IF NOT EXISTS preview JSONB;
+
+-- +goose Down
+ALTER TABLE files DROP COLUMN IF EXISTS preview;
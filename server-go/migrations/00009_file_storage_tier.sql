@@ -0,0 +1,11 @@
+-- +goose Up
+-- Nivel del archivo físico; tier_changed_at evita que un archivo recién restaurado vuelva enseguida al nivel frío
+ALTER TABLE files ADD COLUMN IF NOT EXISTS storage_tier TEXT NOT NULL DEFAULT 'hot';
+ALTER TABLE files ADD COLUMN IF NOT EXISTS tier_changed_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_files_storage_tier_path ON files (storage_tier, path);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_files_storage_tier_path;
+ALTER TABLE files DROP COLUMN IF EXISTS tier_changed_at;
+ALTER TABLE files DROP COLUMN IF EXISTS storage_tier;
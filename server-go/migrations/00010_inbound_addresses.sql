@@ -0,0 +1,16 @@
+-- +goose Up
+-- Direcciones secretas a las que se envían correos o peticiones JSON para crear ideas y recordatorios
+CREATE TABLE IF NOT EXISTS inbound_addresses (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    allowed_senders TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_inbound_addresses_user_id ON inbound_addresses (user_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS inbound_addresses;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Chats de Slack y Telegram vinculados a un usuario para recibir sus notificaciones
+CREATE TABLE IF NOT EXISTS chat_bindings (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    provider TEXT NOT NULL,
+    chat_id TEXT,
+    code_hash TEXT,
+    code_expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    linked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_bindings_user_id ON chat_bindings (user_id, created_at);
+-- Un chat solo puede estar vinculado a un usuario
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_bindings_chat ON chat_bindings (provider, chat_id) WHERE chat_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_bindings_code ON chat_bindings (provider, code_hash) WHERE code_hash IS NOT NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS chat_bindings;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Idioma preferido de cada usuario para los mensajes y notificaciones del servidor
+CREATE TABLE IF NOT EXISTS user_locales (
+    user_id UUID PRIMARY KEY,
+    locale TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_locales;
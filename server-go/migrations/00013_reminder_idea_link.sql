@@ -0,0 +1,11 @@
+-- +goose Up
+-- Idea a la que pertenece el recordatorio; el envejecimiento de prioridades sube las ideas con recordatorios próximos
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS idea_id UUID REFERENCES ideas (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_idea_id ON reminders (idea_id, scheduled_time) WHERE idea_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_ideas_status_id ON ideas (status, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ideas_status_id;
+DROP INDEX IF EXISTS idx_reminders_idea_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS idea_id;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Posición de la idea en su columna del tablero: clave fraccionaria en base 62 que se ordena byte a
+-- byte, de ahí la collation "C"; las ideas existentes quedan sin posición y van primero
+ALTER TABLE ideas ADD COLUMN IF NOT EXISTS position TEXT COLLATE "C" NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_ideas_board ON ideas (user_id, status, position);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ideas_board;
+ALTER TABLE ideas DROP COLUMN IF EXISTS position;
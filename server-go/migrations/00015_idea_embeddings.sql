@@ -0,0 +1,26 @@
+-- +goose Up
+-- Búsqueda de ideas: search_vector alimenta la búsqueda por palabras e idea_embeddings guarda los
+-- vectores de contenido de la búsqueda semántica (extensión pgvector)
+ALTER TABLE ideas ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_ideas_search_vector ON ideas USING GIN (search_vector);
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- La dimensión depende del modelo configurado, por eso la columna no la fija
+CREATE TABLE IF NOT EXISTS idea_embeddings (
+    idea_id UUID PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    model TEXT NOT NULL,
+    embedding VECTOR NOT NULL,
+    content_hash TEXT NOT NULL,
+    embedded_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_embeddings_user_model ON idea_embeddings (user_id, model);
+
+-- +goose Down
+DROP TABLE IF EXISTS idea_embeddings;
+DROP INDEX IF EXISTS idx_ideas_search_vector;
+ALTER TABLE ideas DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Ideas inscritas en el repaso espaciado (SM-2): vuelven a la cola de repaso cuando vence due_at
+CREATE TABLE IF NOT EXISTS idea_reviews (
+    idea_id UUID PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    ease_factor DOUBLE PRECISION NOT NULL,
+    interval_days INTEGER NOT NULL DEFAULT 0,
+    repetitions INTEGER NOT NULL DEFAULT 0,
+    due_at TIMESTAMPTZ NOT NULL,
+    last_reviewed_at TIMESTAMPTZ,
+    enrolled_at TIMESTAMPTZ NOT NULL,
+    version BIGINT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_reviews_user_due ON idea_reviews (user_id, due_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idea_reviews;
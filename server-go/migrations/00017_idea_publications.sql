@@ -0,0 +1,20 @@
+-- +goose Up
+-- Ideas publicadas en modo solo lectura en /p/<slug>, sin autenticación
+CREATE TABLE IF NOT EXISTS idea_publications (
+    id UUID PRIMARY KEY,
+    idea_id UUID NOT NULL REFERENCES ideas (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    slug TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    view_count BIGINT NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    unpublished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_idea_publications_user_id ON idea_publications (user_id, created_at);
+-- Una idea tiene como mucho una publicación vigente; las retiradas quedan como historial
+CREATE UNIQUE INDEX IF NOT EXISTS idx_idea_publications_current ON idea_publications (idea_id) WHERE unpublished_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS idea_publications;
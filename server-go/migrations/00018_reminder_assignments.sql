@@ -0,0 +1,11 @@
+-- +goose Up
+-- Usuario al que se delegó el recordatorio y si aceptó la asignación (0 sin asignar, 1 pendiente, 2 aceptada, 3 rechazada)
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS assignee_id UUID;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS assignment_status INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_assignee_id ON reminders (assignee_id, scheduled_time) WHERE assignee_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reminders_assignee_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS assignment_status;
+ALTER TABLE reminders DROP COLUMN IF EXISTS assignee_id;
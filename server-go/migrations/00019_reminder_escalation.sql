@@ -0,0 +1,15 @@
+-- +goose Up
+-- Política de escalado de los recordatorios Deadline: pasos enviados y confirmación del usuario que lo detiene
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS escalation_policy JSONB;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS escalation_level INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS acknowledged_at TIMESTAMPTZ;
+
+-- El planificador solo recorre los vencidos sin confirmar que tienen política
+CREATE INDEX IF NOT EXISTS idx_reminders_escalation ON reminders (scheduled_time)
+    WHERE escalation_policy IS NOT NULL AND acknowledged_at IS NULL AND status = 5;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reminders_escalation;
+ALTER TABLE reminders DROP COLUMN IF EXISTS acknowledged_at;
+ALTER TABLE reminders DROP COLUMN IF EXISTS escalation_level;
+ALTER TABLE reminders DROP COLUMN IF EXISTS escalation_policy;
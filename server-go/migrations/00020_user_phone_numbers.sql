@@ -0,0 +1,24 @@
+-- +goose Up
+-- Teléfono de cada usuario para las notificaciones por SMS; solo recibe SMS una vez verificado
+CREATE TABLE IF NOT EXISTS user_phone_numbers (
+    user_id UUID PRIMARY KEY,
+    number TEXT NOT NULL,
+    code_hash TEXT,
+    code_expires_at TIMESTAMPTZ NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- SMS enviados por usuario y día (UTC), para el límite diario de gasto
+CREATE TABLE IF NOT EXISTS sms_usage (
+    user_id UUID NOT NULL,
+    day DATE NOT NULL,
+    count INTEGER NOT NULL,
+    PRIMARY KEY (user_id, day)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS sms_usage;
+DROP TABLE IF EXISTS user_phone_numbers;
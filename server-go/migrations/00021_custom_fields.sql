@@ -0,0 +1,26 @@
+-- +goose Up
+-- Campos personalizados con tipo que cada usuario define para sus ideas y su progreso
+CREATE TABLE IF NOT EXISTS custom_fields (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    entity_type INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    field_type INTEGER NOT NULL,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (user_id, entity_type, key)
+);
+
+-- Valores por clave: {"clave": {"type": "number", "value": 3}}
+ALTER TABLE ideas ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE progress ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+
+-- Los filtros por igualdad usan @>
+CREATE INDEX IF NOT EXISTS idx_ideas_custom_fields ON ideas USING GIN (custom_fields jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ideas_custom_fields;
+ALTER TABLE progress DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE ideas DROP COLUMN IF EXISTS custom_fields;
+DROP TABLE IF EXISTS custom_fields;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Estadísticas del panel de cada usuario, mantenidas a partir de los eventos de dominio; cada
+-- sección se recalcula por separado cuando cambian sus datos
+CREATE TABLE IF NOT EXISTS user_statistics (
+    user_id UUID PRIMARY KEY,
+    ideas_by_status JSONB NOT NULL DEFAULT '{}',
+    ideas_by_category JSONB NOT NULL DEFAULT '{}',
+    reminders_due_this_week INTEGER NOT NULL DEFAULT 0,
+    week_start TIMESTAMPTZ,
+    storage_files INTEGER NOT NULL DEFAULT 0,
+    storage_bytes BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_statistics;
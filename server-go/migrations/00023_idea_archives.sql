@@ -0,0 +1,18 @@
+-- +goose Up
+-- Las ideas archivadas sin cambios recientes se compactan: su contenido pasa comprimido a
+-- idea_archives y la fila conserva un extracto para los listados y la búsqueda
+ALTER TABLE ideas ADD COLUMN IF NOT EXISTS compacted_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS idea_archives (
+    idea_id UUID PRIMARY KEY REFERENCES ideas (id) ON DELETE CASCADE,
+    -- JSON comprimido con gzip
+    bundle BYTEA NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ideas_compaction ON ideas (updated_at) WHERE status = 5 AND compacted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ideas_compaction;
+DROP TABLE IF EXISTS idea_archives;
+ALTER TABLE ideas DROP COLUMN IF EXISTS compacted_at;
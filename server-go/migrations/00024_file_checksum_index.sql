@@ -0,0 +1,6 @@
+-- +goose Up
+-- Búsqueda del contenido ya almacenado de un usuario por checksum para evitar volver a subirlo
+CREATE INDEX IF NOT EXISTS idx_files_user_checksum ON files (user_id, checksum, size);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_files_user_checksum;
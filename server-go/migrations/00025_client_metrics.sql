@@ -0,0 +1,21 @@
+-- +goose Up
+-- Telemetría enviada por la app Android, ya muestreada y sin datos personales
+CREATE TABLE IF NOT EXISTS client_metrics (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    device_id TEXT NOT NULL,
+    app_version TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    name TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    message TEXT NOT NULL,
+    attributes JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_metrics_kind_occurred ON client_metrics (kind, name, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_client_metrics_received_at ON client_metrics (received_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS client_metrics;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Auditoría de los contenidos rechazados por la política de moderación; no guarda el texto
+CREATE TABLE IF NOT EXISTS moderation_rejections (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    organization TEXT NOT NULL DEFAULT '',
+    scope TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    matches TEXT[] NOT NULL DEFAULT '{}',
+    moderator TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_rejections_org_created ON moderation_rejections (organization, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS moderation_rejections;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- Direcciones de email de los usuarios para el canal "email" y los informes que aceptaron recibir
+CREATE TABLE IF NOT EXISTS user_emails (
+    user_id UUID PRIMARY KEY,
+    address TEXT NOT NULL,
+    weekly_summary BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_emails_weekly_summary ON user_emails (user_id) WHERE weekly_summary;
+
+-- Último resumen semanal enviado a cada usuario; evita reenviarlo y guarda el progreso de los
+-- proyectos para calcular el avance de la semana siguiente
+CREATE TABLE IF NOT EXISTS weekly_summaries (
+    user_id UUID PRIMARY KEY,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    ideas_created INTEGER NOT NULL,
+    reminders_completed INTEGER NOT NULL,
+    overdue_reminders INTEGER NOT NULL,
+    overdue_milestones INTEGER NOT NULL,
+    projects JSONB NOT NULL DEFAULT '[]',
+    sent_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS weekly_summaries;
+DROP TABLE IF EXISTS user_emails;
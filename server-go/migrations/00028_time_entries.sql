@@ -0,0 +1,17 @@
+-- +goose Up
+-- Tiempo registrado en los hitos del progreso; stopped_at es NULL mientras el cronómetro está en
+-- marcha y cada usuario tiene como máximo uno
+CREATE TABLE IF NOT EXISTS time_entries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    progress_id UUID NOT NULL REFERENCES progress (id) ON DELETE CASCADE,
+    milestone_id UUID NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    stopped_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_entries_progress ON time_entries (progress_id, started_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE stopped_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS time_entries;
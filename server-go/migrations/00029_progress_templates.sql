@@ -0,0 +1,19 @@
+-- +goose Up
+-- Conjuntos reutilizables de hitos; organization no está vacía cuando la plantilla se comparte con
+-- la organización del dueño
+CREATE TABLE IF NOT EXISTS progress_templates (
+    id UUID PRIMARY KEY,
+    owner_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    milestones JSONB NOT NULL DEFAULT '[]',
+    organization TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_progress_templates_owner ON progress_templates (owner_id, name);
+CREATE INDEX IF NOT EXISTS idx_progress_templates_organization ON progress_templates (organization, name) WHERE organization <> '';
+
+-- +goose Down
+DROP TABLE IF EXISTS progress_templates;
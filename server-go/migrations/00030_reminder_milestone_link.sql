@@ -0,0 +1,13 @@
+-- +goose Up
+-- Hito que se completa al completar el recordatorio; completes_reminder pide también el sentido inverso
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS progress_id UUID;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS milestone_id UUID;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS completes_reminder BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_milestone_id ON reminders (milestone_id) WHERE milestone_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reminders_milestone_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS completes_reminder;
+ALTER TABLE reminders DROP COLUMN IF EXISTS milestone_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS progress_id;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Consultas del repositorio de recordatorios en PostgreSQL: listado del usuario y barrido de vencidos.
+-- Las columnas de asignación y escalado llegan con 00018, 00019 y 00030
+CREATE INDEX IF NOT EXISTS idx_reminders_user_id ON reminders (user_id, scheduled_time);
+
+-- El barrido de vencidos solo recorre los pendientes y activos
+CREATE INDEX IF NOT EXISTS idx_reminders_due ON reminders (scheduled_time) WHERE status IN (1, 2);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reminders_due;
+DROP INDEX IF EXISTS idx_reminders_user_id;
@@ -0,0 +1,313 @@
+// Package notebook permite embeber el servidor gRPC del cuaderno dentro de otros servicios Go.
+//
+// New construye el servidor a partir de Config; cualquier repositorio o servicio puede
+// reemplazarse con las opciones With*. Las dependencias no reemplazadas usan SQLite
+// (si Config.SQLitePath no está vacío) o PostgreSQL.
+package notebook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	grpcAdapter https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/grpc"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/postgres"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/sqlite"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/circuitbreaker"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/jobs"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/limits"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/lock"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/notifications"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/requestid"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/security"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/services"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	pbv2 https://github.com/federiconbaez/gogrpc-go-android/proto/notebook/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// ErrAlreadyStarted se devuelve al llamar a Start sobre un servidor ya iniciado
+var ErrAlreadyStarted = errors.New("notebook server already started")
+
+// Config contiene la configuración del servidor embebido
+type Config struct {
+	// Address es la dirección de escucha gRPC; por defecto ":50051"
+	Address string
+	// SQLitePath usa SQLite en ese archivo en lugar de PostgreSQL
+	SQLitePath string
+	// Postgres se usa cuando SQLitePath está vacío y falta algún repositorio
+	Postgres PostgresConfig
+	// UploadDir es el directorio del almacenamiento local de archivos; por defecto "./uploads"
+	UploadDir string
+	// ReminderCheckInterval es la frecuencia con la que se marcan recordatorios vencidos; por defecto 1 minuto
+	ReminderCheckInterval time.Duration
+	// NotificationHeartbeat es el intervalo de los latidos de los streams de notificaciones; por defecto 30 segundos
+	NotificationHeartbeat time.Duration
+	// MaxStreamsPerUser y MaxTotalStreams limitan los streams abiertos; por defecto 10 por usuario y 1000 en total
+	MaxStreamsPerUser int
+	MaxTotalStreams   int
+	// Logger recibe los errores de las tareas en segundo plano; por defecto no se registra nada
+	Logger *zap.Logger
+}
+
+// Server es un servidor del cuaderno listo para iniciarse
+type Server struct {
+	config      Config
+	grpcServer  *grpc.Server
+	jobRegistry *jobs.Registry
+	closers     []func()
+
+	mu       sync.Mutex
+	listener net.Listener
+	stopOnce sync.Once
+}
+
+// New construye el servidor y sus dependencias sin empezar a escuchar
+func New(config Config, options ...Option) (*Server, error) {
+	if config.Address == "" {
+		config.Address = ":50051"
+	}
+	if config.UploadDir == "" {
+		config.UploadDir = "./uploads"
+	}
+	if config.ReminderCheckInterval <= 0 {
+		config.ReminderCheckInterval = time.Minute
+	}
+	if config.Logger == nil {
+		config.Logger = zap.NewNop()
+	}
+
+	deps := &dependencies{}
+	for _, option := range options {
+		option(deps)
+	}
+	if deps.clock == nil {
+		deps.clock = entities.SystemClock{}
+	}
+	if deps.ids == nil {
+		deps.ids = entities.UUIDGenerator{}
+	}
+
+	server := &Server{config: config}
+	if deps.needsDatabase() {
+		if err := server.openDatabase(deps); err != nil {
+			server.close()
+			return nil, err
+		}
+	}
+
+	if deps.locker == nil {
+		// Sin base de datos compartida no hay otras réplicas con las que coordinarse
+		deps.locker = lock.NewLocalLocker()
+	}
+	if deps.eventBus == nil {
+		deps.eventBus = services.NewInMemoryEventBus()
+	}
+	if deps.fileStorage == nil {
+		deps.fileStorage = services.NewLocalFileStorageService(config.UploadDir)
+	}
+	if deps.notificationService == nil {
+		deps.notificationService = notifications.NewHub(notifications.HubConfig{
+			Outbound: services.NewNotificationService(deps.eventBus),
+			Inbox:    deps.notificationInbox,
+			Clock:    deps.clock,
+			IDs:      deps.ids,
+		})
+	}
+
+	ideaUseCases := usecases.NewIdeaUseCases(deps.ideaRepo, deps.eventBus, deps.clock, deps.ids)
+	reminderUseCases := usecases.NewReminderUseCases(deps.reminderRepo, deps.ideaRepo, deps.notificationService, deps.eventBus, deps.clock, deps.ids)
+	fileUseCases := usecases.NewFileUseCases(deps.fileRepo, deps.fileStorage, deps.eventBus, deps.unitOfWork, deps.clock, deps.ids)
+	progressUseCases := usecases.NewProgressUseCases(deps.progressRepo, deps.eventBus, deps.clock, deps.ids)
+
+	if err := server.registerJobs(deps); err != nil {
+		server.close()
+		return nil, err
+	}
+
+	// Los mismos interceptores de transporte que el servidor principal: request ID, códigos de
+	// error, límites de tamaño de las peticiones y de streams abiertos
+	requestIDs := requestid.NewInterceptor(deps.ids)
+	requestLimits := limits.NewEnforcer(limits.Config{})
+	streamLimiter := security.NewStreamLimiter(security.StreamLimiterConfig{
+		MaxStreamsPerUser: config.MaxStreamsPerUser,
+		MaxTotalStreams:   config.MaxTotalStreams,
+	})
+	grpcOptions := append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(requestIDs.UnaryInterceptor(), grpcAdapter.ErrorCodeUnaryInterceptor(), requestLimits.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(requestIDs.StreamInterceptor(), grpcAdapter.ErrorCodeStreamInterceptor(), requestLimits.StreamInterceptor(), streamLimiter.StreamInterceptor()),
+	}, deps.grpcOptions...)
+
+	var serverOptions []grpcAdapter.ServerOption
+	if deps.notificationInbox != nil {
+		serverOptions = append(serverOptions, grpcAdapter.WithNotificationInbox(deps.notificationInbox))
+	}
+	if config.NotificationHeartbeat > 0 {
+		serverOptions = append(serverOptions, grpcAdapter.WithNotificationHeartbeat(config.NotificationHeartbeat))
+	}
+
+	server.grpcServer = grpc.NewServer(grpcOptions...)
+	pb.RegisterNotebookServiceServer(server.grpcServer, grpcAdapter.NewNotebookServer(
+		ideaUseCases,
+		reminderUseCases,
+		fileUseCases,
+		progressUseCases,
+		deps.notificationService,
+		serverOptions...,
+	))
+	pbv2.RegisterNotebookServiceServer(server.grpcServer, grpcAdapter.NewNotebookServerV2(ideaUseCases))
+
+	return server, nil
+}
+
+// GRPCServer devuelve el servidor gRPC subyacente para registrar servicios adicionales antes de Start
+func (s *Server) GRPCServer() *grpc.Server {
+	return s.grpcServer
+}
+
+// Start empieza a escuchar en Config.Address y atiende peticiones en segundo plano.
+// Las tareas en segundo plano se detienen al cancelar ctx o al llamar a Stop.
+func (s *Server) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		return ErrAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.Address, err)
+	}
+	s.listener = listener
+
+	s.jobRegistry.Start(ctx)
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil {
+			s.config.Logger.Error("Notebook gRPC server stopped", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// Addr devuelve la dirección de escucha real, útil con Address ":0"; nil antes de Start
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Stop espera a que terminen las peticiones en curso y libera las conexiones abiertas por New
+func (s *Server) Stop() {
+	s.stopOnce.Do(func() {
+		s.grpcServer.GracefulStop()
+		s.jobRegistry.Stop()
+		s.close()
+	})
+}
+
+// openDatabase construye los repositorios que no se reemplazaron con opciones
+func (s *Server) openDatabase(deps *dependencies) error {
+	if s.config.SQLitePath != "" {
+		db, err := sqlite.NewConnection(s.config.SQLitePath)
+		if err != nil {
+			return fmt.Errorf("failed to open sqlite database: %w", err)
+		}
+		s.closers = append(s.closers, func() { db.Close() })
+
+		if deps.ideaRepo == nil {
+			deps.ideaRepo = sqlite.NewIdeaRepository(db)
+		}
+		if deps.reminderRepo == nil {
+			deps.reminderRepo = sqlite.NewReminderRepository(db)
+		}
+		if deps.fileRepo == nil {
+			deps.fileRepo = sqlite.NewFileRepository(db)
+		}
+		if deps.progressRepo == nil {
+			deps.progressRepo = sqlite.NewProgressRepository(db)
+		}
+		if deps.unitOfWork == nil {
+			deps.unitOfWork = sqlite.NewUnitOfWork(db)
+		}
+		if deps.notificationInbox == nil {
+			deps.notificationInbox = sqlite.NewNotificationInbox(db)
+		}
+		if deps.locker == nil {
+			deps.locker = lock.NewLocalLocker()
+		}
+		return nil
+	}
+
+	db, err := postgres.NewConnection(s.config.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	s.closers = append(s.closers, db.Close)
+
+	retrier := postgres.NewRetrier(postgres.RetryConfig{}, circuitbreaker.NewRegistry())
+	if deps.ideaRepo == nil {
+		deps.ideaRepo = postgres.NewRetryingIdeaRepository(postgres.NewIdeaRepository(db), retrier)
+	}
+	if deps.reminderRepo == nil {
+		deps.reminderRepo = postgres.NewReminderRepository(db)
+	}
+	if deps.fileRepo == nil {
+		deps.fileRepo = postgres.NewRetryingFileRepository(postgres.NewFileRepository(db), retrier)
+	}
+	if deps.progressRepo == nil {
+		deps.progressRepo = postgres.NewProgressRepository(db)
+	}
+	if deps.unitOfWork == nil {
+		deps.unitOfWork = postgres.NewUnitOfWork(db)
+	}
+	if deps.notificationInbox == nil {
+		deps.notificationInbox = postgres.NewNotificationInbox(db)
+	}
+	if deps.locker == nil {
+		deps.locker = postgres.NewAdvisoryLocker(db)
+	}
+	return nil
+}
+
+// registerJobs registra las tareas en segundo plano; el marcado de recordatorios vencidos es singleton
+func (s *Server) registerJobs(deps *dependencies) error {
+	s.jobRegistry = jobs.NewRegistry(jobs.RegistryConfig{Locker: deps.locker, Clock: deps.clock})
+	s.jobRegistry.OnRunComplete(func(status jobs.JobStatus, err error) {
+		if status.LastResult == jobs.ResultFailed {
+			s.config.Logger.Error("Background job failed", zap.String("job", status.Name), zap.Error(err))
+		}
+	})
+
+	reminderScheduler := usecases.NewReminderSchedulerUseCases(deps.reminderRepo, deps.notificationService, deps.clock, usecases.WithReminderEventBus(deps.eventBus, deps.ids))
+	return s.jobRegistry.Register(jobs.JobConfig{
+		Name:       "reminder_scheduler",
+		Interval:   s.config.ReminderCheckInterval,
+		Timeout:    30 * time.Second,
+		RunOnStart: true,
+		Singleton:  true,
+		Task: func(ctx context.Context) error {
+			_, err := reminderScheduler.MarkOverdueReminders(ctx)
+			return err
+		},
+	})
+}
+
+// close libera las conexiones abiertas en orden inverso
+func (s *Server) close() {
+	for i := len(s.closers) - 1; i >= 0; i-- {
+		s.closers[i]()
+	}
+	s.closers = nil
+}
@@ -0,0 +1,123 @@
+package notebook
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Option reemplaza una dependencia del servidor. Las dependencias no reemplazadas
+// se construyen a partir de Config.
+type Option func(*dependencies)
+
+type dependencies struct {
+	ideaRepo            IdeaRepository
+	reminderRepo        ReminderRepository
+	fileRepo            FileRepository
+	progressRepo        ProgressRepository
+	unitOfWork          UnitOfWork
+	fileStorage         FileStorageService
+	notificationService NotificationService
+	notificationInbox   NotificationInbox
+	eventBus            EventBus
+	locker              DistributedLocker
+	clock               Clock
+	ids                 IDGenerator
+	grpcOptions         []grpc.ServerOption
+}
+
+// needsDatabase indica si falta algún repositorio que deba construirse desde Config
+func (d *dependencies) needsDatabase() bool {
+	return d.ideaRepo == nil || d.reminderRepo == nil || d.fileRepo == nil ||
+		d.progressRepo == nil || d.unitOfWork == nil
+}
+
+// WithIdeaRepository reemplaza el repositorio de ideas
+func WithIdeaRepository(repo IdeaRepository) Option {
+	return func(d *dependencies) {
+		d.ideaRepo = repo
+	}
+}
+
+// WithReminderRepository reemplaza el repositorio de recordatorios
+func WithReminderRepository(repo ReminderRepository) Option {
+	return func(d *dependencies) {
+		d.reminderRepo = repo
+	}
+}
+
+// WithFileRepository reemplaza el repositorio de archivos
+func WithFileRepository(repo FileRepository) Option {
+	return func(d *dependencies) {
+		d.fileRepo = repo
+	}
+}
+
+// WithProgressRepository reemplaza el repositorio de progreso
+func WithProgressRepository(repo ProgressRepository) Option {
+	return func(d *dependencies) {
+		d.progressRepo = repo
+	}
+}
+
+// WithUnitOfWork reemplaza la unidad de trabajo transaccional
+func WithUnitOfWork(unitOfWork UnitOfWork) Option {
+	return func(d *dependencies) {
+		d.unitOfWork = unitOfWork
+	}
+}
+
+// WithFileStorage reemplaza el almacenamiento del contenido de los archivos
+func WithFileStorage(storage FileStorageService) Option {
+	return func(d *dependencies) {
+		d.fileStorage = storage
+	}
+}
+
+// WithNotificationService reemplaza el servicio de notificaciones
+func WithNotificationService(service NotificationService) Option {
+	return func(d *dependencies) {
+		d.notificationService = service
+	}
+}
+
+// WithNotificationInbox reemplaza el buzón en el que se guardan las notificaciones enviadas
+// para reenviarlas a los clientes que reanudan su suscripción
+func WithNotificationInbox(inbox NotificationInbox) Option {
+	return func(d *dependencies) {
+		d.notificationInbox = inbox
+	}
+}
+
+// WithEventBus reemplaza el bus de eventos de dominio
+func WithEventBus(eventBus EventBus) Option {
+	return func(d *dependencies) {
+		d.eventBus = eventBus
+	}
+}
+
+// WithLocker reemplaza el lock distribuido usado por las tareas singleton
+func WithLocker(locker DistributedLocker) Option {
+	return func(d *dependencies) {
+		d.locker = locker
+	}
+}
+
+// WithClock reemplaza el reloj usado por los casos de uso
+func WithClock(clock Clock) Option {
+	return func(d *dependencies) {
+		d.clock = clock
+	}
+}
+
+// WithIDGenerator reemplaza el generador de IDs de las entidades
+func WithIDGenerator(ids IDGenerator) Option {
+	return func(d *dependencies) {
+		d.ids = ids
+	}
+}
+
+// WithGRPCServerOptions añade opciones al servidor gRPC, por ejemplo interceptores o credenciales TLS
+func WithGRPCServerOptions(options ...grpc.ServerOption) Option {
+	return func(d *dependencies) {
+		d.grpcOptions = append(d.grpcOptions, options...)
+	}
+}
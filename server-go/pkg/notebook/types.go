@@ -0,0 +1,44 @@
+package notebook
+
+import (
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/ports"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/postgres"
+)
+
+// Alias de los tipos internos necesarios para implementar repositorios y servicios propios
+// desde fuera del módulo. Son los mismos tipos, no copias.
+
+// Entidades de dominio
+type (
+	Idea        = entities.Idea
+	Reminder    = entities.Reminder
+	FileInfo    = entities.FileInfo
+	Progress    = entities.Progress
+	Clock       = entities.Clock
+	IDGenerator = entities.IDGenerator
+)
+
+// Puertos que pueden reemplazarse con opciones de New
+type (
+	IdeaRepository      = ports.IdeaRepository
+	ReminderRepository  = ports.ReminderRepository
+	FileRepository      = ports.FileRepository
+	ProgressRepository  = ports.ProgressRepository
+	UnitOfWork          = ports.UnitOfWork
+	Tx                  = ports.Tx
+	IdeaFilters         = ports.IdeaFilters
+	ReminderFilters     = ports.ReminderFilters
+	FileFilters         = ports.FileFilters
+	FileStorageService  = ports.FileStorageService
+	NotificationService = ports.NotificationService
+	NotificationInbox   = ports.NotificationInbox
+	Notification        = ports.Notification
+	EventBus            = ports.EventBus
+	EventHandler        = ports.EventHandler
+	DistributedLocker   = ports.DistributedLocker
+	LockLease           = ports.LockLease
+)
+
+// PostgresConfig configura la conexión a PostgreSQL
+type PostgresConfig = postgres.Config
@@ -0,0 +1,278 @@
+package e2e
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/limits"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/requestid"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// El servidor no tiene registro de usuarios: un usuario existe desde que se usa su ID, así
+// que cada test empieza con IDs nuevos.
+
+func TestIdeaLifecycle(t *testing.T) {
+	server := newTestServer(t)
+	ctx := testContext(t)
+	userID := uuid.NewString()
+
+	created, err := server.client.CreateIdea(ctx, &pb.CreateIdeaRequest{
+		Title:    "Aplicación de notas por voz",
+		Content:  "Transcribir notas de voz y guardarlas como ideas",
+		Tags:     []string{"producto", "voz"},
+		Category: pb.IdeaCategory_IDEA_CATEGORY_BUSINESS,
+		Priority: 3,
+		UserId:   userID,
+	})
+	require.NoError(t, err)
+	require.True(t, created.Success)
+	require.NotEmpty(t, created.Idea.Id)
+
+	_, err = server.client.CreateIdea(ctx, &pb.CreateIdeaRequest{
+		Title:    "Refactorizar el sincronizador",
+		Content:  "Separar la resolución de conflictos",
+		Category: pb.IdeaCategory_IDEA_CATEGORY_TECHNICAL,
+		UserId:   userID,
+	})
+	require.NoError(t, err)
+
+	got, err := server.client.GetIdea(ctx, &pb.GetIdeaRequest{Id: created.Idea.Id, UserId: userID})
+	require.NoError(t, err)
+	require.Equal(t, created.Idea.Title, got.Idea.Title)
+	require.ElementsMatch(t, []string{"producto", "voz"}, got.Idea.Tags)
+
+	listed, err := server.client.ListIdeas(ctx, &pb.ListIdeasRequest{UserId: userID})
+	require.NoError(t, err)
+	require.Len(t, listed.Ideas, 2)
+	require.EqualValues(t, 2, listed.TotalCount)
+
+	// Las ideas de un usuario no son visibles para otro
+	_, err = server.client.GetIdea(ctx, &pb.GetIdeaRequest{Id: created.Idea.Id, UserId: uuid.NewString()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	otherUser, err := server.client.ListIdeas(ctx, &pb.ListIdeasRequest{UserId: uuid.NewString()})
+	require.NoError(t, err)
+	require.Empty(t, otherUser.Ideas)
+
+	_, err = server.client.DeleteIdea(ctx, &pb.DeleteIdeaRequest{Id: created.Idea.Id, UserId: userID})
+	require.NoError(t, err)
+
+	_, err = server.client.GetIdea(ctx, &pb.GetIdeaRequest{Id: created.Idea.Id, UserId: userID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFileUpload(t *testing.T) {
+	server := newTestServer(t)
+	ctx := testContext(t)
+	userID := uuid.NewString()
+
+	content := []byte("Notas de la reunión del lunes\n- revisar el presupuesto\n- cerrar el diseño\n")
+	sum := sha256.Sum256(content)
+
+	stream, err := server.client.UploadFile(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Metadata{Metadata: &pb.FileMetadata{
+		Filename:    "reunion.txt",
+		ContentType: "text/plain",
+		TotalSize:   int64(len(content)),
+		UserId:      userID,
+		Checksum:    hex.EncodeToString(sum[:]),
+	}}}))
+	// Se envía en dos fragmentos, como lo haría un cliente con archivos grandes
+	for _, chunk := range [][]byte{content[:20], content[20:]} {
+		require.NoError(t, stream.Send(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Chunk{Chunk: chunk}}))
+	}
+	uploaded, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.True(t, uploaded.Success)
+	require.Equal(t, "reunion.txt", uploaded.FileInfo.Filename)
+	require.EqualValues(t, len(content), uploaded.FileInfo.Size)
+
+	listed, err := server.client.ListFiles(ctx, &pb.ListFilesRequest{UserId: userID, SearchQuery: "reunion"})
+	require.NoError(t, err)
+	require.Len(t, listed.Files, 1)
+	require.Equal(t, uploaded.FileInfo.Id, listed.Files[0].Id)
+
+	// Un checksum que no coincide con el contenido rechaza la subida
+	stream, err = server.client.UploadFile(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Metadata{Metadata: &pb.FileMetadata{
+		Filename:    "corrupto.txt",
+		ContentType: "text/plain",
+		TotalSize:   int64(len(content)),
+		UserId:      userID,
+		Checksum:    hex.EncodeToString(make([]byte, sha256.Size)),
+	}}}))
+	require.NoError(t, stream.Send(&pb.UploadFileRequest{Data: &pb.UploadFileRequest_Chunk{Chunk: content}}))
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.DataLoss, status.Code(err))
+
+	listed, err = server.client.ListFiles(ctx, &pb.ListFilesRequest{UserId: userID})
+	require.NoError(t, err)
+	require.Len(t, listed.Files, 1)
+}
+
+// El recordatorio se guarda directamente en la base de datos (NotebookServer no implementa
+// CreateReminder); lo demás pasa por gRPC y por la tarea que marca los recordatorios vencidos.
+func TestOverdueReminderNotification(t *testing.T) {
+	server := newTestServer(t)
+	ctx := testContext(t)
+	userID := uuid.NewString()
+
+	idea, err := server.client.CreateIdea(ctx, &pb.CreateIdeaRequest{
+		Title:    "Preparar la demo",
+		Content:  "Demo para el cliente del viernes",
+		Category: pb.IdeaCategory_IDEA_CATEGORY_BUSINESS,
+		UserId:   userID,
+	})
+	require.NoError(t, err)
+
+	streamCtx, disconnect := context.WithCancel(ctx)
+	subscription, err := server.client.SubscribeNotifications(streamCtx, &pb.NotificationSubscriptionRequest{UserId: userID})
+	require.NoError(t, err)
+	// El primer latido confirma que la suscripción ya está registrada en el hub
+	first, err := subscription.Recv()
+	require.NoError(t, err)
+	require.True(t, first.Heartbeat)
+
+	reminder := createPastReminder(t, server, userID, idea.Idea.Id, "Ensayar la demo")
+
+	overdue := nextNotification(t, subscription)
+	require.Equal(t, "reminder_overdue", overdue.Type)
+	require.Equal(t, "Ensayar la demo", overdue.Title)
+	require.Equal(t, reminder.ID.String(), overdue.Metadata["reminder_id"])
+	require.False(t, overdue.Replayed)
+
+	// Lo enviado mientras el cliente estaba desconectado se recupera desde el buzón al reanudar
+	disconnect()
+	missed := createPastReminder(t, server, userID, idea.Idea.Id, "Enviar la agenda")
+	require.Eventually(t, func() bool {
+		listed, err := server.client.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: userID})
+		return err == nil && len(listed.Notifications) == 2
+	}, 5*time.Second, reminderCheckInterval)
+
+	subscription, err = server.client.SubscribeNotifications(ctx, &pb.NotificationSubscriptionRequest{
+		UserId:        userID,
+		ResumeAfterId: overdue.Id,
+	})
+	require.NoError(t, err)
+	replayed := nextNotification(t, subscription)
+	require.Equal(t, missed.ID.String(), replayed.Metadata["reminder_id"])
+	require.True(t, replayed.Replayed)
+}
+
+// Los límites y los códigos de error los aplican los interceptores, antes de llegar a los handlers
+func TestRequestLimits(t *testing.T) {
+	server := newTestServer(t)
+	ctx := testContext(t)
+
+	var trailer metadata.MD
+	_, err := server.client.CreateIdea(ctx, &pb.CreateIdeaRequest{
+		Title:  strings.Repeat("a", 501),
+		UserId: uuid.NewString(),
+	}, grpc.Trailer(&trailer))
+
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NotEmpty(t, trailer.Get(requestid.Header))
+
+	var info *errdetails.ErrorInfo
+	for _, detail := range status.Convert(err).Details() {
+		if errorInfo, ok := detail.(*errdetails.ErrorInfo); ok && errorInfo.Reason == limits.FieldTooLargeReason {
+			info = errorInfo
+		}
+	}
+	require.NotNil(t, info)
+	require.Equal(t, "title", info.Metadata["field"])
+}
+
+// Las actualizaciones con una versión desactualizada se rechazan con el recordatorio actual
+func TestReminderVersionConflict(t *testing.T) {
+	server := newTestServer(t)
+	ctx := testContext(t)
+	userID := uuid.NewString()
+
+	idea, err := server.client.CreateIdea(ctx, &pb.CreateIdeaRequest{
+		Title:    "Renovar el dominio",
+		Content:  "Vence a fin de mes",
+		Category: pb.IdeaCategory_IDEA_CATEGORY_TECHNICAL,
+		UserId:   userID,
+	})
+	require.NoError(t, err)
+	reminder := createReminder(t, server, userID, idea.Idea.Id, "Pagar el dominio", time.Now().Add(time.Hour))
+
+	updated, err := server.client.UpdateReminder(ctx, &pb.UpdateReminderRequest{
+		Id:              reminder.ID.String(),
+		UserId:          userID,
+		Title:           "Pagar y renovar el dominio",
+		ExpectedVersion: reminder.Version,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Pagar y renovar el dominio", updated.Reminder.Title)
+	require.Greater(t, updated.Reminder.Version, reminder.Version)
+
+	_, err = server.client.UpdateReminder(ctx, &pb.UpdateReminderRequest{
+		Id:              reminder.ID.String(),
+		UserId:          userID,
+		Title:           "Cambio de otro dispositivo",
+		ExpectedVersion: reminder.Version,
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	var latest *pb.Reminder
+	for _, detail := range status.Convert(err).Details() {
+		if current, ok := detail.(*pb.Reminder); ok {
+			latest = current
+		}
+	}
+	require.NotNil(t, latest)
+	require.Equal(t, updated.Reminder.Version, latest.Version)
+	require.Equal(t, "Pagar y renovar el dominio", latest.Title)
+}
+
+func createPastReminder(t *testing.T, server *testServer, userID, ideaID, title string) *entities.Reminder {
+	t.Helper()
+	return createReminder(t, server, userID, ideaID, title, time.Now().Add(-time.Minute))
+}
+
+func createReminder(t *testing.T, server *testServer, userID, ideaID, title string, scheduledTime time.Time) *entities.Reminder {
+	t.Helper()
+	reminder, err := server.reminders.CreateReminder(
+		testContext(t),
+		title,
+		"",
+		scheduledTime,
+		entities.ReminderTypeTask,
+		uuid.MustParse(userID),
+		false,
+		0,
+		nil,
+		uuid.MustParse(ideaID),
+	)
+	require.NoError(t, err)
+	return reminder
+}
+
+// nextNotification devuelve la siguiente notificación del stream, saltando los latidos
+func nextNotification(t *testing.T, subscription pb.NotebookService_SubscribeNotificationsClient) *pb.NotificationResponse {
+	t.Helper()
+	for {
+		notification, err := subscription.Recv()
+		require.NoError(t, err)
+		if !notification.Heartbeat {
+			return notification
+		}
+	}
+}
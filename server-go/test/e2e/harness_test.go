@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/application/usecases"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/domain/entities"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/internal/infrastructure/adapters/sqlite"
+	https://github.com/federiconbaez/gogrpc-go-android/server-go/pkg/notebook"
+	pb https://github.com/federiconbaez/gogrpc-go-android/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// heartbeatInterval es corto para que los tests sepan enseguida que la suscripción está abierta
+	heartbeatInterval = 20 * time.Millisecond
+	// reminderCheckInterval es la frecuencia de la tarea que marca los recordatorios vencidos
+	reminderCheckInterval = 50 * time.Millisecond
+)
+
+// testServer es el servidor embebible de pkg/notebook sobre SQLite en un directorio temporal,
+// escuchando en un puerto TCP libre. Las llamadas pasan por la misma cadena de interceptores
+// que en producción y las tareas en segundo plano corren como en el modo standalone.
+type testServer struct {
+	client pb.NotebookServiceClient
+	// reminders escribe directamente en la base de datos del servidor: NotebookServer no
+	// implementa CreateReminder, así que es la única forma de preparar un recordatorio
+	reminders *usecases.ReminderUseCases
+}
+
+// newTestServer arranca el servidor y lo detiene al terminar el test
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	dir := t.TempDir()
+	sqlitePath := filepath.Join(dir, "notebook.db")
+	server, err := notebook.New(notebook.Config{
+		Address:               "127.0.0.1:0",
+		SQLitePath:            sqlitePath,
+		UploadDir:             filepath.Join(dir, "uploads"),
+		ReminderCheckInterval: reminderCheckInterval,
+		NotificationHeartbeat: heartbeatInterval,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, server.Start(ctx))
+	t.Cleanup(func() {
+		cancel()
+		server.Stop()
+	})
+
+	conn, err := grpc.Dial(server.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	db, err := sqlite.NewConnection(sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return &testServer{
+		client:    pb.NewNotebookServiceClient(conn),
+		reminders: usecases.NewReminderUseCases(sqlite.NewReminderRepository(db), sqlite.NewIdeaRepository(db), nil, nil, entities.SystemClock{}, entities.UUIDGenerator{}),
+	}
+}
+
+// testContext devuelve un contexto que vence antes que el timeout del paquete, para que un
+// stream que no recibe nada falle con un error legible
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}